@@ -0,0 +1,119 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/olegiv/ocms-go/internal/i18n"
+)
+
+// runI18n reports missing and unused message keys across the core admin
+// catalogs (with the operator catalogs of the custom directory layered on
+// top), module catalogs and theme catalogs of a source tree. Every language
+// with a core or operator catalog is checked against the English catalogs.
+// It fails when translations are missing, so it can gate CI.
+func runI18n(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("i18n", flag.ContinueOnError)
+	var (
+		root      string
+		customDir string
+		unused    bool
+	)
+	fs.StringVar(&root, "root", ".", "oCMS source tree to check")
+	fs.StringVar(&customDir, "custom", "", "Custom content directory (default: <root>/custom)")
+	fs.BoolVar(&unused, "unused", true, "Report keys no source file refers to")
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr, "Usage: ocms i18n [options]\n\n")
+		_, _ = fmt.Fprintf(os.Stderr, "Report missing and unused translation keys across core, modules and themes.\n\n")
+		_, _ = fmt.Fprintf(os.Stderr, "Options:\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		fs.Usage()
+		return errors.New("i18n takes no arguments")
+	}
+	if customDir == "" {
+		customDir = filepath.Join(root, "custom")
+	}
+
+	core, err := i18n.ReadCatalogSet("core", filepath.Join(root, "internal", "i18n", "locales"))
+	if err != nil {
+		return err
+	}
+	if err := core.Merge(filepath.Join(customDir, "locales")); err != nil {
+		return err
+	}
+	sets := []i18n.CatalogSet{core}
+	for _, group := range []struct{ kind, pattern string }{
+		{"module", filepath.Join(root, "modules", "*", "locales")},
+		{"module", filepath.Join(customDir, "modules", "*", "locales")},
+		{"theme", filepath.Join(root, "internal", "themes", "*", "locales")},
+		{"theme", filepath.Join(customDir, "themes", "*", "locales")},
+	} {
+		dirs, err := filepath.Glob(group.pattern)
+		if err != nil {
+			return err
+		}
+		for _, dir := range dirs {
+			set, err := i18n.ReadCatalogSet(group.kind+" "+filepath.Base(filepath.Dir(dir)), dir)
+			if err != nil {
+				return err
+			}
+			sets = append(sets, set)
+		}
+	}
+
+	var usage *i18n.KeyUsage
+	if unused {
+		usage, err = i18n.ScanKeyUsage(
+			filepath.Join(root, "cmd"),
+			filepath.Join(root, "internal"),
+			filepath.Join(root, "modules"),
+			filepath.Join(root, "web"),
+			customDir,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	missing := 0
+	for _, report := range i18n.CheckKeys(sets, core.Languages(), usage) {
+		missing += report.MissingCount()
+		if report.MissingCount() == 0 && len(report.Unused) == 0 {
+			_, _ = fmt.Fprintf(w, "%s: ok\n", report.Name)
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "%s:\n", report.Name)
+		for _, lang := range core.Languages() {
+			keys := report.Missing[lang]
+			if len(keys) == 0 {
+				continue
+			}
+			_, _ = fmt.Fprintf(w, "  missing in %s (%d):\n", lang, len(keys))
+			for _, key := range keys {
+				_, _ = fmt.Fprintf(w, "    %s\n", key)
+			}
+		}
+		if len(report.Unused) > 0 {
+			_, _ = fmt.Fprintf(w, "  unused (%d):\n", len(report.Unused))
+			for _, key := range report.Unused {
+				_, _ = fmt.Fprintf(w, "    %s\n", key)
+			}
+		}
+	}
+	if missing > 0 {
+		return fmt.Errorf("%d missing translations", missing)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestRunI18nReportsMissingKeys verifies the check fails on missing
+// translations, including those of an operator-added admin language.
+func TestRunI18nReportsMissingKeys(t *testing.T) {
+	root := t.TempDir()
+	write := func(path, content string) {
+		t.Helper()
+		path = filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("internal/i18n/locales/en/messages.json", `{"messages": [{"id": "btn.save", "translation": "Save"}]}`)
+	write("internal/i18n/locales/ru/messages.json", `{"messages": [{"id": "btn.save", "translation": "Сохранить"}]}`)
+	write("internal/handler/x.go", `package handler; var _ = "btn.save"`)
+	write("modules/demo/locales/en/messages.json", `{"messages": [{"id": "demo.title", "translation": "Demo"}]}`)
+	write("modules/demo/locales/ru/messages.json", `{"messages": [{"id": "demo.title", "translation": "Демо"}]}`)
+	write("custom/locales/de/messages.json", `{"messages": [{"id": "btn.save", "translation": "Speichern"}]}`)
+
+	var out bytes.Buffer
+	err := runI18n([]string{"-root", root}, &out)
+	if err == nil {
+		t.Fatal("expected an error for missing translations")
+	}
+	report := out.String()
+	if !strings.Contains(report, "core: ok") {
+		t.Errorf("core catalogs should be complete:\n%s", report)
+	}
+	if !strings.Contains(report, "module demo:\n  missing in de (1):\n    demo.title\n  unused (1):\n    demo.title\n") {
+		t.Errorf("unexpected module report:\n%s", report)
+	}
+}
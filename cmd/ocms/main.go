@@ -38,7 +38,10 @@ import (
 	"github.com/olegiv/ocms-go/internal/demo"
 	"github.com/olegiv/ocms-go/internal/handler"
 	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/linkcheck"
 	"github.com/olegiv/ocms-go/internal/logging"
+	"github.com/olegiv/ocms-go/internal/machinetranslation"
+	"github.com/olegiv/ocms-go/internal/mail"
 	"github.com/olegiv/ocms-go/internal/middleware"
 	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/module"
@@ -50,6 +53,7 @@ import (
 	"github.com/olegiv/ocms-go/internal/store"
 	"github.com/olegiv/ocms-go/internal/theme"
 	"github.com/olegiv/ocms-go/internal/themes"
+	"github.com/olegiv/ocms-go/internal/transfer"
 	"github.com/olegiv/ocms-go/internal/util"
 	"github.com/olegiv/ocms-go/internal/version"
 	"github.com/olegiv/ocms-go/internal/webhook"
@@ -111,10 +115,13 @@ func registerFrontendRoutes(r chi.Router, h *handler.FrontendHandler) {
 	r.Get(handler.RouteRoot, h.Home)
 	r.Get(handler.RouteSuffixSearch, h.Search)
 	r.Get(handler.RouteBlog, h.Blog)
+	r.Get(handler.RouteBlogYear, h.BlogArchive)
+	r.Get(handler.RouteBlogMonth, h.BlogArchive)
 	r.Get(handler.RouteCategorySlug, h.Category)
 	r.Get(handler.RouteTagSlug, h.Tag)
 	r.Get(handler.RoutePageByID, h.PageByID)
 	r.Get(handler.RouteParamSlug, h.Page)
+	r.Get(handler.RoutePagePath, h.Page)
 
 	// Legacy blog tag URL redirect: /blog/tag/{slug} -> /tag/{slug}
 	r.Get("/blog/tag/{slug}", func(w http.ResponseWriter, req *http.Request) {
@@ -211,6 +218,8 @@ func parseCommand(args []string) (cmd string, rest []string) {
 	switch args[0] {
 	case "init":
 		return "init", args[1:]
+	case "i18n":
+		return "i18n", args[1:]
 	case "serve":
 		return "serve", args[1:]
 	default:
@@ -219,7 +228,8 @@ func parseCommand(args []string) (cmd string, rest []string) {
 }
 
 func main() {
-	// Subcommand dispatch. "init" scaffolds and exits; "serve" (the default)
+	// Subcommand dispatch. "init" scaffolds and exits, "i18n" checks the
+	// translation catalogs and exits; "serve" (the default)
 	// falls through to the normal flag parsing and run() path below.
 	switch cmd, rest := parseCommand(os.Args[1:]); cmd {
 	case "init":
@@ -228,6 +238,12 @@ func main() {
 			os.Exit(1)
 		}
 		return
+	case "i18n":
+		if err := runI18n(rest, os.Stdout); err != nil {
+			slog.Error("i18n check failed", "error", err)
+			os.Exit(1)
+		}
+		return
 	case "serve":
 		os.Args = append([]string{os.Args[0]}, rest...)
 	}
@@ -243,6 +259,7 @@ func main() {
 		_, _ = fmt.Fprintf(os.Stderr, "Usage: %s [command] [options]\n\n", os.Args[0])
 		_, _ = fmt.Fprintf(os.Stderr, "Commands:\n")
 		_, _ = fmt.Fprintf(os.Stderr, "  init <dir>    Scaffold a new site directory (.env + data dirs) and exit\n")
+		_, _ = fmt.Fprintf(os.Stderr, "  i18n          Report missing and unused translation keys and exit\n")
 		_, _ = fmt.Fprintf(os.Stderr, "  serve         Start the server (default when no command is given)\n\n")
 		_, _ = fmt.Fprintf(os.Stderr, "Options:\n")
 		flag.PrintDefaults()
@@ -679,6 +696,29 @@ func auditRequiredAPIKeyExpiryPosture(ctx context.Context, db *sql.DB) error {
 	return nil
 }
 
+// auditLanguageHostCookieDomain refuses to start when OCMS_COOKIE_DOMAIN is
+// set and a language is served from a domain outside it: the session and
+// language cookies would not reach that domain, so visitors would be signed
+// out there.
+func auditLanguageHostCookieDomain(ctx context.Context, queries *store.Queries, cookieDomain string) error {
+	if cookieDomain == "" {
+		return nil
+	}
+	languages, err := queries.ListLanguages(ctx)
+	if err != nil {
+		return fmt.Errorf("auditing language domains: %w", err)
+	}
+	for _, lang := range languages {
+		if lang.Host != "" && !util.HostInDomain(lang.Host, cookieDomain) {
+			return fmt.Errorf(
+				"refusing to start: language %q is served from %s, outside OCMS_COOKIE_DOMAIN=%s; move it to a subdomain of %s or unset OCMS_COOKIE_DOMAIN",
+				lang.Code, lang.Host, cookieDomain, cookieDomain,
+			)
+		}
+	}
+	return nil
+}
+
 func auditRequiredAPIKeyMaxTTLPosture(ctx context.Context, db *sql.DB, maxTTLDays int) error {
 	if maxTTLDays <= 0 {
 		return nil
@@ -1370,6 +1410,11 @@ func run() error {
 	if err := i18n.Init(logger); err != nil {
 		return fmt.Errorf("initializing i18n: %w", err)
 	}
+	// Operator catalogs load before modules and themes so that their
+	// catalogs for added languages are merged too
+	if _, err := i18n.LoadCatalogDir(filepath.Join(cfg.CustomDir, "locales")); err != nil {
+		return fmt.Errorf("loading admin locale catalogs: %w", err)
+	}
 	slog.Info("i18n system initialized", "languages", i18n.SupportedLanguages)
 
 	// Ensure data directory exists with restricted permissions.
@@ -1474,13 +1519,17 @@ func run() error {
 	}
 	initI18nFromDB(ctx, queries)
 
+	if err := auditLanguageHostCookieDomain(ctx, queries, cfg.CookieDomain); err != nil {
+		return err
+	}
+
 	// Initialize session manager
-	sessionManager := session.New(db, cfg.IsDevelopment())
+	sessionManager := session.New(db, cfg.IsDevelopment(), cfg.CookieDomain)
 	middleware.SetSessionManager(sessionManager)
 	slog.Info("session manager initialized")
 
 	// Initialize language cookie security settings
-	middleware.InitLanguageCookies(cfg.IsDevelopment())
+	middleware.InitLanguageCookies(cfg.IsDevelopment(), cfg.CookieDomain)
 
 	// Initialize cache manager
 	cacheManager := initCacheManager(ctx, db, cfg)
@@ -1519,16 +1568,48 @@ func run() error {
 	// Note: Theme loading is deferred until after modules are initialized
 	// so that module template functions (like analyticsHead) are available
 
+	// Initialize and start webhook dispatcher
+	webhookDispatcher := webhook.NewDispatcher(db, logger, webhook.DefaultConfig())
+	var mailSender mail.Sender
+	if cfg.SMTPEnabled() {
+		mailSender = mail.NewSMTPSender(mail.Config{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.SMTPFrom,
+		})
+		webhookDispatcher.SetPauseNotifier(mail.NewAdminNotifier(db, mailSender))
+	}
+	webhookDispatcher.Start(ctx)
+	defer webhookDispatcher.Stop()
+	slog.Info("webhook dispatcher initialized")
+
 	// Initialize scheduler registry and scheduler
 	schedulerRegistry := scheduler.NewRegistry(db, logger)
 	sched := scheduler.New(db, logger, schedulerRegistry)
+	sched.SetDispatcher(webhookDispatcher)
 	if err := sched.Start(); err != nil {
 		return fmt.Errorf("starting scheduler: %w", err)
 	}
 	defer sched.Stop()
 
-	// Initialize task executor for user-created scheduled URL tasks
+	// Initialize task executor for user-created scheduled tasks
 	taskExecutor := scheduler.NewTaskExecutor(db, logger, schedulerRegistry, sched.Cron())
+	taskExecutor.SetCache(cacheManager)
+	taskExecutor.SetSearchIndexer(service.NewSearchService(db))
+	taskExecutor.SetDispatcher(webhookDispatcher)
+	taskExporter := transfer.NewExporter(queries, logger)
+	taskExporter.SetUploadDir(cfg.UploadsDir)
+	taskExecutor.SetExporter(taskExporter, filepath.Join(dbDir, "exports"))
+
+	// Initialize the broken link checker job
+	linkChecker := linkcheck.New(db, logger)
+	linkChecker.SetDispatcher(webhookDispatcher)
+	linkChecker.SetUploadsDir(cfg.UploadsDir)
+	if err := linkChecker.Register(schedulerRegistry, sched.Cron()); err != nil {
+		return fmt.Errorf("registering link check job: %w", err)
+	}
 
 	// Schedule daily demo reset at 01:00 UTC (if demo mode)
 	if middleware.IsDemoMode() {
@@ -1538,12 +1619,6 @@ func run() error {
 		slog.Info("demo reset scheduled", "schedule", "daily at 01:00 UTC")
 	}
 
-	// Initialize and start webhook dispatcher
-	webhookDispatcher := webhook.NewDispatcher(db, logger, webhook.DefaultConfig())
-	webhookDispatcher.Start(ctx)
-	defer webhookDispatcher.Stop()
-	slog.Info("webhook dispatcher initialized")
-
 	// Initialize hook registry
 	hookRegistry := module.NewHookRegistry(logger)
 
@@ -1690,6 +1765,10 @@ func run() error {
 	// Request path middleware for logging context
 	r.Use(middleware.RequestPath)
 
+	// Resolve the site a request is for from its host
+	siteMiddleware := middleware.NewSiteMiddleware(db)
+	r.Use(siteMiddleware.Handler)
+
 	r.Use(sessionManager.LoadAndSave)
 
 	// CSRF protection middleware (applied globally, API routes will be exempted)
@@ -1722,6 +1801,8 @@ func run() error {
 	pagesHandler := handler.NewPagesHandler(db, renderer, sessionManager)
 	pagesHandler.SetBlockSuspiciousMarkup(cfg.BlockSuspiciousPageHTML)
 	pagesHandler.SetSanitizePageHTML(cfg.SanitizePageHTML)
+	pagesHandler.SetThemeManager(themeManager)
+	pagesHandler.SetRedirectsMiddleware(redirectsMiddleware)
 	if cfg.BlockSuspiciousPageHTML {
 		slog.Info("page suspicious HTML blocking policy enabled")
 	}
@@ -1729,6 +1810,7 @@ func run() error {
 	eventsHandler := handler.NewEventsHandler(db, renderer, sessionManager)
 	taxonomyHandler := handler.NewTaxonomyHandler(db, renderer, sessionManager)
 	taxonomyHandler.SetCacheManager(cacheManager)
+	taxonomyHandler.SetRedirectsMiddleware(redirectsMiddleware)
 	mediaHandler := handler.NewMediaHandler(db, renderer, sessionManager, cfg.UploadsDir)
 	menusHandler := handler.NewMenusHandler(db, renderer, sessionManager)
 	frontendHandler := handler.NewFrontendHandler(db, themeManager, cacheManager, logger, renderer.GetMenuService(), eventService)
@@ -1737,6 +1819,9 @@ func run() error {
 		slog.Info("frontend page HTML sanitization enabled")
 	}
 	frontendHandler.SetModuleTemplateFuncsProvider(moduleRegistry)
+	frontendHandler.SetModuleShortcodesProvider(moduleRegistry)
+	frontendHandler.SetModuleWidgetTypesProvider(moduleRegistry)
+	frontendHandler.SetHookRegistry(hookRegistry)
 	formsHandler := handler.NewFormsHandler(db, renderer, sessionManager, hookRegistry, themeManager, cacheManager, renderer.GetMenuService(), frontendHandler)
 	formsHandler.SetRequireCaptcha(cfg.RequireFormCaptcha)
 	if cfg.RequireFormCaptcha {
@@ -1746,27 +1831,71 @@ func run() error {
 	slog.Info("form webhook payload mode configured", "mode", cfg.WebhookFormDataMode)
 	themesHandler := handler.NewThemesHandler(db, renderer, sessionManager, themeManager, cacheManager)
 	widgetsHandler := handler.NewWidgetsHandler(db, renderer, sessionManager, themeManager)
+	widgetsHandler.SetModuleWidgetTypesProvider(moduleRegistry)
 	modulesHandler := handler.NewModulesHandler(db, renderer, sessionManager, moduleRegistry, hookRegistry)
 	cacheHandler := handler.NewCacheHandler(renderer, sessionManager, cacheManager, eventService)
 	schedulerHandler := handler.NewSchedulerHandler(db, renderer, sessionManager, schedulerRegistry, taskExecutor, eventService)
 	languagesHandler := handler.NewLanguagesHandler(db, renderer, sessionManager, cacheManager)
+	languagesHandler.SetRedirectsMiddleware(redirectsMiddleware)
+	languagesHandler.SetCookieDomain(cfg.CookieDomain)
 	apiKeysHandler := handler.NewAPIKeysHandler(db, renderer, sessionManager)
 	apiKeysHandler.SetRequireSourceCIDRs(cfg.RequireAPIKeySourceCIDRs)
 	apiKeysHandler.SetRequireExpiry(cfg.RequireAPIKeyExpiry)
 	apiKeysHandler.SetMaxTTLDays(cfg.APIMaxTTLDays)
 	webhooksHandler := handler.NewWebhooksHandler(db, renderer, sessionManager)
+	inboundWebhooksHandler := handler.NewInboundWebhooksHandler(db, renderer, sessionManager, cacheManager, schedulerRegistry)
+	sitesHandler := handler.NewSitesHandler(db, renderer, sessionManager, themeManager, cacheManager)
+	sitesHandler.SetSiteMiddleware(siteMiddleware)
 	redirectsHandler := handler.NewRedirectsHandler(db, renderer, sessionManager, redirectsMiddleware)
+	contentTypesHandler := handler.NewContentTypesHandler(db, renderer, sessionManager)
+	snippetsHandler := handler.NewSnippetsHandler(db, renderer, sessionManager)
+	snippetsHandler.SetBlockSuspiciousMarkup(cfg.BlockSuspiciousPageHTML)
+	snippetsHandler.SetSanitizePageHTML(cfg.SanitizePageHTML)
+	seriesHandler := handler.NewSeriesHandler(db, renderer, sessionManager)
+	translationStatusHandler := handler.NewTranslationStatusHandler(db, renderer, sessionManager)
+	linkChecksHandler := handler.NewLinkChecksHandler(db, renderer, sessionManager, linkChecker)
+	commentsHandler := handler.NewCommentsHandler(db, renderer, sessionManager, hookRegistry, frontendHandler)
+	membersHandler := handler.NewMembersHandler(db, sessionManager, hookRegistry, authHandler, frontendHandler)
+	if mailSender != nil {
+		membersHandler.SetNotifier(mail.NewMemberNotifier(db, mailSender))
+	}
 	importExportHandler := handler.NewImportExportHandler(db, renderer, sessionManager, cacheManager)
 	importExportHandler.SetUploadDir(cfg.UploadsDir)
 	importExportHandler.SetBlockSuspiciousMarkup(cfg.BlockSuspiciousPageHTML)
 	healthHandler := handler.NewHealthHandler(db, sessionManager, cfg.UploadsDir)
 	docsHandler := handler.NewDocsHandler(renderer, sessionManager, cfg, moduleRegistry, healthHandler.StartTime(), versionInfo)
 
+	// Machine translation pre-fills new translations when a provider is configured
+	mtProvider, err := machinetranslation.NewProvider(cfg.MTProvider, machinetranslation.Options{
+		APIKey: cfg.DeepLAPIKey,
+		APIURL: cfg.DeepLAPIURL,
+	})
+	if err != nil {
+		return fmt.Errorf("initializing machine translation: %w", err)
+	}
+	if translator := machinetranslation.New(mtProvider); translator.Enabled() {
+		pagesHandler.SetMachineTranslator(translator)
+		taxonomyHandler.SetMachineTranslator(translator)
+		formsHandler.SetMachineTranslator(translator)
+		slog.Info("machine translation enabled", "provider", translator.Name())
+	}
+
 	// Set webhook dispatcher on handlers that dispatch events
 	pagesHandler.SetDispatcher(webhookDispatcher)
 	mediaHandler.SetDispatcher(webhookDispatcher)
 	usersHandler.SetDispatcher(webhookDispatcher)
 	formsHandler.SetDispatcher(webhookDispatcher)
+	commentsHandler.SetDispatcher(webhookDispatcher)
+	membersHandler.SetDispatcher(webhookDispatcher)
+	taxonomyHandler.SetDispatcher(webhookDispatcher)
+	languagesHandler.SetDispatcher(webhookDispatcher)
+	menusHandler.SetDispatcher(webhookDispatcher)
+	redirectsHandler.SetDispatcher(webhookDispatcher)
+	configHandler.SetDispatcher(webhookDispatcher)
+	themesHandler.SetDispatcher(webhookDispatcher)
+	modulesHandler.SetDispatcher(webhookDispatcher)
+	webhooksHandler.SetDispatcher(webhookDispatcher)
+	inboundWebhooksHandler.SetDispatcher(webhookDispatcher)
 
 	// Set cache manager on handlers that need cache invalidation
 	pagesHandler.SetCacheManager(cacheManager)
@@ -1815,6 +1944,17 @@ func run() error {
 			r.Use(csrfMiddleware)
 			r.Get(handler.RouteFormsSlug, formsHandler.Show)
 			r.With(formSubmitRateLimiter.HTMLMiddleware()).Post(handler.RouteFormsSlug, formsHandler.Submit)
+			// Comments post back to the commented page
+			r.With(formSubmitRateLimiter.HTMLMiddleware()).Post(handler.RouteCommentsID, commentsHandler.Submit)
+			// Member registration, login and profiles share the protections
+			// of the admin login and of public forms
+			r.Get(handler.RouteMembersLogin, membersHandler.LoginForm)
+			r.With(loginProtection.Middleware()).Post(handler.RouteMembersLogin, membersHandler.Login)
+			r.Get(handler.RouteMembersRegister, membersHandler.RegisterForm)
+			r.With(formSubmitRateLimiter.HTMLMiddleware()).Post(handler.RouteMembersRegister, membersHandler.Register)
+			r.Get(handler.RouteMembersProfile, membersHandler.Profile)
+			r.With(formSubmitRateLimiter.HTMLMiddleware()).Post(handler.RouteMembersProfile, membersHandler.UpdateProfile)
+			r.Post(handler.RouteMembersLogout, membersHandler.Logout)
 		})
 		// Common frontend routes (RouteParamSlug catch-all is registered last)
 		registerFrontendRoutes(r, frontendHandler)
@@ -1834,6 +1974,10 @@ func run() error {
 		r.Post(handler.RouteLanguage, authHandler.SetLanguage)
 	})
 
+	// Inbound webhooks (public, authenticated by per-endpoint HMAC signature
+	// instead of CSRF tokens)
+	r.With(publicRateLimiter.Middleware()).Post(handler.RouteInboundWebhookReceive, inboundWebhooksHandler.Receive)
+
 	// Session test routes (development only)
 	if cfg.IsDevelopment() {
 		r.Get("/session/set", func(w http.ResponseWriter, r *http.Request) {
@@ -1862,6 +2006,7 @@ func run() error {
 		r.Use(csrfMiddleware)
 		r.Use(middleware.Auth(sessionManager))
 		r.Use(middleware.LoadUser(sessionManager, db))
+		r.Use(middleware.AdminSite(sessionManager, db))
 		r.Use(middleware.LoadSiteConfig(db, nil)) // Admin: always query DB, no cache
 
 		// Editor routes (editor + admin) - public users have no admin access
@@ -1871,6 +2016,7 @@ func run() error {
 			// Dashboard and common routes
 			r.Get(handler.RouteRoot, adminHandler.Dashboard)
 			r.Post("/language", adminHandler.SetLanguage)
+			r.Post(handler.RouteSite, adminHandler.SetSite)
 			r.Get("/events", eventsHandler.List)
 
 			// Page management routes
@@ -1879,10 +2025,14 @@ func run() error {
 				EditForm: pagesHandler.EditForm, Update: pagesHandler.Update, Delete: pagesHandler.Delete,
 			})
 			r.Post(handler.RoutePages+handler.RouteSuffixBulkDelete, pagesHandler.BulkDelete)
+			r.Post(handler.RoutePages+"/blocks/convert", pagesHandler.ConvertBlocks)
+			r.Get(handler.RoutePages+handler.RouteSuffixTree, pagesHandler.Tree)
+			r.Post(handler.RoutePages+handler.RouteSuffixTree, pagesHandler.SaveTree)
 			r.Post(handler.RoutePagesID+"/publish", pagesHandler.TogglePublish)
 			r.Get(handler.RoutePagesID+"/versions", pagesHandler.Versions)
 			r.Post(handler.RoutePagesID+"/versions/{versionId}/restore", pagesHandler.RestoreVersion)
 			r.Post(handler.RoutePagesID+handler.RouteSuffixTranslate, pagesHandler.Translate)
+			r.Post(handler.RoutePagesID+"/unlink-translations", pagesHandler.UnlinkTranslations)
 
 			// Tag management routes
 			registerCRUD(r, handler.RouteTags, handler.RouteTagsID, crudHandlers{
@@ -1950,6 +2100,31 @@ func run() error {
 			// Form translation route
 			r.Post(handler.RouteFormsID+handler.RouteSuffixTranslate, formsHandler.TranslateForm)
 
+			// Snippet management routes
+			registerCRUD(r, handler.RouteSnippets, handler.RouteSnippetsID, crudHandlers{
+				List: snippetsHandler.List, NewForm: snippetsHandler.NewForm, Create: snippetsHandler.Create,
+				EditForm: snippetsHandler.EditForm, Update: snippetsHandler.Update, Delete: snippetsHandler.Delete,
+			})
+
+			// Page series management routes
+			registerCRUD(r, handler.RouteSeries, handler.RouteSeriesID, crudHandlers{
+				List: seriesHandler.List, NewForm: seriesHandler.NewForm, Create: seriesHandler.Create,
+				EditForm: seriesHandler.EditForm, Update: seriesHandler.Update, Delete: seriesHandler.Delete,
+			})
+
+			// Comment moderation routes
+			r.Get(handler.RouteComments, commentsHandler.List)
+			r.Post(handler.RouteCommentsID+"/status", commentsHandler.SetStatus)
+			r.Delete(handler.RouteCommentsID, commentsHandler.Delete)
+
+			// Translation status routes
+			r.Get(handler.RouteTranslationStatus, translationStatusHandler.Status)
+			r.Post(handler.RouteTranslationStatus+handler.RouteParamID+"/current", translationStatusHandler.MarkCurrent)
+
+			// Broken link report routes
+			r.Get(handler.RouteBrokenLinks, linkChecksHandler.List)
+			r.Post(handler.RouteBrokenLinks+"/check", linkChecksHandler.Check)
+
 			// Theme settings (not activation - that's admin only)
 			registerSettingsRoutes(r, handler.RouteThemeSettings, themesHandler.Settings, themesHandler.SaveSettings)
 
@@ -2011,6 +2186,13 @@ func run() error {
 			r.Get(handler.RouteWebhooksID+"/deliveries", webhooksHandler.Deliveries)
 			r.Post(handler.RouteWebhooksID+"/test", webhooksHandler.Test)
 			r.Post(handler.RouteWebhooksID+"/deliveries/{did}/retry", webhooksHandler.RetryDelivery)
+			r.Post(handler.RouteWebhooksID+"/resume", webhooksHandler.Resume)
+			r.Get(handler.RouteWebhooks+"/dead-letters", webhooksHandler.DeadLetters)
+			r.Post(handler.RouteWebhooks+"/dead-letters/replay", webhooksHandler.ReplayDeadLetters)
+			registerCRUD(r, handler.RouteInboundWebhooks, handler.RouteInboundWebhooksID, crudHandlers{
+				List: inboundWebhooksHandler.List, NewForm: inboundWebhooksHandler.NewForm, Create: inboundWebhooksHandler.Create,
+				EditForm: inboundWebhooksHandler.EditForm, Update: inboundWebhooksHandler.Update, Delete: inboundWebhooksHandler.Delete,
+			})
 
 			// Redirect management routes
 			registerCRUD(r, handler.RouteRedirects, handler.RouteRedirectsID, crudHandlers{
@@ -2018,6 +2200,23 @@ func run() error {
 				EditForm: redirectsHandler.EditForm, Update: redirectsHandler.Update, Delete: redirectsHandler.Delete,
 			})
 			r.Post(handler.RouteRedirectsID+"/toggle", redirectsHandler.Toggle)
+			r.Get(handler.RouteRedirects+"/export", redirectsHandler.ExportCSV)
+			r.Post(handler.RouteRedirects+"/import", redirectsHandler.ImportCSV)
+			r.Get(handler.RouteRedirects+"/not-found", redirectsHandler.NotFoundLog)
+			r.Post(handler.RouteRedirects+"/not-found/clear", redirectsHandler.ClearNotFound)
+			r.Delete(handler.RouteRedirects+"/not-found/{id}", redirectsHandler.DeleteNotFound)
+
+			// Site management routes
+			registerCRUD(r, handler.RouteSites, handler.RouteSitesID, crudHandlers{
+				List: sitesHandler.List, NewForm: sitesHandler.NewForm, Create: sitesHandler.Create,
+				EditForm: sitesHandler.EditForm, Update: sitesHandler.Update, Delete: sitesHandler.Delete,
+			})
+
+			// Content type management routes
+			registerCRUD(r, handler.RouteContentTypes, handler.RouteContentTypesID, crudHandlers{
+				List: contentTypesHandler.List, NewForm: contentTypesHandler.NewForm, Create: contentTypesHandler.Create,
+				EditForm: contentTypesHandler.EditForm, Update: contentTypesHandler.Update, Delete: contentTypesHandler.Delete,
+			})
 
 			// Cache management routes
 			r.Get("/cache", cacheHandler.Stats)
@@ -2049,6 +2248,10 @@ func run() error {
 			r.Get(handler.RouteImport, importExportHandler.ImportForm)
 			r.Post(handler.RouteImport+"/validate", importExportHandler.ImportValidate)
 			r.Post(handler.RouteImport, importExportHandler.Import)
+			r.Get(handler.RouteTranslationExchange, importExportHandler.TranslationExchange)
+			r.Post(handler.RouteTranslationExchange+"/export", importExportHandler.TranslationExport)
+			r.Post(handler.RouteTranslationExchange+"/import", importExportHandler.TranslationImport)
+			r.Post(handler.RouteTranslationExchange+"/apply", importExportHandler.TranslationApply)
 
 			// Site documentation routes
 			r.Get(handler.RouteDocs, docsHandler.Overview)
@@ -2127,10 +2330,16 @@ func run() error {
 			BlockSuspiciousMarkup: cfg.BlockSuspiciousPageHTML,
 			SanitizeHTML:          cfg.SanitizePageHTML,
 		})
+		pagesSvc.SetDispatcher(webhookDispatcher)
+		pagesSvc.SetRedirectsMiddleware(redirectsMiddleware)
+		pagesSvc.SetCustomFieldSchema(themeManager.ActiveCustomFields)
 		apiv2pages.Register(apiV2.API, pagesSvc)
 		mediaSvc := apiv2media.NewService(db, v2Queries, v2Events, cfg.UploadsDir)
+		mediaSvc.SetDispatcher(webhookDispatcher)
 		apiv2media.Register(apiV2.API, mediaSvc)
 		taxonomySvc := apiv2taxonomy.NewService(db, v2Queries, v2Events)
+		taxonomySvc.SetDispatcher(webhookDispatcher)
+		taxonomySvc.SetRedirectsMiddleware(redirectsMiddleware)
 		apiv2taxonomy.Register(apiV2.API, taxonomySvc)
 		apiV2Docs, err := apiv2.NewDocsServer(templatesFS, apiV2)
 		if err != nil {
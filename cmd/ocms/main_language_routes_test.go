@@ -37,7 +37,8 @@ func newLanguageRouteTestDB(t *testing.T) *sql.DB {
 			direction TEXT NOT NULL DEFAULT 'ltr',
 			position INTEGER NOT NULL DEFAULT 0,
 			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			host TEXT NOT NULL DEFAULT ''
 		);
 		INSERT INTO languages (code, name, native_name, is_default, is_active, position) VALUES
 			('en', 'English', 'English', 1, 1, 0),
@@ -66,7 +66,8 @@ func newPolicyTestDB(t *testing.T) *sql.DB {
 		);
 		CREATE TABLE pages (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
-			body TEXT NOT NULL DEFAULT ''
+			body TEXT NOT NULL DEFAULT '',
+			site_id INTEGER NOT NULL DEFAULT 1
 		);
 	`)
 	if err != nil {
@@ -237,3 +237,30 @@ func TestApplySiteURLOverrideRejectsUnusableValues(t *testing.T) {
 		})
 	}
 }
+
+// TestAuditLanguageHostCookieDomain pins that a language domain outside
+// OCMS_COOKIE_DOMAIN stops startup: the session cookie would not reach it.
+func TestAuditLanguageHostCookieDomain(t *testing.T) {
+	db, cleanup := testutil.TestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	queries := store.New(db)
+
+	if _, err := db.Exec(`UPDATE languages SET host = 'de.example.com' WHERE is_default = 1`); err != nil {
+		t.Fatalf("set language host: %v", err)
+	}
+	if err := auditLanguageHostCookieDomain(ctx, queries, "example.com"); err != nil {
+		t.Errorf("subdomain of the cookie domain: %v", err)
+	}
+	if err := auditLanguageHostCookieDomain(ctx, queries, ""); err != nil {
+		t.Errorf("no cookie domain: %v", err)
+	}
+
+	if _, err := db.Exec(`UPDATE languages SET host = 'example.de' WHERE is_default = 1`); err != nil {
+		t.Fatalf("set language host: %v", err)
+	}
+	err := auditLanguageHostCookieDomain(ctx, queries, "example.com")
+	if err == nil || !strings.Contains(err.Error(), "example.de") {
+		t.Errorf("domain outside the cookie domain: error = %v, want one naming example.de", err)
+	}
+}
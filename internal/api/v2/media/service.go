@@ -22,17 +22,19 @@ import (
 	"github.com/olegiv/ocms-go/internal/service"
 	"github.com/olegiv/ocms-go/internal/store"
 	"github.com/olegiv/ocms-go/internal/util"
+	"github.com/olegiv/ocms-go/internal/webhook"
 )
 
 // Service owns every media operation. Uploads delegate to service.MediaService
 // (disk IO + variant generation); DB mutations go through the sqlc-generated
 // store.Queries directly.
 type Service struct {
-	db        *sql.DB
-	queries   *store.Queries
-	events    *service.EventService
-	uploader  *service.MediaService
-	uploadDir string
+	db         *sql.DB
+	queries    *store.Queries
+	events     *service.EventService
+	dispatcher *webhook.Dispatcher
+	uploader   *service.MediaService
+	uploadDir  string
 }
 
 // NewService constructs a v2 media Service. The uploadDir is used when the
@@ -48,6 +50,19 @@ func NewService(db *sql.DB, queries *store.Queries, events *service.EventService
 	}
 }
 
+// SetDispatcher enables webhook dispatch for media mutations.
+func (s *Service) SetDispatcher(d *webhook.Dispatcher) {
+	s.dispatcher = d
+}
+
+// dispatchMediaEvent sends a media webhook event. Best-effort like logMediaAudit.
+func (s *Service) dispatchMediaEvent(ctx context.Context, eventType string, media store.Medium) {
+	if s.dispatcher == nil {
+		return
+	}
+	_ = s.dispatcher.DispatchEvent(ctx, eventType, webhook.NewMediaEventData(media))
+}
+
 // requireWritePerm returns a domain error when the actor cannot write media.
 func (s *Service) requireWritePerm(a v2.Actor) error {
 	if a.APIKey == nil {
@@ -298,6 +313,7 @@ func (s *Service) Upload(ctx context.Context, a v2.Actor, in UploadMediaMetadata
 		"mime":     result.Media.MimeType,
 		"size":     result.Media.Size,
 	})
+	s.dispatchMediaEvent(ctx, model.EventMediaUploaded, result.Media)
 	return &dto, nil
 }
 
@@ -393,7 +409,8 @@ func (s *Service) Delete(ctx context.Context, a v2.Actor, id int64) error {
 	if err := s.requireWritePerm(a); err != nil {
 		return err
 	}
-	if _, err := s.queries.GetMediaByID(ctx, id); err != nil {
+	media, err := s.queries.GetMediaByID(ctx, id)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return v2.NewError(v2.ErrNotFound, fmt.Sprintf("media %d not found", id))
 		}
@@ -405,6 +422,7 @@ func (s *Service) Delete(ctx context.Context, a v2.Actor, id int64) error {
 	s.logMediaAudit(ctx, a, "API: Media deleted", map[string]any{
 		"media_id": id,
 	})
+	s.dispatchMediaEvent(ctx, model.EventMediaDeleted, media)
 	return nil
 }
 
@@ -0,0 +1,136 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package pages
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/api/v2"
+	"github.com/olegiv/ocms-go/internal/contenttype"
+	"github.com/olegiv/ocms-go/internal/handler"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// resolvePageType validates a page type and returns its content type, or nil
+// for the built-in post and page types.
+func (s *Service) resolvePageType(ctx context.Context, pageType string) (*store.ContentType, error) {
+	if contenttype.IsBuiltinPageType(pageType) {
+		return nil, nil
+	}
+	ct, err := s.queries.GetContentTypeBySlug(ctx, pageType)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, v2.NewValidationError(
+				map[string]string{"page_type": fmt.Sprintf("Page type must be 'post', 'page' or a content type slug, got %q", pageType)},
+				"Validation failed",
+			)
+		}
+		return nil, v2.NewError(v2.ErrInternal, "Failed to load content type")
+	}
+	return &ct, nil
+}
+
+// normalizeContentFields validates field input against the schema of ct.
+// Built-in page types accept no fields.
+func (s *Service) normalizeContentFields(ctx context.Context, ct *store.ContentType, input map[string]any) (contenttype.Values, error) {
+	if ct == nil {
+		if len(input) > 0 {
+			return nil, v2.NewValidationError(
+				map[string]string{"fields": "Fields are only supported for content type pages"},
+				"Validation failed",
+			)
+		}
+		return nil, nil
+	}
+	fields := contenttype.ParseFields(ct.Fields)
+	values, errs := contenttype.Normalize(fields, input)
+	if len(errs) == 0 {
+		errs = handler.ValidateContentReferences(ctx, s.queries, fields, values)
+	}
+	if len(errs) > 0 {
+		fieldErrs := make(map[string]string, len(errs))
+		for name, msg := range errs {
+			fieldErrs["fields."+name] = msg
+		}
+		return nil, v2.NewValidationError(fieldErrs, "Validation failed")
+	}
+	return values, nil
+}
+
+// storedContentValues returns the stored field values of a page.
+func (s *Service) storedContentValues(ctx context.Context, pageID int64) (contenttype.Values, error) {
+	row, err := s.queries.GetPageContentFields(ctx, pageID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return make(contenttype.Values), nil
+		}
+		return nil, err
+	}
+	return contenttype.ParseValues(row.Data), nil
+}
+
+// saveContentFields stores the field values of a page, or removes them when
+// the page has a built-in page type.
+func saveContentFields(ctx context.Context, q *store.Queries, pageID int64, ct *store.ContentType, values contenttype.Values) error {
+	if ct == nil {
+		if err := q.DeletePageContentFields(ctx, pageID); err != nil {
+			return v2.NewError(v2.ErrInternal, "Failed to delete page fields")
+		}
+		return nil
+	}
+	if err := q.UpsertPageContentFields(ctx, store.UpsertPageContentFieldsParams{
+		PageID:    pageID,
+		Data:      values.JSON(),
+		UpdatedAt: time.Now(),
+	}); err != nil {
+		return v2.NewError(v2.ErrInternal, "Failed to save page fields")
+	}
+	return nil
+}
+
+// attachContentFields sets the stored field values on a content type page DTO.
+// Media and page references are returned as IDs.
+func (s *Service) attachContentFields(ctx context.Context, dto *Page) {
+	if contenttype.IsBuiltinPageType(dto.PageType) {
+		return
+	}
+	values, err := s.storedContentValues(ctx, dto.ID)
+	if err != nil || len(values) == 0 {
+		return
+	}
+	dto.Fields = values
+}
+
+// updateContentFields resolves the field values of a page update. Changing
+// the page type without new fields revalidates the stored values against the
+// new schema. save reports whether the stored values must be rewritten.
+func (s *Service) updateContentFields(ctx context.Context, in *UpdatePageBody, existing store.Page, pageType string) (ct *store.ContentType, values contenttype.Values, save bool, err error) {
+	if in.Fields == nil && pageType == existing.PageType {
+		return nil, nil, false, nil
+	}
+	ct, err = s.resolvePageType(ctx, pageType)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	var input map[string]any
+	switch {
+	case in.Fields != nil:
+		input = *in.Fields
+	case ct != nil:
+		stored, err := s.storedContentValues(ctx, existing.ID)
+		if err != nil {
+			return nil, nil, false, v2.NewError(v2.ErrInternal, "Failed to load page fields")
+		}
+		input = stored
+	}
+	values, err = s.normalizeContentFields(ctx, ct, input)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return ct, values, true, nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package pages
+
+import (
+	"context"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/api/v2"
+	"github.com/olegiv/ocms-go/internal/contenttype"
+	"github.com/olegiv/ocms-go/internal/handler"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// SetCustomFieldSchema sets the source of the custom field schema, normally
+// the active theme. Without it every custom field is ad-hoc.
+func (s *Service) SetCustomFieldSchema(schema func() []contenttype.Field) {
+	s.customFieldSchema = schema
+}
+
+// normalizeCustomFields validates custom field input against the schema of
+// the active theme and the ad-hoc field rules.
+func (s *Service) normalizeCustomFields(ctx context.Context, input []contenttype.CustomField) (contenttype.CustomFields, error) {
+	var schema []contenttype.Field
+	if s.customFieldSchema != nil {
+		schema = s.customFieldSchema()
+	}
+	fields, errs := contenttype.NormalizeCustomFields(schema, input)
+	if len(errs) == 0 {
+		errs = handler.ValidateCustomReferences(ctx, s.queries, fields)
+	}
+	if len(errs) > 0 {
+		fieldErrs := make(map[string]string, len(errs))
+		for name, msg := range errs {
+			fieldErrs["custom_fields."+name] = msg
+		}
+		return nil, v2.NewValidationError(fieldErrs, "Validation failed")
+	}
+	return fields, nil
+}
+
+// saveCustomFields stores the custom fields of a page, or removes the row
+// when the page has none.
+func saveCustomFields(ctx context.Context, q *store.Queries, pageID int64, fields contenttype.CustomFields) error {
+	if len(fields) == 0 {
+		if err := q.DeletePageCustomFields(ctx, pageID); err != nil {
+			return v2.NewError(v2.ErrInternal, "Failed to delete page custom fields")
+		}
+		return nil
+	}
+	if err := q.UpsertPageCustomFields(ctx, store.UpsertPageCustomFieldsParams{
+		PageID:    pageID,
+		Data:      fields.JSON(),
+		UpdatedAt: time.Now(),
+	}); err != nil {
+		return v2.NewError(v2.ErrInternal, "Failed to save page custom fields")
+	}
+	return nil
+}
+
+// attachCustomFields sets the stored custom fields on a page DTO. Media and
+// page references are returned as IDs.
+func (s *Service) attachCustomFields(ctx context.Context, dto *Page) {
+	row, err := s.queries.GetPageCustomFields(ctx, dto.ID)
+	if err != nil {
+		return
+	}
+	if fields := contenttype.ParseCustomFields(row.Data); len(fields) > 0 {
+		dto.CustomFields = fields
+	}
+}
@@ -32,7 +32,7 @@ type ListPagesInput struct {
 	Status   string `query:"status" enum:"draft,published" doc:"Filter by page status. Requires pages:read for non-published."`
 	Category int64  `query:"category" doc:"Restrict results to this category id."`
 	Tag      int64  `query:"tag" doc:"Restrict results to this tag id."`
-	Include  string `query:"include" doc:"Comma-separated relations to populate: author, categories, tags."`
+	Include  string `query:"include" doc:"Comma-separated relations to populate: author, categories, tags, series, related."`
 }
 
 // PagesListMeta carries pagination metadata in list responses.
@@ -69,7 +69,7 @@ func registerList(api huma.API, svc *Service) {
 			CategoryID: in.Category,
 			TagID:      in.Tag,
 		}
-		f.IncludeAuthor, f.IncludeCategories, f.IncludeTags = parseIncludes(in.Include)
+		f.IncludeAuthor, f.IncludeCategories, f.IncludeTags, f.IncludeSeries, f.IncludeRelated = parseIncludes(in.Include)
 		result, err := svc.List(ctx, actor, f)
 		if err != nil {
 			return nil, v2.ToHuma(err)
@@ -89,7 +89,7 @@ func registerList(api huma.API, svc *Service) {
 // GetPageInput carries the id path param and optional include flags.
 type GetPageInput struct {
 	ID      int64  `path:"id" minimum:"1"`
-	Include string `query:"include" doc:"Comma-separated relations to populate: author, categories, tags."`
+	Include string `query:"include" doc:"Comma-separated relations to populate: author, categories, tags, series, related."`
 }
 
 // PageOutput wraps a single Page in {data: …}.
@@ -110,7 +110,7 @@ func registerGet(api huma.API, svc *Service) {
 	}, func(ctx context.Context, in *GetPageInput) (*PageOutput, error) {
 		actor := v2.ActorFromContext(ctx)
 		f := ListFilter{}
-		f.IncludeAuthor, f.IncludeCategories, f.IncludeTags = parseIncludes(in.Include)
+		f.IncludeAuthor, f.IncludeCategories, f.IncludeTags, f.IncludeSeries, f.IncludeRelated = parseIncludes(in.Include)
 		page, err := svc.Get(ctx, actor, in.ID, f)
 		if err != nil {
 			return nil, v2.ToHuma(err)
@@ -138,7 +138,7 @@ func registerGetBySlug(api huma.API, svc *Service) {
 	}, func(ctx context.Context, in *GetPageBySlugInput) (*PageOutput, error) {
 		actor := v2.ActorFromContext(ctx)
 		f := ListFilter{}
-		f.IncludeAuthor, f.IncludeCategories, f.IncludeTags = parseIncludes(in.Include)
+		f.IncludeAuthor, f.IncludeCategories, f.IncludeTags, f.IncludeSeries, f.IncludeRelated = parseIncludes(in.Include)
 		page, err := svc.GetBySlug(ctx, actor, in.Slug, f)
 		if err != nil {
 			return nil, v2.ToHuma(err)
@@ -227,8 +227,9 @@ func registerDelete(api huma.API, svc *Service) {
 	})
 }
 
-// parseIncludes converts a "author,categories,tags" query string into booleans.
-func parseIncludes(include string) (author, categories, tags bool) {
+// parseIncludes converts a "author,categories,tags,series,related" query
+// string into booleans.
+func parseIncludes(include string) (author, categories, tags, series, related bool) {
 	if include == "" {
 		return
 	}
@@ -240,6 +241,10 @@ func parseIncludes(include string) (author, categories, tags bool) {
 			categories = true
 		case "tags":
 			tags = true
+		case "series":
+			series = true
+		case "related":
+			related = true
 		}
 	}
 	return
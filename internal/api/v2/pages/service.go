@@ -15,6 +15,7 @@ import (
 
 	"github.com/olegiv/ocms-go/internal/api/v2"
 	"github.com/olegiv/ocms-go/internal/cache"
+	"github.com/olegiv/ocms-go/internal/contenttype"
 	"github.com/olegiv/ocms-go/internal/handler"
 	"github.com/olegiv/ocms-go/internal/middleware"
 	"github.com/olegiv/ocms-go/internal/model"
@@ -22,6 +23,7 @@ import (
 	"github.com/olegiv/ocms-go/internal/service"
 	"github.com/olegiv/ocms-go/internal/store"
 	"github.com/olegiv/ocms-go/internal/util"
+	"github.com/olegiv/ocms-go/internal/webhook"
 )
 
 // maxSummaryRunes caps the summary length (measured in unicode runes, not bytes).
@@ -34,18 +36,31 @@ type Policy struct {
 }
 
 // Service owns Page business logic end-to-end: validation, transactions,
-// cache invalidation, event logging.
+// cache invalidation, event logging, webhook dispatch.
 type Service struct {
-	db      *sql.DB
-	queries *store.Queries
-	cache   *cache.Manager
-	events  *service.EventService
-	policy  Policy
+	db         *sql.DB
+	queries    *store.Queries
+	cache      *cache.Manager
+	events     *service.EventService
+	dispatcher *webhook.Dispatcher
+	related    *service.RelatedService
+	urlHistory *handler.URLHistory
+	policy     Policy
+	// customFieldSchema returns the custom field schema of the active theme.
+	customFieldSchema func() []contenttype.Field
 }
 
 // NewService constructs a Pages service. Cache and events may be nil for tests.
 func NewService(db *sql.DB, queries *store.Queries, cache *cache.Manager, events *service.EventService, policy Policy) *Service {
-	return &Service{db: db, queries: queries, cache: cache, events: events, policy: policy}
+	return &Service{
+		db:         db,
+		queries:    queries,
+		cache:      cache,
+		events:     events,
+		related:    service.NewRelatedService(db),
+		urlHistory: handler.NewURLHistory(db),
+		policy:     policy,
+	}
 }
 
 // requireWritePerm returns a forbidden domain error if the actor can't write pages.
@@ -64,6 +79,12 @@ func canReadNonPublished(a v2.Actor) bool {
 	return a.HasPermission(model.PermissionPagesRead)
 }
 
+// isRestricted reports whether a page is limited to members or roles. Callers
+// without pages:read read the API like anonymous visitors of the site.
+func (s *Service) isRestricted(ctx context.Context, pageID int64) bool {
+	return service.PageAccessRule(ctx, s.queries, pageID).Access != model.PageAccessPublic
+}
+
 // validateSafeURL rejects URLs whose scheme would be dangerous to render in a
 // page template (javascript:, data:, vbscript:, file:, …). An empty string is
 // accepted — the caller may want to clear the field.
@@ -182,7 +203,8 @@ func validateRoutableLanguage(language store.Language) error {
 
 // ensureSlugAvailable reports a conflict error when the slug cannot serve as
 // this page's route: an active language owns the prefix, or another page whose
-// id is NOT exceptID already holds it. Pass 0 to check against all pages.
+// id is NOT exceptID already holds it on the current site. Pass 0 to check
+// against all pages of the site.
 func (s *Service) ensureSlugAvailable(ctx context.Context, slug string, exceptID int64) error {
 	// Uniqueness is not the whole of availability. The language middleware
 	// strips a first path segment that matches an active language code before
@@ -197,7 +219,7 @@ func (s *Service) ensureSlugAvailable(ctx context.Context, slug string, exceptID
 		return v2.NewValidationError(map[string]string{"slug": conflict}, "Validation failed")
 	}
 
-	existing, err := s.queries.GetPageBySlug(ctx, slug)
+	existing, err := s.queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: slug, SiteID: store.SiteIDFromContext(ctx)})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil
@@ -279,9 +301,12 @@ func dtoFromStore(p store.Page) Page {
 }
 
 // populateIncludes fetches and attaches optional relations (author / categories /
-// tags) to a Page DTO. The authenticated flag gates whether the author's email
-// is returned.
+// tags / series / related) to a Page DTO, along with the custom field values of content type
+// pages and the key/value custom fields. The authenticated flag gates whether
+// the author's email is returned.
 func (s *Service) populateIncludes(ctx context.Context, dto *Page, pageID int64, authenticated bool, want ListFilter) {
+	s.attachContentFields(ctx, dto)
+	s.attachCustomFields(ctx, dto)
 	if want.IncludeAuthor {
 		if author, err := s.queries.GetPageAuthor(ctx, pageID); err == nil {
 			a := &Author{ID: author.ID, Name: author.Name}
@@ -311,6 +336,81 @@ func (s *Service) populateIncludes(ctx context.Context, dto *Page, pageID int64,
 			}
 		}
 	}
+	if want.IncludeSeries {
+		if nav := service.PageSeriesNav(ctx, s.queries, pageID, ""); nav != nil {
+			dto.Series = &Series{
+				ID:          nav.ID,
+				Name:        nav.Name,
+				Slug:        nav.Slug,
+				Description: nav.Description,
+				Position:    nav.Position,
+				Total:       nav.Total(),
+				Pages:       toPageRefs(nav.Pages),
+				Prev:        toPageRefPtr(nav.Prev),
+				Next:        toPageRefPtr(nav.Next),
+			}
+		}
+	}
+	if want.IncludeRelated {
+		dto.Related = toPageRefs(s.related.RelatedPageLinks(ctx, pageID, "", service.DefaultRelatedLimit))
+	}
+}
+
+// toPageRefs converts page links to inline page references.
+func toPageRefs(links []service.PageLink) []PageRef {
+	refs := make([]PageRef, 0, len(links))
+	for _, l := range links {
+		refs = append(refs, PageRef{ID: l.ID, Title: l.Title, Slug: l.Slug, Path: l.Path})
+	}
+	return refs
+}
+
+// toPageRefPtr converts an optional page link to an inline page reference.
+func toPageRefPtr(link *service.PageLink) *PageRef {
+	if link == nil {
+		return nil
+	}
+	return &PageRef{ID: link.ID, Title: link.Title, Slug: link.Slug, Path: link.Path}
+}
+
+// SetDispatcher enables webhook dispatch for page mutations.
+func (s *Service) SetDispatcher(d *webhook.Dispatcher) {
+	s.dispatcher = d
+	s.urlHistory.SetDispatcher(d)
+}
+
+// SetRedirectsMiddleware sets the redirects middleware whose cache is
+// invalidated when a slug change redirects the old URLs of a page.
+func (s *Service) SetRedirectsMiddleware(rm *middleware.RedirectsMiddleware) {
+	s.urlHistory.SetRedirectsMiddleware(rm)
+}
+
+// dispatchPageEvents sends the given page webhook events. Best-effort like
+// logPageAudit: a failed dispatch never fails the completed write.
+func (s *Service) dispatchPageEvents(ctx context.Context, page store.Page, eventTypes ...string) {
+	if s.dispatcher == nil {
+		return
+	}
+	var authorEmail string
+	if author, err := s.queries.GetUserByID(ctx, page.AuthorID); err == nil {
+		authorEmail = author.Email
+	}
+	data := webhook.NewPageEventData(page, authorEmail)
+	for _, eventType := range eventTypes {
+		_ = s.dispatcher.DispatchEvent(ctx, eventType, data)
+	}
+}
+
+// statusChangeEvent returns page.published / page.unpublished when the status
+// moved into or out of published, or "" otherwise.
+func statusChangeEvent(before, after string) string {
+	switch {
+	case before != model.PageStatusPublished && after == model.PageStatusPublished:
+		return model.EventPagePublished
+	case before == model.PageStatusPublished && after != model.PageStatusPublished:
+		return model.EventPageUnpublished
+	}
+	return ""
 }
 
 // invalidatePageCache flushes cache entries after a mutation.
@@ -371,6 +471,18 @@ func (s *Service) Create(ctx context.Context, a v2.Actor, in CreatePageBody) (*P
 	if pageType == "" {
 		pageType = "post"
 	}
+	contentType, err := s.resolvePageType(ctx, pageType)
+	if err != nil {
+		return nil, err
+	}
+	fieldValues, err := s.normalizeContentFields(ctx, contentType, in.Fields)
+	if err != nil {
+		return nil, err
+	}
+	customFields, err := s.normalizeCustomFields(ctx, in.CustomFields)
+	if err != nil {
+		return nil, err
+	}
 	scheduledAt, err := parseScheduledAt(in.ScheduledAt)
 	if err != nil {
 		return nil, err
@@ -417,6 +529,7 @@ func (s *Service) Create(ctx context.Context, a v2.Actor, in CreatePageBody) (*P
 		ExcludeFromLists:  boolToInt64(in.ExcludeFromLists),
 		VideoUrl:          in.VideoURL,
 		VideoTitle:        in.VideoTitle,
+		SiteID:            store.SiteIDFromContext(ctx),
 	}
 	if status == model.PageStatusPublished {
 		params.PublishedAt = sql.NullTime{Time: now, Valid: true}
@@ -445,6 +558,14 @@ func (s *Service) Create(ctx context.Context, a v2.Actor, in CreatePageBody) (*P
 	if err := linkTags(ctx, txq, page.ID, tagIDs); err != nil {
 		return nil, err
 	}
+	if contentType != nil {
+		if err := saveContentFields(ctx, txq, page.ID, contentType, fieldValues); err != nil {
+			return nil, err
+		}
+	}
+	if err := saveCustomFields(ctx, txq, page.ID, customFields); err != nil {
+		return nil, err
+	}
 	if err := tx.Commit(); err != nil {
 		return nil, v2.NewError(v2.ErrInternal, "Failed to commit page")
 	}
@@ -455,6 +576,7 @@ func (s *Service) Create(ctx context.Context, a v2.Actor, in CreatePageBody) (*P
 		"title":   page.Title,
 		"status":  page.Status,
 	})
+	s.dispatchPageEvents(ctx, page, model.EventPageCreated)
 
 	dto := dtoFromStore(page)
 	s.populateIncludes(ctx, &dto, page.ID, true, ListFilter{IncludeCategories: true, IncludeTags: true})
@@ -466,7 +588,7 @@ func (s *Service) Update(ctx context.Context, a v2.Actor, id int64, in UpdatePag
 	if err := s.requireWritePerm(a); err != nil {
 		return nil, err
 	}
-	existing, err := s.queries.GetPageByID(ctx, id)
+	existing, err := s.getPage(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, pageNotFound(id)
@@ -502,6 +624,16 @@ func (s *Service) Update(ctx context.Context, a v2.Actor, id int64, in UpdatePag
 	if err := s.applyUpdate(ctx, a, &in, &params, existing); err != nil {
 		return nil, err
 	}
+	contentType, fieldValues, saveFields, err := s.updateContentFields(ctx, &in, existing, params.PageType)
+	if err != nil {
+		return nil, err
+	}
+	var customFields contenttype.CustomFields
+	if in.CustomFields != nil {
+		if customFields, err = s.normalizeCustomFields(ctx, *in.CustomFields); err != nil {
+			return nil, err
+		}
+	}
 	if in.CategoryIDs != nil {
 		for _, catID := range *in.CategoryIDs {
 			if _, err := s.queries.GetCategoryByID(ctx, catID); err != nil {
@@ -516,6 +648,14 @@ func (s *Service) Update(ctx context.Context, a v2.Actor, id int64, in UpdatePag
 		}
 	}
 
+	// A slug change moves the page and its subpages; their old URLs are
+	// redirected after the update like in the admin.
+	slugChanged := params.Slug != existing.Slug
+	var oldURLs handler.URLSnapshot
+	if slugChanged {
+		oldURLs = s.urlHistory.Capture(ctx, false, existing.LanguageCode)
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, v2.NewError(v2.ErrInternal, "Failed to start transaction")
@@ -561,16 +701,45 @@ func (s *Service) Update(ctx context.Context, a v2.Actor, id int64, in UpdatePag
 			return nil, err
 		}
 	}
+	if saveFields {
+		if err := saveContentFields(ctx, txq, page.ID, contentType, fieldValues); err != nil {
+			return nil, err
+		}
+	}
+	if in.CustomFields != nil {
+		if err := saveCustomFields(ctx, txq, page.ID, customFields); err != nil {
+			return nil, err
+		}
+	}
+	// A body written through the API replaces the block document, so the
+	// page continues as an HTML page.
+	if in.Body != nil {
+		if err := txq.DeletePageBlocks(ctx, page.ID); err != nil {
+			return nil, v2.NewError(v2.ErrInternal, "Failed to delete page blocks")
+		}
+	}
 	if err := tx.Commit(); err != nil {
 		return nil, v2.NewError(v2.ErrInternal, "Failed to commit page update")
 	}
 	s.invalidatePageCache(page.ID)
+	if slugChanged {
+		for _, owner := range s.urlHistory.RedirectMoved(ctx, oldURLs, existing.LanguageCode) {
+			if owner.EntityType == model.EntityTypePage {
+				s.invalidatePageCache(owner.ID)
+			}
+		}
+	}
 	s.logPageAudit(ctx, a, "API: Page updated", map[string]any{
 		"page_id": page.ID,
 		"slug":    page.Slug,
 		"title":   page.Title,
 		"status":  page.Status,
 	})
+	events := []string{model.EventPageUpdated}
+	if ev := statusChangeEvent(existing.Status, page.Status); ev != "" {
+		events = append(events, ev)
+	}
+	s.dispatchPageEvents(ctx, page, events...)
 
 	dto := dtoFromStore(page)
 	s.populateIncludes(ctx, &dto, page.ID, true, ListFilter{IncludeCategories: true, IncludeTags: true})
@@ -622,9 +791,7 @@ func (s *Service) applyUpdate(ctx context.Context, a v2.Actor, in *UpdatePageBod
 		}
 	}
 	if in.PageType != nil {
-		if *in.PageType != "post" && *in.PageType != "page" {
-			return v2.NewValidationError(map[string]string{"page_type": "Page type must be 'post' or 'page'"}, "Validation failed")
-		}
+		// Validated with the field values in updateContentFields.
 		params.PageType = *in.PageType
 	}
 	if in.FeaturedImageID != nil {
@@ -680,12 +847,27 @@ func (s *Service) applyUpdate(ctx context.Context, a v2.Actor, in *UpdatePageBod
 	return nil
 }
 
+// getPage loads a page of the site ctx is scoped to. Pages of other sites
+// are reported as missing, like on their public URLs.
+func (s *Service) getPage(ctx context.Context, id int64) (store.Page, error) {
+	page, err := s.queries.GetPageByID(ctx, id)
+	if err == nil && page.SiteID != store.SiteIDFromContext(ctx) {
+		return store.Page{}, sql.ErrNoRows
+	}
+	return page, err
+}
+
+// getPageBySlug is the slug variant of getPage.
+func (s *Service) getPageBySlug(ctx context.Context, slug string) (store.Page, error) {
+	return s.queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: slug, SiteID: store.SiteIDFromContext(ctx)})
+}
+
 // Delete removes a page and cascades the related link / version rows.
 func (s *Service) Delete(ctx context.Context, a v2.Actor, id int64) error {
 	if err := s.requireWritePerm(a); err != nil {
 		return err
 	}
-	page, err := s.queries.GetPageByID(ctx, id)
+	page, err := s.getPage(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return pageNotFound(id)
@@ -719,20 +901,21 @@ func (s *Service) Delete(ctx context.Context, a v2.Actor, id int64) error {
 		"slug":    page.Slug,
 		"title":   page.Title,
 	})
+	s.dispatchPageEvents(ctx, page, model.EventPageDeleted)
 	return nil
 }
 
 // Get returns a page by ID, enforcing the published-only visibility rule for
 // unauthenticated / non-pages:read callers.
 func (s *Service) Get(ctx context.Context, a v2.Actor, id int64, includes ListFilter) (*Page, error) {
-	page, err := s.queries.GetPageByID(ctx, id)
+	page, err := s.getPage(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, pageNotFound(id)
 		}
 		return nil, v2.NewError(v2.ErrInternal, "Failed to load page")
 	}
-	if page.Status != model.PageStatusPublished && !canReadNonPublished(a) {
+	if !canReadNonPublished(a) && (page.Status != model.PageStatusPublished || s.isRestricted(ctx, page.ID)) {
 		return nil, pageNotFound(id)
 	}
 	dto := dtoFromStore(page)
@@ -742,14 +925,14 @@ func (s *Service) Get(ctx context.Context, a v2.Actor, id int64, includes ListFi
 
 // GetBySlug is the slug variant of Get.
 func (s *Service) GetBySlug(ctx context.Context, a v2.Actor, slug string, includes ListFilter) (*Page, error) {
-	page, err := s.queries.GetPageBySlug(ctx, slug)
+	page, err := s.getPageBySlug(ctx, slug)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, pageNotFound(slug)
 		}
 		return nil, v2.NewError(v2.ErrInternal, "Failed to load page")
 	}
-	if page.Status != model.PageStatusPublished && !canReadNonPublished(a) {
+	if !canReadNonPublished(a) && (page.Status != model.PageStatusPublished || s.isRestricted(ctx, page.ID)) {
 		return nil, pageNotFound(slug)
 	}
 	dto := dtoFromStore(page)
@@ -778,6 +961,7 @@ func (s *Service) List(ctx context.Context, a v2.Actor, f ListFilter) (*ListResu
 	offset := int64((f.Page - 1) * f.PerPage)
 	publishedOnly := status == model.PageStatusPublished
 
+	siteID := store.SiteIDFromContext(ctx)
 	var rows []store.Page
 	var total int64
 	var err error
@@ -787,23 +971,35 @@ func (s *Service) List(ctx context.Context, a v2.Actor, f ListFilter) (*ListResu
 	case f.TagID > 0:
 		rows, total, err = s.listByTag(ctx, publishedOnly, f.TagID, limit, offset)
 	case status != "":
-		rows, err = s.queries.ListPagesByStatus(ctx, store.ListPagesByStatusParams{Status: status, Limit: limit, Offset: offset})
+		rows, err = s.queries.ListPagesByStatusAndSite(ctx, store.ListPagesByStatusAndSiteParams{Status: status, SiteID: siteID, Limit: limit, Offset: offset})
 		if err == nil {
-			total, err = s.queries.CountPagesByStatus(ctx, status)
+			total, err = s.queries.CountPagesByStatusAndSite(ctx, store.CountPagesByStatusAndSiteParams{Status: status, SiteID: siteID})
 		}
 	default:
-		rows, err = s.queries.ListPages(ctx, store.ListPagesParams{Limit: limit, Offset: offset})
+		rows, err = s.queries.ListPagesBySite(ctx, store.ListPagesBySiteParams{SiteID: siteID, Limit: limit, Offset: offset})
 		if err == nil {
-			total, err = s.queries.CountPages(ctx)
+			total, err = s.queries.CountPagesBySite(ctx, siteID)
 		}
 	}
 	if err != nil {
 		return nil, v2.NewError(v2.ErrInternal, "Failed to list pages")
 	}
 
+	// Restricted pages are listed without their body, like in site lists
+	var rules map[int64]store.PageAccess
+	if !readAll {
+		ids := make([]int64, 0, len(rows))
+		for _, p := range rows {
+			ids = append(ids, p.ID)
+		}
+		rules = service.PageAccessRules(ctx, s.queries, ids)
+	}
 	dtos := make([]Page, 0, len(rows))
 	for _, p := range rows {
 		dto := dtoFromStore(p)
+		if !readAll && rules[p.ID].Access != model.PageAccessPublic {
+			dto.Body = ""
+		}
 		s.populateIncludes(ctx, &dto, p.ID, readAll, f)
 		dtos = append(dtos, dto)
 	}
@@ -811,35 +1007,37 @@ func (s *Service) List(ctx context.Context, a v2.Actor, f ListFilter) (*ListResu
 }
 
 func (s *Service) listByCategory(ctx context.Context, publishedOnly bool, categoryID, limit, offset int64) ([]store.Page, int64, error) {
+	siteID := store.SiteIDFromContext(ctx)
 	if publishedOnly {
-		rows, err := s.queries.ListPublishedPagesByCategory(ctx, store.ListPublishedPagesByCategoryParams{CategoryID: categoryID, Limit: limit, Offset: offset})
+		rows, err := s.queries.ListPublishedPagesByCategory(ctx, store.ListPublishedPagesByCategoryParams{CategoryID: categoryID, SiteID: siteID, Limit: limit, Offset: offset})
 		if err != nil {
 			return nil, 0, err
 		}
-		total, err := s.queries.CountPublishedPagesByCategory(ctx, categoryID)
+		total, err := s.queries.CountPublishedPagesByCategory(ctx, store.CountPublishedPagesByCategoryParams{CategoryID: categoryID, SiteID: siteID})
 		return rows, total, err
 	}
-	rows, err := s.queries.ListPagesByCategory(ctx, store.ListPagesByCategoryParams{CategoryID: categoryID, Limit: limit, Offset: offset})
+	rows, err := s.queries.ListPagesByCategoryAndSite(ctx, store.ListPagesByCategoryAndSiteParams{CategoryID: categoryID, SiteID: siteID, Limit: limit, Offset: offset})
 	if err != nil {
 		return nil, 0, err
 	}
-	total, err := s.queries.CountPagesByCategory(ctx, categoryID)
+	total, err := s.queries.CountPagesByCategoryAndSite(ctx, store.CountPagesByCategoryAndSiteParams{CategoryID: categoryID, SiteID: siteID})
 	return rows, total, err
 }
 
 func (s *Service) listByTag(ctx context.Context, publishedOnly bool, tagID, limit, offset int64) ([]store.Page, int64, error) {
+	siteID := store.SiteIDFromContext(ctx)
 	if publishedOnly {
-		rows, err := s.queries.ListPublishedPagesForTag(ctx, store.ListPublishedPagesForTagParams{TagID: tagID, Limit: limit, Offset: offset})
+		rows, err := s.queries.ListPublishedPagesForTag(ctx, store.ListPublishedPagesForTagParams{TagID: tagID, SiteID: siteID, Limit: limit, Offset: offset})
 		if err != nil {
 			return nil, 0, err
 		}
-		total, err := s.queries.CountPublishedPagesForTag(ctx, tagID)
+		total, err := s.queries.CountPublishedPagesForTag(ctx, store.CountPublishedPagesForTagParams{TagID: tagID, SiteID: siteID})
 		return rows, total, err
 	}
-	rows, err := s.queries.GetPagesForTag(ctx, store.GetPagesForTagParams{TagID: tagID, Limit: limit, Offset: offset})
+	rows, err := s.queries.ListPagesForTagAndSite(ctx, store.ListPagesForTagAndSiteParams{TagID: tagID, SiteID: siteID, Limit: limit, Offset: offset})
 	if err != nil {
 		return nil, 0, err
 	}
-	total, err := s.queries.CountPagesForTag(ctx, tagID)
+	total, err := s.queries.CountPagesForTagAndSite(ctx, store.CountPagesForTagAndSiteParams{TagID: tagID, SiteID: siteID})
 	return rows, total, err
 }
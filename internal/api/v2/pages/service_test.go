@@ -11,6 +11,7 @@ import (
 
 	v2 "github.com/olegiv/ocms-go/internal/api/v2"
 	"github.com/olegiv/ocms-go/internal/api/v2/pages"
+	"github.com/olegiv/ocms-go/internal/contenttype"
 	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/store"
 	"github.com/olegiv/ocms-go/internal/testutil"
@@ -111,6 +112,83 @@ func TestDeletePageReturnsNotFoundForUnknownID(t *testing.T) {
 	}
 }
 
+// TestPagesAreScopedToTheRequestSite covers a second site on the same
+// install: its API host must neither list nor reach the pages of the other.
+func TestPagesAreScopedToTheRequestSite(t *testing.T) {
+	db, cleanup := testutil.TestDB(t)
+	defer cleanup()
+	queries := store.New(db)
+	svc := pages.NewService(db, queries, nil, nil, pages.Policy{})
+	ctx := context.Background()
+	now := time.Now()
+
+	author, err := queries.CreateUser(ctx, store.CreateUserParams{
+		Email: "api@example.com", PasswordHash: "x", Role: model.RoleAdmin, Name: "API",
+		CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	site, err := queries.CreateSite(ctx, store.CreateSiteParams{
+		Name: "Digest", Host: "digest.example", CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateSite: %v", err)
+	}
+	digestCtx := store.WithSiteID(ctx, site.ID)
+	actor := v2.Actor{
+		APIKey:      &store.ApiKey{ID: 1, CreatedBy: author.ID},
+		Permissions: []string{model.PermissionPagesRead, model.PermissionPagesWrite},
+	}
+
+	main, err := svc.Create(ctx, actor, pages.CreatePageBody{Title: "Main", Slug: "main-news", Body: "b"})
+	if err != nil {
+		t.Fatalf("Create(main): %v", err)
+	}
+	digest, err := svc.Create(digestCtx, actor, pages.CreatePageBody{Title: "Digest", Slug: "digest-news", Body: "b"})
+	if err != nil {
+		t.Fatalf("Create(digest): %v", err)
+	}
+	if stored, err := queries.GetPageByID(ctx, digest.ID); err != nil || stored.SiteID != site.ID {
+		t.Fatalf("digest page site = %d (%v), want %d", stored.SiteID, err, site.ID)
+	}
+
+	for _, tt := range []struct {
+		name string
+		ctx  context.Context
+		want int64
+	}{
+		{"default site", ctx, main.ID},
+		{"digest site", digestCtx, digest.ID},
+	} {
+		res, err := svc.List(tt.ctx, actor, pages.ListFilter{})
+		if err != nil {
+			t.Fatalf("List(%s): %v", tt.name, err)
+		}
+		if res.Total != 1 || len(res.Pages) != 1 || res.Pages[0].ID != tt.want {
+			t.Errorf("List(%s) = %d pages (total %d), want only page %d", tt.name, len(res.Pages), res.Total, tt.want)
+		}
+	}
+
+	var de *v2.Error
+	if _, err := svc.Get(ctx, actor, digest.ID, pages.ListFilter{}); !errors.As(err, &de) || de.Kind != v2.ErrNotFound {
+		t.Errorf("Get() of another site's page error = %v, want not found", err)
+	}
+	if _, err := svc.GetBySlug(ctx, actor, "digest-news", pages.ListFilter{}); !errors.As(err, &de) || de.Kind != v2.ErrNotFound {
+		t.Errorf("GetBySlug() of another site's page error = %v, want not found", err)
+	}
+	title := "Renamed"
+	if _, err := svc.Update(ctx, actor, digest.ID, pages.UpdatePageBody{Title: &title}); !errors.As(err, &de) || de.Kind != v2.ErrNotFound {
+		t.Errorf("Update() of another site's page error = %v, want not found", err)
+	}
+	if err := svc.Delete(ctx, actor, digest.ID); !errors.As(err, &de) || de.Kind != v2.ErrNotFound {
+		t.Errorf("Delete() of another site's page error = %v, want not found", err)
+	}
+	if _, err := svc.Get(digestCtx, actor, digest.ID, pages.ListFilter{}); err != nil {
+		t.Errorf("Get() on the page's own site: %v", err)
+	}
+}
+
 // TestCreateAndUpdateRejectSlugsShadowedByLanguagePrefix covers the API half of
 // a cross-namespace collision the admin form already refuses.
 //
@@ -169,6 +247,101 @@ func TestCreateAndUpdateRejectSlugsShadowedByLanguagePrefix(t *testing.T) {
 	}
 }
 
+func TestListOmitsTheBodyOfRestrictedPages(t *testing.T) {
+	db, cleanup := testutil.TestDB(t)
+	defer cleanup()
+	queries := store.New(db)
+	svc := pages.NewService(db, queries, nil, nil, pages.Policy{})
+	ctx := context.Background()
+	now := time.Now()
+
+	author, err := queries.CreateUser(ctx, store.CreateUserParams{
+		Email: "api@example.com", PasswordHash: "x", Role: model.RoleAdmin, Name: "API",
+		CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	writer := v2.Actor{
+		APIKey:      &store.ApiKey{ID: 1, CreatedBy: author.ID},
+		Permissions: []string{model.PermissionPagesWrite},
+	}
+	open, err := svc.Create(ctx, writer, pages.CreatePageBody{Title: "Open", Slug: "open", Body: "open body", Status: model.PageStatusPublished})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	closed, err := svc.Create(ctx, writer, pages.CreatePageBody{Title: "Closed", Slug: "closed", Body: "closed body", Status: model.PageStatusPublished})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if err := queries.UpsertPageAccess(ctx, store.UpsertPageAccessParams{PageID: closed.ID, Access: model.PageAccessMembers}); err != nil {
+		t.Fatalf("UpsertPageAccess: %v", err)
+	}
+
+	res, err := svc.List(ctx, v2.Actor{}, pages.ListFilter{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	bodies := make(map[int64]string)
+	for _, p := range res.Pages {
+		bodies[p.ID] = p.Body
+	}
+	if bodies[open.ID] != "open body" || bodies[closed.ID] != "" {
+		t.Errorf("bodies = %q / %q, want the public body and none for the restricted page", bodies[open.ID], bodies[closed.ID])
+	}
+}
+
+func TestUpdateRedirectsTheOldURLOfARenamedPage(t *testing.T) {
+	db, cleanup := testutil.TestDB(t)
+	defer cleanup()
+	queries := store.New(db)
+	svc := pages.NewService(db, queries, nil, nil, pages.Policy{})
+	ctx := context.Background()
+	now := time.Now()
+
+	author, err := queries.CreateUser(ctx, store.CreateUserParams{
+		Email: "api@example.com", PasswordHash: "x", Role: model.RoleAdmin, Name: "API",
+		CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	writer := v2.Actor{
+		APIKey:      &store.ApiKey{ID: 1, CreatedBy: author.ID},
+		Permissions: []string{model.PermissionPagesWrite},
+	}
+	created, err := svc.Create(ctx, writer, pages.CreatePageBody{Title: "Pricing", Slug: "pricing", Body: "b", Status: model.PageStatusPublished})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO redirects (source_path, target_url, status_code, target_type, enabled, created_at, updated_at)
+		VALUES ('/prices', '/pricing', 301, '_self', 1, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`); err != nil {
+		t.Fatalf("create redirect: %v", err)
+	}
+
+	slug := "plans"
+	if _, err := svc.Update(ctx, writer, created.ID, pages.UpdatePageBody{Slug: &slug}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	for source, want := range map[string]string{
+		"/pricing": "/plans",
+		"/prices":  "/plans",
+	} {
+		redirect, err := queries.GetRedirectBySourcePath(ctx, source)
+		if err != nil || redirect.TargetUrl != want {
+			t.Errorf("redirect from %s = %q, %v; want %s", source, redirect.TargetUrl, err, want)
+		}
+	}
+	history, err := queries.ListPageURLHistory(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("ListPageURLHistory: %v", err)
+	}
+	if len(history) != 1 || history[0].Path != "/pricing" {
+		t.Errorf("URL history = %+v, want /pricing", history)
+	}
+}
+
 // TestCreateRejectsLanguagesThePublicRouterWillNotServe covers content the API
 // could write but no visitor could ever read.
 //
@@ -214,7 +387,7 @@ func TestCreateRejectsLanguagesThePublicRouterWillNotServe(t *testing.T) {
 			if !errors.As(err, &de) || de.Kind != v2.ErrValidation {
 				t.Fatalf("Create() error = %v, want a validation error for an unroutable language", err)
 			}
-			if _, lookupErr := queries.GetPageBySlug(ctx, "unreachable-"+code); lookupErr == nil {
+			if _, lookupErr := queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: "unreachable-" + code, SiteID: store.DefaultSiteID}); lookupErr == nil {
 				t.Fatal("the rejected page was written anyway")
 			}
 		})
@@ -301,3 +474,142 @@ func TestURLSchemeAllowlistIsEnforced(t *testing.T) {
 		})
 	}
 }
+
+// TestContentTypePagesValidateAndReturnFields covers pages whose page_type is
+// an admin-defined content type: unknown types are refused, field values are
+// validated against the schema and stored values are returned on reads.
+func TestContentTypePagesValidateAndReturnFields(t *testing.T) {
+	db, cleanup := testutil.TestDB(t)
+	defer cleanup()
+	queries := store.New(db)
+	svc := pages.NewService(db, queries, nil, nil, pages.Policy{})
+	ctx := context.Background()
+	now := time.Now()
+
+	if _, err := queries.CreateContentType(ctx, store.CreateContentTypeParams{
+		Name: "Event", Slug: "event",
+		Fields:    `[{"name":"venue","label":"Venue","type":"text","required":true},{"name":"seats","label":"Seats","type":"number"}]`,
+		CreatedAt: now, UpdatedAt: now,
+	}); err != nil {
+		t.Fatalf("CreateContentType: %v", err)
+	}
+	author, err := queries.CreateUser(ctx, store.CreateUserParams{
+		Email: "api@example.com", PasswordHash: "x", Role: model.RoleAdmin, Name: "API",
+		CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	writer := v2.Actor{
+		APIKey:      &store.ApiKey{ID: 1, CreatedBy: author.ID},
+		Permissions: []string{model.PermissionPagesWrite, model.PermissionPagesRead},
+	}
+
+	var de *v2.Error
+	_, err = svc.Create(ctx, writer, pages.CreatePageBody{Title: "X", Slug: "x", PageType: "unknown"})
+	if !errors.As(err, &de) || de.Kind != v2.ErrValidation {
+		t.Fatalf("Create(unknown type) error = %v, want a validation error", err)
+	}
+	_, err = svc.Create(ctx, writer, pages.CreatePageBody{Title: "X", Slug: "x", PageType: "event"})
+	if !errors.As(err, &de) || de.Fields["fields.venue"] == "" {
+		t.Fatalf("Create(missing required field) error = %v, want a fields.venue error", err)
+	}
+	_, err = svc.Create(ctx, writer, pages.CreatePageBody{Title: "X", Slug: "x", Fields: map[string]any{"venue": "Hall"}})
+	if !errors.As(err, &de) || de.Fields["fields"] == "" {
+		t.Fatalf("Create(post with fields) error = %v, want a fields error", err)
+	}
+
+	created, err := svc.Create(ctx, writer, pages.CreatePageBody{
+		Title: "Launch", Slug: "launch", PageType: "event",
+		Fields: map[string]any{"venue": "Hall", "seats": 120.0, "ignored": "x"},
+	})
+	if err != nil {
+		t.Fatalf("Create(): %v", err)
+	}
+	got, err := svc.Get(ctx, writer, created.ID, pages.ListFilter{})
+	if err != nil {
+		t.Fatalf("Get(): %v", err)
+	}
+	if got.Fields["venue"] != "Hall" || got.Fields["seats"] != 120.0 {
+		t.Fatalf("Get().Fields = %v, want venue and seats", got.Fields)
+	}
+	if _, ok := got.Fields["ignored"]; ok {
+		t.Fatalf("Get().Fields = %v, want keys outside the schema dropped", got.Fields)
+	}
+
+	post := "post"
+	updated, err := svc.Update(ctx, writer, created.ID, pages.UpdatePageBody{PageType: &post})
+	if err != nil {
+		t.Fatalf("Update(): %v", err)
+	}
+	if updated.Fields != nil {
+		t.Fatalf("Update().Fields = %v, want values removed for a built-in type", updated.Fields)
+	}
+	if _, err := queries.GetPageContentFields(ctx, created.ID); err == nil {
+		t.Fatal("page_content_fields row still present after switching to a built-in type")
+	}
+}
+
+// TestCustomFieldsAreValidatedAndReturned covers the key/value custom fields
+// of a page: theme fields follow the schema, other fields are ad-hoc, and an
+// update without custom_fields keeps the stored values.
+func TestCustomFieldsAreValidatedAndReturned(t *testing.T) {
+	db, cleanup := testutil.TestDB(t)
+	defer cleanup()
+	queries := store.New(db)
+	svc := pages.NewService(db, queries, nil, nil, pages.Policy{})
+	svc.SetCustomFieldSchema(func() []contenttype.Field {
+		return []contenttype.Field{{Name: "subtitle", Label: "Subtitle", Type: contenttype.FieldText, MaxLength: 5}}
+	})
+	ctx := context.Background()
+	now := time.Now()
+
+	author, err := queries.CreateUser(ctx, store.CreateUserParams{
+		Email: "api@example.com", PasswordHash: "x", Role: model.RoleAdmin, Name: "API",
+		CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	writer := v2.Actor{
+		APIKey:      &store.ApiKey{ID: 1, CreatedBy: author.ID},
+		Permissions: []string{model.PermissionPagesWrite, model.PermissionPagesRead},
+	}
+
+	var de *v2.Error
+	_, err = svc.Create(ctx, writer, pages.CreatePageBody{Title: "X", Slug: "x", CustomFields: []contenttype.CustomField{
+		{Name: "subtitle", Value: "too long"},
+		{Name: "cover", Type: contenttype.FieldMedia, Value: 1},
+	}})
+	if !errors.As(err, &de) || de.Fields["custom_fields.subtitle"] == "" || de.Fields["custom_fields.cover"] == "" {
+		t.Fatalf("Create(invalid custom fields) error = %v, want subtitle and cover errors", err)
+	}
+
+	created, err := svc.Create(ctx, writer, pages.CreatePageBody{Title: "About", Slug: "about", CustomFields: []contenttype.CustomField{
+		{Name: "rating", Type: contenttype.FieldNumber, Value: 4.0},
+		{Name: "subtitle", Value: "Hi"},
+	}})
+	if err != nil {
+		t.Fatalf("Create(): %v", err)
+	}
+	title := "About us"
+	updated, err := svc.Update(ctx, writer, created.ID, pages.UpdatePageBody{Title: &title})
+	if err != nil {
+		t.Fatalf("Update(): %v", err)
+	}
+	want := contenttype.CustomFields{
+		{Name: "subtitle", Type: contenttype.FieldText, Value: "Hi"},
+		{Name: "rating", Type: contenttype.FieldNumber, Value: 4.0},
+	}
+	if !contenttype.CustomFields(updated.CustomFields).Equal(want) {
+		t.Fatalf("Update().CustomFields = %v, want %v", updated.CustomFields, want)
+	}
+
+	updated, err = svc.Update(ctx, writer, created.ID, pages.UpdatePageBody{CustomFields: &[]contenttype.CustomField{}})
+	if err != nil {
+		t.Fatalf("Update(clear): %v", err)
+	}
+	if len(updated.CustomFields) != 0 {
+		t.Fatalf("Update(clear).CustomFields = %v, want none", updated.CustomFields)
+	}
+}
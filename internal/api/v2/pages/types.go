@@ -7,39 +7,47 @@
 // output or error. No HTTP types leak into the service layer.
 package pages
 
-import "time"
+import (
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/contenttype"
+)
 
 // Page is the DTO returned by every page response. Derived from the sqlc
 // store.Page but with nullable columns replaced by pointers for clean JSON.
 type Page struct {
-	ID                int64      `json:"id"`
-	Title             string     `json:"title"`
-	Slug              string     `json:"slug"`
-	Body              string     `json:"body"`
-	Summary           string     `json:"summary,omitempty"`
-	Status            string     `json:"status" enum:"draft,published"`
-	PageType          string     `json:"page_type" enum:"post,page"`
-	AuthorID          int64      `json:"author_id"`
-	LanguageCode      string     `json:"language_code"`
-	CreatedAt         time.Time  `json:"created_at"`
-	UpdatedAt         time.Time  `json:"updated_at"`
-	PublishedAt       *time.Time `json:"published_at,omitempty"`
-	FeaturedImageID   *int64     `json:"featured_image_id,omitempty"`
-	HideFeaturedImage bool       `json:"hide_featured_image"`
-	ExcludeFromLists  bool       `json:"exclude_from_lists"`
-	MetaTitle         string     `json:"meta_title,omitempty"`
-	MetaDescription   string     `json:"meta_description,omitempty"`
-	MetaKeywords      string     `json:"meta_keywords,omitempty"`
-	OGImageID         *int64     `json:"og_image_id,omitempty"`
-	NoIndex           bool       `json:"no_index"`
-	NoFollow          bool       `json:"no_follow"`
-	CanonicalURL      string     `json:"canonical_url,omitempty"`
-	ScheduledAt       *time.Time `json:"scheduled_at,omitempty"`
-	VideoURL          string     `json:"video_url,omitempty"`
-	VideoTitle        string     `json:"video_title,omitempty"`
-	Author            *Author    `json:"author,omitempty"`
-	Categories        []Category `json:"categories,omitempty"`
-	Tags              []Tag      `json:"tags,omitempty"`
+	ID                int64                     `json:"id"`
+	Title             string                    `json:"title"`
+	Slug              string                    `json:"slug"`
+	Body              string                    `json:"body"`
+	Summary           string                    `json:"summary,omitempty"`
+	Status            string                    `json:"status" enum:"draft,published"`
+	PageType          string                    `json:"page_type" doc:"post, page or the slug of a content type."`
+	AuthorID          int64                     `json:"author_id"`
+	LanguageCode      string                    `json:"language_code"`
+	CreatedAt         time.Time                 `json:"created_at"`
+	UpdatedAt         time.Time                 `json:"updated_at"`
+	PublishedAt       *time.Time                `json:"published_at,omitempty"`
+	FeaturedImageID   *int64                    `json:"featured_image_id,omitempty"`
+	HideFeaturedImage bool                      `json:"hide_featured_image"`
+	ExcludeFromLists  bool                      `json:"exclude_from_lists"`
+	MetaTitle         string                    `json:"meta_title,omitempty"`
+	MetaDescription   string                    `json:"meta_description,omitempty"`
+	MetaKeywords      string                    `json:"meta_keywords,omitempty"`
+	OGImageID         *int64                    `json:"og_image_id,omitempty"`
+	NoIndex           bool                      `json:"no_index"`
+	NoFollow          bool                      `json:"no_follow"`
+	CanonicalURL      string                    `json:"canonical_url,omitempty"`
+	ScheduledAt       *time.Time                `json:"scheduled_at,omitempty"`
+	VideoURL          string                    `json:"video_url,omitempty"`
+	VideoTitle        string                    `json:"video_title,omitempty"`
+	Author            *Author                   `json:"author,omitempty"`
+	Categories        []Category                `json:"categories,omitempty"`
+	Tags              []Tag                     `json:"tags,omitempty"`
+	Series            *Series                   `json:"series,omitempty" doc:"Series of a published page, with its parts and previous/next part."`
+	Related           []PageRef                 `json:"related,omitempty" doc:"Related published pages: editor picks first, then suggestions."`
+	Fields            map[string]any            `json:"fields,omitempty" doc:"Custom field values of content type pages, keyed by field name."`
+	CustomFields      []contenttype.CustomField `json:"custom_fields,omitempty" doc:"Typed key/value custom fields of the page, in editor order."`
 }
 
 // Author is the inline author reference on a Page response. Email is only
@@ -65,60 +73,87 @@ type Tag struct {
 	Slug string `json:"slug"`
 }
 
+// PageRef is an inline reference to another page. Path is the nested URL
+// path below the language prefix.
+type PageRef struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+	Slug  string `json:"slug"`
+	Path  string `json:"path"`
+}
+
+// Series is the inline series reference on a Page response. Position is the
+// 1-based part number of the page.
+type Series struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description,omitempty"`
+	Position    int       `json:"position"`
+	Total       int       `json:"total"`
+	Pages       []PageRef `json:"pages"`
+	Prev        *PageRef  `json:"prev,omitempty"`
+	Next        *PageRef  `json:"next,omitempty"`
+}
+
 // CreatePageBody is the validated service-level input for creating a page. The
 // huma operation parses the request body into this type via its struct tags.
 type CreatePageBody struct {
-	Title             string    `json:"title" required:"true" minLength:"1" maxLength:"255"`
-	Slug              string    `json:"slug" required:"true" minLength:"1" maxLength:"255" pattern:"^[a-z0-9]+(?:-[a-z0-9]+)*$" doc:"Lowercase alphanumeric with dashes. Must be unique."`
-	Body              string    `json:"body"`
-	Summary           string    `json:"summary,omitempty" maxLength:"500" doc:"Short plaintext summary, trimmed. Max 500 characters (runes)."`
-	Status            string    `json:"status,omitempty" enum:"draft,published" default:"draft"`
-	PageType          string    `json:"page_type,omitempty" enum:"post,page" default:"post"`
-	LanguageCode      *string   `json:"language_code,omitempty" doc:"Falls back to system default if omitted."`
-	FeaturedImageID   *int64    `json:"featured_image_id,omitempty"`
-	HideFeaturedImage bool      `json:"hide_featured_image,omitempty"`
-	ExcludeFromLists  bool      `json:"exclude_from_lists,omitempty"`
-	MetaTitle         string    `json:"meta_title,omitempty"`
-	MetaDescription   string    `json:"meta_description,omitempty"`
-	MetaKeywords      string    `json:"meta_keywords,omitempty"`
-	OGImageID         *int64    `json:"og_image_id,omitempty"`
-	NoIndex           bool      `json:"no_index,omitempty"`
-	NoFollow          bool      `json:"no_follow,omitempty"`
-	CanonicalURL      string    `json:"canonical_url,omitempty" format:"uri-reference" maxLength:"2048"`
-	ScheduledAt       *string   `json:"scheduled_at,omitempty" format:"date-time" doc:"RFC3339 timestamp."`
-	CategoryIDs       []int64   `json:"category_ids,omitempty"`
-	TagIDs            []int64   `json:"tag_ids,omitempty"`
-	TagNames          []string  `json:"tags,omitempty" doc:"Tag names; new tags are created if the actor has taxonomy:write."`
-	VideoURL          string    `json:"video_url,omitempty" format:"uri-reference" maxLength:"2048"`
-	VideoTitle        string    `json:"video_title,omitempty"`
+	Title             string                    `json:"title" required:"true" minLength:"1" maxLength:"255"`
+	Slug              string                    `json:"slug" required:"true" minLength:"1" maxLength:"255" pattern:"^[a-z0-9]+(?:-[a-z0-9]+)*$" doc:"Lowercase alphanumeric with dashes. Must be unique."`
+	Body              string                    `json:"body"`
+	Summary           string                    `json:"summary,omitempty" maxLength:"500" doc:"Short plaintext summary, trimmed. Max 500 characters (runes)."`
+	Status            string                    `json:"status,omitempty" enum:"draft,published" default:"draft"`
+	PageType          string                    `json:"page_type,omitempty" default:"post" doc:"post, page or the slug of a content type."`
+	LanguageCode      *string                   `json:"language_code,omitempty" doc:"Falls back to system default if omitted."`
+	FeaturedImageID   *int64                    `json:"featured_image_id,omitempty"`
+	HideFeaturedImage bool                      `json:"hide_featured_image,omitempty"`
+	ExcludeFromLists  bool                      `json:"exclude_from_lists,omitempty"`
+	MetaTitle         string                    `json:"meta_title,omitempty"`
+	MetaDescription   string                    `json:"meta_description,omitempty"`
+	MetaKeywords      string                    `json:"meta_keywords,omitempty"`
+	OGImageID         *int64                    `json:"og_image_id,omitempty"`
+	NoIndex           bool                      `json:"no_index,omitempty"`
+	NoFollow          bool                      `json:"no_follow,omitempty"`
+	CanonicalURL      string                    `json:"canonical_url,omitempty" format:"uri-reference" maxLength:"2048"`
+	ScheduledAt       *string                   `json:"scheduled_at,omitempty" format:"date-time" doc:"RFC3339 timestamp."`
+	CategoryIDs       []int64                   `json:"category_ids,omitempty"`
+	TagIDs            []int64                   `json:"tag_ids,omitempty"`
+	TagNames          []string                  `json:"tags,omitempty" doc:"Tag names; new tags are created if the actor has taxonomy:write."`
+	VideoURL          string                    `json:"video_url,omitempty" format:"uri-reference" maxLength:"2048"`
+	VideoTitle        string                    `json:"video_title,omitempty"`
+	Fields            map[string]any            `json:"fields,omitempty" doc:"Custom field values, validated against the content type schema. Media and page references are IDs."`
+	CustomFields      []contenttype.CustomField `json:"custom_fields,omitempty" doc:"Typed key/value custom fields. Names defined by the active theme use its schema; other fields need type text, number or date."`
 }
 
 // UpdatePageBody is the patch-style input for updating a page. Pointer fields
 // distinguish "not provided" from zero values. CategoryIDs / TagIDs / TagNames
 // pointers let callers explicitly clear collections by sending an empty array.
 type UpdatePageBody struct {
-	Title             *string   `json:"title,omitempty" minLength:"1" maxLength:"255"`
-	Slug              *string   `json:"slug,omitempty" minLength:"1" maxLength:"255" pattern:"^[a-z0-9]+(?:-[a-z0-9]+)*$"`
-	Body              *string   `json:"body,omitempty"`
-	Summary           *string   `json:"summary,omitempty" maxLength:"500"`
-	Status            *string   `json:"status,omitempty" enum:"draft,published"`
-	PageType          *string   `json:"page_type,omitempty" enum:"post,page"`
-	FeaturedImageID   *int64    `json:"featured_image_id,omitempty"`
-	HideFeaturedImage *bool     `json:"hide_featured_image,omitempty"`
-	ExcludeFromLists  *bool     `json:"exclude_from_lists,omitempty"`
-	MetaTitle         *string   `json:"meta_title,omitempty"`
-	MetaDescription   *string   `json:"meta_description,omitempty"`
-	MetaKeywords      *string   `json:"meta_keywords,omitempty"`
-	OGImageID         *int64    `json:"og_image_id,omitempty"`
-	NoIndex           *bool     `json:"no_index,omitempty"`
-	NoFollow          *bool     `json:"no_follow,omitempty"`
-	CanonicalURL      *string   `json:"canonical_url,omitempty" format:"uri-reference" maxLength:"2048"`
-	ScheduledAt       *string   `json:"scheduled_at,omitempty" format:"date-time"`
-	CategoryIDs       *[]int64  `json:"category_ids,omitempty"`
-	TagIDs            *[]int64  `json:"tag_ids,omitempty"`
-	TagNames          *[]string `json:"tags,omitempty"`
-	VideoURL          *string   `json:"video_url,omitempty" format:"uri-reference" maxLength:"2048"`
-	VideoTitle        *string   `json:"video_title,omitempty"`
+	Title             *string                    `json:"title,omitempty" minLength:"1" maxLength:"255"`
+	Slug              *string                    `json:"slug,omitempty" minLength:"1" maxLength:"255" pattern:"^[a-z0-9]+(?:-[a-z0-9]+)*$"`
+	Body              *string                    `json:"body,omitempty"`
+	Summary           *string                    `json:"summary,omitempty" maxLength:"500"`
+	Status            *string                    `json:"status,omitempty" enum:"draft,published"`
+	PageType          *string                    `json:"page_type,omitempty" doc:"post, page or the slug of a content type."`
+	FeaturedImageID   *int64                     `json:"featured_image_id,omitempty"`
+	HideFeaturedImage *bool                      `json:"hide_featured_image,omitempty"`
+	ExcludeFromLists  *bool                      `json:"exclude_from_lists,omitempty"`
+	MetaTitle         *string                    `json:"meta_title,omitempty"`
+	MetaDescription   *string                    `json:"meta_description,omitempty"`
+	MetaKeywords      *string                    `json:"meta_keywords,omitempty"`
+	OGImageID         *int64                     `json:"og_image_id,omitempty"`
+	NoIndex           *bool                      `json:"no_index,omitempty"`
+	NoFollow          *bool                      `json:"no_follow,omitempty"`
+	CanonicalURL      *string                    `json:"canonical_url,omitempty" format:"uri-reference" maxLength:"2048"`
+	ScheduledAt       *string                    `json:"scheduled_at,omitempty" format:"date-time"`
+	CategoryIDs       *[]int64                   `json:"category_ids,omitempty"`
+	TagIDs            *[]int64                   `json:"tag_ids,omitempty"`
+	TagNames          *[]string                  `json:"tags,omitempty"`
+	VideoURL          *string                    `json:"video_url,omitempty" format:"uri-reference" maxLength:"2048"`
+	VideoTitle        *string                    `json:"video_title,omitempty"`
+	Fields            *map[string]any            `json:"fields,omitempty" doc:"Replaces all custom field values. Changing page_type without fields revalidates the stored values."`
+	CustomFields      *[]contenttype.CustomField `json:"custom_fields,omitempty" doc:"Replaces all key/value custom fields."`
 }
 
 // ListFilter is the input for Service.List.
@@ -131,6 +166,8 @@ type ListFilter struct {
 	IncludeAuthor     bool
 	IncludeCategories bool
 	IncludeTags       bool
+	IncludeSeries     bool
+	IncludeRelated    bool
 }
 
 // ListResult is the paginated list return value from Service.List.
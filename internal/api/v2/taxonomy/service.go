@@ -11,23 +11,48 @@ import (
 	"time"
 
 	v2 "github.com/olegiv/ocms-go/internal/api/v2"
+	"github.com/olegiv/ocms-go/internal/handler"
+	"github.com/olegiv/ocms-go/internal/middleware"
 	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/service"
 	"github.com/olegiv/ocms-go/internal/store"
 	"github.com/olegiv/ocms-go/internal/util"
+	"github.com/olegiv/ocms-go/internal/webhook"
 )
 
 // Service owns TaxonomyTag and TaxonomyCategory operations end-to-end.
 type Service struct {
-	db      *sql.DB
-	queries *store.Queries
-	events  *service.EventService
+	db         *sql.DB
+	queries    *store.Queries
+	events     *service.EventService
+	dispatcher *webhook.Dispatcher
+	urlHistory *handler.URLHistory
 }
 
 // NewService constructs a Taxonomy service. events may be nil in tests; when
 // non-nil every successful write records an audit row.
 func NewService(db *sql.DB, queries *store.Queries, events *service.EventService) *Service {
-	return &Service{db: db, queries: queries, events: events}
+	return &Service{db: db, queries: queries, events: events, urlHistory: handler.NewURLHistory(db)}
+}
+
+// SetDispatcher enables webhook dispatch for tag and category mutations.
+func (s *Service) SetDispatcher(d *webhook.Dispatcher) {
+	s.dispatcher = d
+	s.urlHistory.SetDispatcher(d)
+}
+
+// SetRedirectsMiddleware sets the redirects middleware whose cache is
+// invalidated when a renamed tag or category gets a redirect.
+func (s *Service) SetRedirectsMiddleware(rm *middleware.RedirectsMiddleware) {
+	s.urlHistory.SetRedirectsMiddleware(rm)
+}
+
+// dispatch sends a taxonomy webhook event. Best-effort like the audit helpers.
+func (s *Service) dispatch(ctx context.Context, eventType string, data any) {
+	if s.dispatcher == nil {
+		return
+	}
+	_ = s.dispatcher.DispatchEvent(ctx, eventType, data)
 }
 
 // requireWritePerm returns a domain error when the actor cannot write taxonomy.
@@ -151,6 +176,7 @@ func (s *Service) CreateTag(ctx context.Context, a v2.Actor, in CreateTagBody) (
 		"name":   tag.Name,
 		"slug":   tag.Slug,
 	})
+	s.dispatch(ctx, model.EventTagCreated, webhook.NewTagEventData(tag))
 	return &TaxonomyTag{
 		ID:           tag.ID,
 		Name:         tag.Name,
@@ -201,12 +227,14 @@ func (s *Service) UpdateTag(ctx context.Context, a v2.Actor, id int64, in Update
 	if err != nil {
 		return nil, v2.NewError(v2.ErrInternal, "Failed to update tag")
 	}
+	s.urlHistory.RedirectRenamedTag(ctx, existing, tag)
 	count, _ := s.queries.CountPagesForTag(ctx, tag.ID)
 	s.logTagAudit(ctx, a, "API: Tag updated", map[string]any{
 		"tag_id": tag.ID,
 		"name":   tag.Name,
 		"slug":   tag.Slug,
 	})
+	s.dispatch(ctx, model.EventTagUpdated, webhook.NewTagEventData(tag))
 	return &TaxonomyTag{
 		ID:           tag.ID,
 		Name:         tag.Name,
@@ -223,7 +251,8 @@ func (s *Service) DeleteTag(ctx context.Context, a v2.Actor, id int64) error {
 	if err := s.requireWritePerm(a); err != nil {
 		return err
 	}
-	if _, err := s.queries.GetTagByID(ctx, id); err != nil {
+	tag, err := s.queries.GetTagByID(ctx, id)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return v2.NewError(v2.ErrNotFound, fmt.Sprintf("tag %d not found", id))
 		}
@@ -233,6 +262,7 @@ func (s *Service) DeleteTag(ctx context.Context, a v2.Actor, id int64) error {
 		return v2.NewError(v2.ErrInternal, "Failed to delete tag")
 	}
 	s.logTagAudit(ctx, a, "API: Tag deleted", map[string]any{"tag_id": id})
+	s.dispatch(ctx, model.EventTagDeleted, webhook.NewTagEventData(tag))
 	return nil
 }
 
@@ -338,6 +368,7 @@ func (s *Service) CreateCategory(ctx context.Context, a v2.Actor, in CreateCateg
 		"name":        cat.Name,
 		"slug":        cat.Slug,
 	})
+	s.dispatch(ctx, model.EventCategoryCreated, webhook.NewCategoryEventData(cat))
 	dto := categoryToDTO(cat, 0)
 	return &dto, nil
 }
@@ -413,12 +444,14 @@ func (s *Service) UpdateCategory(ctx context.Context, a v2.Actor, id int64, in U
 	if err != nil {
 		return nil, v2.NewError(v2.ErrInternal, "Failed to update category")
 	}
+	s.urlHistory.RedirectRenamedCategory(ctx, existing, cat)
 	count, _ := s.queries.CountPagesByCategory(ctx, cat.ID)
 	s.logCategoryAudit(ctx, a, "API: Category updated", map[string]any{
 		"category_id": cat.ID,
 		"name":        cat.Name,
 		"slug":        cat.Slug,
 	})
+	s.dispatch(ctx, model.EventCategoryUpdated, webhook.NewCategoryEventData(cat))
 	dto := categoryToDTO(cat, count)
 	return &dto, nil
 }
@@ -443,6 +476,7 @@ func (s *Service) DeleteCategory(ctx context.Context, a v2.Actor, id int64) erro
 		return v2.NewError(v2.ErrInternal, "Failed to delete category")
 	}
 	s.logCategoryAudit(ctx, a, "API: Category deleted", map[string]any{"category_id": cat.ID})
+	s.dispatch(ctx, model.EventCategoryDeleted, webhook.NewCategoryEventData(cat))
 	return nil
 }
 
@@ -181,3 +181,38 @@ func TestDeleteCategoryRejectsIfChildrenExist(t *testing.T) {
 		t.Errorf("expected Conflict (409), got kind=%d: %s", de.Kind, de.Msg)
 	}
 }
+
+func TestUpdateRedirectsTheOldURLOfARenamedTerm(t *testing.T) {
+	db, cleanup := testutil.TestDB(t)
+	defer cleanup()
+	queries := store.New(db)
+	svc := taxonomy.NewService(db, queries, nil)
+	ctx := context.Background()
+	actor := writerActor(t)
+
+	tag, err := svc.CreateTag(ctx, actor, taxonomy.CreateTagBody{Name: "Go", Slug: "go"})
+	if err != nil {
+		t.Fatalf("CreateTag: %v", err)
+	}
+	category, err := svc.CreateCategory(ctx, actor, taxonomy.CreateCategoryBody{Name: "News", Slug: "news"})
+	if err != nil {
+		t.Fatalf("CreateCategory: %v", err)
+	}
+	tagSlug, categorySlug := "golang", "updates"
+	if _, err := svc.UpdateTag(ctx, actor, tag.ID, taxonomy.UpdateTagBody{Slug: &tagSlug}); err != nil {
+		t.Fatalf("UpdateTag: %v", err)
+	}
+	if _, err := svc.UpdateCategory(ctx, actor, category.ID, taxonomy.UpdateCategoryBody{Slug: &categorySlug}); err != nil {
+		t.Fatalf("UpdateCategory: %v", err)
+	}
+
+	for source, want := range map[string]string{
+		"/tag/go":        "/tag/golang",
+		"/category/news": "/category/updates",
+	} {
+		redirect, err := queries.GetRedirectBySourcePath(ctx, source)
+		if err != nil || redirect.TargetUrl != want {
+			t.Errorf("redirect from %s = %q, %v; want %s", source, redirect.TargetUrl, err, want)
+		}
+	}
+}
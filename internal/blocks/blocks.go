@@ -0,0 +1,376 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package blocks implements structured page content for the block editor: a
+// versioned JSON document of typed blocks, its validation, server-side
+// rendering to HTML with overridable renderers, and conversion from existing
+// HTML page bodies.
+package blocks
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/olegiv/ocms-go/internal/security"
+	"github.com/olegiv/ocms-go/internal/video"
+)
+
+// CurrentVersion is the document format version written by this package.
+// Documents of older versions are upgraded by Parse.
+const CurrentVersion = 1
+
+// Block types.
+const (
+	TypeParagraph = "paragraph"
+	TypeHeading   = "heading"
+	TypeImage     = "image"
+	TypeGallery   = "gallery"
+	TypeQuote     = "quote"
+	TypeEmbed     = "embed"
+	TypeCode      = "code"
+	TypeColumns   = "columns"
+	TypeCTA       = "cta"
+	TypeForm      = "form"
+	// TypeHTML holds raw (sanitized) HTML that could not be converted into
+	// another block type.
+	TypeHTML = "html"
+)
+
+// Document limits.
+const (
+	MaxBlocks        = 500
+	MaxGalleryImages = 50
+	MinColumns       = 2
+	MaxColumns       = 4
+	MaxTextLength    = 100000
+	maxShortLength   = 500
+)
+
+// Document is the structured content of a page.
+type Document struct {
+	Version int     `json:"version"`
+	Blocks  []Block `json:"blocks"`
+}
+
+// Block is one content block. Which fields are used depends on Type:
+//
+//	paragraph: Text (inline HTML)
+//	heading:   Text (inline HTML), Level (2-6)
+//	image:     URL, Alt, Caption
+//	gallery:   Images, Caption
+//	quote:     Text (inline HTML), Citation
+//	embed:     URL (video URL), Caption
+//	code:      Text (plain text), Language
+//	columns:   Columns (2-4 lists of blocks, not nested)
+//	cta:       Text (inline HTML), Label, URL
+//	form:      Form (form slug), Label
+//	html:      HTML
+type Block struct {
+	Type     string    `json:"type"`
+	Text     string    `json:"text,omitempty"`
+	Level    int       `json:"level,omitempty"`
+	Citation string    `json:"citation,omitempty"`
+	Language string    `json:"language,omitempty"`
+	URL      string    `json:"url,omitempty"`
+	Alt      string    `json:"alt,omitempty"`
+	Caption  string    `json:"caption,omitempty"`
+	Images   []Image   `json:"images,omitempty"`
+	Columns  [][]Block `json:"columns,omitempty"`
+	Label    string    `json:"label,omitempty"`
+	Form     string    `json:"form,omitempty"`
+	HTML     string    `json:"html,omitempty"`
+}
+
+// Image is one image of a gallery block.
+type Image struct {
+	URL string `json:"url"`
+	Alt string `json:"alt,omitempty"`
+}
+
+// Types returns all block types in the order offered by the editor.
+func Types() []string {
+	return []string{
+		TypeParagraph, TypeHeading, TypeImage, TypeGallery, TypeQuote,
+		TypeEmbed, TypeCode, TypeColumns, TypeCTA, TypeForm, TypeHTML,
+	}
+}
+
+// videos validates and renders embed blocks.
+var videos = video.NewRegistry()
+
+// Parse decodes a stored or submitted document. Unknown properties are
+// rejected, documents without a version are treated as version 1 and
+// documents of a newer version than CurrentVersion are an error.
+func Parse(raw string) (Document, error) {
+	var doc Document
+	if strings.TrimSpace(raw) == "" {
+		return Document{Version: CurrentVersion}, nil
+	}
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&doc); err != nil {
+		return Document{}, fmt.Errorf("invalid block document: %w", err)
+	}
+	if dec.More() {
+		return Document{}, errors.New("invalid block document: trailing data")
+	}
+	switch {
+	case doc.Version == 0:
+		doc.Version = CurrentVersion
+	case doc.Version > CurrentVersion:
+		return Document{}, fmt.Errorf("unsupported block document version %d", doc.Version)
+	}
+	return doc, nil
+}
+
+// JSON encodes the document for storage.
+func (d Document) JSON() string {
+	if d.Blocks == nil {
+		d.Blocks = []Block{}
+	}
+	d.Version = CurrentVersion
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(d); err != nil {
+		return `{"version":1,"blocks":[]}`
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// Empty reports whether the document has no blocks.
+func (d Document) Empty() bool {
+	return len(d.Blocks) == 0
+}
+
+// InlineHTML returns the submitted markup of all blocks that accept HTML,
+// before sanitization. Callers use it for the suspicious markup check that is
+// applied to HTML page bodies.
+func (d Document) InlineHTML() string {
+	var sb strings.Builder
+	var walk func([]Block)
+	walk = func(list []Block) {
+		for _, b := range list {
+			for _, s := range []string{b.Text, b.HTML} {
+				if s != "" && b.Type != TypeCode {
+					sb.WriteString(s)
+					sb.WriteByte('\n')
+				}
+			}
+			for _, col := range b.Columns {
+				walk(col)
+			}
+		}
+	}
+	walk(d.Blocks)
+	return sb.String()
+}
+
+// Normalize validates the document and returns it in storage form: inline
+// HTML is sanitized, strings are trimmed and blocks without content are
+// dropped. The error names the first invalid block by its position.
+func Normalize(doc Document) (Document, error) {
+	if count(doc.Blocks) > MaxBlocks {
+		return Document{}, fmt.Errorf("a page can have at most %d blocks", MaxBlocks)
+	}
+	out, err := normalizeList(doc.Blocks, true, "")
+	if err != nil {
+		return Document{}, err
+	}
+	return Document{Version: CurrentVersion, Blocks: out}, nil
+}
+
+// count returns the number of blocks including column children.
+func count(list []Block) int {
+	n := len(list)
+	for _, b := range list {
+		for _, col := range b.Columns {
+			n += count(col)
+		}
+	}
+	return n
+}
+
+func normalizeList(list []Block, allowColumns bool, prefix string) ([]Block, error) {
+	out := make([]Block, 0, len(list))
+	for i, b := range list {
+		pos := fmt.Sprintf("%s%d", prefix, i+1)
+		if b.Type == TypeColumns && !allowColumns {
+			return nil, fmt.Errorf("block %s: columns cannot be nested", pos)
+		}
+		nb, keep, err := normalizeBlock(b, pos)
+		if err != nil {
+			return nil, err
+		}
+		if keep {
+			out = append(out, nb)
+		}
+	}
+	return out, nil
+}
+
+func normalizeBlock(b Block, pos string) (Block, bool, error) {
+	fail := func(format string, args ...any) (Block, bool, error) {
+		return Block{}, false, fmt.Errorf("block %s (%s): %s", pos, b.Type, fmt.Sprintf(format, args...))
+	}
+	if !slices.Contains(Types(), b.Type) {
+		return Block{}, false, fmt.Errorf("block %s: unknown block type %q", pos, b.Type)
+	}
+	if len(b.Text) > MaxTextLength || len(b.HTML) > MaxTextLength {
+		return fail("content is too long")
+	}
+	for _, s := range []string{b.Citation, b.Language, b.URL, b.Alt, b.Caption, b.Label, b.Form} {
+		if len(s) > maxShortLength {
+			return fail("a property is longer than %d characters", maxShortLength)
+		}
+	}
+
+	out := Block{Type: b.Type}
+	switch b.Type {
+	case TypeParagraph, TypeQuote, TypeHeading:
+		out.Text = sanitizeInline(b.Text)
+		if out.Text == "" {
+			return Block{}, false, nil
+		}
+		if b.Type == TypeQuote {
+			out.Citation = strings.TrimSpace(b.Citation)
+		}
+		if b.Type == TypeHeading {
+			out.Level = b.Level
+			if out.Level == 0 {
+				out.Level = 2
+			}
+			if out.Level < 2 || out.Level > 6 {
+				return fail("heading level must be between 2 and 6")
+			}
+		}
+	case TypeImage:
+		out.URL = strings.TrimSpace(b.URL)
+		if out.URL == "" {
+			return Block{}, false, nil
+		}
+		if !validURL(out.URL) {
+			return fail("image URL must be an http(s) URL or a site path")
+		}
+		out.Alt = strings.TrimSpace(b.Alt)
+		out.Caption = strings.TrimSpace(b.Caption)
+	case TypeGallery:
+		for _, img := range b.Images {
+			img.URL = strings.TrimSpace(img.URL)
+			if img.URL == "" {
+				continue
+			}
+			if !validURL(img.URL) || len(img.URL) > maxShortLength || len(img.Alt) > maxShortLength {
+				return fail("invalid image URL %q", img.URL)
+			}
+			out.Images = append(out.Images, Image{URL: img.URL, Alt: strings.TrimSpace(img.Alt)})
+		}
+		if len(out.Images) == 0 {
+			return Block{}, false, nil
+		}
+		if len(out.Images) > MaxGalleryImages {
+			return fail("a gallery can have at most %d images", MaxGalleryImages)
+		}
+		out.Caption = strings.TrimSpace(b.Caption)
+	case TypeEmbed:
+		out.URL = strings.TrimSpace(b.URL)
+		if out.URL == "" {
+			return Block{}, false, nil
+		}
+		if msg := videos.ValidateURL(out.URL); msg != "" {
+			return fail("%s", msg)
+		}
+		out.Caption = strings.TrimSpace(b.Caption)
+	case TypeCode:
+		out.Text = strings.TrimRight(b.Text, " \t\r\n")
+		if strings.TrimSpace(out.Text) == "" {
+			return Block{}, false, nil
+		}
+		out.Language = strings.TrimSpace(b.Language)
+		if out.Language != "" && !languagePattern(out.Language) {
+			return fail("invalid code language %q", out.Language)
+		}
+	case TypeColumns:
+		if len(b.Columns) < MinColumns || len(b.Columns) > MaxColumns {
+			return fail("columns must have between %d and %d columns", MinColumns, MaxColumns)
+		}
+		out.Columns = make([][]Block, 0, len(b.Columns))
+		for i, col := range b.Columns {
+			nc, err := normalizeList(col, false, fmt.Sprintf("%s.%d.", pos, i+1))
+			if err != nil {
+				return Block{}, false, err
+			}
+			out.Columns = append(out.Columns, nc)
+		}
+	case TypeCTA:
+		out.Text = sanitizeInline(b.Text)
+		out.Label = strings.TrimSpace(b.Label)
+		out.URL = strings.TrimSpace(b.URL)
+		if out.Label == "" || out.URL == "" {
+			return fail("a call to action needs a button label and URL")
+		}
+		if !validURL(out.URL) {
+			return fail("button URL must be an http(s) URL or a site path")
+		}
+	case TypeForm:
+		out.Form = strings.TrimSpace(b.Form)
+		if out.Form == "" {
+			return Block{}, false, nil
+		}
+		if !slugPattern(out.Form) {
+			return fail("invalid form slug %q", out.Form)
+		}
+		out.Label = strings.TrimSpace(b.Label)
+	case TypeHTML:
+		out.HTML = strings.TrimSpace(security.SanitizePageHTML(b.HTML))
+		if out.HTML == "" {
+			return Block{}, false, nil
+		}
+	}
+	return out, true, nil
+}
+
+// sanitizeInline sanitizes the inline HTML of a text block.
+func sanitizeInline(s string) string {
+	return strings.TrimSpace(security.SanitizePageHTML(s))
+}
+
+// validURL accepts absolute http(s) URLs and site-relative paths.
+func validURL(raw string) bool {
+	if strings.HasPrefix(raw, "/") {
+		return !strings.HasPrefix(raw, "//") && !strings.ContainsAny(raw, "\\ \t\r\n")
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// languagePattern reports whether s is a usable code language name.
+func languagePattern(s string) bool {
+	if len(s) > 32 {
+		return false
+	}
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '+' || r == '#' || r == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// slugPattern reports whether s is a valid form slug.
+func slugPattern(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package blocks
+
+import (
+	"html/template"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	doc, err := Parse(`{"blocks":[{"type":"paragraph","text":"Hi"}]}`)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if doc.Version != CurrentVersion || len(doc.Blocks) != 1 {
+		t.Errorf("doc = %+v", doc)
+	}
+
+	for _, raw := range []string{
+		`{"version":99,"blocks":[]}`,
+		`{"blocks":[{"type":"paragraph","bogus":1}]}`,
+		`{"blocks":[]} {}`,
+		`not json`,
+	} {
+		if _, err := Parse(raw); err == nil {
+			t.Errorf("Parse(%q) expected error", raw)
+		}
+	}
+
+	empty, err := Parse("  ")
+	if err != nil || !empty.Empty() {
+		t.Errorf("Parse(blank) = %+v, %v", empty, err)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	doc := Document{Blocks: []Block{
+		{Type: TypeParagraph, Text: `Hello <strong>world</strong><script>alert(1)</script>`},
+		{Type: TypeParagraph, Text: "   "},
+		{Type: TypeHeading, Text: "Title"},
+		{Type: TypeCode, Text: "<b>x</b>\n\n", Language: "go"},
+		{Type: TypeColumns, Columns: [][]Block{
+			{{Type: TypeParagraph, Text: "Left"}},
+			{{Type: TypeImage, URL: "/uploads/a.jpg", Alt: "A"}},
+		}},
+		{Type: TypeHTML, HTML: `<table><tr><td onclick="x()">1</td></tr></table>`},
+	}}
+	got, err := Normalize(doc)
+	if err != nil {
+		t.Fatalf("Normalize: %v", err)
+	}
+	if len(got.Blocks) != 5 {
+		t.Fatalf("blocks = %d, want 5 (empty paragraph dropped)", len(got.Blocks))
+	}
+	if strings.Contains(got.Blocks[0].Text, "script") || !strings.Contains(got.Blocks[0].Text, "<strong>") {
+		t.Errorf("paragraph text = %q", got.Blocks[0].Text)
+	}
+	if got.Blocks[1].Level != 2 {
+		t.Errorf("heading level = %d, want default 2", got.Blocks[1].Level)
+	}
+	if got.Blocks[2].Text != "<b>x</b>" {
+		t.Errorf("code text = %q, must be kept verbatim", got.Blocks[2].Text)
+	}
+	if strings.Contains(got.Blocks[4].HTML, "onclick") {
+		t.Errorf("html block not sanitized: %q", got.Blocks[4].HTML)
+	}
+
+	invalid := []Block{
+		{Type: "marquee"},
+		{Type: TypeHeading, Text: "x", Level: 1},
+		{Type: TypeImage, URL: "javascript:alert(1)"},
+		{Type: TypeImage, URL: "//evil.example/a.png"},
+		{Type: TypeEmbed, URL: "https://example.com/video"},
+		{Type: TypeColumns, Columns: [][]Block{{}}},
+		{Type: TypeColumns, Columns: [][]Block{{{Type: TypeColumns}}, {}}},
+		{Type: TypeCTA, Text: "Go", Label: "Go"},
+		{Type: TypeForm, Form: "Bad Slug"},
+		{Type: TypeCode, Text: "x", Language: "go lang"},
+	}
+	for _, b := range invalid {
+		if _, err := Normalize(Document{Blocks: []Block{b}}); err == nil {
+			t.Errorf("Normalize(%+v) expected error", b)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	doc, err := Normalize(Document{Blocks: []Block{
+		{Type: TypeHeading, Text: "Intro", Level: 3},
+		{Type: TypeParagraph, Text: "Some <em>text</em>"},
+		{Type: TypeQuote, Text: "Quoted", Citation: "Someone"},
+		{Type: TypeCode, Text: "if a < b {}", Language: "go"},
+		{Type: TypeEmbed, URL: "https://www.youtube.com/watch?v=dQw4w9WgXcQ"},
+		{Type: TypeCTA, Text: "Ready?", Label: "Sign up", URL: "/signup"},
+		{Type: TypeForm, Form: "contact"},
+		{Type: TypeGallery, Images: []Image{{URL: "/a.jpg", Alt: "A"}, {URL: "/b.jpg"}}},
+		{Type: TypeColumns, Columns: [][]Block{
+			{{Type: TypeParagraph, Text: "L"}},
+			{{Type: TypeParagraph, Text: "R"}},
+		}},
+	}})
+	if err != nil {
+		t.Fatalf("Normalize: %v", err)
+	}
+	out := string(Render(doc))
+	for _, want := range []string{
+		"<h3>Intro</h3>",
+		"<p>Some <em>text</em></p>",
+		"<cite>Someone</cite>",
+		`<code class="language-go">if a &lt; b {}</code>`,
+		"youtube-nocookie.com/embed/dQw4w9WgXcQ",
+		`<a class="block-cta-button" href="/signup">Sign up</a>`,
+		`<a href="/forms/contact">contact</a>`,
+		`<img src="/b.jpg" alt="" loading="lazy">`,
+		`<div class="block-columns block-columns-2"><div class="block-column"><p>L</p></div>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("rendered HTML missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderWithOverride(t *testing.T) {
+	doc := Document{Blocks: []Block{
+		{Type: TypeParagraph, Text: "Plain"},
+		{Type: TypeColumns, Columns: [][]Block{
+			{{Type: TypeParagraph, Text: "A"}},
+			{{Type: TypeHeading, Text: "B", Level: 2}},
+		}},
+	}}
+	out := string(RenderWith(doc, func(v View) (template.HTML, bool) {
+		if v.Type != TypeParagraph {
+			return "", false
+		}
+		return template.HTML(`<p class="custom">` + string(v.Text) + `</p>`), true
+	}))
+	if !strings.Contains(out, `<p class="custom">Plain</p>`) || !strings.Contains(out, `<p class="custom">A</p>`) {
+		t.Errorf("override not applied to all paragraphs:\n%s", out)
+	}
+	if !strings.Contains(out, "<h2>B</h2>") {
+		t.Errorf("default renderer not used as fallback:\n%s", out)
+	}
+}
+
+func TestFromHTML(t *testing.T) {
+	body := `<h1>Title</h1>
+<p>First <strong>para</strong></p>
+<p><img src="/uploads/x.jpg" alt="X"></p>
+<figure><img src="/uploads/y.jpg" alt="Y"><figcaption>Caption</figcaption></figure>
+<blockquote><p>Wise words</p><cite>Sage</cite></blockquote>
+<pre><code class="language-go">fmt.Println("hi")</code></pre>
+<table><tr><td>1</td></tr></table>
+<ul><li>a</li></ul>`
+	doc := FromHTML(body)
+	types := make([]string, 0, len(doc.Blocks))
+	for _, b := range doc.Blocks {
+		types = append(types, b.Type)
+	}
+	want := []string{TypeHeading, TypeParagraph, TypeImage, TypeImage, TypeQuote, TypeCode, TypeHTML}
+	if strings.Join(types, ",") != strings.Join(want, ",") {
+		t.Fatalf("types = %v, want %v", types, want)
+	}
+	if doc.Blocks[0].Level != 2 {
+		t.Errorf("h1 level = %d, want 2", doc.Blocks[0].Level)
+	}
+	if doc.Blocks[3].Caption != "Caption" || doc.Blocks[3].URL != "/uploads/y.jpg" {
+		t.Errorf("figure = %+v", doc.Blocks[3])
+	}
+	if doc.Blocks[4].Citation != "Sage" || strings.Contains(doc.Blocks[4].Text, "cite") {
+		t.Errorf("quote = %+v", doc.Blocks[4])
+	}
+	if doc.Blocks[5].Language != "go" || doc.Blocks[5].Text != `fmt.Println("hi")` {
+		t.Errorf("code = %+v", doc.Blocks[5])
+	}
+	if !strings.Contains(doc.Blocks[6].HTML, "<table>") || !strings.Contains(doc.Blocks[6].HTML, "<ul>") {
+		t.Errorf("html block = %q", doc.Blocks[6].HTML)
+	}
+
+	// A converted document round-trips through Normalize and Render.
+	out, err := ToHTML(doc)
+	if err != nil {
+		t.Fatalf("ToHTML: %v", err)
+	}
+	if !strings.Contains(out, "<p>First <strong>para</strong></p>") || !strings.Contains(out, "<td>1</td>") {
+		t.Errorf("ToHTML = %s", out)
+	}
+}
+
+func TestDocumentJSON(t *testing.T) {
+	doc := Document{Blocks: []Block{{Type: TypeParagraph, Text: "a & <b>b</b>"}}}
+	raw := doc.JSON()
+	if !strings.Contains(raw, `"version":1`) || !strings.Contains(raw, "<b>") {
+		t.Errorf("JSON = %s", raw)
+	}
+	back, err := Parse(raw)
+	if err != nil || back.Blocks[0].Text != doc.Blocks[0].Text {
+		t.Errorf("round trip = %+v, %v", back, err)
+	}
+	if got := (Document{}).JSON(); got != `{"version":1,"blocks":[]}` {
+		t.Errorf("empty JSON = %s", got)
+	}
+}
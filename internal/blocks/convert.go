@@ -0,0 +1,189 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package blocks
+
+import (
+	"bytes"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// FromHTML converts an HTML page body into a block document. Top-level
+// paragraphs, headings, quotes, code and images become blocks of their type;
+// any other markup is kept as html blocks so no content is lost. The result
+// still has to pass Normalize before it is stored.
+func FromHTML(body string) Document {
+	doc := Document{Version: CurrentVersion, Blocks: []Block{}}
+	if strings.TrimSpace(body) == "" {
+		return doc
+	}
+	context := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(body), context)
+	if err != nil {
+		return Document{Version: CurrentVersion, Blocks: []Block{{Type: TypeHTML, HTML: body}}}
+	}
+	for _, n := range nodes {
+		b, ok := blockFromNode(n)
+		if !ok {
+			continue
+		}
+		// Merge adjacent unconverted markup into one html block.
+		if last := len(doc.Blocks) - 1; b.Type == TypeHTML && last >= 0 && doc.Blocks[last].Type == TypeHTML {
+			doc.Blocks[last].HTML += "\n" + b.HTML
+			continue
+		}
+		doc.Blocks = append(doc.Blocks, b)
+	}
+	return doc
+}
+
+// ToHTML converts a block document into an HTML body. Invalid documents
+// produce an error from Normalize.
+func ToHTML(doc Document) (string, error) {
+	normalized, err := Normalize(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(Render(normalized)), nil
+}
+
+func blockFromNode(n *html.Node) (Block, bool) {
+	switch n.Type {
+	case html.TextNode:
+		text := strings.TrimSpace(n.Data)
+		if text == "" {
+			return Block{}, false
+		}
+		return Block{Type: TypeParagraph, Text: html.EscapeString(text)}, true
+	case html.ElementNode:
+	default:
+		return Block{}, false
+	}
+
+	switch n.DataAtom {
+	case atom.P:
+		if img := onlyChild(n, atom.Img); img != nil {
+			return imageBlock(img, ""), true
+		}
+		return Block{Type: TypeParagraph, Text: innerHTML(n)}, true
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := int(n.Data[1] - '0')
+		if level < 2 {
+			level = 2
+		}
+		return Block{Type: TypeHeading, Text: innerHTML(n), Level: level}, true
+	case atom.Blockquote:
+		b := Block{Type: TypeQuote}
+		if cite := findChild(n, atom.Cite); cite != nil {
+			b.Citation = strings.TrimSpace(textContent(cite))
+			n.RemoveChild(cite)
+		}
+		b.Text = innerHTML(n)
+		return b, true
+	case atom.Pre:
+		b := Block{Type: TypeCode, Text: textContent(n)}
+		if code := onlyChild(n, atom.Code); code != nil {
+			for _, class := range strings.Fields(attr(code, "class")) {
+				if lang, ok := strings.CutPrefix(class, "language-"); ok {
+					b.Language = lang
+				}
+			}
+		}
+		return b, true
+	case atom.Img:
+		return imageBlock(n, ""), true
+	case atom.Figure:
+		img := findChild(n, atom.Img)
+		caption := findChild(n, atom.Figcaption)
+		if img != nil && countElements(n) <= 2 {
+			c := ""
+			if caption != nil {
+				c = strings.TrimSpace(textContent(caption))
+			}
+			return imageBlock(img, c), true
+		}
+	}
+	return Block{Type: TypeHTML, HTML: outerHTML(n)}, true
+}
+
+func imageBlock(img *html.Node, caption string) Block {
+	return Block{Type: TypeImage, URL: attr(img, "src"), Alt: attr(img, "alt"), Caption: caption}
+}
+
+// onlyChild returns the single element child of n when it has type a and n
+// holds no other non-whitespace content.
+func onlyChild(n *html.Node, a atom.Atom) *html.Node {
+	var found *html.Node
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		switch {
+		case c.Type == html.TextNode && strings.TrimSpace(c.Data) == "":
+		case c.Type == html.ElementNode && c.DataAtom == a && found == nil:
+			found = c
+		default:
+			return nil
+		}
+	}
+	return found
+}
+
+// findChild returns the first direct element child of n with type a.
+func findChild(n *html.Node, a atom.Atom) *html.Node {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.DataAtom == a {
+			return c
+		}
+	}
+	return nil
+}
+
+// countElements returns the number of direct element children of n.
+func countElements(n *html.Node) int {
+	count := 0
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode {
+			count++
+		}
+	}
+	return count
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func textContent(n *html.Node) string {
+	var sb strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			sb.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return sb.String()
+}
+
+func innerHTML(n *html.Node) string {
+	var buf bytes.Buffer
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		_ = html.Render(&buf, c)
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+func outerHTML(n *html.Node) string {
+	var buf bytes.Buffer
+	_ = html.Render(&buf, n)
+	return buf.String()
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package blocks
+
+import (
+	"bytes"
+	"html/template"
+	"log/slog"
+	"strings"
+)
+
+// View is the data passed to a block renderer. Text carries the sanitized
+// inline HTML of text blocks (and the markup of html blocks), Embed the
+// player markup of embed blocks and Columns the rendered children of a
+// columns block.
+type View struct {
+	Block
+	Index   int
+	Text    template.HTML
+	Embed   template.HTML
+	Columns []template.HTML
+}
+
+// Override renders a block in place of the default renderer. It returns false
+// to fall back to the default.
+type Override func(v View) (template.HTML, bool)
+
+// defaultTemplates are the built-in block renderers, one named template per
+// block type.
+const defaultTemplates = `
+{{define "paragraph"}}<p>{{.Text}}</p>{{end}}
+{{define "heading"}}{{if eq .Level 3}}<h3>{{.Text}}</h3>{{else if eq .Level 4}}<h4>{{.Text}}</h4>{{else if eq .Level 5}}<h5>{{.Text}}</h5>{{else if eq .Level 6}}<h6>{{.Text}}</h6>{{else}}<h2>{{.Text}}</h2>{{end}}{{end}}
+{{define "image"}}<figure class="block-image"><img src="{{.URL}}" alt="{{.Alt}}" loading="lazy">{{with .Caption}}<figcaption>{{.}}</figcaption>{{end}}</figure>{{end}}
+{{define "gallery"}}<figure class="block-gallery"><div class="block-gallery-images">{{range .Images}}<img src="{{.URL}}" alt="{{.Alt}}" loading="lazy">{{end}}</div>{{with .Caption}}<figcaption>{{.}}</figcaption>{{end}}</figure>{{end}}
+{{define "quote"}}<blockquote class="block-quote">{{.Text}}{{with .Citation}}<cite>{{.}}</cite>{{end}}</blockquote>{{end}}
+{{define "embed"}}<figure class="block-embed"><div class="block-embed-player">{{.Embed}}</div>{{with .Caption}}<figcaption>{{.}}</figcaption>{{end}}</figure>{{end}}
+{{define "code"}}<pre class="block-code"><code{{with .Language}} class="language-{{.}}"{{end}}>{{.Block.Text}}</code></pre>{{end}}
+{{define "columns"}}<div class="block-columns block-columns-{{len .Columns}}">{{range .Columns}}<div class="block-column">{{.}}</div>{{end}}</div>{{end}}
+{{define "cta"}}<div class="block-cta">{{with .Text}}<div class="block-cta-text">{{.}}</div>{{end}}<a class="block-cta-button" href="{{.URL}}">{{.Label}}</a></div>{{end}}
+{{define "form"}}<div class="block-form" data-form="{{.Form}}"><a href="/forms/{{.Form}}">{{if .Label}}{{.Label}}{{else}}{{.Form}}{{end}}</a></div>{{end}}
+{{define "html"}}{{.Text}}{{end}}
+`
+
+var defaultRenderers = template.Must(template.New("blocks").Parse(defaultTemplates))
+
+// Render renders a normalized document to HTML with the default renderers.
+func Render(doc Document) template.HTML {
+	return RenderWith(doc, nil)
+}
+
+// RenderWith renders a normalized document to HTML. override, when not nil,
+// is asked first for every block, including blocks inside columns. The
+// document must have passed Normalize: inline HTML is not sanitized again.
+func RenderWith(doc Document, override Override) template.HTML {
+	return renderList(doc.Blocks, override)
+}
+
+func renderList(list []Block, override Override) template.HTML {
+	var sb strings.Builder
+	for i, b := range list {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		sb.WriteString(string(renderBlock(i, b, override)))
+	}
+	// #nosec G203 - blocks are normalized and rendered by html/template
+	return template.HTML(sb.String())
+}
+
+func renderBlock(index int, b Block, override Override) template.HTML {
+	v := View{Block: b, Index: index}
+	switch b.Type {
+	case TypeHTML:
+		// #nosec G203 - html blocks are sanitized by Normalize
+		v.Text = template.HTML(b.HTML)
+	case TypeCode:
+		// Code is plain text and escaped by the template.
+	default:
+		// #nosec G203 - inline text is sanitized by Normalize
+		v.Text = template.HTML(b.Text)
+	}
+	if b.Type == TypeEmbed {
+		embed, err := videos.EmbedHTML(b.URL)
+		if err != nil {
+			return ""
+		}
+		v.Embed = embed
+	}
+	for _, col := range b.Columns {
+		v.Columns = append(v.Columns, renderList(col, override))
+	}
+
+	if override != nil {
+		if out, ok := override(v); ok {
+			return out
+		}
+	}
+	var buf bytes.Buffer
+	if err := defaultRenderers.ExecuteTemplate(&buf, b.Type, v); err != nil {
+		slog.Error("failed to render block", "error", err, "type", b.Type)
+		return ""
+	}
+	// #nosec G203 - output of html/template
+	return template.HTML(buf.String())
+}
@@ -22,24 +22,39 @@ type ConfigCache struct {
 
 	// Store all config as map for bulk access
 	allConfig map[string]store.Config
+	// Per-site overrides, keyed by site ID then config key
+	siteConfig map[int64]map[string]string
 }
 
 // NewConfigCache creates a new config cache.
 // TTL is set to 1 hour but cache is invalidated on any config change.
 func NewConfigCache(queries *store.Queries) *ConfigCache {
 	return &ConfigCache{
-		cache:     New(time.Hour), // Long TTL, manually invalidated
-		queries:   queries,
-		allConfig: make(map[string]store.Config),
+		cache:      New(time.Hour), // Long TTL, manually invalidated
+		queries:    queries,
+		allConfig:  make(map[string]store.Config),
+		siteConfig: make(map[int64]map[string]string),
 	}
 }
 
+// lookup returns the config entry of key as seen by the site ctx is scoped
+// to. Callers must hold c.mu.
+func (c *ConfigCache) lookup(ctx context.Context, key string) (store.Config, bool) {
+	cfg, ok := c.allConfig[key]
+	if value, overridden := c.siteConfig[store.SiteIDFromContext(ctx)][key]; overridden {
+		cfg.Key = key
+		cfg.Value = value
+		ok = true
+	}
+	return cfg, ok
+}
+
 // Get retrieves a config value by key.
 // Returns empty string if not found.
 func (c *ConfigCache) Get(ctx context.Context, key string) (string, error) {
 	c.mu.RLock()
 	if c.loaded {
-		if cfg, ok := c.allConfig[key]; ok {
+		if cfg, ok := c.lookup(ctx, key); ok {
 			c.mu.RUnlock()
 			c.cache.hits.Add(1)
 			return cfg.Value, nil
@@ -57,7 +72,7 @@ func (c *ConfigCache) Get(ctx context.Context, key string) (string, error) {
 
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	if cfg, ok := c.allConfig[key]; ok {
+	if cfg, ok := c.lookup(ctx, key); ok {
 		c.cache.hits.Add(1)
 		return cfg.Value, nil
 	}
@@ -69,7 +84,7 @@ func (c *ConfigCache) Get(ctx context.Context, key string) (string, error) {
 func (c *ConfigCache) GetConfig(ctx context.Context, key string) (store.Config, bool, error) {
 	c.mu.RLock()
 	if c.loaded {
-		cfg, ok := c.allConfig[key]
+		cfg, ok := c.lookup(ctx, key)
 		c.mu.RUnlock()
 		if ok {
 			c.cache.hits.Add(1)
@@ -87,7 +102,7 @@ func (c *ConfigCache) GetConfig(ctx context.Context, key string) (store.Config,
 
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	cfg, ok := c.allConfig[key]
+	cfg, ok := c.lookup(ctx, key)
 	if ok {
 		c.cache.hits.Add(1)
 	} else {
@@ -110,7 +125,7 @@ func (c *ConfigCache) GetMultiple(ctx context.Context, keys ...string) (map[stri
 
 	result := make(map[string]string, len(keys))
 	for _, key := range keys {
-		if cfg, ok := c.allConfig[key]; ok {
+		if cfg, ok := c.lookup(ctx, key); ok {
 			result[key] = cfg.Value
 		}
 	}
@@ -133,6 +148,9 @@ func (c *ConfigCache) All(ctx context.Context) (map[string]string, error) {
 	for key, cfg := range c.allConfig {
 		result[key] = cfg.Value
 	}
+	for key, value := range c.siteConfig[store.SiteIDFromContext(ctx)] {
+		result[key] = value
+	}
 	return result, nil
 }
 
@@ -155,6 +173,18 @@ func (c *ConfigCache) loadAll(ctx context.Context) error {
 	for _, cfg := range configs {
 		c.allConfig[cfg.Key] = cfg
 	}
+
+	siteConfigs, err := c.queries.ListAllSiteConfig(ctx)
+	if err != nil {
+		return err
+	}
+	c.siteConfig = make(map[int64]map[string]string)
+	for _, sc := range siteConfigs {
+		if c.siteConfig[sc.SiteID] == nil {
+			c.siteConfig[sc.SiteID] = make(map[string]string)
+		}
+		c.siteConfig[sc.SiteID][sc.Key] = sc.Value
+	}
 	c.loaded = true
 	c.cache.sets.Add(1)
 
@@ -167,6 +197,7 @@ func (c *ConfigCache) Invalidate() {
 	defer c.mu.Unlock()
 	c.loaded = false
 	c.allConfig = make(map[string]store.Config)
+	c.siteConfig = make(map[int64]map[string]string)
 	c.cache.ResetStats()
 }
 
@@ -83,6 +83,52 @@ func TestConfigCache_GetConfig(t *testing.T) {
 	}
 }
 
+func TestConfigCache_SiteOverrides(t *testing.T) {
+	q := newTestDB(t)
+	c := NewConfigCache(q)
+	ctx := context.Background()
+	now := time.Now()
+
+	if _, err := q.UpsertConfig(ctx, store.UpsertConfigParams{
+		Key: "site_name", Value: "Main", Type: "string", UpdatedAt: now,
+	}); err != nil {
+		t.Fatalf("UpsertConfig: %v", err)
+	}
+	site, err := q.CreateSite(ctx, store.CreateSiteParams{
+		Name: "Digest", Host: "digest.example", CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateSite: %v", err)
+	}
+	for key, value := range map[string]string{"site_name": "Digest", "security_contact": "mailto:sec@digest.example"} {
+		if err := q.UpsertSiteConfig(ctx, store.UpsertSiteConfigParams{
+			SiteID: site.ID, Key: key, Value: value, UpdatedAt: now,
+		}); err != nil {
+			t.Fatalf("UpsertSiteConfig: %v", err)
+		}
+	}
+
+	if got, _ := c.Get(ctx, "site_name"); got != "Main" {
+		t.Errorf("default site Get(site_name) = %q, want %q", got, "Main")
+	}
+	if _, ok, _ := c.GetConfig(ctx, "security_contact"); ok {
+		t.Error("default site should not see another site's override")
+	}
+
+	siteCtx := store.WithSiteID(ctx, site.ID)
+	if got, _ := c.Get(siteCtx, "site_name"); got != "Digest" {
+		t.Errorf("site Get(site_name) = %q, want %q", got, "Digest")
+	}
+	cfg, ok, _ := c.GetConfig(siteCtx, "site_name")
+	if !ok || cfg.Value != "Digest" || cfg.Type != "string" {
+		t.Errorf("site GetConfig(site_name) = %+v, %v; want override keeping type", cfg, ok)
+	}
+	all, _ := c.All(siteCtx)
+	if all["security_contact"] != "mailto:sec@digest.example" || all["site_name"] != "Digest" {
+		t.Errorf("site All() = %v, want overrides applied", all)
+	}
+}
+
 func TestConfigCache_Stats(t *testing.T) {
 	q := newTestDB(t)
 	c := NewConfigCache(q)
@@ -531,8 +577,9 @@ func TestMenuCache_WithData(t *testing.T) {
 	ctx := context.Background()
 
 	menu, err := q.CreateMenu(ctx, store.CreateMenuParams{
-		Name: "Main Menu",
-		Slug: "main",
+		Name:   "Main Menu",
+		Slug:   "main",
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMenu: %v", err)
@@ -1106,6 +1153,7 @@ func createLanguageScopedPages(t *testing.T, q *store.Queries, slug string) (eng
 	english, err = q.CreatePage(ctx, store.CreatePageParams{
 		Title: "English", Slug: slug + "-en", Body: "b", Status: "published",
 		AuthorID: author.ID, LanguageCode: "en", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage: %v", err)
@@ -1113,6 +1161,7 @@ func createLanguageScopedPages(t *testing.T, q *store.Queries, slug string) (eng
 	french, err = q.CreatePage(ctx, store.CreatePageParams{
 		Title: "French", Slug: slug, Body: "b", Status: "published",
 		AuthorID: author.ID, LanguageCode: "fr", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage: %v", err)
@@ -395,7 +395,8 @@ func (m *Manager) GetTranslationsBatch(ctx context.Context, entityType string, e
 	return m.Translation.GetBatch(ctx, entityType, entityIDs)
 }
 
-// GetPublishedPageBySlug is a convenience method to get a published page by slug.
+// GetPublishedPageBySlug is a convenience method to get a published page by slug
+// on the site of ctx.
 // The cacheCtx provides language and role context for cache key generation.
 func (m *Manager) GetPublishedPageBySlug(ctx context.Context, cacheCtx Context, slug string) (*store.Page, error) {
 	return m.Page.GetBySlug(ctx, cacheCtx, slug)
@@ -13,7 +13,8 @@ import (
 )
 
 // MenuWithItems represents a menu with its items for caching.
-// Items includes page data (title, slug) from JOIN for URL building.
+// Items includes page data (title, slug) from JOIN for URL building, and each
+// item's type, link target, visibility rules and mega menu settings.
 type MenuWithItems struct {
 	Menu  store.Menu
 	Items []store.ListMenuItemsWithPageRow
@@ -135,6 +136,11 @@ func (c *MenuCache) loadAll(ctx context.Context) error {
 	c.menus = make(map[string]*MenuWithItems, len(menus))
 
 	for _, menu := range menus {
+		// Slugs are unique per site; the cache serves the default site's
+		// menus, other sites read theirs from the database.
+		if menu.SiteID != store.DefaultSiteID {
+			continue
+		}
 		// Use ListMenuItemsWithPage to include page slugs for URL building
 		items, err := c.queries.ListMenuItemsWithPage(ctx, menu.ID)
 		if err != nil {
@@ -15,14 +15,14 @@ import (
 )
 
 // PageCache provides cached access to published pages.
-// Pages are cached by context-aware keys: {lang}:{role}:{slug} or {lang}:{role}:{id}
+// Pages are cached by context-aware keys: {site}:{lang}:{role}:{slug} or {lang}:{role}:{id}
 type PageCache struct {
 	cache   *SimpleCache
 	queries *store.Queries
 	mu      sync.RWMutex
 
 	// Caches for different lookup patterns (context-aware keys)
-	bySlug map[string]*store.Page // "en:anonymous:about-us" -> page
+	bySlug map[string]*store.Page // "1:en:anonymous:about-us" -> page
 	byID   map[string]*store.Page // "en:anonymous:123" -> page
 
 	// Reverse index: page ID -> list of cache keys (for invalidation)
@@ -41,11 +41,12 @@ func NewPageCache(queries *store.Queries) *PageCache {
 	}
 }
 
-// GetBySlug retrieves a published page by slug within the context's language.
-// Returns the page if found in cache or database, nil if not found.
+// GetBySlug retrieves a published page by slug within the context's language
+// on the site of ctx. Returns the page if found in cache or database, nil if
+// not found.
 //
-// The miss path is scoped to cacheCtx.LanguageCode, not a global slug lookup.
-// Slugs are unique across the whole site rather than per language, so the
+// The miss path is scoped to cacheCtx.LanguageCode, not a site-wide slug lookup.
+// Slugs are unique per site rather than per language, so the
 // global form let a page belonging to one language answer a request routed to
 // another — and then cached it under the requesting language's key, which is
 // the boundary the frontend router exists to hold. The frontend stopped using
@@ -54,7 +55,7 @@ func (c *PageCache) GetBySlug(ctx context.Context, cacheCtx Context, slug string
 	if cacheCtx.LanguageCode == "" {
 		return nil, errors.New("page cache lookup requires a language")
 	}
-	key := cacheCtx.PageKey(slug)
+	key := pageSlugKey(store.SiteIDFromContext(ctx), cacheCtx, slug)
 
 	c.mu.RLock()
 	if page, ok := c.bySlug[key]; ok {
@@ -70,6 +71,7 @@ func (c *PageCache) GetBySlug(ctx context.Context, cacheCtx Context, slug string
 	page, err := c.queries.GetPublishedPageBySlugAndLanguage(ctx, store.GetPublishedPageBySlugAndLanguageParams{
 		Slug:         slug,
 		LanguageCode: cacheCtx.LanguageCode,
+		SiteID:       store.SiteIDFromContext(ctx),
 	})
 	if err != nil {
 		return nil, err // Return error (including sql.ErrNoRows) to caller
@@ -108,16 +110,22 @@ func (c *PageCache) GetByID(ctx context.Context, cacheCtx Context, id int64) (*s
 	return &page, nil
 }
 
+// pageSlugKey prefixes the context-aware slug key with the site, as two sites
+// may each have a page at the same slug.
+func pageSlugKey(siteID int64, cacheCtx Context, slug string) string {
+	return fmt.Sprintf("%d:%s", siteID, cacheCtx.PageKey(slug))
+}
+
 // store adds a page to caches with context-aware keys.
 //
-// The slug key carries the page's own language rather than the caller's. A
+// The slug key carries the page's own site and language rather than the caller's. A
 // page fetched by ID may belong to a different language than the request that
 // fetched it, and filing it under the caller's language would let a later slug
 // lookup in that language answer with a page the router would never route
 // there. The ID key keeps the caller's context: an ID names one page whatever
 // language asked for it.
 func (c *PageCache) store(page *store.Page, cacheCtx Context) {
-	slugKey := Context{LanguageCode: page.LanguageCode, Role: cacheCtx.Role}.PageKey(page.Slug)
+	slugKey := pageSlugKey(page.SiteID, Context{LanguageCode: page.LanguageCode, Role: cacheCtx.Role}, page.Slug)
 	idKey := cacheCtx.PageIDKey(page.ID)
 
 	c.mu.Lock()
@@ -154,19 +162,19 @@ func (c *PageCache) InvalidatePage(pageID int64) {
 }
 
 // InvalidateBySlug removes all cached variants of a page by slug pattern.
-// This clears all language/role variants for pages with matching slugs.
+// This clears all site/language/role variants for pages with matching slugs.
 func (c *PageCache) InvalidateBySlug(slug string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// Find all keys that end with the slug
 	var keysToDelete []string
-	var pageID int64
+	pageIDs := make(map[int64]bool)
 
 	for key, page := range c.bySlug {
 		if strings.HasSuffix(key, ":"+slug) {
 			keysToDelete = append(keysToDelete, key)
-			pageID = page.ID
+			pageIDs[page.ID] = true
 		}
 	}
 
@@ -175,8 +183,9 @@ func (c *PageCache) InvalidateBySlug(slug string) {
 		delete(c.bySlug, key)
 	}
 
-	// Also delete corresponding byID entries
-	if pageID > 0 {
+	// Also delete corresponding byID entries; pages of different sites may
+	// share the slug
+	for pageID := range pageIDs {
 		idSuffix := fmt.Sprintf(":%d", pageID)
 		for key := range c.byID {
 			if strings.HasSuffix(key, idSuffix) {
@@ -14,18 +14,23 @@ import (
 	"github.com/olegiv/ocms-go/internal/util"
 )
 
-// SitemapCache provides cached sitemap XML generation.
-// The sitemap is regenerated when invalidated or when TTL expires.
+// SitemapCache provides cached sitemap XML generation, one sitemap per site.
+// A sitemap is regenerated when invalidated or when TTL expires.
 type SitemapCache struct {
 	cache   *SimpleCache
 	queries *store.Queries
 	mu      sync.RWMutex
 
-	// Cached sitemap data
-	xml           []byte
-	cachedAt      time.Time
-	cachedSiteURL string
-	ttl           time.Duration
+	// Cached sitemap data by site ID
+	entries map[int64]sitemapEntry
+	ttl     time.Duration
+}
+
+// sitemapEntry is the cached sitemap of one site.
+type sitemapEntry struct {
+	xml      []byte
+	cachedAt time.Time
+	siteURL  string
 }
 
 // NewSitemapCache creates a new sitemap cache.
@@ -37,34 +42,41 @@ func NewSitemapCache(queries *store.Queries, ttl time.Duration) *SitemapCache {
 	return &SitemapCache{
 		cache:   New(ttl),
 		queries: queries,
+		entries: make(map[int64]sitemapEntry),
 		ttl:     ttl,
 	}
 }
 
-// Get returns the cached sitemap XML, generating it if needed.
+// Get returns the cached sitemap XML of the site ctx is scoped to,
+// generating it if needed.
 func (c *SitemapCache) Get(ctx context.Context, siteURL string) ([]byte, error) {
+	siteID := store.SiteIDFromContext(ctx)
 	c.mu.RLock()
-	if c.xml != nil && c.cachedSiteURL == siteURL && time.Since(c.cachedAt) < c.ttl {
-		xml := c.xml
+	if entry, ok := c.entries[siteID]; ok && c.fresh(entry, siteURL) {
 		c.mu.RUnlock()
 		c.cache.hits.Add(1)
-		return xml, nil
+		return entry.xml, nil
 	}
 	c.mu.RUnlock()
 
 	// Need to regenerate
-	return c.regenerate(ctx, siteURL)
+	return c.regenerate(ctx, siteID, siteURL)
+}
+
+// fresh reports whether a cached entry can answer a request for siteURL.
+func (c *SitemapCache) fresh(entry sitemapEntry, siteURL string) bool {
+	return entry.siteURL == siteURL && time.Since(entry.cachedAt) < c.ttl
 }
 
-// regenerate generates the sitemap and caches it.
-func (c *SitemapCache) regenerate(ctx context.Context, siteURL string) ([]byte, error) {
+// regenerate generates the sitemap of a site and caches it.
+func (c *SitemapCache) regenerate(ctx context.Context, siteID int64, siteURL string) ([]byte, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// Double-check after acquiring write lock
-	if c.xml != nil && c.cachedSiteURL == siteURL && time.Since(c.cachedAt) < c.ttl {
+	if entry, ok := c.entries[siteID]; ok && c.fresh(entry, siteURL) {
 		c.cache.hits.Add(1)
-		return c.xml, nil
+		return entry.xml, nil
 	}
 
 	c.cache.misses.Add(1)
@@ -79,6 +91,12 @@ func (c *SitemapCache) regenerate(ctx context.Context, siteURL string) ([]byte,
 	if err != nil {
 		return nil, fmt.Errorf("list active languages for sitemap: %w", err)
 	}
+	// Language hosts serve the default site only.
+	if siteID == store.DefaultSiteID {
+		for _, language := range activeLanguages {
+			builder.SetLanguageHost(language.Code, language.Host)
+		}
+	}
 	for _, language := range activeLanguages {
 		if !util.IsValidLangCode(language.Code) || util.IsReservedLanguageCode(language.Code) {
 			continue
@@ -87,11 +105,15 @@ func (c *SitemapCache) regenerate(ctx context.Context, siteURL string) ([]byte,
 	}
 
 	// Add published pages (excluding noindex pages)
-	pages, err := c.queries.ListPublishedPagesForSitemap(ctx)
+	pages, err := c.queries.ListPublishedPagesForSitemap(ctx, siteID)
 	if err == nil {
 		for _, p := range pages {
+			path, pathErr := c.queries.GetPagePath(ctx, p.ID)
+			if pathErr != nil {
+				path = p.Slug
+			}
 			builder.AddPage(seo.SitemapPage{
-				Slug:         p.Slug,
+				Slug:         path,
 				LanguageCode: p.LanguageCode,
 				IsDefault:    p.IsDefault,
 				UpdatedAt:    p.UpdatedAt,
@@ -100,7 +122,7 @@ func (c *SitemapCache) regenerate(ctx context.Context, siteURL string) ([]byte,
 	}
 
 	// Add categories
-	categories, err := c.queries.ListCategoriesForSitemap(ctx)
+	categories, err := c.queries.ListCategoriesForSitemap(ctx, siteID)
 	if err == nil {
 		for _, cat := range categories {
 			builder.AddCategory(seo.SitemapCategory{
@@ -113,7 +135,7 @@ func (c *SitemapCache) regenerate(ctx context.Context, siteURL string) ([]byte,
 	}
 
 	// Add tags
-	tags, err := c.queries.ListTagsForSitemap(ctx)
+	tags, err := c.queries.ListTagsForSitemap(ctx, siteID)
 	if err == nil {
 		for _, t := range tags {
 			builder.AddTag(seo.SitemapTag{
@@ -125,6 +147,14 @@ func (c *SitemapCache) regenerate(ctx context.Context, siteURL string) ([]byte,
 		}
 	}
 
+	// Add blog date archives
+	posts, err := c.queries.ListPostDatesForSitemap(ctx, siteID)
+	if err == nil {
+		for _, archive := range postArchives(posts) {
+			builder.AddArchive(archive)
+		}
+	}
+
 	// Generate XML
 	xml, err := builder.Build()
 	if err != nil {
@@ -132,21 +162,57 @@ func (c *SitemapCache) regenerate(ctx context.Context, siteURL string) ([]byte,
 	}
 
 	// Cache it
-	c.xml = xml
-	c.cachedAt = time.Now()
-	c.cachedSiteURL = siteURL
+	c.entries[siteID] = sitemapEntry{xml: xml, cachedAt: time.Now(), siteURL: siteURL}
 	c.cache.sets.Add(1)
 
 	return xml, nil
 }
 
-// Invalidate clears the cached sitemap and resets statistics, forcing regeneration on next request.
+// postArchives returns the yearly and monthly archives of posts, each dated
+// by its latest post update, newest first.
+func postArchives(posts []store.ListPostDatesForSitemapRow) []seo.SitemapArchive {
+	type archiveKey struct {
+		lang        string
+		year, month int
+	}
+	var keys []archiveKey
+	archives := make(map[archiveKey]seo.SitemapArchive)
+	add := func(key archiveKey, post store.ListPostDatesForSitemapRow) {
+		archive, ok := archives[key]
+		if !ok {
+			keys = append(keys, key)
+			archive = seo.SitemapArchive{
+				Year:         key.year,
+				Month:        key.month,
+				LanguageCode: key.lang,
+				IsDefault:    post.IsDefault,
+			}
+		}
+		if post.UpdatedAt.After(archive.UpdatedAt) {
+			archive.UpdatedAt = post.UpdatedAt
+		}
+		archives[key] = archive
+	}
+	for _, p := range posts {
+		if !p.PublishedAt.Valid {
+			continue
+		}
+		published := p.PublishedAt.Time
+		add(archiveKey{lang: p.LanguageCode, year: published.Year()}, p)
+		add(archiveKey{lang: p.LanguageCode, year: published.Year(), month: int(published.Month())}, p)
+	}
+	result := make([]seo.SitemapArchive, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, archives[key])
+	}
+	return result
+}
+
+// Invalidate clears the cached sitemaps and resets statistics, forcing regeneration on next request.
 func (c *SitemapCache) Invalidate() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	c.xml = nil
-	c.cachedAt = time.Time{}
-	c.cachedSiteURL = ""
+	c.entries = make(map[int64]sitemapEntry)
 	c.cache.ResetStats()
 }
 
@@ -154,9 +220,7 @@ func (c *SitemapCache) Invalidate() {
 func (c *SitemapCache) Stats() Stats {
 	stats := c.cache.Stats()
 	c.mu.RLock()
-	if c.xml != nil {
-		stats.Items = 1
-	}
+	stats.Items = len(c.entries)
 	c.mu.RUnlock()
 	return stats
 }
@@ -166,23 +230,38 @@ func (c *SitemapCache) ResetStats() {
 	c.cache.ResetStats()
 }
 
-// IsCached returns true if the sitemap is currently cached.
+// IsCached returns true if the sitemap of any site is currently cached.
 func (c *SitemapCache) IsCached() bool {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	return c.xml != nil && time.Since(c.cachedAt) < c.ttl
+	for _, entry := range c.entries {
+		if time.Since(entry.cachedAt) < c.ttl {
+			return true
+		}
+	}
+	return false
 }
 
-// CachedAt returns when the sitemap was last cached.
+// CachedAt returns when a sitemap was last cached.
 func (c *SitemapCache) CachedAt() time.Time {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	return c.cachedAt
+	var latest time.Time
+	for _, entry := range c.entries {
+		if entry.cachedAt.After(latest) {
+			latest = entry.cachedAt
+		}
+	}
+	return latest
 }
 
-// Size returns the size of the cached sitemap in bytes.
+// Size returns the total size of the cached sitemaps in bytes.
 func (c *SitemapCache) Size() int {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	return len(c.xml)
+	size := 0
+	for _, entry := range c.entries {
+		size += len(entry.xml)
+	}
+	return size
 }
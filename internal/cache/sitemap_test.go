@@ -5,7 +5,9 @@ package cache
 
 import (
 	"context"
+	"database/sql"
 	"encoding/xml"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -119,6 +121,7 @@ func TestSitemapCacheLanguageCanonicalURLs(t *testing.T) {
 			LanguageCode: code,
 			CreatedAt:    now,
 			UpdatedAt:    now,
+			SiteID:       store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreatePage(%q): %v", code, err)
@@ -248,3 +251,67 @@ func TestSitemapCacheRejectsAmbiguousDefaultLanguages(t *testing.T) {
 		t.Fatal("Get succeeded with multiple default languages")
 	}
 }
+
+func TestSitemapCacheBlogArchives(t *testing.T) {
+	q := newTestDB(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	if _, err := q.CreateLanguage(ctx, store.CreateLanguageParams{
+		Code: "fr", Name: "French", NativeName: "Français", IsActive: true,
+		Direction: "ltr", CreatedAt: now, UpdatedAt: now,
+	}); err != nil {
+		t.Fatalf("CreateLanguage: %v", err)
+	}
+	author, err := q.CreateUser(ctx, store.CreateUserParams{
+		Email: "archives@example.com", PasswordHash: "hash", Role: "editor",
+		Name: "Archive Author", CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	for i, post := range []struct {
+		lang, pageType string
+		published      time.Time
+		exclude        int64
+	}{
+		{"en", "post", time.Date(2026, time.October, 3, 9, 0, 0, 0, time.UTC), 0},
+		{"en", "post", time.Date(2026, time.October, 20, 9, 0, 0, 0, time.UTC), 0},
+		{"en", "post", time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC), 1},
+		{"en", "page", time.Date(2024, time.May, 1, 9, 0, 0, 0, time.UTC), 0},
+		{"fr", "post", time.Date(2026, time.February, 1, 9, 0, 0, 0, time.UTC), 0},
+	} {
+		if _, err := q.CreatePage(ctx, store.CreatePageParams{
+			Title: fmt.Sprintf("Post %d", i), Slug: fmt.Sprintf("post-%d", i), Status: "published",
+			AuthorID: author.ID, LanguageCode: post.lang, PageType: post.pageType,
+			ExcludeFromLists: post.exclude, NoIndex: 1,
+			PublishedAt: sql.NullTime{Time: post.published, Valid: true},
+			CreatedAt:   now, UpdatedAt: now,
+			SiteID: store.DefaultSiteID,
+		}); err != nil {
+			t.Fatalf("CreatePage: %v", err)
+		}
+	}
+
+	content, err := NewSitemapCache(q, time.Hour).Get(ctx, "https://example.com")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	sitemap := string(content)
+	for _, loc := range []string{
+		"https://example.com/blog/2026",
+		"https://example.com/blog/2026/10",
+		"https://example.com/fr/blog/2026",
+		"https://example.com/fr/blog/2026/02",
+	} {
+		if strings.Count(sitemap, "<loc>"+loc+"</loc>") != 1 {
+			t.Errorf("sitemap lists %q %d times, want once", loc, strings.Count(sitemap, "<loc>"+loc+"</loc>"))
+		}
+	}
+	for _, loc := range []string{"/blog/2025", "/blog/2024"} {
+		if strings.Contains(sitemap, loc) {
+			t.Errorf("sitemap lists archive %q without listed posts", loc)
+		}
+	}
+}
@@ -6,11 +6,15 @@ package config
 import (
 	"fmt"
 	"log/slog"
+	"net/mail"
+	"net/url"
 	"os"
 	"slices"
 	"strings"
 
 	"github.com/caarlos0/env/v11"
+
+	"github.com/olegiv/ocms-go/internal/util"
 )
 
 // knownWeakSecrets contains default/example secrets that must be rejected in production.
@@ -31,7 +35,8 @@ type Config struct {
 	CustomDir     string `env:"OCMS_CUSTOM_DIR" envDefault:"./custom"`
 	UploadsDir    string `env:"OCMS_UPLOADS_DIR" envDefault:"./uploads"`
 	ActiveTheme   string `env:"OCMS_ACTIVE_THEME" envDefault:"default"`
-	SiteURL       string `env:"OCMS_SITE_URL"` // Full site URL written into site config on startup (overrides the admin setting)
+	SiteURL       string `env:"OCMS_SITE_URL"`      // Full site URL written into site config on startup (overrides the admin setting)
+	CookieDomain  string `env:"OCMS_COOKIE_DOMAIN"` // Parent domain for session and language cookies shared by language subdomains (e.g. example.com)
 
 	// Cache configuration
 	RedisURL     string `env:"OCMS_REDIS_URL"`                         // Optional Redis URL for distributed caching
@@ -47,6 +52,18 @@ type Config struct {
 	// GeoIP configuration
 	GeoIPDBPath string `env:"OCMS_GEOIP_DB_PATH"` // Path to GeoLite2-Country.mmdb file
 
+	// Outgoing email for administrator notifications
+	SMTPHost     string `env:"OCMS_SMTP_HOST"`                  // SMTP server; empty disables email notifications
+	SMTPPort     int    `env:"OCMS_SMTP_PORT" envDefault:"587"` // SMTP server port
+	SMTPUsername string `env:"OCMS_SMTP_USERNAME"`              // SMTP user; empty sends without authentication
+	SMTPPassword string `env:"OCMS_SMTP_PASSWORD"`              // SMTP password
+	SMTPFrom     string `env:"OCMS_SMTP_FROM"`                  // Sender address of notifications
+
+	// Machine translation of new translations
+	MTProvider  string `env:"OCMS_MT_PROVIDER"`   // Provider that pre-fills new translations: deepl, stub, or empty to disable
+	DeepLAPIKey string `env:"OCMS_DEEPL_API_KEY"` // DeepL authentication key
+	DeepLAPIURL string `env:"OCMS_DEEPL_API_URL"` // DeepL API base URL; empty uses the free plan API
+
 	// Reverse proxy configuration
 	TrustedProxies        string `env:"OCMS_TRUSTED_PROXIES"`                            // Comma-separated CIDRs/IPs of trusted reverse proxies
 	RequireTrustedProxies bool   `env:"OCMS_REQUIRE_TRUSTED_PROXIES" envDefault:"false"` // Reject startup in production when trusted proxies are not configured
@@ -120,6 +137,11 @@ func (c Config) GeoIPEnabled() bool {
 	return c.GeoIPDBPath != ""
 }
 
+// SMTPEnabled returns true if outgoing email is configured.
+func (c Config) SMTPEnabled() bool {
+	return c.SMTPHost != ""
+}
+
 // MinSessionSecretLength is the minimum required length for the session secret.
 // AES-256 requires 32 bytes minimum for secure encryption.
 const MinSessionSecretLength = 32
@@ -192,6 +214,40 @@ func Load() (*Config, error) {
 	if cfg.Env == "production" && cfg.RequireWebhookFormDataMinimization && cfg.WebhookFormDataMode == "full" {
 		return nil, fmt.Errorf("OCMS_WEBHOOK_FORM_DATA_MODE=full is not allowed in production when OCMS_REQUIRE_WEBHOOK_FORM_DATA_MINIMIZATION is enabled")
 	}
+	if cfg.CookieDomain != "" {
+		domain, err := util.NormalizeHost(strings.TrimPrefix(strings.TrimSpace(cfg.CookieDomain), "."))
+		if err != nil || strings.Contains(domain, ":") {
+			return nil, fmt.Errorf("OCMS_COOKIE_DOMAIN must be a domain name without scheme or port, e.g. example.com")
+		}
+		cfg.CookieDomain = domain
+	}
+	if cfg.SMTPHost != "" {
+		if _, err := mail.ParseAddress(cfg.SMTPFrom); err != nil {
+			return nil, fmt.Errorf("OCMS_SMTP_FROM must be a valid email address when OCMS_SMTP_HOST is set")
+		}
+		if cfg.SMTPPort <= 0 || cfg.SMTPPort > 65535 {
+			return nil, fmt.Errorf("OCMS_SMTP_PORT must be between 1 and 65535")
+		}
+	}
+	cfg.MTProvider = strings.ToLower(strings.TrimSpace(cfg.MTProvider))
+	switch cfg.MTProvider {
+	case "", "stub":
+	case "deepl":
+		if cfg.DeepLAPIKey == "" {
+			return nil, fmt.Errorf("OCMS_DEEPL_API_KEY must be configured when OCMS_MT_PROVIDER=deepl")
+		}
+	default:
+		return nil, fmt.Errorf("OCMS_MT_PROVIDER must be one of: deepl, stub")
+	}
+	if cfg.DeepLAPIURL != "" {
+		u, err := url.Parse(cfg.DeepLAPIURL)
+		if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+			return nil, fmt.Errorf("OCMS_DEEPL_API_URL must be an absolute http or https URL")
+		}
+		if cfg.RequireHTTPSOutbound && u.Scheme != "https" {
+			return nil, fmt.Errorf("OCMS_DEEPL_API_URL must use https when OCMS_REQUIRE_HTTPS_OUTBOUND is enabled")
+		}
+	}
 	if cfg.APIMaxTTLDays < 0 {
 		return nil, fmt.Errorf("OCMS_API_KEY_MAX_TTL_DAYS must be >= 0")
 	}
@@ -5,6 +5,7 @@ package config
 
 import (
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -567,6 +568,66 @@ func TestLoad_InvalidWebhookFormDataMode(t *testing.T) {
 	}
 }
 
+func TestLoad_MachineTranslation(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr bool
+	}{
+		{"disabled", nil, false},
+		{"stub", map[string]string{"OCMS_MT_PROVIDER": "Stub"}, false},
+		{"deepl", map[string]string{"OCMS_MT_PROVIDER": "deepl", "OCMS_DEEPL_API_KEY": "key", "OCMS_DEEPL_API_URL": "https://api.deepl.com"}, false},
+		{"deepl without key", map[string]string{"OCMS_MT_PROVIDER": "deepl"}, true},
+		{"unknown provider", map[string]string{"OCMS_MT_PROVIDER": "google"}, true},
+		{"invalid url", map[string]string{"OCMS_MT_PROVIDER": "deepl", "OCMS_DEEPL_API_KEY": "key", "OCMS_DEEPL_API_URL": "api.deepl.com"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Clearenv()
+			setEnv(t, "OCMS_SESSION_SECRET", "test-secret-key-32-bytes-long!!!")
+			for k, v := range tt.env {
+				setEnv(t, k, v)
+			}
+			cfg, err := Load()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && cfg.MTProvider != strings.ToLower(tt.env["OCMS_MT_PROVIDER"]) {
+				t.Errorf("MTProvider = %q, want %q", cfg.MTProvider, strings.ToLower(tt.env["OCMS_MT_PROVIDER"]))
+			}
+		})
+	}
+}
+
+func TestLoad_SMTP(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr bool
+	}{
+		{"disabled", nil, false},
+		{"configured", map[string]string{"OCMS_SMTP_HOST": "smtp.example.com", "OCMS_SMTP_FROM": "ocms@example.com"}, false},
+		{"without sender", map[string]string{"OCMS_SMTP_HOST": "smtp.example.com"}, true},
+		{"invalid port", map[string]string{"OCMS_SMTP_HOST": "smtp.example.com", "OCMS_SMTP_FROM": "ocms@example.com", "OCMS_SMTP_PORT": "70000"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Clearenv()
+			setEnv(t, "OCMS_SESSION_SECRET", "test-secret-key-32-bytes-long!!!")
+			for k, v := range tt.env {
+				setEnv(t, k, v)
+			}
+			cfg, err := Load()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && cfg.SMTPEnabled() != (tt.env["OCMS_SMTP_HOST"] != "") {
+				t.Errorf("SMTPEnabled() = %v", cfg.SMTPEnabled())
+			}
+		})
+	}
+}
+
 func TestLoad_InvalidAPIMaxTTLDays(t *testing.T) {
 	os.Clearenv()
 	setEnv(t, "OCMS_SESSION_SECRET", "test-secret-key-32-bytes-long!!!")
@@ -25,6 +25,8 @@ var secretConfigFields = map[string]bool{
 	"SessionSecret":     true,
 	"HCaptchaSecretKey": true,
 	"EmbedProxyToken":   true,
+	"DeepLAPIKey":       true,
+	"SMTPPassword":      true,
 }
 
 // nonSecretConfigFields are fields whose names match secretNamePattern but hold
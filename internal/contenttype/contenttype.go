@@ -0,0 +1,209 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package contenttype defines admin-configurable content types: named field
+// schemas that pages can be assigned to through their page_type, together
+// with validation and normalization of the field values stored per page.
+package contenttype
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Field types.
+const (
+	FieldText     = "text"
+	FieldRichText = "rich_text"
+	FieldNumber   = "number"
+	FieldDate     = "date"
+	FieldMedia    = "media"
+	FieldPage     = "page"
+	FieldRepeater = "repeater"
+	FieldSelect   = "select"
+)
+
+// Schema limits.
+const (
+	// MaxFields caps the number of fields in a schema (and in a repeater).
+	MaxFields = 50
+	// MaxSelectOptions caps the number of options of a select field.
+	MaxSelectOptions = 100
+	// MaxRepeaterItems is the default and upper limit of repeater rows.
+	MaxRepeaterItems = 100
+	// MaxTextLength is the default and upper limit of text field values.
+	MaxTextLength = 10000
+	// maxRichTextLength caps rich text field values.
+	maxRichTextLength = 200000
+	// maxLabelLength caps field labels and help texts.
+	maxLabelLength = 255
+)
+
+// DateFormat is the storage format of date field values.
+const DateFormat = "2006-01-02"
+
+// fieldNamePattern restricts field names to identifiers usable as template
+// map keys and form names.
+var fieldNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// builtinPageTypes are the page types that exist without a content type.
+var builtinPageTypes = []string{"post", "page"}
+
+// Field describes one field of a content type schema.
+type Field struct {
+	Name      string   `json:"name"`
+	Label     string   `json:"label"`
+	Type      string   `json:"type"`
+	Required  bool     `json:"required,omitempty"`
+	Help      string   `json:"help,omitempty"`
+	Options   []string `json:"options,omitempty"`    // select
+	MaxLength int      `json:"max_length,omitempty"` // text
+	Min       *float64 `json:"min,omitempty"`        // number
+	Max       *float64 `json:"max,omitempty"`        // number
+	MaxItems  int      `json:"max_items,omitempty"`  // repeater
+	Fields    []Field  `json:"fields,omitempty"`     // repeater sub-fields
+}
+
+// FieldTypes returns all supported field types in display order.
+func FieldTypes() []string {
+	return []string{FieldText, FieldRichText, FieldNumber, FieldDate, FieldMedia, FieldPage, FieldSelect, FieldRepeater}
+}
+
+// IsValidFieldType reports whether t is a supported field type.
+func IsValidFieldType(t string) bool {
+	return slices.Contains(FieldTypes(), t)
+}
+
+// IsBuiltinPageType reports whether slug is one of the built-in page types
+// ("post", "page"), which cannot be used as content type slugs.
+func IsBuiltinPageType(slug string) bool {
+	return slices.Contains(builtinPageTypes, slug)
+}
+
+// ParseFields decodes a stored JSON schema. Invalid JSON yields no fields so a
+// damaged row never breaks page rendering.
+func ParseFields(raw string) []Field {
+	var fields []Field
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(raw), &fields); err != nil {
+		return nil
+	}
+	return fields
+}
+
+// DecodeFields decodes a JSON schema submitted by an administrator and
+// validates it.
+func DecodeFields(raw string) ([]Field, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return []Field{}, nil
+	}
+	var fields []Field
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if err := ValidateFields(fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// FieldsJSON encodes a schema for storage.
+func FieldsJSON(fields []Field) string {
+	if len(fields) == 0 {
+		return "[]"
+	}
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return "[]"
+	}
+	return string(b)
+}
+
+// ValidateFields checks a schema: unique identifier-like names, known types,
+// options for select fields and sub-fields for repeaters. Repeaters cannot be
+// nested.
+func ValidateFields(fields []Field) error {
+	return validateFields(fields, false)
+}
+
+func validateFields(fields []Field, nested bool) error {
+	if len(fields) > MaxFields {
+		return fmt.Errorf("a schema can have at most %d fields", MaxFields)
+	}
+	seen := make(map[string]bool, len(fields))
+	for i, f := range fields {
+		if !fieldNamePattern.MatchString(f.Name) {
+			return fmt.Errorf("field %d: name %q must start with a letter and contain only lowercase letters, digits and underscores", i+1, f.Name)
+		}
+		if seen[f.Name] {
+			return fmt.Errorf("field %q is defined more than once", f.Name)
+		}
+		seen[f.Name] = true
+
+		if strings.TrimSpace(f.Label) == "" {
+			return fmt.Errorf("field %q: label is required", f.Name)
+		}
+		if len(f.Label) > maxLabelLength || len(f.Help) > maxLabelLength {
+			return fmt.Errorf("field %q: label and help must be at most %d characters", f.Name, maxLabelLength)
+		}
+		if !IsValidFieldType(f.Type) {
+			return fmt.Errorf("field %q: unknown type %q", f.Name, f.Type)
+		}
+		if err := validateFieldOptions(f, nested); err != nil {
+			return fmt.Errorf("field %q: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// validateFieldOptions checks the type-specific settings of a field.
+func validateFieldOptions(f Field, nested bool) error {
+	if f.Type != FieldRepeater && len(f.Fields) > 0 {
+		return fmt.Errorf("only repeater fields can have sub-fields")
+	}
+	if f.Type != FieldSelect && len(f.Options) > 0 {
+		return fmt.Errorf("only select fields can have options")
+	}
+
+	switch f.Type {
+	case FieldText:
+		if f.MaxLength < 0 || f.MaxLength > MaxTextLength {
+			return fmt.Errorf("max_length must be between 0 and %d", MaxTextLength)
+		}
+	case FieldNumber:
+		if f.Min != nil && f.Max != nil && *f.Min > *f.Max {
+			return fmt.Errorf("min must not be greater than max")
+		}
+	case FieldSelect:
+		if len(f.Options) == 0 || len(f.Options) > MaxSelectOptions {
+			return fmt.Errorf("select fields need between 1 and %d options", MaxSelectOptions)
+		}
+		seen := make(map[string]bool, len(f.Options))
+		for _, opt := range f.Options {
+			if strings.TrimSpace(opt) == "" || seen[opt] {
+				return fmt.Errorf("options must be non-empty and unique")
+			}
+			seen[opt] = true
+		}
+	case FieldRepeater:
+		if nested {
+			return fmt.Errorf("repeaters cannot be nested")
+		}
+		if len(f.Fields) == 0 {
+			return fmt.Errorf("repeater fields need at least one sub-field")
+		}
+		if f.MaxItems < 0 || f.MaxItems > MaxRepeaterItems {
+			return fmt.Errorf("max_items must be between 0 and %d", MaxRepeaterItems)
+		}
+		return validateFields(f.Fields, true)
+	}
+	return nil
+}
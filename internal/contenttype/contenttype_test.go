@@ -0,0 +1,160 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package contenttype
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecodeFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		wantErr string
+	}{
+		{name: "empty", raw: "", wantErr: ""},
+		{name: "valid", raw: `[{"name":"venue","label":"Venue","type":"text"}]`, wantErr: ""},
+		{name: "unknown key", raw: `[{"name":"venue","label":"Venue","type":"text","colour":"red"}]`, wantErr: "unknown field"},
+		{name: "bad name", raw: `[{"name":"Venue","label":"Venue","type":"text"}]`, wantErr: "must start with a letter"},
+		{name: "duplicate", raw: `[{"name":"a","label":"A","type":"text"},{"name":"a","label":"A","type":"text"}]`, wantErr: "more than once"},
+		{name: "unknown type", raw: `[{"name":"a","label":"A","type":"color"}]`, wantErr: "unknown type"},
+		{name: "select without options", raw: `[{"name":"a","label":"A","type":"select"}]`, wantErr: "options"},
+		{name: "options on text", raw: `[{"name":"a","label":"A","type":"text","options":["x"]}]`, wantErr: "only select"},
+		{name: "min above max", raw: `[{"name":"a","label":"A","type":"number","min":5,"max":1}]`, wantErr: "min must not"},
+		{name: "empty repeater", raw: `[{"name":"a","label":"A","type":"repeater"}]`, wantErr: "sub-field"},
+		{name: "nested repeater", raw: `[{"name":"a","label":"A","type":"repeater","fields":[{"name":"b","label":"B","type":"repeater","fields":[{"name":"c","label":"C","type":"text"}]}]}]`, wantErr: "cannot be nested"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeFields(tt.raw)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("DecodeFields() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("DecodeFields() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseFieldsIsLenient(t *testing.T) {
+	if got := ParseFields("not json"); len(got) != 0 {
+		t.Fatalf("ParseFields(invalid) = %v, want no fields", got)
+	}
+	fields := []Field{{Name: "a", Label: "A", Type: FieldText}}
+	if got := ParseFields(FieldsJSON(fields)); len(got) != 1 || got[0].Name != "a" {
+		t.Fatalf("ParseFields(FieldsJSON()) = %v, want the schema back", got)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	minSeats, maxSeats := 1.0, 500.0
+	fields := []Field{
+		{Name: "venue", Label: "Venue", Type: FieldText, Required: true, MaxLength: 10},
+		{Name: "seats", Label: "Seats", Type: FieldNumber, Min: &minSeats, Max: &maxSeats},
+		{Name: "day", Label: "Day", Type: FieldDate},
+		{Name: "kind", Label: "Kind", Type: FieldSelect, Options: []string{"talk", "workshop"}},
+		{Name: "poster", Label: "Poster", Type: FieldMedia},
+		{Name: "speakers", Label: "Speakers", Type: FieldRepeater, MaxItems: 2, Fields: []Field{
+			{Name: "name", Label: "Name", Type: FieldText, Required: true},
+		}},
+	}
+
+	values, errs := Normalize(fields, map[string]any{
+		"venue":    " Hall ",
+		"seats":    "120",
+		"day":      "2026-10-18",
+		"kind":     "talk",
+		"poster":   "7",
+		"speakers": []any{map[string]any{"name": "Ann"}, map[string]any{"name": ""}},
+		"unknown":  "dropped",
+	})
+	if len(errs) != 0 {
+		t.Fatalf("Normalize() errors = %v, want none", errs)
+	}
+	if values["venue"] != "Hall" || values["seats"] != 120.0 || values["poster"] != int64(7) {
+		t.Errorf("Normalize() values = %v", values)
+	}
+	if rows := Rows(values["speakers"]); len(rows) != 1 || rows[0]["name"] != "Ann" {
+		t.Errorf("speakers = %v, want the empty row dropped", values["speakers"])
+	}
+	if _, ok := values["unknown"]; ok {
+		t.Error("keys outside the schema must be dropped")
+	}
+
+	_, errs = Normalize(fields, map[string]any{
+		"seats":    "900",
+		"day":      "18/10/2026",
+		"kind":     "party",
+		"poster":   "abc",
+		"speakers": []any{map[string]any{"name": "A"}, map[string]any{"name": "B"}, map[string]any{"name": "C"}},
+	})
+	for _, name := range []string{"venue", "seats", "day", "kind", "poster", "speakers"} {
+		if errs[name] == "" {
+			t.Errorf("Normalize() errors = %v, want an error for %q", errs, name)
+		}
+	}
+}
+
+func TestFormInputCollectsRepeaterRows(t *testing.T) {
+	fields := []Field{
+		{Name: "venue", Label: "Venue", Type: FieldText},
+		{Name: "speakers", Label: "Speakers", Type: FieldRepeater, Fields: []Field{
+			{Name: "name", Label: "Name", Type: FieldText},
+		}},
+	}
+	form := url.Values{}
+	form.Set(InputName("venue"), "Hall")
+	form.Set(RowInputName("speakers", 5, "name"), "Bob")
+	form.Set(RowInputName("speakers", 1, "name"), "Ann")
+
+	input := FormInput(fields, form)
+	if input["venue"] != "Hall" {
+		t.Errorf("venue = %v, want Hall", input["venue"])
+	}
+	rows := Rows(input["speakers"])
+	if len(rows) != 2 || rows[0]["name"] != "Ann" || rows[1]["name"] != "Bob" {
+		t.Errorf("speakers = %v, want rows ordered by index", rows)
+	}
+}
+
+func TestMapReferencesAndDisplay(t *testing.T) {
+	fields := []Field{
+		{Name: "poster", Label: "Poster", Type: FieldMedia},
+		{Name: "day", Label: "Day", Type: FieldDate},
+		{Name: "links", Label: "Links", Type: FieldRepeater, Fields: []Field{
+			{Name: "page", Label: "Page", Type: FieldPage},
+		}},
+	}
+	values := ParseValues(`{"poster":3,"day":"2026-10-18","links":[{"page":4},{"page":5}]}`)
+
+	mapped := MapReferences(fields, values, func(f Field, v any) (any, bool) {
+		id, _ := ReferenceID(v)
+		return id * 10, id != 5
+	})
+	if mapped["poster"] != int64(30) {
+		t.Errorf("poster = %v, want 30", mapped["poster"])
+	}
+	rows := Rows(mapped["links"])
+	if len(rows) != 2 || rows[0]["page"] != int64(40) {
+		t.Errorf("links = %v, want the first reference mapped", rows)
+	}
+	if _, ok := rows[1]["page"]; ok {
+		t.Errorf("links = %v, want the rejected reference removed", rows)
+	}
+
+	display := Display(fields, values, func(f Field, id int64) any { return f.Type })
+	if display["poster"] != FieldMedia {
+		t.Errorf("poster = %v, want the resolved value", display["poster"])
+	}
+	if day, ok := display["day"].(time.Time); !ok || day.Day() != 18 {
+		t.Errorf("day = %v, want a time.Time", display["day"])
+	}
+}
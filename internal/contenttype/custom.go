@@ -0,0 +1,253 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package contenttype
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// CustomField is one typed key/value custom field attached to a page. Custom
+// fields work on every page, independent of its page type. Their names and
+// types either come from the custom_fields schema of the active theme or are
+// chosen by the editor (ad-hoc fields).
+type CustomField struct {
+	Name  string `json:"name"`
+	Type  string `json:"type,omitempty"`
+	Value any    `json:"value"`
+}
+
+// CustomFields is the ordered list of custom fields of a page: fields of the
+// theme schema first, in schema order, followed by ad-hoc fields.
+type CustomFields []CustomField
+
+// Custom field form input names: theme schema fields are submitted as
+// custom_fields[name], ad-hoc rows as custom_extra[row][name|type|value].
+const (
+	CustomFormPrefix      = "custom_fields"
+	CustomExtraFormPrefix = "custom_extra"
+)
+
+// customExtraInputPattern matches the form name of an ad-hoc custom field part.
+var customExtraInputPattern = regexp.MustCompile(`^` + CustomExtraFormPrefix + `\[(\d{1,4})\]\[(name|type|value)\]$`)
+
+// AdHocFieldTypes returns the field types editors can choose for ad-hoc
+// custom fields.
+func AdHocFieldTypes() []string {
+	return []string{FieldText, FieldNumber, FieldDate}
+}
+
+// ValidateCustomFieldSchema checks a theme custom field schema. It accepts the
+// content type schema format without repeaters.
+func ValidateCustomFieldSchema(fields []Field) error {
+	if err := ValidateFields(fields); err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if f.Type == FieldRepeater {
+			return fmt.Errorf("field %q: custom fields cannot be repeaters", f.Name)
+		}
+	}
+	return nil
+}
+
+// ParseCustomFields decodes stored custom fields. Invalid JSON yields no
+// fields.
+func ParseCustomFields(raw string) CustomFields {
+	var fields CustomFields
+	if strings.TrimSpace(raw) == "" {
+		return CustomFields{}
+	}
+	if err := json.Unmarshal([]byte(raw), &fields); err != nil {
+		return CustomFields{}
+	}
+	return fields
+}
+
+// JSON encodes the custom fields for storage.
+func (c CustomFields) JSON() string {
+	if len(c) == 0 {
+		return "[]"
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "[]"
+	}
+	return string(b)
+}
+
+// Get returns the custom field with the given name.
+func (c CustomFields) Get(name string) (CustomField, bool) {
+	for _, cf := range c {
+		if cf.Name == name {
+			return cf, true
+		}
+	}
+	return CustomField{}, false
+}
+
+// Equal reports whether both lists hold the same fields and values, compared
+// in their stored form.
+func (c CustomFields) Equal(other CustomFields) bool {
+	return c.JSON() == other.JSON()
+}
+
+// CustomInputName returns the form input name of a theme schema custom field.
+func CustomInputName(field string) string {
+	return CustomFormPrefix + "[" + field + "]"
+}
+
+// CustomExtraInputName returns the form input name of one part (name, type or
+// value) of an ad-hoc custom field row.
+func CustomExtraInputName(row int, part string) string {
+	return fmt.Sprintf("%s[%d][%s]", CustomExtraFormPrefix, row, part)
+}
+
+// CustomFormInput collects the submitted custom fields of the page editor in
+// the shape accepted by NormalizeCustomFields. Ad-hoc rows are ordered by row
+// index; rows without a name and value are ignored.
+func CustomFormInput(schema []Field, form url.Values) []CustomField {
+	input := make([]CustomField, 0, len(schema))
+	for _, f := range schema {
+		input = append(input, CustomField{Name: f.Name, Type: f.Type, Value: form.Get(CustomInputName(f.Name))})
+	}
+
+	rows := make(map[int]*CustomField)
+	for key, vals := range form {
+		m := customExtraInputPattern.FindStringSubmatch(key)
+		if m == nil || len(vals) == 0 {
+			continue
+		}
+		idx, _ := strconv.Atoi(m[1])
+		if rows[idx] == nil {
+			rows[idx] = &CustomField{}
+		}
+		switch m[2] {
+		case "name":
+			rows[idx].Name = strings.TrimSpace(vals[0])
+		case "type":
+			rows[idx].Type = vals[0]
+		case "value":
+			rows[idx].Value = vals[0]
+		}
+	}
+	indexes := make([]int, 0, len(rows))
+	for idx := range rows {
+		indexes = append(indexes, idx)
+	}
+	slices.Sort(indexes)
+	for _, idx := range indexes {
+		row := rows[idx]
+		if row.Name == "" && isEmptyInput(row.Value) {
+			continue
+		}
+		input = append(input, *row)
+	}
+	return input
+}
+
+// NormalizeCustomFields validates submitted custom fields and returns them in
+// storage form. Fields named like a schema field are validated against the
+// schema (an empty type means the schema type); other fields are ad-hoc and
+// need a valid name and one of AdHocFieldTypes. Empty optional values are
+// dropped. Errors are keyed by field name, or by CustomFormPrefix for entries
+// without a usable name.
+func NormalizeCustomFields(schema []Field, input []CustomField) (CustomFields, map[string]string) {
+	errs := make(map[string]string)
+	bySchema := make(map[string]CustomField, len(schema))
+	var extra []CustomField
+	seen := make(map[string]bool, len(input))
+
+	for _, in := range input {
+		if seen[in.Name] {
+			errs[in.Name] = fmt.Sprintf("%s is used more than once", in.Name)
+			continue
+		}
+		seen[in.Name] = true
+		if slices.ContainsFunc(schema, func(f Field) bool { return f.Name == in.Name }) {
+			bySchema[in.Name] = in
+			continue
+		}
+		extra = append(extra, in)
+	}
+
+	out := make(CustomFields, 0, len(input))
+	for _, f := range schema {
+		in := bySchema[f.Name]
+		if in.Type != "" && in.Type != f.Type {
+			errs[f.Name] = fmt.Sprintf("%s must be of type %s", f.Label, f.Type)
+			continue
+		}
+		v, msg := normalizeValue(f, in.Value)
+		if msg != "" {
+			errs[f.Name] = msg
+			continue
+		}
+		if v != nil {
+			out = append(out, CustomField{Name: f.Name, Type: f.Type, Value: v})
+		}
+	}
+
+	for _, in := range extra {
+		if !fieldNamePattern.MatchString(in.Name) {
+			errs[CustomFormPrefix] = fmt.Sprintf("custom field name %q must start with a letter and contain only lowercase letters, digits and underscores", in.Name)
+			continue
+		}
+		if !slices.Contains(AdHocFieldTypes(), in.Type) {
+			errs[in.Name] = fmt.Sprintf("%s has an unsupported type", in.Name)
+			continue
+		}
+		v, msg := normalizeValue(Field{Name: in.Name, Label: in.Name, Type: in.Type}, in.Value)
+		if msg != "" {
+			errs[in.Name] = msg
+			continue
+		}
+		if v != nil {
+			out = append(out, CustomField{Name: in.Name, Type: in.Type, Value: v})
+		}
+	}
+
+	if len(out) > MaxFields {
+		errs[CustomFormPrefix] = fmt.Sprintf("a page can have at most %d custom fields", MaxFields)
+	}
+	return out, errs
+}
+
+// MapCustomReferences returns a copy of the custom fields in which every media
+// and page reference is replaced by the result of fn. Fields for which fn
+// returns false are removed.
+func MapCustomReferences(c CustomFields, fn func(f Field, v any) (any, bool)) CustomFields {
+	out := make(CustomFields, 0, len(c))
+	for _, cf := range c {
+		if cf.Type == FieldMedia || cf.Type == FieldPage {
+			mapped, keep := fn(Field{Name: cf.Name, Label: cf.Name, Type: cf.Type}, cf.Value)
+			if !keep {
+				continue
+			}
+			cf.Value = mapped
+		}
+		out = append(out, cf)
+	}
+	return out
+}
+
+// DisplayCustom converts custom fields into template values keyed by name,
+// the same way Display converts content type fields. Every schema field is
+// present, with a nil value when empty.
+func DisplayCustom(schema []Field, c CustomFields, resolve func(f Field, id int64) any) map[string]any {
+	out := make(map[string]any, len(schema)+len(c))
+	for _, f := range schema {
+		out[f.Name] = nil
+	}
+	for _, cf := range c {
+		f := Field{Name: cf.Name, Label: cf.Name, Type: cf.Type}
+		out[cf.Name] = Display([]Field{f}, Values{cf.Name: cf.Value}, resolve)[cf.Name]
+	}
+	return out
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package contenttype
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestNormalizeCustomFields(t *testing.T) {
+	schema := []Field{
+		{Name: "subtitle", Label: "Subtitle", Type: FieldText, Required: true},
+		{Name: "mood", Label: "Mood", Type: FieldSelect, Options: []string{"calm", "loud"}},
+	}
+
+	fields, errs := NormalizeCustomFields(schema, []CustomField{
+		{Name: "rating", Type: FieldNumber, Value: "4.5"},
+		{Name: "subtitle", Value: " Hello "},
+		{Name: "empty", Type: FieldText, Value: ""},
+	})
+	if len(errs) != 0 {
+		t.Fatalf("NormalizeCustomFields() errors = %v, want none", errs)
+	}
+	want := CustomFields{
+		{Name: "subtitle", Type: FieldText, Value: "Hello"},
+		{Name: "rating", Type: FieldNumber, Value: 4.5},
+	}
+	if !fields.Equal(want) {
+		t.Errorf("NormalizeCustomFields() = %v, want %v", fields, want)
+	}
+
+	_, errs = NormalizeCustomFields(schema, []CustomField{
+		{Name: "mood", Type: FieldNumber, Value: "1"},
+		{Name: "Bad Name", Type: FieldText, Value: "x"},
+		{Name: "poster", Type: FieldMedia, Value: "7"},
+		{Name: "day", Type: FieldDate, Value: "tomorrow"},
+		{Name: "day", Type: FieldDate, Value: "2026-10-18"},
+	})
+	for _, key := range []string{"subtitle", "mood", CustomFormPrefix, "poster", "day"} {
+		if errs[key] == "" {
+			t.Errorf("NormalizeCustomFields() errors = %v, want an error for %q", errs, key)
+		}
+	}
+}
+
+func TestCustomFormInput(t *testing.T) {
+	schema := []Field{{Name: "subtitle", Label: "Subtitle", Type: FieldText}}
+	form := url.Values{}
+	form.Set(CustomInputName("subtitle"), "Hello")
+	form.Set(CustomExtraInputName(3, "name"), "second")
+	form.Set(CustomExtraInputName(3, "type"), FieldText)
+	form.Set(CustomExtraInputName(3, "value"), "b")
+	form.Set(CustomExtraInputName(1, "name"), "first")
+	form.Set(CustomExtraInputName(1, "type"), FieldNumber)
+	form.Set(CustomExtraInputName(1, "value"), "1")
+	form.Set(CustomExtraInputName(2, "type"), FieldText)
+
+	input := CustomFormInput(schema, form)
+	if len(input) != 3 {
+		t.Fatalf("CustomFormInput() = %v, want the schema field and two rows", input)
+	}
+	if input[0].Value != "Hello" || input[1].Name != "first" || input[2].Name != "second" {
+		t.Errorf("CustomFormInput() = %v, want rows ordered by index", input)
+	}
+}
+
+func TestCustomFieldsRoundTripAndDisplay(t *testing.T) {
+	stored := CustomFields{
+		{Name: "day", Type: FieldDate, Value: "2026-10-18"},
+		{Name: "poster", Type: FieldMedia, Value: int64(3)},
+	}
+	parsed := ParseCustomFields(stored.JSON())
+	if !parsed.Equal(stored) {
+		t.Fatalf("ParseCustomFields(JSON()) = %v, want %v", parsed, stored)
+	}
+	if got := ParseCustomFields("not json"); len(got) != 0 {
+		t.Errorf("ParseCustomFields(invalid) = %v, want none", got)
+	}
+
+	mapped := MapCustomReferences(parsed, func(f Field, v any) (any, bool) { return nil, false })
+	if _, ok := mapped.Get("poster"); ok || len(mapped) != 1 {
+		t.Errorf("MapCustomReferences() = %v, want the rejected reference removed", mapped)
+	}
+
+	schema := []Field{{Name: "subtitle", Label: "Subtitle", Type: FieldText}}
+	display := DisplayCustom(schema, parsed, func(f Field, id int64) any { return id })
+	if v, ok := display["subtitle"]; !ok || v != nil {
+		t.Errorf("subtitle = %v, want a nil schema field", v)
+	}
+	if day, ok := display["day"].(time.Time); !ok || day.Day() != 18 {
+		t.Errorf("day = %v, want a time.Time", display["day"])
+	}
+	if display["poster"] != int64(3) {
+		t.Errorf("poster = %v, want the resolved reference", display["poster"])
+	}
+}
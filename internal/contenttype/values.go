@@ -0,0 +1,460 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package contenttype
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"math"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/olegiv/ocms-go/internal/security"
+)
+
+// Values holds the field values of one page, keyed by field name. Stored
+// values are normalized: strings for text, rich_text, select and date fields,
+// float64 for numbers, int64 IDs for media and page references and
+// []map[string]any rows for repeaters. Empty optional values are omitted.
+type Values map[string]any
+
+// FormPrefix is the name prefix of content field form inputs: scalar fields
+// are submitted as fields[name], repeater sub-fields as fields[name][row][sub].
+const FormPrefix = "fields"
+
+// repeaterInputPattern matches the form name of a repeater sub-field.
+var repeaterInputPattern = regexp.MustCompile(`^` + FormPrefix + `\[([a-z][a-z0-9_]*)\]\[(\d{1,4})\]\[([a-z][a-z0-9_]*)\]$`)
+
+// ParseValues decodes stored field values. Invalid JSON yields no values.
+func ParseValues(raw string) Values {
+	v := make(Values)
+	if strings.TrimSpace(raw) == "" {
+		return v
+	}
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return make(Values)
+	}
+	return v
+}
+
+// JSON encodes the values for storage.
+func (v Values) JSON() string {
+	if len(v) == 0 {
+		return "{}"
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
+
+// InputName returns the form input name of a top-level field.
+func InputName(field string) string {
+	return FormPrefix + "[" + field + "]"
+}
+
+// RowInputName returns the form input name of a repeater sub-field.
+func RowInputName(field string, row int, sub string) string {
+	return fmt.Sprintf("%s[%s][%d][%s]", FormPrefix, field, row, sub)
+}
+
+// FormInput collects the raw values of the given fields from a submitted
+// form, in the shape accepted by Normalize.
+func FormInput(fields []Field, form url.Values) map[string]any {
+	input := make(map[string]any, len(fields))
+	rows := make(map[string]map[int]map[string]any)
+
+	for key, vals := range form {
+		m := repeaterInputPattern.FindStringSubmatch(key)
+		if m == nil || len(vals) == 0 {
+			continue
+		}
+		idx, _ := strconv.Atoi(m[2])
+		if rows[m[1]] == nil {
+			rows[m[1]] = make(map[int]map[string]any)
+		}
+		if rows[m[1]][idx] == nil {
+			rows[m[1]][idx] = make(map[string]any)
+		}
+		rows[m[1]][idx][m[3]] = vals[0]
+	}
+
+	for _, f := range fields {
+		if f.Type != FieldRepeater {
+			input[f.Name] = form.Get(InputName(f.Name))
+			continue
+		}
+		byIndex := rows[f.Name]
+		indexes := make([]int, 0, len(byIndex))
+		for idx := range byIndex {
+			indexes = append(indexes, idx)
+		}
+		slices.Sort(indexes)
+		items := make([]any, 0, len(indexes))
+		for _, idx := range indexes {
+			items = append(items, byIndex[idx])
+		}
+		input[f.Name] = items
+	}
+	return input
+}
+
+// Normalize validates input against the schema and returns the normalized
+// values. Errors are keyed by top-level field name. Keys in input that are not
+// part of the schema are dropped.
+func Normalize(fields []Field, input map[string]any) (Values, map[string]string) {
+	values := make(Values)
+	errs := make(map[string]string)
+
+	for _, f := range fields {
+		if f.Type == FieldRepeater {
+			rows, err := normalizeRepeater(f, input[f.Name])
+			if err != "" {
+				errs[f.Name] = err
+				continue
+			}
+			if len(rows) > 0 {
+				values[f.Name] = rows
+			}
+			continue
+		}
+
+		v, err := normalizeValue(f, input[f.Name])
+		if err != "" {
+			errs[f.Name] = err
+			continue
+		}
+		if v != nil {
+			values[f.Name] = v
+		}
+	}
+	return values, errs
+}
+
+// normalizeRepeater validates the rows of a repeater field. Rows whose
+// sub-fields are all empty are dropped.
+func normalizeRepeater(f Field, raw any) ([]map[string]any, string) {
+	var items []any
+	switch v := raw.(type) {
+	case nil:
+	case []any:
+		items = v
+	case []map[string]any:
+		for _, row := range v {
+			items = append(items, row)
+		}
+	default:
+		return nil, fmt.Sprintf("%s must be a list", f.Label)
+	}
+
+	maxItems := f.MaxItems
+	if maxItems == 0 {
+		maxItems = MaxRepeaterItems
+	}
+
+	rows := make([]map[string]any, 0, len(items))
+	for i, item := range items {
+		rowInput, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Sprintf("%s: row %d must be an object", f.Label, i+1)
+		}
+		row, errs := Normalize(f.Fields, rowInput)
+		if len(row) == 0 && !hasNonEmptyInput(rowInput) {
+			continue
+		}
+		for _, sub := range f.Fields {
+			if msg, ok := errs[sub.Name]; ok {
+				return nil, fmt.Sprintf("%s, row %d: %s", f.Label, len(rows)+1, msg)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) > maxItems {
+		return nil, fmt.Sprintf("%s can have at most %d rows", f.Label, maxItems)
+	}
+	if f.Required && len(rows) == 0 {
+		return nil, fmt.Sprintf("%s is required", f.Label)
+	}
+	return rows, ""
+}
+
+// hasNonEmptyInput reports whether any value of a submitted row is non-empty.
+func hasNonEmptyInput(row map[string]any) bool {
+	for _, v := range row {
+		if !isEmptyInput(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmptyInput reports whether a raw input value counts as "not provided".
+func isEmptyInput(raw any) bool {
+	switch v := raw.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	}
+	return false
+}
+
+// normalizeValue validates and converts a scalar field value. A nil result
+// with no error means the field is empty.
+func normalizeValue(f Field, raw any) (any, string) {
+	if isEmptyInput(raw) {
+		if f.Required {
+			return nil, fmt.Sprintf("%s is required", f.Label)
+		}
+		return nil, ""
+	}
+
+	switch f.Type {
+	case FieldText, FieldRichText, FieldSelect, FieldDate:
+		s, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Sprintf("%s must be a string", f.Label)
+		}
+		return normalizeString(f, strings.TrimSpace(s))
+
+	case FieldNumber:
+		n, ok := toFloat(raw)
+		if !ok {
+			return nil, fmt.Sprintf("%s must be a number", f.Label)
+		}
+		if f.Min != nil && n < *f.Min {
+			return nil, fmt.Sprintf("%s must be at least %s", f.Label, formatNumber(*f.Min))
+		}
+		if f.Max != nil && n > *f.Max {
+			return nil, fmt.Sprintf("%s must be at most %s", f.Label, formatNumber(*f.Max))
+		}
+		return n, ""
+
+	case FieldMedia, FieldPage:
+		id, ok := toID(raw)
+		if !ok {
+			return nil, fmt.Sprintf("%s must be a valid ID", f.Label)
+		}
+		return id, ""
+	}
+	return nil, fmt.Sprintf("%s has an unsupported type", f.Label)
+}
+
+// normalizeString validates the string-based field types.
+func normalizeString(f Field, s string) (any, string) {
+	switch f.Type {
+	case FieldText:
+		maxLen := f.MaxLength
+		if maxLen == 0 {
+			maxLen = MaxTextLength
+		}
+		if utf8.RuneCountInString(s) > maxLen {
+			return nil, fmt.Sprintf("%s must be at most %d characters", f.Label, maxLen)
+		}
+	case FieldRichText:
+		if len(s) > maxRichTextLength {
+			return nil, fmt.Sprintf("%s is too long", f.Label)
+		}
+		s = security.SanitizePageHTML(s)
+	case FieldSelect:
+		if !slices.Contains(f.Options, s) {
+			return nil, fmt.Sprintf("%s has an invalid option", f.Label)
+		}
+	case FieldDate:
+		if _, err := time.Parse(DateFormat, s); err != nil {
+			return nil, fmt.Sprintf("%s must be a date (YYYY-MM-DD)", f.Label)
+		}
+	}
+	return s, ""
+}
+
+// toFloat converts JSON numbers and numeric strings to a finite float64.
+func toFloat(raw any) (float64, bool) {
+	var n float64
+	switch v := raw.(type) {
+	case float64:
+		n = v
+	case int64:
+		n = float64(v)
+	case int:
+		n = float64(v)
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return 0, false
+		}
+		n = f
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, false
+		}
+		n = f
+	default:
+		return 0, false
+	}
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, false
+	}
+	return n, true
+}
+
+// toID converts JSON numbers and digit strings to a positive int64 ID.
+func toID(raw any) (int64, bool) {
+	switch v := raw.(type) {
+	case int64:
+		return v, v > 0
+	case int:
+		return int64(v), v > 0
+	case float64:
+		if v != math.Trunc(v) || v <= 0 || v > math.MaxInt64/2 {
+			return 0, false
+		}
+		return int64(v), true
+	case json.Number:
+		id, err := v.Int64()
+		return id, err == nil && id > 0
+	case string:
+		id, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		return id, err == nil && id > 0
+	}
+	return 0, false
+}
+
+// formatNumber formats a number bound for error messages.
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+// MapReferences returns a copy of values in which every media and page
+// reference - including those inside repeater rows - is replaced by the
+// result of fn. References for which fn returns false are removed.
+func MapReferences(fields []Field, values Values, fn func(f Field, v any) (any, bool)) Values {
+	out := make(Values, len(values))
+	for _, f := range fields {
+		v, ok := values[f.Name]
+		if !ok {
+			continue
+		}
+		switch f.Type {
+		case FieldMedia, FieldPage:
+			if mapped, keep := fn(f, v); keep {
+				out[f.Name] = mapped
+			}
+		case FieldRepeater:
+			rows := repeaterRows(v)
+			mappedRows := make([]map[string]any, 0, len(rows))
+			for _, row := range rows {
+				mappedRows = append(mappedRows, MapReferences(f.Fields, row, fn))
+			}
+			out[f.Name] = mappedRows
+		default:
+			out[f.Name] = v
+		}
+	}
+	return out
+}
+
+// repeaterRows returns the rows of a stored or normalized repeater value.
+func repeaterRows(v any) []map[string]any {
+	switch rows := v.(type) {
+	case []map[string]any:
+		return rows
+	case []any:
+		out := make([]map[string]any, 0, len(rows))
+		for _, row := range rows {
+			if m, ok := row.(map[string]any); ok {
+				out = append(out, m)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// Rows returns the rows of a stored, normalized or submitted repeater value.
+func Rows(v any) []map[string]any {
+	return repeaterRows(v)
+}
+
+// FormValue formats a scalar value - stored, normalized or submitted - for a
+// form input.
+func FormValue(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return x
+	case float64:
+		return formatNumber(x)
+	case int64:
+		return strconv.FormatInt(x, 10)
+	case int:
+		return strconv.Itoa(x)
+	case json.Number:
+		return x.String()
+	}
+	return fmt.Sprint(v)
+}
+
+// ReferenceID returns the ID of a stored media or page reference value.
+func ReferenceID(v any) (int64, bool) {
+	return toID(v)
+}
+
+// Display converts stored values into values convenient for templates: rich
+// text becomes template.HTML, dates become time.Time, repeater rows become
+// []map[string]any and references are passed to resolve (a nil result removes
+// the value). Fields without a value are present with a nil value so
+// templates can test them with if.
+func Display(fields []Field, values Values, resolve func(f Field, id int64) any) map[string]any {
+	out := make(map[string]any, len(fields))
+	for _, f := range fields {
+		v, ok := values[f.Name]
+		if !ok {
+			out[f.Name] = nil
+			continue
+		}
+		switch f.Type {
+		case FieldRichText:
+			s, _ := v.(string)
+			out[f.Name] = template.HTML(s) //nolint:gosec // sanitized by Normalize before storage
+		case FieldDate:
+			s, _ := v.(string)
+			if t, err := time.Parse(DateFormat, s); err == nil {
+				out[f.Name] = t
+			} else {
+				out[f.Name] = nil
+			}
+		case FieldNumber:
+			n, _ := toFloat(v)
+			out[f.Name] = n
+		case FieldMedia, FieldPage:
+			id, ok := toID(v)
+			if !ok || resolve == nil {
+				out[f.Name] = nil
+				continue
+			}
+			out[f.Name] = resolve(f, id)
+		case FieldRepeater:
+			rows := repeaterRows(v)
+			display := make([]map[string]any, 0, len(rows))
+			for _, row := range rows {
+				display = append(display, Display(f.Fields, row, resolve))
+			}
+			out[f.Name] = display
+		default:
+			out[f.Name] = v
+		}
+	}
+	return out
+}
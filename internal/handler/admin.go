@@ -10,12 +10,17 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/alexedwards/scs/v2"
 
 	"github.com/olegiv/ocms-go/internal/cache"
 	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/middleware"
 	"github.com/olegiv/ocms-go/internal/render"
 	"github.com/olegiv/ocms-go/internal/store"
 	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
@@ -421,6 +426,36 @@ func (h *AdminHandler) SetLanguage(w http.ResponseWriter, r *http.Request) {
 	setLanguagePreference(w, r, h.renderer, redirectAdmin)
 }
 
+// SetSite switches the site the admin panel manages.
+// POST /admin/site
+func (h *AdminHandler) SetSite(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.FormValue("site_id"), 10, 64)
+	if err == nil && slices.ContainsFunc(middleware.GetAdminSites(r), func(s store.Site) bool { return s.ID == id }) {
+		h.sessionManager.Put(r.Context(), middleware.SessionKeyAdminSiteID, id)
+	}
+
+	// Entities of the previous site are not found on the new one, so go back
+	// to the section list rather than the exact referring page.
+	redirect := redirectAdmin
+	if ref := r.Header.Get("Referer"); ref != "" {
+		if parsed, err := url.Parse(ref); err == nil && parsed.Host == r.Host {
+			redirect = adminSectionURL(parsed.Path)
+		}
+	}
+	http.Redirect(w, r, redirect, http.StatusSeeOther)
+}
+
+// adminSectionURL returns the admin section list URL a path belongs to,
+// e.g. /admin/pages for /admin/pages/12.
+func adminSectionURL(path string) string {
+	rest, ok := strings.CutPrefix(path, redirectAdmin+"/")
+	if !ok || rest == "" {
+		return redirectAdmin
+	}
+	section, _, _ := strings.Cut(rest, "/")
+	return redirectAdmin + "/" + section
+}
+
 // formatTimeAgo returns a human-readable relative time string.
 func formatTimeAgo(t time.Time) string {
 	now := time.Now()
@@ -106,13 +106,34 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	email := r.FormValue("email")
-	password := r.FormValue("password")
+	user, errorMsg := h.authenticate(r, lang, r.FormValue("email"), r.FormValue("password"))
+	if errorMsg != "" {
+		flashError(w, r, h.renderer, redirectLogin, errorMsg)
+		return
+	}
+
+	if err := h.startSession(r, user, r.FormValue("password")); err != nil {
+		logAndInternalError(w, "session renewal error", "error", err)
+		return
+	}
+
+	h.renderer.SetFlash(r, i18n.T(lang, "auth.welcome_back", user.Name), "success")
 
+	// Redirect based on user role
+	if user.Role == "admin" {
+		http.Redirect(w, r, redirectAdmin, http.StatusSeeOther)
+	} else {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+	}
+}
+
+// authenticate checks login credentials with the captcha, account lockout
+// and failed attempt tracking. On failure it returns the message to show,
+// translated to lang. It is shared by the admin and member login forms.
+func (h *AuthHandler) authenticate(r *http.Request, lang, email, password string) (store.User, string) {
 	// Validate input
 	if email == "" || password == "" {
-		flashError(w, r, h.renderer, redirectLogin, i18n.T(lang, "auth.email_password_required"))
-		return
+		return store.User{}, i18n.T(lang, "auth.email_password_required")
 	}
 
 	// Verify hCaptcha only when there is at least one active hook handler.
@@ -125,8 +146,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		result, err := h.hookRegistry.Call(r.Context(), hcaptcha.HookAuthBeforeLogin, verifyReq)
 		if err != nil {
 			slog.Error("captcha hook error", "error", err)
-			flashError(w, r, h.renderer, redirectLogin, i18n.T(lang, "hcaptcha.error_verification"))
-			return
+			return store.User{}, i18n.T(lang, "hcaptcha.error_verification")
 		}
 
 		if req, ok := result.(*hcaptcha.VerifyRequest); ok && !req.Verified {
@@ -134,8 +154,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 			if errorMsg == req.ErrorCode {
 				errorMsg = req.Error
 			}
-			flashError(w, r, h.renderer, redirectLogin, errorMsg)
-			return
+			return store.User{}, errorMsg
 		}
 	}
 
@@ -146,8 +165,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	if h.loginProtection != nil {
 		if locked, remaining := h.loginProtection.IsAccountLocked(email); locked {
 			_ = h.eventService.LogAuthEvent(r.Context(), model.EventLevelWarning, "Login attempt on locked account", nil, clientIP, middleware.GetRequestURL(r), map[string]any{"email": email})
-			flashError(w, r, h.renderer, redirectLogin, i18n.T(lang, "auth.account_locked", formatDuration(remaining)))
-			return
+			return store.User{}, i18n.T(lang, "auth.account_locked", formatDuration(remaining))
 		}
 	}
 
@@ -164,47 +182,20 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		// response latency cannot be used to enumerate valid accounts.
 		auth.VerifyDummyPassword(password)
 		// Record failed attempt even for non-existent users to prevent enumeration
-		if h.loginProtection != nil {
-			if locked, lockDuration := h.loginProtection.RecordFailedAttempt(email); locked {
-				flashError(w, r, h.renderer, redirectLogin, i18n.T(lang, "auth.too_many_attempts", formatDuration(lockDuration)))
-				return
-			}
-			remaining := h.loginProtection.GetRemainingAttempts(email)
-			if remaining <= 3 && remaining > 0 {
-				flashError(w, r, h.renderer, redirectLogin, i18n.T(lang, "auth.attempts_remaining", remaining))
-				return
-			}
-		}
-		flashError(w, r, h.renderer, redirectLogin, i18n.T(lang, "auth.invalid_credentials"))
-		return
+		return store.User{}, h.failedAttemptMessage(r, lang, email, nil)
 	}
 
 	// Check password
 	valid, err := auth.CheckPassword(password, user.PasswordHash)
 	if err != nil {
 		slog.Error("password check error", "error", err)
-		flashError(w, r, h.renderer, redirectLogin, i18n.T(lang, "auth.invalid_credentials"))
-		return
+		return store.User{}, i18n.T(lang, "auth.invalid_credentials")
 	}
 
 	if !valid {
 		slog.Debug("invalid password attempt", "email", email)
 		_ = h.eventService.LogAuthEvent(r.Context(), model.EventLevelWarning, "Login failed: invalid password", &user.ID, clientIP, middleware.GetRequestURL(r), map[string]any{"email": email})
-		// Record failed attempt
-		if h.loginProtection != nil {
-			if locked, lockDuration := h.loginProtection.RecordFailedAttempt(email); locked {
-				_ = h.eventService.LogAuthEvent(r.Context(), model.EventLevelWarning, "Account locked due to failed attempts", &user.ID, clientIP, middleware.GetRequestURL(r), map[string]any{"email": email, "duration": lockDuration.String()})
-				flashError(w, r, h.renderer, redirectLogin, i18n.T(lang, "auth.too_many_attempts", formatDuration(lockDuration)))
-				return
-			}
-			remaining := h.loginProtection.GetRemainingAttempts(email)
-			if remaining <= 3 && remaining > 0 {
-				flashError(w, r, h.renderer, redirectLogin, i18n.T(lang, "auth.attempts_remaining", remaining))
-				return
-			}
-		}
-		flashError(w, r, h.renderer, redirectLogin, i18n.T(lang, "auth.invalid_credentials"))
-		return
+		return store.User{}, h.failedAttemptMessage(r, lang, email, &user.ID)
 	}
 
 	// Clear failed attempts on successful login
@@ -212,6 +203,31 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		h.loginProtection.RecordSuccessfulLogin(email)
 	}
 
+	return user, ""
+}
+
+// failedAttemptMessage records a failed login attempt and returns the
+// message to show: the lockout, the remaining attempts when few are left,
+// or invalid credentials. userID is nil for unknown accounts.
+func (h *AuthHandler) failedAttemptMessage(r *http.Request, lang, email string, userID *int64) string {
+	if h.loginProtection != nil {
+		if locked, lockDuration := h.loginProtection.RecordFailedAttempt(email); locked {
+			if userID != nil {
+				_ = h.eventService.LogAuthEvent(r.Context(), model.EventLevelWarning, "Account locked due to failed attempts", userID, hcaptcha.GetRemoteIP(r), middleware.GetRequestURL(r), map[string]any{"email": email, "duration": lockDuration.String()})
+			}
+			return i18n.T(lang, "auth.too_many_attempts", formatDuration(lockDuration))
+		}
+		remaining := h.loginProtection.GetRemainingAttempts(email)
+		if remaining <= 3 && remaining > 0 {
+			return i18n.T(lang, "auth.attempts_remaining", remaining)
+		}
+	}
+	return i18n.T(lang, "auth.invalid_credentials")
+}
+
+// startSession logs an authenticated user in: it re-hashes outdated password
+// hashes, records the login time and stores the user in a renewed session.
+func (h *AuthHandler) startSession(r *http.Request, user store.User, password string) error {
 	// Re-hash password if it uses old/expensive parameters (e.g., 64MB → 19MB).
 	// This is a representation change for the same credential — use
 	// UpdateUserPasswordHash so existing sessions for this user are not
@@ -241,8 +257,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 
 	// Regenerate session ID to prevent session fixation
 	if err := h.sessionManager.RenewToken(r.Context()); err != nil {
-		logAndInternalError(w, "session renewal error", "error", err)
-		return
+		return err
 	}
 
 	// Store user ID in session along with the user's current session_version
@@ -251,16 +266,8 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	h.sessionManager.Put(r.Context(), middleware.SessionKeyUserSessionVersion, user.SessionVersion)
 
 	slog.Info("user logged in", "user_id", user.ID, "email", user.Email)
-	_ = h.eventService.LogAuthEvent(r.Context(), model.EventLevelInfo, "User logged in", &user.ID, clientIP, middleware.GetRequestURL(r), map[string]any{"email": user.Email})
-
-	h.renderer.SetFlash(r, i18n.T(lang, "auth.welcome_back", user.Name), "success")
-
-	// Redirect based on user role
-	if user.Role == "admin" {
-		http.Redirect(w, r, redirectAdmin, http.StatusSeeOther)
-	} else {
-		http.Redirect(w, r, "/", http.StatusSeeOther)
-	}
+	_ = h.eventService.LogAuthEvent(r.Context(), model.EventLevelInfo, "User logged in", &user.ID, hcaptcha.GetRemoteIP(r), middleware.GetRequestURL(r), map[string]any{"email": user.Email})
+	return nil
 }
 
 // Logout handles user logout.
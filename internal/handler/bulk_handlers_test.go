@@ -32,6 +32,7 @@ func TestPagesHandler_BulkDelete_Success(t *testing.T) {
 		AuthorID:  user.ID,
 		CreatedAt: now,
 		UpdatedAt: now,
+		SiteID:    store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage(1) failed: %v", err)
@@ -44,6 +45,7 @@ func TestPagesHandler_BulkDelete_Success(t *testing.T) {
 		AuthorID:  user.ID,
 		CreatedAt: now,
 		UpdatedAt: now,
+		SiteID:    store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage(2) failed: %v", err)
@@ -249,6 +251,7 @@ func TestMediaHandler_BulkDelete_Success(t *testing.T) {
 	media1, err := q.CreateMedia(context.Background(), store.CreateMediaParams{
 		Uuid: "11111111-1111-4111-8111-111111111111", Filename: "one.jpg", MimeType: "image/jpeg", Size: 100,
 		UploadedBy: user.ID, LanguageCode: "en", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMedia(1) failed: %v", err)
@@ -256,6 +259,7 @@ func TestMediaHandler_BulkDelete_Success(t *testing.T) {
 	media2, err := q.CreateMedia(context.Background(), store.CreateMediaParams{
 		Uuid: "22222222-2222-4222-8222-222222222222", Filename: "two.jpg", MimeType: "image/jpeg", Size: 100,
 		UploadedBy: user.ID, LanguageCode: "en", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMedia(2) failed: %v", err)
@@ -0,0 +1,462 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/alexedwards/scs/v2"
+
+	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/module"
+	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/store"
+	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+	"github.com/olegiv/ocms-go/internal/webhook"
+	"github.com/olegiv/ocms-go/modules/hcaptcha"
+)
+
+const (
+	// CommentsPerPage is the number of comments per moderation queue page.
+	CommentsPerPage = 25
+	// maxCommentBodyLen caps comment bodies, in characters.
+	maxCommentBodyLen = 5000
+	// maxCommentNameLen caps the names of anonymous commenters.
+	maxCommentNameLen = 100
+	// maxCommentFormBytes caps the comment form payload.
+	maxCommentFormBytes = 64 * 1024
+	// commentFloodLimit is the number of comments one IP address may post
+	// anonymously within commentFloodWindow.
+	commentFloodLimit  = 5
+	commentFloodWindow = 10 * time.Minute
+	// commentHoneypotSlug identifies the comment form in honeypot events.
+	commentHoneypotSlug = "comments"
+)
+
+// Values of the comment query parameter, next to the comment statuses, set
+// when redirecting back to the page after posting.
+const (
+	commentNoticeInvalid = "invalid"
+	commentNoticeCaptcha = "captcha"
+	commentNoticeClosed  = "closed"
+	commentNoticeLogin   = "login"
+	commentNoticeFlood   = "flood"
+)
+
+// CommentsHandler handles posting comments on pages and the comment
+// moderation queue.
+type CommentsHandler struct {
+	db             *sql.DB
+	queries        *store.Queries
+	renderer       *render.Renderer
+	sessionManager *scs.SessionManager
+	hookRegistry   *module.HookRegistry
+	frontend       *FrontendHandler
+	dispatcher     *webhook.Dispatcher
+	eventService   *service.EventService
+}
+
+// NewCommentsHandler creates a new CommentsHandler. The frontend handler
+// resolves the URLs of commented pages.
+func NewCommentsHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionManager, hr *module.HookRegistry, fh *FrontendHandler) *CommentsHandler {
+	return &CommentsHandler{
+		db:             db,
+		queries:        store.New(db),
+		renderer:       renderer,
+		sessionManager: sm,
+		hookRegistry:   hr,
+		frontend:       fh,
+		eventService:   service.NewEventService(db),
+	}
+}
+
+// SetDispatcher sets the webhook dispatcher for event dispatching.
+func (h *CommentsHandler) SetDispatcher(d *webhook.Dispatcher) {
+	h.dispatcher = d
+}
+
+// dispatchCommentEvent dispatches a comment webhook event.
+func (h *CommentsHandler) dispatchCommentEvent(ctx context.Context, eventType string, comment store.Comment, pageTitle string) {
+	if h.dispatcher == nil {
+		return
+	}
+	if err := h.dispatcher.DispatchEvent(ctx, eventType, webhook.NewCommentEventData(comment, pageTitle)); err != nil {
+		slog.Error("failed to dispatch webhook event",
+			"error", err,
+			"event_type", eventType,
+			"comment_id", comment.ID)
+	}
+}
+
+// Submit handles POST /comments/{id} - posts a comment on a page and
+// redirects back to the page with the outcome in the comment parameter.
+func (h *CommentsHandler) Submit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pageID, err := ParseIDParam(r)
+	if err != nil {
+		h.frontend.renderNotFound(w, r)
+		return
+	}
+	page, err := h.queries.GetPageByID(ctx, pageID)
+	if err != nil || page.Status != PageStatusPublished || page.SiteID != store.SiteIDFromContext(ctx) {
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("failed to get commented page", "error", err, "page_id", pageID)
+		}
+		h.frontend.renderNotFound(w, r)
+		return
+	}
+	// Visitors can't comment on pages they may not view
+	if !service.CanViewPage(ctx, h.queries, page.ID, middleware.GetUser(r)) {
+		h.frontend.renderNotFound(w, r)
+		return
+	}
+	languagePrefix, ok := h.frontend.canonicalPageLanguagePrefix(ctx, page)
+	if !ok {
+		h.frontend.renderNotFound(w, r)
+		return
+	}
+	pageURL := "/" + h.frontend.pagePath(ctx, page)
+	if languagePrefix != "" {
+		pageURL = "/" + languagePrefix + pageURL
+	}
+	back := func(notice string) {
+		http.Redirect(w, r, pageURL+"?comment="+notice+"#comments", http.StatusSeeOther)
+	}
+
+	if !h.frontend.commentsEnabled(ctx) || !service.CommentsOpen(ctx, h.queries, page) {
+		back(commentNoticeClosed)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxCommentFormBytes)
+	if err := r.ParseForm(); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, "Comment too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		back(commentNoticeInvalid)
+		return
+	}
+
+	clientIP := middleware.GetClientIP(r)
+
+	// Bots filling the honeypot are told their comment awaits moderation
+	if r.FormValue("_website") != "" {
+		slog.Info("honeypot triggered", "form_slug", commentHoneypotSlug, "ip", clientIP)
+		_ = h.eventService.LogSecurityEvent(ctx, model.EventLevelWarning, "Comment honeypot triggered",
+			nil, clientIP, middleware.GetRequestURL(r), map[string]any{"page_id": page.ID})
+		// Fire hook for modules (e.g., Sentinel auto-ban)
+		if h.hookRegistry != nil {
+			_ = h.hookRegistry.CallNoResult(ctx, module.HookSecurityHoneypotTriggered, map[string]any{
+				"ip":          clientIP,
+				"form_slug":   commentHoneypotSlug,
+				"page_id":     page.ID,
+				"request_url": middleware.GetRequestURL(r),
+			})
+		}
+		back(model.CommentStatusPending)
+		return
+	}
+
+	user := middleware.GetUser(r)
+	if user == nil {
+		if !h.frontend.anonymousCommentsAllowed(ctx) {
+			back(commentNoticeLogin)
+			return
+		}
+		if !verifyFormCaptcha(ctx, h.hookRegistry, r) {
+			back(commentNoticeCaptcha)
+			return
+		}
+		recent, err := h.queries.CountRecentCommentsByIP(ctx, store.CountRecentCommentsByIPParams{
+			IpAddress: clientIP,
+			CreatedAt: time.Now().Add(-commentFloodWindow),
+		})
+		if err != nil {
+			slog.Error("failed to count recent comments", "error", err)
+		} else if recent >= commentFloodLimit {
+			back(commentNoticeFlood)
+			return
+		}
+	}
+
+	input, ok := h.parseCommentInput(ctx, r, page.ID, user)
+	if !ok {
+		back(commentNoticeInvalid)
+		return
+	}
+
+	spamReason := service.CommentSpamReason(input.AuthorName, input.Body)
+	autoApprove := h.frontend.getConfigValue(ctx, model.ConfigKeyCommentsAutoApproveUsers) == "true"
+	now := time.Now()
+	comment, err := h.queries.CreateComment(ctx, store.CreateCommentParams{
+		PageID:      page.ID,
+		ParentID:    input.ParentID,
+		UserID:      input.UserID,
+		AuthorName:  input.AuthorName,
+		AuthorEmail: input.AuthorEmail,
+		Body:        input.Body,
+		Status:      service.CommentStatusFor(spamReason, user != nil, autoApprove),
+		IpAddress:   clientIP,
+		UserAgent:   r.UserAgent(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	if err != nil {
+		slog.Error("failed to create comment", "error", err, "page_id", page.ID)
+		h.frontend.renderInternalError(w)
+		return
+	}
+
+	slog.Info("comment posted", "comment_id", comment.ID, "page_id", page.ID, "status", comment.Status)
+	metadata := map[string]any{"comment_id": comment.ID, "page_id": page.ID, "status": comment.Status}
+	if spamReason != "" {
+		metadata["spam_reason"] = spamReason
+	}
+	_ = h.eventService.LogPageEvent(ctx, model.EventLevelInfo, "Comment posted",
+		middleware.GetUserIDPtr(r), clientIP, middleware.GetRequestURL(r), metadata)
+	h.dispatchCommentEvent(ctx, model.EventCommentCreated, comment, page.Title)
+
+	// Spam is reported as held for moderation, like honeypot hits
+	if comment.Status == model.CommentStatusApproved {
+		back(model.CommentStatusApproved)
+		return
+	}
+	back(model.CommentStatusPending)
+}
+
+// commentInput holds the validated fields of a posted comment.
+type commentInput struct {
+	ParentID    sql.NullInt64
+	UserID      sql.NullInt64
+	AuthorName  string
+	AuthorEmail string
+	Body        string
+}
+
+// parseCommentInput validates the comment form. Logged-in users comment
+// under their account name; anonymous visitors give a name and optionally
+// an email address. Replies must answer an approved comment of the page.
+func (h *CommentsHandler) parseCommentInput(ctx context.Context, r *http.Request, pageID int64, user *store.User) (commentInput, bool) {
+	input := commentInput{
+		Body: strings.TrimSpace(strings.ReplaceAll(r.FormValue("body"), "\r\n", "\n")),
+	}
+	if input.Body == "" || utf8.RuneCountInString(input.Body) > maxCommentBodyLen {
+		return input, false
+	}
+
+	if user != nil {
+		input.UserID = sql.NullInt64{Int64: user.ID, Valid: true}
+		input.AuthorName = user.Name
+		input.AuthorEmail = user.Email
+	} else {
+		input.AuthorName = strings.TrimSpace(r.FormValue("name"))
+		input.AuthorEmail = strings.TrimSpace(r.FormValue("email"))
+		if input.AuthorName == "" || utf8.RuneCountInString(input.AuthorName) > maxCommentNameLen {
+			return input, false
+		}
+		if input.AuthorEmail != "" && !isValidEmail(input.AuthorEmail) {
+			return input, false
+		}
+	}
+
+	if raw := r.FormValue("parent_id"); raw != "" {
+		parentID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return input, false
+		}
+		parent, err := h.queries.GetCommentByID(ctx, parentID)
+		if err != nil || parent.PageID != pageID || parent.Status != model.CommentStatusApproved {
+			return input, false
+		}
+		input.ParentID = sql.NullInt64{Int64: parent.ID, Valid: true}
+	}
+	return input, true
+}
+
+// verifyFormCaptcha verifies the captcha of a public form, such as the
+// comment and registration forms, when a captcha module is active. Without
+// one, forms are accepted without a captcha.
+func verifyFormCaptcha(ctx context.Context, hr *module.HookRegistry, r *http.Request) bool {
+	if hr == nil || !hr.HasHandlers(hcaptcha.HookFormCaptchaVerify) {
+		return true
+	}
+	result, err := hr.Call(ctx, hcaptcha.HookFormCaptchaVerify, &hcaptcha.VerifyRequest{
+		Response: hcaptcha.GetResponseFromForm(r),
+		RemoteIP: hcaptcha.GetRemoteIP(r),
+	})
+	if err != nil {
+		slog.Error("captcha verification hook error", "error", err)
+		return false
+	}
+	verified, ok := result.(*hcaptcha.VerifyRequest)
+	return ok && verified.Verified
+}
+
+// List handles GET /admin/comments - the moderation queue, filtered by
+// status (pending by default).
+func (h *CommentsHandler) List(w http.ResponseWriter, r *http.Request) {
+	lang := middleware.GetAdminLang(r)
+	ctx := r.Context()
+
+	status := r.URL.Query().Get("status")
+	if !model.IsValidCommentStatus(status) {
+		status = model.CommentStatusPending
+	}
+	page := ParsePageParam(r)
+
+	counts := make(map[string]int64, len(model.CommentStatuses))
+	for _, s := range model.CommentStatuses {
+		count, err := h.queries.CountCommentsByStatus(ctx, s)
+		if err != nil {
+			logAndInternalError(w, "failed to count comments", "error", err)
+			return
+		}
+		counts[s] = count
+	}
+
+	comments, err := h.queries.ListCommentsByStatus(ctx, store.ListCommentsByStatusParams{
+		Status: status,
+		Limit:  CommentsPerPage,
+		Offset: int64((page - 1) * CommentsPerPage),
+	})
+	if err != nil {
+		logAndInternalError(w, "failed to list comments", "error", err)
+		return
+	}
+
+	sentinelActive := h.renderer.SentinelIsActive()
+	data := adminviews.CommentsListViewData{
+		Comments:       convertCommentItems(comments, h.renderer, sentinelActive, middleware.GetClientIP(r)),
+		Status:         status,
+		Statuses:       model.CommentStatuses,
+		Counts:         counts,
+		SentinelActive: sentinelActive,
+		Pagination:     convertPagination(BuildAdminPagination(page, int(counts[status]), CommentsPerPage, redirectAdminComments, r.URL.Query())),
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "comments.title"), commentsBreadcrumbs(lang))
+	renderTempl(w, r, adminviews.CommentsListPage(pc, data))
+}
+
+// SetStatus handles POST /admin/comments/{id}/status - approves a comment,
+// marks it as spam or returns it to the queue.
+func (h *CommentsHandler) SetStatus(w http.ResponseWriter, r *http.Request) {
+	if middleware.IsDemoMode() {
+		h.sendError(w, middleware.DemoModeMessageDetailed(middleware.RestrictionContentReadOnly))
+		return
+	}
+
+	id, err := ParseIDParam(r)
+	if err != nil {
+		http.Error(w, "Invalid comment ID", http.StatusBadRequest)
+		return
+	}
+	comment, ok := requireEntityWithCustomError(w, "Comment", id,
+		func(id int64) (store.Comment, error) { return h.queries.GetCommentByID(r.Context(), id) },
+		h.sendError)
+	if !ok {
+		return
+	}
+
+	status := r.FormValue("status")
+	if !model.IsValidCommentStatus(status) {
+		h.sendError(w, "Invalid comment status")
+		return
+	}
+	if status == comment.Status {
+		h.respondModerated(w, r, comment.Status)
+		return
+	}
+
+	if err := h.queries.UpdateCommentStatus(r.Context(), store.UpdateCommentStatusParams{
+		Status:    status,
+		UpdatedAt: time.Now(),
+		ID:        id,
+	}); err != nil {
+		slog.Error("failed to update comment status", "error", err, "comment_id", id)
+		http.Error(w, "Error updating comment", http.StatusInternalServerError)
+		return
+	}
+
+	slog.Info("comment moderated", "comment_id", id, "status", status, "moderated_by", middleware.GetUserID(r))
+	_ = h.eventService.LogPageEvent(r.Context(), model.EventLevelInfo, "Comment moderated",
+		middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+		map[string]any{"comment_id": id, "page_id": comment.PageID, "status": status, "previous_status": comment.Status})
+
+	if status == model.CommentStatusApproved {
+		comment.Status = status
+		pageTitle := ""
+		if page, err := h.queries.GetPageByID(r.Context(), comment.PageID); err == nil {
+			pageTitle = page.Title
+		}
+		h.dispatchCommentEvent(r.Context(), model.EventCommentApproved, comment, pageTitle)
+	}
+
+	h.respondModerated(w, r, comment.Status)
+}
+
+// Delete handles DELETE /admin/comments/{id} - deletes a comment and its
+// replies.
+func (h *CommentsHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if middleware.IsDemoMode() {
+		h.sendError(w, middleware.DemoModeMessageDetailed(middleware.RestrictionContentReadOnly))
+		return
+	}
+
+	handleDeleteEntity(w, r, h.renderer, deleteEntityParams[store.Comment]{
+		EntityName:     "comment",
+		IDField:        "comment_id",
+		RedirectURL:    redirectAdminComments,
+		SuccessMessage: "Comment deleted successfully",
+		RequireFn: func(id int64) (store.Comment, bool) {
+			return requireEntityWithCustomError(w, "Comment", id,
+				func(id int64) (store.Comment, error) { return h.queries.GetCommentByID(r.Context(), id) },
+				h.sendError)
+		},
+		DeleteFn: h.queries.DeleteComment,
+		GetSlug:  func(c store.Comment) string { return c.AuthorName },
+		OnDeleted: func(c store.Comment) {
+			_ = h.eventService.LogPageEvent(r.Context(), model.EventLevelInfo, "Comment deleted",
+				middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+				map[string]any{"comment_id": c.ID, "page_id": c.PageID, "status": c.Status})
+		},
+	})
+}
+
+// respondModerated answers a moderation action. HTMX requests get an empty
+// response that removes the comment from the current queue; other requests
+// are redirected back to the queue of the previous status.
+func (h *CommentsHandler) respondModerated(w http.ResponseWriter, r *http.Request, status string) {
+	if r.Header.Get("HX-Request") == "true" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	returnStatus := r.FormValue("return_status")
+	if !model.IsValidCommentStatus(returnStatus) {
+		returnStatus = status
+	}
+	flashSuccess(w, r, h.renderer, fmt.Sprintf("%s?status=%s", redirectAdminComments, returnStatus), "Comment updated successfully")
+}
+
+// sendError sends an error response for moderation actions.
+func (h *CommentsHandler) sendError(w http.ResponseWriter, message string) {
+	w.Header().Set("HX-Reswap", "none")
+	w.Header().Set("HX-Trigger", `{"showToast": "`+message+`", "toastType": "error"}`)
+	w.WriteHeader(http.StatusBadRequest)
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// newTestCommentsHandler creates a comments handler with comments enabled
+// and a published post to comment on.
+func newTestCommentsHandler(t *testing.T) (*CommentsHandler, store.Page, store.User) {
+	t.Helper()
+
+	db, sm := testHandlerSetup(t)
+	admin := createTestAdminUser(t, db)
+	queries := store.New(db)
+	setTestConfig(t, queries, model.ConfigKeyCommentsEnabled, "true")
+
+	now := time.Now()
+	post, err := queries.CreatePage(context.Background(), store.CreatePageParams{
+		Title: "Hello", Slug: "hello", Status: "published", AuthorID: admin.ID,
+		PageType: "post", LanguageCode: "en",
+		PublishedAt: sql.NullTime{Time: now, Valid: true},
+		CreatedAt:   now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
+	})
+	if err != nil {
+		t.Fatalf("CreatePage: %v", err)
+	}
+
+	frontend := NewFrontendHandler(db, loadedFrontendThemeManager(t, "default"), nil, slog.Default(), nil, nil)
+	return NewCommentsHandler(db, nil, sm, nil, frontend), post, admin
+}
+
+// setTestConfig stores a site config value.
+func setTestConfig(t *testing.T, queries *store.Queries, key, value string) {
+	t.Helper()
+	if _, err := queries.UpsertConfig(context.Background(), store.UpsertConfigParams{
+		Key: key, Value: value, Type: model.ConfigTypeBool, UpdatedAt: time.Now(),
+	}); err != nil {
+		t.Fatalf("UpsertConfig(%s): %v", key, err)
+	}
+}
+
+// postComment submits the comment form of a page.
+func postComment(h *CommentsHandler, pageID int64, form url.Values, user *store.User) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/comments/%d", pageID), strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req = requestWithURLParams(req, map[string]string{"id": fmt.Sprint(pageID)})
+	if user != nil {
+		req = addUserToContext(req, user)
+	}
+	w := httptest.NewRecorder()
+	h.Submit(w, req)
+	return w
+}
+
+func TestCommentsHandler_Submit(t *testing.T) {
+	h, post, admin := newTestCommentsHandler(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		form       url.Values
+		user       *store.User
+		wantNotice string
+		wantStatus string // status of the stored comment, "" when none is stored
+	}{
+		{"anonymous", url.Values{"name": {"Ann"}, "body": {"Nice post"}}, nil, "pending", model.CommentStatusPending},
+		{"anonymous without name", url.Values{"body": {"Nice post"}}, nil, "invalid", ""},
+		{"bad email", url.Values{"name": {"Ann"}, "email": {"nope"}, "body": {"Hi"}}, nil, "invalid", ""},
+		{"honeypot", url.Values{"name": {"Bot"}, "body": {"Buy"}, "_website": {"x"}}, nil, "pending", ""},
+		{"spam", url.Values{"name": {"Ann"}, "body": {"https://spam.example"}}, nil, "pending", model.CommentStatusSpam},
+		{"unknown parent", url.Values{"name": {"Ann"}, "body": {"Hi"}, "parent_id": {"999"}}, nil, "invalid", ""},
+		{"logged in", url.Values{"body": {"Thanks"}}, &admin, "pending", model.CommentStatusPending},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before, _ := h.queries.CountCommentsByStatus(ctx, tt.wantStatus)
+
+			w := postComment(h, post.ID, tt.form, tt.user)
+			assertStatus(t, w.Code, http.StatusSeeOther)
+			if want := "/hello?comment=" + tt.wantNotice + "#comments"; w.Header().Get("Location") != want {
+				t.Errorf("Location = %q, want %q", w.Header().Get("Location"), want)
+			}
+			if tt.wantStatus == "" {
+				return
+			}
+			after, _ := h.queries.CountCommentsByStatus(ctx, tt.wantStatus)
+			if after != before+1 {
+				t.Errorf("%s comments = %d, want %d", tt.wantStatus, after, before+1)
+			}
+		})
+	}
+
+	t.Run("auto-approved user", func(t *testing.T) {
+		setTestConfig(t, h.queries, model.ConfigKeyCommentsAutoApproveUsers, "true")
+		w := postComment(h, post.ID, url.Values{"body": {"Approved"}}, &admin)
+		if want := "/hello?comment=approved#comments"; w.Header().Get("Location") != want {
+			t.Errorf("Location = %q, want %q", w.Header().Get("Location"), want)
+		}
+	})
+
+	t.Run("anonymous disallowed", func(t *testing.T) {
+		setTestConfig(t, h.queries, model.ConfigKeyCommentsAllowAnonymous, "false")
+		w := postComment(h, post.ID, url.Values{"name": {"Ann"}, "body": {"Hi"}}, nil)
+		if want := "/hello?comment=login#comments"; w.Header().Get("Location") != want {
+			t.Errorf("Location = %q, want %q", w.Header().Get("Location"), want)
+		}
+	})
+
+	t.Run("closed page", func(t *testing.T) {
+		if err := h.queries.UpsertPageCommentSetting(ctx, store.UpsertPageCommentSettingParams{PageID: post.ID, Enabled: false}); err != nil {
+			t.Fatalf("UpsertPageCommentSetting: %v", err)
+		}
+		w := postComment(h, post.ID, url.Values{"body": {"Hi"}}, &admin)
+		if want := "/hello?comment=closed#comments"; w.Header().Get("Location") != want {
+			t.Errorf("Location = %q, want %q", w.Header().Get("Location"), want)
+		}
+	})
+}
+
+func TestCommentsHandler_RestrictedPage(t *testing.T) {
+	h, post, admin := newTestCommentsHandler(t)
+	ctx := context.Background()
+
+	w := postComment(h, post.ID, url.Values{"body": {"Members sentinel"}}, &admin)
+	assertStatus(t, w.Code, http.StatusSeeOther)
+	comments, err := h.queries.ListCommentsByStatus(ctx, store.ListCommentsByStatusParams{
+		Status: model.CommentStatusPending, Limit: 10,
+	})
+	if err != nil || len(comments) != 1 {
+		t.Fatalf("ListCommentsByStatus = %d comments, %v; want 1", len(comments), err)
+	}
+	if err := h.queries.UpdateCommentStatus(ctx, store.UpdateCommentStatusParams{
+		ID: comments[0].ID, Status: model.CommentStatusApproved, UpdatedAt: time.Now(),
+	}); err != nil {
+		t.Fatalf("UpdateCommentStatus: %v", err)
+	}
+	if err := h.queries.UpsertPageAccess(ctx, store.UpsertPageAccessParams{PageID: post.ID, Access: model.PageAccessMembers}); err != nil {
+		t.Fatalf("UpsertPageAccess: %v", err)
+	}
+
+	before, _ := h.queries.CountCommentsByStatus(ctx, model.CommentStatusPending)
+	w = postComment(h, post.ID, url.Values{"name": {"Ann"}, "body": {"Let me in"}}, nil)
+	assertStatus(t, w.Code, http.StatusNotFound)
+	if after, _ := h.queries.CountCommentsByStatus(ctx, model.CommentStatusPending); after != before {
+		t.Errorf("pending comments = %d, want %d", after, before)
+	}
+
+	page := func(user *store.User) *CommentsView {
+		req := httptest.NewRequest(http.MethodGet, "/hello", nil)
+		if user != nil {
+			req = addUserToContext(req, user)
+		}
+		return h.frontend.pageComments(req, post, BaseTemplateData{})
+	}
+	if view := page(nil); view != nil {
+		t.Errorf("anonymous visitor sees %d comments of a members-only page", view.Count)
+	}
+	if view := page(&admin); view == nil || view.Count != 1 {
+		t.Errorf("admin comments view = %+v, want 1 comment", view)
+	}
+}
+
+func TestCommentsHandler_SubmitOtherSite(t *testing.T) {
+	h, post, _ := newTestCommentsHandler(t)
+	ctx := context.Background()
+
+	form := url.Values{"name": {"Ann"}, "body": {"Cross-site"}}
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/comments/%d", post.ID), strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req = requestWithURLParams(req, map[string]string{"id": fmt.Sprint(post.ID)})
+	req = req.WithContext(store.WithSiteID(req.Context(), store.DefaultSiteID+1))
+	w := httptest.NewRecorder()
+
+	before, _ := h.queries.CountCommentsByStatus(ctx, model.CommentStatusPending)
+	h.Submit(w, req)
+	assertStatus(t, w.Code, http.StatusNotFound)
+	if after, _ := h.queries.CountCommentsByStatus(ctx, model.CommentStatusPending); after != before {
+		t.Errorf("pending comments = %d, want %d", after, before)
+	}
+}
+
+func TestCommentsHandler_SubmitFlood(t *testing.T) {
+	h, post, _ := newTestCommentsHandler(t)
+
+	for i := range commentFloodLimit {
+		w := postComment(h, post.ID, url.Values{"name": {"Ann"}, "body": {fmt.Sprintf("Comment %d", i)}}, nil)
+		if loc := w.Header().Get("Location"); !strings.Contains(loc, "comment=pending") {
+			t.Fatalf("comment %d: Location = %q, want pending", i, loc)
+		}
+	}
+	w := postComment(h, post.ID, url.Values{"name": {"Ann"}, "body": {"One more"}}, nil)
+	if loc := w.Header().Get("Location"); !strings.Contains(loc, "comment=flood") {
+		t.Errorf("Location = %q, want flood", loc)
+	}
+}
+
+func TestCommentsHandler_ModerateAndRender(t *testing.T) {
+	h, post, _ := newTestCommentsHandler(t)
+	ctx := context.Background()
+
+	postComment(h, post.ID, url.Values{"name": {"Ann"}, "body": {"Moderated sentinel"}}, nil)
+	comments, err := h.queries.ListCommentsByStatus(ctx, store.ListCommentsByStatusParams{
+		Status: model.CommentStatusPending, Limit: 10,
+	})
+	if err != nil || len(comments) != 1 {
+		t.Fatalf("ListCommentsByStatus = %d comments, %v; want 1", len(comments), err)
+	}
+
+	router := chi.NewRouter()
+	router.Use(middleware.Language(h.db))
+	router.Get(RouteParamSlug, h.frontend.Page)
+	render := func() string {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/hello", nil))
+		assertStatus(t, w.Code, http.StatusOK)
+		return w.Body.String()
+	}
+	if body := render(); strings.Contains(body, "Moderated sentinel") {
+		t.Error("pending comment is shown on the page")
+	}
+
+	form := url.Values{"status": {model.CommentStatusApproved}}
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/admin/comments/%d/status", comments[0].ID), strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("HX-Request", "true")
+	req = requestWithURLParams(req, map[string]string{"id": fmt.Sprint(comments[0].ID)})
+	w := httptest.NewRecorder()
+	h.SetStatus(w, req)
+	assertStatus(t, w.Code, http.StatusOK)
+
+	body := render()
+	if !strings.Contains(body, "Moderated sentinel") {
+		t.Error("approved comment is not shown on the page")
+	}
+	if !strings.Contains(body, `id="comment-form"`) {
+		t.Error("comment form is not shown on an open post")
+	}
+
+	view := h.frontend.pageToView(ctx, post, "en", "")
+	if view.CommentCount != 1 {
+		t.Errorf("PageView.CommentCount = %d, want 1", view.CommentCount)
+	}
+}
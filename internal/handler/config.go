@@ -4,6 +4,7 @@
 package handler
 
 import (
+	"context"
 	"database/sql"
 	"log/slog"
 	"net"
@@ -23,20 +24,25 @@ import (
 	"github.com/olegiv/ocms-go/internal/service"
 	"github.com/olegiv/ocms-go/internal/store"
 	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+	"github.com/olegiv/ocms-go/internal/webhook"
 )
 
 // configKeyOrder defines the display order for config keys.
 // Keys not in this list will appear after these, sorted alphabetically.
 var configKeyOrder = map[string]int{
-	model.ConfigKeySiteName:        1,
-	model.ConfigKeySiteDescription: 2,
-	model.ConfigKeySiteURL:         3,
-	model.ConfigKeyDefaultOGImage:  4,
-	model.ConfigKeyCopyright:       5,
-	model.ConfigKeyPoweredBy:       6,
-	model.ConfigKeyPostsPerPage:    7,
-	model.ConfigKeyAdminEmail:      8,
-	model.ConfigKeyExcludedIPs:     9,
+	model.ConfigKeySiteName:                 1,
+	model.ConfigKeySiteDescription:          2,
+	model.ConfigKeySiteURL:                  3,
+	model.ConfigKeyDefaultOGImage:           4,
+	model.ConfigKeyCopyright:                5,
+	model.ConfigKeyPoweredBy:                6,
+	model.ConfigKeyPostsPerPage:             7,
+	model.ConfigKeyAdminEmail:               8,
+	model.ConfigKeyExcludedIPs:              9,
+	model.ConfigKeyCommentsEnabled:          10,
+	model.ConfigKeyCommentsAllowAnonymous:   11,
+	model.ConfigKeyCommentsAutoApproveUsers: 12,
+	model.ConfigKeyMembersRegistration:      13,
 }
 
 // sortConfigs sorts config items according to configKeyOrder.
@@ -108,6 +114,12 @@ type ConfigHandler struct {
 	sessionManager *scs.SessionManager
 	cacheManager   *cache.Manager
 	eventService   *service.EventService
+	dispatcher     *webhook.Dispatcher
+}
+
+// SetDispatcher sets the webhook dispatcher for event dispatching.
+func (h *ConfigHandler) SetDispatcher(d *webhook.Dispatcher) {
+	h.dispatcher = d
 }
 
 // NewConfigHandler creates a new ConfigHandler.
@@ -251,7 +263,11 @@ func (h *ConfigHandler) Update(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Snapshot current translations so changed keys can be reported
+	existingTranslations := h.configTranslationsMap(r.Context())
+
 	validationErrors := make(map[string]string)
+	changedKeys := make(map[string]bool)
 	now := time.Now()
 	userID := middleware.GetUserID(r)
 	updatedBy := sql.NullInt64{Int64: userID, Valid: userID > 0}
@@ -268,6 +284,9 @@ func (h *ConfigHandler) Update(w http.ResponseWriter, r *http.Request) {
 			// Get the default language form value for the base config row
 			defaultLangFieldName := cfg.Key + "_" + defaultLangCode
 			defaultLangValue := r.FormValue(defaultLangFieldName)
+			if defaultLangValue != cfg.Value {
+				changedKeys[cfg.Key] = true
+			}
 
 			// Ensure the config key exists in the config table first (for FK constraint)
 			// Use the default language form value so cache lookups get the correct value
@@ -289,6 +308,9 @@ func (h *ConfigHandler) Update(w http.ResponseWriter, r *http.Request) {
 				// Form field name: key_langcode (e.g., site_name_en, site_name_ru)
 				fieldName := cfg.Key + "_" + language.Code
 				value := r.FormValue(fieldName)
+				if value != existingTranslations[cfg.Key][language.ID] {
+					changedKeys[cfg.Key] = true
+				}
 
 				// Save translation
 				_, err := h.queries.UpsertConfigTranslation(r.Context(), store.UpsertConfigTranslationParams{
@@ -353,19 +375,16 @@ func (h *ConfigHandler) Update(w http.ResponseWriter, r *http.Request) {
 		if err != nil {
 			slog.Error("failed to upsert config", "key", cfg.Key, "error", err)
 			validationErrors[cfg.Key] = i18n.T(lang, "error.saving_value")
+			continue
+		}
+		if value != cfg.Value {
+			changedKeys[cfg.Key] = true
 		}
 	}
 
 	if len(validationErrors) > 0 {
 		// Get all config translations for re-rendering
-		allTranslations, _ := h.queries.ListAllConfigTranslations(r.Context())
-		translationsMap := make(map[string]map[int64]string)
-		for _, t := range allTranslations {
-			if translationsMap[t.ConfigKey] == nil {
-				translationsMap[t.ConfigKey] = make(map[int64]string)
-			}
-			translationsMap[t.ConfigKey][t.LanguageID] = t.Value
-		}
+		translationsMap := h.configTranslationsMap(r.Context())
 
 		// Build form values map for re-rendering (including translation values from form)
 		formValues := make(map[string]string)
@@ -394,9 +413,42 @@ func (h *ConfigHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 	slog.Info("config updated", "updated_by", middleware.GetUserID(r))
 	_ = h.eventService.LogConfigEvent(r.Context(), model.EventLevelInfo, "Configuration updated", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), nil)
+	h.dispatchConfigUpdated(r.Context(), changedKeys, userID)
 	flashSuccess(w, r, h.renderer, redirectAdminConfig, i18n.T(lang, "msg.config_saved"))
 }
 
+// configTranslationsMap returns config translations keyed by config key and language ID.
+func (h *ConfigHandler) configTranslationsMap(ctx context.Context) map[string]map[int64]string {
+	allTranslations, _ := h.queries.ListAllConfigTranslations(ctx)
+	translationsMap := make(map[string]map[int64]string)
+	for _, t := range allTranslations {
+		if translationsMap[t.ConfigKey] == nil {
+			translationsMap[t.ConfigKey] = make(map[int64]string)
+		}
+		translationsMap[t.ConfigKey][t.LanguageID] = t.Value
+	}
+	return translationsMap
+}
+
+// dispatchConfigUpdated dispatches config.updated with the sorted changed keys.
+// Values are never sent since config may contain secrets.
+func (h *ConfigHandler) dispatchConfigUpdated(ctx context.Context, changedKeys map[string]bool, userID int64) {
+	if len(changedKeys) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(changedKeys))
+	for key := range changedKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	dispatchWebhookEvent(ctx, h.dispatcher, model.EventConfigUpdated, webhook.ConfigEventData{
+		Keys:      keys,
+		UpdatedBy: userID,
+	})
+}
+
 // configKeyToLabel converts a config key to a translated label.
 // If no translation exists, generates a readable label from the key.
 func configKeyToLabel(key string, lang string) string {
@@ -30,11 +30,15 @@ const (
 	RouteSuffixFolders = "/folders"
 	// RouteSuffixBulkDelete is the suffix for bulk delete/revoke routes.
 	RouteSuffixBulkDelete = "/bulk-delete"
+	// RouteSuffixTree is the suffix for tree editor routes.
+	RouteSuffixTree = "/tree"
 
 	// RouteParamID is the ID parameter pattern.
 	RouteParamID = "/{id}"
 	// RouteParamSlug is the slug parameter pattern.
 	RouteParamSlug = "/{slug}"
+	// RoutePagePath is the nested page path pattern (/parent/child).
+	RoutePagePath = "/{slug}/*"
 	// RoutePageByID is the page by ID route pattern (redirects to slug URL).
 	RoutePageByID = "/page/{id}"
 	// RouteTagSlug is the tag slug route pattern.
@@ -56,8 +60,22 @@ const (
 	RouteLogout = "/logout"
 	// RouteLanguage is the public language switch route.
 	RouteLanguage = "/language"
+	// RouteMembers is the member routes prefix.
+	RouteMembers = "/members"
+	// RouteMembersLogin is the member login route.
+	RouteMembersLogin = RouteMembers + "/login"
+	// RouteMembersRegister is the member registration route.
+	RouteMembersRegister = RouteMembers + "/register"
+	// RouteMembersProfile is the member profile route.
+	RouteMembersProfile = RouteMembers + "/profile"
+	// RouteMembersLogout is the member logout route.
+	RouteMembersLogout = RouteMembers + "/logout"
 	// RouteBlog is the blog route.
 	RouteBlog = "/blog"
+	// RouteBlogYear is the yearly blog archive route pattern.
+	RouteBlogYear = "/blog/{year:[0-9]{4}}"
+	// RouteBlogMonth is the monthly blog archive route pattern.
+	RouteBlogMonth = "/blog/{year:[0-9]{4}}/{month:[0-9]{2}}"
 
 	// RouteUsers is the users admin route.
 	RouteUsers = "/users"
@@ -87,8 +105,26 @@ const (
 	RouteExport = "/export"
 	// RouteImport is the import admin route.
 	RouteImport = "/import"
+	// RouteTranslationExchange is the XLIFF/PO translation exchange admin route.
+	RouteTranslationExchange = "/translation-exchange"
+	// RouteTranslationStatus is the translation coverage and freshness admin route.
+	RouteTranslationStatus = "/translation-status"
+	// RouteBrokenLinks is the broken link report admin route.
+	RouteBrokenLinks = "/broken-links"
 	// RouteConfig is the config admin route.
 	RouteConfig = "/config"
+	// RouteContentTypes is the content types admin route.
+	RouteContentTypes = "/content-types"
+	// RouteSnippets is the snippets admin route.
+	RouteSnippets = "/snippets"
+	// RouteSeries is the page series admin route.
+	RouteSeries = "/series"
+	// RouteComments is the comments route, public for posting and admin for moderation.
+	RouteComments = "/comments"
+	// RouteSites is the sites admin route.
+	RouteSites = "/sites"
+	// RouteSite is the admin site switch route.
+	RouteSite = "/site"
 	// RouteDocs is the site docs admin route.
 	RouteDocs = "/docs"
 	// RouteDocsSlug is the site docs guide route pattern.
@@ -120,14 +156,31 @@ const (
 	RouteAPIKeysID = RouteAPIKeys + RouteParamID
 	// RouteWebhooksID is the webhooks ID route pattern.
 	RouteWebhooksID = RouteWebhooks + RouteParamID
+	// RouteInboundWebhooks is the inbound webhooks admin route.
+	RouteInboundWebhooks = RouteWebhooks + "/inbound"
+	// RouteInboundWebhooksID is the inbound webhooks ID route pattern.
+	RouteInboundWebhooksID = RouteInboundWebhooks + RouteParamID
+	// RouteInboundWebhookReceive is the public endpoint receiving inbound webhooks.
+	RouteInboundWebhookReceive = "/webhooks/in/{token}"
 	// RouteRedirectsID is the redirects ID route pattern.
 	RouteRedirectsID = RouteRedirects + RouteParamID
+	// RouteContentTypesID is the content types ID route pattern.
+	RouteContentTypesID = RouteContentTypes + RouteParamID
+	// RouteSnippetsID is the snippets ID route pattern.
+	RouteSnippetsID = RouteSnippets + RouteParamID
+	// RouteSeriesID is the page series ID route pattern.
+	RouteSeriesID = RouteSeries + RouteParamID
+	// RouteCommentsID is the comments ID route pattern.
+	RouteCommentsID = RouteComments + RouteParamID
+	// RouteSitesID is the sites ID route pattern.
+	RouteSitesID = RouteSites + RouteParamID
 )
 
 const (
 	redirectAdmin              = "/admin"
 	redirectAdminPages         = redirectAdmin + RoutePages
 	redirectAdminPagesNew      = redirectAdminPages + RouteSuffixNew
+	redirectAdminPagesTree     = redirectAdminPages + RouteSuffixTree
 	redirectAdminMedia         = redirectAdmin + RouteMedia
 	redirectAdminMediaUpload   = redirectAdminMedia + RouteSuffixUpload
 	redirectAdminWebhooks      = redirectAdmin + RouteWebhooks
@@ -163,6 +216,10 @@ const (
 	redirectAdminMediaID              = redirectAdminMedia + "/%d"
 	redirectAdminWebhooksID           = redirectAdminWebhooks + "/%d"
 	redirectAdminWebhooksIDDeliveries = redirectAdminWebhooksID + "/deliveries"
+	redirectAdminWebhooksDeadLetters  = redirectAdminWebhooks + "/dead-letters"
+	redirectAdminInboundWebhooks      = redirectAdminWebhooks + "/inbound"
+	redirectAdminInboundWebhooksNew   = redirectAdminInboundWebhooks + RouteSuffixNew
+	redirectAdminInboundWebhooksID    = redirectAdminInboundWebhooks + "/%d"
 	redirectAdminUsersID              = redirectAdminUsers + "/%d"
 	redirectAdminTagsID               = redirectAdminTags + "/%d"
 	redirectAdminCategoriesID         = redirectAdminCategories + "/%d"
@@ -173,6 +230,23 @@ const (
 	redirectAdminRedirects            = redirectAdmin + RouteRedirects
 	redirectAdminRedirectsNew         = redirectAdminRedirects + RouteSuffixNew
 	redirectAdminRedirectsID          = redirectAdminRedirects + "/%d"
+	redirectAdminRedirectsNotFound    = redirectAdminRedirects + "/not-found"
+	redirectAdminContentTypes         = redirectAdmin + RouteContentTypes
+	redirectAdminContentTypesNew      = redirectAdminContentTypes + RouteSuffixNew
+	redirectAdminContentTypesID       = redirectAdminContentTypes + "/%d"
+	redirectAdminSnippets             = redirectAdmin + RouteSnippets
+	redirectAdminSnippetsNew          = redirectAdminSnippets + RouteSuffixNew
+	redirectAdminSnippetsID           = redirectAdminSnippets + "/%d"
+	redirectAdminSeries               = redirectAdmin + RouteSeries
+	redirectAdminSeriesNew            = redirectAdminSeries + RouteSuffixNew
+	redirectAdminSeriesID             = redirectAdminSeries + "/%d"
+	redirectAdminComments             = redirectAdmin + RouteComments
+	redirectAdminTranslationExchange  = redirectAdmin + RouteTranslationExchange
+	redirectAdminTranslationStatus    = redirectAdmin + RouteTranslationStatus
+	redirectAdminBrokenLinks          = redirectAdmin + RouteBrokenLinks
+	redirectAdminSites                = redirectAdmin + RouteSites
+	redirectAdminSitesNew             = redirectAdminSites + RouteSuffixNew
+	redirectAdminSitesID              = redirectAdminSites + "/%d"
 )
 
 // Utility constants used by main.go.
@@ -0,0 +1,400 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+
+	"github.com/olegiv/ocms-go/internal/contenttype"
+	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/util"
+	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+)
+
+const (
+	// maxContentTypeNameLen caps content type names.
+	maxContentTypeNameLen = 255
+	// maxContentTypeSlugLen caps content type slugs, which are stored as page types.
+	maxContentTypeSlugLen = 64
+)
+
+// contentTypeTemplatePattern restricts template names to theme template identifiers.
+var contentTypeTemplatePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,99}$`)
+
+// ContentTypesHandler handles content type management routes.
+type ContentTypesHandler struct {
+	queries        *store.Queries
+	renderer       *render.Renderer
+	sessionManager *scs.SessionManager
+	eventService   *service.EventService
+}
+
+// NewContentTypesHandler creates a new ContentTypesHandler.
+func NewContentTypesHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionManager) *ContentTypesHandler {
+	return &ContentTypesHandler{
+		queries:        store.New(db),
+		renderer:       renderer,
+		sessionManager: sm,
+		eventService:   service.NewEventService(db),
+	}
+}
+
+// ContentTypeFormData holds data for the content type form template.
+type ContentTypeFormData struct {
+	ContentType *store.ContentType
+	PageCount   int64
+	Errors      map[string]string
+	FormValues  map[string]string
+	IsEdit      bool
+}
+
+// List handles GET /admin/content-types - lists content types.
+func (h *ContentTypesHandler) List(w http.ResponseWriter, r *http.Request) {
+	lang := middleware.GetAdminLang(r)
+
+	types, err := h.queries.ListContentTypes(r.Context())
+	if err != nil {
+		logAndInternalError(w, "failed to list content types", "error", err)
+		return
+	}
+
+	counts := make(map[int64]int64, len(types))
+	for _, ct := range types {
+		count, err := h.queries.CountPagesByPageType(r.Context(), ct.Slug)
+		if err != nil {
+			slog.Error("failed to count content type pages", "error", err, "content_type", ct.Slug)
+			continue
+		}
+		counts[ct.ID] = count
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "content_types.title"), contentTypesBreadcrumbs(lang))
+	renderTempl(w, r, adminviews.ContentTypesListPage(pc, convertContentTypesListViewData(types, counts)))
+}
+
+// NewForm handles GET /admin/content-types/new - displays the new content type form.
+func (h *ContentTypesHandler) NewForm(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentTypes, redirectAdminContentTypes) {
+		return
+	}
+
+	h.renderForm(w, r, ContentTypeFormData{
+		Errors:     make(map[string]string),
+		FormValues: map[string]string{"fields": "[]"},
+	})
+}
+
+// Create handles POST /admin/content-types - creates a content type.
+func (h *ContentTypesHandler) Create(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentTypes, redirectAdminContentTypes) {
+		return
+	}
+
+	if !parseFormOrRedirect(w, r, h.renderer, redirectAdminContentTypesNew) {
+		return
+	}
+
+	input := parseContentTypeFormInput(r)
+	fields, validationErrors := h.validateContentTypeForm(r.Context(), input, 0)
+	if len(validationErrors) > 0 {
+		h.renderForm(w, r, ContentTypeFormData{
+			Errors:     validationErrors,
+			FormValues: input.toFormValues(),
+		})
+		return
+	}
+
+	now := time.Now()
+	ct, err := h.queries.CreateContentType(r.Context(), store.CreateContentTypeParams{
+		Name:        input.Name,
+		Slug:        input.Slug,
+		Description: input.Description,
+		Fields:      contenttype.FieldsJSON(fields),
+		Template:    input.Template,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	if err != nil {
+		slog.Error("failed to create content type", "error", err)
+		flashError(w, r, h.renderer, redirectAdminContentTypesNew, "Error creating content type")
+		return
+	}
+
+	slog.Info("content type created", "content_type_id", ct.ID, "slug", ct.Slug, "created_by", middleware.GetUserID(r))
+	_ = h.eventService.LogConfigEvent(r.Context(), model.EventLevelInfo, "Content type created",
+		middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+		map[string]any{"content_type_id": ct.ID, "name": ct.Name, "slug": ct.Slug, "fields": len(fields)})
+
+	flashSuccess(w, r, h.renderer, redirectAdminContentTypes, "Content type created successfully")
+}
+
+// EditForm handles GET /admin/content-types/{id} - displays the edit form.
+func (h *ContentTypesHandler) EditForm(w http.ResponseWriter, r *http.Request) {
+	id, err := ParseIDParam(r)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminContentTypes, "Invalid content type ID")
+		return
+	}
+
+	ct, ok := h.requireContentTypeWithRedirect(w, r, id)
+	if !ok {
+		return
+	}
+
+	h.renderForm(w, r, ContentTypeFormData{
+		ContentType: &ct,
+		Errors:      make(map[string]string),
+		FormValues: map[string]string{
+			"name":        ct.Name,
+			"slug":        ct.Slug,
+			"description": ct.Description,
+			"template":    ct.Template,
+			"fields":      indentFieldsJSON(ct.Fields),
+		},
+		IsEdit: true,
+	})
+}
+
+// Update handles PUT /admin/content-types/{id} - updates a content type.
+// Changing the slug moves all pages of the old type to the new one.
+func (h *ContentTypesHandler) Update(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentTypes, redirectAdminContentTypes) {
+		return
+	}
+
+	id, err := ParseIDParam(r)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminContentTypes, "Invalid content type ID")
+		return
+	}
+
+	ct, ok := h.requireContentTypeWithRedirect(w, r, id)
+	if !ok {
+		return
+	}
+
+	editURL := fmt.Sprintf(redirectAdminContentTypesID, id)
+	if !parseFormOrRedirect(w, r, h.renderer, editURL) {
+		return
+	}
+
+	input := parseContentTypeFormInput(r)
+	fields, validationErrors := h.validateContentTypeForm(r.Context(), input, id)
+	if len(validationErrors) > 0 {
+		h.renderForm(w, r, ContentTypeFormData{
+			ContentType: &ct,
+			Errors:      validationErrors,
+			FormValues:  input.toFormValues(),
+			IsEdit:      true,
+		})
+		return
+	}
+
+	if _, err := h.queries.UpdateContentType(r.Context(), store.UpdateContentTypeParams{
+		Name:        input.Name,
+		Slug:        input.Slug,
+		Description: input.Description,
+		Fields:      contenttype.FieldsJSON(fields),
+		Template:    input.Template,
+		UpdatedAt:   time.Now(),
+		ID:          id,
+	}); err != nil {
+		slog.Error("failed to update content type", "error", err, "content_type_id", id)
+		flashError(w, r, h.renderer, editURL, "Error updating content type")
+		return
+	}
+
+	if input.Slug != ct.Slug {
+		if err := h.queries.RenamePageType(r.Context(), store.RenamePageTypeParams{NewType: input.Slug, OldType: ct.Slug}); err != nil {
+			slog.Error("failed to move pages to renamed content type", "error", err, "old_slug", ct.Slug, "new_slug", input.Slug)
+		}
+	}
+
+	slog.Info("content type updated", "content_type_id", id, "updated_by", middleware.GetUserID(r))
+	_ = h.eventService.LogConfigEvent(r.Context(), model.EventLevelInfo, "Content type updated",
+		middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+		map[string]any{"content_type_id": id, "name": input.Name, "slug": input.Slug, "old_slug": ct.Slug, "fields": len(fields)})
+
+	flashSuccess(w, r, h.renderer, redirectAdminContentTypes, "Content type updated successfully")
+}
+
+// Delete handles DELETE /admin/content-types/{id} - deletes a content type.
+// Content types that are still assigned to pages cannot be deleted.
+func (h *ContentTypesHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if middleware.IsDemoMode() {
+		h.sendDeleteError(w, middleware.DemoModeMessageDetailed(middleware.RestrictionContentTypes))
+		return
+	}
+
+	handleDeleteEntity(w, r, h.renderer, deleteEntityParams[store.ContentType]{
+		EntityName:     "content type",
+		IDField:        "content_type_id",
+		RedirectURL:    redirectAdminContentTypes,
+		SuccessMessage: "Content type deleted successfully",
+		RequireFn: func(id int64) (store.ContentType, bool) {
+			ct, ok := requireEntityWithCustomError(w, "Content type", id,
+				func(id int64) (store.ContentType, error) { return h.queries.GetContentTypeByID(r.Context(), id) },
+				h.sendDeleteError)
+			if !ok {
+				return ct, false
+			}
+			count, err := h.queries.CountPagesByPageType(r.Context(), ct.Slug)
+			if err != nil {
+				slog.Error("failed to count content type pages", "error", err, "content_type_id", id)
+				h.sendDeleteError(w, "Error deleting content type")
+				return ct, false
+			}
+			if count > 0 {
+				h.sendDeleteError(w, fmt.Sprintf("Cannot delete content type: %d page(s) still use it", count))
+				return ct, false
+			}
+			return ct, true
+		},
+		DeleteFn: h.queries.DeleteContentType,
+		GetSlug:  func(ct store.ContentType) string { return ct.Slug },
+		OnDeleted: func(ct store.ContentType) {
+			_ = h.eventService.LogConfigEvent(r.Context(), model.EventLevelInfo, "Content type deleted",
+				middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+				map[string]any{"content_type_id": ct.ID, "name": ct.Name, "slug": ct.Slug})
+		},
+	})
+}
+
+// contentTypeFormInput holds parsed form values for content type create/update.
+type contentTypeFormInput struct {
+	Name        string
+	Slug        string
+	Description string
+	Template    string
+	Fields      string
+}
+
+// parseContentTypeFormInput extracts content type form values. An empty slug
+// is derived from the name.
+func parseContentTypeFormInput(r *http.Request) contentTypeFormInput {
+	input := contentTypeFormInput{
+		Name:        strings.TrimSpace(r.FormValue("name")),
+		Slug:        strings.TrimSpace(r.FormValue("slug")),
+		Description: strings.TrimSpace(r.FormValue("description")),
+		Template:    strings.TrimSpace(r.FormValue("template")),
+		Fields:      strings.TrimSpace(r.FormValue("fields")),
+	}
+	if input.Slug == "" {
+		input.Slug = util.Slugify(input.Name)
+	}
+	return input
+}
+
+// toFormValues converts contentTypeFormInput to a map for form re-rendering.
+func (input contentTypeFormInput) toFormValues() map[string]string {
+	return map[string]string{
+		"name":        input.Name,
+		"slug":        input.Slug,
+		"description": input.Description,
+		"template":    input.Template,
+		"fields":      input.Fields,
+	}
+}
+
+// validateContentTypeForm validates content type form input and returns the
+// decoded field schema. excludeID is the content type being edited.
+func (h *ContentTypesHandler) validateContentTypeForm(ctx context.Context, input contentTypeFormInput, excludeID int64) ([]contenttype.Field, map[string]string) {
+	validationErrors := make(map[string]string)
+
+	if input.Name == "" {
+		validationErrors["name"] = "Name is required"
+	} else if len(input.Name) > maxContentTypeNameLen {
+		validationErrors["name"] = "Name must be less than 255 characters"
+	}
+
+	switch {
+	case input.Slug == "":
+		validationErrors["slug"] = "Slug is required"
+	case len(input.Slug) > maxContentTypeSlugLen:
+		validationErrors["slug"] = fmt.Sprintf("Slug must be at most %d characters", maxContentTypeSlugLen)
+	case !util.IsValidSlug(input.Slug):
+		validationErrors["slug"] = "Invalid slug format (use lowercase letters, numbers, and hyphens)"
+	case contenttype.IsBuiltinPageType(input.Slug):
+		validationErrors["slug"] = "This slug is reserved for a built-in page type"
+	default:
+		existing, err := h.queries.GetContentTypeBySlug(ctx, input.Slug)
+		if err == nil && existing.ID != excludeID {
+			validationErrors["slug"] = "Slug already exists"
+		} else if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("failed to check content type slug", "error", err)
+			validationErrors["slug"] = "Error checking slug"
+		}
+	}
+
+	if input.Template != "" && !contentTypeTemplatePattern.MatchString(input.Template) {
+		validationErrors["template"] = "Template names may contain only lowercase letters, digits, hyphens and underscores"
+	}
+
+	fields, err := contenttype.DecodeFields(input.Fields)
+	if err != nil {
+		validationErrors["fields"] = err.Error()
+	}
+
+	return fields, validationErrors
+}
+
+// indentFieldsJSON pretty-prints a stored field schema for editing.
+func indentFieldsJSON(raw string) string {
+	fields := contenttype.ParseFields(raw)
+	if len(fields) == 0 {
+		return "[]"
+	}
+	b, err := json.MarshalIndent(fields, "", "  ")
+	if err != nil {
+		return raw
+	}
+	return string(b)
+}
+
+// renderForm renders the new or edit content type form.
+func (h *ContentTypesHandler) renderForm(w http.ResponseWriter, r *http.Request, data ContentTypeFormData) {
+	lang := middleware.GetAdminLang(r)
+
+	title := i18n.T(lang, "content_types.new")
+	breadcrumbs := contentTypeNewBreadcrumbs(lang)
+	if data.IsEdit && data.ContentType != nil {
+		title = i18n.T(lang, "content_types.edit")
+		breadcrumbs = contentTypeEditBreadcrumbs(lang, *data.ContentType)
+		if count, err := h.queries.CountPagesByPageType(r.Context(), data.ContentType.Slug); err == nil {
+			data.PageCount = count
+		}
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, title, breadcrumbs)
+	renderTempl(w, r, adminviews.ContentTypeFormPage(pc, convertContentTypeFormViewData(data)))
+}
+
+// requireContentTypeWithRedirect fetches a content type by ID and redirects with flash on error.
+func (h *ContentTypesHandler) requireContentTypeWithRedirect(w http.ResponseWriter, r *http.Request, id int64) (store.ContentType, bool) {
+	return requireEntityWithRedirect(w, r, h.renderer, redirectAdminContentTypes, "Content type", id,
+		func(id int64) (store.ContentType, error) { return h.queries.GetContentTypeByID(r.Context(), id) })
+}
+
+// sendDeleteError sends an error response for delete operations.
+func (h *ContentTypesHandler) sendDeleteError(w http.ResponseWriter, message string) {
+	w.Header().Set("HX-Reswap", "none")
+	w.Header().Set("HX-Trigger", `{"showToast": "`+message+`", "toastType": "error"}`)
+	w.WriteHeader(http.StatusBadRequest)
+}
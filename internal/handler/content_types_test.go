@@ -0,0 +1,160 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/contenttype"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+const testEventFields = `[{"name":"venue","label":"Venue","type":"text","required":true},{"name":"host","label":"Host","type":"page"}]`
+
+// newTestContentType creates a content type with the event test schema.
+func newTestContentType(t *testing.T, h *ContentTypesHandler, slug string) store.ContentType {
+	t.Helper()
+
+	now := time.Now()
+	ct, err := h.queries.CreateContentType(context.Background(), store.CreateContentTypeParams{
+		Name:      "Event",
+		Slug:      slug,
+		Fields:    testEventFields,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateContentType: %v", err)
+	}
+	return ct
+}
+
+// newTestTypedPage creates a published page with the given page type.
+func newTestTypedPage(t *testing.T, db *sql.DB, authorID int64, slug, pageType string) store.Page {
+	t.Helper()
+
+	now := time.Now()
+	page, err := store.New(db).CreatePage(context.Background(), store.CreatePageParams{
+		Title:        slug,
+		Slug:         slug,
+		Status:       "published",
+		PageType:     pageType,
+		AuthorID:     authorID,
+		LanguageCode: "en",
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		SiteID:       store.DefaultSiteID,
+	})
+	if err != nil {
+		t.Fatalf("CreatePage: %v", err)
+	}
+	return page
+}
+
+func TestValidateContentTypeForm(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	h := NewContentTypesHandler(db, nil, sm)
+	existing := newTestContentType(t, h, "event")
+
+	tests := []struct {
+		name      string
+		input     contentTypeFormInput
+		excludeID int64
+		wantErr   string
+	}{
+		{"valid", contentTypeFormInput{Name: "Recipe", Slug: "recipe", Fields: testEventFields}, 0, ""},
+		{"missing name", contentTypeFormInput{Slug: "recipe"}, 0, "name"},
+		{"bad slug", contentTypeFormInput{Name: "Recipe", Slug: "Recipe!"}, 0, "slug"},
+		{"builtin slug", contentTypeFormInput{Name: "Posts", Slug: "post"}, 0, "slug"},
+		{"duplicate slug", contentTypeFormInput{Name: "Event", Slug: "event"}, 0, "slug"},
+		{"same slug on edit", contentTypeFormInput{Name: "Event", Slug: "event"}, existing.ID, ""},
+		{"bad template", contentTypeFormInput{Name: "Recipe", Slug: "recipe", Template: "../page"}, 0, "template"},
+		{"bad schema", contentTypeFormInput{Name: "Recipe", Slug: "recipe", Fields: `[{"name":"x","type":"nope"}]`}, 0, "fields"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := h.validateContentTypeForm(context.Background(), tt.input, tt.excludeID)
+			if tt.wantErr == "" {
+				if len(errs) > 0 {
+					t.Errorf("unexpected errors: %v", errs)
+				}
+				return
+			}
+			if _, ok := errs[tt.wantErr]; !ok {
+				t.Errorf("errors = %v, want key %q", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestContentTypeDelete_RefusedWhilePagesUseIt(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	user := createTestAdminUser(t, db)
+	h := NewContentTypesHandler(db, nil, sm)
+	ct := newTestContentType(t, h, "event")
+	page := newTestTypedPage(t, db, user.ID, "launch", "event")
+
+	deleteContentType := func() *httptest.ResponseRecorder {
+		id := strconv.FormatInt(ct.ID, 10)
+		req := httptest.NewRequest(http.MethodDelete, "/admin/content-types/"+id, nil)
+		req.Header.Set("HX-Request", "true")
+		req = requestWithURLParams(req, map[string]string{"id": id})
+		w := httptest.NewRecorder()
+		h.Delete(w, req)
+		return w
+	}
+
+	assertStatus(t, deleteContentType().Code, http.StatusBadRequest)
+	if _, err := h.queries.GetContentTypeByID(context.Background(), ct.ID); err != nil {
+		t.Fatalf("content type deleted while in use: %v", err)
+	}
+
+	if err := h.queries.DeletePage(context.Background(), page.ID); err != nil {
+		t.Fatalf("DeletePage: %v", err)
+	}
+	assertStatus(t, deleteContentType().Code, http.StatusOK)
+	if _, err := h.queries.GetContentTypeByID(context.Background(), ct.ID); err == nil {
+		t.Fatal("content type still exists after delete")
+	}
+}
+
+func TestParsePageContentFields(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	user := createTestAdminUser(t, db)
+	ct := newTestContentType(t, NewContentTypesHandler(db, nil, sm), "event")
+	host := newTestTypedPage(t, db, user.ID, "host", "page")
+	h := NewPagesHandler(db, nil, sm)
+
+	form := url.Values{}
+	form.Set(contenttype.InputName("venue"), "Hall")
+	form.Set(contenttype.InputName("host"), strconv.FormatInt(host.ID, 10))
+	values, _, errs := h.parsePageContentFields(context.Background(), &ct, form)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if values["venue"] != "Hall" || values["host"] != host.ID {
+		t.Errorf("values = %v, want venue and host", values)
+	}
+
+	form = url.Values{}
+	form.Set(contenttype.InputName("host"), "99999")
+	_, _, errs = h.parsePageContentFields(context.Background(), &ct, form)
+	if _, ok := errs[contentFieldErrorPrefix+"venue"]; !ok {
+		t.Errorf("errors = %v, want a required field error", errs)
+	}
+
+	form.Set(contenttype.InputName("venue"), "Hall")
+	_, _, errs = h.parsePageContentFields(context.Background(), &ct, form)
+	if _, ok := errs[contentFieldErrorPrefix+"host"]; !ok {
+		t.Errorf("errors = %v, want a missing page reference error", errs)
+	}
+}
@@ -278,6 +278,7 @@ func (h *DocsHandler) getEndpoints(lang string) []DocsEndpointGroup {
 			Endpoints: []DocsEndpoint{
 				{Method: "GET", Path: "/", Description: i18n.T(lang, "docs.ep_homepage"), Auth: i18n.T(lang, "docs.auth_none")},
 				{Method: "GET", Path: "/blog", Description: i18n.T(lang, "docs.ep_blog"), Auth: i18n.T(lang, "docs.auth_none")},
+				{Method: "GET", Path: "/blog/{year}/{month}", Description: i18n.T(lang, "docs.ep_blog_archive"), Auth: i18n.T(lang, "docs.auth_none")},
 				{Method: "GET", Path: "/sitemap.xml", Description: i18n.T(lang, "docs.ep_sitemap"), Auth: i18n.T(lang, "docs.auth_none")},
 				{Method: "GET", Path: "/robots.txt", Description: i18n.T(lang, "docs.ep_robots"), Auth: i18n.T(lang, "docs.auth_none")},
 			},
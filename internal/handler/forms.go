@@ -25,6 +25,7 @@ import (
 
 	"github.com/olegiv/ocms-go/internal/cache"
 	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/machinetranslation"
 	"github.com/olegiv/ocms-go/internal/middleware"
 	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/module"
@@ -52,6 +53,7 @@ type FormsHandler struct {
 	frontendHandler *FrontendHandler
 	requireCaptcha  bool
 	webhookDataMode string
+	translator      *machinetranslation.Translator
 }
 
 const maxPublicFormBodyBytes int64 = 64 * 1024
@@ -112,6 +114,11 @@ func (h *FormsHandler) SetWebhookFormDataMode(mode string) {
 	h.webhookDataMode = normalizeFormWebhookDataMode(mode)
 }
 
+// SetMachineTranslator enables machine translation of new form translations.
+func (h *FormsHandler) SetMachineTranslator(t *machinetranslation.Translator) {
+	h.translator = t
+}
+
 // dispatchFormEvent dispatches a form submission webhook event.
 func (h *FormsHandler) dispatchFormEvent(ctx context.Context, form store.Form, submissionID int64, data map[string]string) {
 	if h.dispatcher == nil {
@@ -237,6 +244,7 @@ func parseFormInput(r *http.Request) formInput {
 		"description":     description,
 		"success_message": successMessage,
 		"email_to":        emailTo,
+		"mt_reviewed":     r.FormValue("mt_reviewed"),
 	}
 	if isActive {
 		formValues["is_active"] = "true"
@@ -508,6 +516,7 @@ func (h *FormsHandler) updateLanguageContext(w http.ResponseWriter, r *http.Requ
 		NativeName: formLang.NativeName,
 		Direction:  formLang.Direction,
 		IsDefault:  formLang.IsDefault,
+		Host:       siteLanguageHost(r.Context(), formLang.Host),
 	}
 
 	ctx := r.Context()
@@ -523,6 +532,7 @@ func (h *FormsHandler) updateLanguageContext(w http.ResponseWriter, r *http.Requ
 func (h *FormsHandler) renderFormFormPage(w http.ResponseWriter, r *http.Request, data FormFormData, title string, breadcrumbs []render.Breadcrumb) {
 	pc := buildPageContext(r, h.sessionManager, h.renderer, title, breadcrumbs)
 	viewData := convertFormFormViewData(data, h.renderer)
+	viewData.MachineTranslation = convertMachineTranslationView(data.MachineTranslation, data.FormValues["mt_reviewed"], h.renderer, pc.AdminLang)
 	renderTempl(w, r, adminviews.FormsFormPage(pc, viewData))
 }
 
@@ -586,6 +596,8 @@ type FormFormData struct {
 	Translations     []FormTranslationInfo // Existing translations
 	MissingLanguages []store.Language      // Languages without translations
 	PublicURL        string
+	// Machine translation awaiting review
+	MachineTranslation *store.MachineTranslation
 }
 
 // NewForm handles GET /admin/forms/new - displays the new form form.
@@ -719,6 +731,7 @@ func (h *FormsHandler) EditForm(w http.ResponseWriter, r *http.Request) {
 		MissingLanguages: langInfo.MissingLanguages,
 		PublicURL:        publicFormPath(r.Context(), h.queries, *form),
 	}
+	data.MachineTranslation = loadMachineTranslation(r.Context(), h.queries, model.EntityTypeForm, id)
 
 	h.renderFormFormPage(w, r, data, fmt.Sprintf("Edit Form - %s", form.Name), formsEditBreadcrumbs(lang, form.Name, form.ID))
 }
@@ -781,6 +794,7 @@ func (h *FormsHandler) Update(w http.ResponseWriter, r *http.Request) {
 			Translations:     langInfo.Translations,
 			MissingLanguages: langInfo.MissingLanguages,
 		}
+		data.MachineTranslation = loadMachineTranslation(r.Context(), h.queries, model.EntityTypeForm, id)
 
 		h.renderFormFormPage(w, r, data, fmt.Sprintf("Edit Form - %s", form.Name), formsEditBreadcrumbs(lang, form.Name, form.ID))
 		return
@@ -804,6 +818,7 @@ func (h *FormsHandler) Update(w http.ResponseWriter, r *http.Request) {
 		flashError(w, r, h.renderer, fmt.Sprintf(redirectAdminFormsID, id), "Error updating form")
 		return
 	}
+	confirmMachineTranslation(r.Context(), h.queries, model.EntityTypeForm, id, input.FormValues["mt_reviewed"])
 
 	slog.Info("form updated", "form_id", id, "updated_by", middleware.GetUserID(r))
 	flashSuccess(w, r, h.renderer, fmt.Sprintf(redirectAdminFormsID, id), "Form updated successfully")
@@ -2025,13 +2040,50 @@ func (h *FormsHandler) TranslateForm(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Create the translated form with same properties
-	translatedForm, err := h.queries.CreateForm(r.Context(), store.CreateFormParams{
-		Name:           sourceForm.Name, // Keep same name (user will translate)
-		Slug:           setup.TranslatedSlug,
+	sourceFields, fieldsErr := h.queries.GetFormFields(r.Context(), id)
+	if fieldsErr != nil {
+		slog.Error("failed to get source form fields", "error", fieldsErr, "form_id", id)
+	}
+
+	// Create the translated form with same properties, unless a machine
+	// translation provider pre-fills the texts. Field names and option
+	// values are submitted data and stay untranslated.
+	params := store.CreateFormParams{
+		Name:           sourceForm.Name,
 		Title:          sourceForm.Title,
 		Description:    sourceForm.Description,
 		SuccessMessage: sourceForm.SuccessMessage,
+	}
+	segments := []machinetranslation.Segment{
+		machinetranslation.Text(sourceForm.Name),
+		machinetranslation.Text(sourceForm.Title),
+		machinetranslation.Text(sourceForm.Description.String),
+		machinetranslation.Text(sourceForm.SuccessMessage.String),
+	}
+	for _, field := range sourceFields {
+		segments = append(segments,
+			machinetranslation.Text(field.Label),
+			machinetranslation.Text(field.Placeholder.String),
+			machinetranslation.Text(field.HelpText.String))
+	}
+	mt := machineTranslate(r.Context(), h.translator, sourceForm.LanguageCode, setup.TargetContext.TargetLang.Code, segments...)
+	if mt != nil {
+		params.Name, params.Title = mt[0], mt[1]
+		params.Description.String, params.SuccessMessage.String = mt[2], mt[3]
+		for i := range sourceFields {
+			field := &sourceFields[i]
+			field.Label = mt[4+3*i]
+			field.Placeholder.String = mt[5+3*i]
+			field.HelpText.String = mt[6+3*i]
+		}
+	}
+
+	translatedForm, err := h.queries.CreateForm(r.Context(), store.CreateFormParams{
+		Name:           params.Name,
+		Slug:           setup.TranslatedSlug,
+		Title:          params.Title,
+		Description:    params.Description,
+		SuccessMessage: params.SuccessMessage,
 		EmailTo:        sourceForm.EmailTo,
 		IsActive:       false, // Start as inactive until translated
 		LanguageCode:   setup.TargetContext.TargetLang.Code,
@@ -2045,31 +2097,29 @@ func (h *FormsHandler) TranslateForm(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Copy all form fields to the translated form
-	sourceFields, err := h.queries.GetFormFields(r.Context(), id)
-	if err != nil {
-		slog.Error("failed to get source form fields", "error", err, "form_id", id)
-	} else {
-		for _, field := range sourceFields {
-			_, err := h.queries.CreateFormField(r.Context(), store.CreateFormFieldParams{
-				FormID:       translatedForm.ID,
-				Type:         field.Type,
-				Name:         field.Name,
-				Label:        field.Label,
-				Placeholder:  field.Placeholder,
-				HelpText:     field.HelpText,
-				Options:      field.Options,
-				Validation:   field.Validation,
-				IsRequired:   field.IsRequired,
-				Position:     field.Position,
-				LanguageCode: setup.TargetContext.TargetLang.Code,
-				CreatedAt:    setup.Now,
-				UpdatedAt:    setup.Now,
-			})
-			if err != nil {
-				slog.Error("failed to copy form field", "error", err, "field_id", field.ID)
-			}
+	for _, field := range sourceFields {
+		_, err := h.queries.CreateFormField(r.Context(), store.CreateFormFieldParams{
+			FormID:       translatedForm.ID,
+			Type:         field.Type,
+			Name:         field.Name,
+			Label:        field.Label,
+			Placeholder:  field.Placeholder,
+			HelpText:     field.HelpText,
+			Options:      field.Options,
+			Validation:   field.Validation,
+			IsRequired:   field.IsRequired,
+			Position:     field.Position,
+			LanguageCode: setup.TargetContext.TargetLang.Code,
+			CreatedAt:    setup.Now,
+			UpdatedAt:    setup.Now,
+		})
+		if err != nil {
+			slog.Error("failed to copy form field", "error", err, "field_id", field.ID)
 		}
 	}
+	if mt != nil {
+		markMachineTranslated(r.Context(), h.queries, h.translator, model.EntityTypeForm, translatedForm.ID)
+	}
 
 	// Create translation link from source to translated form
 	_, err = h.queries.CreateTranslation(r.Context(), store.CreateTranslationParams{
@@ -2082,8 +2132,11 @@ func (h *FormsHandler) TranslateForm(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		slog.Error("failed to create translation link", "error", err)
 		// Form was created, so we should still redirect to it
-	} else if h.cacheManager != nil {
-		h.cacheManager.Translation.InvalidateType(model.EntityTypeForm)
+	} else {
+		if h.cacheManager != nil {
+			h.cacheManager.Translation.InvalidateType(model.EntityTypeForm)
+		}
+		dispatchTranslationLinked(r.Context(), h.dispatcher, model.EntityTypeForm, id, translatedForm.ID, setup.TargetContext.TargetLang.Code)
 	}
 
 	slog.Info("form translation created",
@@ -2093,7 +2146,8 @@ func (h *FormsHandler) TranslateForm(w http.ResponseWriter, r *http.Request) {
 		"created_by", middleware.GetUserID(r))
 
 	flashSuccess(w, r, h.renderer, fmt.Sprintf(redirectAdminFormsID, translatedForm.ID),
-		fmt.Sprintf("Translation created for %s. Please translate the form content.", setup.TargetContext.TargetLang.Name))
+		machineTranslationFlash(mt != nil, h.translator,
+			fmt.Sprintf("Translation created for %s.", setup.TargetContext.TargetLang.Name), "Please translate the form content."))
 }
 
 // FormTemplateData holds data for form template rendering.
@@ -2115,7 +2169,7 @@ type FormTemplateData struct {
 // For templ engine: renders via templ component.
 // For html engine: renders via html/template through activeTheme.RenderPage.
 func (h *FormsHandler) render(w http.ResponseWriter, r *http.Request, data FormTemplateData) {
-	activeTheme := h.themeManager.GetActiveTheme()
+	activeTheme := siteTheme(r.Context(), h.themeManager)
 
 	engine := theme.EngineTempl
 	if activeTheme != nil {
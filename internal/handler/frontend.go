@@ -29,14 +29,17 @@ import (
 	"github.com/olegiv/ocms-go/internal/i18n"
 	"github.com/olegiv/ocms-go/internal/middleware"
 	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/module"
 	"github.com/olegiv/ocms-go/internal/security"
 	"github.com/olegiv/ocms-go/internal/seo"
 	mdneg "github.com/olegiv/ocms-go/internal/seo/markdown"
 	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/shortcode"
 	"github.com/olegiv/ocms-go/internal/store"
 	"github.com/olegiv/ocms-go/internal/theme"
 	"github.com/olegiv/ocms-go/internal/util"
 	"github.com/olegiv/ocms-go/internal/video"
+	"github.com/olegiv/ocms-go/internal/widget"
 )
 
 // PageView represents a page with computed fields for template rendering.
@@ -47,6 +50,7 @@ type PageView struct {
 	ID                    int64
 	Title                 string
 	Slug                  string
+	Path                  string // Slug prefixed with the slugs of parent pages
 	Body                  template.HTML
 	Excerpt               string
 	URL                   string
@@ -70,6 +74,8 @@ type PageView struct {
 	VideoTitle            string        // Optional video title/caption
 	VideoEmbedHTML        template.HTML // Server-generated safe iframe embed
 	ReadingTime           int           // Estimated reading time in minutes
+	CommentCount          int64         // Approved comments, 0 when comments are disabled
+	Access                string        // "public", "members" or "roles"
 	Highlight             string        // Search result highlight
 	Author                *AuthorView
 	Category              *CategoryView
@@ -89,6 +95,12 @@ type PageView struct {
 	NoIndex         bool
 	NoFollow        bool
 	CanonicalURL    string
+	// Content type (nil for the built-in post and page types) and its field
+	// values by name
+	ContentType *ContentTypeView
+	Fields      map[string]any
+	// Key/value custom fields by name; theme-defined fields are always present
+	CustomFields map[string]any
 }
 
 // AuthorView represents an author for template rendering.
@@ -221,6 +233,10 @@ type BaseTemplateData struct {
 	ShowSearch  bool
 	SearchQuery string
 
+	// Member links, nil when registration is closed and the visitor is not
+	// logged in
+	Members *MembersNav
+
 	// Widgets - map of widget area ID to widgets
 	Widgets map[string][]service.WidgetView
 
@@ -266,6 +282,7 @@ type LanguageView struct {
 	Direction  string
 	IsDefault  bool
 	IsCurrent  bool
+	URL        string // Language switch link: "/?lang=ru", or the root of the language's host
 }
 
 // TranslationLink represents a translation for the language switcher.
@@ -289,6 +306,10 @@ type MenuItem struct {
 	Target   string
 	Children []MenuItem
 	IsActive bool
+	// MegaMenu shows Children side by side as columns; ImageURL is the
+	// optional image of a column.
+	MegaMenu bool
+	ImageURL string
 }
 
 // RecentPost holds minimal data for sidebar recent posts widget.
@@ -322,6 +343,15 @@ type PageData struct {
 	Page          *PageView
 	RelatedPages  []PageView
 	ShowAuthorBox bool
+	// Page tree: the trail from the home page to this page, and the
+	// published subpages of this page in tree order
+	Breadcrumbs []BreadcrumbView
+	ChildPages  []service.PageLink
+	// Series the page is part of, with prev/next links (nil if none)
+	Series *service.SeriesNav
+	// Comments of the page and the comment form (nil when comments are
+	// disabled, or closed on a page without comments)
+	Comments *CommentsView
 	// Sidebar data for themes that show sidebar on single pages
 	Categories  []CategoryView
 	Tags        []TagView
@@ -412,12 +442,16 @@ type FrontendHandler struct {
 	menuService         *service.MenuService
 	widgetService       *service.WidgetService
 	searchService       *service.SearchService
+	relatedService      *service.RelatedService
 	cacheManager        *cache.Manager
 	eventService        *service.EventService
 	logger              *slog.Logger
 	sanitizePages       bool
 	videoRegistry       *video.Registry
 	moduleFuncsProvider ModuleTemplateFuncsProvider
+	moduleShortcodes    ModuleShortcodesProvider
+	moduleWidgets       ModuleWidgetTypesProvider
+	hookRegistry        *module.HookRegistry
 
 	// openAPISpecProvider returns the served OpenAPI 3.1 document as bytes
 	// (identical to what /api/v2/openapi.json emits). When set, the SHA-256
@@ -442,16 +476,17 @@ func NewFrontendHandler(db *sql.DB, themeManager *theme.Manager, cacheManager *c
 		menuService = service.NewMenuService(db, menuCache)
 	}
 	return &FrontendHandler{
-		db:            db,
-		queries:       store.New(db),
-		themeManager:  themeManager,
-		menuService:   menuService,
-		widgetService: service.NewWidgetService(db),
-		searchService: service.NewSearchService(db),
-		cacheManager:  cacheManager,
-		eventService:  eventService,
-		logger:        logger,
-		videoRegistry: video.NewRegistry(),
+		db:             db,
+		queries:        store.New(db),
+		themeManager:   themeManager,
+		menuService:    menuService,
+		widgetService:  service.NewWidgetService(db),
+		searchService:  service.NewSearchService(db),
+		relatedService: service.NewRelatedService(db),
+		cacheManager:   cacheManager,
+		eventService:   eventService,
+		logger:         logger,
+		videoRegistry:  video.NewRegistry(),
 	}
 }
 
@@ -534,58 +569,14 @@ func requestPageOrigin(r *http.Request) string {
 	if r == nil {
 		return ""
 	}
-	host := resolveRequestHost(r)
+	host := middleware.RequestHost(r)
 	if host == "" {
 		return ""
 	}
-	scheme := resolveRequestScheme(r)
+	scheme := middleware.RequestScheme(r)
 	return strings.ToLower(scheme + "://" + canonicalHost(host, scheme))
 }
 
-// resolveRequestHost returns the public host the page is served from,
-// honouring X-Forwarded-Host only when the request comes from a configured
-// trusted proxy. Falls back to r.Host. Untrusted clients can set this
-// header but it is ignored to prevent spoofing the bound origin.
-func resolveRequestHost(r *http.Request) string {
-	if middleware.WasFromTrustedProxy(r) {
-		if fwd := firstForwardedValue(r.Header.Get("X-Forwarded-Host")); fwd != "" {
-			return fwd
-		}
-	}
-	return r.Host
-}
-
-// resolveRequestScheme returns "http" or "https" for the request, preferring
-// the TLS state on the connection, then X-Forwarded-Proto (leftmost value).
-// X-Forwarded-Proto is honoured only when the immediate peer is a trusted
-// proxy, matching the X-Forwarded-Host gate so untrusted clients cannot
-// spoof the bound scheme.
-func resolveRequestScheme(r *http.Request) string {
-	if r.TLS != nil {
-		return "https"
-	}
-	if middleware.WasFromTrustedProxy(r) {
-		if proto := firstForwardedValue(r.Header.Get("X-Forwarded-Proto")); proto != "" {
-			return strings.ToLower(proto)
-		}
-	}
-	return "http"
-}
-
-// firstForwardedValue returns the leftmost value of a possibly comma-separated
-// forwarded header (e.g., X-Forwarded-Host, X-Forwarded-Proto), trimmed.
-// Returns "" if the input is empty or only whitespace.
-func firstForwardedValue(raw string) string {
-	v := strings.TrimSpace(raw)
-	if v == "" {
-		return ""
-	}
-	if comma := strings.Index(v, ","); comma >= 0 {
-		v = strings.TrimSpace(v[:comma])
-	}
-	return v
-}
-
 // canonicalHost strips a default port from host when it matches the scheme's
 // default (80 for http, 443 for https). Browsers omit default ports when
 // serializing the Origin header (RFC 6454 §6.2), so a render-time token
@@ -645,9 +636,10 @@ func (h *FrontendHandler) Home(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get base template data
+	r = requestWithWidgetPage(r, widget.PageHome)
 	base := h.getBaseTemplateData(r, "", "")
 	base.MetaDescription = base.Site.Description
-	base.Canonical = strings.TrimRight(h.getSiteURL(ctx, r), "/") + base.HomeURL
+	base.Canonical = languageOrigin(r, h.getSiteURL(ctx, r)) + base.HomeURL
 	base.OGURL = base.Canonical
 	base.BodyClass = "home"
 
@@ -656,12 +648,14 @@ func (h *FrontendHandler) Home(w http.ResponseWriter, r *http.Request) {
 	var err error
 	if languageCode != "" {
 		recentPages, err = h.queries.ListPublishedPostsByLanguage(ctx, store.ListPublishedPostsByLanguageParams{
+			SiteID:       store.SiteIDFromContext(ctx),
 			LanguageCode: languageCode,
 			Limit:        10,
 			Offset:       0,
 		})
 	} else {
 		recentPages, err = h.queries.ListPublishedPosts(ctx, store.ListPublishedPostsParams{
+			SiteID: store.SiteIDFromContext(ctx),
 			Limit:  10,
 			Offset: 0,
 		})
@@ -673,11 +667,7 @@ func (h *FrontendHandler) Home(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Convert to PageViews
-	recentPageViews := make([]PageView, 0, len(recentPages))
-	for _, p := range recentPages {
-		pv := h.pageToView(ctx, p, languageCode, base.LangPrefix)
-		recentPageViews = append(recentPageViews, pv)
-	}
+	recentPageViews := h.pagesToViews(ctx, recentPages, languageCode, base.LangPrefix)
 
 	// Content negotiation: serve Markdown when the client prefers it.
 	// See docs/agent-ready.md and internal/seo/markdown.
@@ -688,16 +678,17 @@ func (h *FrontendHandler) Home(w http.ResponseWriter, r *http.Request) {
 	// When unset, fall through to the HTML path.
 	if mdneg.WantsMarkdown(r) {
 		if siteURL := strings.TrimRight(h.getConfiguredSiteURL(ctx), "/"); siteURL != "" {
+			origin := languageOrigin(r, siteURL)
 			mdRecent := make([]mdneg.RecentPost, 0, len(recentPageViews))
 			for _, pv := range recentPageViews {
 				mdRecent = append(mdRecent, mdneg.RecentPost{
 					Title:       pv.Title,
-					URL:         siteURL + pv.URL,
+					URL:         origin + pv.URL,
 					PublishedAt: pv.PublishedAt,
 					Excerpt:     pv.Excerpt,
 				})
 			}
-			body := mdneg.HomeToMarkdown(base.SiteName, base.Site.Description, siteURL+base.HomeURL, mdRecent, h.markdownLabels(r))
+			body := mdneg.HomeToMarkdown(base.SiteName, base.Site.Description, origin+base.HomeURL, mdRecent, h.markdownLabels(r))
 			mdneg.WriteMarkdown(w, body)
 			return
 		}
@@ -710,7 +701,10 @@ func (h *FrontendHandler) Home(w http.ResponseWriter, r *http.Request) {
 	if languageCode != "" {
 		taxonomyPrefixes := map[string]string{languageCode: base.LangPrefix}
 		// Get categories with usage counts filtered by language
-		categoriesWithCount, err := h.queries.GetCategoryUsageCountsByLanguage(ctx, languageCode)
+		categoriesWithCount, err := h.queries.GetCategoryUsageCountsByLanguage(ctx, store.GetCategoryUsageCountsByLanguageParams{
+			LanguageCode: languageCode,
+			SiteID:       store.SiteIDFromContext(ctx),
+		})
 		if err != nil {
 			h.logger.Error("failed to get categories", "error", err)
 		}
@@ -732,6 +726,7 @@ func (h *FrontendHandler) Home(w http.ResponseWriter, r *http.Request) {
 
 		// Get tags with usage counts filtered by language
 		tagsWithCount, err := h.queries.GetTagUsageCountsByLanguage(ctx, store.GetTagUsageCountsByLanguageParams{
+			SiteID:         store.SiteIDFromContext(ctx),
 			LanguageCode:   languageCode,
 			LanguageCode_2: languageCode,
 			Limit:          20,
@@ -815,7 +810,7 @@ func (h *FrontendHandler) Home(w http.ResponseWriter, r *http.Request) {
 
 	// Build homepage translations for language switcher
 	if base.ShowLanguagePicker {
-		base.Translations, base.HrefLangs = h.getHomepageTranslations(base.LangCode, base.SiteURL, base.Languages)
+		base.Translations, base.HrefLangs = h.getHomepageTranslations(ctx, base.LangCode, base.SiteURL, base.Languages)
 	}
 
 	// Enable sidebar for homepage
@@ -836,11 +831,10 @@ func (h *FrontendHandler) Home(w http.ResponseWriter, r *http.Request) {
 	h.render(w, r, "home", data)
 }
 
-// Page handles single page display.
-// publishedPageForRoute reads the published page this URL owns, through the
-// page cache when one is configured.
+// publishedPageForRoute reads the published page this URL owns on the
+// request's site, through the page cache when one is configured.
 //
-// Both paths are scoped to the same language: slugs are unique site-wide
+// Both paths are scoped to the same language: slugs are unique per site
 // rather than per language, so an unscoped read would hand a request routed to
 // one language a page belonging to another.
 func (h *FrontendHandler) publishedPageForRoute(
@@ -859,14 +853,16 @@ func (h *FrontendHandler) publishedPageForRoute(
 	return h.queries.GetPublishedPageBySlugAndLanguage(ctx, store.GetPublishedPageBySlugAndLanguageParams{
 		Slug:         slug,
 		LanguageCode: languageCode,
+		SiteID:       store.SiteIDFromContext(ctx),
 	})
 }
 
+// Page handles single page display.
 func (h *FrontendHandler) Page(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	slug := chi.URLParam(r, "slug")
+	requestPath, slug, validPath := pageRequestPath(r)
 	explicitLangCode, validExplicitLanguage := explicitPageLanguage(r)
-	if !validExplicitLanguage {
+	if !validPath || !validExplicitLanguage {
 		h.renderNotFound(w, r)
 		return
 	}
@@ -874,7 +870,7 @@ func (h *FrontendHandler) Page(w http.ResponseWriter, r *http.Request) {
 	// Resolve the language owned by this URL before looking up the page. An
 	// explicit prefix was already verified by Language middleware; an
 	// unprefixed URL belongs only to the active configured default language.
-	// This prevents globally unique slugs from exposing non-default, inactive,
+	// This prevents per-site slugs shared by all languages from exposing non-default, inactive,
 	// orphaned, or legacy-unsafe language content at the root namespace.
 	routeLanguageCode := explicitLangCode
 	if routeLanguageCode == "" {
@@ -901,13 +897,15 @@ func (h *FrontendHandler) Page(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			// Slug not found - check if it's an alias
-			if h.redirectPageAlias(w, r, slug) {
+			if h.redirectPageAlias(w, r, requestPath) {
 				return
 			}
 
 			// Draft preview for admin/editor users
 			if user := middleware.GetUser(r); user != nil && (user.Role == model.RoleAdmin || user.Role == model.RoleEditor) {
-				draftPage, draftErr := h.queries.GetPageBySlug(ctx, slug)
+				draftPage, draftErr := h.queries.GetPageBySlug(ctx, store.GetPageBySlugParams{
+					Slug: slug, SiteID: store.SiteIDFromContext(ctx),
+				})
 				if draftErr == nil && draftPage.Status != PageStatusPublished &&
 					draftPage.LanguageCode == routeLanguageCode {
 					page = draftPage
@@ -931,6 +929,22 @@ func (h *FrontendHandler) Page(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Pages below a parent live at their nested path. The bare slug of a
+	// subpage redirects there; any other path is not the page's URL.
+	pagePath := h.pagePath(ctx, page)
+	if pagePath != requestPath {
+		if requestPath != slug || !writePermanentPageRedirect(w, canonicalLanguagePrefix, pagePath) {
+			h.renderNotFound(w, r)
+		}
+		return
+	}
+
+	// Members-only and role-restricted pages, in both representations
+	if !service.CanViewPage(ctx, h.queries, page.ID, middleware.GetUser(r)) {
+		h.renderPageAccessDenied(w, r, canonicalLanguagePrefix)
+		return
+	}
+
 	// Content negotiation: serve Markdown when the client prefers it.
 	// Runs after the draft-preview guard above, so drafts stay gated to
 	// admins/editors in both representations. Falls through to HTML on
@@ -946,12 +960,15 @@ func (h *FrontendHandler) Page(w http.ResponseWriter, r *http.Request) {
 				t := page.PublishedAt.Time
 				publishedAt = &t
 			}
-			canonicalPath := "/" + page.Slug
+			canonicalPath := "/" + pagePath
+			langPrefix := ""
 			if canonicalLanguagePrefix != "" {
-				canonicalPath = "/" + canonicalLanguagePrefix + "/" + page.Slug
+				langPrefix = "/" + canonicalLanguagePrefix
+				canonicalPath = langPrefix + "/" + pagePath
 			}
-			canonical := siteURL + canonicalPath
-			mdBody, mdErr := mdneg.PageToMarkdown(page.Title, page.Summary, page.Body, canonical, publishedAt, h.markdownLabels(r))
+			canonical := languageOrigin(r, siteURL) + canonicalPath
+			body := h.expandShortcodes(ctx, page.Body, page.LanguageCode, langPrefix)
+			mdBody, mdErr := mdneg.PageToMarkdown(page.Title, page.Summary, body, canonical, publishedAt, h.markdownLabels(r))
 			if mdErr != nil {
 				slog.Error("markdown conversion failed", "error", mdErr, "slug", page.Slug)
 			} else {
@@ -976,10 +993,14 @@ func (h *FrontendHandler) Page(w http.ResponseWriter, r *http.Request) {
 
 	// Get base template data first (for site info and language context)
 	// Note: We use empty title/excerpt initially, will update after pageView is created
+	r = requestWithWidgetPage(r, page.PageType, h.pageCategoryIDs(ctx, page.ID)...)
 	base := h.getBaseTemplateData(r, "", "")
 
 	// Convert to PageView
 	pageView := h.pageToView(ctx, page, base.LangCode, base.LangPrefix)
+	contentType := h.applyContentType(ctx, &pageView, page)
+	h.applyCustomFields(ctx, &pageView, page)
+	h.applyPageBlocks(ctx, &pageView, page)
 
 	// Update base data with page title and excerpt
 	base.Title = pageView.Title
@@ -993,27 +1014,8 @@ func (h *FrontendHandler) Page(w http.ResponseWriter, r *http.Request) {
 		pageView.FeaturedImage = pageView.FeaturedImageMedium
 	}
 
-	// Get related pages (same category)
-	var relatedPages []PageView
-	if pageView.Category != nil {
-		related, err := h.queries.ListPublishedPagesByCategoryAndLanguage(ctx, store.ListPublishedPagesByCategoryAndLanguageParams{
-			CategoryID:   pageView.Category.ID,
-			LanguageCode: base.LangCode,
-			Limit:        4,
-			Offset:       0,
-		})
-		if err == nil {
-			for _, p := range related {
-				if p.ID != page.ID {
-					relatedPages = append(relatedPages, h.pageToView(ctx, p, base.LangCode, base.LangPrefix))
-				}
-			}
-			// Limit to 3 related pages
-			if len(relatedPages) > 3 {
-				relatedPages = relatedPages[:3]
-			}
-		}
-	}
+	// Related pages: editor picks, then pages sharing tags, categories or text
+	relatedPages := h.pagesToViews(ctx, h.relatedService.RelatedPages(ctx, page, service.DefaultRelatedLimit), base.LangCode, base.LangPrefix)
 
 	// Build SEO meta with fallbacks
 	siteConfig := &seo.SiteConfig{
@@ -1041,7 +1043,7 @@ func (h *FrontendHandler) Page(w http.ResponseWriter, r *http.Request) {
 	pageData := &seo.PageData{
 		Title:           pageView.Title,
 		Body:            string(pageView.Body),
-		Slug:            pageSEOSlug(base.LangPrefix, pageView.Slug),
+		Slug:            pageSEOSlug(base.LangPrefix, pageView.Path),
 		MetaTitle:       pageView.MetaTitle,
 		MetaDescription: pageView.MetaDescription,
 		MetaKeywords:    pageView.MetaKeywords,
@@ -1084,8 +1086,13 @@ func (h *FrontendHandler) Page(w http.ResponseWriter, r *http.Request) {
 		base.Robots = "noindex, nofollow"
 	}
 
-	// Build JSON-LD structured data
+	// Build JSON-LD structured data. Subpages also describe their position
+	// in the page tree.
+	breadcrumbs := h.pageBreadcrumbs(ctx, page, pageView, base)
 	base.JSONLD = seo.BuildArticleSchema(pageData, siteConfig, page.UpdatedAt)
+	if len(breadcrumbs) > 2 {
+		base.JSONLD = seo.CombineJSONLD(base.JSONLD, seo.BuildBreadcrumbSchema(breadcrumbSchemaItems(breadcrumbs), siteConfig))
+	}
 
 	// Get translations for language switcher and hreflang
 	if base.ShowLanguagePicker {
@@ -1108,12 +1115,16 @@ func (h *FrontendHandler) Page(w http.ResponseWriter, r *http.Request) {
 		Page:             &pageView,
 		RelatedPages:     relatedPages,
 		ShowAuthorBox:    true,
+		Breadcrumbs:      breadcrumbs,
+		ChildPages:       service.ChildPageLinks(ctx, h.queries, page.ID, base.LangPrefix),
+		Series:           service.PageSeriesNav(ctx, h.queries, page.ID, base.LangPrefix),
+		Comments:         h.pageComments(r, page, base),
 		Categories:       sidebarCategories,
 		Tags:             sidebarTags,
 		RecentPages:      sidebarRecent,
 	}
 
-	h.render(w, r, "page", data)
+	h.render(w, r, h.pageTemplateName(r.Context(), contentType), data)
 }
 
 // PageByID handles /page/{id} - redirects to the canonical slug URL.
@@ -1145,7 +1156,7 @@ func (h *FrontendHandler) PageByID(w http.ResponseWriter, r *http.Request) {
 		page, err = h.queries.GetPublishedPageByID(ctx, id)
 	}
 
-	if err != nil {
+	if err != nil || page.SiteID != store.SiteIDFromContext(ctx) {
 		h.renderNotFound(w, r)
 		return
 	}
@@ -1158,7 +1169,7 @@ func (h *FrontendHandler) PageByID(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// HTTP 301 Moved Permanently - signals that this URL permanently redirects to the canonical
-	if !writePermanentPageRedirect(w, languagePrefix, page.Slug) {
+	if !writePermanentPageRedirect(w, languagePrefix, h.pagePath(ctx, page)) {
 		h.renderNotFound(w, r)
 	}
 }
@@ -1207,6 +1218,7 @@ func (h *FrontendHandler) Category(w http.ResponseWriter, r *http.Request) {
 
 	// Get base template data first to access LangPrefix
 	title := "Category: " + category.Name
+	r = requestWithWidgetPage(r, widget.PageCategory, category.ID)
 	base := h.getBaseTemplateData(r, title, category.Description.String)
 
 	// Fetch pages for this category (with optional language filter)
@@ -1218,10 +1230,7 @@ func (h *FrontendHandler) Category(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Convert to PageViews
-	pageViews := make([]PageView, 0, len(pages))
-	for _, p := range pages {
-		pageViews = append(pageViews, h.pageToView(ctx, p, base.LangCode, base.LangPrefix))
-	}
+	pageViews := h.pagesToViews(ctx, pages, base.LangCode, base.LangPrefix)
 
 	categoryView := CategoryView{
 		ID:          category.ID,
@@ -1299,6 +1308,7 @@ func (h *FrontendHandler) Tag(w http.ResponseWriter, r *http.Request) {
 
 	// Get base template data first to access LangPrefix
 	title := "Tag: " + tag.Name
+	r = requestWithWidgetPage(r, widget.PageTag)
 	base := h.getBaseTemplateData(r, title, "")
 
 	// Fetch pages for this tag (with optional language filter)
@@ -1310,10 +1320,7 @@ func (h *FrontendHandler) Tag(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Convert to PageViews
-	pageViews := make([]PageView, 0, len(pages))
-	for _, p := range pages {
-		pageViews = append(pageViews, h.pageToView(ctx, p, base.LangCode, base.LangPrefix))
-	}
+	pageViews := h.pagesToViews(ctx, pages, base.LangCode, base.LangPrefix)
 
 	tagView := TagView{
 		ID:   tag.ID,
@@ -1364,6 +1371,7 @@ func (h *FrontendHandler) Blog(w http.ResponseWriter, r *http.Request) {
 	offset := (page - 1) * defaultPerPage
 
 	// Get base template data first to access LangPrefix
+	r = requestWithWidgetPage(r, widget.PageBlog)
 	base := h.getBaseTemplateData(r, "", "")
 
 	// Get published posts (not pages) filtered by language
@@ -1373,6 +1381,7 @@ func (h *FrontendHandler) Blog(w http.ResponseWriter, r *http.Request) {
 
 	if languageCode != "" {
 		pages, err = h.queries.ListPublishedPostsByLanguage(ctx, store.ListPublishedPostsByLanguageParams{
+			SiteID:       store.SiteIDFromContext(ctx),
 			LanguageCode: languageCode,
 			Limit:        int64(defaultPerPage),
 			Offset:       int64(offset),
@@ -1383,13 +1392,17 @@ func (h *FrontendHandler) Blog(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		total, err = h.queries.CountPublishedPostsByLanguage(ctx, languageCode)
+		total, err = h.queries.CountPublishedPostsByLanguage(ctx, store.CountPublishedPostsByLanguageParams{
+			LanguageCode: languageCode,
+			SiteID:       store.SiteIDFromContext(ctx),
+		})
 		if err != nil {
 			h.logger.Error("failed to count blog posts", "error", err)
 			total = 0
 		}
 	} else {
 		pages, err = h.queries.ListPublishedPosts(ctx, store.ListPublishedPostsParams{
+			SiteID: store.SiteIDFromContext(ctx),
 			Limit:  int64(defaultPerPage),
 			Offset: int64(offset),
 		})
@@ -1399,7 +1412,7 @@ func (h *FrontendHandler) Blog(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		total, err = h.queries.CountPublishedPosts(ctx)
+		total, err = h.queries.CountPublishedPosts(ctx, store.SiteIDFromContext(ctx))
 		if err != nil {
 			h.logger.Error("failed to count blog posts", "error", err)
 			total = 0
@@ -1407,10 +1420,7 @@ func (h *FrontendHandler) Blog(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Convert to PageViews
-	pageViews := make([]PageView, 0, len(pages))
-	for _, p := range pages {
-		pageViews = append(pageViews, h.pageToView(ctx, p, base.LangCode, base.LangPrefix))
-	}
+	pageViews := h.pagesToViews(ctx, pages, base.LangCode, base.LangPrefix)
 	base.BodyClass = "archive blog"
 
 	// Build pagination with language prefix
@@ -1446,6 +1456,7 @@ func (h *FrontendHandler) Search(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get base template data early for language prefix
+	r = requestWithWidgetPage(r, widget.PageSearch)
 	base := h.getBaseTemplateData(r, "Search", "")
 	base.BodyClass = "search"
 
@@ -1471,12 +1482,13 @@ func (h *FrontendHandler) Search(w http.ResponseWriter, r *http.Request) {
 	page := h.getPageNum(r)
 	offset := (page - 1) * defaultPerPage
 
-	// Use FTS5 search service with language filtering
+	// Use FTS5 search service with language and page access filtering
 	searchResults, total, err := h.searchService.SearchPublishedPages(ctx, service.SearchParams{
 		Query:        query,
 		Limit:        defaultPerPage,
 		Offset:       offset,
 		LanguageCode: languageCode,
+		Role:         cache.RoleFromUser(middleware.GetUser(r)),
 	})
 	if err != nil {
 		h.logger.Error("failed to search pages", "query", query, "error", err)
@@ -1518,7 +1530,7 @@ func (h *FrontendHandler) searchResultToPageView(ctx context.Context, sr service
 		Body:      h.trustedPageBody(sr.Body),
 		Excerpt:   sr.Excerpt,
 		Highlight: h.stripHTMLPreserveMark(sr.Highlight),
-		URL:       languagePrefixedURL(langPrefix, "/"+sr.Slug),
+		URL:       languagePrefixedURL(langPrefix, "/"+service.PagePath(ctx, h.queries, sr.ID, sr.Slug)),
 		Status:    sr.Status,
 		Type:      "page",
 		CreatedAt: sr.CreatedAt,
@@ -1585,6 +1597,7 @@ func (h *FrontendHandler) Sitemap(w http.ResponseWriter, r *http.Request) {
 
 // generateSitemap generates sitemap XML without caching.
 func (h *FrontendHandler) generateSitemap(ctx context.Context, siteURL string) ([]byte, error) {
+	siteID := store.SiteIDFromContext(ctx)
 	builder := seo.NewSitemapBuilder(siteURL)
 	defaultLang, err := h.queries.GetDefaultLanguage(ctx)
 	if err != nil {
@@ -1594,6 +1607,9 @@ func (h *FrontendHandler) generateSitemap(ctx context.Context, siteURL string) (
 	if err != nil {
 		return nil, fmt.Errorf("list active languages for sitemap: %w", err)
 	}
+	for _, language := range siteLanguages(ctx, activeLanguages) {
+		builder.SetLanguageHost(language.Code, language.Host)
+	}
 	for _, language := range activeLanguages {
 		if !util.IsValidLangCode(language.Code) || util.IsReservedLanguageCode(language.Code) {
 			continue
@@ -1602,13 +1618,13 @@ func (h *FrontendHandler) generateSitemap(ctx context.Context, siteURL string) (
 	}
 
 	// Add published pages (excluding noindex pages)
-	pages, err := h.queries.ListPublishedPagesForSitemap(ctx)
+	pages, err := h.queries.ListPublishedPagesForSitemap(ctx, siteID)
 	if err != nil {
 		h.logger.Error("failed to get pages for sitemap", "error", err)
 	} else {
 		for _, p := range pages {
 			builder.AddPage(seo.SitemapPage{
-				Slug:         p.Slug,
+				Slug:         service.PagePath(ctx, h.queries, p.ID, p.Slug),
 				LanguageCode: p.LanguageCode,
 				IsDefault:    p.IsDefault,
 				UpdatedAt:    p.UpdatedAt,
@@ -1617,7 +1633,7 @@ func (h *FrontendHandler) generateSitemap(ctx context.Context, siteURL string) (
 	}
 
 	// Add categories
-	categories, err := h.queries.ListCategoriesForSitemap(ctx)
+	categories, err := h.queries.ListCategoriesForSitemap(ctx, siteID)
 	if err != nil {
 		h.logger.Error("failed to get categories for sitemap", "error", err)
 	} else {
@@ -1632,7 +1648,7 @@ func (h *FrontendHandler) generateSitemap(ctx context.Context, siteURL string) (
 	}
 
 	// Add tags
-	tags, err := h.queries.ListTagsForSitemap(ctx)
+	tags, err := h.queries.ListTagsForSitemap(ctx, siteID)
 	if err != nil {
 		h.logger.Error("failed to get tags for sitemap", "error", err)
 	} else {
@@ -1658,8 +1674,8 @@ func (h *FrontendHandler) Robots(w http.ResponseWriter, r *http.Request) {
 	disallowAll := false
 	var disallowStr string
 	if h.cacheManager != nil {
-		disallowStr, _ = h.cacheManager.GetConfig(ctx, "robots_disallow_all")
-	} else if cfg, err := h.queries.GetConfigByKey(ctx, "robots_disallow_all"); err == nil {
+		disallowStr, _ = h.cacheManager.GetConfig(ctx, model.ConfigKeyRobotsDisallowAll)
+	} else if cfg, err := h.queries.GetSiteConfigByKey(ctx, model.ConfigKeyRobotsDisallowAll); err == nil {
 		disallowStr = cfg.Value
 	}
 	disallowAll = disallowStr == "1" || disallowStr == "true"
@@ -1667,8 +1683,8 @@ func (h *FrontendHandler) Robots(w http.ResponseWriter, r *http.Request) {
 	// Get extra robots.txt rules from config
 	extraRules := ""
 	if h.cacheManager != nil {
-		extraRules, _ = h.cacheManager.GetConfig(ctx, "robots_txt_extra")
-	} else if cfg, err := h.queries.GetConfigByKey(ctx, "robots_txt_extra"); err == nil {
+		extraRules, _ = h.cacheManager.GetConfig(ctx, model.ConfigKeyRobotsTxtExtra)
+	} else if cfg, err := h.queries.GetSiteConfigByKey(ctx, model.ConfigKeyRobotsTxtExtra); err == nil {
 		extraRules = cfg.Value
 	}
 
@@ -1682,7 +1698,7 @@ func (h *FrontendHandler) Robots(w http.ResponseWriter, r *http.Request) {
 	contentSignal := ""
 	if h.cacheManager != nil {
 		contentSignal, _ = h.cacheManager.GetConfig(ctx, model.ConfigKeyRobotsContentSignal)
-	} else if cfg, err := h.queries.GetConfigByKey(ctx, model.ConfigKeyRobotsContentSignal); err == nil {
+	} else if cfg, err := h.queries.GetSiteConfigByKey(ctx, model.ConfigKeyRobotsContentSignal); err == nil {
 		contentSignal = cfg.Value
 	}
 	switch strings.ToLower(strings.TrimSpace(contentSignal)) {
@@ -1709,8 +1725,8 @@ func (h *FrontendHandler) Security(w http.ResponseWriter, r *http.Request) {
 	// Get security contact from config
 	var contact string
 	if h.cacheManager != nil {
-		contact, _ = h.cacheManager.GetConfig(ctx, "security_contact")
-	} else if cfg, err := h.queries.GetConfigByKey(ctx, "security_contact"); err == nil {
+		contact, _ = h.cacheManager.GetConfig(ctx, model.ConfigKeySecurityContact)
+	} else if cfg, err := h.queries.GetSiteConfigByKey(ctx, model.ConfigKeySecurityContact); err == nil {
 		contact = cfg.Value
 	}
 
@@ -1723,8 +1739,8 @@ func (h *FrontendHandler) Security(w http.ResponseWriter, r *http.Request) {
 	// Get optional security policy URL
 	var policy string
 	if h.cacheManager != nil {
-		policy, _ = h.cacheManager.GetConfig(ctx, "security_policy")
-	} else if cfg, err := h.queries.GetConfigByKey(ctx, "security_policy"); err == nil {
+		policy, _ = h.cacheManager.GetConfig(ctx, model.ConfigKeySecurityPolicy)
+	} else if cfg, err := h.queries.GetSiteConfigByKey(ctx, model.ConfigKeySecurityPolicy); err == nil {
 		policy = cfg.Value
 	}
 
@@ -1799,7 +1815,7 @@ func (h *FrontendHandler) MCPServerCard(w http.ResponseWriter, r *http.Request)
 	version := ""
 	if h.cacheManager != nil {
 		version, _ = h.cacheManager.GetConfig(r.Context(), model.ConfigKeyMCPServerVersion)
-	} else if cfg, err := h.queries.GetConfigByKey(r.Context(), model.ConfigKeyMCPServerVersion); err == nil {
+	} else if cfg, err := h.queries.GetSiteConfigByKey(r.Context(), model.ConfigKeyMCPServerVersion); err == nil {
 		version = cfg.Value
 	}
 	body := seo.BuildMCPServerCard(siteURL, version)
@@ -1811,12 +1827,35 @@ func (h *FrontendHandler) MCPServerCard(w http.ResponseWriter, r *http.Request)
 
 // pageToView converts a store.Page to a PageView with computed fields.
 func (h *FrontendHandler) pageToView(ctx context.Context, p store.Page, langCode, langPrefix string) PageView {
+	return h.pageToViewWithAccess(ctx, p, service.PageAccessRule(ctx, h.queries, p.ID), langCode, langPrefix)
+}
+
+// pagesToViews converts a list of pages to PageViews, loading their access
+// rules with a single query.
+func (h *FrontendHandler) pagesToViews(ctx context.Context, pages []store.Page, langCode, langPrefix string) []PageView {
+	ids := make([]int64, 0, len(pages))
+	for _, p := range pages {
+		ids = append(ids, p.ID)
+	}
+	rules := service.PageAccessRules(ctx, h.queries, ids)
+
+	views := make([]PageView, 0, len(pages))
+	for _, p := range pages {
+		views = append(views, h.pageToViewWithAccess(ctx, p, rules[p.ID], langCode, langPrefix))
+	}
+	return views
+}
+
+// pageToViewWithAccess converts a store.Page with the given access rule to a
+// PageView. The body of pages the visitor may not view is left empty.
+func (h *FrontendHandler) pageToViewWithAccess(ctx context.Context, p store.Page, rule store.PageAccess, langCode, langPrefix string) PageView {
+	path := h.pagePath(ctx, p)
 	pv := PageView{
 		ID:         p.ID,
 		Title:      p.Title,
 		Slug:       p.Slug,
-		Body:       h.trustedPageBody(p.Body),
-		URL:        languagePrefixedURL(langPrefix, "/"+p.Slug),
+		Path:       path,
+		URL:        languagePrefixedURL(langPrefix, "/"+path),
 		Status:     p.Status,
 		Type:       p.PageType,
 		CreatedAt:  p.CreatedAt,
@@ -1825,6 +1864,10 @@ func (h *FrontendHandler) pageToView(ctx context.Context, p store.Page, langCode
 		LangPrefix: langPrefix,
 	}
 
+	if service.CanViewPageWithRule(rule, viewerFromContext(ctx)) {
+		pv.Body = h.trustedPageBody(h.expandShortcodes(ctx, p.Body, langCode, langPrefix))
+	}
+
 	// Set published date
 	if p.PublishedAt.Valid {
 		t := p.PublishedAt.Time
@@ -1832,15 +1875,20 @@ func (h *FrontendHandler) pageToView(ctx context.Context, p store.Page, langCode
 		pv.PublishedAtFormatted = t.Format("Jan 2, 2006")
 	}
 
-	// Use custom summary if set, otherwise auto-generate from body
+	// Use custom summary if set, otherwise auto-generate from body. The body
+	// of restricted pages is kept out of excerpts.
+	pv.Access = rule.Access
+	text := shortcode.Strip(p.Body)
 	if p.Summary != "" {
 		pv.Excerpt = p.Summary
-	} else {
-		pv.Excerpt = h.generateExcerpt(p.Body, 200)
+	} else if pv.Access == model.PageAccessPublic {
+		pv.Excerpt = h.generateExcerpt(text, 200)
 	}
 
+	pv.CommentCount = h.commentCount(ctx, p.ID)
+
 	// Calculate reading time (approximately 200 words per minute)
-	wordCount := len(strings.Fields(h.generateExcerpt(p.Body, len(p.Body))))
+	wordCount := len(strings.Fields(h.generateExcerpt(text, len(text))))
 	pv.ReadingTime = (wordCount + 199) / 200 // Round up
 	if pv.ReadingTime < 1 {
 		pv.ReadingTime = 1
@@ -1918,7 +1966,8 @@ func (h *FrontendHandler) pageToView(ctx context.Context, p store.Page, langCode
 	if (err == nil && len(categories) > 0) || (tagsErr == nil && len(tags) > 0) {
 		languages, languagesErr := h.queries.ListActiveLanguages(ctx)
 		if languagesErr == nil {
-			prefixes := canonicalTaxonomyLanguagePrefixes(languages)
+			urls := newLanguageURLs(h.getConfiguredSiteURL(ctx), siteLanguages(ctx, languages), middleware.HostLanguageFromContext(ctx))
+			prefixes := canonicalTaxonomyLanguagePrefixes(languages, urls)
 			pv.Categories = make([]CategoryView, 0, len(categories))
 			for _, c := range categories {
 				entityURL, ok := canonicalTaxonomyEntityURL(prefixes, c.LanguageCode, redirectCategory, c.Slug)
@@ -2061,7 +2110,10 @@ func (h *FrontendHandler) getSidebarData(ctx context.Context, languageCode, lang
 	if languageCode != "" {
 		taxonomyPrefixes := map[string]string{languageCode: langPrefix}
 		// Get categories with usage counts filtered by language
-		categoriesWithCount, err := h.queries.GetCategoryUsageCountsByLanguage(ctx, languageCode)
+		categoriesWithCount, err := h.queries.GetCategoryUsageCountsByLanguage(ctx, store.GetCategoryUsageCountsByLanguageParams{
+			LanguageCode: languageCode,
+			SiteID:       store.SiteIDFromContext(ctx),
+		})
 		if err != nil {
 			h.logger.Error("failed to get sidebar categories", "error", err)
 		}
@@ -2083,6 +2135,7 @@ func (h *FrontendHandler) getSidebarData(ctx context.Context, languageCode, lang
 
 		// Get tags with usage counts filtered by language
 		tagsWithCount, err := h.queries.GetTagUsageCountsByLanguage(ctx, store.GetTagUsageCountsByLanguageParams{
+			SiteID:         store.SiteIDFromContext(ctx),
 			LanguageCode:   languageCode,
 			LanguageCode_2: languageCode,
 			Limit:          20,
@@ -2108,6 +2161,7 @@ func (h *FrontendHandler) getSidebarData(ctx context.Context, languageCode, lang
 
 		// Get recent posts (not pages) filtered by language
 		recentPages, err := h.queries.ListPublishedPostsByLanguage(ctx, store.ListPublishedPostsByLanguageParams{
+			SiteID:       store.SiteIDFromContext(ctx),
 			LanguageCode: languageCode,
 			Limit:        5,
 			Offset:       0,
@@ -2115,10 +2169,7 @@ func (h *FrontendHandler) getSidebarData(ctx context.Context, languageCode, lang
 		if err != nil {
 			h.logger.Error("failed to get sidebar recent posts", "error", err)
 		}
-		recentPageViews = make([]PageView, 0, len(recentPages))
-		for _, p := range recentPages {
-			recentPageViews = append(recentPageViews, h.pageToView(ctx, p, languageCode, langPrefix))
-		}
+		recentPageViews = h.pagesToViews(ctx, recentPages, languageCode, langPrefix)
 	} else {
 		// Fallback to all languages (for single-language sites)
 		categoriesWithCount, err := h.queries.GetCategoryUsageCounts(ctx)
@@ -2156,16 +2207,14 @@ func (h *FrontendHandler) getSidebarData(ctx context.Context, languageCode, lang
 		}
 
 		recentPages, err := h.queries.ListPublishedPosts(ctx, store.ListPublishedPostsParams{
+			SiteID: store.SiteIDFromContext(ctx),
 			Limit:  5,
 			Offset: 0,
 		})
 		if err != nil {
 			h.logger.Error("failed to get sidebar recent posts", "error", err)
 		}
-		recentPageViews = make([]PageView, 0, len(recentPages))
-		for _, p := range recentPages {
-			recentPageViews = append(recentPageViews, h.pageToView(ctx, p, languageCode, langPrefix))
-		}
+		recentPageViews = h.pagesToViews(ctx, recentPages, languageCode, langPrefix)
 	}
 
 	return categoryViews, tagViews, recentPageViews
@@ -2173,25 +2222,23 @@ func (h *FrontendHandler) getSidebarData(ctx context.Context, languageCode, lang
 
 // languagePrefixedURL applies the already-validated request language prefix to
 // an internal public path. Callers pass paths beginning with "/" and prefixes
-// produced by BaseTemplateData (either empty or "/<language-code>").
+// produced by BaseTemplateData (either empty or "/<language-code>"), or by
+// languageURLs.base for a language served from another host.
 func languagePrefixedURL(langPrefix, path string) string {
 	return strings.TrimSuffix(langPrefix, "/") + "/" + strings.TrimPrefix(path, "/")
 }
 
 // canonicalTaxonomyLanguagePrefixes maps active, routable taxonomy languages
-// to their public URL prefix. Invalid and reserved legacy codes are ignored,
-// including a misconfigured default, until an administrator remediates them.
-func canonicalTaxonomyLanguagePrefixes(languages []store.Language) map[string]string {
+// to their public URL prefix, which includes the origin of a language served
+// from another host. Invalid and reserved legacy codes are ignored, including
+// a misconfigured default, until an administrator remediates them.
+func canonicalTaxonomyLanguagePrefixes(languages []store.Language, urls languageURLs) map[string]string {
 	prefixes := make(map[string]string, len(languages))
 	for _, lang := range languages {
 		if !lang.IsActive || !util.IsValidLangCode(lang.Code) || util.IsReservedLanguageCode(lang.Code) {
 			continue
 		}
-		if lang.IsDefault {
-			prefixes[lang.Code] = ""
-			continue
-		}
-		prefixes[lang.Code] = "/" + lang.Code
+		prefixes[lang.Code] = urls.base(lang.Code, lang.IsDefault)
 	}
 	return prefixes
 }
@@ -2304,22 +2351,22 @@ func (h *FrontendHandler) getSiteData(ctx context.Context) SiteData {
 		}
 	} else {
 		// Fallback to direct DB queries if cache not available
-		if cfg, err := h.queries.GetConfigByKey(ctx, "site_name"); err == nil {
+		if cfg, err := h.queries.GetSiteConfigByKey(ctx, "site_name"); err == nil {
 			site.SiteName = cfg.Value
 		}
-		if cfg, err := h.queries.GetConfigByKey(ctx, "site_description"); err == nil {
+		if cfg, err := h.queries.GetSiteConfigByKey(ctx, "site_description"); err == nil {
 			site.Description = cfg.Value
 		}
-		if cfg, err := h.queries.GetConfigByKey(ctx, "site_url"); err == nil {
+		if cfg, err := h.queries.GetSiteConfigByKey(ctx, "site_url"); err == nil {
 			site.URL = strings.TrimSuffix(cfg.Value, "/")
 		}
-		if cfg, err := h.queries.GetConfigByKey(ctx, "default_og_image"); err == nil && cfg.Value != "" {
+		if cfg, err := h.queries.GetSiteConfigByKey(ctx, "default_og_image"); err == nil && cfg.Value != "" {
 			site.DefaultOGImage = cfg.Value
 		}
 	}
 
 	// Get active theme config and load theme settings
-	if activeTheme := h.themeManager.GetActiveTheme(); activeTheme != nil {
+	if activeTheme := siteTheme(ctx, h.themeManager); activeTheme != nil {
 		site.Theme = &activeTheme.Config
 
 		// Load theme settings from cache
@@ -2328,7 +2375,7 @@ func (h *FrontendHandler) getSiteData(ctx context.Context) SiteData {
 		if h.cacheManager != nil {
 			settingsJSON, _ = h.cacheManager.GetConfig(ctx, configKey)
 		} else {
-			if cfg, err := h.queries.GetConfigByKey(ctx, configKey); err == nil {
+			if cfg, err := h.queries.GetSiteConfigByKey(ctx, configKey); err == nil {
 				settingsJSON = cfg.Value
 			}
 		}
@@ -2389,10 +2436,10 @@ func (h *FrontendHandler) getBaseTemplateData(r *http.Request, title, metaDesc s
 		}
 	} else {
 		// Fallback to direct DB queries if cache not available
-		if cfg, err := h.queries.GetConfigByKey(ctx, "site_logo"); err == nil && cfg.Value != "" {
+		if cfg, err := h.queries.GetSiteConfigByKey(ctx, "site_logo"); err == nil && cfg.Value != "" {
 			data.SiteLogo = cfg.Value
 		}
-		if cfg, err := h.queries.GetConfigByKey(ctx, "custom_css"); err == nil && cfg.Value != "" {
+		if cfg, err := h.queries.GetSiteConfigByKey(ctx, "custom_css"); err == nil && cfg.Value != "" {
 			data.CustomCSS = cfg.Value
 		}
 	}
@@ -2415,54 +2462,43 @@ func (h *FrontendHandler) getBaseTemplateData(r *http.Request, title, metaDesc s
 		if data.LangDirection == "" {
 			data.LangDirection = "ltr"
 		}
-		// Set language prefix for URLs (empty for default language)
-		if !langInfo.IsDefault {
+		// Set language prefix for URLs (empty for the default language and
+		// for a language served at the root of its own host)
+		if !langInfo.IsDefault && middleware.GetHostLanguage(r) != langInfo.Code {
 			data.LangPrefix = "/" + langInfo.Code
 		}
 		data.HomeURL = frontendHomePath(data.LangPrefix)
 
 		// Apply translated config values for current language
-		if translatedName, err := h.queries.GetConfigTranslationByKeyAndLangCode(ctx, store.GetConfigTranslationByKeyAndLangCodeParams{
-			ConfigKey: "site_name",
-			Code:      langInfo.Code,
-		}); err == nil && translatedName.Value != "" {
-			data.SiteName = translatedName.Value
-			data.Site.SiteName = translatedName.Value
-		}
-		if translatedDesc, err := h.queries.GetConfigTranslationByKeyAndLangCode(ctx, store.GetConfigTranslationByKeyAndLangCodeParams{
-			ConfigKey: "site_description",
-			Code:      langInfo.Code,
-		}); err == nil && translatedDesc.Value != "" {
-			data.SiteTagline = translatedDesc.Value
-			data.Site.Description = translatedDesc.Value
+		if translatedName := h.configTranslation(ctx, "site_name", langInfo.Code); translatedName != "" {
+			data.SiteName = translatedName
+			data.Site.SiteName = translatedName
+		}
+		if translatedDesc := h.configTranslation(ctx, "site_description", langInfo.Code); translatedDesc != "" {
+			data.SiteTagline = translatedDesc
+			data.Site.Description = translatedDesc
 		}
 		// Get powered_by translation for footer
-		if translatedPoweredBy, err := h.queries.GetConfigTranslationByKeyAndLangCode(ctx, store.GetConfigTranslationByKeyAndLangCodeParams{
-			ConfigKey: "powered_by",
-			Code:      langInfo.Code,
-		}); err == nil && translatedPoweredBy.Value != "" {
-			data.FooterText = translatedPoweredBy.Value
+		if translatedPoweredBy := h.configTranslation(ctx, "powered_by", langInfo.Code); translatedPoweredBy != "" {
+			data.FooterText = translatedPoweredBy
 		}
 		// Get copyright translation for footer
-		if translatedCopyright, err := h.queries.GetConfigTranslationByKeyAndLangCode(ctx, store.GetConfigTranslationByKeyAndLangCodeParams{
-			ConfigKey: "copyright",
-			Code:      langInfo.Code,
-		}); err == nil && translatedCopyright.Value != "" {
-			data.CopyrightText = translatedCopyright.Value
+		if translatedCopyright := h.configTranslation(ctx, "copyright", langInfo.Code); translatedCopyright != "" {
+			data.CopyrightText = translatedCopyright
 		}
 	}
 
 	// Load menus by slug and language
-	data.MainMenu = loadMenu(h.menuService, "main", r.URL.Path, langCode)
-	data.FooterMenu = loadMenu(h.menuService, "footer", r.URL.Path, langCode)
+	data.MainMenu = loadMenu(r.Context(), h.menuService, "main", r.URL.Path, langCode)
+	data.FooterMenu = loadMenu(r.Context(), h.menuService, "footer", r.URL.Path, langCode)
 	// Navigation/FooterNav are aliases for MainMenu/FooterMenu (for template compatibility)
 	data.Navigation = data.MainMenu
 	data.FooterNav = data.FooterMenu
 
-	// Load widgets for the active theme
-	if activeTheme := h.themeManager.GetActiveTheme(); activeTheme != nil {
-		data.Widgets = h.widgetService.GetAllWidgetsForTheme(ctx, activeTheme.Name)
-	}
+	// Render the widgets of the active theme shown on this page
+	data.Widgets = h.loadWidgets(r, langCode, data.LangPrefix)
+
+	data.Members = h.membersNav(r, data.LangPrefix)
 
 	// Load routable active languages for the public language picker. Legacy
 	// invalid or reserved active rows remain manageable in admin, but must not
@@ -2472,6 +2508,7 @@ func (h *FrontendHandler) getBaseTemplateData(r *http.Request, title, metaDesc s
 		activeLanguages = routableLanguages(activeLanguages)
 	}
 	if len(activeLanguages) > 1 {
+		urls := newLanguageURLs(site.URL, siteLanguages(ctx, activeLanguages), middleware.GetHostLanguage(r))
 		data.ShowLanguagePicker = true
 		data.Languages = make([]LanguageView, 0, len(activeLanguages))
 		for _, lang := range activeLanguages {
@@ -2483,6 +2520,7 @@ func (h *FrontendHandler) getBaseTemplateData(r *http.Request, title, metaDesc s
 				Direction:  lang.Direction,
 				IsDefault:  lang.IsDefault,
 				IsCurrent:  data.LangCode == lang.Code,
+				URL:        urls.switchLink(lang.Code),
 			}
 			data.Languages = append(data.Languages, lv)
 		}
@@ -2495,8 +2533,8 @@ func (h *FrontendHandler) getBaseTemplateData(r *http.Request, title, metaDesc s
 	if data.CopyrightText == "" {
 		data.CopyrightText = h.getConfigValue(ctx, "copyright")
 	}
-	if data.SiteURL != "" {
-		canonicalURL := strings.TrimRight(data.SiteURL, "/") + canonicalFrontendPath(r, data.LangPrefix)
+	if origin := languageOrigin(r, data.SiteURL); origin != "" {
+		canonicalURL := origin + canonicalFrontendPath(r, data.LangPrefix)
 		data.Canonical = canonicalURL
 		data.OGURL = canonicalURL
 	}
@@ -2562,17 +2600,35 @@ func routableLanguages(languages []store.Language) []store.Language {
 	return result
 }
 
-// loadMenu loads a menu by slug and language, and marks active items.
-func loadMenu(ms *service.MenuService, slug, currentPath, langCode string) []MenuItem {
+// loadMenu loads a menu by slug and language, drops the items hidden from
+// the visitor, and marks active items.
+func loadMenu(ctx context.Context, ms *service.MenuService, slug, currentPath, langCode string) []MenuItem {
 	if langCode == "" {
 		return nil
 	}
-	items := ms.GetMenuForLanguage(slug, langCode)
+	items := ms.GetSiteMenuForLanguage(ctx, slug, langCode)
 	if items == nil {
 		return nil
 	}
 
-	return menuItemsToView(items, currentPath)
+	return menuItemsToView(service.FilterMenuItems(items, menuViewerRole(ctx)), currentPath)
+}
+
+// menuViewerRole returns the role of the logged-in user of a request
+// context, or model.RoleAnonymous.
+func menuViewerRole(ctx context.Context) string {
+	if user := viewerFromContext(ctx); user != nil {
+		return user.Role
+	}
+	return model.RoleAnonymous
+}
+
+// viewerFromContext returns the logged-in user of a request context, or nil.
+func viewerFromContext(ctx context.Context) *store.User {
+	if user, ok := ctx.Value(middleware.ContextKeyUser).(store.User); ok {
+		return &user
+	}
+	return nil
 }
 
 // menuItemsToView converts service menu items to view items with active state.
@@ -2585,6 +2641,8 @@ func menuItemsToView(items []service.MenuItem, currentPath string) []MenuItem {
 			Target:   item.Target,
 			IsActive: item.URL == currentPath,
 			Children: menuItemsToView(item.Children, currentPath),
+			MegaMenu: item.MegaMenu,
+			ImageURL: item.ImageURL,
 		}
 		result = append(result, mi)
 	}
@@ -2598,7 +2656,7 @@ func (h *FrontendHandler) getConfigValue(ctx context.Context, key string) string
 			return value
 		}
 	}
-	if cfg, err := h.queries.GetConfigByKey(ctx, key); err == nil && cfg.Value != "" {
+	if cfg, err := h.queries.GetSiteConfigByKey(ctx, key); err == nil && cfg.Value != "" {
 		return cfg.Value
 	}
 	return ""
@@ -2634,8 +2692,8 @@ type availableEntityTranslation struct {
 
 func buildEntityTranslationLinks(
 	translations []availableEntityTranslation,
-	currentLangCode,
-	siteURL,
+	currentLangCode string,
+	urls languageURLs,
 	entityPathPrefix string,
 ) ([]TranslationLink, []HrefLangLink) {
 	links := make([]TranslationLink, 0, len(translations))
@@ -2657,25 +2715,25 @@ func buildEntityTranslationLinks(
 			IsCurrent:  translation.languageCode == currentLangCode,
 		}
 		hasEntity := translation.entityID != 0 && translation.slug != ""
-		entityURL := frontendHomePath("")
-		if !language.IsDefault {
-			entityURL = frontendHomePath("/" + language.Code)
-		}
+		entityPath := "/"
 		if hasEntity {
-			prefix := ""
-			if !language.IsDefault {
-				prefix = "/" + language.Code
-			}
-			entityURL = prefix + entityPathPrefix + translation.slug
+			entityPath = entityPathPrefix + translation.slug
+		}
+		entityURL := urls.link(language.Code, language.IsDefault, entityPath)
+		if !hasEntity {
+			entityURL = urls.homeLink(language.Code, language.IsDefault)
 		}
 
 		links = append(links, TranslationLink{
 			Language: language, URL: entityURL, PageTitle: translation.title, HasPage: hasEntity,
 		})
-		if !hasEntity || siteURL == "" {
+		if !hasEntity {
+			continue
+		}
+		fullURL := urls.absolute(language.Code, language.IsDefault, entityPath)
+		if fullURL == "" {
 			continue
 		}
-		fullURL := strings.TrimRight(siteURL, "/") + entityURL
 		hrefLangs = append(hrefLangs, HrefLangLink{Lang: language.Code, Href: fullURL})
 		if language.IsDefault {
 			defaultLangURL = fullURL
@@ -2704,7 +2762,7 @@ func (h *FrontendHandler) getPageTranslations(ctx context.Context, pageID int64,
 			entityID: row.PageID, slug: row.PageSlug, title: row.PageTitle,
 		})
 	}
-	return buildEntityTranslationLinks(translations, currentLangCode, siteURL, "/")
+	return buildEntityTranslationLinks(translations, currentLangCode, h.languageURLs(ctx, siteURL), "/")
 }
 
 func (h *FrontendHandler) getCategoryTranslations(ctx context.Context, categoryID int64, currentLangCode, siteURL string) ([]TranslationLink, []HrefLangLink) {
@@ -2722,7 +2780,7 @@ func (h *FrontendHandler) getCategoryTranslations(ctx context.Context, categoryI
 			entityID: row.CategoryID, slug: row.CategorySlug, title: row.CategoryName,
 		})
 	}
-	return buildEntityTranslationLinks(translations, currentLangCode, siteURL, "/category/")
+	return buildEntityTranslationLinks(translations, currentLangCode, h.languageURLs(ctx, siteURL), "/category/")
 }
 
 func (h *FrontendHandler) getTagTranslations(ctx context.Context, tagID int64, currentLangCode, siteURL string) ([]TranslationLink, []HrefLangLink) {
@@ -2740,7 +2798,7 @@ func (h *FrontendHandler) getTagTranslations(ctx context.Context, tagID int64, c
 			entityID: row.TagID, slug: row.TagSlug, title: row.TagName,
 		})
 	}
-	return buildEntityTranslationLinks(translations, currentLangCode, siteURL, "/tag/")
+	return buildEntityTranslationLinks(translations, currentLangCode, h.languageURLs(ctx, siteURL), "/tag/")
 }
 
 func (h *FrontendHandler) getFormTranslations(ctx context.Context, formID int64, currentLangCode, siteURL string) ([]TranslationLink, []HrefLangLink) {
@@ -2758,21 +2816,16 @@ func (h *FrontendHandler) getFormTranslations(ctx context.Context, formID int64,
 			entityID: row.FormID, slug: row.FormSlug, title: row.FormName,
 		})
 	}
-	return buildEntityTranslationLinks(translations, currentLangCode, siteURL, "/forms/")
+	return buildEntityTranslationLinks(translations, currentLangCode, h.languageURLs(ctx, siteURL), "/forms/")
 }
 
 // getHomepageTranslations returns canonical homepage links and SEO alternates.
-func (h *FrontendHandler) getHomepageTranslations(currentLangCode, siteURL string, languages []LanguageView) ([]TranslationLink, []HrefLangLink) {
+func (h *FrontendHandler) getHomepageTranslations(ctx context.Context, currentLangCode, siteURL string, languages []LanguageView) ([]TranslationLink, []HrefLangLink) {
+	urls := h.languageURLs(ctx, siteURL)
 	links := make([]TranslationLink, 0, len(languages))
 	hrefLangs := make([]HrefLangLink, 0, len(languages)+1)
 	defaultURL := ""
 	for _, lang := range languages {
-		prefix := ""
-		if !lang.IsDefault {
-			prefix = "/" + lang.Code
-		}
-		homeURL := frontendHomePath(prefix)
-
 		links = append(links, TranslationLink{
 			Language: LanguageView{
 				ID:         lang.ID,
@@ -2783,12 +2836,11 @@ func (h *FrontendHandler) getHomepageTranslations(currentLangCode, siteURL strin
 				IsDefault:  lang.IsDefault,
 				IsCurrent:  lang.Code == currentLangCode,
 			},
-			URL:       homeURL,
+			URL:       urls.homeLink(lang.Code, lang.IsDefault),
 			PageTitle: "",
 			HasPage:   true,
 		})
-		if siteURL != "" {
-			fullURL := strings.TrimRight(siteURL, "/") + homeURL
+		if fullURL := urls.absoluteHome(lang.Code, lang.IsDefault); fullURL != "" {
 			hrefLangs = append(hrefLangs, HrefLangLink{Lang: lang.Code, Href: fullURL})
 			if lang.IsDefault {
 				defaultURL = fullURL
@@ -2816,48 +2868,55 @@ func fetchAndCount(list func() ([]store.Page, error), count func() (int64, error
 	return pages, total, nil
 }
 
-// fetchPagesForEntity fetches published pages for a category or tag with optional language filter.
+// fetchPagesForEntity fetches published pages of the request site for a category or tag with optional language filter.
 func (h *FrontendHandler) fetchPagesForEntity(ctx context.Context, entityID int64, langCode string, limit, offset int64, isTag bool) ([]store.Page, int64, error) {
 	hasLang := langCode != ""
+	siteID := store.SiteIDFromContext(ctx)
 
 	switch {
 	case isTag && hasLang:
 		return fetchAndCount(
 			func() ([]store.Page, error) {
 				return h.queries.ListPublishedPagesForTagAndLanguage(ctx, store.ListPublishedPagesForTagAndLanguageParams{
-					TagID: entityID, LanguageCode: langCode, Limit: limit, Offset: offset})
+					TagID: entityID, LanguageCode: langCode, SiteID: siteID, Limit: limit, Offset: offset})
 			},
 			func() (int64, error) {
 				return h.queries.CountPublishedPagesForTagAndLanguage(ctx, store.CountPublishedPagesForTagAndLanguageParams{
-					TagID: entityID, LanguageCode: langCode})
+					TagID: entityID, LanguageCode: langCode, SiteID: siteID})
 			},
 		)
 	case isTag:
 		return fetchAndCount(
 			func() ([]store.Page, error) {
 				return h.queries.ListPublishedPagesForTag(ctx, store.ListPublishedPagesForTagParams{
-					TagID: entityID, Limit: limit, Offset: offset})
+					TagID: entityID, SiteID: siteID, Limit: limit, Offset: offset})
+			},
+			func() (int64, error) {
+				return h.queries.CountPublishedPagesForTag(ctx, store.CountPublishedPagesForTagParams{
+					TagID: entityID, SiteID: siteID})
 			},
-			func() (int64, error) { return h.queries.CountPublishedPagesForTag(ctx, entityID) },
 		)
 	case hasLang:
 		return fetchAndCount(
 			func() ([]store.Page, error) {
 				return h.queries.ListPublishedPagesByCategoryAndLanguage(ctx, store.ListPublishedPagesByCategoryAndLanguageParams{
-					CategoryID: entityID, LanguageCode: langCode, Limit: limit, Offset: offset})
+					CategoryID: entityID, LanguageCode: langCode, SiteID: siteID, Limit: limit, Offset: offset})
 			},
 			func() (int64, error) {
 				return h.queries.CountPublishedPagesByCategoryAndLanguage(ctx, store.CountPublishedPagesByCategoryAndLanguageParams{
-					CategoryID: entityID, LanguageCode: langCode})
+					CategoryID: entityID, LanguageCode: langCode, SiteID: siteID})
 			},
 		)
 	default:
 		return fetchAndCount(
 			func() ([]store.Page, error) {
 				return h.queries.ListPublishedPagesByCategory(ctx, store.ListPublishedPagesByCategoryParams{
-					CategoryID: entityID, Limit: limit, Offset: offset})
+					CategoryID: entityID, SiteID: siteID, Limit: limit, Offset: offset})
+			},
+			func() (int64, error) {
+				return h.queries.CountPublishedPagesByCategory(ctx, store.CountPublishedPagesByCategoryParams{
+					CategoryID: entityID, SiteID: siteID})
 			},
-			func() (int64, error) { return h.queries.CountPublishedPagesByCategory(ctx, entityID) },
 		)
 	}
 }
@@ -2872,12 +2931,29 @@ func (h *FrontendHandler) getConfiguredSiteURL(ctx context.Context) string {
 			return siteURL
 		}
 	}
-	if cfg, err := h.queries.GetConfigByKey(ctx, "site_url"); err == nil {
+	if cfg, err := h.queries.GetSiteConfigByKey(ctx, "site_url"); err == nil {
 		return cfg.Value
 	}
 	return ""
 }
 
+// configTranslation returns the translation of a config value into a
+// language, or "" when there is none. Translations belong to the global
+// value, so a key the current site overrides is never translated.
+func (h *FrontendHandler) configTranslation(ctx context.Context, key, langCode string) string {
+	if h.queries.SiteOverridesConfig(ctx, key) {
+		return ""
+	}
+	translated, err := h.queries.GetConfigTranslationByKeyAndLangCode(ctx, store.GetConfigTranslationByKeyAndLangCodeParams{
+		ConfigKey: key,
+		Code:      langCode,
+	})
+	if err != nil {
+		return ""
+	}
+	return translated.Value
+}
+
 // getSiteURL retrieves the site URL from config with cache fallback.
 // Falls back to request host if no config is set.
 func (h *FrontendHandler) getSiteURL(ctx context.Context, r *http.Request) string {
@@ -3034,6 +3110,10 @@ func templComponent(templateName string, data any) templ.Component {
 		if d, ok := data.(NotFoundData); ok {
 			return FrontendNotFoundPage(d)
 		}
+	case "member":
+		if d, ok := data.(MemberPageData); ok {
+			return FrontendMemberPage(d)
+		}
 	}
 	return nil
 }
@@ -3042,7 +3122,7 @@ func templComponent(templateName string, data any) templ.Component {
 // For templ engine (embedded/core themes): renders via templ components.
 // For html engine (custom/ThemeForest themes): renders via html/template.
 func (h *FrontendHandler) render(w http.ResponseWriter, r *http.Request, templateName string, data any) {
-	activeTheme := h.themeManager.GetActiveTheme()
+	activeTheme := siteTheme(r.Context(), h.themeManager)
 
 	// Determine engine: if no active theme, default to templ.
 	engine := theme.EngineTempl
@@ -3107,6 +3187,13 @@ func (h *FrontendHandler) renderNotFound(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// Count the path in the 404 log, where admins can turn it into a redirect
+	if r.Method == http.MethodGet {
+		if err := recordNotFound(r.Context(), h.queries, r.URL.Path, r.Referer(), time.Now()); err != nil {
+			h.logger.Warn("failed to record 404", "path", r.URL.Path, "error", err)
+		}
+	}
+
 	// Log 404 for monitoring and debugging
 	clientIP := middleware.GetClientIP(r)
 	h.logger.Info("page not found",
@@ -3145,15 +3232,14 @@ func (h *FrontendHandler) renderNotFound(w http.ResponseWriter, r *http.Request)
 	}
 	if languageCode != "" {
 		pages, err := h.queries.ListPagesByLanguageAndStatus(r.Context(), store.ListPagesByLanguageAndStatusParams{
+			SiteID:       store.SiteIDFromContext(r.Context()),
 			LanguageCode: languageCode,
 			Status:       PageStatusPublished,
 			Limit:        5,
 			Offset:       0,
 		})
 		if err == nil {
-			for _, p := range pages {
-				suggestedPages = append(suggestedPages, h.pageToView(r.Context(), p, base.LangCode, base.LangPrefix))
-			}
+			suggestedPages = h.pagesToViews(r.Context(), pages, base.LangCode, base.LangPrefix)
 		}
 	}
 
@@ -3218,6 +3304,7 @@ func (h *FrontendHandler) taxonomyRouteLanguage(ctx context.Context, r *http.Req
 			IsDefault:  lang.IsDefault,
 			IsActive:   true,
 			Direction:  lang.Direction,
+			Host:       lang.Host,
 		}, true, nil
 	}
 
@@ -3243,6 +3330,7 @@ func requestWithFrontendLanguage(r *http.Request, lang store.Language) *http.Req
 		NativeName: lang.NativeName,
 		Direction:  lang.Direction,
 		IsDefault:  lang.IsDefault,
+		Host:       siteLanguageHost(r.Context(), lang.Host),
 	}
 	ctx := context.WithValue(r.Context(), middleware.ContextKeyLanguage, langInfo)
 	ctx = context.WithValue(ctx, middleware.ContextKeyLanguageCode, lang.Code)
@@ -3251,10 +3339,13 @@ func requestWithFrontendLanguage(r *http.Request, lang store.Language) *http.Req
 
 // redirectPageAlias redirects an alias to the target page's canonical
 // language-aware URL. An explicitly prefixed alias only resolves for a page in
-// that same language, preventing one global alias from leaking across every
-// active language prefix.
+// that same language, preventing one alias from leaking across every active
+// language prefix. Aliases are looked up on the request's site only.
 func (h *FrontendHandler) redirectPageAlias(w http.ResponseWriter, r *http.Request, alias string) bool {
-	page, err := h.queries.GetPublishedPageByAlias(r.Context(), alias)
+	page, err := h.queries.GetPublishedPageByAlias(r.Context(), store.GetPublishedPageByAliasParams{
+		Alias:  alias,
+		SiteID: store.SiteIDFromContext(r.Context()),
+	})
 	if err != nil {
 		return false
 	}
@@ -3265,7 +3356,7 @@ func (h *FrontendHandler) redirectPageAlias(w http.ResponseWriter, r *http.Reque
 		return false
 	}
 
-	return writePermanentPageRedirect(w, prefix, page.Slug)
+	return writePermanentPageRedirect(w, prefix, h.pagePath(r.Context(), page))
 }
 
 // canonicalPageLanguagePrefix resolves a stored page language to its only
@@ -3293,6 +3384,10 @@ func (h *FrontendHandler) canonicalPageLanguagePrefix(ctx context.Context, page
 	if lang.ID == defaultLang.ID && lang.Code == defaultLang.Code {
 		return "", true
 	}
+	if lang.Host != "" && lang.Code == middleware.HostLanguageFromContext(ctx) {
+		// The language's own host serves it at the root.
+		return "", true
+	}
 	return lang.Code, true
 }
 
@@ -3319,17 +3414,17 @@ func (h *FrontendHandler) canonicalAliasLanguagePrefix(r *http.Request, page sto
 // writePermanentPageRedirect emits only a same-origin path assembled from
 // validated route segments. Writing the fixed local Location directly avoids
 // passing request-derived data to the generic redirect API.
-func writePermanentPageRedirect(w http.ResponseWriter, languageCode, slug string) bool {
-	if !util.IsValidSlug(slug) {
+func writePermanentPageRedirect(w http.ResponseWriter, languageCode, pagePath string) bool {
+	if !isValidPagePath(pagePath) {
 		return false
 	}
 
-	location := "/" + slug
+	location := "/" + pagePath
 	if languageCode != "" {
 		if !util.IsValidLangCode(languageCode) || util.IsReservedLanguageCode(languageCode) {
 			return false
 		}
-		location = "/" + languageCode + "/" + slug
+		location = "/" + languageCode + "/" + pagePath
 	}
 
 	w.Header().Set("Location", location)
@@ -3355,14 +3450,14 @@ func (h *FrontendHandler) Favicon(w http.ResponseWriter, r *http.Request, defaul
 
 	// Check active theme's settings for custom favicon
 	if h.themeManager != nil {
-		activeTheme := h.themeManager.GetActiveTheme()
+		activeTheme := siteTheme(ctx, h.themeManager)
 		if activeTheme != nil {
 			configKey := "theme_settings_" + activeTheme.Name
 			var settingsJSON string
 			if h.cacheManager != nil {
 				settingsJSON, _ = h.cacheManager.GetConfig(ctx, configKey)
 			} else {
-				if cfg, err := h.queries.GetConfigByKey(ctx, configKey); err == nil {
+				if cfg, err := h.queries.GetSiteConfigByKey(ctx, configKey); err == nil {
 					settingsJSON = cfg.Value
 				}
 			}
@@ -0,0 +1,142 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/widget"
+)
+
+// BlogArchive handles the yearly and monthly blog archives, /blog/2026 and
+// /blog/2026/10. Like taxonomy URLs, archives are canonical by language, and
+// archives without posts are not found.
+func (h *FrontendHandler) BlogArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	year, month, ok := parseArchivePeriod(chi.URLParam(r, "year"), chi.URLParam(r, "month"))
+	if !ok {
+		h.renderNotFound(w, r)
+		return
+	}
+
+	routeLanguage, validLanguage, err := h.taxonomyRouteLanguage(ctx, r)
+	if err != nil {
+		h.logger.Error("failed to resolve blog archive route language", "error", err)
+		h.renderInternalError(w)
+		return
+	}
+	if !validLanguage {
+		h.renderNotFound(w, r)
+		return
+	}
+	r = requestWithFrontendLanguage(r, routeLanguage)
+	ctx = r.Context()
+	languageCode := routeLanguage.Code
+	period := archivePeriod(year, month)
+
+	total, err := h.queries.CountPublishedPostsByPeriod(ctx, store.CountPublishedPostsByPeriodParams{
+		SiteID:       store.SiteIDFromContext(ctx),
+		LanguageCode: languageCode,
+		Period:       period,
+	})
+	if err != nil {
+		h.logger.Error("failed to count archive posts", "period", period, "error", err)
+		h.renderInternalError(w)
+		return
+	}
+	if total == 0 {
+		h.renderNotFound(w, r)
+		return
+	}
+
+	// Pagination
+	page := h.getPageNum(r)
+	offset := (page - 1) * defaultPerPage
+
+	pages, err := h.queries.ListPublishedPostsByPeriod(ctx, store.ListPublishedPostsByPeriodParams{
+		SiteID:       store.SiteIDFromContext(ctx),
+		LanguageCode: languageCode,
+		Period:       period,
+		Limit:        int64(defaultPerPage),
+		Offset:       int64(offset),
+	})
+	if err != nil {
+		h.logger.Error("failed to get archive posts", "period", period, "error", err)
+		h.renderInternalError(w)
+		return
+	}
+
+	r = requestWithWidgetPage(r, widget.PageArchive)
+	base := h.getBaseTemplateData(r, archiveTitle(year, month, languageCode), "")
+	base.BodyClass = "archive blog date-archive"
+
+	pageViews := h.pagesToViews(ctx, pages, base.LangCode, base.LangPrefix)
+
+	pagination := h.buildPagination(page, int(total), blogArchiveURL(base.LangPrefix, year, month))
+
+	// Fetch sidebar data for themes that show sidebar on list pages
+	sidebarCategories, sidebarTags, sidebarRecent := h.getSidebarData(ctx, languageCode, base.LangPrefix)
+
+	data := ListData{
+		BaseTemplateData: base,
+		Pages:            pageViews,
+		Pagination:       pagination,
+		Categories:       sidebarCategories,
+		Tags:             sidebarTags,
+		RecentPages:      sidebarRecent,
+	}
+
+	h.render(w, r, "list", data)
+}
+
+// parseArchivePeriod parses the year and optional month of an archive URL.
+// The month is 0 for yearly archives.
+func parseArchivePeriod(yearParam, monthParam string) (year, month int, ok bool) {
+	year, err := strconv.Atoi(yearParam)
+	if err != nil || year < 1 {
+		return 0, 0, false
+	}
+	if monthParam == "" {
+		return year, 0, true
+	}
+	month, err = strconv.Atoi(monthParam)
+	if err != nil || month < 1 || month > 12 {
+		return 0, 0, false
+	}
+	return year, month, true
+}
+
+// archivePeriod returns the prefix of the publication dates in an archive,
+// "2026" or "2026-10".
+func archivePeriod(year, month int) string {
+	if month == 0 {
+		return fmt.Sprintf("%04d", year)
+	}
+	return fmt.Sprintf("%04d-%02d", year, month)
+}
+
+// archiveTitle returns the title of an archive, "2026" or "October 2026".
+func archiveTitle(year, month int, langCode string) string {
+	if month == 0 {
+		return strconv.Itoa(year)
+	}
+	return render.FormatMonthLocale(time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC), langCode)
+}
+
+// blogArchiveURL returns the URL of a yearly archive, or of a monthly archive
+// when month is not 0.
+func blogArchiveURL(langPrefix string, year, month int) string {
+	if month == 0 {
+		return fmt.Sprintf("%s/blog/%04d", langPrefix, year)
+	}
+	return fmt.Sprintf("%s/blog/%04d/%02d", langPrefix, year, month)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+func TestFrontendHandler_BlogArchive(t *testing.T) {
+	db, _ := testHandlerSetup(t)
+	admin := createTestAdminUser(t, db)
+	createTestLanguage(t, db, "fr", true)
+	queries := store.New(db)
+
+	for i, post := range []struct {
+		title, lang string
+		published   time.Time
+	}{
+		{"October sentinel", "en", time.Date(2026, time.October, 3, 9, 0, 0, 0, time.UTC)},
+		{"March sentinel", "en", time.Date(2026, time.March, 14, 9, 0, 0, 0, time.UTC)},
+		{"Old sentinel", "en", time.Date(2025, time.December, 31, 9, 0, 0, 0, time.UTC)},
+		{"French sentinel", "fr", time.Date(2026, time.October, 5, 9, 0, 0, 0, time.UTC)},
+	} {
+		if _, err := queries.CreatePage(context.Background(), store.CreatePageParams{
+			Title: post.title, Slug: fmt.Sprintf("archive-post-%d", i), Status: "published",
+			AuthorID: admin.ID, PageType: "post", LanguageCode: post.lang,
+			PublishedAt: sql.NullTime{Time: post.published, Valid: true},
+			CreatedAt:   post.published, UpdatedAt: post.published,
+			SiteID: store.DefaultSiteID,
+		}); err != nil {
+			t.Fatalf("CreatePage: %v", err)
+		}
+	}
+
+	h := NewFrontendHandler(db, loadedFrontendThemeManager(t, "default"), nil, slog.Default(), nil, nil)
+	root := chi.NewRouter()
+	frontend := chi.NewRouter()
+	frontend.Use(middleware.Language(db))
+	frontend.Get(RouteBlogYear, h.BlogArchive)
+	frontend.Get(RouteBlogMonth, h.BlogArchive)
+	frontend.NotFound(h.NotFound)
+	root.Mount("/", frontend)
+
+	tests := []struct {
+		path       string
+		wantStatus int
+		want       []string
+		notWant    []string
+	}{
+		{"/blog/2026", http.StatusOK, []string{"October sentinel", "March sentinel"}, []string{"Old sentinel", "French sentinel"}},
+		{"/blog/2026/10", http.StatusOK, []string{"October 2026", "October sentinel"}, []string{"March sentinel", "French sentinel"}},
+		{"/blog/2025/12", http.StatusOK, []string{"Old sentinel"}, nil},
+		{"/fr/blog/2026/10", http.StatusOK, []string{"French sentinel"}, []string{"October sentinel"}},
+		{"/fr/blog/2026/03", http.StatusNotFound, nil, nil},
+		{"/blog/2024", http.StatusNotFound, nil, nil},
+		{"/blog/2026/13", http.StatusNotFound, nil, nil},
+		{"/blog/2026/10?lang=fr", http.StatusOK, []string{"October sentinel"}, []string{"French sentinel"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			root.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			body := w.Body.String()
+			// The sidebar lists recent posts of every month.
+			if i := strings.Index(body, "<aside"); i >= 0 {
+				body = body[:i]
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(body, want) {
+					t.Errorf("body misses %q", want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(body, notWant) {
+					t.Errorf("body contains %q", notWant)
+				}
+			}
+		})
+	}
+}
+
+func TestParseArchivePeriod(t *testing.T) {
+	tests := []struct {
+		year, month         string
+		wantYear, wantMonth int
+		wantOK              bool
+	}{
+		{"2026", "", 2026, 0, true},
+		{"2026", "10", 2026, 10, true},
+		{"2026", "00", 0, 0, false},
+		{"2026", "13", 0, 0, false},
+		{"0000", "", 0, 0, false},
+		{"abcd", "", 0, 0, false},
+	}
+	for _, tt := range tests {
+		year, month, ok := parseArchivePeriod(tt.year, tt.month)
+		if year != tt.wantYear || month != tt.wantMonth || ok != tt.wantOK {
+			t.Errorf("parseArchivePeriod(%q, %q) = %d, %d, %v", tt.year, tt.month, year, month, ok)
+		}
+	}
+	if got := blogArchiveURL("/fr", 2026, 3); got != "/fr/blog/2026/03" {
+		t.Errorf("blogArchiveURL = %q", got)
+	}
+}
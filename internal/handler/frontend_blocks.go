@@ -0,0 +1,29 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/olegiv/ocms-go/internal/blocks"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// applyPageBlocks renders the body of a page edited with the block editor
+// from its block document, using the block partials of the active theme.
+// Pages edited as HTML keep their stored body.
+func (h *FrontendHandler) applyPageBlocks(ctx context.Context, pv *PageView, p store.Page) {
+	raw := loadPageBlocks(ctx, h.queries, p.ID)
+	if raw == "" {
+		return
+	}
+	doc, err := blocks.Parse(raw)
+	if err != nil {
+		slog.Error("failed to parse page blocks", "error", err, "page_id", p.ID)
+		return
+	}
+	body := string(blocks.RenderWith(doc, siteTheme(ctx, h.themeManager).BlockOverride()))
+	pv.Body = h.trustedPageBody(h.expandShortcodes(ctx, body, pv.LangCode, pv.LangPrefix))
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/module"
+	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/modules/hcaptcha"
+)
+
+// CommentsView is the comments section of a single page.
+type CommentsView struct {
+	Count    int64
+	Comments []CommentView // Top-level comments with their replies, oldest first
+	Open     bool          // The page accepts new comments
+	CanPost  bool          // The visitor may post: logged in, or anonymous comments allowed
+	UserName string        // Name of the logged-in visitor, "" for anonymous visitors
+	Action   string        // URL the comment form posts to
+	LoginURL string
+	Captcha  template.HTML // Captcha widget for anonymous visitors
+	ReplyTo  *CommentView  // Comment being answered, from the reply query parameter
+	// Message shown after posting, from the comment query parameter
+	Notice      string
+	NoticeError bool
+}
+
+// CommentView is an approved comment.
+type CommentView struct {
+	ID                 int64
+	AuthorName         string
+	Body               string // Plain text; themes keep its line breaks
+	CreatedAt          time.Time
+	CreatedAtFormatted string
+	Depth              int  // 0 for top-level comments
+	CanReply           bool // Replies are accepted at this depth
+	Replies            []CommentView
+}
+
+// commentNotices maps the comment query parameter set after posting to the
+// message shown and whether it is an error.
+var commentNotices = map[string]struct {
+	key   string
+	error bool
+}{
+	model.CommentStatusApproved: {"frontend.comment_published", false},
+	model.CommentStatusPending:  {"frontend.comment_pending", false},
+	commentNoticeInvalid:        {"frontend.comment_invalid", true},
+	commentNoticeCaptcha:        {"frontend.comment_captcha", true},
+	commentNoticeClosed:         {"frontend.comments_closed", true},
+	commentNoticeLogin:          {"frontend.comment_login_required", true},
+	commentNoticeFlood:          {"frontend.comment_flood", true},
+}
+
+// SetHookRegistry sets the hook registry used for the captcha widget of the
+// comment and registration forms.
+func (h *FrontendHandler) SetHookRegistry(hr *module.HookRegistry) {
+	h.hookRegistry = hr
+}
+
+// commentsEnabled reports whether comments are enabled site-wide.
+func (h *FrontendHandler) commentsEnabled(ctx context.Context) bool {
+	return h.getConfigValue(ctx, model.ConfigKeyCommentsEnabled) == "true"
+}
+
+// anonymousCommentsAllowed reports whether visitors who are not logged in
+// may comment. It defaults to true.
+func (h *FrontendHandler) anonymousCommentsAllowed(ctx context.Context) bool {
+	return h.getConfigValue(ctx, model.ConfigKeyCommentsAllowAnonymous) != "false"
+}
+
+// commentCount returns the number of approved comments of a page, or 0 when
+// comments are disabled.
+func (h *FrontendHandler) commentCount(ctx context.Context, pageID int64) int64 {
+	if !h.commentsEnabled(ctx) {
+		return 0
+	}
+	count, err := h.queries.CountApprovedCommentsByPage(ctx, pageID)
+	if err != nil {
+		h.logger.Error("failed to count comments", "error", err, "page_id", pageID)
+	}
+	return count
+}
+
+// pageComments builds the comments section of a page. It returns nil when
+// comments are disabled, when the visitor may not view the page, or when the
+// page is closed for comments and has none to show.
+func (h *FrontendHandler) pageComments(r *http.Request, page store.Page, base BaseTemplateData) *CommentsView {
+	ctx := r.Context()
+	if !h.commentsEnabled(ctx) || !service.CanViewPage(ctx, h.queries, page.ID, middleware.GetUser(r)) {
+		return nil
+	}
+
+	comments, err := h.queries.ListApprovedCommentsByPage(ctx, page.ID)
+	if err != nil {
+		h.logger.Error("failed to list comments", "error", err, "page_id", page.ID)
+		return nil
+	}
+	open := page.Status == PageStatusPublished && service.CommentsOpen(ctx, h.queries, page)
+	if !open && len(comments) == 0 {
+		return nil
+	}
+
+	view := &CommentsView{
+		Count:    int64(len(comments)),
+		Comments: commentViews(service.CommentThreads(comments)),
+		Open:     open,
+		Action:   languagePrefixedURL(base.LangPrefix, fmt.Sprintf("/comments/%d", page.ID)),
+		LoginURL: memberLoginURL(base.LangPrefix, r.URL.RequestURI()),
+	}
+	if user := middleware.GetUser(r); user != nil {
+		view.UserName = user.Name
+		view.CanPost = open
+	} else {
+		view.CanPost = open && h.anonymousCommentsAllowed(ctx)
+		if view.CanPost {
+			view.Captcha = h.captchaWidget(ctx)
+		}
+	}
+	if view.CanPost {
+		if replyID, err := strconv.ParseInt(r.URL.Query().Get("reply"), 10, 64); err == nil {
+			if c := findCommentView(view.Comments, replyID); c != nil && c.CanReply {
+				view.ReplyTo = c
+			}
+		}
+	}
+	if notice, ok := commentNotices[r.URL.Query().Get("comment")]; ok {
+		view.Notice = h.themeManager.Translate(base.LangCode, notice.key)
+		view.NoticeError = notice.error
+	}
+	return view
+}
+
+// commentViews converts comment threads to views.
+func commentViews(nodes []*service.CommentNode) []CommentView {
+	views := make([]CommentView, 0, len(nodes))
+	for _, node := range nodes {
+		views = append(views, CommentView{
+			ID:                 node.Comment.ID,
+			AuthorName:         node.Comment.AuthorName,
+			Body:               node.Comment.Body,
+			CreatedAt:          node.Comment.CreatedAt,
+			CreatedAtFormatted: node.Comment.CreatedAt.Format("Jan 2, 2006"),
+			Depth:              node.Depth,
+			CanReply:           node.Depth < service.MaxCommentDepth-1,
+			Replies:            commentViews(node.Replies),
+		})
+	}
+	return views
+}
+
+// findCommentView finds a comment in threads by ID.
+func findCommentView(comments []CommentView, id int64) *CommentView {
+	for i := range comments {
+		if comments[i].ID == id {
+			return &comments[i]
+		}
+		if c := findCommentView(comments[i].Replies, id); c != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+// loginCaptchaWidget returns the captcha widget of the login form while the
+// login captcha is enforced, or an empty string.
+func (h *FrontendHandler) loginCaptchaWidget(ctx context.Context) template.HTML {
+	if h.hookRegistry == nil || !h.hookRegistry.HasActiveHandlers(hcaptcha.HookAuthBeforeLogin) {
+		return ""
+	}
+	result, err := h.hookRegistry.Call(ctx, hcaptcha.HookAuthLoginWidget, nil)
+	if err != nil {
+		h.logger.Error("failed to get login captcha widget", "error", err)
+		return ""
+	}
+	widget, _ := result.(template.HTML)
+	return widget
+}
+
+// captchaWidget returns the captcha widget of the active captcha module, or
+// an empty string.
+func (h *FrontendHandler) captchaWidget(ctx context.Context) template.HTML {
+	if h.hookRegistry == nil || !h.hookRegistry.HasHandlers(hcaptcha.HookFormCaptchaWidget) {
+		return ""
+	}
+	result, err := h.hookRegistry.Call(ctx, hcaptcha.HookFormCaptchaWidget, nil)
+	if err != nil {
+		h.logger.Error("failed to get captcha widget", "error", err)
+		return ""
+	}
+	widget, _ := result.(template.HTML)
+	return widget
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/olegiv/ocms-go/internal/contenttype"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/theme"
+)
+
+// ContentTypeView represents the content type of a page for template rendering.
+type ContentTypeView struct {
+	Name string
+	Slug string
+}
+
+// ContentMediaView is a media reference field value for template rendering.
+type ContentMediaView struct {
+	ID       int64
+	URL      string
+	Alt      string
+	Filename string
+	MimeType string
+}
+
+// ContentPageView is a page reference field value for template rendering.
+type ContentPageView struct {
+	ID    int64
+	Title string
+	URL   string
+}
+
+// applyContentType loads the content type and field values of a page into
+// its view. It returns the content type, or nil for built-in page types.
+func (h *FrontendHandler) applyContentType(ctx context.Context, pv *PageView, p store.Page) *store.ContentType {
+	if p.PageType == PageTypePost || p.PageType == PageTypePage {
+		return nil
+	}
+	ct, err := h.queries.GetContentTypeBySlug(ctx, p.PageType)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("failed to load content type", "error", err, "page_type", p.PageType)
+		}
+		return nil
+	}
+
+	fields := contenttype.ParseFields(ct.Fields)
+	values := loadPageContentValues(ctx, h.queries, p.ID)
+	pv.ContentType = &ContentTypeView{Name: ct.Name, Slug: ct.Slug}
+	pv.Fields = contenttype.Display(fields, values, func(f contenttype.Field, id int64) any {
+		if f.Type == contenttype.FieldMedia {
+			return h.contentMediaView(ctx, id)
+		}
+		return h.contentPageView(ctx, id)
+	})
+	return &ct
+}
+
+// applyCustomFields loads the key/value custom fields of a page into its
+// view, resolving references like content type fields.
+func (h *FrontendHandler) applyCustomFields(ctx context.Context, pv *PageView, p store.Page) {
+	pv.CustomFields = contenttype.DisplayCustom(h.themeManager.ActiveCustomFields(), loadPageCustomFields(ctx, h.queries, p.ID), func(f contenttype.Field, id int64) any {
+		if f.Type == contenttype.FieldMedia {
+			return h.contentMediaView(ctx, id)
+		}
+		return h.contentPageView(ctx, id)
+	})
+}
+
+// contentMediaView resolves a media reference. Missing media yield an untyped
+// nil so templates can test the field with if.
+func (h *FrontendHandler) contentMediaView(ctx context.Context, id int64) any {
+	media, err := h.queries.GetMediaByID(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return ContentMediaView{
+		ID:       media.ID,
+		URL:      model.MediaURL(model.VariantOriginal, media.Uuid, media.Filename),
+		Alt:      media.Alt.String,
+		Filename: media.Filename,
+		MimeType: media.MimeType,
+	}
+}
+
+// contentPageView resolves a page reference to a published page. The URL is
+// the stable /page/{id} route, which redirects to the canonical slug URL.
+func (h *FrontendHandler) contentPageView(ctx context.Context, id int64) any {
+	page, err := h.queries.GetPublishedPageByID(ctx, id)
+	if err != nil || page.SiteID != store.SiteIDFromContext(ctx) {
+		return nil
+	}
+	return ContentPageView{
+		ID:    page.ID,
+		Title: page.Title,
+		URL:   fmt.Sprintf("/page/%d", page.ID),
+	}
+}
+
+// pageTemplateName returns the theme template used to render a page of the
+// given content type. HTML themes may provide the template configured on the
+// content type or a page-<slug> template; everything else uses "page".
+func (h *FrontendHandler) pageTemplateName(ctx context.Context, ct *store.ContentType) string {
+	if ct == nil {
+		return "page"
+	}
+	activeTheme := siteTheme(ctx, h.themeManager)
+	if activeTheme == nil || activeTheme.RenderEngine() != theme.EngineHTML {
+		return "page"
+	}
+	if ct.Template != "" && activeTheme.HasPageTemplate(ct.Template) {
+		return ct.Template
+	}
+	if activeTheme.HasPageTemplate("page-" + ct.Slug) {
+		return "page-" + ct.Slug
+	}
+	return "page"
+}
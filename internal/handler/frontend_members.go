@@ -0,0 +1,149 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode"
+
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/theme"
+)
+
+// Forms of the member page.
+const (
+	memberFormLogin    = "login"
+	memberFormRegister = "register"
+	memberFormProfile  = "profile"
+	memberFormDenied   = "denied"
+)
+
+// MembersNav holds the member links of the site header.
+type MembersNav struct {
+	UserName    string // Name of the logged-in visitor, "" for anonymous visitors
+	LoginURL    string
+	RegisterURL string // "" when registration is closed
+	ProfileURL  string
+	LogoutURL   string
+}
+
+// MemberPageData holds the data of the member login, registration, profile
+// and access denied pages.
+type MemberPageData struct {
+	BaseTemplateData
+	Form        string // "login", "register", "profile" or "denied"
+	Action      string // URL the form posts to
+	Next        string // Local URL to return to after logging in
+	Values      map[string]string
+	Errors      map[string]string // Field errors by field name
+	Error       string            // Error of the whole form
+	Message     string            // Success message
+	Captcha     template.HTML     // Captcha widget of the registration form
+	LoginURL    string
+	RegisterURL string // "" when registration is closed
+}
+
+// memberRegistrationOpen reports whether visitors may register member
+// accounts.
+func (h *FrontendHandler) memberRegistrationOpen(ctx context.Context) bool {
+	return h.getConfigValue(ctx, model.ConfigKeyMembersRegistration) == "true"
+}
+
+// membersNav builds the member links of the site header. It returns nil
+// when registration is closed and the visitor is not logged in.
+func (h *FrontendHandler) membersNav(r *http.Request, langPrefix string) *MembersNav {
+	user := middleware.GetUser(r)
+	open := h.memberRegistrationOpen(r.Context())
+	if user == nil && !open {
+		return nil
+	}
+	nav := &MembersNav{
+		LoginURL:   memberLoginURL(langPrefix, r.URL.RequestURI()),
+		ProfileURL: languagePrefixedURL(langPrefix, RouteMembersProfile),
+		LogoutURL:  languagePrefixedURL(langPrefix, RouteMembersLogout),
+	}
+	if user != nil {
+		nav.UserName = user.Name
+	}
+	if open {
+		nav.RegisterURL = languagePrefixedURL(langPrefix, RouteMembersRegister)
+	}
+	return nav
+}
+
+// memberLoginURL returns the member login URL that returns to next.
+func memberLoginURL(langPrefix, next string) string {
+	loginURL := languagePrefixedURL(langPrefix, RouteMembersLogin)
+	if next = safeMemberNext(next); next != "" {
+		loginURL += "?next=" + url.QueryEscape(next)
+	}
+	return loginURL
+}
+
+// safeMemberNext returns next when it is a local path, or "". It keeps the
+// login redirect from leaving the site and control characters out of the
+// Location header.
+func safeMemberNext(next string) string {
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") ||
+		strings.ContainsRune(next, '\\') || strings.ContainsFunc(next, unicode.IsControl) {
+		return ""
+	}
+	return next
+}
+
+// memberPageData builds the data of a member page titled with the
+// translation of titleKey.
+func (h *FrontendHandler) memberPageData(r *http.Request, form, titleKey string) MemberPageData {
+	base := h.getBaseTemplateData(r, "", "")
+	base.Title = h.themeManager.Translate(base.LangCode, titleKey)
+	base.Robots = "noindex,nofollow"
+	data := MemberPageData{
+		BaseTemplateData: base,
+		Form:             form,
+		Values:           map[string]string{},
+		Errors:           map[string]string{},
+		LoginURL:         languagePrefixedURL(base.LangPrefix, RouteMembersLogin),
+	}
+	if h.memberRegistrationOpen(r.Context()) {
+		data.RegisterURL = languagePrefixedURL(base.LangPrefix, RouteMembersRegister)
+	}
+	return data
+}
+
+// renderMemberPage renders a member page with the given status. HTML themes
+// without a member template fall back to the built-in templ page.
+func (h *FrontendHandler) renderMemberPage(w http.ResponseWriter, r *http.Request, status int, data MemberPageData) {
+	w.Header().Set("Cache-Control", "no-store")
+	if status != http.StatusOK {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(status)
+	}
+	activeTheme := siteTheme(r.Context(), h.themeManager)
+	if activeTheme != nil && activeTheme.RenderEngine() == theme.EngineHTML && activeTheme.HasPageTemplate("member") {
+		h.renderHTML(w, activeTheme, "member", data)
+		return
+	}
+	h.renderTempl(w, r, "member", data)
+}
+
+// renderPageAccessDenied answers a request for a page the visitor may not
+// view. Anonymous visitors are sent to the member login form; logged-in
+// visitors without a permitted role get 403.
+func (h *FrontendHandler) renderPageAccessDenied(w http.ResponseWriter, r *http.Request, languagePrefix string) {
+	langPrefix := ""
+	if languagePrefix != "" {
+		langPrefix = "/" + languagePrefix
+	}
+	if middleware.GetUser(r) == nil {
+		http.Redirect(w, r, memberLoginURL(langPrefix, r.URL.RequestURI()), http.StatusSeeOther)
+		return
+	}
+	data := h.memberPageData(r, memberFormDenied, "frontend.member_access_denied")
+	h.renderMemberPage(w, r, http.StatusForbidden, data)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/olegiv/ocms-go/internal/seo"
+	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/util"
+)
+
+// BreadcrumbView is an entry of the breadcrumb trail of a page.
+type BreadcrumbView struct {
+	Title   string
+	URL     string // Empty for unpublished parent pages
+	Current bool   // The page being displayed
+}
+
+// pageRequestPath returns the page path of a page request and its last
+// segment, the slug of the requested page. Nested paths are matched by
+// RoutePagePath; ok is false when a segment is not a valid slug.
+func pageRequestPath(r *http.Request) (path, slug string, ok bool) {
+	slug = chi.URLParam(r, "slug")
+	rest := strings.Trim(chi.URLParam(r, "*"), "/")
+	if rest == "" {
+		return slug, slug, true
+	}
+	path = slug + "/" + rest
+	if !isValidPagePath(path) {
+		return "", "", false
+	}
+	return path, path[strings.LastIndex(path, "/")+1:], true
+}
+
+// isValidPagePath reports whether a page path has at most MaxPageDepth
+// segments that are all valid slugs.
+func isValidPagePath(path string) bool {
+	segments := strings.Split(path, "/")
+	if len(segments) > service.MaxPageDepth {
+		return false
+	}
+	for _, segment := range segments {
+		if !util.IsValidSlug(segment) {
+			return false
+		}
+	}
+	return true
+}
+
+// pagePath returns the nested path of a page below the language prefix.
+func (h *FrontendHandler) pagePath(ctx context.Context, p store.Page) string {
+	return service.PagePath(ctx, h.queries, p.ID, p.Slug)
+}
+
+// pageBreadcrumbs returns the trail from the home page through the parent
+// pages of a page to the page itself.
+func (h *FrontendHandler) pageBreadcrumbs(ctx context.Context, page store.Page, pv PageView, base BaseTemplateData) []BreadcrumbView {
+	crumbs := []BreadcrumbView{{
+		Title: h.themeManager.Translate(base.LangCode, "frontend.home"),
+		URL:   base.HomeURL,
+	}}
+	path := ""
+	for _, ancestor := range service.PageAncestors(ctx, h.queries, page.ID) {
+		path += ancestor.Slug + "/"
+		crumb := BreadcrumbView{Title: ancestor.Title}
+		if ancestor.Status == PageStatusPublished {
+			crumb.URL = languagePrefixedURL(base.LangPrefix, "/"+strings.TrimSuffix(path, "/"))
+		}
+		crumbs = append(crumbs, crumb)
+	}
+	return append(crumbs, BreadcrumbView{Title: pv.Title, URL: pv.URL, Current: true})
+}
+
+// breadcrumbSchemaItems returns the linked entries of a breadcrumb trail for
+// BreadcrumbList structured data.
+func breadcrumbSchemaItems(crumbs []BreadcrumbView) []seo.Breadcrumb {
+	items := make([]seo.Breadcrumb, 0, len(crumbs))
+	for _, crumb := range crumbs {
+		if crumb.URL != "" {
+			items = append(items, seo.Breadcrumb{Name: crumb.Title, Path: crumb.URL})
+		}
+	}
+	return items
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/shortcode"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+const (
+	// defaultGalleryShortcodeLimit is the number of images shown by
+	// [[gallery]] without a limit attribute.
+	defaultGalleryShortcodeLimit = 24
+	// maxGalleryShortcodeLimit caps the limit attribute of [[gallery]].
+	maxGalleryShortcodeLimit = 100
+)
+
+// ModuleShortcodesProvider returns page body shortcodes from active modules.
+// Calling this per-request ensures toggled modules are reflected immediately.
+type ModuleShortcodesProvider interface {
+	AllShortcodes() map[string]shortcode.Func
+}
+
+// SetModuleShortcodesProvider sets the provider used to fetch module
+// shortcodes per-request.
+func (h *FrontendHandler) SetModuleShortcodesProvider(p ModuleShortcodesProvider) {
+	h.moduleShortcodes = p
+}
+
+var shortcodeTemplates = template.Must(template.New("shortcodes").Parse(`
+{{define "form"}}<div class="shortcode-form" data-form="{{.Slug}}"><a href="{{.URL}}">{{.Label}}</a></div>{{end}}
+{{define "gallery"}}<figure class="shortcode-gallery"><div class="shortcode-gallery-images">{{range .Images}}<a href="{{.Full}}"><img src="{{.Thumb}}" alt="{{.Alt}}" loading="lazy"></a>{{end}}</div>{{with .Caption}}<figcaption>{{.}}</figcaption>{{end}}</figure>{{end}}
+`))
+
+// shortcodes returns the built-in shortcodes and those of active modules.
+// Built-in names cannot be overridden by modules.
+func (h *FrontendHandler) shortcodes() map[string]shortcode.Func {
+	codes := make(map[string]shortcode.Func)
+	if h.moduleShortcodes != nil {
+		for name, fn := range h.moduleShortcodes.AllShortcodes() {
+			codes[name] = fn
+		}
+	}
+	codes["snippet"] = h.snippetShortcode
+	codes["form"] = h.formShortcode
+	codes["gallery"] = h.galleryShortcode
+	return codes
+}
+
+// expandShortcodes expands the shortcodes of a page body for the page language.
+func (h *FrontendHandler) expandShortcodes(ctx context.Context, body, langCode, langPrefix string) string {
+	if !strings.Contains(body, "[[") {
+		return body
+	}
+	return shortcode.Expand(ctx, body, h.shortcodes(), shortcode.Env{Lang: langCode, LangPrefix: langPrefix})
+}
+
+// snippetShortcode renders [[snippet:slug]] in the page language, falling
+// back to the default language. Shortcodes in the snippet are expanded too.
+func (h *FrontendHandler) snippetShortcode(ctx context.Context, call shortcode.Call) (template.HTML, error) {
+	if call.Arg == "" {
+		return "", errors.New("snippet slug is required")
+	}
+	s, err := h.queries.GetSnippetBySlugAndLanguage(ctx, store.GetSnippetBySlugAndLanguageParams{
+		Slug: call.Arg, LanguageCode: call.Lang,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		def, defErr := h.queries.GetDefaultLanguage(ctx)
+		if defErr != nil {
+			return "", fmt.Errorf("snippet %q not found", call.Arg)
+		}
+		s, err = h.queries.GetSnippetBySlugAndLanguage(ctx, store.GetSnippetBySlugAndLanguageParams{
+			Slug: call.Arg, LanguageCode: def.Code,
+		})
+	}
+	if err != nil {
+		return "", fmt.Errorf("snippet %q: %w", call.Arg, err)
+	}
+	return template.HTML(call.Expand(ctx, s.Body)), nil
+}
+
+// formShortcode renders [[form:slug]] as a link to the public form in the
+// page language. The label attribute overrides the form title.
+func (h *FrontendHandler) formShortcode(ctx context.Context, call shortcode.Call) (template.HTML, error) {
+	form, err := h.queries.GetFormBySlugAndLanguage(ctx, store.GetFormBySlugAndLanguageParams{
+		Slug: call.Arg, LanguageCode: call.Lang,
+	})
+	if err != nil {
+		return "", fmt.Errorf("form %q: %w", call.Arg, err)
+	}
+	if !form.IsActive {
+		return "", fmt.Errorf("form %q is inactive", call.Arg)
+	}
+	label := call.Attr("label")
+	if label == "" {
+		label = form.Title
+	}
+	return executeShortcodeTemplate("form", map[string]string{
+		"Slug":  form.Slug,
+		"URL":   languagePrefixedURL(call.LangPrefix, "/forms/"+form.Slug),
+		"Label": label,
+	})
+}
+
+// galleryShortcodeImage is an image of a [[gallery]] shortcode.
+type galleryShortcodeImage struct {
+	Full, Thumb, Alt string
+}
+
+// galleryShortcode renders [[gallery folder=ID]] with the images of a media
+// folder, newest first. Optional attributes are limit and caption.
+func (h *FrontendHandler) galleryShortcode(ctx context.Context, call shortcode.Call) (template.HTML, error) {
+	folderID, err := strconv.ParseInt(call.Attr("folder"), 10, 64)
+	if err != nil || folderID <= 0 {
+		return "", errors.New("gallery requires a numeric folder attribute")
+	}
+	limit := defaultGalleryShortcodeLimit
+	if raw := call.Attr("limit"); raw != "" {
+		if limit, err = strconv.Atoi(raw); err != nil || limit <= 0 {
+			return "", fmt.Errorf("invalid gallery limit %q", raw)
+		}
+		limit = min(limit, maxGalleryShortcodeLimit)
+	}
+
+	media, err := h.queries.ListMediaInFolder(ctx, store.ListMediaInFolderParams{
+		FolderID: sql.NullInt64{Int64: folderID, Valid: true},
+		Limit:    int64(limit),
+	})
+	if err != nil {
+		return "", fmt.Errorf("gallery folder %d: %w", folderID, err)
+	}
+	images := make([]galleryShortcodeImage, 0, len(media))
+	for _, m := range media {
+		if !strings.HasPrefix(m.MimeType, "image/") {
+			continue
+		}
+		images = append(images, galleryShortcodeImage{
+			Full:  model.MediaURL(model.VariantOriginal, m.Uuid, m.Filename),
+			Thumb: model.MediaURL(model.VariantThumbnail, m.Uuid, m.Filename),
+			Alt:   m.Alt.String,
+		})
+	}
+	if len(images) == 0 {
+		return "", nil
+	}
+	return executeShortcodeTemplate("gallery", map[string]any{
+		"Images":  images,
+		"Caption": call.Attr("caption"),
+	})
+}
+
+func executeShortcodeTemplate(name string, data any) (template.HTML, error) {
+	var buf bytes.Buffer
+	if err := shortcodeTemplates.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", err
+	}
+	return template.HTML(buf.String()), nil
+}
@@ -207,7 +207,7 @@ func TestFrontendViewsPrefixNonDefaultLanguageLinks(t *testing.T) {
 	}
 
 	h := NewFrontendHandler(db, testThemeManager(), nil, slog.Default(), nil, nil)
-	page, err := h.queries.GetPageBySlug(ctx, "article")
+	page, err := h.queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: "article", SiteID: store.DefaultSiteID})
 	if err != nil {
 		t.Fatalf("GetPageBySlug: %v", err)
 	}
@@ -431,7 +431,10 @@ func TestLanguageScopedTaxonomyUsageQueriesExcludeCrossLanguageAssociations(t *t
 	}
 
 	queries := store.New(db)
-	categories, err := queries.GetCategoryUsageCountsByLanguage(context.Background(), "en")
+	categories, err := queries.GetCategoryUsageCountsByLanguage(context.Background(), store.GetCategoryUsageCountsByLanguageParams{
+		LanguageCode: "en",
+		SiteID:       store.DefaultSiteID,
+	})
 	if err != nil {
 		t.Fatalf("GetCategoryUsageCountsByLanguage: %v", err)
 	}
@@ -441,6 +444,7 @@ func TestLanguageScopedTaxonomyUsageQueriesExcludeCrossLanguageAssociations(t *t
 
 	tags, err := queries.GetTagUsageCountsByLanguage(context.Background(), store.GetTagUsageCountsByLanguageParams{
 		LanguageCode:   "en",
+		SiteID:         store.DefaultSiteID,
 		LanguageCode_2: "en",
 		Limit:          20,
 	})
@@ -880,6 +884,64 @@ func TestFrontendHandler_Page_ExplicitPrefixRequiresMatchingPageLanguage(t *test
 	}
 }
 
+func TestFrontendHandler_Page_SameSlugOnTwoSites(t *testing.T) {
+	db, _ := testHandlerSetup(t)
+	admin := createTestAdminUser(t, db)
+	ctx := context.Background()
+	queries := store.New(db)
+	now := time.Now()
+	blog, err := queries.CreateSite(ctx, store.CreateSiteParams{Name: "Blog", Host: "blog.example.com", CreatedAt: now, UpdatedAt: now})
+	if err != nil {
+		t.Fatalf("CreateSite: %v", err)
+	}
+	seedPageWithHTML(t, db, "about", "Main About", "<p>Main site content</p>", "", admin.ID)
+	res, err := db.Exec(
+		`INSERT INTO pages (title, slug, body, status, author_id, page_type, published_at, site_id)
+		 VALUES ('Blog About', 'about', '<p>Blog site content</p>', 'published', ?, 'post', CURRENT_TIMESTAMP, ?)`,
+		admin.ID, blog.ID,
+	)
+	if err != nil {
+		t.Fatalf("a second site could not reuse the slug: %v", err)
+	}
+	blogPageID, _ := res.LastInsertId()
+	if _, err := queries.CreatePageAlias(ctx, store.CreatePageAliasParams{PageID: blogPageID, Alias: "old-about", CreatedAt: now}); err != nil {
+		t.Fatalf("CreatePageAlias: %v", err)
+	}
+
+	// The page cache is shared by all sites, so it must key pages by site too
+	cacheManager := cache.NewManager(queries)
+	h := NewFrontendHandler(db, testThemeManager(), cacheManager, slog.Default(), nil, nil)
+	router := languageAwareAliasTestRouter(db, h)
+
+	tests := []struct {
+		name       string
+		siteID     int64
+		path       string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "main site page", siteID: store.DefaultSiteID, path: "/about", wantStatus: http.StatusOK, wantBody: "Main site content"},
+		{name: "blog site page", siteID: blog.ID, path: "/about", wantStatus: http.StatusOK, wantBody: "Blog site content"},
+		{name: "blog alias on blog site", siteID: blog.ID, path: "/old-about", wantStatus: http.StatusMovedPermanently},
+		{name: "blog alias on main site", siteID: store.DefaultSiteID, path: "/old-about", wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req = req.WithContext(store.WithSiteID(req.Context(), tt.siteID))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d; want %d", w.Code, tt.wantStatus)
+			}
+			if tt.wantBody != "" && !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Errorf("body does not contain %q", tt.wantBody)
+			}
+		})
+	}
+}
+
 func TestFrontendHandler_PageRelatedPagesStayInRequestLanguage(t *testing.T) {
 	db, _ := testHandlerSetup(t)
 	admin := createTestAdminUser(t, db)
@@ -0,0 +1,366 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/shortcode"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/widget"
+)
+
+const (
+	// maxWidgetListItems caps the limit setting of list widgets.
+	maxWidgetListItems = 50
+	// defaultWidgetGalleryLimit is the number of images of a gallery widget
+	// without a limit setting.
+	defaultWidgetGalleryLimit = 6
+)
+
+var widgetTemplates = template.Must(template.New("widgets").Parse(`
+{{define "links"}}<ul class="category-list">{{range .}}<li{{if .Current}} class="active"{{end}}><a href="{{.URL}}"{{if .Current}} aria-current="page"{{end}}>{{.Title}}</a>{{with .Count}} <span class="count">({{.}})</span>{{end}}</li>{{end}}</ul>{{end}}
+{{define "posts"}}<ul class="recent-posts-list">{{range .}}<li><a href="{{.URL}}">{{.Title}}</a>{{with .Date}}<span class="post-date">{{.}}</span>{{end}}</li>{{end}}</ul>{{end}}
+{{define "tags"}}<div class="tag-cloud">{{range .}}<a href="{{.URL}}" class="tag">{{.Title}}</a>{{end}}</div>{{end}}
+{{define "search"}}<form class="widget-search-form" action="{{.Action}}" method="get" role="search"><input type="search" name="q" placeholder="{{.Placeholder}}" aria-label="{{.Placeholder}}"><button type="submit">{{.Button}}</button></form>{{end}}
+{{define "menu"}}<ul class="widget-menu">{{range .}}<li{{if .IsActive}} class="active"{{end}}><a href="{{.URL}}"{{with .Target}} target="{{.}}"{{end}}>{{.Title}}</a>{{with .Children}}{{template "menu" .}}{{end}}</li>{{end}}</ul>{{end}}
+{{define "media"}}<figure class="widget-media">{{if .Link}}<a href="{{.Link}}">{{end}}<img src="{{.Src}}" alt="{{.Alt}}" loading="lazy">{{if .Link}}</a>{{end}}{{with .Caption}}<figcaption>{{.}}</figcaption>{{end}}</figure>{{end}}
+`))
+
+// widgetLink is an entry of a list widget.
+type widgetLink struct {
+	Title, URL, Date string
+	Count            int64
+	Current          bool
+}
+
+// widgetPageKey is the request context key of the page widgets are rendered
+// for.
+type widgetPageKey struct{}
+
+// widgetPage is the page type and categories widget visibility rules match.
+type widgetPage struct {
+	pageType    string
+	categoryIDs []int64
+}
+
+// requestWithWidgetPage records the page type and categories of the page
+// being rendered, for the widget visibility rules. It must be called before
+// getBaseTemplateData.
+func requestWithWidgetPage(r *http.Request, pageType string, categoryIDs ...int64) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), widgetPageKey{}, widgetPage{
+		pageType:    pageType,
+		categoryIDs: categoryIDs,
+	}))
+}
+
+// pageCategoryIDs returns the categories of a page for widget visibility.
+func (h *FrontendHandler) pageCategoryIDs(ctx context.Context, pageID int64) []int64 {
+	categories, err := h.queries.GetCategoriesForPage(ctx, pageID)
+	if err != nil {
+		h.logger.Error("failed to get page categories", "error", err, "page_id", pageID)
+		return nil
+	}
+	ids := make([]int64, 0, len(categories))
+	for _, c := range categories {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+// SetModuleWidgetTypesProvider sets the provider used to fetch module widget
+// types per-request.
+func (h *FrontendHandler) SetModuleWidgetTypesProvider(p ModuleWidgetTypesProvider) {
+	h.moduleWidgets = p
+}
+
+// loadWidgets renders the widgets of the active theme shown on a request.
+func (h *FrontendHandler) loadWidgets(r *http.Request, langCode, langPrefix string) map[string][]service.WidgetView {
+	activeTheme := siteTheme(r.Context(), h.themeManager)
+	if activeTheme == nil {
+		return nil
+	}
+	env := widget.Env{Lang: langCode, LangPrefix: langPrefix, Path: r.URL.Path}
+	if page, ok := r.Context().Value(widgetPageKey{}).(widgetPage); ok {
+		env.PageType = page.pageType
+		env.CategoryIDs = page.categoryIDs
+	}
+	return h.widgetService.RenderWidgetsForPage(r.Context(), activeTheme.Name, env, h.widgetTypes(r))
+}
+
+// widgetTypes returns the core and module widget types, with the core
+// renderers bound for a request.
+func (h *FrontendHandler) widgetTypes(r *http.Request) map[string]widget.Type {
+	renderers := map[string]widget.Func{
+		"recent_posts":      h.recentPostsWidget,
+		"categories":        h.categoriesWidget,
+		"tags":              h.tagsWidget,
+		"search":            searchWidget,
+		"custom_menu":       h.customMenuWidget,
+		"form":              h.formWidget,
+		"featured_media":    h.featuredMediaWidget,
+		"gallery":           h.galleryWidget,
+		"archives":          h.archivesWidget,
+		"language_switcher": h.languageSwitcherWidget,
+		"module_output":     h.moduleOutputWidget(middleware.GetCSPNonce(r), requestPageOrigin(r)),
+	}
+	types := make(map[string]widget.Type)
+	for _, t := range availableWidgetTypes(h.moduleWidgets) {
+		if fn, ok := renderers[t.ID]; ok {
+			t.Render = fn
+		}
+		types[t.ID] = t
+	}
+	return types
+}
+
+// recentPostsWidget lists the latest posts of the page language.
+func (h *FrontendHandler) recentPostsWidget(ctx context.Context, call widget.Call) (template.HTML, error) {
+	posts, err := h.queries.ListPublishedPostsByLanguage(ctx, store.ListPublishedPostsByLanguageParams{
+		SiteID:       store.SiteIDFromContext(ctx),
+		LanguageCode: call.Lang,
+		Limit:        int64(call.IntSetting("limit", 5, maxWidgetListItems)),
+	})
+	if err != nil {
+		return "", err
+	}
+	links := make([]widgetLink, 0, len(posts))
+	for _, p := range posts {
+		link := widgetLink{Title: p.Title, URL: languagePrefixedURL(call.LangPrefix, "/"+h.pagePath(ctx, p))}
+		if p.PublishedAt.Valid {
+			link.Date = p.PublishedAt.Time.Format("Jan 2, 2006")
+		}
+		links = append(links, link)
+	}
+	return executeWidgetLinks("posts", links)
+}
+
+// categoriesWidget lists the categories of the page language with their
+// page counts. The category being viewed is marked current.
+func (h *FrontendHandler) categoriesWidget(ctx context.Context, call widget.Call) (template.HTML, error) {
+	categories, err := h.queries.GetCategoryUsageCountsByLanguage(ctx, store.GetCategoryUsageCountsByLanguageParams{
+		LanguageCode: call.Lang,
+		SiteID:       store.SiteIDFromContext(ctx),
+	})
+	if err != nil {
+		return "", err
+	}
+	prefixes := map[string]string{call.Lang: call.LangPrefix}
+	links := make([]widgetLink, 0, len(categories))
+	for _, c := range categories {
+		url, ok := canonicalTaxonomyEntityURL(prefixes, c.LanguageCode, redirectCategory, c.Slug)
+		if !ok {
+			continue
+		}
+		links = append(links, widgetLink{
+			Title:   c.Name,
+			URL:     url,
+			Count:   c.UsageCount,
+			Current: call.PageType == widget.PageCategory && slices.Contains(call.CategoryIDs, c.ID),
+		})
+	}
+	return executeWidgetLinks("links", links)
+}
+
+// tagsWidget shows the most used tags of the page language.
+func (h *FrontendHandler) tagsWidget(ctx context.Context, call widget.Call) (template.HTML, error) {
+	tags, err := h.queries.GetTagUsageCountsByLanguage(ctx, store.GetTagUsageCountsByLanguageParams{
+		SiteID:         store.SiteIDFromContext(ctx),
+		LanguageCode:   call.Lang,
+		LanguageCode_2: call.Lang,
+		Limit:          int64(call.IntSetting("limit", 20, maxWidgetListItems)),
+	})
+	if err != nil {
+		return "", err
+	}
+	prefixes := map[string]string{call.Lang: call.LangPrefix}
+	links := make([]widgetLink, 0, len(tags))
+	for _, t := range tags {
+		if url, ok := canonicalTaxonomyEntityURL(prefixes, t.LanguageCode, redirectTag, t.Slug); ok {
+			links = append(links, widgetLink{Title: t.Name, URL: url})
+		}
+	}
+	return executeWidgetLinks("tags", links)
+}
+
+// searchWidget renders a search form for the page language.
+func searchWidget(_ context.Context, call widget.Call) (template.HTML, error) {
+	return executeWidgetTemplate("search", map[string]string{
+		"Action":      languagePrefixedURL(call.LangPrefix, "/search"),
+		"Placeholder": i18n.T(call.Lang, "search.placeholder"),
+		"Button":      i18n.T(call.Lang, "search.button"),
+	})
+}
+
+// customMenuWidget renders the menu named by the menu setting in the page
+// language.
+func (h *FrontendHandler) customMenuWidget(ctx context.Context, call widget.Call) (template.HTML, error) {
+	slug := call.Setting("menu")
+	if slug == "" {
+		return "", errors.New("menu setting is required")
+	}
+	items := loadMenu(ctx, h.menuService, slug, call.Path, call.Lang)
+	if len(items) == 0 {
+		return "", nil
+	}
+	return executeWidgetTemplate("menu", items)
+}
+
+// formWidget links to the form named by the form setting, like [[form]].
+func (h *FrontendHandler) formWidget(ctx context.Context, call widget.Call) (template.HTML, error) {
+	return h.formShortcode(ctx, widgetShortcodeCall(call, "form", call.Setting("form"), "label"))
+}
+
+// galleryWidget shows the images of the folder setting, like [[gallery]].
+func (h *FrontendHandler) galleryWidget(ctx context.Context, call widget.Call) (template.HTML, error) {
+	sc := widgetShortcodeCall(call, "gallery", "", "folder", "caption")
+	sc.Attrs["limit"] = strconv.Itoa(call.IntSetting("limit", defaultWidgetGalleryLimit, maxGalleryShortcodeLimit))
+	return h.galleryShortcode(ctx, sc)
+}
+
+// featuredMediaWidget shows one image of the media library.
+func (h *FrontendHandler) featuredMediaWidget(ctx context.Context, call widget.Call) (template.HTML, error) {
+	id, err := strconv.ParseInt(call.Setting("media_id"), 10, 64)
+	if err != nil || id <= 0 {
+		return "", errors.New("media_id setting is required")
+	}
+	m, err := h.queries.GetMediaByID(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("media %d: %w", id, err)
+	}
+	if !strings.HasPrefix(m.MimeType, "image/") {
+		return "", fmt.Errorf("media %d is not an image", id)
+	}
+	return executeWidgetTemplate("media", map[string]string{
+		"Src":     model.MediaURL(model.VariantMedium, m.Uuid, m.Filename),
+		"Alt":     m.Alt.String,
+		"Link":    call.Setting("link"),
+		"Caption": call.Setting("caption"),
+	})
+}
+
+// archivesWidget lists the months with posts in the page language, newest
+// first, linking to the monthly blog archives.
+func (h *FrontendHandler) archivesWidget(ctx context.Context, call widget.Call) (template.HTML, error) {
+	months, err := h.queries.ListPostArchiveMonths(ctx, store.ListPostArchiveMonthsParams{
+		SiteID:       store.SiteIDFromContext(ctx),
+		LanguageCode: call.Lang,
+		Limit:        int64(call.IntSetting("limit", 12, maxWidgetListItems)),
+	})
+	if err != nil {
+		return "", err
+	}
+	links := make([]widgetLink, 0, len(months))
+	for _, m := range months {
+		t, err := time.Parse("2006-01", m.Month)
+		if err != nil {
+			continue
+		}
+		url := blogArchiveURL(call.LangPrefix, t.Year(), int(t.Month()))
+		links = append(links, widgetLink{
+			Title:   render.FormatMonthLocale(t, call.Lang),
+			URL:     url,
+			Count:   m.PageCount,
+			Current: url == call.Path,
+		})
+	}
+	return executeWidgetLinks("links", links)
+}
+
+// languageSwitcherWidget links to the home page of every public language.
+// It renders nothing on single-language sites.
+func (h *FrontendHandler) languageSwitcherWidget(ctx context.Context, call widget.Call) (template.HTML, error) {
+	languages, err := h.queries.ListActiveLanguages(ctx)
+	if err != nil {
+		return "", err
+	}
+	languages = routableLanguages(languages)
+	if len(languages) < 2 {
+		return "", nil
+	}
+	links := make([]widgetLink, 0, len(languages))
+	for _, lang := range languages {
+		prefix := ""
+		if !lang.IsDefault {
+			prefix = "/" + lang.Code
+		}
+		links = append(links, widgetLink{
+			Title:   lang.NativeName,
+			URL:     frontendHomePath(prefix),
+			Current: lang.Code == call.Lang,
+		})
+	}
+	return executeWidgetLinks("links", links)
+}
+
+// moduleOutputWidget returns a renderer showing the output of the module
+// template function named by the func setting. Functions taking variadic
+// arguments get the CSP nonce and page origin, like the layout hooks.
+func (h *FrontendHandler) moduleOutputWidget(nonce, origin string) widget.Func {
+	return func(_ context.Context, call widget.Call) (template.HTML, error) {
+		name := call.Setting("func")
+		if h.moduleFuncsProvider == nil || name == "" {
+			return "", errors.New("func setting is required")
+		}
+		switch fn := h.moduleFuncsProvider.AllTemplateFuncs()[name].(type) {
+		case func(...any) template.HTML:
+			return fn(nonce, origin), nil
+		case func() template.HTML:
+			return fn(), nil
+		case func() string:
+			return template.HTML(template.HTMLEscapeString(fn())), nil
+		case nil:
+			return "", fmt.Errorf("module template function %q not found", name)
+		default:
+			return "", fmt.Errorf("module template function %q does not return HTML", name)
+		}
+	}
+}
+
+// widgetShortcodeCall builds a shortcode call from widget settings, so
+// widgets can share the rendering of shortcodes.
+func widgetShortcodeCall(call widget.Call, name, arg string, attrs ...string) shortcode.Call {
+	sc := shortcode.Call{
+		Env:   shortcode.Env{Lang: call.Lang, LangPrefix: call.LangPrefix},
+		Name:  name,
+		Arg:   arg,
+		Attrs: make(map[string]string),
+	}
+	for _, key := range attrs {
+		if v := call.Setting(key); v != "" {
+			sc.Attrs[key] = v
+		}
+	}
+	return sc
+}
+
+// executeWidgetLinks renders a list widget; an empty list hides the widget.
+func executeWidgetLinks(name string, links []widgetLink) (template.HTML, error) {
+	if len(links) == 0 {
+		return "", nil
+	}
+	return executeWidgetTemplate(name, links)
+}
+
+func executeWidgetTemplate(name string, data any) (template.HTML, error) {
+	var buf bytes.Buffer
+	if err := widgetTemplates.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", err
+	}
+	return template.HTML(buf.String()), nil
+}
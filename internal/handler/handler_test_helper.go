@@ -60,7 +60,7 @@ func testDB(t *testing.T) *sql.DB {
 		CREATE TABLE pages (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
 			title TEXT NOT NULL,
-			slug TEXT NOT NULL UNIQUE,
+			slug TEXT NOT NULL,
 			body TEXT NOT NULL DEFAULT '',
 			summary TEXT NOT NULL DEFAULT '',
 			status TEXT NOT NULL DEFAULT 'draft',
@@ -83,8 +83,10 @@ func testDB(t *testing.T) *sql.DB {
 			exclude_from_lists INTEGER NOT NULL DEFAULT 0,
 			video_url TEXT NOT NULL DEFAULT '',
 			video_title TEXT NOT NULL DEFAULT '',
+			site_id INTEGER NOT NULL DEFAULT 1,
 			FOREIGN KEY (author_id) REFERENCES users(id)
 		);
+		CREATE UNIQUE INDEX idx_pages_site_slug ON pages(site_id, slug);
 		CREATE INDEX idx_pages_slug ON pages(slug);
 		CREATE INDEX idx_pages_status ON pages(status);
 		CREATE INDEX idx_pages_author_id ON pages(author_id);
@@ -94,11 +96,116 @@ func testDB(t *testing.T) *sql.DB {
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
 			page_id INTEGER NOT NULL REFERENCES pages(id) ON DELETE CASCADE,
 			alias TEXT NOT NULL,
-			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			site_id INTEGER NOT NULL DEFAULT 1
 		);
-		CREATE UNIQUE INDEX idx_page_aliases_alias ON page_aliases(alias);
+		CREATE UNIQUE INDEX idx_page_aliases_alias ON page_aliases(site_id, alias);
 		CREATE INDEX idx_page_aliases_page_id ON page_aliases(page_id);
 
+		CREATE TABLE page_url_history (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			page_id INTEGER NOT NULL REFERENCES pages(id) ON DELETE CASCADE,
+			path TEXT NOT NULL,
+			changed_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+		CREATE UNIQUE INDEX idx_page_url_history_page_path ON page_url_history(page_id, path);
+
+		CREATE TABLE content_types (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL,
+			slug TEXT NOT NULL UNIQUE,
+			description TEXT NOT NULL DEFAULT '',
+			fields TEXT NOT NULL DEFAULT '[]',
+			template TEXT NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE TABLE page_content_fields (
+			page_id INTEGER PRIMARY KEY REFERENCES pages(id) ON DELETE CASCADE,
+			data TEXT NOT NULL DEFAULT '{}',
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE TABLE page_custom_fields (
+			page_id INTEGER PRIMARY KEY REFERENCES pages(id) ON DELETE CASCADE,
+			data TEXT NOT NULL DEFAULT '[]',
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE TABLE page_blocks (
+			page_id INTEGER PRIMARY KEY REFERENCES pages(id) ON DELETE CASCADE,
+			data TEXT NOT NULL,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE TABLE page_hierarchy (
+			page_id INTEGER PRIMARY KEY REFERENCES pages(id) ON DELETE CASCADE,
+			parent_id INTEGER REFERENCES pages(id) ON DELETE SET NULL,
+			position INTEGER NOT NULL DEFAULT 0
+		);
+
+		CREATE TABLE page_series (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL,
+			slug TEXT NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			language_code TEXT NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE(slug, language_code)
+		);
+
+		CREATE TABLE page_series_items (
+			page_id INTEGER PRIMARY KEY REFERENCES pages(id) ON DELETE CASCADE,
+			series_id INTEGER NOT NULL REFERENCES page_series(id) ON DELETE CASCADE,
+			position INTEGER NOT NULL DEFAULT 0
+		);
+
+		CREATE TABLE page_related (
+			page_id INTEGER NOT NULL REFERENCES pages(id) ON DELETE CASCADE,
+			related_page_id INTEGER NOT NULL REFERENCES pages(id) ON DELETE CASCADE,
+			position INTEGER NOT NULL DEFAULT 0,
+			PRIMARY KEY (page_id, related_page_id)
+		);
+
+		CREATE TABLE comments (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			page_id INTEGER NOT NULL REFERENCES pages(id) ON DELETE CASCADE,
+			parent_id INTEGER REFERENCES comments(id) ON DELETE CASCADE,
+			user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+			author_name TEXT NOT NULL,
+			author_email TEXT NOT NULL DEFAULT '',
+			body TEXT NOT NULL,
+			status TEXT NOT NULL DEFAULT 'pending',
+			ip_address TEXT NOT NULL DEFAULT '',
+			user_agent TEXT NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE TABLE page_comment_settings (
+			page_id INTEGER PRIMARY KEY REFERENCES pages(id) ON DELETE CASCADE,
+			enabled BOOLEAN NOT NULL DEFAULT 1
+		);
+
+		CREATE TABLE page_access (
+			page_id INTEGER PRIMARY KEY REFERENCES pages(id) ON DELETE CASCADE,
+			access TEXT NOT NULL,
+			roles TEXT NOT NULL DEFAULT ''
+		);
+
+		CREATE TABLE snippets (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL,
+			slug TEXT NOT NULL,
+			body TEXT NOT NULL DEFAULT '',
+			language_code TEXT NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE(slug, language_code)
+		);
+
 		CREATE TABLE events (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
 			level TEXT NOT NULL DEFAULT 'info',
@@ -126,7 +233,8 @@ func testDB(t *testing.T) *sql.DB {
 			direction TEXT NOT NULL DEFAULT 'ltr',
 			position INTEGER NOT NULL DEFAULT 0,
 			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			host TEXT NOT NULL DEFAULT ''
 		);
 		CREATE INDEX idx_languages_code ON languages(code);
 		CREATE INDEX idx_languages_active ON languages(is_active);
@@ -158,6 +266,7 @@ func testDB(t *testing.T) *sql.DB {
 			language_code TEXT NOT NULL DEFAULT 'en',
 			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			site_id INTEGER NOT NULL DEFAULT 1,
 			FOREIGN KEY (uploaded_by) REFERENCES users(id),
 			FOREIGN KEY (folder_id) REFERENCES media_folders(id) ON DELETE SET NULL
 		);
@@ -228,7 +337,12 @@ func testDB(t *testing.T) *sql.DB {
 			headers TEXT NOT NULL DEFAULT '{}',
 			created_by INTEGER NOT NULL REFERENCES users(id),
 			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			payload_format TEXT NOT NULL DEFAULT 'json',
+			payload_template TEXT NOT NULL DEFAULT '',
+			consecutive_failures INTEGER NOT NULL DEFAULT 0,
+			paused_at DATETIME,
+			pause_reason TEXT NOT NULL DEFAULT ''
 		);
 
 		CREATE TABLE webhook_deliveries (
@@ -247,6 +361,24 @@ func testDB(t *testing.T) *sql.DB {
 			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
 		);
 
+		CREATE TABLE inbound_webhooks (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL,
+			token TEXT NOT NULL UNIQUE,
+			secret TEXT NOT NULL,
+			action TEXT NOT NULL,
+			job_source TEXT NOT NULL DEFAULT '',
+			job_name TEXT NOT NULL DEFAULT '',
+			is_active BOOLEAN NOT NULL DEFAULT 1,
+			trigger_count INTEGER NOT NULL DEFAULT 0,
+			last_triggered_at DATETIME,
+			last_status TEXT NOT NULL DEFAULT '',
+			last_error TEXT NOT NULL DEFAULT '',
+			created_by INTEGER NOT NULL REFERENCES users(id),
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+
 		CREATE TABLE config (
 			key TEXT PRIMARY KEY,
 			value TEXT NOT NULL DEFAULT '',
@@ -308,10 +440,20 @@ func testDB(t *testing.T) *sql.DB {
 			language_id INTEGER NOT NULL,
 			translation_id INTEGER NOT NULL,
 			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			source_version_id INTEGER,
+			source_updated_at DATETIME,
 			FOREIGN KEY (language_id) REFERENCES languages(id) ON DELETE CASCADE
 		);
 		CREATE INDEX idx_translations_entity ON translations(entity_type, entity_id);
 
+		CREATE TABLE machine_translations (
+			entity_type TEXT NOT NULL,
+			entity_id INTEGER NOT NULL,
+			provider TEXT NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (entity_type, entity_id)
+		);
+
 		CREATE TABLE menus (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
 			name TEXT NOT NULL,
@@ -319,7 +461,8 @@ func testDB(t *testing.T) *sql.DB {
 			language_code TEXT NOT NULL DEFAULT 'en',
 			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			UNIQUE(slug, language_code)
+			site_id INTEGER NOT NULL DEFAULT 1,
+			UNIQUE(site_id, slug, language_code)
 		);
 
 		CREATE TABLE menu_items (
@@ -337,6 +480,13 @@ func testDB(t *testing.T) *sql.DB {
 			is_active INTEGER NOT NULL DEFAULT 1,
 			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			item_type TEXT NOT NULL DEFAULT '',
+			link_id INTEGER,
+			visibility TEXT NOT NULL DEFAULT '',
+			visible_roles TEXT NOT NULL DEFAULT '',
+			visible_languages TEXT NOT NULL DEFAULT '',
+			mega_menu INTEGER NOT NULL DEFAULT 0,
+			image_url TEXT NOT NULL DEFAULT '',
 			FOREIGN KEY (menu_id) REFERENCES menus(id) ON DELETE CASCADE,
 			FOREIGN KEY (parent_id) REFERENCES menu_items(id) ON DELETE SET NULL,
 			FOREIGN KEY (page_id) REFERENCES pages(id) ON DELETE SET NULL,
@@ -373,10 +523,65 @@ func testDB(t *testing.T) *sql.DB {
 			is_active INTEGER NOT NULL DEFAULT 1,
 			language_code TEXT NOT NULL DEFAULT 'en',
 			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			site_id INTEGER NOT NULL DEFAULT 1
 		);
 		CREATE INDEX idx_widgets_theme ON widgets(theme);
 		CREATE INDEX idx_widgets_area ON widgets(area);
+		CREATE TABLE widget_visibility (
+			widget_id INTEGER PRIMARY KEY REFERENCES widgets(id) ON DELETE CASCADE,
+			rules TEXT NOT NULL DEFAULT '{}'
+		);
+
+		CREATE TABLE redirects (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			source_path TEXT NOT NULL UNIQUE,
+			target_url TEXT NOT NULL,
+			status_code INTEGER NOT NULL DEFAULT 301,
+			is_wildcard BOOLEAN NOT NULL DEFAULT 0,
+			target_type TEXT NOT NULL DEFAULT '_self',
+			enabled BOOLEAN NOT NULL DEFAULT 1,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			is_regex BOOLEAN NOT NULL DEFAULT 0,
+			drop_query BOOLEAN NOT NULL DEFAULT 0,
+			hit_count INTEGER NOT NULL DEFAULT 0,
+			last_hit_at DATETIME
+		);
+
+		CREATE TABLE not_found_log (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			path TEXT NOT NULL UNIQUE,
+			referrer TEXT NOT NULL DEFAULT '',
+			hit_count INTEGER NOT NULL DEFAULT 1,
+			first_seen_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			last_seen_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE TABLE sites (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL,
+			host TEXT NOT NULL DEFAULT '',
+			theme TEXT NOT NULL DEFAULT '',
+			is_default BOOLEAN NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+		INSERT INTO sites (id, name, is_default) VALUES (1, 'Default', 1);
+
+		CREATE TABLE site_config (
+			site_id INTEGER NOT NULL REFERENCES sites(id) ON DELETE CASCADE,
+			key TEXT NOT NULL,
+			value TEXT NOT NULL DEFAULT '',
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (site_id, key)
+		);
+
+		CREATE TABLE user_sites (
+			user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			site_id INTEGER NOT NULL REFERENCES sites(id) ON DELETE CASCADE,
+			PRIMARY KEY (user_id, site_id)
+		);
 	`
 
 	if _, err := db.Exec(schema); err != nil {
@@ -127,6 +127,7 @@ func TestImportExportHandlerUsesConfiguredUploadDirForMediaExport(t *testing.T)
 		LanguageCode: "en",
 		CreatedAt:    now,
 		UpdatedAt:    now,
+		SiteID:       store.DefaultSiteID,
 	}); err != nil {
 		t.Fatalf("create media row: %v", err)
 	}
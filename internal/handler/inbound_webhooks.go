@@ -0,0 +1,409 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+
+	"github.com/olegiv/ocms-go/internal/cache"
+	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/scheduler"
+	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/store"
+	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+	webhookpkg "github.com/olegiv/ocms-go/internal/webhook"
+)
+
+// InboundWebhooksHandler handles inbound webhook management routes and the
+// public endpoint that receives signed inbound requests.
+type InboundWebhooksHandler struct {
+	queries        *store.Queries
+	renderer       *render.Renderer
+	sessionManager *scs.SessionManager
+	eventService   *service.EventService
+	searchService  *service.SearchService
+	cacheManager   *cache.Manager
+	registry       *scheduler.Registry
+	dispatcher     *webhookpkg.Dispatcher
+	replays        *inboundReplayCache
+}
+
+// NewInboundWebhooksHandler creates a new InboundWebhooksHandler.
+func NewInboundWebhooksHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionManager, cm *cache.Manager, registry *scheduler.Registry) *InboundWebhooksHandler {
+	return &InboundWebhooksHandler{
+		queries:        store.New(db),
+		renderer:       renderer,
+		sessionManager: sm,
+		eventService:   service.NewEventService(db),
+		searchService:  service.NewSearchService(db),
+		cacheManager:   cm,
+		registry:       registry,
+		replays:        newInboundReplayCache(),
+	}
+}
+
+// SetDispatcher sets the webhook dispatcher used to announce draft pages
+// created by inbound webhooks.
+func (h *InboundWebhooksHandler) SetDispatcher(d *webhookpkg.Dispatcher) {
+	h.dispatcher = d
+}
+
+// InboundWebhookFormData holds data for the inbound webhook form template.
+type InboundWebhookFormData struct {
+	InboundWebhook *store.InboundWebhook
+	Jobs           []scheduler.JobInfo
+	Errors         map[string]string
+	FormValues     map[string]string
+	IsEdit         bool
+}
+
+// List handles GET /admin/webhooks/inbound - lists inbound webhooks.
+func (h *InboundWebhooksHandler) List(w http.ResponseWriter, r *http.Request) {
+	lang := middleware.GetAdminLang(r)
+
+	hooks, err := h.queries.ListInboundWebhooks(r.Context())
+	if err != nil {
+		logAndInternalError(w, "failed to list inbound webhooks", "error", err)
+		return
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "inbound_webhooks.title"), inboundWebhooksBreadcrumbs(lang))
+	renderTempl(w, r, adminviews.InboundWebhooksListPage(pc, convertInboundWebhooksListViewData(hooks, inboundWebhookBaseURL(r))))
+}
+
+// NewForm handles GET /admin/webhooks/inbound/new - displays the new inbound webhook form.
+func (h *InboundWebhooksHandler) NewForm(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionWebhooks, redirectAdminInboundWebhooks) {
+		return
+	}
+
+	h.renderForm(w, r, InboundWebhookFormData{
+		Jobs:       h.triggerableJobs(),
+		Errors:     make(map[string]string),
+		FormValues: map[string]string{"action": model.InboundActionRebuildSearch, "is_active": "1"},
+	})
+}
+
+// Create handles POST /admin/webhooks/inbound - creates an inbound webhook.
+func (h *InboundWebhooksHandler) Create(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionWebhooks, redirectAdminInboundWebhooks) {
+		return
+	}
+
+	if !parseFormOrRedirect(w, r, h.renderer, redirectAdminInboundWebhooksNew) {
+		return
+	}
+
+	input := parseInboundWebhookFormInput(r)
+	if input.Secret == "" {
+		secret, err := model.GenerateWebhookSecret()
+		if err != nil {
+			slog.Error("failed to generate inbound webhook secret", "error", err)
+			flashError(w, r, h.renderer, redirectAdminInboundWebhooksNew, "Error generating secret")
+			return
+		}
+		input.Secret = secret
+	}
+
+	jobs := h.triggerableJobs()
+	if validationErrors := validateInboundWebhookForm(input, jobs); len(validationErrors) > 0 {
+		h.renderForm(w, r, InboundWebhookFormData{
+			Jobs:       jobs,
+			Errors:     validationErrors,
+			FormValues: input.toFormValues(),
+		})
+		return
+	}
+
+	token, err := model.GenerateInboundWebhookToken()
+	if err != nil {
+		slog.Error("failed to generate inbound webhook token", "error", err)
+		flashError(w, r, h.renderer, redirectAdminInboundWebhooksNew, "Error generating token")
+		return
+	}
+
+	now := time.Now()
+	hook, err := h.queries.CreateInboundWebhook(r.Context(), store.CreateInboundWebhookParams{
+		Name:      input.Name,
+		Token:     token,
+		Secret:    input.Secret,
+		Action:    input.Action,
+		JobSource: input.JobSource,
+		JobName:   input.JobName,
+		IsActive:  input.IsActive,
+		CreatedBy: middleware.GetUserID(r),
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		slog.Error("failed to create inbound webhook", "error", err)
+		flashError(w, r, h.renderer, redirectAdminInboundWebhooksNew, "Error creating inbound webhook")
+		return
+	}
+
+	slog.Info("inbound webhook created", "inbound_webhook_id", hook.ID, "action", hook.Action, "created_by", middleware.GetUserID(r))
+	_ = h.eventService.LogWebhookEvent(r.Context(), model.EventLevelInfo, "Inbound webhook created",
+		middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+		map[string]any{"inbound_webhook_id": hook.ID, "name": hook.Name, "action": hook.Action})
+
+	flashSuccess(w, r, h.renderer, fmt.Sprintf(redirectAdminInboundWebhooksID, hook.ID), "Inbound webhook created. Copy the endpoint URL and secret into the sending system.")
+}
+
+// EditForm handles GET /admin/webhooks/inbound/{id} - displays the edit form.
+func (h *InboundWebhooksHandler) EditForm(w http.ResponseWriter, r *http.Request) {
+	id, err := ParseIDParam(r)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminInboundWebhooks, "Invalid inbound webhook ID")
+		return
+	}
+
+	hook, ok := h.requireInboundWebhookWithRedirect(w, r, id)
+	if !ok {
+		return
+	}
+
+	formValues := map[string]string{
+		"name":   hook.Name,
+		"secret": hook.Secret,
+		"action": hook.Action,
+		"job":    inboundJobKey(hook.JobSource, hook.JobName),
+	}
+	if hook.IsActive {
+		formValues["is_active"] = "1"
+	}
+
+	h.renderForm(w, r, InboundWebhookFormData{
+		InboundWebhook: &hook,
+		Jobs:           h.triggerableJobs(),
+		Errors:         make(map[string]string),
+		FormValues:     formValues,
+		IsEdit:         true,
+	})
+}
+
+// Update handles PUT /admin/webhooks/inbound/{id} - updates an inbound webhook.
+func (h *InboundWebhooksHandler) Update(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionWebhooks, redirectAdminInboundWebhooks) {
+		return
+	}
+
+	id, err := ParseIDParam(r)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminInboundWebhooks, "Invalid inbound webhook ID")
+		return
+	}
+
+	hook, ok := h.requireInboundWebhookWithRedirect(w, r, id)
+	if !ok {
+		return
+	}
+
+	editURL := fmt.Sprintf(redirectAdminInboundWebhooksID, id)
+	if !parseFormOrRedirect(w, r, h.renderer, editURL) {
+		return
+	}
+
+	input := parseInboundWebhookFormInput(r)
+	if input.Secret == "" {
+		input.Secret = hook.Secret
+	}
+
+	jobs := h.triggerableJobs()
+	if validationErrors := validateInboundWebhookForm(input, jobs); len(validationErrors) > 0 {
+		h.renderForm(w, r, InboundWebhookFormData{
+			InboundWebhook: &hook,
+			Jobs:           jobs,
+			Errors:         validationErrors,
+			FormValues:     input.toFormValues(),
+			IsEdit:         true,
+		})
+		return
+	}
+
+	if _, err := h.queries.UpdateInboundWebhook(r.Context(), store.UpdateInboundWebhookParams{
+		Name:      input.Name,
+		Secret:    input.Secret,
+		Action:    input.Action,
+		JobSource: input.JobSource,
+		JobName:   input.JobName,
+		IsActive:  input.IsActive,
+		UpdatedAt: time.Now(),
+		ID:        id,
+	}); err != nil {
+		slog.Error("failed to update inbound webhook", "error", err, "inbound_webhook_id", id)
+		flashError(w, r, h.renderer, editURL, "Error updating inbound webhook")
+		return
+	}
+
+	slog.Info("inbound webhook updated", "inbound_webhook_id", id, "updated_by", middleware.GetUserID(r))
+	_ = h.eventService.LogWebhookEvent(r.Context(), model.EventLevelInfo, "Inbound webhook updated",
+		middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+		map[string]any{
+			"inbound_webhook_id": id,
+			"name":               input.Name,
+			"action":             input.Action,
+			"secret_changed":     input.Secret != hook.Secret,
+		})
+
+	flashSuccess(w, r, h.renderer, redirectAdminInboundWebhooks, "Inbound webhook updated successfully")
+}
+
+// Delete handles DELETE /admin/webhooks/inbound/{id} - deletes an inbound webhook.
+func (h *InboundWebhooksHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if middleware.IsDemoMode() {
+		h.sendDeleteError(w, middleware.DemoModeMessageDetailed(middleware.RestrictionWebhooks))
+		return
+	}
+
+	handleDeleteEntity(w, r, h.renderer, deleteEntityParams[store.InboundWebhook]{
+		EntityName:     "inbound webhook",
+		IDField:        "inbound_webhook_id",
+		RedirectURL:    redirectAdminInboundWebhooks,
+		SuccessMessage: "Inbound webhook deleted successfully",
+		RequireFn: func(id int64) (store.InboundWebhook, bool) {
+			return requireEntityWithCustomError(w, "Inbound webhook", id,
+				func(id int64) (store.InboundWebhook, error) { return h.queries.GetInboundWebhookByID(r.Context(), id) },
+				h.sendDeleteError)
+		},
+		DeleteFn: h.queries.DeleteInboundWebhook,
+		GetSlug:  func(hook store.InboundWebhook) string { return hook.Name },
+		OnDeleted: func(hook store.InboundWebhook) {
+			_ = h.eventService.LogWebhookEvent(r.Context(), model.EventLevelInfo, "Inbound webhook deleted",
+				middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+				map[string]any{"inbound_webhook_id": hook.ID, "name": hook.Name, "action": hook.Action})
+		},
+	})
+}
+
+// inboundWebhookFormInput holds parsed form values for inbound webhook create/update.
+type inboundWebhookFormInput struct {
+	Name      string
+	Secret    string
+	Action    string
+	JobSource string
+	JobName   string
+	IsActive  bool
+}
+
+// parseInboundWebhookFormInput extracts inbound webhook form values. The job
+// select submits "source:name"; it is only kept for the run_job action.
+func parseInboundWebhookFormInput(r *http.Request) inboundWebhookFormInput {
+	input := inboundWebhookFormInput{
+		Name:     strings.TrimSpace(r.FormValue("name")),
+		Secret:   strings.TrimSpace(r.FormValue("secret")),
+		Action:   strings.TrimSpace(r.FormValue("action")),
+		IsActive: r.FormValue("is_active") == "on" || r.FormValue("is_active") == "true" || r.FormValue("is_active") == "1",
+	}
+	if input.Action == model.InboundActionRunJob {
+		input.JobSource, input.JobName, _ = strings.Cut(strings.TrimSpace(r.FormValue("job")), ":")
+	}
+	return input
+}
+
+// toFormValues converts inboundWebhookFormInput to a map for form re-rendering.
+func (input inboundWebhookFormInput) toFormValues() map[string]string {
+	formValues := map[string]string{
+		"name":   input.Name,
+		"secret": input.Secret,
+		"action": input.Action,
+		"job":    inboundJobKey(input.JobSource, input.JobName),
+	}
+	if input.IsActive {
+		formValues["is_active"] = "1"
+	}
+	return formValues
+}
+
+// validateInboundWebhookForm validates inbound webhook form input. jobs are the
+// scheduler jobs that may be triggered manually.
+func validateInboundWebhookForm(input inboundWebhookFormInput, jobs []scheduler.JobInfo) map[string]string {
+	validationErrors := make(map[string]string)
+
+	if input.Name == "" {
+		validationErrors["name"] = "Name is required"
+	} else if len(input.Name) > maxWebhookFormNameLen {
+		validationErrors["name"] = "Name must be less than 255 characters"
+	}
+
+	if len(input.Secret) < minInboundSecretLen {
+		validationErrors["secret"] = fmt.Sprintf("Secret must be at least %d characters", minInboundSecretLen)
+	}
+
+	if !model.IsValidInboundAction(input.Action) {
+		validationErrors["action"] = "Invalid action"
+	} else if input.Action == model.InboundActionRunJob {
+		found := false
+		for _, job := range jobs {
+			if job.Source == input.JobSource && job.Name == input.JobName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			validationErrors["job"] = "Select a scheduler job that can be triggered manually"
+		}
+	}
+
+	return validationErrors
+}
+
+// inboundJobKey returns the "source:name" select value for a scheduler job.
+func inboundJobKey(source, name string) string {
+	if source == "" && name == "" {
+		return ""
+	}
+	return source + ":" + name
+}
+
+// triggerableJobs returns the scheduler jobs that support manual triggering.
+func (h *InboundWebhooksHandler) triggerableJobs() []scheduler.JobInfo {
+	if h.registry == nil {
+		return nil
+	}
+	var jobs []scheduler.JobInfo
+	for _, job := range h.registry.List() {
+		if job.CanTrigger {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs
+}
+
+// renderForm renders the new or edit inbound webhook form.
+func (h *InboundWebhooksHandler) renderForm(w http.ResponseWriter, r *http.Request, data InboundWebhookFormData) {
+	lang := middleware.GetAdminLang(r)
+
+	title := i18n.T(lang, "inbound_webhooks.new")
+	breadcrumbs := inboundWebhookNewBreadcrumbs(lang)
+	if data.IsEdit && data.InboundWebhook != nil {
+		title = i18n.T(lang, "inbound_webhooks.edit")
+		breadcrumbs = inboundWebhookEditBreadcrumbs(lang, *data.InboundWebhook)
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, title, breadcrumbs)
+	renderTempl(w, r, adminviews.InboundWebhookFormPage(pc, convertInboundWebhookFormViewData(data, inboundWebhookBaseURL(r))))
+}
+
+// requireInboundWebhookWithRedirect fetches an inbound webhook by ID and redirects with flash on error.
+func (h *InboundWebhooksHandler) requireInboundWebhookWithRedirect(w http.ResponseWriter, r *http.Request, id int64) (store.InboundWebhook, bool) {
+	return requireEntityWithRedirect(w, r, h.renderer, redirectAdminInboundWebhooks, "Inbound webhook", id,
+		func(id int64) (store.InboundWebhook, error) { return h.queries.GetInboundWebhookByID(r.Context(), id) })
+}
+
+// sendDeleteError sends an error response for delete operations.
+func (h *InboundWebhooksHandler) sendDeleteError(w http.ResponseWriter, message string) {
+	w.Header().Set("HX-Reswap", "none")
+	w.Header().Set("HX-Trigger", `{"showToast": "`+message+`", "toastType": "error"}`)
+	w.WriteHeader(http.StatusBadRequest)
+}
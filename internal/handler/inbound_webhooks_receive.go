@@ -0,0 +1,366 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/security"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/util"
+	webhookpkg "github.com/olegiv/ocms-go/internal/webhook"
+)
+
+const (
+	// minInboundSecretLen is the minimum length of an inbound webhook secret.
+	minInboundSecretLen = 16
+	// maxDraftTitleLen caps the title of draft pages created by inbound webhooks.
+	maxDraftTitleLen = 255
+	// maxDraftSummaryRunes caps the summary of draft pages created by inbound webhooks.
+	maxDraftSummaryRunes = 500
+	// maxDraftSlugAttempts bounds the numeric suffixes tried for a free slug.
+	maxDraftSlugAttempts = 100
+	// inboundTimestampTolerance is how far the X-Webhook-Timestamp of an
+	// inbound request may be from the server clock.
+	inboundTimestampTolerance = 5 * time.Minute
+)
+
+var (
+	// errInboundActionUnavailable is returned when the service behind an
+	// action is not wired in (e.g. no cache manager).
+	errInboundActionUnavailable = errors.New("action is not available")
+	// errInvalidDraftPayload wraps validation failures of create_draft payloads.
+	errInvalidDraftPayload = errors.New("invalid draft payload")
+	// errInboundSignature is returned for requests whose signature, timestamp
+	// or freshness does not check out.
+	errInboundSignature = errors.New("invalid signature")
+)
+
+// inboundReplayCache remembers the signatures of accepted inbound requests
+// for as long as their timestamp is accepted, so a captured request can't be
+// sent again.
+type inboundReplayCache struct {
+	mu   sync.Mutex
+	seen map[string]time.Time // Hook ID and signature -> expiry
+}
+
+func newInboundReplayCache() *inboundReplayCache {
+	return &inboundReplayCache{seen: make(map[string]time.Time)}
+}
+
+// add records key and reports whether it was new.
+func (c *inboundReplayCache) add(key string, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, expiry := range c.seen {
+		if now.After(expiry) {
+			delete(c.seen, k)
+		}
+	}
+	if _, ok := c.seen[key]; ok {
+		return false
+	}
+	// A timestamp is accepted up to the tolerance on either side of now.
+	c.seen[key] = now.Add(2 * inboundTimestampTolerance)
+	return true
+}
+
+// inboundDraftPayload is the JSON body accepted by the create_draft action.
+type inboundDraftPayload struct {
+	Title        string `json:"title"`
+	Slug         string `json:"slug"`
+	Body         string `json:"body"`
+	Summary      string `json:"summary"`
+	LanguageCode string `json:"language_code"`
+}
+
+// Receive handles POST /webhooks/in/{token} - verifies the HMAC-SHA256
+// signature of the X-Webhook-Timestamp header and the request body against
+// the inbound webhook's secret and runs its configured action. Requests with
+// a timestamp outside inboundTimestampTolerance and repeats of an accepted
+// request are rejected. Unknown and inactive tokens both return 404 so the
+// endpoint does not reveal which tokens exist.
+func (h *InboundWebhooksHandler) Receive(w http.ResponseWriter, r *http.Request) {
+	hook, err := h.queries.GetInboundWebhookByToken(r.Context(), chi.URLParam(r, "token"))
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("failed to load inbound webhook", "error", err)
+			writeJSONError(w, http.StatusInternalServerError, "Internal server error")
+			return
+		}
+		writeJSONError(w, http.StatusNotFound, "Not found")
+		return
+	}
+	if !hook.IsActive {
+		writeJSONError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxJSONBodyBytes))
+	if err != nil {
+		writeJSONError(w, http.StatusRequestEntityTooLarge, "Request body too large")
+		return
+	}
+
+	if err := h.verifyInboundRequest(hook, r, body, time.Now()); err != nil {
+		slog.Warn("inbound webhook signature rejected", "inbound_webhook_id", hook.ID, "reason", err, "ip", middleware.GetClientIP(r))
+		_ = h.eventService.LogWebhookEvent(r.Context(), model.EventLevelWarning, "Inbound webhook signature rejected",
+			nil, middleware.GetClientIP(r), middleware.GetRequestURL(r),
+			map[string]any{"inbound_webhook_id": hook.ID, "name": hook.Name, "action": hook.Action, "reason": err.Error()})
+		writeJSONError(w, http.StatusUnauthorized, "Invalid signature")
+		return
+	}
+
+	result, runErr := h.runAction(r.Context(), hook, body)
+
+	status, lastError, level := model.InboundStatusSuccess, "", model.EventLevelInfo
+	if runErr != nil {
+		status, lastError, level = model.InboundStatusFailed, runErr.Error(), model.EventLevelError
+	}
+	if err := h.queries.RecordInboundWebhookTrigger(r.Context(), store.RecordInboundWebhookTriggerParams{
+		LastTriggeredAt: sql.NullTime{Time: time.Now(), Valid: true},
+		LastStatus:      status,
+		LastError:       lastError,
+		ID:              hook.ID,
+	}); err != nil {
+		slog.Error("failed to record inbound webhook trigger", "error", err, "inbound_webhook_id", hook.ID)
+	}
+
+	metadata := map[string]any{
+		"inbound_webhook_id": hook.ID,
+		"name":               hook.Name,
+		"action":             hook.Action,
+		"status":             status,
+	}
+	for k, v := range result {
+		metadata[k] = v
+	}
+	if runErr != nil {
+		metadata["error"] = lastError
+	}
+	_ = h.eventService.LogWebhookEvent(r.Context(), level, "Inbound webhook triggered",
+		nil, middleware.GetClientIP(r), middleware.GetRequestURL(r), metadata)
+
+	if runErr != nil {
+		slog.Error("inbound webhook action failed", "error", runErr, "inbound_webhook_id", hook.ID, "action", hook.Action)
+		switch {
+		case errors.Is(runErr, errInvalidDraftPayload):
+			writeJSONError(w, http.StatusUnprocessableEntity, runErr.Error())
+		case errors.Is(runErr, errInboundActionUnavailable):
+			writeJSONError(w, http.StatusServiceUnavailable, runErr.Error())
+		default:
+			writeJSONError(w, http.StatusInternalServerError, "Action failed")
+		}
+		return
+	}
+
+	slog.Info("inbound webhook triggered", "inbound_webhook_id", hook.ID, "action", hook.Action)
+	if result == nil {
+		result = make(map[string]any)
+	}
+	result["action"] = hook.Action
+	writeJSONSuccess(w, result)
+}
+
+// verifyInboundRequest checks that the request is signed with the hook's
+// secret over "timestamp.body", that the timestamp is recent and that the
+// request was not accepted before.
+func (h *InboundWebhooksHandler) verifyInboundRequest(hook store.InboundWebhook, r *http.Request, body []byte, now time.Time) error {
+	signature := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(r.Header.Get("X-Webhook-Signature")), "sha256="))
+	if signature == "" {
+		return fmt.Errorf("%w: missing signature", errInboundSignature)
+	}
+	timestamp := strings.TrimSpace(r.Header.Get("X-Webhook-Timestamp"))
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: missing or malformed timestamp", errInboundSignature)
+	}
+	if skew := now.Sub(time.Unix(unix, 0)); skew > inboundTimestampTolerance || skew < -inboundTimestampTolerance {
+		return fmt.Errorf("%w: timestamp outside the accepted window", errInboundSignature)
+	}
+
+	signed := make([]byte, 0, len(timestamp)+1+len(body))
+	signed = append(append(append(signed, timestamp...), '.'), body...)
+	if !webhookpkg.VerifySignature(signed, signature, hook.Secret) {
+		return fmt.Errorf("%w: signature mismatch", errInboundSignature)
+	}
+	if !h.replays.add(fmt.Sprintf("%d:%s", hook.ID, signature), now) {
+		return fmt.Errorf("%w: request already received", errInboundSignature)
+	}
+	return nil
+}
+
+// runAction executes the inbound webhook's configured action and returns
+// details to include in the response and audit event.
+func (h *InboundWebhooksHandler) runAction(ctx context.Context, hook store.InboundWebhook, body []byte) (map[string]any, error) {
+	switch hook.Action {
+	case model.InboundActionRebuildSearch:
+		if h.searchService == nil {
+			return nil, errInboundActionUnavailable
+		}
+		return nil, h.searchService.RebuildIndex(ctx)
+
+	case model.InboundActionClearCache:
+		if h.cacheManager == nil {
+			return nil, errInboundActionUnavailable
+		}
+		h.cacheManager.ClearAll()
+		return nil, nil
+
+	case model.InboundActionRunJob:
+		if h.registry == nil {
+			return nil, errInboundActionUnavailable
+		}
+		if err := h.registry.TriggerNow(hook.JobSource, hook.JobName); err != nil {
+			return nil, err
+		}
+		return map[string]any{"job": inboundJobKey(hook.JobSource, hook.JobName)}, nil
+
+	case model.InboundActionCreateDraft:
+		page, err := h.createDraftPage(ctx, hook, body)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"page_id": page.ID, "slug": page.Slug}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown inbound action %q", hook.Action)
+	}
+}
+
+// createDraftPage creates a draft page from a JSON payload, authored by the
+// user who created the inbound webhook. The slug is derived from the title
+// when omitted and suffixed until it is free; the body is always sanitized
+// since it comes from an external system.
+func (h *InboundWebhooksHandler) createDraftPage(ctx context.Context, hook store.InboundWebhook, body []byte) (store.Page, error) {
+	var payload inboundDraftPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return store.Page{}, fmt.Errorf("%w: body must be a JSON object", errInvalidDraftPayload)
+	}
+
+	title := strings.TrimSpace(payload.Title)
+	if errMsg := validatePageTitle(title); errMsg != "" {
+		return store.Page{}, fmt.Errorf("%w: %s", errInvalidDraftPayload, errMsg)
+	}
+	if len(title) > maxDraftTitleLen {
+		return store.Page{}, fmt.Errorf("%w: title must be less than %d characters", errInvalidDraftPayload, maxDraftTitleLen)
+	}
+
+	summary := strings.TrimSpace(payload.Summary)
+	if utf8.RuneCountInString(summary) > maxDraftSummaryRunes {
+		return store.Page{}, fmt.Errorf("%w: summary must be %d characters or less", errInvalidDraftPayload, maxDraftSummaryRunes)
+	}
+
+	baseSlug := strings.TrimSpace(payload.Slug)
+	if baseSlug == "" {
+		baseSlug = util.Slugify(title)
+	}
+	if errMsg := ValidateSlugFormat(baseSlug); errMsg != "" {
+		return store.Page{}, fmt.Errorf("%w: %s", errInvalidDraftPayload, errMsg)
+	}
+
+	langCode, err := h.resolveDraftLanguage(ctx, strings.TrimSpace(payload.LanguageCode))
+	if err != nil {
+		return store.Page{}, err
+	}
+
+	slug, err := h.freeDraftSlug(ctx, baseSlug)
+	if err != nil {
+		return store.Page{}, err
+	}
+
+	now := time.Now()
+	page, err := h.queries.CreatePage(ctx, store.CreatePageParams{
+		Title:        title,
+		Slug:         slug,
+		Body:         security.SanitizePageHTML(payload.Body),
+		Summary:      summary,
+		Status:       model.PageStatusDraft,
+		PageType:     "post",
+		AuthorID:     hook.CreatedBy,
+		LanguageCode: langCode,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		SiteID:       store.SiteIDFromContext(ctx),
+	})
+	if err != nil {
+		return store.Page{}, fmt.Errorf("creating page: %w", err)
+	}
+
+	var authorEmail string
+	if author, err := h.queries.GetUserByID(ctx, hook.CreatedBy); err == nil {
+		authorEmail = author.Email
+	}
+	dispatchWebhookEvent(ctx, h.dispatcher, model.EventPageCreated, webhookpkg.NewPageEventData(page, authorEmail), "page_id", page.ID)
+
+	return page, nil
+}
+
+// resolveDraftLanguage returns code when it names an existing language, or
+// the default language code when code is empty.
+func (h *InboundWebhooksHandler) resolveDraftLanguage(ctx context.Context, code string) (string, error) {
+	if code == "" {
+		lang, err := h.queries.GetDefaultLanguage(ctx)
+		if err != nil {
+			return "", fmt.Errorf("loading default language: %w", err)
+		}
+		return lang.Code, nil
+	}
+
+	lang, err := h.queries.GetLanguageByCode(ctx, code)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("%w: unknown language %q", errInvalidDraftPayload, code)
+	}
+	if err != nil {
+		return "", fmt.Errorf("loading language: %w", err)
+	}
+	return lang.Code, nil
+}
+
+// freeDraftSlug returns baseSlug, or baseSlug with the first numeric suffix
+// that is not used by a page, a page alias or a language prefix.
+func (h *InboundWebhooksHandler) freeDraftSlug(ctx context.Context, baseSlug string) (string, error) {
+	slug := baseSlug
+	for i := 2; i <= maxDraftSlugAttempts; i++ {
+		conflict, err := LanguagePrefixConflict(ctx, h.queries, slug)
+		if err != nil {
+			return "", fmt.Errorf("checking language prefixes: %w", err)
+		}
+		if conflict == "" {
+			exists, err := h.queries.SlugOrAliasExists(ctx, store.SlugOrAliasExistsParams{
+				Slug: slug, SiteID: store.SiteIDFromContext(ctx), Alias: slug,
+			})
+			if err != nil {
+				return "", fmt.Errorf("checking slug: %w", err)
+			}
+			if exists == 0 {
+				return slug, nil
+			}
+		}
+		slug = fmt.Sprintf("%s-%d", baseSlug, i)
+	}
+	return "", fmt.Errorf("%w: no free slug for %q", errInvalidDraftPayload, baseSlug)
+}
+
+// inboundWebhookBaseURL returns the absolute URL prefix of inbound webhook
+// endpoints for the current request's origin.
+func inboundWebhookBaseURL(r *http.Request) string {
+	return requestPageOrigin(r) + strings.TrimSuffix(RouteInboundWebhookReceive, "{token}")
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/scheduler"
+	"github.com/olegiv/ocms-go/internal/store"
+	webhookpkg "github.com/olegiv/ocms-go/internal/webhook"
+)
+
+const testInboundSecret = "inbound-secret-0123456789"
+
+// newTestInboundWebhook creates an inbound webhook for action owned by userID.
+func newTestInboundWebhook(t *testing.T, h *InboundWebhooksHandler, userID int64, token, action string, active bool) store.InboundWebhook {
+	t.Helper()
+
+	now := time.Now()
+	hook, err := h.queries.CreateInboundWebhook(context.Background(), store.CreateInboundWebhookParams{
+		Name:      "Hook " + token,
+		Token:     token,
+		Secret:    testInboundSecret,
+		Action:    action,
+		IsActive:  active,
+		CreatedBy: userID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateInboundWebhook: %v", err)
+	}
+	return hook
+}
+
+// signInbound signs body the way inbound webhook senders do, with the
+// timestamp and body joined by a dot.
+func signInbound(timestamp, body string) string {
+	return webhookpkg.GenerateSignature([]byte(timestamp+"."+body), testInboundSecret)
+}
+
+// nowTimestamp returns the current Unix time as an X-Webhook-Timestamp value.
+func nowTimestamp() string {
+	return strconv.FormatInt(time.Now().Unix(), 10)
+}
+
+// postInbound sends body to the inbound endpoint for token with the given
+// timestamp and signature.
+func postInbound(h *InboundWebhooksHandler, token, body, timestamp, signature string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/in/"+token, strings.NewReader(body))
+	if signature != "" {
+		req.Header.Set("X-Webhook-Signature", signature)
+	}
+	if timestamp != "" {
+		req.Header.Set("X-Webhook-Timestamp", timestamp)
+	}
+	req = requestWithURLParams(req, map[string]string{"token": token})
+	w := httptest.NewRecorder()
+	h.Receive(w, req)
+	return w
+}
+
+func TestInboundWebhookReceive_CreateDraft(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	user := createTestAdminUser(t, db)
+	h := NewInboundWebhooksHandler(db, nil, sm, nil, nil)
+	hook := newTestInboundWebhook(t, h, user.ID, "draft-token", model.InboundActionCreateDraft, true)
+
+	body := `{"title": "Release Notes", "body": "<p>Hi</p><script>alert(1)</script>"}`
+	ts := nowTimestamp()
+	w := postInbound(h, hook.Token, body, ts, "sha256="+signInbound(ts, body))
+	assertStatus(t, w.Code, http.StatusOK)
+
+	page, err := h.queries.GetPageBySlug(context.Background(), store.GetPageBySlugParams{Slug: "release-notes", SiteID: store.DefaultSiteID})
+	if err != nil {
+		t.Fatalf("draft page not created: %v", err)
+	}
+	if page.Status != model.PageStatusDraft || page.AuthorID != user.ID || page.LanguageCode != "en" {
+		t.Errorf("page = status %q author %d lang %q, want draft/%d/en", page.Status, page.AuthorID, page.LanguageCode, user.ID)
+	}
+	if strings.Contains(page.Body, "<script>") {
+		t.Errorf("body was not sanitized: %q", page.Body)
+	}
+
+	// A second draft with the same title gets a suffixed slug.
+	ts = strconv.FormatInt(time.Now().Unix()-1, 10)
+	w = postInbound(h, hook.Token, body, ts, signInbound(ts, body))
+	assertStatus(t, w.Code, http.StatusOK)
+	if _, err := h.queries.GetPageBySlug(context.Background(), store.GetPageBySlugParams{Slug: "release-notes-2", SiteID: store.DefaultSiteID}); err != nil {
+		t.Errorf("second draft slug: %v", err)
+	}
+
+	got, _ := h.queries.GetInboundWebhookByID(context.Background(), hook.ID)
+	if got.TriggerCount != 2 || got.LastStatus != model.InboundStatusSuccess || !got.LastTriggeredAt.Valid {
+		t.Errorf("trigger stats = count %d status %q, want 2/success", got.TriggerCount, got.LastStatus)
+	}
+}
+
+func TestInboundWebhookReceive_Rejections(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	user := createTestAdminUser(t, db)
+	h := NewInboundWebhooksHandler(db, nil, sm, nil, nil)
+	active := newTestInboundWebhook(t, h, user.ID, "active-token", model.InboundActionCreateDraft, true)
+	inactive := newTestInboundWebhook(t, h, user.ID, "inactive-token", model.InboundActionCreateDraft, false)
+	noCache := newTestInboundWebhook(t, h, user.ID, "cache-token", model.InboundActionClearCache, true)
+
+	ts := nowTimestamp()
+	stale := strconv.FormatInt(time.Now().Add(-inboundTimestampTolerance-time.Minute).Unix(), 10)
+	future := strconv.FormatInt(time.Now().Add(inboundTimestampTolerance+time.Minute).Unix(), 10)
+	sign := func(body string) string { return signInbound(ts, body) }
+
+	tests := []struct {
+		name      string
+		token     string
+		body      string
+		timestamp string
+		signature string
+		want      int
+	}{
+		{"unknown token", "missing", `{}`, ts, sign(`{}`), http.StatusNotFound},
+		{"inactive", inactive.Token, `{}`, ts, sign(`{}`), http.StatusNotFound},
+		{"missing signature", active.Token, `{"title":"x"}`, ts, "", http.StatusUnauthorized},
+		{"wrong signature", active.Token, `{"title":"Hello"}`, ts, sign(`{"title":"other"}`), http.StatusUnauthorized},
+		{"missing timestamp", active.Token, `{"title":"a"}`, "", sign(`{"title":"a"}`), http.StatusUnauthorized},
+		{"body-only signature", active.Token, `{"title":"b"}`, ts, webhookpkg.GenerateSignature([]byte(`{"title":"b"}`), testInboundSecret), http.StatusUnauthorized},
+		{"stale timestamp", active.Token, `{"title":"c"}`, stale, signInbound(stale, `{"title":"c"}`), http.StatusUnauthorized},
+		{"future timestamp", active.Token, `{"title":"d"}`, future, signInbound(future, `{"title":"d"}`), http.StatusUnauthorized},
+		{"invalid payload", active.Token, `{"title":""}`, ts, sign(`{"title":""}`), http.StatusUnprocessableEntity},
+		{"unknown language", active.Token, `{"title":"Hello","language_code":"xx"}`, ts, sign(`{"title":"Hello","language_code":"xx"}`), http.StatusUnprocessableEntity},
+		{"service unavailable", noCache.Token, `{}`, ts, sign(`{}`), http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := postInbound(h, tt.token, tt.body, tt.timestamp, tt.signature)
+			assertStatus(t, w.Code, tt.want)
+		})
+	}
+
+	got, _ := h.queries.GetInboundWebhookByID(context.Background(), active.ID)
+	if got.LastStatus != model.InboundStatusFailed || got.LastError == "" {
+		t.Errorf("failed trigger not recorded: status %q error %q", got.LastStatus, got.LastError)
+	}
+}
+
+func TestInboundWebhookReceive_Replay(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	user := createTestAdminUser(t, db)
+	h := NewInboundWebhooksHandler(db, nil, sm, nil, nil)
+	hook := newTestInboundWebhook(t, h, user.ID, "replay-token", model.InboundActionCreateDraft, true)
+
+	body := `{"title": "Once"}`
+	ts := nowTimestamp()
+	signature := signInbound(ts, body)
+	assertStatus(t, postInbound(h, hook.Token, body, ts, signature).Code, http.StatusOK)
+	assertStatus(t, postInbound(h, hook.Token, body, ts, "sha256="+strings.ToUpper(signature)).Code, http.StatusUnauthorized)
+
+	if _, err := h.queries.GetPageBySlug(context.Background(), store.GetPageBySlugParams{Slug: "once-2", SiteID: store.DefaultSiteID}); err == nil {
+		t.Error("replayed request created a second draft")
+	}
+}
+
+func TestValidateInboundWebhookForm(t *testing.T) {
+	jobs := []scheduler.JobInfo{{Source: "core", Name: "sitemap", CanTrigger: true}}
+
+	tests := []struct {
+		name    string
+		input   inboundWebhookFormInput
+		wantErr string
+	}{
+		{"valid", inboundWebhookFormInput{Name: "CI", Secret: testInboundSecret, Action: model.InboundActionClearCache}, ""},
+		{"missing name", inboundWebhookFormInput{Secret: testInboundSecret, Action: model.InboundActionClearCache}, "name"},
+		{"short secret", inboundWebhookFormInput{Name: "CI", Secret: "short", Action: model.InboundActionClearCache}, "secret"},
+		{"bad action", inboundWebhookFormInput{Name: "CI", Secret: testInboundSecret, Action: "reboot"}, "action"},
+		{"valid job", inboundWebhookFormInput{Name: "CI", Secret: testInboundSecret, Action: model.InboundActionRunJob, JobSource: "core", JobName: "sitemap"}, ""},
+		{"unknown job", inboundWebhookFormInput{Name: "CI", Secret: testInboundSecret, Action: model.InboundActionRunJob, JobSource: "core", JobName: "nope"}, "job"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateInboundWebhookForm(tt.input, jobs)
+			if tt.wantErr == "" {
+				if len(errs) > 0 {
+					t.Errorf("unexpected errors: %v", errs)
+				}
+				return
+			}
+			if _, ok := errs[tt.wantErr]; !ok {
+				t.Errorf("errors = %v, want key %q", errs, tt.wantErr)
+			}
+		})
+	}
+}
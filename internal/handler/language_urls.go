@@ -0,0 +1,173 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/util"
+)
+
+// languageURLs builds the public URLs of content per language. A language
+// mapped to a host lives at the root of that host; the others live under the
+// site URL, with a /{code} prefix unless they are the default language.
+type languageURLs struct {
+	siteURL      string            // configured site URL without trailing slash
+	hosts        map[string]string // language code -> mapped host
+	hostLanguage string            // language served by the request host
+}
+
+// newLanguageURLs builds the URLs of the given active languages as seen
+// from a request served by hostLanguage's host, if any.
+func newLanguageURLs(siteURL string, languages []store.Language, hostLanguage string) languageURLs {
+	return languageURLs{
+		siteURL:      strings.TrimRight(siteURL, "/"),
+		hosts:        languageHosts(languages),
+		hostLanguage: hostLanguage,
+	}
+}
+
+// languageURLs loads the host mappings of the active languages. Without
+// them every language is linked under the site URL.
+func (h *FrontendHandler) languageURLs(ctx context.Context, siteURL string) languageURLs {
+	languages, _ := h.queries.ListActiveLanguages(ctx)
+	return newLanguageURLs(siteURL, siteLanguages(ctx, languages), middleware.HostLanguageFromContext(ctx))
+}
+
+// siteLanguageHost returns the host of a language as served by the site ctx
+// is scoped to. Language hosts belong to the default site; every other site
+// serves all languages under a path prefix of its own host.
+func siteLanguageHost(ctx context.Context, host string) string {
+	if store.SiteIDFromContext(ctx) != store.DefaultSiteID {
+		return ""
+	}
+	return host
+}
+
+// siteLanguages applies siteLanguageHost to languages.
+func siteLanguages(ctx context.Context, languages []store.Language) []store.Language {
+	if store.SiteIDFromContext(ctx) == store.DefaultSiteID {
+		return languages
+	}
+	result := make([]store.Language, len(languages))
+	for i, lang := range languages {
+		lang.Host = ""
+		result[i] = lang
+	}
+	return result
+}
+
+// prefix returns the path prefix of a language on its own host.
+func (u languageURLs) prefix(code string, isDefault bool) string {
+	if isDefault || u.hosts[code] != "" {
+		return ""
+	}
+	return "/" + code
+}
+
+// origin returns the scheme and host a language is served from, or "" when
+// it lives under a site URL that is not configured.
+func (u languageURLs) origin(code string) string {
+	if host := u.hosts[code]; host != "" {
+		return util.HostURL(u.siteURL, host)
+	}
+	return u.siteURL
+}
+
+// sameHost reports whether a language is served from the request host.
+func (u languageURLs) sameHost(code string) bool {
+	if u.hostLanguage != "" {
+		return u.hostLanguage == code
+	}
+	return u.hosts[code] == ""
+}
+
+// absolute returns the absolute URL of path in a language, or "" when the
+// language has no known origin.
+func (u languageURLs) absolute(code string, isDefault bool, path string) string {
+	origin := u.origin(code)
+	if origin == "" {
+		return ""
+	}
+	return origin + languagePrefixedURL(u.prefix(code, isDefault), path)
+}
+
+// base returns what paths in a language are joined to from the current
+// request: its path prefix on the same host, its origin and prefix across
+// hosts.
+func (u languageURLs) base(code string, isDefault bool) string {
+	if !u.sameHost(code) {
+		if origin := u.origin(code); origin != "" {
+			return origin + u.prefix(code, isDefault)
+		}
+	}
+	return u.prefix(code, isDefault)
+}
+
+// link returns the URL that links to path in a language from the current
+// request: relative on the same host, absolute across hosts.
+func (u languageURLs) link(code string, isDefault bool, path string) string {
+	return languagePrefixedURL(u.base(code, isDefault), path)
+}
+
+// absoluteHome returns the absolute canonical homepage URL of a language.
+func (u languageURLs) absoluteHome(code string, isDefault bool) string {
+	origin := u.origin(code)
+	if origin == "" {
+		return ""
+	}
+	return origin + frontendHomePath(u.prefix(code, isDefault))
+}
+
+// homeLink returns the URL that links to the homepage of a language from the
+// current request.
+func (u languageURLs) homeLink(code string, isDefault bool) string {
+	if !u.sameHost(code) {
+		if url := u.absoluteHome(code, isDefault); url != "" {
+			return url
+		}
+	}
+	return frontendHomePath(u.prefix(code, isDefault))
+}
+
+// switchLink returns the link of the language picker that switches to a
+// language. Languages on the site host switch through ?lang so the
+// preference cookie is updated; a language on its own host is its root.
+func (u languageURLs) switchLink(code string) string {
+	if host := u.hosts[code]; host != "" {
+		if u.hostLanguage == code {
+			return "/"
+		}
+		return util.HostURL(u.siteURL, host) + "/"
+	}
+	if u.hostLanguage != "" && u.siteURL != "" {
+		return u.siteURL + "/?lang=" + code
+	}
+	return "/?lang=" + code
+}
+
+// languageOrigin returns the origin of canonical URLs for the request
+// language: the root of its host when it has one, siteURL otherwise.
+func languageOrigin(r *http.Request, siteURL string) string {
+	if lang := middleware.GetLanguage(r); lang != nil && lang.Host != "" {
+		return util.HostURL(siteURL, lang.Host)
+	}
+	return strings.TrimRight(siteURL, "/")
+}
+
+// languageHosts maps the code of each language mapped to a host to that
+// host.
+func languageHosts(languages []store.Language) map[string]string {
+	hosts := make(map[string]string)
+	for _, lang := range languages {
+		if lang.Host != "" {
+			hosts[lang.Code] = lang.Host
+		}
+	}
+	return hosts
+}
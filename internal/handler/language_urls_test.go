@@ -0,0 +1,81 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+func TestLanguageURLs(t *testing.T) {
+	languages := []store.Language{
+		{Code: "en", IsDefault: true},
+		{Code: "de", Host: "example.de"},
+		{Code: "ru"},
+	}
+
+	tests := []struct {
+		name         string
+		hostLanguage string
+		code         string
+		isDefault    bool
+		wantLink     string
+		wantAbsolute string
+		wantSwitch   string
+	}{
+		{
+			name:         "default language from the site host",
+			code:         "en",
+			isDefault:    true,
+			wantLink:     "/about",
+			wantAbsolute: "https://example.com/about",
+			wantSwitch:   "/?lang=en",
+		},
+		{
+			name:         "prefixed language from the site host",
+			code:         "ru",
+			wantLink:     "/ru/about",
+			wantAbsolute: "https://example.com/ru/about",
+			wantSwitch:   "/?lang=ru",
+		},
+		{
+			name:         "hosted language from the site host",
+			code:         "de",
+			wantLink:     "https://example.de/about",
+			wantAbsolute: "https://example.de/about",
+			wantSwitch:   "https://example.de/",
+		},
+		{
+			name:         "hosted language from its own host",
+			hostLanguage: "de",
+			code:         "de",
+			wantLink:     "/about",
+			wantAbsolute: "https://example.de/about",
+			wantSwitch:   "/",
+		},
+		{
+			name:         "prefixed language from a language host",
+			hostLanguage: "de",
+			code:         "ru",
+			wantLink:     "https://example.com/ru/about",
+			wantAbsolute: "https://example.com/ru/about",
+			wantSwitch:   "https://example.com/?lang=ru",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			urls := newLanguageURLs("https://example.com/", languages, tt.hostLanguage)
+			if got := urls.link(tt.code, tt.isDefault, "/about"); got != tt.wantLink {
+				t.Errorf("link = %q, want %q", got, tt.wantLink)
+			}
+			if got := urls.absolute(tt.code, tt.isDefault, "/about"); got != tt.wantAbsolute {
+				t.Errorf("absolute = %q, want %q", got, tt.wantAbsolute)
+			}
+			if got := urls.switchLink(tt.code); got != tt.wantSwitch {
+				t.Errorf("switchLink = %q, want %q", got, tt.wantSwitch)
+			}
+		})
+	}
+}
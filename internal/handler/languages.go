@@ -22,6 +22,7 @@ import (
 	"github.com/olegiv/ocms-go/internal/store"
 	"github.com/olegiv/ocms-go/internal/util"
 	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+	"github.com/olegiv/ocms-go/internal/webhook"
 
 	"github.com/alexedwards/scs/v2"
 )
@@ -33,6 +34,8 @@ type LanguagesHandler struct {
 	renderer       *render.Renderer
 	sessionManager *scs.SessionManager
 	cacheManager   *cache.Manager
+	urlHistory     *URLHistory
+	cookieDomain   string
 }
 
 const languageStateRefreshTimeout = 5 * time.Second
@@ -49,6 +52,7 @@ func NewLanguagesHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionM
 		queries:        store.New(db),
 		renderer:       renderer,
 		sessionManager: sm,
+		urlHistory:     NewURLHistory(db),
 	}
 	if len(cacheManagers) > 0 {
 		h.cacheManager = cacheManagers[0]
@@ -104,6 +108,7 @@ func (h *LanguagesHandler) updateLanguage(ctx context.Context, params store.Upda
 		`UPDATE widgets SET language_code = ? WHERE language_code = ?`,
 		`UPDATE media SET language_code = ? WHERE language_code = ?`,
 		`UPDATE config SET language_code = ? WHERE language_code = ?`,
+		`UPDATE page_series SET language_code = ? WHERE language_code = ?`,
 	} {
 		if _, err := tx.ExecContext(ctx, query, params.Code, previousCode); err != nil {
 			return fmt.Errorf("propagate language code %q to %q: %w",
@@ -137,6 +142,35 @@ func refreshI18nLanguageState(ctx context.Context, queries *store.Queries) error
 	return nil
 }
 
+// SetRedirectsMiddleware sets the redirects middleware whose cache is
+// invalidated when a language prefix change redirects old URLs.
+func (h *LanguagesHandler) SetRedirectsMiddleware(rm *middleware.RedirectsMiddleware) {
+	h.urlHistory.SetRedirectsMiddleware(rm)
+}
+
+// SetDispatcher sets the webhook dispatcher for the redirect events of
+// URLs moved by a language prefix change.
+func (h *LanguagesHandler) SetDispatcher(d *webhook.Dispatcher) {
+	h.urlHistory.SetDispatcher(d)
+}
+
+// SetCookieDomain sets OCMS_COOKIE_DOMAIN. Language domains must then lie
+// under it, so the session cookie reaches every language.
+func (h *LanguagesHandler) SetCookieDomain(domain string) {
+	h.cookieDomain = domain
+}
+
+// redirectLanguageURLs redirects the old URLs of the pages, tags and
+// categories of languages whose URL prefix changed. oldURLs is taken with
+// URLHistory.Capture before the change.
+func (h *LanguagesHandler) redirectLanguageURLs(ctx context.Context, oldURLs URLSnapshot, languageCodes ...string) {
+	moved := h.urlHistory.RedirectMoved(ctx, oldURLs, languageCodes...)
+	if len(moved) == 0 {
+		return
+	}
+	slog.Info("redirected URLs after language prefix change", "count", len(moved), "languages", languageCodes)
+}
+
 func (h *LanguagesHandler) invalidateLanguageCaches(ctx context.Context) {
 	refreshCtx, cancel := detachedLanguageStateContext(ctx)
 	defer cancel()
@@ -268,6 +302,7 @@ type languageFormInput struct {
 	Direction  string
 	IsActive   bool
 	Position   string
+	Host       string
 }
 
 // parseLanguageFormInput extracts language form field values from the request.
@@ -280,6 +315,7 @@ func parseLanguageFormInput(r *http.Request) languageFormInput {
 		Direction:  strings.TrimSpace(r.FormValue("direction")),
 		IsActive:   isActiveStr == "1" || isActiveStr == "on" || isActiveStr == "true",
 		Position:   strings.TrimSpace(r.FormValue("position")),
+		Host:       strings.TrimSpace(r.FormValue("host")),
 	}
 }
 
@@ -291,6 +327,7 @@ func (input languageFormInput) toFormValues() map[string]string {
 		"native_name": input.NativeName,
 		"direction":   input.Direction,
 		"position":    input.Position,
+		"host":        input.Host,
 	}
 	if input.IsActive {
 		fv["is_active"] = "1"
@@ -374,6 +411,47 @@ func (h *LanguagesHandler) addPagePrefixConflictError(
 	}
 }
 
+// validateLanguageHost normalizes the optional host of a language in place
+// and records a format, cookie domain or uniqueness error under the form's
+// host field. Two languages, or a language and a site, cannot share a host:
+// the request host alone selects the language. With a cookie domain
+// configured, the host must lie under it so the session cookie reaches it.
+func (h *LanguagesHandler) validateLanguageHost(
+	r *http.Request, validationErrors map[string]string, input *languageFormInput, excludeID int64,
+) {
+	host, err := util.NormalizeHost(input.Host)
+	if err != nil {
+		validationErrors["host"] = i18n.T(h.renderer.GetAdminLang(r), "languages.error_host_format")
+		return
+	}
+	input.Host = host
+	if host == "" {
+		return
+	}
+	if h.cookieDomain != "" && !util.HostInDomain(host, h.cookieDomain) {
+		validationErrors["host"] = i18n.T(h.renderer.GetAdminLang(r), "languages.error_host_cookie_domain", h.cookieDomain)
+		return
+	}
+	taken, err := h.queries.LanguageHostExistsExcluding(r.Context(), store.LanguageHostExistsExcludingParams{
+		Host: host,
+		ID:   excludeID,
+	})
+	if err != nil {
+		slog.Error("database error checking language host", "error", err, "host", host)
+		return
+	}
+	if taken == 0 {
+		taken, err = h.queries.SiteHostExistsExcluding(r.Context(), store.SiteHostExistsExcludingParams{Host: host})
+		if err != nil {
+			slog.Error("database error checking site host", "error", err, "host", host)
+			return
+		}
+	}
+	if taken != 0 {
+		validationErrors["host"] = i18n.T(h.renderer.GetAdminLang(r), "languages.error_host_taken")
+	}
+}
+
 // pageRouteQueryer reads whether a page already answers at the first path
 // segment a language code would take over.
 type pageRouteQueryer interface {
@@ -565,6 +643,8 @@ func (h *LanguagesHandler) Create(w http.ResponseWriter, r *http.Request) {
 		validationErrors["direction"] = "Direction must be ltr or rtl"
 	}
 
+	h.validateLanguageHost(r, validationErrors, &input, 0)
+
 	if len(validationErrors) > 0 {
 		h.renderLanguageForm(w, r, nil, validationErrors, input.toFormValues(), false)
 		return
@@ -581,6 +661,7 @@ func (h *LanguagesHandler) Create(w http.ResponseWriter, r *http.Request) {
 		Position:   position,
 		CreatedAt:  now,
 		UpdatedAt:  now,
+		Host:       input.Host,
 	})
 	if errors.Is(err, errLanguagePrefixTaken) {
 		// A page claimed the prefix between validation and this write.
@@ -617,6 +698,7 @@ func languageToFormValues(lang *store.Language) map[string]string {
 		"native_name": lang.NativeName,
 		"direction":   lang.Direction,
 		"position":    strconv.FormatInt(lang.Position, 10),
+		"host":        lang.Host,
 	}
 	if lang.IsActive {
 		fv["is_active"] = "1"
@@ -694,6 +776,8 @@ func (h *LanguagesHandler) Update(w http.ResponseWriter, r *http.Request) {
 		validationErrors["direction"] = "Direction must be ltr or rtl"
 	}
 
+	h.validateLanguageHost(r, validationErrors, &input, existingLang.ID)
+
 	// Cannot deactivate default language
 	if existingLang.IsDefault && !input.IsActive {
 		validationErrors["is_active"] = "Cannot deactivate the default language"
@@ -704,6 +788,13 @@ func (h *LanguagesHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// A renamed language moves its pages, tags and categories to a new prefix
+	renamed := input.Code != existingLang.Code
+	var oldURLs URLSnapshot
+	if renamed {
+		oldURLs = h.urlHistory.Capture(r.Context(), true, existingLang.Code)
+	}
+
 	now := time.Now()
 	err := h.updateLanguage(r.Context(), store.UpdateLanguageParams{
 		ID:         existingLang.ID,
@@ -715,6 +806,7 @@ func (h *LanguagesHandler) Update(w http.ResponseWriter, r *http.Request) {
 		Direction:  direction,
 		Position:   position,
 		UpdatedAt:  now,
+		Host:       input.Host,
 	}, existingLang.Code)
 	if errors.Is(err, errLanguagePrefixTaken) {
 		// A page claimed the prefix between validation and this write.
@@ -729,6 +821,9 @@ func (h *LanguagesHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	h.invalidateLanguageCaches(r.Context())
+	if renamed {
+		h.redirectLanguageURLs(r.Context(), oldURLs, input.Code)
+	}
 	slog.Info("language updated", "language_id", existingLang.ID, "code", input.Code)
 	flashSuccess(w, r, h.renderer, redirectAdminLanguages, "Language updated successfully")
 }
@@ -858,11 +953,19 @@ func (h *LanguagesHandler) SetDefault(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The previous default language gains a prefix and the new one loses it
+	codes := []string{lang.Code}
+	if previous, err := h.queries.GetDefaultLanguage(r.Context()); err == nil && previous.Code != lang.Code {
+		codes = append(codes, previous.Code)
+	}
+	oldURLs := h.urlHistory.Capture(r.Context(), true, codes...)
+
 	if err := h.setDefaultLanguage(r.Context(), lang.ID); err != nil {
 		slog.Error("failed to set default language", "error", err)
 		flashError(w, r, h.renderer, redirectAdminLanguages, "Error setting default language")
 		return
 	}
+	h.redirectLanguageURLs(r.Context(), oldURLs, codes...)
 
 	slog.Info("default language set", "language_id", lang.ID, "code", lang.Code)
 	flashSuccess(w, r, h.renderer, redirectAdminLanguages, fmt.Sprintf("%s set as default language", lang.Name))
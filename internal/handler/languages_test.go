@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"strconv"
 	"testing"
 	"time"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/olegiv/ocms-go/internal/cache"
 	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/render"
 	"github.com/olegiv/ocms-go/internal/store"
 	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
 )
@@ -156,6 +158,40 @@ func TestContentLanguageChoicesIgnoreLegacyUnsafeRows(t *testing.T) {
 	}
 }
 
+func TestValidateLanguageHostCookieDomain(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	renderer, err := render.New(render.Config{
+		TemplatesFS: os.DirFS("../../web/templates"), SessionManager: sm, DB: db, IsDev: true,
+	})
+	if err != nil {
+		t.Fatalf("create renderer: %v", err)
+	}
+	h := NewLanguagesHandler(db, renderer, sm)
+	h.SetCookieDomain("example.com")
+
+	tests := []struct {
+		host    string
+		wantErr bool
+	}{
+		{"", false},
+		{"de.example.com", false},
+		{"example.com:8443", false},
+		{"example.de", true},
+		{"notexample.com", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			req := requestWithSession(sm, httptest.NewRequest(http.MethodPost, "/admin/languages", nil))
+			validationErrors := map[string]string{}
+			input := languageFormInput{Host: tt.host}
+			h.validateLanguageHost(req, validationErrors, &input, 0)
+			if _, got := validationErrors["host"]; got != tt.wantErr {
+				t.Errorf("host %q: error = %q, want error %v", tt.host, validationErrors["host"], tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestLanguageCreate(t *testing.T) {
 	db, _ := testHandlerSetup(t)
 
@@ -815,6 +851,7 @@ func TestLanguagePrefixCannotShadowExistingPageRoute(t *testing.T) {
 
 	page, err := queries.CreatePage(ctx, store.CreatePageParams{
 		Title: "Engineering", Slug: "eng", Body: "Content", Status: "published", AuthorID: user.ID,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage failed: %v", err)
@@ -860,6 +897,7 @@ func TestSetDefaultLanguageRejectsPageShadowingPrefix(t *testing.T) {
 
 	if _, err := queries.CreatePage(ctx, store.CreatePageParams{
 		Title: "Engineering", Slug: "eng", Body: "Content", Status: "published", AuthorID: user.ID,
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatalf("CreatePage failed: %v", err)
 	}
@@ -903,6 +941,7 @@ func TestLanguageWritesRefuseTakenPrefixInsideTheirTransaction(t *testing.T) {
 
 	if _, err := queries.CreatePage(ctx, store.CreatePageParams{
 		Title: "Engineering", Slug: "eng", Body: "Content", Status: "published", AuthorID: user.ID,
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatalf("CreatePage: %v", err)
 	}
@@ -0,0 +1,151 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/alexedwards/scs/v2"
+
+	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/linkcheck"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/store"
+	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+)
+
+// BrokenLinksPerPage is the number of broken links per admin page.
+const BrokenLinksPerPage = 25
+
+// LinkChecksHandler shows the results of the broken link checker.
+type LinkChecksHandler struct {
+	queries        *store.Queries
+	renderer       *render.Renderer
+	sessionManager *scs.SessionManager
+	checker        *linkcheck.Checker
+}
+
+// NewLinkChecksHandler creates a new LinkChecksHandler.
+func NewLinkChecksHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionManager, checker *linkcheck.Checker) *LinkChecksHandler {
+	return &LinkChecksHandler{
+		queries:        store.New(db),
+		renderer:       renderer,
+		sessionManager: sm,
+		checker:        checker,
+	}
+}
+
+func brokenLinksBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "nav.broken_links"), URL: redirectAdminBrokenLinks, Active: true},
+	}
+}
+
+// List handles GET /admin/broken-links - shows the broken links found by the
+// last check with the content they are in.
+func (h *LinkChecksHandler) List(w http.ResponseWriter, r *http.Request) {
+	lang := middleware.GetAdminLang(r)
+	ctx := r.Context()
+	page := ParsePageParam(r)
+
+	var counts [3]int64
+	for i, status := range []string{linkcheck.StatusBroken, linkcheck.StatusOK, linkcheck.StatusSkipped} {
+		count, err := h.queries.CountLinkChecksByStatus(ctx, status)
+		if err != nil {
+			logAndInternalError(w, "failed to count link checks", "error", err, "status", status)
+			return
+		}
+		counts[i] = count
+	}
+	brokenCount := counts[0]
+
+	page, _ = NormalizePagination(page, int(brokenCount), BrokenLinksPerPage)
+	checks, err := h.queries.ListBrokenLinkChecks(ctx, store.ListBrokenLinkChecksParams{
+		Limit:  BrokenLinksPerPage,
+		Offset: int64((page - 1) * BrokenLinksPerPage),
+	})
+	if err != nil {
+		logAndInternalError(w, "failed to list broken links", "error", err)
+		return
+	}
+
+	items := make([]adminviews.BrokenLinkItem, len(checks))
+	for i, check := range checks {
+		items[i] = adminviews.BrokenLinkItem{
+			URL:         check.Url,
+			AnchorText:  check.AnchorText,
+			IsExternal:  check.IsExternal,
+			StatusCode:  check.StatusCode,
+			Error:       check.Error,
+			SourceType:  check.SourceType,
+			SourceTitle: check.SourceTitle,
+			SourceURL:   h.sourceURL(ctx, check),
+			CheckedAt:   check.CheckedAt,
+		}
+		if check.BrokenSince.Valid {
+			items[i].BrokenSince = check.BrokenSince.Time
+		}
+	}
+
+	data := adminviews.BrokenLinksData{
+		Links:        items,
+		BrokenCount:  brokenCount,
+		OKCount:      counts[1],
+		SkippedCount: counts[2],
+		Running:      h.checker != nil && h.checker.Running(),
+		Pagination:   convertPagination(BuildAdminPagination(page, int(brokenCount), BrokenLinksPerPage, redirectAdminBrokenLinks, r.URL.Query())),
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "broken_links.title"), brokenLinksBreadcrumbs(lang))
+	renderTempl(w, r, adminviews.BrokenLinksPage(pc, data))
+}
+
+// Check handles POST /admin/broken-links/check - starts a check in the
+// background.
+func (h *LinkChecksHandler) Check(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionScheduler, redirectAdminBrokenLinks) {
+		return
+	}
+	if h.checker == nil {
+		flashError(w, r, h.renderer, redirectAdminBrokenLinks, "Link checker is not available")
+		return
+	}
+
+	if err := h.checker.Start(); err != nil {
+		if errors.Is(err, linkcheck.ErrRunning) {
+			flashError(w, r, h.renderer, redirectAdminBrokenLinks, "A link check is already running")
+			return
+		}
+		slog.Error("failed to start link check", "error", err)
+		flashError(w, r, h.renderer, redirectAdminBrokenLinks, "Error starting link check")
+		return
+	}
+
+	slog.Info("link check started", "started_by", middleware.GetUserID(r))
+	flashSuccess(w, r, h.renderer, redirectAdminBrokenLinks, "Link check started. Reload this page in a few minutes to see the results.")
+}
+
+// sourceURL returns the admin URL to edit the content a link was found in.
+func (h *LinkChecksHandler) sourceURL(ctx context.Context, check store.LinkCheck) string {
+	switch check.SourceType {
+	case linkcheck.SourcePage:
+		return fmt.Sprintf(redirectAdminPagesID, check.SourceID)
+	case linkcheck.SourceMenuItem:
+		item, err := h.queries.GetMenuItemByID(ctx, check.SourceID)
+		if err != nil {
+			return redirectAdminMenus
+		}
+		return fmt.Sprintf(redirectAdminMenusID, item.MenuID)
+	case linkcheck.SourceWidget:
+		return redirectAdmin + RouteWidgets
+	}
+	return ""
+}
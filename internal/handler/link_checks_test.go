@@ -0,0 +1,52 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/linkcheck"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+func TestLinkChecksHandler_SourceURL(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	queries := store.New(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	menu, err := queries.CreateMenu(ctx, store.CreateMenuParams{Name: "Footer", Slug: "footer", LanguageCode: "en", CreatedAt: now, UpdatedAt: now, SiteID: store.DefaultSiteID})
+	if err != nil {
+		t.Fatalf("CreateMenu failed: %v", err)
+	}
+	item, err := queries.CreateMenuItem(ctx, store.CreateMenuItemParams{
+		MenuID: menu.ID, Title: "Docs", Url: sql.NullString{String: "/docs", Valid: true},
+		Target: sql.NullString{String: "_self", Valid: true}, CssClass: sql.NullString{Valid: true}, IsActive: true, CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateMenuItem failed: %v", err)
+	}
+
+	h := NewLinkChecksHandler(db, nil, sm, nil)
+	tests := []struct {
+		sourceType string
+		sourceID   int64
+		want       string
+	}{
+		{linkcheck.SourcePage, 7, "/admin/pages/7"},
+		{linkcheck.SourceMenuItem, item.ID, fmt.Sprintf("/admin/menus/%d", menu.ID)},
+		{linkcheck.SourceMenuItem, item.ID + 100, "/admin/menus"},
+		{linkcheck.SourceWidget, 3, "/admin/widgets"},
+	}
+	for _, tt := range tests {
+		check := store.LinkCheck{SourceType: tt.sourceType, SourceID: tt.sourceID}
+		if got := h.sourceURL(ctx, check); got != tt.want {
+			t.Errorf("sourceURL(%s %d) = %q, want %q", tt.sourceType, tt.sourceID, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/machinetranslation"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// machineTranslationTimeout bounds machine translation within a Translate
+// request, so a slow provider delays the editor by seconds, not minutes.
+const machineTranslationTimeout = 20 * time.Second
+
+// machineTranslate pre-fills the texts of a new translation. It returns nil
+// when machine translation is disabled, fails or takes longer than
+// machineTranslationTimeout; failures are logged and the translation is
+// created from the untranslated source.
+func machineTranslate(ctx context.Context, mt *machinetranslation.Translator, source, target string, segments ...machinetranslation.Segment) []string {
+	if !mt.Enabled() {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, machineTranslationTimeout)
+	defer cancel()
+	texts, err := mt.Translate(ctx, source, target, segments)
+	if err != nil {
+		slog.Error("machine translation failed", "error", err, "provider", mt.Name(), "source", source, "target", target)
+		return nil
+	}
+	return texts
+}
+
+// markMachineTranslated flags a new translation as machine translated and
+// awaiting review.
+func markMachineTranslated(ctx context.Context, queries *store.Queries, mt *machinetranslation.Translator, entityType string, id int64) {
+	if err := queries.UpsertMachineTranslation(ctx, store.UpsertMachineTranslationParams{
+		EntityType: entityType,
+		EntityID:   id,
+		Provider:   mt.Name(),
+		CreatedAt:  time.Now(),
+	}); err != nil {
+		slog.Error("failed to mark machine translation", "error", err, "entity_type", entityType, "entity_id", id)
+	}
+}
+
+// machineTranslationFlash returns the success message of a Translate action.
+func machineTranslationFlash(translated bool, mt *machinetranslation.Translator, created, untranslated string) string {
+	switch {
+	case translated:
+		return created + " It was machine translated with " + mt.Name() + ", please review it."
+	case mt.Enabled():
+		return created + " Machine translation failed. " + untranslated
+	}
+	return created + " " + untranslated
+}
+
+// loadMachineTranslation returns the review flag of an entity, or nil when
+// it is not awaiting review.
+func loadMachineTranslation(ctx context.Context, queries *store.Queries, entityType string, id int64) *store.MachineTranslation {
+	mt, err := queries.GetMachineTranslation(ctx, store.GetMachineTranslationParams{EntityType: entityType, EntityID: id})
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("failed to get machine translation", "error", err, "entity_type", entityType, "entity_id", id)
+		}
+		return nil
+	}
+	return &mt
+}
+
+// confirmMachineTranslation removes the review flag of an entity when the
+// editor ticked the reviewed checkbox.
+func confirmMachineTranslation(ctx context.Context, queries *store.Queries, entityType string, id int64, reviewed string) {
+	if reviewed == "" {
+		return
+	}
+	if err := queries.DeleteMachineTranslation(ctx, store.DeleteMachineTranslationParams{EntityType: entityType, EntityID: id}); err != nil {
+		slog.Error("failed to confirm machine translation", "error", err, "entity_type", entityType, "entity_id", id)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/machinetranslation"
+)
+
+// deadlineProvider records the deadline of the context it translates with
+// and fails as if the provider timed out.
+type deadlineProvider struct {
+	deadline time.Time
+}
+
+func (p *deadlineProvider) Name() string { return "Deadline" }
+
+func (p *deadlineProvider) Translate(ctx context.Context, _, _ string, _ []string) ([]string, error) {
+	p.deadline, _ = ctx.Deadline()
+	return nil, context.DeadlineExceeded
+}
+
+func TestMachineTranslate_BoundsProviderTime(t *testing.T) {
+	provider := &deadlineProvider{}
+	start := time.Now()
+	texts := machineTranslate(context.Background(), machinetranslation.New(provider), "en", "de", machinetranslation.Text("Hello"))
+	if texts != nil {
+		t.Errorf("machineTranslate() = %v, want nil when the provider times out", texts)
+	}
+	if provider.deadline.IsZero() {
+		t.Fatal("provider was called without a deadline")
+	}
+	if limit := start.Add(machineTranslationTimeout); provider.deadline.After(limit.Add(time.Second)) {
+		t.Errorf("deadline = %v, want at most %v", provider.deadline, limit)
+	}
+}
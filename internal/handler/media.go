@@ -77,25 +77,7 @@ func (h *MediaHandler) SetDispatcher(d *webhook.Dispatcher) {
 
 // dispatchMediaEvent dispatches a media-related webhook event.
 func (h *MediaHandler) dispatchMediaEvent(ctx context.Context, eventType string, media store.Medium) {
-	if h.dispatcher == nil {
-		return
-	}
-
-	data := webhook.MediaEventData{
-		ID:         media.ID,
-		UUID:       media.Uuid,
-		Filename:   media.Filename,
-		MimeType:   media.MimeType,
-		Size:       media.Size,
-		UploaderID: media.UploadedBy,
-	}
-
-	if err := h.dispatcher.DispatchEvent(ctx, eventType, data); err != nil {
-		slog.Error("failed to dispatch webhook event",
-			"error", err,
-			"event_type", eventType,
-			"media_id", media.ID)
-	}
+	dispatchWebhookEvent(ctx, h.dispatcher, eventType, webhook.NewMediaEventData(media), "media_id", media.ID)
 }
 
 // MediaItem represents a media item with additional computed fields.
@@ -145,7 +127,8 @@ func (h *MediaHandler) Library(w http.ResponseWriter, r *http.Request) {
 	search := strings.TrimSpace(r.URL.Query().Get("q"))
 
 	// Get total count
-	totalCount, err := h.queries.CountMedia(r.Context())
+	siteID := store.SiteIDFromContext(r.Context())
+	totalCount, err := h.queries.CountMediaBySite(r.Context(), siteID)
 	if err != nil {
 		logAndInternalError(w, "failed to count media", "error", err)
 		return
@@ -159,6 +142,7 @@ func (h *MediaHandler) Library(w http.ResponseWriter, r *http.Request) {
 	// Fetch media
 	var mediaList []store.Medium
 	listParams := store.ListMediaSortedParams{
+		SiteID:    sql.NullInt64{Int64: siteID, Valid: true},
 		Limit:     int64(perPage),
 		Offset:    offset,
 		SortField: sortField,
@@ -1132,13 +1116,19 @@ func (h *MediaHandler) API(w http.ResponseWriter, r *http.Request) {
 // requireMediaWithRedirect fetches media by ID and handles errors with flash messages and redirect.
 func (h *MediaHandler) requireMediaWithRedirect(w http.ResponseWriter, r *http.Request, id int64) (store.Medium, bool) {
 	return requireEntityWithRedirect(w, r, h.renderer, redirectAdminMedia, "Media", id,
-		func(id int64) (store.Medium, error) { return h.queries.GetMediaByID(r.Context(), id) })
+		func(id int64) (store.Medium, error) {
+			media, err := h.queries.GetMediaByID(r.Context(), id)
+			return media, siteScopedErr(r.Context(), media.SiteID, err)
+		})
 }
 
 // requireMediaWithError fetches media by ID and handles errors with http.Error.
 func (h *MediaHandler) requireMediaWithError(w http.ResponseWriter, r *http.Request, id int64) (store.Medium, bool) {
 	return requireEntityWithError(w, "Media", id,
-		func(id int64) (store.Medium, error) { return h.queries.GetMediaByID(r.Context(), id) })
+		func(id int64) (store.Medium, error) {
+			media, err := h.queries.GetMediaByID(r.Context(), id)
+			return media, siteScopedErr(r.Context(), media.SiteID, err)
+		})
 }
 
 // requireFolderWithError fetches folder by ID and handles errors with http.Error.
@@ -155,6 +155,7 @@ func TestMediaOperations(t *testing.T) {
 			Width:      sql.NullInt64{Int64: 800, Valid: true},
 			Height:     sql.NullInt64{Int64: 600, Valid: true},
 			UploadedBy: user.ID,
+			SiteID:     store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreateMedia failed: %v", err)
@@ -226,6 +227,7 @@ func TestMediaWithFolder(t *testing.T) {
 		Size:       2048,
 		FolderID:   sql.NullInt64{Int64: folder.ID, Valid: true},
 		UploadedBy: user.ID,
+		SiteID:     store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMedia failed: %v", err)
@@ -250,6 +252,7 @@ func TestMediaUpdate(t *testing.T) {
 		MimeType:   "image/jpeg",
 		Size:       1024,
 		UploadedBy: user.ID,
+		SiteID:     store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMedia failed: %v", err)
@@ -289,6 +292,7 @@ func TestMediaDelete(t *testing.T) {
 		MimeType:   "image/jpeg",
 		Size:       1024,
 		UploadedBy: user.ID,
+		SiteID:     store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMedia failed: %v", err)
@@ -0,0 +1,469 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/alexedwards/scs/v2"
+
+	"github.com/olegiv/ocms-go/internal/auth"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/module"
+	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/webhook"
+)
+
+const (
+	// maxMemberNameLen caps member names, in characters.
+	maxMemberNameLen = 100
+	// maxMemberEmailLen caps member email addresses, in bytes (RFC 5321).
+	maxMemberEmailLen = 254
+	// maxMemberFormBytes caps the member form payloads.
+	maxMemberFormBytes = 16 * 1024
+	// memberHoneypotSlug identifies the registration form in honeypot events.
+	memberHoneypotSlug = "members"
+	// memberNotifyTimeout bounds sending an email to a member.
+	memberNotifyTimeout = time.Minute
+)
+
+// MembersHandler handles self-service registration, login and profiles of
+// users with the public role on the frontend.
+type MembersHandler struct {
+	queries        *store.Queries
+	sessionManager *scs.SessionManager
+	auth           *AuthHandler
+	frontend       *FrontendHandler
+	hookRegistry   *module.HookRegistry
+	dispatcher     *webhook.Dispatcher
+	eventService   *service.EventService
+	notifier       MemberNotifier
+}
+
+// MemberNotifier emails members about their account.
+type MemberNotifier interface {
+	NotifyRegistrationAttempt(ctx context.Context, user store.User, loginPath string) error
+}
+
+// NewMembersHandler creates a new MembersHandler. Logins go through the
+// credential checks of the auth handler; the frontend handler renders the
+// member pages.
+func NewMembersHandler(db *sql.DB, sm *scs.SessionManager, hr *module.HookRegistry, ah *AuthHandler, fh *FrontendHandler) *MembersHandler {
+	return &MembersHandler{
+		queries:        store.New(db),
+		sessionManager: sm,
+		auth:           ah,
+		frontend:       fh,
+		hookRegistry:   hr,
+		eventService:   service.NewEventService(db),
+	}
+}
+
+// SetDispatcher sets the webhook dispatcher for event dispatching.
+func (h *MembersHandler) SetDispatcher(d *webhook.Dispatcher) {
+	h.dispatcher = d
+}
+
+// SetNotifier sets the notifier that emails the owner of an address someone
+// tried to register again.
+func (h *MembersHandler) SetNotifier(n MemberNotifier) {
+	h.notifier = n
+}
+
+// notifyRegistrationAttempt emails the owner of an existing account about a
+// registration with their address in the background, so the response does
+// not wait for the mail server.
+func (h *MembersHandler) notifyRegistrationAttempt(user store.User, loginPath string) {
+	if h.notifier == nil {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), memberNotifyTimeout)
+		defer cancel()
+		if err := h.notifier.NotifyRegistrationAttempt(ctx, user, loginPath); err != nil {
+			slog.Error("failed to send registration notice", "error", err, "user_id", user.ID)
+		}
+	}()
+}
+
+// dispatchUserCreated dispatches the user.created webhook event of a new
+// member.
+func (h *MembersHandler) dispatchUserCreated(ctx context.Context, user store.User) {
+	if h.dispatcher == nil {
+		return
+	}
+	data := webhook.UserEventData{
+		ID:    user.ID,
+		Email: user.Email,
+		Name:  user.Name,
+		Role:  user.Role,
+	}
+	if err := h.dispatcher.DispatchEvent(ctx, model.EventUserCreated, data); err != nil {
+		slog.Error("failed to dispatch webhook event",
+			"error", err,
+			"event_type", model.EventUserCreated,
+			"user_id", user.ID)
+	}
+}
+
+// t translates a frontend message to the language of a member page.
+func (h *MembersHandler) t(data MemberPageData, key string, args ...any) string {
+	return h.frontend.themeManager.Translate(data.LangCode, key, args...)
+}
+
+// afterLogin returns where to send a member after logging in: the next form
+// or query value when it is a local path, or the profile page.
+func afterLogin(r *http.Request, langPrefix string) string {
+	if next := safeMemberNext(r.FormValue("next")); next != "" {
+		return next
+	}
+	return languagePrefixedURL(langPrefix, RouteMembersProfile)
+}
+
+// parseMemberForm limits and parses a member form. It answers the request
+// and returns false when the form cannot be parsed.
+func parseMemberForm(w http.ResponseWriter, r *http.Request) bool {
+	r.Body = http.MaxBytesReader(w, r.Body, maxMemberFormBytes)
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// LoginForm handles GET /members/login - the member login form. Logged-in
+// visitors are sent on.
+func (h *MembersHandler) LoginForm(w http.ResponseWriter, r *http.Request) {
+	data := h.frontend.memberPageData(r, memberFormLogin, "frontend.member_login")
+	if middleware.GetUser(r) != nil {
+		http.Redirect(w, r, afterLogin(r, data.LangPrefix), http.StatusSeeOther)
+		return
+	}
+	data.Action = data.LoginURL
+	data.Next = safeMemberNext(r.URL.Query().Get("next"))
+	data.Captcha = h.frontend.loginCaptchaWidget(r.Context())
+	h.frontend.renderMemberPage(w, r, http.StatusOK, data)
+}
+
+// Login handles POST /members/login. It shares the captcha, lockout and
+// failed attempt tracking of the admin login.
+func (h *MembersHandler) Login(w http.ResponseWriter, r *http.Request) {
+	if !parseMemberForm(w, r) {
+		return
+	}
+	data := h.frontend.memberPageData(r, memberFormLogin, "frontend.member_login")
+	email := strings.TrimSpace(r.FormValue("email"))
+	password := r.FormValue("password")
+
+	user, errorMsg := h.auth.authenticate(r, data.LangCode, email, password)
+	if errorMsg != "" {
+		data.Action = data.LoginURL
+		data.Next = safeMemberNext(r.FormValue("next"))
+		data.Values["email"] = email
+		data.Error = errorMsg
+		data.Captcha = h.frontend.loginCaptchaWidget(r.Context())
+		h.frontend.renderMemberPage(w, r, http.StatusUnprocessableEntity, data)
+		return
+	}
+	if err := h.auth.startSession(r, user, password); err != nil {
+		logAndInternalError(w, "session renewal error", "error", err)
+		return
+	}
+	http.Redirect(w, r, afterLogin(r, data.LangPrefix), http.StatusSeeOther)
+}
+
+// RegisterForm handles GET /members/register - the registration form. It is
+// not found while registration is closed.
+func (h *MembersHandler) RegisterForm(w http.ResponseWriter, r *http.Request) {
+	if !h.frontend.memberRegistrationOpen(r.Context()) {
+		h.frontend.renderNotFound(w, r)
+		return
+	}
+	data := h.frontend.memberPageData(r, memberFormRegister, "frontend.member_register")
+	if middleware.GetUser(r) != nil {
+		http.Redirect(w, r, afterLogin(r, data.LangPrefix), http.StatusSeeOther)
+		return
+	}
+	data.Action = data.RegisterURL
+	data.Next = safeMemberNext(r.URL.Query().Get("next"))
+	data.Captcha = h.frontend.captchaWidget(r.Context())
+	h.frontend.renderMemberPage(w, r, http.StatusOK, data)
+}
+
+// Register handles POST /members/register - creates a user with the public
+// role and logs them in.
+func (h *MembersHandler) Register(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if !h.frontend.memberRegistrationOpen(ctx) {
+		h.frontend.renderNotFound(w, r)
+		return
+	}
+	if !parseMemberForm(w, r) {
+		return
+	}
+	data := h.frontend.memberPageData(r, memberFormRegister, "frontend.member_register")
+	clientIP := middleware.GetClientIP(r)
+
+	// Bots filling the honeypot are sent to the login form without an account
+	if r.FormValue("_website") != "" {
+		slog.Info("honeypot triggered", "form_slug", memberHoneypotSlug, "ip", clientIP)
+		_ = h.eventService.LogSecurityEvent(ctx, model.EventLevelWarning, "Registration honeypot triggered",
+			nil, clientIP, middleware.GetRequestURL(r), nil)
+		// Fire hook for modules (e.g., Sentinel auto-ban)
+		if h.hookRegistry != nil {
+			_ = h.hookRegistry.CallNoResult(ctx, module.HookSecurityHoneypotTriggered, map[string]any{
+				"ip":          clientIP,
+				"form_slug":   memberHoneypotSlug,
+				"request_url": middleware.GetRequestURL(r),
+			})
+		}
+		http.Redirect(w, r, data.LoginURL, http.StatusSeeOther)
+		return
+	}
+
+	name := strings.TrimSpace(r.FormValue("name"))
+	email := strings.TrimSpace(r.FormValue("email"))
+	password := r.FormValue("password")
+	data.Action = data.RegisterURL
+	data.Next = safeMemberNext(r.FormValue("next"))
+	data.Values["name"] = name
+	data.Values["email"] = email
+
+	if !verifyFormCaptcha(ctx, h.hookRegistry, r) {
+		data.Error = h.t(data, "frontend.member_captcha_failed")
+	}
+	// A taken address gets the same answer as a free one until the form is
+	// otherwise valid, and then a neutral message; its owner is told by email.
+	var existing *store.User
+	h.validateMemberName(data, name)
+	switch addr, err := mail.ParseAddress(email); {
+	case email == "":
+		data.Errors["email"] = h.t(data, "frontend.member_email_required")
+	case err != nil || addr.Address != email || len(email) > maxMemberEmailLen:
+		data.Errors["email"] = h.t(data, "frontend.member_email_invalid")
+	default:
+		if user, err := h.queries.GetUserByEmail(ctx, email); err == nil {
+			existing = &user
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("database error checking email", "error", err)
+			h.frontend.renderInternalError(w)
+			return
+		}
+	}
+	h.validateNewPassword(data, "password", password, r.FormValue("password_confirm"))
+	if existing != nil && data.Error == "" && len(data.Errors) == 0 {
+		h.notifyRegistrationAttempt(*existing, data.LoginURL)
+		data.Error = h.t(data, "frontend.member_register_failed")
+	}
+
+	if data.Error != "" || len(data.Errors) > 0 {
+		data.Captcha = h.frontend.captchaWidget(ctx)
+		h.frontend.renderMemberPage(w, r, http.StatusUnprocessableEntity, data)
+		return
+	}
+
+	passwordHash, err := auth.HashPassword(password)
+	if err != nil {
+		logAndInternalError(w, "failed to hash password", "error", err)
+		return
+	}
+	now := time.Now()
+	user, err := h.queries.CreateUser(ctx, store.CreateUserParams{
+		Email:        email,
+		PasswordHash: passwordHash,
+		Role:         model.RolePublic,
+		Name:         name,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+	if err != nil {
+		logAndInternalError(w, "failed to create member", "error", err)
+		return
+	}
+
+	slog.Info("member registered", "user_id", user.ID, "email", user.Email)
+	_ = h.eventService.LogUserEvent(ctx, model.EventLevelInfo, "Member registered", &user.ID, clientIP,
+		middleware.GetRequestURL(r), map[string]any{"user_id": user.ID, "email": user.Email, "role": user.Role})
+	h.dispatchUserCreated(ctx, user)
+
+	if err := h.auth.startSession(r, user, password); err != nil {
+		logAndInternalError(w, "session renewal error", "error", err)
+		return
+	}
+	http.Redirect(w, r, afterLogin(r, data.LangPrefix), http.StatusSeeOther)
+}
+
+// Profile handles GET /members/profile - the profile form of the logged-in
+// visitor.
+func (h *MembersHandler) Profile(w http.ResponseWriter, r *http.Request) {
+	user := middleware.GetUser(r)
+	data := h.frontend.memberPageData(r, memberFormProfile, "frontend.member_profile")
+	if user == nil {
+		http.Redirect(w, r, memberLoginURL(data.LangPrefix, r.URL.RequestURI()), http.StatusSeeOther)
+		return
+	}
+	data.Action = languagePrefixedURL(data.LangPrefix, RouteMembersProfile)
+	data.Values = memberProfileValues(*user)
+	if r.URL.Query().Get("saved") != "" {
+		data.Message = h.t(data, "frontend.member_profile_saved")
+	}
+	h.frontend.renderMemberPage(w, r, http.StatusOK, data)
+}
+
+// UpdateProfile handles POST /members/profile - saves the name, bio and
+// website of the logged-in visitor and optionally changes their password.
+func (h *MembersHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user := middleware.GetUser(r)
+	data := h.frontend.memberPageData(r, memberFormProfile, "frontend.member_profile")
+	if user == nil {
+		http.Redirect(w, r, data.LoginURL, http.StatusSeeOther)
+		return
+	}
+	if !parseMemberForm(w, r) {
+		return
+	}
+	profileURL := languagePrefixedURL(data.LangPrefix, RouteMembersProfile)
+	data.Action = profileURL
+
+	name := strings.TrimSpace(r.FormValue("name"))
+	bio := strings.TrimSpace(r.FormValue("bio"))
+	websiteURL := strings.TrimSpace(r.FormValue("website_url"))
+	data.Values = memberProfileValues(*user)
+	data.Values["name"] = name
+	data.Values["bio"] = bio
+	data.Values["website_url"] = websiteURL
+
+	h.validateMemberName(data, name)
+	if utf8.RuneCountInString(bio) > MaxBioLength {
+		data.Errors["bio"] = h.t(data, "frontend.member_bio_too_long", MaxBioLength)
+	}
+	if len(websiteURL) > MaxProfileURLLength || validateProfileURL(websiteURL) != "" {
+		data.Errors["website_url"] = h.t(data, "frontend.member_website_invalid")
+	}
+	newPassword := r.FormValue("new_password")
+	if newPassword != "" {
+		if valid, err := auth.CheckPassword(r.FormValue("current_password"), user.PasswordHash); err != nil || !valid {
+			data.Errors["current_password"] = h.t(data, "frontend.member_current_password_invalid")
+		}
+		h.validateNewPassword(data, "new_password", newPassword, r.FormValue("new_password_confirm"))
+	}
+	if len(data.Errors) > 0 {
+		h.frontend.renderMemberPage(w, r, http.StatusUnprocessableEntity, data)
+		return
+	}
+
+	now := time.Now()
+	if _, err := h.queries.UpdateUser(ctx, store.UpdateUserParams{
+		Email:       user.Email,
+		Role:        user.Role,
+		Name:        name,
+		Avatar:      user.Avatar,
+		Bio:         bio,
+		WebsiteUrl:  websiteURL,
+		LinkedinUrl: user.LinkedinUrl,
+		GithubUrl:   user.GithubUrl,
+		TelegramUrl: user.TelegramUrl,
+		UpdatedAt:   now,
+		ID:          user.ID,
+	}); err != nil {
+		logAndInternalError(w, "failed to update member profile", "error", err, "user_id", user.ID)
+		return
+	}
+
+	if newPassword != "" {
+		if err := h.changePassword(r, user.ID, newPassword, now); err != nil {
+			logAndInternalError(w, "failed to change member password", "error", err, "user_id", user.ID)
+			return
+		}
+	}
+
+	_ = h.eventService.LogUserEvent(ctx, model.EventLevelInfo, "Member profile updated", &user.ID,
+		middleware.GetClientIP(r), middleware.GetRequestURL(r),
+		map[string]any{"user_id": user.ID, "password_changed": newPassword != ""})
+	http.Redirect(w, r, profileURL+"?saved=1", http.StatusSeeOther)
+}
+
+// changePassword sets a new password, which ends the other sessions of the
+// user, and keeps the current session valid in a renewed token.
+func (h *MembersHandler) changePassword(r *http.Request, userID int64, password string, now time.Time) error {
+	ctx := r.Context()
+	passwordHash, err := auth.HashPassword(password)
+	if err != nil {
+		return err
+	}
+	if err := h.queries.UpdateUserPassword(ctx, store.UpdateUserPasswordParams{
+		PasswordHash: passwordHash,
+		UpdatedAt:    now,
+		ID:           userID,
+	}); err != nil {
+		return err
+	}
+	user, err := h.queries.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := h.sessionManager.RenewToken(ctx); err != nil {
+		return err
+	}
+	h.sessionManager.Put(ctx, middleware.SessionKeyUserSessionVersion, user.SessionVersion)
+	return nil
+}
+
+// Logout handles POST /members/logout - ends the session and returns to the
+// home page.
+func (h *MembersHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if user := middleware.GetUser(r); user != nil {
+		_ = h.eventService.LogAuthEvent(ctx, model.EventLevelInfo, "User logged out", &user.ID,
+			middleware.GetClientIP(r), middleware.GetRequestURL(r), nil)
+		slog.Info("user logged out", "user_id", user.ID)
+	}
+	if err := h.sessionManager.Destroy(ctx); err != nil {
+		slog.Error("session destroy error", "error", err)
+	}
+	home := RouteRoot
+	if prefix := middleware.GetLanguagePrefix(r); prefix != "" {
+		home = "/" + prefix
+	}
+	http.Redirect(w, r, home, http.StatusSeeOther)
+}
+
+// validateMemberName checks the name of a member.
+func (h *MembersHandler) validateMemberName(data MemberPageData, name string) {
+	if n := utf8.RuneCountInString(name); n < 2 || n > maxMemberNameLen {
+		data.Errors["name"] = h.t(data, "frontend.member_name_invalid", maxMemberNameLen)
+	}
+}
+
+// validateNewPassword checks a new password and its confirmation, reporting
+// errors under field.
+func (h *MembersHandler) validateNewPassword(data MemberPageData, field, password, confirm string) {
+	switch {
+	case len(password) < MinPasswordLength:
+		data.Errors[field] = h.t(data, "frontend.member_password_too_short", MinPasswordLength)
+	case password != confirm:
+		data.Errors[field+"_confirm"] = h.t(data, "frontend.member_password_mismatch")
+	}
+}
+
+// memberProfileValues returns the profile form values of a user.
+func memberProfileValues(user store.User) map[string]string {
+	return map[string]string{
+		"name":        user.Name,
+		"email":       user.Email,
+		"bio":         user.Bio,
+		"website_url": user.WebsiteUrl,
+	}
+}
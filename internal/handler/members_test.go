@@ -0,0 +1,409 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/olegiv/ocms-go/internal/auth"
+	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/module"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/modules/hcaptcha"
+)
+
+// newTestMembersHandler creates a members handler with registration open.
+func newTestMembersHandler(t *testing.T) *MembersHandler {
+	t.Helper()
+
+	db, sm := testHandlerSetup(t)
+	setTestConfig(t, store.New(db), model.ConfigKeyMembersRegistration, "true")
+	frontend := NewFrontendHandler(db, loadedFrontendThemeManager(t, "default"), nil, slog.Default(), nil, nil)
+	return NewMembersHandler(db, sm, nil, NewAuthHandler(db, nil, sm, nil, nil), frontend)
+}
+
+// recordingMemberNotifier records the users told about a registration
+// attempt with their address.
+type recordingMemberNotifier struct {
+	sent chan store.User
+}
+
+func (n *recordingMemberNotifier) NotifyRegistrationAttempt(_ context.Context, user store.User, _ string) error {
+	n.sent <- user
+	return nil
+}
+
+// postMemberForm submits a member form to handle.
+func postMemberForm(h *MembersHandler, handle http.HandlerFunc, path string, form url.Values, user *store.User) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req = requestWithSession(h.sessionManager, req)
+	if user != nil {
+		req = addUserToContext(req, user)
+	}
+	w := httptest.NewRecorder()
+	handle(w, req)
+	return w
+}
+
+func TestMembersHandler_Register(t *testing.T) {
+	h := newTestMembersHandler(t)
+	ctx := context.Background()
+	valid := url.Values{
+		"name": {"Ann"}, "email": {"ann@example.com"},
+		"password": {"correct-horse"}, "password_confirm": {"correct-horse"},
+	}
+	with := func(key, value string) url.Values {
+		form := url.Values{}
+		for k, v := range valid {
+			form[k] = v
+		}
+		form.Set(key, value)
+		return form
+	}
+
+	tests := []struct {
+		name       string
+		form       url.Values
+		wantStatus int
+		wantField  string // field with an error on 422
+	}{
+		{"short name", with("name", "A"), http.StatusUnprocessableEntity, "name"},
+		{"bad email", with("email", "Ann <ann@example.com>"), http.StatusUnprocessableEntity, "email"},
+		{"short password", with("password", "short"), http.StatusUnprocessableEntity, "password"},
+		{"mismatched password", with("password_confirm", "other-horse"), http.StatusUnprocessableEntity, "password"},
+		{"honeypot", with("_website", "x"), http.StatusSeeOther, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := postMemberForm(h, h.Register, RouteMembersRegister, tt.form, nil)
+			assertStatus(t, w.Code, tt.wantStatus)
+			if tt.wantField != "" && !strings.Contains(w.Body.String(), `id="member-`+tt.wantField+`"`) {
+				t.Errorf("form is not re-rendered with the %s field", tt.wantField)
+			}
+			if _, err := h.queries.GetUserByEmail(ctx, "ann@example.com"); err == nil {
+				t.Fatal("member was created")
+			}
+		})
+	}
+
+	w := postMemberForm(h, h.Register, RouteMembersRegister, with("next", "/members-only"), nil)
+	assertStatus(t, w.Code, http.StatusSeeOther)
+	if loc := w.Header().Get("Location"); loc != "/members-only" {
+		t.Errorf("Location = %q, want /members-only", loc)
+	}
+	user, err := h.queries.GetUserByEmail(ctx, "ann@example.com")
+	if err != nil {
+		t.Fatalf("GetUserByEmail: %v", err)
+	}
+	if user.Role != model.RolePublic {
+		t.Errorf("member role = %q, want %q", user.Role, model.RolePublic)
+	}
+
+	t.Run("taken email", func(t *testing.T) {
+		neutral := i18n.T("en", "frontend.member_register_failed")
+		notifier := &recordingMemberNotifier{sent: make(chan store.User, 1)}
+		h.SetNotifier(notifier)
+		defer h.SetNotifier(nil)
+
+		// An invalid form with a taken address answers like a free one
+		w := postMemberForm(h, h.Register, RouteMembersRegister, with("password_confirm", "other-horse"), nil)
+		assertStatus(t, w.Code, http.StatusUnprocessableEntity)
+		if strings.Contains(w.Body.String(), neutral) {
+			t.Error("invalid registration form tells that the email address is taken")
+		}
+
+		w = postMemberForm(h, h.Register, RouteMembersRegister, valid, nil)
+		assertStatus(t, w.Code, http.StatusUnprocessableEntity)
+		if !strings.Contains(w.Body.String(), neutral) {
+			t.Error("registration form does not show the neutral message")
+		}
+		select {
+		case notified := <-notifier.sent:
+			if notified.ID != user.ID {
+				t.Errorf("notified user %d, want %d", notified.ID, user.ID)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("owner of the address was not notified")
+		}
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		setTestConfig(t, h.queries, model.ConfigKeyMembersRegistration, "false")
+		w := postMemberForm(h, h.Register, RouteMembersRegister, with("email", "bob@example.com"), nil)
+		assertStatus(t, w.Code, http.StatusNotFound)
+	})
+}
+
+func TestMembersHandler_LoginAndProfile(t *testing.T) {
+	h := newTestMembersHandler(t)
+	ctx := context.Background()
+	hash, err := auth.HashPassword("correct-horse")
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	now := time.Now()
+	member, err := h.queries.CreateUser(ctx, store.CreateUserParams{
+		Email: "ann@example.com", PasswordHash: hash, Role: model.RolePublic, Name: "Ann",
+		CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	w := postMemberForm(h, h.Login, RouteMembersLogin, url.Values{"email": {member.Email}, "password": {"wrong-horse"}}, nil)
+	assertStatus(t, w.Code, http.StatusUnprocessableEntity)
+
+	tests := []struct {
+		next, want string
+	}{
+		{"/guides/secret", "/guides/secret"},
+		{"//evil.example", RouteMembersProfile},
+		{"https://evil.example", RouteMembersProfile},
+	}
+	for _, tt := range tests {
+		form := url.Values{"email": {member.Email}, "password": {"correct-horse"}, "next": {tt.next}}
+		w := postMemberForm(h, h.Login, RouteMembersLogin, form, nil)
+		assertStatus(t, w.Code, http.StatusSeeOther)
+		if loc := w.Header().Get("Location"); loc != tt.want {
+			t.Errorf("next %q: Location = %q, want %q", tt.next, loc, tt.want)
+		}
+	}
+
+	form := url.Values{"name": {"Ann B"}, "bio": {"Reader"}, "website_url": {"https://ann.example"}}
+	w = postMemberForm(h, h.UpdateProfile, RouteMembersProfile, form, &member)
+	assertStatus(t, w.Code, http.StatusSeeOther)
+	updated, err := h.queries.GetUserByID(ctx, member.ID)
+	if err != nil {
+		t.Fatalf("GetUserByID: %v", err)
+	}
+	if updated.Name != "Ann B" || updated.Bio != "Reader" || updated.Role != model.RolePublic {
+		t.Errorf("updated member = %q/%q/%q, want Ann B/Reader/public", updated.Name, updated.Bio, updated.Role)
+	}
+
+	form = url.Values{"name": {"Ann B"}, "new_password": {"battery-staple"}, "new_password_confirm": {"battery-staple"}, "current_password": {"wrong-horse"}}
+	w = postMemberForm(h, h.UpdateProfile, RouteMembersProfile, form, &updated)
+	assertStatus(t, w.Code, http.StatusUnprocessableEntity)
+}
+
+func TestMembersHandler_LoginCaptcha(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	queries := store.New(db)
+	hash, err := auth.HashPassword("correct-horse")
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	now := time.Now()
+	member, err := queries.CreateUser(context.Background(), store.CreateUserParams{
+		Email: "ann@example.com", PasswordHash: hash, Role: model.RolePublic, Name: "Ann",
+		CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	hooks := module.NewHookRegistry(slog.Default())
+	hooks.RegisterFunc(hcaptcha.HookAuthLoginWidget, "widget", "hcaptcha", func(context.Context, any) (any, error) {
+		return template.HTML(`<div class="h-captcha"></div>`), nil
+	})
+	hooks.RegisterFunc(hcaptcha.HookAuthBeforeLogin, "verify", "hcaptcha", func(_ context.Context, data any) (any, error) {
+		req := data.(*hcaptcha.VerifyRequest)
+		req.Verified = req.Response == "valid"
+		if !req.Verified {
+			req.Error = "captcha failed"
+		}
+		return req, nil
+	})
+	frontend := NewFrontendHandler(db, loadedFrontendThemeManager(t, "default"), nil, slog.Default(), nil, nil)
+	frontend.SetHookRegistry(hooks)
+	h := NewMembersHandler(db, sm, hooks, NewAuthHandler(db, nil, sm, nil, hooks), frontend)
+
+	req := requestWithSession(sm, httptest.NewRequest(http.MethodGet, RouteMembersLogin, nil))
+	w := httptest.NewRecorder()
+	h.LoginForm(w, req)
+	assertStatus(t, w.Code, http.StatusOK)
+	if !strings.Contains(w.Body.String(), `class="h-captcha"`) {
+		t.Error("login form does not render the captcha widget")
+	}
+
+	form := url.Values{"email": {member.Email}, "password": {"correct-horse"}, "h-captcha-response": {"invalid"}}
+	w = postMemberForm(h, h.Login, RouteMembersLogin, form, nil)
+	assertStatus(t, w.Code, http.StatusUnprocessableEntity)
+	if !strings.Contains(w.Body.String(), `class="h-captcha"`) {
+		t.Error("re-rendered login form does not render the captcha widget")
+	}
+
+	form.Set("h-captcha-response", "valid")
+	w = postMemberForm(h, h.Login, RouteMembersLogin, form, nil)
+	assertStatus(t, w.Code, http.StatusSeeOther)
+}
+
+func TestFrontendHandler_PageAccess(t *testing.T) {
+	db, _ := testHandlerSetup(t)
+	admin := createTestAdminUser(t, db)
+	queries := store.New(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	pages := map[string]store.Page{}
+	for _, slug := range []string{"members-guide", "editors-guide"} {
+		p, err := queries.CreatePage(ctx, store.CreatePageParams{
+			Title: slug, Slug: slug, Status: "published", AuthorID: admin.ID,
+			PageType: "page", LanguageCode: "en",
+			PublishedAt: sql.NullTime{Time: now, Valid: true},
+			CreatedAt:   now, UpdatedAt: now,
+			SiteID: store.DefaultSiteID,
+		})
+		if err != nil {
+			t.Fatalf("CreatePage: %v", err)
+		}
+		pages[slug] = p
+	}
+	for _, rule := range []store.UpsertPageAccessParams{
+		{PageID: pages["members-guide"].ID, Access: model.PageAccessMembers},
+		{PageID: pages["editors-guide"].ID, Access: model.PageAccessRoles, Roles: model.RoleEditor},
+	} {
+		if err := queries.UpsertPageAccess(ctx, rule); err != nil {
+			t.Fatalf("UpsertPageAccess: %v", err)
+		}
+	}
+	member := createTestUser(t, db, testUser{Email: "member@example.com", Name: "Member", Role: model.RolePublic})
+
+	h := NewFrontendHandler(db, loadedFrontendThemeManager(t, "default"), nil, slog.Default(), nil, nil)
+	router := chi.NewRouter()
+	router.Use(middleware.Language(db))
+	router.Get(RouteParamSlug, h.Page)
+
+	tests := []struct {
+		name       string
+		path       string
+		user       *store.User
+		wantStatus int
+		wantLoc    string
+	}{
+		{"anonymous members page", "/members-guide", nil, http.StatusSeeOther, "/members/login?next=%2Fmembers-guide"},
+		{"member members page", "/members-guide", &member, http.StatusOK, ""},
+		{"member roles page", "/editors-guide", &member, http.StatusForbidden, ""},
+		{"admin roles page", "/editors-guide", &admin, http.StatusOK, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.user != nil {
+				req = addUserToContext(req, tt.user)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assertStatus(t, w.Code, tt.wantStatus)
+			if loc := w.Header().Get("Location"); loc != tt.wantLoc {
+				t.Errorf("Location = %q, want %q", loc, tt.wantLoc)
+			}
+		})
+	}
+
+	sitemap, err := queries.ListPublishedPagesForSitemap(ctx, store.DefaultSiteID)
+	if err != nil {
+		t.Fatalf("ListPublishedPagesForSitemap: %v", err)
+	}
+	if len(sitemap) != 0 {
+		t.Errorf("sitemap lists %d restricted pages, want 0", len(sitemap))
+	}
+}
+
+func TestFrontendHandler_PagesToViews_RestrictedBody(t *testing.T) {
+	db, _ := testHandlerSetup(t)
+	admin := createTestAdminUser(t, db)
+	queries := store.New(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	var pages []store.Page
+	for _, slug := range []string{"public-post", "members-post", "editors-post"} {
+		p, err := queries.CreatePage(ctx, store.CreatePageParams{
+			Title: slug, Slug: slug, Body: "<p>Secret " + slug + "</p>", Status: "published",
+			AuthorID: admin.ID, PageType: "post", LanguageCode: "en",
+			PublishedAt: sql.NullTime{Time: now, Valid: true},
+			CreatedAt:   now, UpdatedAt: now,
+			SiteID: store.DefaultSiteID,
+		})
+		if err != nil {
+			t.Fatalf("CreatePage: %v", err)
+		}
+		pages = append(pages, p)
+	}
+	for _, rule := range []store.UpsertPageAccessParams{
+		{PageID: pages[1].ID, Access: model.PageAccessMembers},
+		{PageID: pages[2].ID, Access: model.PageAccessRoles, Roles: model.RoleEditor},
+	} {
+		if err := queries.UpsertPageAccess(ctx, rule); err != nil {
+			t.Fatalf("UpsertPageAccess: %v", err)
+		}
+	}
+	member := createTestUser(t, db, testUser{Email: "member@example.com", Name: "Member", Role: model.RolePublic})
+
+	h := NewFrontendHandler(db, testThemeManager(), nil, slog.Default(), nil, nil)
+	tests := []struct {
+		name     string
+		user     *store.User
+		wantBody []bool
+	}{
+		{"anonymous", nil, []bool{true, false, false}},
+		{"member", &member, []bool{true, true, false}},
+		{"admin", &admin, []bool{true, true, true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viewCtx := ctx
+			if tt.user != nil {
+				viewCtx = context.WithValue(ctx, middleware.ContextKeyUser, *tt.user)
+			}
+			views := h.pagesToViews(viewCtx, pages, "en", "")
+			if len(views) != len(pages) {
+				t.Fatalf("pagesToViews() returned %d views, want %d", len(views), len(pages))
+			}
+			for i, view := range views {
+				if got := strings.Contains(string(view.Body), "Secret"); got != tt.wantBody[i] {
+					t.Errorf("%s: body shown = %v, want %v", view.Slug, got, tt.wantBody[i])
+				}
+				if single := h.pageToView(viewCtx, pages[i], "en", ""); single.Body != view.Body {
+					t.Errorf("%s: pageToView body = %q, pagesToViews body = %q", view.Slug, single.Body, view.Body)
+				}
+			}
+		})
+	}
+}
+
+func TestSafeMemberNext(t *testing.T) {
+	tests := map[string]string{
+		"/docs?page=2":          "/docs?page=2",
+		"":                      "",
+		"docs":                  "",
+		"//evil.example":        "",
+		"/\\evil.example":       "",
+		"https://evil.example":  "",
+		"/ok\r\nSet-Cookie: x=": "",
+		"/ok\tpath":             "",
+		"/ok\x00":               "",
+		"/ok\x7f":               "",
+		"/ok\u0085":             "",
+	}
+	for next, want := range tests {
+		if got := safeMemberNext(next); got != want {
+			t.Errorf("safeMemberNext(%q) = %q, want %q", next, got, want)
+		}
+	}
+}
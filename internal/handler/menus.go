@@ -12,6 +12,7 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/olegiv/ocms-go/internal/store"
 	"github.com/olegiv/ocms-go/internal/util"
 	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+	"github.com/olegiv/ocms-go/internal/webhook"
 )
 
 // MenusHandler handles menu management routes.
@@ -33,6 +35,7 @@ type MenusHandler struct {
 	renderer       *render.Renderer
 	sessionManager *scs.SessionManager
 	eventService   *service.EventService
+	dispatcher     *webhook.Dispatcher
 }
 
 // NewMenusHandler creates a new MenusHandler.
@@ -45,11 +48,24 @@ func NewMenusHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionManag
 	}
 }
 
+// SetDispatcher sets the webhook dispatcher for event dispatching.
+func (h *MenusHandler) SetDispatcher(d *webhook.Dispatcher) {
+	h.dispatcher = d
+}
+
+// dispatchMenuEvent dispatches a menu-related webhook event. change describes
+// what was modified for menu.updated (see webhook.MenuChange* constants).
+func (h *MenusHandler) dispatchMenuEvent(ctx context.Context, eventType string, menu store.Menu, change string, itemID int64) {
+	data := webhook.NewMenuEventData(menu, change)
+	data.ItemID = itemID
+	dispatchWebhookEvent(ctx, h.dispatcher, eventType, data, "menu_id", menu.ID)
+}
+
 // List handles GET /admin/menus - displays a list of menus.
 func (h *MenusHandler) List(w http.ResponseWriter, r *http.Request) {
 	lang := h.renderer.GetAdminLang(r)
 
-	menus, err := h.queries.ListMenus(r.Context())
+	menus, err := h.queries.ListMenusBySite(r.Context(), store.SiteIDFromContext(r.Context()))
 	if err != nil {
 		slog.Error("failed to list menus", "error", err)
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
@@ -73,16 +89,23 @@ type MenuItemNode struct {
 
 // menuItemJSON is a JSON-friendly representation of MenuItem.
 type menuItemJSON struct {
-	ID       int64  `json:"id"`
-	MenuID   int64  `json:"menu_id"`
-	ParentID *int64 `json:"parent_id"`
-	Title    string `json:"title"`
-	URL      string `json:"url"`
-	Target   string `json:"target"`
-	PageID   *int64 `json:"page_id"`
-	Position int64  `json:"position"`
-	CssClass string `json:"css_class"`
-	IsActive bool   `json:"is_active"`
+	ID               int64  `json:"id"`
+	MenuID           int64  `json:"menu_id"`
+	ParentID         *int64 `json:"parent_id"`
+	Title            string `json:"title"`
+	URL              string `json:"url"`
+	Target           string `json:"target"`
+	PageID           *int64 `json:"page_id"`
+	Position         int64  `json:"position"`
+	CssClass         string `json:"css_class"`
+	IsActive         bool   `json:"is_active"`
+	ItemType         string `json:"item_type"`
+	LinkID           *int64 `json:"link_id"`
+	Visibility       string `json:"visibility"`
+	VisibleRoles     string `json:"visible_roles"`
+	VisibleLanguages string `json:"visible_languages"`
+	MegaMenu         bool   `json:"mega_menu"`
+	ImageURL         string `json:"image_url"`
 }
 
 // menuItemNodeJSON is a JSON-friendly representation of MenuItemNode.
@@ -107,6 +130,10 @@ func (n MenuItemNode) toJSON() menuItemNodeJSON {
 	if n.Item.PageID.Valid {
 		pageID = &n.Item.PageID.Int64
 	}
+	var linkID *int64
+	if n.Item.LinkID.Valid {
+		linkID = &n.Item.LinkID.Int64
+	}
 
 	children := make([]menuItemNodeJSON, 0, len(n.Children))
 	for _, child := range n.Children {
@@ -115,16 +142,23 @@ func (n MenuItemNode) toJSON() menuItemNodeJSON {
 
 	return menuItemNodeJSON{
 		Item: menuItemJSON{
-			ID:       n.Item.ID,
-			MenuID:   n.Item.MenuID,
-			ParentID: parentID,
-			Title:    n.Item.Title,
-			URL:      n.Item.Url.String,
-			Target:   n.Item.Target.String,
-			PageID:   pageID,
-			Position: n.Item.Position,
-			CssClass: n.Item.CssClass.String,
-			IsActive: n.Item.IsActive,
+			ID:               n.Item.ID,
+			MenuID:           n.Item.MenuID,
+			ParentID:         parentID,
+			Title:            n.Item.Title,
+			URL:              n.Item.Url.String,
+			Target:           n.Item.Target.String,
+			PageID:           pageID,
+			Position:         n.Item.Position,
+			CssClass:         n.Item.CssClass.String,
+			IsActive:         n.Item.IsActive,
+			ItemType:         n.Item.ItemType,
+			LinkID:           linkID,
+			Visibility:       n.Item.Visibility,
+			VisibleRoles:     n.Item.VisibleRoles,
+			VisibleLanguages: n.Item.VisibleLanguages,
+			MegaMenu:         n.Item.MegaMenu,
+			ImageURL:         n.Item.ImageUrl,
 		},
 		Children: children,
 		PageSlug: n.PageSlug,
@@ -176,18 +210,25 @@ func buildMenuTree(items []store.ListMenuItemsWithPageRow, parentID sql.NullInt6
 			(!item.ParentID.Valid || item.ParentID.Int64 == parentID.Int64) {
 			node := MenuItemNode{
 				Item: store.MenuItem{
-					ID:        item.ID,
-					MenuID:    item.MenuID,
-					ParentID:  item.ParentID,
-					Title:     item.Title,
-					Url:       item.Url,
-					Target:    item.Target,
-					PageID:    item.PageID,
-					Position:  item.Position,
-					CssClass:  item.CssClass,
-					IsActive:  item.IsActive,
-					CreatedAt: item.CreatedAt,
-					UpdatedAt: item.UpdatedAt,
+					ID:               item.ID,
+					MenuID:           item.MenuID,
+					ParentID:         item.ParentID,
+					Title:            item.Title,
+					Url:              item.Url,
+					Target:           item.Target,
+					PageID:           item.PageID,
+					Position:         item.Position,
+					CssClass:         item.CssClass,
+					IsActive:         item.IsActive,
+					CreatedAt:        item.CreatedAt,
+					UpdatedAt:        item.UpdatedAt,
+					ItemType:         item.ItemType,
+					LinkID:           item.LinkID,
+					Visibility:       item.Visibility,
+					VisibleRoles:     item.VisibleRoles,
+					VisibleLanguages: item.VisibleLanguages,
+					MegaMenu:         item.MegaMenu,
+					ImageUrl:         item.ImageUrl,
 				},
 				PageSlug: item.PageSlug.String,
 				Children: buildMenuTree(items, util.NullInt64FromValue(item.ID)),
@@ -259,6 +300,7 @@ func (h *MenusHandler) Create(w http.ResponseWriter, r *http.Request) {
 		LanguageCode: input.LanguageCode,
 		CreatedAt:    now,
 		UpdatedAt:    now,
+		SiteID:       store.SiteIDFromContext(r.Context()),
 	})
 	if err != nil {
 		slog.Error("failed to create menu", "error", err)
@@ -268,6 +310,7 @@ func (h *MenusHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	slog.Info("menu created", "menu_id", menu.ID, "slug", menu.Slug)
 	_ = h.eventService.LogMenuEvent(r.Context(), model.EventLevelInfo, "Menu created", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"menu_id": menu.ID, "name": menu.Name, "slug": menu.Slug})
+	h.dispatchMenuEvent(r.Context(), model.EventMenuCreated, menu, "", 0)
 	flashSuccess(w, r, h.renderer, fmt.Sprintf(redirectAdminMenusID, menu.ID), "Menu created successfully")
 }
 
@@ -310,6 +353,7 @@ func (h *MenusHandler) EditForm(w http.ResponseWriter, r *http.Request) {
 	languages := ListActiveLanguagesWithFallback(r.Context(), h.queries)
 
 	viewData := buildMenuFormViewData(true, &menu, tree, pages, languages, make(map[string]string), make(map[string]string))
+	h.applyMenuLinkOptions(r.Context(), &viewData, menu, languages)
 	pc := buildPageContext(r, h.sessionManager, h.renderer, fmt.Sprintf("Edit Menu - %s", menu.Name), menuEditBreadcrumbs(lang, menu.Name, menu.ID))
 	renderTempl(w, r, adminviews.MenuFormPage(pc, viewData))
 }
@@ -361,7 +405,7 @@ func (h *MenusHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	now := time.Now()
-	_, err = h.queries.UpdateMenu(r.Context(), store.UpdateMenuParams{
+	updatedMenu, err := h.queries.UpdateMenu(r.Context(), store.UpdateMenuParams{
 		ID:           id,
 		Name:         input.Name,
 		Slug:         input.Slug,
@@ -382,6 +426,7 @@ func (h *MenusHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 	slog.Info("menu updated", "menu_id", id, "updated_by", middleware.GetUserID(r))
 	_ = h.eventService.LogMenuEvent(r.Context(), model.EventLevelInfo, "Menu updated", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"menu_id": id})
+	h.dispatchMenuEvent(r.Context(), model.EventMenuUpdated, updatedMenu, webhook.MenuChangeDetails, 0)
 	flashSuccess(w, r, h.renderer, fmt.Sprintf(redirectAdminMenusID, id), "Menu updated successfully")
 }
 
@@ -422,6 +467,7 @@ func (h *MenusHandler) Delete(w http.ResponseWriter, r *http.Request) {
 
 	slog.Info("menu deleted", "menu_id", id, "slug", menu.Slug, "deleted_by", middleware.GetUserID(r))
 	_ = h.eventService.LogMenuEvent(r.Context(), model.EventLevelInfo, "Menu deleted", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"menu_id": id, "slug": menu.Slug})
+	h.dispatchMenuEvent(r.Context(), model.EventMenuDeleted, menu, "", 0)
 
 	if r.Header.Get("HX-Request") == "true" {
 		w.WriteHeader(http.StatusOK)
@@ -439,6 +485,20 @@ type AddItemRequest struct {
 	PageID   *int64 `json:"page_id"`
 	ParentID *int64 `json:"parent_id"`
 	CSSClass string `json:"css_class"`
+	menuItemOptions
+}
+
+// menuItemOptions are the menu item fields beyond a plain page or URL link:
+// the item type and its target, the visibility rules and the mega menu
+// settings. Roles and languages are lists.
+type menuItemOptions struct {
+	ItemType         string   `json:"item_type"`
+	LinkID           *int64   `json:"link_id"`
+	Visibility       string   `json:"visibility"`
+	VisibleRoles     []string `json:"visible_roles"`
+	VisibleLanguages []string `json:"visible_languages"`
+	MegaMenu         bool     `json:"mega_menu"`
+	ImageURL         string   `json:"image_url"`
 }
 
 // AddItem handles POST /admin/menus/{id}/items - adds a menu item.
@@ -470,8 +530,9 @@ func (h *MenusHandler) AddItem(w http.ResponseWriter, r *http.Request) {
 		PageID:   req.PageID,
 		URL:      req.URL,
 		CSSClass: req.CSSClass,
+		Options:  req.menuItemOptions,
 	})
-	if !ok {
+	if !ok || !h.requireMenuItemLink(w, r, validated) {
 		return
 	}
 
@@ -486,17 +547,24 @@ func (h *MenusHandler) AddItem(w http.ResponseWriter, r *http.Request) {
 
 	now := time.Now()
 	item, err := h.queries.CreateMenuItem(r.Context(), store.CreateMenuItemParams{
-		MenuID:    menuID,
-		ParentID:  parentID,
-		Title:     req.Title,
-		Url:       validated.URL,
-		Target:    util.NullStringFromValue(validated.Target),
-		PageID:    validated.PageID,
-		Position:  maxPos + 1,
-		CssClass:  validated.CSSClass,
-		IsActive:  true,
-		CreatedAt: now,
-		UpdatedAt: now,
+		MenuID:           menuID,
+		ParentID:         parentID,
+		Title:            req.Title,
+		Url:              validated.URL,
+		Target:           util.NullStringFromValue(validated.Target),
+		PageID:           validated.PageID,
+		Position:         maxPos + 1,
+		CssClass:         validated.CSSClass,
+		IsActive:         true,
+		ItemType:         validated.ItemType,
+		LinkID:           validated.LinkID,
+		Visibility:       validated.Visibility,
+		VisibleRoles:     validated.VisibleRoles,
+		VisibleLanguages: validated.VisibleLanguages,
+		MegaMenu:         validated.MegaMenu,
+		ImageUrl:         validated.ImageURL,
+		CreatedAt:        now,
+		UpdatedAt:        now,
 	})
 	if err != nil {
 		slog.Error("failed to create menu item", "error", err)
@@ -506,6 +574,7 @@ func (h *MenusHandler) AddItem(w http.ResponseWriter, r *http.Request) {
 
 	// Invalidate menu cache
 	h.renderer.InvalidateMenuCache(menu.Slug)
+	h.dispatchMenuEvent(r.Context(), model.EventMenuUpdated, menu, webhook.MenuChangeItemAdded, item.ID)
 
 	writeJSONSuccess(w, map[string]any{"item": item})
 }
@@ -519,6 +588,7 @@ type UpdateItemRequest struct {
 	ParentID *int64 `json:"parent_id"`
 	CSSClass string `json:"css_class"`
 	IsActive bool   `json:"is_active"`
+	menuItemOptions
 }
 
 // UpdateItem handles PUT /admin/menus/{id}/items/{itemId} - updates a menu item.
@@ -544,8 +614,9 @@ func (h *MenusHandler) UpdateItem(w http.ResponseWriter, r *http.Request) {
 		PageID:   req.PageID,
 		URL:      req.URL,
 		CSSClass: req.CSSClass,
+		Options:  req.menuItemOptions,
 	})
-	if !ok {
+	if !ok || !h.requireMenuItemLink(w, r, validated) {
 		return
 	}
 
@@ -576,16 +647,23 @@ func (h *MenusHandler) UpdateItem(w http.ResponseWriter, r *http.Request) {
 
 	now := time.Now()
 	updatedItem, err := h.queries.UpdateMenuItem(r.Context(), store.UpdateMenuItemParams{
-		ID:        item.ID,
-		ParentID:  parentID,
-		Title:     req.Title,
-		Url:       validated.URL,
-		Target:    util.NullStringFromValue(validated.Target),
-		PageID:    validated.PageID,
-		Position:  position,
-		CssClass:  validated.CSSClass,
-		IsActive:  req.IsActive,
-		UpdatedAt: now,
+		ID:               item.ID,
+		ParentID:         parentID,
+		Title:            req.Title,
+		Url:              validated.URL,
+		Target:           util.NullStringFromValue(validated.Target),
+		PageID:           validated.PageID,
+		Position:         position,
+		CssClass:         validated.CSSClass,
+		IsActive:         req.IsActive,
+		ItemType:         validated.ItemType,
+		LinkID:           validated.LinkID,
+		Visibility:       validated.Visibility,
+		VisibleRoles:     validated.VisibleRoles,
+		VisibleLanguages: validated.VisibleLanguages,
+		MegaMenu:         validated.MegaMenu,
+		ImageUrl:         validated.ImageURL,
+		UpdatedAt:        now,
 	})
 	if err != nil {
 		slog.Error("failed to update menu item", "error", err)
@@ -595,6 +673,7 @@ func (h *MenusHandler) UpdateItem(w http.ResponseWriter, r *http.Request) {
 
 	// Invalidate menu cache
 	h.renderer.InvalidateMenuCache(menu.Slug)
+	h.dispatchMenuEvent(r.Context(), model.EventMenuUpdated, menu, webhook.MenuChangeItemUpdated, updatedItem.ID)
 
 	writeJSONSuccess(w, map[string]any{"item": updatedItem})
 }
@@ -620,6 +699,7 @@ func (h *MenusHandler) DeleteItem(w http.ResponseWriter, r *http.Request) {
 
 	// Invalidate menu cache
 	h.renderer.InvalidateMenuCache(menu.Slug)
+	h.dispatchMenuEvent(r.Context(), model.EventMenuUpdated, menu, webhook.MenuChangeItemDeleted, item.ID)
 
 	writeJSONSuccess(w, nil)
 }
@@ -667,6 +747,7 @@ func (h *MenusHandler) Reorder(w http.ResponseWriter, r *http.Request) {
 
 	// Invalidate menu cache
 	h.renderer.InvalidateMenuCache(menu.Slug)
+	h.dispatchMenuEvent(r.Context(), model.EventMenuUpdated, menu, webhook.MenuChangeItemsReordered, 0)
 
 	writeJSONSuccess(w, nil)
 }
@@ -716,13 +797,19 @@ func (h *MenusHandler) processReorderItems(r *http.Request, menuID int64, items
 // requireMenuWithRedirect fetches menu by ID and handles errors with flash messages and redirect.
 func (h *MenusHandler) requireMenuWithRedirect(w http.ResponseWriter, r *http.Request, id int64) (store.Menu, bool) {
 	return requireEntityWithRedirect(w, r, h.renderer, redirectAdminMenus, "Menu", id,
-		func(id int64) (store.Menu, error) { return h.queries.GetMenuByID(r.Context(), id) })
+		func(id int64) (store.Menu, error) {
+			menu, err := h.queries.GetMenuByID(r.Context(), id)
+			return menu, siteScopedErr(r.Context(), menu.SiteID, err)
+		})
 }
 
 // requireMenuWithError fetches menu by ID and handles errors with http.Error.
 func (h *MenusHandler) requireMenuWithError(w http.ResponseWriter, r *http.Request, id int64) (store.Menu, bool) {
 	return requireEntityWithError(w, "Menu", id,
-		func(id int64) (store.Menu, error) { return h.queries.GetMenuByID(r.Context(), id) })
+		func(id int64) (store.Menu, error) {
+			menu, err := h.queries.GetMenuByID(r.Context(), id)
+			return menu, siteScopedErr(r.Context(), menu.SiteID, err)
+		})
 }
 
 // menuFormInput holds parsed and validated menu form input.
@@ -813,14 +900,22 @@ type menuItemInput struct {
 	PageID   *int64
 	URL      string
 	CSSClass string
+	Options  menuItemOptions
 }
 
 // menuItemValidated holds validated menu item data ready for database operations.
 type menuItemValidated struct {
-	Target   string
-	PageID   sql.NullInt64
-	URL      sql.NullString
-	CSSClass sql.NullString
+	Target           string
+	PageID           sql.NullInt64
+	URL              sql.NullString
+	CSSClass         sql.NullString
+	ItemType         string
+	LinkID           sql.NullInt64
+	Visibility       string
+	VisibleRoles     string
+	VisibleLanguages string
+	MegaMenu         bool
+	ImageURL         string
 }
 
 // validateMenuItemInput validates common menu item fields and writes JSON error on failure.
@@ -841,18 +936,164 @@ func validateMenuItemInput(w http.ResponseWriter, input menuItemInput) (menuItem
 		return menuItemValidated{}, false
 	}
 
-	return menuItemValidated{
-		Target:   target,
-		PageID:   util.NullInt64FromPtr(input.PageID),
-		URL:      util.NullStringFromValue(input.URL),
-		CSSClass: util.NullStringFromValue(input.CSSClass),
-	}, true
+	opts := input.Options
+	if !model.IsValidMenuItemType(opts.ItemType) {
+		writeJSONError(w, http.StatusBadRequest, "Invalid item type")
+		return menuItemValidated{}, false
+	}
+	if !model.IsValidMenuVisibility(opts.Visibility) {
+		writeJSONError(w, http.StatusBadRequest, "Invalid visibility")
+		return menuItemValidated{}, false
+	}
+	if err := validateMenuItemImageURL(opts.ImageURL); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "Invalid image URL")
+		return menuItemValidated{}, false
+	}
+
+	validated := menuItemValidated{
+		Target:     target,
+		PageID:     util.NullInt64FromPtr(input.PageID),
+		URL:        util.NullStringFromValue(input.URL),
+		CSSClass:   util.NullStringFromValue(input.CSSClass),
+		ItemType:   opts.ItemType,
+		LinkID:     util.NullInt64FromPtr(opts.LinkID),
+		Visibility: opts.Visibility,
+		MegaMenu:   opts.MegaMenu,
+		ImageURL:   opts.ImageURL,
+	}
+
+	switch opts.ItemType {
+	case model.MenuItemLink, model.MenuItemBlog:
+		validated.LinkID = sql.NullInt64{}
+	case model.MenuItemCategory, model.MenuItemTag, model.MenuItemForm:
+		if !validated.LinkID.Valid {
+			writeJSONError(w, http.StatusBadRequest, "Link target is required")
+			return menuItemValidated{}, false
+		}
+	}
+	if opts.ItemType != model.MenuItemLink {
+		// Typed items resolve their own URL, so a page or URL is never kept.
+		validated.PageID = sql.NullInt64{}
+		validated.URL = sql.NullString{}
+	}
+
+	// Menu items follow the page access rule: admins see every
+	// role-restricted item, so only the other roles are kept.
+	if opts.Visibility == model.MenuVisibilityRoles {
+		validated.VisibleRoles = pageAccessRoles(opts.VisibleRoles)
+		if validated.VisibleRoles == "" {
+			writeJSONError(w, http.StatusBadRequest, "Select at least one role")
+			return menuItemValidated{}, false
+		}
+	}
+
+	languages := make([]string, 0, len(opts.VisibleLanguages))
+	for _, code := range opts.VisibleLanguages {
+		if !util.IsValidLangCode(code) {
+			writeJSONError(w, http.StatusBadRequest, "Invalid language")
+			return menuItemValidated{}, false
+		}
+		if !slices.Contains(languages, code) {
+			languages = append(languages, code)
+		}
+	}
+	validated.VisibleLanguages = strings.Join(languages, ",")
+
+	return validated, true
+}
+
+// validateMenuItemImageURL validates the image of a mega menu column: a site
+// path or an http(s) URL.
+func validateMenuItemImageURL(rawURL string) error {
+	if rawURL == "" {
+		return nil
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if parsed.IsAbs() {
+		if parsed.Scheme == "http" || parsed.Scheme == "https" {
+			return nil
+		}
+		return errors.New("invalid image URL scheme")
+	}
+	if strings.HasPrefix(rawURL, "/") && !strings.HasPrefix(rawURL, "//") {
+		return nil
+	}
+	return errors.New("invalid image URL format")
+}
+
+// requireMenuItemLink checks that the category, tag, form or language a
+// validated item links to exists, writing a JSON error otherwise.
+func (h *MenusHandler) requireMenuItemLink(w http.ResponseWriter, r *http.Request, item menuItemValidated) bool {
+	if !item.LinkID.Valid {
+		return true
+	}
+
+	var err error
+	switch item.ItemType {
+	case model.MenuItemCategory:
+		_, err = h.queries.GetCategoryByID(r.Context(), item.LinkID.Int64)
+	case model.MenuItemTag:
+		_, err = h.queries.GetTagByID(r.Context(), item.LinkID.Int64)
+	case model.MenuItemForm:
+		_, err = h.queries.GetFormByID(r.Context(), item.LinkID.Int64)
+	case model.MenuItemLanguage:
+		_, err = h.queries.GetLanguageByID(r.Context(), item.LinkID.Int64)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		writeJSONError(w, http.StatusBadRequest, "Link target not found")
+		return false
+	}
+	if err != nil {
+		slog.Error("failed to check menu item link", "error", err, "item_type", item.ItemType)
+		writeJSONError(w, http.StatusInternalServerError, "Error checking link target")
+		return false
+	}
+	return true
+}
+
+// applyMenuLinkOptions fills the categories, tags and forms in the menu's
+// language, and the languages, that the menu builder's items can link to.
+func (h *MenusHandler) applyMenuLinkOptions(ctx context.Context, data *adminviews.MenuFormData, menu store.Menu, languages []store.Language) {
+	if categories, err := h.queries.ListCategoriesByLanguage(ctx, menu.LanguageCode); err != nil {
+		slog.Error("failed to list categories", "error", err)
+	} else {
+		for _, c := range categories {
+			data.Categories = append(data.Categories, adminviews.MenuLinkOption{ID: c.ID, Title: c.Name})
+		}
+	}
+	if tags, err := h.queries.ListTagsByLanguage(ctx, menu.LanguageCode); err != nil {
+		slog.Error("failed to list tags", "error", err)
+	} else {
+		for _, t := range tags {
+			data.Tags = append(data.Tags, adminviews.MenuLinkOption{ID: t.ID, Title: t.Name})
+		}
+	}
+	if forms, err := h.queries.ListFormsByLanguage(ctx, store.ListFormsByLanguageParams{
+		LanguageCode: menu.LanguageCode,
+		Limit:        1000,
+	}); err != nil {
+		slog.Error("failed to list forms", "error", err)
+	} else {
+		for _, f := range forms {
+			data.Forms = append(data.Forms, adminviews.MenuLinkOption{ID: f.ID, Title: f.Name})
+		}
+	}
+	for _, l := range languages {
+		data.LinkLanguages = append(data.LinkLanguages, adminviews.MenuLinkOption{ID: l.ID, Title: l.NativeName})
+	}
+	data.Roles = pageAccessRoleOptions
 }
 
 // requireMenuWithJSONError fetches menu by ID and handles errors with JSON response.
 func (h *MenusHandler) requireMenuWithJSONError(w http.ResponseWriter, r *http.Request, id int64) (store.Menu, bool) {
 	return requireEntityWithJSONError(w, "Menu", id,
-		func(id int64) (store.Menu, error) { return h.queries.GetMenuByID(r.Context(), id) })
+		func(id int64) (store.Menu, error) {
+			menu, err := h.queries.GetMenuByID(r.Context(), id)
+			return menu, siteScopedErr(r.Context(), menu.SiteID, err)
+		})
 }
 
 // requireMenuItemWithJSONError fetches menu item by ID and handles errors with JSON response.
@@ -927,6 +1168,7 @@ func (h *MenusHandler) validateMenuSlugCreate(ctx context.Context, slug string,
 		return h.queries.MenuSlugExistsForLanguage(ctx, store.MenuSlugExistsForLanguageParams{
 			Slug:         slug,
 			LanguageCode: languageCode,
+			SiteID:       store.SiteIDFromContext(ctx),
 		})
 	})
 }
@@ -942,6 +1184,7 @@ func (h *MenusHandler) validateMenuSlugUpdate(ctx context.Context, slug string,
 		return h.queries.MenuSlugExistsForLanguageExcluding(ctx, store.MenuSlugExistsForLanguageExcludingParams{
 			Slug:         slug,
 			LanguageCode: languageCode,
+			SiteID:       store.SiteIDFromContext(ctx),
 			ID:           menuID,
 		})
 	})
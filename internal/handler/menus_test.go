@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/store"
 )
 
@@ -30,7 +31,7 @@ func TestMenuCreate(t *testing.T) {
 	db, _ := testHandlerSetup(t)
 	queries := store.New(db)
 
-	menu, err := queries.CreateMenu(context.Background(), store.CreateMenuParams{Name: "Main Menu", Slug: "main-menu"})
+	menu, err := queries.CreateMenu(context.Background(), store.CreateMenuParams{Name: "Main Menu", Slug: "main-menu", SiteID: store.DefaultSiteID})
 	if err != nil {
 		t.Fatalf("CreateMenu failed: %v", err)
 	}
@@ -51,8 +52,9 @@ func TestMenuList(t *testing.T) {
 	menus := []string{"header", "footer", "sidebar"}
 	for _, slug := range menus {
 		_, err := queries.CreateMenu(context.Background(), store.CreateMenuParams{
-			Name: slug + " Menu",
-			Slug: slug,
+			Name:   slug + " Menu",
+			Slug:   slug,
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreateMenu failed: %v", err)
@@ -75,8 +77,9 @@ func TestMenuGetBySlug(t *testing.T) {
 	queries := store.New(db)
 
 	_, err := queries.CreateMenu(context.Background(), store.CreateMenuParams{
-		Name: "Test Menu",
-		Slug: "test-menu",
+		Name:   "Test Menu",
+		Slug:   "test-menu",
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMenu failed: %v", err)
@@ -98,7 +101,7 @@ func TestMenuUpdate(t *testing.T) {
 	ctx := context.Background()
 
 	// Setup: create original menu
-	original, err := queries.CreateMenu(ctx, store.CreateMenuParams{Name: "Original Menu", Slug: "original-menu"})
+	original, err := queries.CreateMenu(ctx, store.CreateMenuParams{Name: "Original Menu", Slug: "original-menu", SiteID: store.DefaultSiteID})
 	if err != nil {
 		t.Fatalf("CreateMenu: %v", err)
 	}
@@ -125,7 +128,7 @@ func TestMenuDelete(t *testing.T) {
 	ctx := context.Background()
 
 	// Setup: create a menu to delete
-	toDelete, err := queries.CreateMenu(ctx, store.CreateMenuParams{Name: "To Delete", Slug: "to-delete"})
+	toDelete, err := queries.CreateMenu(ctx, store.CreateMenuParams{Name: "To Delete", Slug: "to-delete", SiteID: store.DefaultSiteID})
 	if err != nil {
 		t.Fatalf("CreateMenu: %v", err)
 	}
@@ -150,8 +153,9 @@ func TestMenuItemCreate(t *testing.T) {
 	now := time.Now()
 
 	menu, err := queries.CreateMenu(context.Background(), store.CreateMenuParams{
-		Name: "Item Test Menu",
-		Slug: "item-test-menu",
+		Name:   "Item Test Menu",
+		Slug:   "item-test-menu",
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMenu failed: %v", err)
@@ -187,8 +191,9 @@ func TestMenuItemWithParent(t *testing.T) {
 	now := time.Now()
 
 	menu, err := queries.CreateMenu(context.Background(), store.CreateMenuParams{
-		Name: "Nested Menu",
-		Slug: "nested-menu",
+		Name:   "Nested Menu",
+		Slug:   "nested-menu",
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMenu failed: %v", err)
@@ -237,8 +242,9 @@ func TestMenuItemList(t *testing.T) {
 	now := time.Now()
 
 	menu, err := queries.CreateMenu(context.Background(), store.CreateMenuParams{
-		Name: "List Items Menu",
-		Slug: "list-items-menu",
+		Name:   "List Items Menu",
+		Slug:   "list-items-menu",
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMenu failed: %v", err)
@@ -280,8 +286,9 @@ func TestMenuItemUpdate(t *testing.T) {
 	now := time.Now()
 
 	menu, err := queries.CreateMenu(context.Background(), store.CreateMenuParams{
-		Name: "Update Item Menu",
-		Slug: "update-item-menu",
+		Name:   "Update Item Menu",
+		Slug:   "update-item-menu",
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMenu failed: %v", err)
@@ -333,8 +340,9 @@ func TestMenuItemDelete(t *testing.T) {
 	now := time.Now()
 
 	menu, err := queries.CreateMenu(context.Background(), store.CreateMenuParams{
-		Name: "Delete Item Menu",
-		Slug: "delete-item-menu",
+		Name:   "Delete Item Menu",
+		Slug:   "delete-item-menu",
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMenu failed: %v", err)
@@ -410,13 +418,77 @@ func TestValidateMenuItemInputAcceptsSafeURLs(t *testing.T) {
 	}
 }
 
+func TestValidateMenuItemInputTypedLinks(t *testing.T) {
+	categoryID := int64(7)
+	recorder := httptest.NewRecorder()
+
+	validated, ok := validateMenuItemInput(recorder, menuItemInput{
+		Title:  "News",
+		Target: "_self",
+		URL:    "/stale",
+		Options: menuItemOptions{
+			ItemType:         model.MenuItemCategory,
+			LinkID:           &categoryID,
+			Visibility:       model.MenuVisibilityRoles,
+			VisibleRoles:     []string{model.RoleEditor, "superuser"},
+			VisibleLanguages: []string{"fr", "en", "fr"},
+			ImageURL:         "/uploads/news.jpg",
+		},
+	})
+	if !ok {
+		t.Fatalf("expected validation success, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+	if validated.URL.Valid {
+		t.Errorf("validated URL = %#v, want none for a category item", validated.URL)
+	}
+	if !validated.LinkID.Valid || validated.LinkID.Int64 != categoryID {
+		t.Errorf("validated LinkID = %#v, want %d", validated.LinkID, categoryID)
+	}
+	if validated.VisibleRoles != model.RoleEditor {
+		t.Errorf("validated VisibleRoles = %q, want %q", validated.VisibleRoles, model.RoleEditor)
+	}
+	if validated.VisibleLanguages != "fr,en" {
+		t.Errorf("validated VisibleLanguages = %q, want fr,en", validated.VisibleLanguages)
+	}
+}
+
+func TestValidateMenuItemInputRejectsInvalidOptions(t *testing.T) {
+	testCases := map[string]menuItemOptions{
+		"unknown type":        {ItemType: "widget"},
+		"missing link target": {ItemType: model.MenuItemTag},
+		"unknown visibility":  {Visibility: "friends"},
+		"roles without roles": {Visibility: model.MenuVisibilityRoles},
+		"bad language":        {VisibleLanguages: []string{"../en"}},
+		"unsafe image":        {ImageURL: "javascript:alert(1)"},
+	}
+
+	for name, options := range testCases {
+		t.Run(name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			_, ok := validateMenuItemInput(recorder, menuItemInput{
+				Title:   "Link",
+				Target:  "_self",
+				URL:     "/docs",
+				Options: options,
+			})
+			if ok {
+				t.Fatal("expected validation to fail")
+			}
+			if recorder.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want %d", recorder.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
 func TestMenuSlugExists(t *testing.T) {
 	db, _ := testHandlerSetup(t)
 	queries := store.New(db)
 	ctx := context.Background()
 
 	// Create menu to test against
-	_, err := queries.CreateMenu(ctx, store.CreateMenuParams{Name: "Existing Menu", Slug: "existing-menu"})
+	_, err := queries.CreateMenu(ctx, store.CreateMenuParams{Name: "Existing Menu", Slug: "existing-menu", SiteID: store.DefaultSiteID})
 	if err != nil {
 		t.Fatalf("setup CreateMenu: %v", err)
 	}
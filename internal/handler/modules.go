@@ -13,10 +13,12 @@ import (
 
 	"github.com/olegiv/ocms-go/internal/i18n"
 	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/module"
 	"github.com/olegiv/ocms-go/internal/render"
 	"github.com/olegiv/ocms-go/internal/store"
 	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+	"github.com/olegiv/ocms-go/internal/webhook"
 )
 
 // ModulesHandler handles module management routes.
@@ -26,6 +28,12 @@ type ModulesHandler struct {
 	sessionManager *scs.SessionManager
 	registry       *module.Registry
 	hooks          *module.HookRegistry
+	dispatcher     *webhook.Dispatcher
+}
+
+// SetDispatcher sets the webhook dispatcher for event dispatching.
+func (h *ModulesHandler) SetDispatcher(d *webhook.Dispatcher) {
+	h.dispatcher = d
 }
 
 // NewModulesHandler creates a new ModulesHandler.
@@ -86,9 +94,10 @@ type ToggleSidebarResponse struct {
 
 // moduleToggleParams holds parameters for a generic module toggle operation.
 type moduleToggleParams struct {
-	fieldName string                            // JSON response field name (e.g., "active", "show")
-	logMsg    string                            // Log message (e.g., "module active status toggled")
-	setFn     func(name string, val bool) error // Registry setter function
+	fieldName string                                       // JSON response field name (e.g., "active", "show")
+	logMsg    string                                       // Log message (e.g., "module active status toggled")
+	setFn     func(name string, val bool) error            // Registry setter function
+	afterSet  func(r *http.Request, name string, val bool) // Optional callback after a successful set
 }
 
 // handleModuleToggle performs a generic toggle operation for a module boolean field.
@@ -124,6 +133,9 @@ func (h *ModulesHandler) handleModuleToggle(w http.ResponseWriter, r *http.Reque
 	}
 
 	slog.Info(p.logMsg, "module", moduleName, p.fieldName, value)
+	if p.afterSet != nil {
+		p.afterSet(r, moduleName, value)
+	}
 	writeJSONSuccess(w, map[string]any{p.fieldName: value})
 }
 
@@ -139,9 +151,23 @@ func (h *ModulesHandler) ToggleActive(w http.ResponseWriter, r *http.Request) {
 		fieldName: "active",
 		logMsg:    "module active status toggled",
 		setFn:     h.registry.SetActive,
+		afterSet:  h.dispatchModuleEvent,
 	})
 }
 
+// dispatchModuleEvent dispatches module.activated or module.deactivated.
+func (h *ModulesHandler) dispatchModuleEvent(r *http.Request, name string, active bool) {
+	eventType := model.EventModuleDeactivated
+	if active {
+		eventType = model.EventModuleActivated
+	}
+	dispatchWebhookEvent(r.Context(), h.dispatcher, eventType, webhook.ModuleEventData{
+		Name:      name,
+		Active:    active,
+		ChangedBy: middleware.GetUserID(r),
+	}, "module", name)
+}
+
 // ToggleSidebar handles POST /admin/modules/{name}/toggle-sidebar - toggles module sidebar visibility.
 func (h *ModulesHandler) ToggleSidebar(w http.ResponseWriter, r *http.Request) {
 	// Block in demo mode
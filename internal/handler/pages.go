@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"net/http"
 	"strconv"
 	"strings"
@@ -18,13 +19,16 @@ import (
 	"github.com/go-chi/chi/v5"
 
 	"github.com/olegiv/ocms-go/internal/cache"
+	"github.com/olegiv/ocms-go/internal/contenttype"
 	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/machinetranslation"
 	"github.com/olegiv/ocms-go/internal/middleware"
 	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/render"
 	"github.com/olegiv/ocms-go/internal/security"
 	"github.com/olegiv/ocms-go/internal/service"
 	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/theme"
 	"github.com/olegiv/ocms-go/internal/util"
 	"github.com/olegiv/ocms-go/internal/video"
 	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
@@ -88,6 +92,9 @@ type PagesHandler struct {
 	blockSuspiciousMarkup bool
 	sanitizePageHTML      bool
 	videoRegistry         *video.Registry
+	themeManager          *theme.Manager
+	urlHistory            *URLHistory
+	translator            *machinetranslation.Translator
 }
 
 // NewPagesHandler creates a new PagesHandler.
@@ -99,12 +106,14 @@ func NewPagesHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionManag
 		sessionManager: sm,
 		eventService:   service.NewEventService(db),
 		videoRegistry:  video.NewRegistry(),
+		urlHistory:     NewURLHistory(db),
 	}
 }
 
 // SetDispatcher sets the webhook dispatcher for event dispatching.
 func (h *PagesHandler) SetDispatcher(d *webhook.Dispatcher) {
 	h.dispatcher = d
+	h.urlHistory.SetDispatcher(d)
 }
 
 // SetCacheManager sets the cache manager for cache invalidation.
@@ -123,6 +132,11 @@ func (h *PagesHandler) SetSanitizePageHTML(sanitize bool) {
 	h.sanitizePageHTML = sanitize
 }
 
+// SetMachineTranslator enables machine translation of new page translations.
+func (h *PagesHandler) SetMachineTranslator(t *machinetranslation.Translator) {
+	h.translator = t
+}
+
 // invalidatePageCache invalidates the page cache after a page is modified.
 func (h *PagesHandler) invalidatePageCache(pageID int64) {
 	if h.cacheManager != nil {
@@ -132,58 +146,32 @@ func (h *PagesHandler) invalidatePageCache(pageID int64) {
 
 // dispatchPageEvent dispatches a page-related webhook event.
 func (h *PagesHandler) dispatchPageEvent(ctx context.Context, eventType string, page store.Page, authorEmail string) {
-	if h.dispatcher == nil {
-		return
-	}
-
-	var languageCode *string
-	if page.LanguageCode != "" {
-		languageCode = new(page.LanguageCode)
-	}
-
-	var publishedAt *string
-	if page.PublishedAt.Valid {
-		publishedAt = new(page.PublishedAt.Time.Format(time.RFC3339))
-	}
-
-	data := webhook.PageEventData{
-		ID:           page.ID,
-		Title:        page.Title,
-		Slug:         page.Slug,
-		Status:       page.Status,
-		AuthorID:     page.AuthorID,
-		AuthorEmail:  authorEmail,
-		LanguageCode: languageCode,
-		PublishedAt:  publishedAt,
-	}
-
-	if err := h.dispatcher.DispatchEvent(ctx, eventType, data); err != nil {
-		slog.Error("failed to dispatch webhook event",
-			"error", err,
-			"event_type", eventType,
-			"page_id", page.ID)
-	}
+	dispatchWebhookEvent(ctx, h.dispatcher, eventType, webhook.NewPageEventData(page, authorEmail), "page_id", page.ID)
 }
 
 // PagesListData holds data for the pages list template.
 type PagesListData struct {
-	Pages              []store.Page
-	PagePublicURLs     map[int64]string             // Canonical public URL by page ID; empty means not publicly routable
-	PageTags           map[int64][]store.Tag        // Map of page ID to tags
-	PageCategories     map[int64][]store.Category   // Map of page ID to categories
-	PageFeaturedImages map[int64]*FeaturedImageData // Map of page ID to featured image
-	PageLanguages      map[int64]*store.Language    // Map of page ID to language
-	TotalCount         int64
-	StatusFilter       string
-	PageTypeFilter     string
-	CategoryFilter     int64
-	LanguageFilter     string             // Language code filter
-	SearchFilter       string             // Search query filter
-	AllCategories      []PageCategoryNode // For category filter dropdown
-	AllLanguages       []store.Language   // All active languages for filter dropdown
-	Statuses           []string
-	PageTypes          []string
-	Pagination         AdminPagination
+	Pages                  []store.Page
+	PagePublicURLs         map[int64]string             // Canonical public URL by page ID; empty means not publicly routable
+	PageTags               map[int64][]store.Tag        // Map of page ID to tags
+	PageCategories         map[int64][]store.Category   // Map of page ID to categories
+	PageFeaturedImages     map[int64]*FeaturedImageData // Map of page ID to featured image
+	PageLanguages          map[int64]*store.Language    // Map of page ID to language
+	PagesOutdated          map[int64]bool               // Translations whose source changed since
+	PagesMachineTranslated map[int64]bool               // Machine translations awaiting review
+	TotalCount             int64
+	StatusFilter           string
+	PageTypeFilter         string
+	CategoryFilter         int64
+	LanguageFilter         string             // Language code filter
+	SearchFilter           string             // Search query filter
+	TranslationFilter      string             // "outdated" for translations needing update
+	AllCategories          []PageCategoryNode // For category filter dropdown
+	AllLanguages           []store.Language   // All active languages for filter dropdown
+	Statuses               []string
+	PageTypes              []string
+	PageTypeLabels         map[string]string // Content type names by slug
+	Pagination             AdminPagination
 }
 
 // List handles GET /admin/pages - displays a paginated list of pages.
@@ -201,8 +189,9 @@ func (h *PagesHandler) List(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get page type filter from query string
+	pageTypeChoices := loadPageTypeChoices(r.Context(), h.queries)
 	pageTypeFilter := r.URL.Query().Get("page_type")
-	if pageTypeFilter != "" && !isValidPageType(pageTypeFilter) {
+	if pageTypeFilter != "" && !pageTypeChoices.isValid(pageTypeFilter) {
 		pageTypeFilter = ""
 	}
 
@@ -214,13 +203,21 @@ func (h *PagesHandler) List(w http.ResponseWriter, r *http.Request) {
 
 	// Get language filter from query string (accepts language code)
 	languageFilter := strings.TrimSpace(r.URL.Query().Get("language"))
+
+	// Get translation filter from query string
+	translationFilter := r.URL.Query().Get("translation")
+	if translationFilter != "outdated" {
+		translationFilter = ""
+	}
 	defaultSortField, defaultSortDir := defaultPagesSort(statusFilter, searchFilter)
 	sortField, sortDir := parseSortParams(r, defaultSortField, defaultSortDir, pagesSortableFields)
 
 	// Build filter params used for both count and list
 	listParams := store.ListPagesSortedParams{
-		SortField: sortField,
-		SortDir:   sortDir,
+		SortField:    sortField,
+		SortDir:      sortDir,
+		OutdatedOnly: translationFilter != "",
+		SiteID:       sql.NullInt64{Int64: store.SiteIDFromContext(r.Context()), Valid: true},
 	}
 
 	if pageTypeFilter != "" {
@@ -325,7 +322,7 @@ func (h *PagesHandler) List(w http.ResponseWriter, r *http.Request) {
 	if defaultErr == nil {
 		for _, p := range pages {
 			if language := pageLanguages[p.ID]; language != nil {
-				pagePublicURLs[p.ID] = publicPagePathWithLanguages(p, *language, defaultLanguage)
+				pagePublicURLs[p.ID] = publicPagePathWithLanguages(p, service.PagePath(r.Context(), h.queries, p.ID, p.Slug), *language, defaultLanguage)
 			}
 		}
 	}
@@ -341,28 +338,53 @@ func (h *PagesHandler) List(w http.ResponseWriter, r *http.Request) {
 	// Load all active languages for filter dropdown
 	allLanguages := ListActiveLanguagesWithFallback(r.Context(), h.queries)
 
+	// Flag the translations whose source changed since they were updated
+	// and the machine translations awaiting review
+	pagesOutdated := make(map[int64]bool)
+	pagesMachineTranslated := make(map[int64]bool)
+	if len(allLanguages) > 1 {
+		outdatedIDs, err := h.queries.ListOutdatedPageTranslationIDs(r.Context())
+		if err != nil {
+			slog.Error("failed to list outdated page translations", "error", err)
+		}
+		for _, id := range outdatedIDs {
+			pagesOutdated[id] = true
+		}
+		mtIDs, err := h.queries.ListMachineTranslatedIDs(r.Context(), model.EntityTypePage)
+		if err != nil {
+			slog.Error("failed to list machine translated pages", "error", err)
+		}
+		for _, id := range mtIDs {
+			pagesMachineTranslated[id] = true
+		}
+	}
+
 	pagination := BuildAdminPagination(page, int(totalCount), perPage, redirectAdminPages, r.URL.Query())
 	pagination.SortField = sortField
 	pagination.SortDir = sortDir
 
 	data := PagesListData{
-		Pages:              pages,
-		PageTags:           pageTags,
-		PageCategories:     pageCategories,
-		PageFeaturedImages: pageFeaturedImages,
-		PageLanguages:      pageLanguages,
-		PagePublicURLs:     pagePublicURLs,
-		TotalCount:         totalCount,
-		StatusFilter:       statusFilter,
-		PageTypeFilter:     pageTypeFilter,
-		CategoryFilter:     categoryFilter,
-		LanguageFilter:     languageFilter,
-		SearchFilter:       searchFilter,
-		AllCategories:      categoryTree,
-		AllLanguages:       allLanguages,
-		Statuses:           ValidPageStatuses,
-		PageTypes:          ValidPageTypes,
-		Pagination:         pagination,
+		Pages:                  pages,
+		PageTags:               pageTags,
+		PageCategories:         pageCategories,
+		PageFeaturedImages:     pageFeaturedImages,
+		PageLanguages:          pageLanguages,
+		PagesOutdated:          pagesOutdated,
+		PagesMachineTranslated: pagesMachineTranslated,
+		PagePublicURLs:         pagePublicURLs,
+		TotalCount:             totalCount,
+		StatusFilter:           statusFilter,
+		PageTypeFilter:         pageTypeFilter,
+		CategoryFilter:         categoryFilter,
+		LanguageFilter:         languageFilter,
+		SearchFilter:           searchFilter,
+		TranslationFilter:      translationFilter,
+		AllCategories:          categoryTree,
+		AllLanguages:           allLanguages,
+		Statuses:               ValidPageStatuses,
+		PageTypes:              pageTypeChoices.Types,
+		PageTypeLabels:         pageTypeChoices.Labels,
+		Pagination:             pagination,
 	}
 
 	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "pages.title"), pagesBreadcrumbs(lang))
@@ -394,17 +416,46 @@ type PageFormData struct {
 	AllCategories []PageCategoryNode // All categories for selection (with tree structure)
 	FeaturedImage *FeaturedImageData
 	OgImage       *FeaturedImageData
-	Aliases       []store.PageAlias // URL aliases for the page
+	Aliases       []store.PageAlias     // URL aliases for the page
+	URLHistory    []PageURLHistoryEntry // Earlier public paths, newest first
 	Statuses      []string
 	PageTypes     []string
 	Errors        map[string]string
 	FormValues    map[string]string
 	IsEdit        bool
+	// Page hierarchy
+	ParentOptions []PageParentOption // Pages of the same language selectable as parent
+	// Series and related pages
+	SeriesOptions  []store.PageSeries // Series of the same language
+	RelatedOptions []PageParentOption // Pages selectable as related pages
+	RelatedPageIDs []int64            // Picked related pages in order
+	// Content types
+	PageTypeLabels map[string]string   // Content type names by slug
+	ContentTypes   []store.ContentType // Content types whose fields the form renders
+	ContentInput   map[string]any      // Field values of the page's content type
+	PageRefOptions []store.Page        // Pages selectable by page reference fields
+	// Custom fields
+	CustomFieldSchema []contenttype.Field       // Custom fields defined by the active theme
+	CustomFieldInput  []contenttype.CustomField // Stored or submitted custom fields
+	// Block editor
+	EditorMode string // EditorModeHTML or EditorModeBlocks
+	BlocksJSON string // Stored or submitted block document
 	// Language and translation support
 	Language         *store.Language       // Current page language
 	AllLanguages     []store.Language      // All active languages for selection
 	Translations     []PageTranslationInfo // Existing translations
 	MissingLanguages []store.Language      // Languages without translations
+	OutdatedSources  []PageTranslationInfo // Sources changed since the page was translated
+	// Machine translation awaiting review
+	MachineTranslation *store.MachineTranslation
+}
+
+// PageURLHistoryEntry is an earlier public path of a page with the redirect
+// from it, if there still is one.
+type PageURLHistoryEntry struct {
+	Path      string
+	ChangedAt time.Time
+	Redirect  *store.Redirect
 }
 
 // PageTranslationInfo holds information about a page translation.
@@ -434,11 +485,13 @@ func publicPagePath(ctx context.Context, queries *store.Queries, page store.Page
 	if err != nil {
 		return ""
 	}
-	return publicPagePathWithLanguages(page, language, defaultLanguage)
+	return publicPagePathWithLanguages(page, service.PagePath(ctx, queries, page.ID, page.Slug), language, defaultLanguage)
 }
 
-func publicPagePathWithLanguages(page store.Page, language, defaultLanguage store.Language) string {
-	if page.Status != PageStatusPublished || !util.IsValidSlug(page.Slug) ||
+// publicPagePathWithLanguages returns the public URL of a published page at
+// pagePath, its nested path below the language prefix.
+func publicPagePathWithLanguages(page store.Page, pagePath string, language, defaultLanguage store.Language) string {
+	if page.Status != PageStatusPublished || !isValidPagePath(pagePath) ||
 		!language.IsActive || language.Code != page.LanguageCode ||
 		!util.IsValidLangCode(language.Code) || util.IsReservedLanguageCode(language.Code) {
 		return ""
@@ -447,7 +500,7 @@ func publicPagePathWithLanguages(page store.Page, language, defaultLanguage stor
 	if language.ID != defaultLanguage.ID || language.Code != defaultLanguage.Code {
 		prefix = "/" + language.Code
 	}
-	return prefix + "/" + page.Slug
+	return prefix + "/" + pagePath
 }
 
 // buildPageCategoryTree builds a flat list with depth for display.
@@ -522,12 +575,22 @@ func (h *PagesHandler) NewForm(w http.ResponseWriter, r *http.Request) {
 		AllLanguages:  allLanguages,
 		Language:      defaultLanguage,
 		Statuses:      ValidPageStatuses,
-		PageTypes:     ValidPageTypes,
 		Errors:        make(map[string]string),
 		FormValues:    make(map[string]string),
 		IsEdit:        false,
 	}
 
+	// Preselect the page type when creating an entry of a content type
+	choices := loadPageTypeChoices(r.Context(), h.queries)
+	if pageType := r.URL.Query().Get("page_type"); pageType != "" && choices.isValid(pageType) {
+		data.FormValues["page_type"] = pageType
+	}
+	h.applyPageTypeChoices(r.Context(), &data, choices, nil)
+	h.applyCustomFields(r.Context(), &data, nil)
+	applyPageBlocks(&data, false, "")
+	h.applyPageParent(r.Context(), &data, pageFormParentLanguage(data), 0)
+	h.applyPageRelations(r.Context(), &data, pageFormParentLanguage(data), 0)
+
 	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "pages.new"), pagesNewBreadcrumbs(lang))
 	viewData := convertPageFormViewData(data, h.renderer, lang)
 	renderTempl(w, r, adminviews.PageFormPage(pc, viewData))
@@ -576,6 +639,15 @@ func (h *PagesHandler) Create(w http.ResponseWriter, r *http.Request) {
 	if errMsg := h.validatePageSlugCreate(r.Context(), input.Slug); errMsg != "" {
 		validationErrors["slug"] = errMsg
 	}
+	parentID, parentErr := h.parsePageParent(r.Context(), input.FormValues["parent_id"], 0, input.LanguageCode)
+	if parentErr != "" {
+		validationErrors["parent_id"] = parentErr
+	}
+	seriesID, seriesErr := h.parsePageSeries(r.Context(), input.FormValues["series_id"], input.LanguageCode)
+	if seriesErr != "" {
+		validationErrors["series_id"] = seriesErr
+	}
+	relatedIDs := parseRelatedPageIDs(r.Form["related_pages[]"], 0)
 
 	// Status validation
 	if input.Status == "" {
@@ -586,15 +658,32 @@ func (h *PagesHandler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Page type validation (already defaulted in parsePageFormInput)
-	if !isValidPageType(input.PageType) {
+	choices := loadPageTypeChoices(r.Context(), h.queries)
+	var contentValues contenttype.Values
+	var contentInput map[string]any
+	contentType := choices.contentType(input.PageType)
+	if !choices.isValid(input.PageType) {
 		validationErrors["page_type"] = "Invalid page type"
+	} else if contentType != nil {
+		var fieldErrors map[string]string
+		contentValues, contentInput, fieldErrors = h.parsePageContentFields(r.Context(), contentType, r.PostForm)
+		maps.Copy(validationErrors, fieldErrors)
 	}
+	customFields, customInput, customErrors := h.parsePageCustomFields(r.Context(), r.PostForm)
+	maps.Copy(validationErrors, customErrors)
 
 	// Featured image size validation
 	if errMsg := h.validateFeaturedImageSize(r.Context(), input.FeaturedImageID, lang); errMsg != "" {
 		validationErrors["featured_image_id"] = errMsg
 	}
+	blocksInput, blocksErr := parsePageBlocks(r.PostForm)
+	if blocksErr != "" {
+		validationErrors["blocks"] = blocksErr
+	}
 	rawBody := input.Body
+	if blocksInput.Enabled {
+		rawBody = blocksInput.Markup
+	}
 	if bodyErr := validatePageBodySecurityPolicy(rawBody, h.blockSuspiciousMarkup); bodyErr != "" {
 		validationErrors["body"] = bodyErr
 	}
@@ -625,11 +714,16 @@ func (h *PagesHandler) Create(w http.ResponseWriter, r *http.Request) {
 			FeaturedImage: h.loadImageData(r.Context(), input.FeaturedImageID),
 			OgImage:       h.loadImageData(r.Context(), input.OgImageID),
 			Statuses:      ValidPageStatuses,
-			PageTypes:     ValidPageTypes,
 			Errors:        validationErrors,
 			FormValues:    input.FormValues,
 			IsEdit:        false,
 		}
+		h.applyPageTypeChoices(r.Context(), &data, choices, contentInput)
+		h.applyCustomFields(r.Context(), &data, customInput)
+		applyPageBlocks(&data, blocksInput.Enabled, blocksInput.Raw)
+		h.applyPageParent(r.Context(), &data, input.LanguageCode, 0)
+		data.RelatedPageIDs = relatedIDs
+		h.applyPageRelations(r.Context(), &data, input.LanguageCode, 0)
 
 		pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "pages.new"), pagesNewBreadcrumbs(lang))
 		viewData := convertPageFormViewData(data, h.renderer, lang)
@@ -646,7 +740,7 @@ func (h *PagesHandler) Create(w http.ResponseWriter, r *http.Request) {
 	if input.Status == PageStatusPublished {
 		publishedAt = sql.NullTime{Time: now, Valid: true}
 	}
-	normalizedBody := h.normalizePageBodyForStorage(rawBody)
+	normalizedBody := h.pageBodyForStorage(rawBody, blocksInput)
 
 	newPage, err := h.createPageGuarded(r.Context(), store.CreatePageParams{
 		Title:             input.Title,
@@ -673,6 +767,7 @@ func (h *PagesHandler) Create(w http.ResponseWriter, r *http.Request) {
 		UpdatedAt:         now,
 		VideoUrl:          input.VideoURL,
 		VideoTitle:        input.VideoTitle,
+		SiteID:            store.SiteIDFromContext(r.Context()),
 	})
 	if errors.Is(err, errPageRouteTaken) {
 		// A language claimed the prefix between validation and this write.
@@ -688,21 +783,33 @@ func (h *PagesHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	// Create initial version
 	_, err = h.queries.CreatePageVersion(r.Context(), store.CreatePageVersionParams{
-		PageID:    newPage.ID,
-		Title:     input.Title,
-		Body:      normalizedBody,
-		ChangedBy: userID,
-		CreatedAt: now,
+		PageID:       newPage.ID,
+		Title:        input.Title,
+		Body:         normalizedBody,
+		ChangedBy:    userID,
+		CreatedAt:    now,
+		CustomFields: customFields.JSON(),
+		Blocks:       blocksInput.stored(),
 	})
 	if err != nil {
 		slog.Error("failed to create page version", "error", err)
 		// Page was created but version failed - log but don't fail the request
 	}
 
-	// Save tags, categories, and aliases
+	// Save tags, categories, aliases, custom fields, blocks and content fields
 	h.savePageTags(r.Context(), newPage.ID, r.Form["tags[]"])
 	h.savePageCategories(r.Context(), newPage.ID, r.Form["categories[]"])
 	h.savePageAliases(r.Context(), newPage.ID, r.Form["aliases[]"])
+	h.savePageCustomFields(r.Context(), newPage.ID, customFields)
+	h.savePageBlocks(r.Context(), newPage.ID, blocksInput.stored())
+	h.savePageParent(r.Context(), newPage.ID, parentID)
+	h.savePageSeries(r.Context(), newPage.ID, seriesID)
+	h.saveRelatedPages(r.Context(), newPage.ID, newPage.LanguageCode, relatedIDs)
+	h.savePageCommentSetting(r.Context(), newPage.ID, input.FormValues["comments"])
+	h.savePageAccess(r.Context(), newPage.ID, input.FormValues["access"], input.FormValues["access_roles"])
+	if contentType != nil {
+		h.savePageContentFields(r.Context(), newPage.ID, contentType, contentValues)
+	}
 
 	slog.Info("page created", "page_id", newPage.ID, "slug", newPage.Slug, "created_by", middleware.GetUserID(r))
 	_ = h.eventService.LogPageEvent(r.Context(), model.EventLevelInfo, "Page created", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"page_id": newPage.ID, "slug": newPage.Slug})
@@ -727,16 +834,6 @@ func isValidPageStatus(status string) bool {
 	return false
 }
 
-// isValidPageType checks if a page type is valid.
-func isValidPageType(pageType string) bool {
-	for _, t := range ValidPageTypes {
-		if t == pageType {
-			return true
-		}
-	}
-	return false
-}
-
 // EditForm handles GET /admin/pages/{id} - displays the edit page form.
 func (h *PagesHandler) EditForm(w http.ResponseWriter, r *http.Request) {
 	adminLang := h.renderer.GetAdminLang(r)
@@ -802,11 +899,19 @@ func (h *PagesHandler) EditForm(w http.ResponseWriter, r *http.Request) {
 		Translations:     langInfo.Translations,
 		MissingLanguages: langInfo.MissingLanguages,
 		Statuses:         ValidPageStatuses,
-		PageTypes:        ValidPageTypes,
 		Errors:           make(map[string]string),
 		FormValues:       make(map[string]string),
 		IsEdit:           true,
 	}
+	h.applyPageTypeChoices(r.Context(), &data, loadPageTypeChoices(r.Context(), h.queries), loadPageContentValues(r.Context(), h.queries, id))
+	h.applyCustomFields(r.Context(), &data, loadPageCustomFields(r.Context(), h.queries, id))
+	storedBlocks := loadPageBlocks(r.Context(), h.queries, id)
+	applyPageBlocks(&data, storedBlocks != "", storedBlocks)
+	h.applyPageParent(r.Context(), &data, page.LanguageCode, id)
+	h.applyPageRelations(r.Context(), &data, page.LanguageCode, id)
+	h.applyPageFreshness(r.Context(), &data, id)
+	h.applyPageURLHistory(r.Context(), &data, id)
+	data.MachineTranslation = loadMachineTranslation(r.Context(), h.queries, model.EntityTypePage, id)
 
 	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(adminLang, "pages.edit"), pagesEditBreadcrumbs(adminLang, page.Title, page.ID))
 	viewData := convertPageFormViewData(data, h.renderer, adminLang)
@@ -855,6 +960,15 @@ func (h *PagesHandler) Update(w http.ResponseWriter, r *http.Request) {
 	if slugErr := h.validatePageSlugUpdate(r.Context(), input.Slug, existingPage.Slug, id); slugErr != "" {
 		validationErrors["slug"] = slugErr
 	}
+	parentID, parentErr := h.parsePageParent(r.Context(), input.FormValues["parent_id"], id, existingPage.LanguageCode)
+	if parentErr != "" {
+		validationErrors["parent_id"] = parentErr
+	}
+	seriesID, seriesErr := h.parsePageSeries(r.Context(), input.FormValues["series_id"], existingPage.LanguageCode)
+	if seriesErr != "" {
+		validationErrors["series_id"] = seriesErr
+	}
+	relatedIDs := parseRelatedPageIDs(r.Form["related_pages[]"], id)
 
 	// Status validation
 	status := input.Status
@@ -866,9 +980,20 @@ func (h *PagesHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Page type validation (already defaulted in parsePageFormInput)
-	if !isValidPageType(input.PageType) {
+	choices := loadPageTypeChoices(r.Context(), h.queries)
+	var contentValues contenttype.Values
+	var contentInput map[string]any
+	contentType := choices.contentType(input.PageType)
+	// Pages keep a page type whose content type was renamed or removed meanwhile
+	if !choices.isValid(input.PageType) && input.PageType != existingPage.PageType {
 		validationErrors["page_type"] = "Invalid page type"
+	} else if contentType != nil {
+		var fieldErrors map[string]string
+		contentValues, contentInput, fieldErrors = h.parsePageContentFields(r.Context(), contentType, r.PostForm)
+		maps.Copy(validationErrors, fieldErrors)
 	}
+	customFields, customInput, customErrors := h.parsePageCustomFields(r.Context(), r.PostForm)
+	maps.Copy(validationErrors, customErrors)
 
 	// Featured image size validation (skip if image unchanged to grandfather existing data)
 	if input.FeaturedImageID != existingPage.FeaturedImageID {
@@ -876,7 +1001,14 @@ func (h *PagesHandler) Update(w http.ResponseWriter, r *http.Request) {
 			validationErrors["featured_image_id"] = errMsg
 		}
 	}
+	blocksInput, blocksErr := parsePageBlocks(r.PostForm)
+	if blocksErr != "" {
+		validationErrors["blocks"] = blocksErr
+	}
 	rawBody := input.Body
+	if blocksInput.Enabled {
+		rawBody = blocksInput.Markup
+	}
 	if bodyErr := validatePageBodySecurityPolicy(rawBody, h.blockSuspiciousMarkup); bodyErr != "" {
 		validationErrors["body"] = bodyErr
 	}
@@ -905,11 +1037,19 @@ func (h *PagesHandler) Update(w http.ResponseWriter, r *http.Request) {
 			FeaturedImage: h.loadImageData(r.Context(), input.FeaturedImageID),
 			OgImage:       h.loadImageData(r.Context(), input.OgImageID),
 			Statuses:      ValidPageStatuses,
-			PageTypes:     ValidPageTypes,
 			Errors:        validationErrors,
 			FormValues:    input.FormValues,
 			IsEdit:        true,
 		}
+		h.applyPageTypeChoices(r.Context(), &data, choices, contentInput)
+		h.applyCustomFields(r.Context(), &data, customInput)
+		applyPageBlocks(&data, blocksInput.Enabled, blocksInput.Raw)
+		h.applyPageParent(r.Context(), &data, existingPage.LanguageCode, id)
+		data.RelatedPageIDs = relatedIDs
+		h.applyPageRelations(r.Context(), &data, existingPage.LanguageCode, id)
+		h.applyPageFreshness(r.Context(), &data, id)
+		h.applyPageURLHistory(r.Context(), &data, id)
+		data.MachineTranslation = loadMachineTranslation(r.Context(), h.queries, model.EntityTypePage, id)
 
 		pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "pages.edit"), pagesEditBreadcrumbs(lang, existingPage.Title, id))
 		viewData := convertPageFormViewData(data, h.renderer, lang)
@@ -917,8 +1057,11 @@ func (h *PagesHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Update page
+	// Update page; the public paths of the language are compared after a
+	// slug change or move to redirect the old URLs of the page and its
+	// subpages
 	now := time.Now()
+	oldURLs := h.urlHistory.Capture(r.Context(), false, existingPage.LanguageCode)
 
 	// Determine published_at: unpublishing always clears it, then respect user input
 	var publishedAt sql.NullTime
@@ -936,7 +1079,7 @@ func (h *PagesHandler) Update(w http.ResponseWriter, r *http.Request) {
 		// Preserve existing
 		publishedAt = existingPage.PublishedAt
 	}
-	normalizedBody := h.normalizePageBodyForStorage(rawBody)
+	normalizedBody := h.pageBodyForStorage(rawBody, blocksInput)
 
 	updatedPage, err := h.updatePageGuarded(r.Context(), store.UpdatePageParams{
 		ID:                id,
@@ -983,14 +1126,19 @@ func (h *PagesHandler) Update(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
-	// Create new version (only if title or body changed)
-	if input.Title != existingPage.Title || normalizedBody != existingPage.Body {
+	// Create new version (only if title, body, custom fields or blocks changed)
+	existingCustomFields := loadPageCustomFields(r.Context(), h.queries, id)
+	existingBlocks := loadPageBlocks(r.Context(), h.queries, id)
+	if input.Title != existingPage.Title || normalizedBody != existingPage.Body ||
+		!customFields.Equal(existingCustomFields) || blocksInput.stored() != existingBlocks {
 		_, err = h.queries.CreatePageVersion(r.Context(), store.CreatePageVersionParams{
-			PageID:    id,
-			Title:     input.Title,
-			Body:      normalizedBody,
-			ChangedBy: middleware.GetUserID(r),
-			CreatedAt: now,
+			PageID:       id,
+			Title:        input.Title,
+			Body:         normalizedBody,
+			ChangedBy:    middleware.GetUserID(r),
+			CreatedAt:    now,
+			CustomFields: customFields.JSON(),
+			Blocks:       blocksInput.stored(),
 		})
 		if err != nil {
 			slog.Error("failed to create page version", "error", err, "page_id", id)
@@ -1016,6 +1164,23 @@ func (h *PagesHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 	h.savePageAliases(r.Context(), id, r.Form["aliases[]"])
 
+	h.savePageCustomFields(r.Context(), id, customFields)
+	h.savePageBlocks(r.Context(), id, blocksInput.stored())
+	if h.savePageParent(r.Context(), id, parentID) || updatedPage.Slug != existingPage.Slug {
+		h.redirectMovedPages(r.Context(), existingPage.LanguageCode, oldURLs)
+	}
+	h.savePageSeries(r.Context(), id, seriesID)
+	h.saveRelatedPages(r.Context(), id, existingPage.LanguageCode, relatedIDs)
+	h.savePageCommentSetting(r.Context(), id, input.FormValues["comments"])
+	h.savePageAccess(r.Context(), id, input.FormValues["access"], input.FormValues["access_roles"])
+	h.savePageFreshness(r.Context(), id, input.FormValues["translation_current"])
+	confirmMachineTranslation(r.Context(), h.queries, model.EntityTypePage, id, input.FormValues["mt_reviewed"])
+
+	// Content fields belong to the page type; a built-in type drops them
+	if contentType != nil || choices.isValid(input.PageType) {
+		h.savePageContentFields(r.Context(), id, contentType, contentValues)
+	}
+
 	slog.Info("page updated", "page_id", updatedPage.ID, "slug", updatedPage.Slug, "updated_by", middleware.GetUserID(r))
 	_ = h.eventService.LogPageEvent(r.Context(), model.EventLevelInfo, "Page updated", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"page_id": updatedPage.ID, "slug": updatedPage.Slug})
 
@@ -1323,12 +1488,21 @@ func (h *PagesHandler) RestoreVersion(w http.ResponseWriter, r *http.Request) {
 		flashError(w, r, h.renderer, versionsURL, "Version does not belong to this page")
 		return
 	}
+	// Block versions are re-rendered from their document
+	restoredBlocks, blocksErr := storedPageBlocks(version.Blocks)
+	if blocksErr != "" {
+		flashError(w, r, h.renderer, versionsURL, "Version has an invalid block document")
+		return
+	}
 	rawVersionBody := version.Body
+	if restoredBlocks.Enabled {
+		rawVersionBody = restoredBlocks.Markup
+	}
 	if bodyErr := validatePageBodySecurityPolicy(rawVersionBody, h.blockSuspiciousMarkup); bodyErr != "" {
 		flashError(w, r, h.renderer, versionsURL, bodyErr)
 		return
 	}
-	normalizedBody := h.normalizePageBodyForStorage(rawVersionBody)
+	normalizedBody := h.pageBodyForStorage(rawVersionBody, restoredBlocks)
 
 	// Update page with version content (keeping SEO fields and scheduling intact)
 	now := time.Now()
@@ -1359,15 +1533,20 @@ func (h *PagesHandler) RestoreVersion(w http.ResponseWriter, r *http.Request) {
 		flashError(w, r, h.renderer, versionsURL, "Error restoring version")
 		return
 	}
+	restoredCustomFields := contenttype.ParseCustomFields(version.CustomFields)
+	h.savePageCustomFields(r.Context(), id, restoredCustomFields)
+	h.savePageBlocks(r.Context(), id, restoredBlocks.stored())
 	h.invalidatePageCache(id)
 
 	// Create new version to record the restore
 	_, err = h.queries.CreatePageVersion(r.Context(), store.CreatePageVersionParams{
-		PageID:    id,
-		Title:     version.Title,
-		Body:      normalizedBody,
-		ChangedBy: middleware.GetUserID(r),
-		CreatedAt: now,
+		PageID:       id,
+		Title:        version.Title,
+		Body:         normalizedBody,
+		ChangedBy:    middleware.GetUserID(r),
+		CreatedAt:    now,
+		CustomFields: restoredCustomFields.JSON(),
+		Blocks:       restoredBlocks.stored(),
 	})
 	if err != nil {
 		slog.Error("failed to create page version after restore", "error", err, "page_id", id)
@@ -1412,7 +1591,7 @@ func (h *PagesHandler) Translate(w http.ResponseWriter, r *http.Request) {
 
 	// Generate a unique slug for the translated page
 	translatedSlug, err := generateUniqueSlug(sourcePage.Slug, langCode, func(slug string) (int64, error) {
-		return h.queries.SlugExists(r.Context(), slug)
+		return h.queries.SlugExists(r.Context(), store.SlugExistsParams{Slug: slug, SiteID: sourcePage.SiteID})
 	})
 	if err != nil {
 		slog.Error("database error checking slug", "error", err)
@@ -1420,20 +1599,33 @@ func (h *PagesHandler) Translate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Create the translated page with same title but empty body
+	// Create the translated page with same title but empty body, unless a
+	// machine translation provider pre-fills the content
+	params := store.CreatePageParams{Title: sourcePage.Title}
+	mt := machineTranslate(r.Context(), h.translator, sourcePage.LanguageCode, tc.TargetLang.Code,
+		machinetranslation.Text(sourcePage.Title),
+		machinetranslation.HTML(sourcePage.Body),
+		machinetranslation.Text(sourcePage.Summary),
+		machinetranslation.Text(sourcePage.MetaTitle),
+		machinetranslation.Text(sourcePage.MetaDescription),
+		machinetranslation.Text(sourcePage.MetaKeywords))
+	if mt != nil {
+		params.Title, params.Body, params.Summary = mt[0], h.normalizePageBodyForStorage(mt[1]), mt[2]
+		params.MetaTitle, params.MetaDescription, params.MetaKeywords = mt[3], mt[4], mt[5]
+	}
 	now := time.Now()
 	userID := middleware.GetUserID(r)
 	translatedPage, err := h.queries.CreatePage(r.Context(), store.CreatePageParams{
-		Title:             sourcePage.Title, // Keep same title (user will translate)
+		Title:             params.Title,
 		Slug:              translatedSlug,
-		Body:              "",              // Empty body for translation
-		Summary:           "",              // Empty summary for translation
+		Body:              params.Body,
+		Summary:           params.Summary,
 		Status:            PageStatusDraft, // Always start as draft
 		AuthorID:          userID,
 		FeaturedImageID:   sourcePage.FeaturedImageID,
-		MetaTitle:         "",
-		MetaDescription:   "",
-		MetaKeywords:      "",
+		MetaTitle:         params.MetaTitle,
+		MetaDescription:   params.MetaDescription,
+		MetaKeywords:      params.MetaKeywords,
 		OgImageID:         sql.NullInt64{},
 		NoIndex:           0,
 		NoFollow:          0,
@@ -1448,6 +1640,7 @@ func (h *PagesHandler) Translate(w http.ResponseWriter, r *http.Request) {
 		UpdatedAt:         now,
 		VideoUrl:          sourcePage.VideoUrl,   // Inherit video from source
 		VideoTitle:        sourcePage.VideoTitle, // Inherit video title from source
+		SiteID:            sourcePage.SiteID,     // A translation belongs to the site of its source
 	})
 	if err != nil {
 		slog.Error("failed to create translated page", "error", err)
@@ -1455,19 +1648,35 @@ func (h *PagesHandler) Translate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Inherit custom fields; their values are translated on the new page
+	customFields := loadPageCustomFields(r.Context(), h.queries, id)
+	h.savePageCustomFields(r.Context(), translatedPage.ID, customFields)
+
 	// Create initial version for the translated page
 	_, err = h.queries.CreatePageVersion(r.Context(), store.CreatePageVersionParams{
-		PageID:    translatedPage.ID,
-		Title:     translatedPage.Title,
-		Body:      translatedPage.Body,
-		ChangedBy: userID,
-		CreatedAt: now,
+		PageID:       translatedPage.ID,
+		Title:        translatedPage.Title,
+		Body:         translatedPage.Body,
+		ChangedBy:    userID,
+		CreatedAt:    now,
+		CustomFields: customFields.JSON(),
 	})
 	if err != nil {
 		slog.Error("failed to create page version for translation", "error", err)
 		// Don't fail - page was created
 	}
 
+	// Inherit content field values along with the page type
+	if source, err := h.queries.GetPageContentFields(r.Context(), id); err == nil {
+		if err := h.queries.UpsertPageContentFields(r.Context(), store.UpsertPageContentFieldsParams{
+			PageID:    translatedPage.ID,
+			Data:      source.Data,
+			UpdatedAt: now,
+		}); err != nil {
+			slog.Error("failed to copy content fields to translation", "error", err, "page_id", translatedPage.ID)
+		}
+	}
+
 	// Create translation link from source to translated page
 	_, err = h.queries.CreateTranslation(r.Context(), store.CreateTranslationParams{
 		EntityType:    model.EntityTypePage,
@@ -1479,10 +1688,20 @@ func (h *PagesHandler) Translate(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		slog.Error("failed to create translation link", "error", err)
 		// Page was created, so we should still redirect to it
-	} else if h.cacheManager != nil {
-		h.cacheManager.Translation.InvalidateType(model.EntityTypePage)
+	} else {
+		if h.cacheManager != nil {
+			h.cacheManager.Translation.InvalidateType(model.EntityTypePage)
+		}
+		dispatchTranslationLinked(r.Context(), h.dispatcher, model.EntityTypePage, id, translatedPage.ID, langCode)
 	}
 
+	if mt != nil {
+		markMachineTranslated(r.Context(), h.queries, h.translator, model.EntityTypePage, translatedPage.ID)
+	}
+
+	// The translation is a new page in its own right
+	h.dispatchPageEvent(r.Context(), model.EventPageCreated, translatedPage, middleware.GetUserEmail(r))
+
 	slog.Info("page translation created",
 		"source_page_id", id,
 		"translated_page_id", translatedPage.ID,
@@ -1490,7 +1709,55 @@ func (h *PagesHandler) Translate(w http.ResponseWriter, r *http.Request) {
 		"created_by", userID)
 	_ = h.eventService.LogPageEvent(r.Context(), model.EventLevelInfo, "Page translation created", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"source_id": id, "translation_id": translatedPage.ID, "language": langCode})
 
-	flashSuccess(w, r, h.renderer, fmt.Sprintf(redirectAdminPagesID, translatedPage.ID), fmt.Sprintf("Translation created for %s. Please translate the content.", tc.TargetLang.Name))
+	flashSuccess(w, r, h.renderer, fmt.Sprintf(redirectAdminPagesID, translatedPage.ID), machineTranslationFlash(mt != nil, h.translator,
+		fmt.Sprintf("Translation created for %s.", tc.TargetLang.Name), "Please translate the content."))
+}
+
+// UnlinkTranslations handles POST /admin/pages/{id}/unlink-translations -
+// detaches a page from its translation group without deleting any page.
+func (h *PagesHandler) UnlinkTranslations(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentReadOnly, redirectAdminPages) {
+		return
+	}
+
+	id, err := ParseIDParam(r)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminPages, "Invalid page ID")
+		return
+	}
+
+	redirectURL := fmt.Sprintf(redirectAdminPagesID, id)
+	if _, ok := h.requirePageWithRedirect(w, r, id); !ok {
+		return
+	}
+
+	edges, err := detachFromTranslationComponent(r.Context(), h.db, h.queries, model.EntityTypePage, id)
+	if err != nil {
+		slog.Error("failed to unlink page translations", "error", err, "page_id", id)
+		flashError(w, r, h.renderer, redirectURL, "Error unlinking translations")
+		return
+	}
+	if len(edges) == 0 {
+		flashAndRedirect(w, r, h.renderer, redirectURL, "Page is not linked to any translations", "info")
+		return
+	}
+
+	if h.cacheManager != nil {
+		h.cacheManager.Translation.InvalidateType(model.EntityTypePage)
+	}
+	for _, e := range edges {
+		dispatchWebhookEvent(r.Context(), h.dispatcher, model.EventTranslationUnlinked, webhook.TranslationEventData{
+			EntityType:    model.EntityTypePage,
+			EntityID:      e.EntityID,
+			TranslationID: e.TranslationID,
+			LanguageCode:  e.LanguageCode,
+		}, "page_id", id)
+	}
+
+	slog.Info("page translations unlinked", "page_id", id, "links_removed", len(edges), "unlinked_by", middleware.GetUserID(r))
+	_ = h.eventService.LogPageEvent(r.Context(), model.EventLevelInfo, "Page translations unlinked", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"page_id": id, "links_removed": len(edges)})
+
+	flashSuccess(w, r, h.renderer, redirectURL, "Page unlinked from its translations")
 }
 
 // Helper functions
@@ -1498,13 +1765,19 @@ func (h *PagesHandler) Translate(w http.ResponseWriter, r *http.Request) {
 // requirePageWithRedirect fetches page by ID and handles errors with flash messages and redirect.
 func (h *PagesHandler) requirePageWithRedirect(w http.ResponseWriter, r *http.Request, id int64) (store.Page, bool) {
 	return requireEntityWithRedirect(w, r, h.renderer, redirectAdminPages, "Page", id,
-		func(id int64) (store.Page, error) { return h.queries.GetPageByID(r.Context(), id) })
+		func(id int64) (store.Page, error) {
+			page, err := h.queries.GetPageByID(r.Context(), id)
+			return page, siteScopedErr(r.Context(), page.SiteID, err)
+		})
 }
 
 // requirePageWithError fetches page by ID and handles errors with http.Error.
 func (h *PagesHandler) requirePageWithError(w http.ResponseWriter, r *http.Request, id int64) (store.Page, bool) {
 	return requireEntityWithError(w, "Page", id,
-		func(id int64) (store.Page, error) { return h.queries.GetPageByID(r.Context(), id) })
+		func(id int64) (store.Page, error) {
+			page, err := h.queries.GetPageByID(r.Context(), id)
+			return page, siteScopedErr(r.Context(), page.SiteID, err)
+		})
 }
 
 // pageFormInput holds parsed page form input values.
@@ -1646,6 +1919,13 @@ func parsePageFormInput(r *http.Request) pageFormInput {
 		"exclude_from_lists":  excludeFromListsStr,
 		"video_url":           videoURL,
 		"video_title":         videoTitle,
+		"parent_id":           strings.TrimSpace(r.FormValue("parent_id")),
+		"series_id":           strings.TrimSpace(r.FormValue("series_id")),
+		"comments":            r.FormValue("comments"),
+		"access":              r.FormValue("access"),
+		"access_roles":        pageAccessRoles(r.Form["access_roles[]"]),
+		"translation_current": r.FormValue("translation_current"),
+		"mt_reviewed":         r.FormValue("mt_reviewed"),
 	}
 
 	return pageFormInput{
@@ -1688,21 +1968,22 @@ func validatePageTitle(title string) string {
 }
 
 // validatePageSlugCreate validates a page slug for creation.
-// Checks against both existing page slugs and page aliases.
+// Checks against both existing page slugs and page aliases of the current site.
 func (h *PagesHandler) validatePageSlugCreate(ctx context.Context, slug string) string {
 	if conflict := h.languagePrefixConflict(ctx, slug); conflict != "" {
 		return conflict
 	}
 	return ValidateSlugWithChecker(slug, func() (int64, error) {
 		return h.queries.SlugOrAliasExists(ctx, store.SlugOrAliasExistsParams{
-			Slug:  slug,
-			Alias: slug,
+			Slug:   slug,
+			SiteID: store.SiteIDFromContext(ctx),
+			Alias:  slug,
 		})
 	})
 }
 
 // validatePageSlugUpdate validates the page slug for update (checks uniqueness excluding current page).
-// Checks against both existing page slugs and page aliases.
+// Checks against both existing page slugs and page aliases of the current site.
 func (h *PagesHandler) validatePageSlugUpdate(ctx context.Context, slug string, currentSlug string, pageID int64) string {
 	if slug != currentSlug {
 		if conflict := h.languagePrefixConflict(ctx, slug); conflict != "" {
@@ -1713,6 +1994,7 @@ func (h *PagesHandler) validatePageSlugUpdate(ctx context.Context, slug string,
 		return h.queries.SlugOrAliasExistsExcluding(ctx, store.SlugOrAliasExistsExcludingParams{
 			Slug:   slug,
 			ID:     pageID,
+			SiteID: store.SiteIDFromContext(ctx),
 			Alias:  slug,
 			PageID: pageID,
 		})
@@ -0,0 +1,64 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// pageAccessRoleOptions lists the roles the page form can grant access to.
+// Admins may view every page, so they are not offered.
+var pageAccessRoleOptions = []string{model.RoleEditor, model.RolePublic}
+
+// applyPageAccess fills the access choice of the edit form from the stored
+// rule, unless the form was submitted.
+func (h *PagesHandler) applyPageAccess(ctx context.Context, data *PageFormData, pageID int64) {
+	if _, ok := data.FormValues["access"]; ok || pageID == 0 {
+		return
+	}
+	rule := service.PageAccessRule(ctx, h.queries, pageID)
+	data.FormValues["access"] = rule.Access
+	data.FormValues["access_roles"] = rule.Roles
+}
+
+// pageAccessRoles returns the submitted roles of a "roles" access rule,
+// comma-separated, keeping only roles the form offers.
+func pageAccessRoles(submitted []string) string {
+	roles := make([]string, 0, len(submitted))
+	for _, role := range pageAccessRoleOptions {
+		if slices.Contains(submitted, role) {
+			roles = append(roles, role)
+		}
+	}
+	return strings.Join(roles, ",")
+}
+
+// savePageAccess stores the access rule of a page. Public access removes the
+// page's rule.
+func (h *PagesHandler) savePageAccess(ctx context.Context, pageID int64, access, roles string) {
+	var err error
+	switch access {
+	case model.PageAccessMembers, model.PageAccessRoles:
+		if access == model.PageAccessMembers {
+			roles = ""
+		}
+		err = h.queries.UpsertPageAccess(ctx, store.UpsertPageAccessParams{
+			PageID: pageID,
+			Access: access,
+			Roles:  roles,
+		})
+	default:
+		err = h.queries.DeletePageAccess(ctx, pageID)
+	}
+	if err != nil {
+		slog.Error("failed to save page access rule", "error", err, "page_id", pageID)
+	}
+}
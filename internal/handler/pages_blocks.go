@@ -0,0 +1,155 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/blocks"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// Page editor modes.
+const (
+	EditorModeHTML   = "html"
+	EditorModeBlocks = "blocks"
+)
+
+// pageBlocksInput holds the block editor state submitted with the page form.
+type pageBlocksInput struct {
+	Enabled bool            // the page is edited with the block editor
+	Raw     string          // submitted document, for re-rendering the form
+	Doc     blocks.Document // normalized document
+	Markup  string          // submitted inline HTML, before sanitization
+}
+
+// parsePageBlocks reads the editor mode and block document of the page form.
+// The returned message is a validation error for the blocks field.
+func parsePageBlocks(form url.Values) (pageBlocksInput, string) {
+	if form.Get("editor_mode") != EditorModeBlocks {
+		return pageBlocksInput{}, ""
+	}
+	return blocksInputFromDocument(form.Get("blocks"))
+}
+
+// storedPageBlocks reads a block document stored with a page version. An
+// empty document means the version was edited as HTML.
+func storedPageBlocks(raw string) (pageBlocksInput, string) {
+	if raw == "" {
+		return pageBlocksInput{}, ""
+	}
+	return blocksInputFromDocument(raw)
+}
+
+// blocksInputFromDocument parses and normalizes a block document.
+func blocksInputFromDocument(raw string) (pageBlocksInput, string) {
+	in := pageBlocksInput{Enabled: true, Raw: raw}
+	doc, err := blocks.Parse(in.Raw)
+	if err != nil {
+		return in, err.Error()
+	}
+	in.Markup = doc.InlineHTML()
+	if in.Doc, err = blocks.Normalize(doc); err != nil {
+		return in, err.Error()
+	}
+	return in, ""
+}
+
+// stored returns the document as stored in page_blocks and page_versions,
+// or an empty string for pages edited as HTML.
+func (in pageBlocksInput) stored() string {
+	if !in.Enabled {
+		return ""
+	}
+	return in.Doc.JSON()
+}
+
+// pageBodyForStorage returns the body to store for a page. Block pages store
+// the rendered document, which is sanitized block by block already; HTML
+// bodies go through normalizePageBodyForStorage.
+func (h *PagesHandler) pageBodyForStorage(rawBody string, in pageBlocksInput) string {
+	if in.Enabled {
+		return string(blocks.Render(in.Doc))
+	}
+	return h.normalizePageBodyForStorage(rawBody)
+}
+
+// loadPageBlocks returns the stored block document of a page, or an empty
+// string for pages edited as HTML.
+func loadPageBlocks(ctx context.Context, queries *store.Queries, pageID int64) string {
+	row, err := queries.GetPageBlocks(ctx, pageID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("failed to load page blocks", "error", err, "page_id", pageID)
+		}
+		return ""
+	}
+	return row.Data
+}
+
+// savePageBlocks stores the block document of a page, or removes it when the
+// page is edited as HTML.
+func (h *PagesHandler) savePageBlocks(ctx context.Context, pageID int64, data string) {
+	if data == "" {
+		if err := h.queries.DeletePageBlocks(ctx, pageID); err != nil {
+			slog.Error("failed to delete page blocks", "error", err, "page_id", pageID)
+		}
+		return
+	}
+	if err := h.queries.UpsertPageBlocks(ctx, store.UpsertPageBlocksParams{
+		PageID:    pageID,
+		Data:      data,
+		UpdatedAt: time.Now(),
+	}); err != nil {
+		slog.Error("failed to save page blocks", "error", err, "page_id", pageID)
+	}
+}
+
+// applyPageBlocks fills the block editor data of the page form. doc holds
+// the stored or submitted document of a page edited with the block editor.
+func applyPageBlocks(data *PageFormData, enabled bool, doc string) {
+	data.EditorMode = EditorModeHTML
+	if enabled {
+		data.EditorMode = EditorModeBlocks
+		data.BlocksJSON = doc
+	}
+}
+
+// ConvertBlocksRequest is the JSON request of the block conversion endpoint.
+type ConvertBlocksRequest struct {
+	To     string          `json:"to"` // "blocks" or "html"
+	HTML   string          `json:"html,omitempty"`
+	Blocks blocks.Document `json:"blocks"`
+}
+
+// ConvertBlocks handles POST /admin/pages/blocks/convert - converts an HTML
+// body into a block document or renders a block document to HTML, used when
+// switching the editor mode.
+func (h *PagesHandler) ConvertBlocks(w http.ResponseWriter, r *http.Request) {
+	var req ConvertBlocksRequest
+	if err := decodeJSONWithLimit(w, r, &req, 4*MaxJSONBodyBytes); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	switch req.To {
+	case EditorModeBlocks:
+		writeJSONSuccess(w, map[string]any{"blocks": blocks.FromHTML(req.HTML)})
+	case EditorModeHTML:
+		body, err := blocks.ToHTML(req.Blocks)
+		if err != nil {
+			writeJSONError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		writeJSONSuccess(w, map[string]any{"html": body})
+	default:
+		writeJSONError(w, http.StatusBadRequest, "Unknown conversion target")
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestParseAndSavePageBlocks(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	user := createTestAdminUser(t, db)
+	page := newTestTypedPage(t, db, user.ID, "about", "page")
+	h := NewPagesHandler(db, nil, sm)
+
+	form := url.Values{}
+	form.Set("editor_mode", EditorModeHTML)
+	form.Set("blocks", `{"blocks":[{"type":"paragraph","text":"ignored"}]}`)
+	if in, msg := parsePageBlocks(form); in.Enabled || msg != "" || in.stored() != "" {
+		t.Fatalf("HTML mode parsed as blocks: %+v, %q", in, msg)
+	}
+
+	form.Set("editor_mode", EditorModeBlocks)
+	form.Set("blocks", `{"version":1,"blocks":[{"type":"heading","text":"Hello","level":2},{"type":"paragraph","text":"<p onclick=\"x()\">Body</p>"}]}`)
+	in, msg := parsePageBlocks(form)
+	if msg != "" {
+		t.Fatalf("unexpected error: %s", msg)
+	}
+	if !strings.Contains(in.Markup, "onclick") {
+		t.Errorf("Markup = %q, want the raw submitted HTML for the suspicious markup check", in.Markup)
+	}
+	if validatePageBodySecurityPolicy(in.Markup, true) == "" {
+		t.Error("suspicious markup in blocks is not blocked by policy")
+	}
+	body := h.pageBodyForStorage("", in)
+	if !strings.Contains(body, "<h2>Hello</h2>") || strings.Contains(body, "onclick") {
+		t.Errorf("body = %q", body)
+	}
+
+	h.savePageBlocks(context.Background(), page.ID, in.stored())
+	if stored := loadPageBlocks(context.Background(), h.queries, page.ID); stored != in.stored() {
+		t.Errorf("stored blocks = %q, want %q", stored, in.stored())
+	}
+	h.savePageBlocks(context.Background(), page.ID, "")
+	if stored := loadPageBlocks(context.Background(), h.queries, page.ID); stored != "" {
+		t.Errorf("blocks = %q, want them removed", stored)
+	}
+
+	form.Set("blocks", `{"blocks":[{"type":"heading","text":"x","level":9}]}`)
+	if _, msg := parsePageBlocks(form); msg == "" {
+		t.Error("expected an invalid heading level error")
+	}
+}
+
+func TestConvertBlocks(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	h := NewPagesHandler(db, nil, sm)
+
+	convert := func(body string) (int, map[string]any) {
+		req := httptest.NewRequest(http.MethodPost, "/admin/pages/blocks/convert", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		h.ConvertBlocks(w, req)
+		var resp map[string]any
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		return w.Code, resp
+	}
+
+	code, resp := convert(`{"to":"blocks","html":"<h2>Title</h2><p>Text</p>"}`)
+	assertStatus(t, code, http.StatusOK)
+	doc, _ := resp["blocks"].(map[string]any)
+	if list, _ := doc["blocks"].([]any); len(list) != 2 {
+		t.Errorf("blocks = %v, want 2 blocks", resp["blocks"])
+	}
+
+	code, resp = convert(`{"to":"html","blocks":{"version":1,"blocks":[{"type":"quote","text":"Hi","citation":"Me"}]}}`)
+	assertStatus(t, code, http.StatusOK)
+	if html, _ := resp["html"].(string); !strings.Contains(html, "<cite>Me</cite>") {
+		t.Errorf("html = %v", resp["html"])
+	}
+
+	code, _ = convert(`{"to":"html","blocks":{"blocks":[{"type":"marquee"}]}}`)
+	assertStatus(t, code, http.StatusUnprocessableEntity)
+
+	code, _ = convert(`{"to":"pdf"}`)
+	assertStatus(t, code, http.StatusBadRequest)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// Values of the comments field of the page form. An empty value follows
+// the default: open on posts, closed on other page types.
+const (
+	pageCommentsOpen   = "open"
+	pageCommentsClosed = "closed"
+)
+
+// applyPageCommentSetting fills the comments choice of the edit form from
+// the stored setting, unless the form was submitted.
+func (h *PagesHandler) applyPageCommentSetting(ctx context.Context, data *PageFormData, pageID int64) {
+	if _, ok := data.FormValues["comments"]; ok || pageID == 0 {
+		return
+	}
+	setting, err := h.queries.GetPageCommentSetting(ctx, pageID)
+	switch {
+	case err == nil && setting.Enabled:
+		data.FormValues["comments"] = pageCommentsOpen
+	case err == nil:
+		data.FormValues["comments"] = pageCommentsClosed
+	case !errors.Is(err, sql.ErrNoRows):
+		slog.Error("failed to load page comment setting", "error", err, "page_id", pageID)
+	}
+}
+
+// savePageCommentSetting stores the comments choice of a page. The default
+// choice removes the page's own setting.
+func (h *PagesHandler) savePageCommentSetting(ctx context.Context, pageID int64, value string) {
+	var err error
+	switch value {
+	case pageCommentsOpen, pageCommentsClosed:
+		err = h.queries.UpsertPageCommentSetting(ctx, store.UpsertPageCommentSettingParams{
+			PageID:  pageID,
+			Enabled: value == pageCommentsOpen,
+		})
+	default:
+		err = h.queries.DeletePageCommentSetting(ctx, pageID)
+	}
+	if err != nil {
+		slog.Error("failed to save page comment setting", "error", err, "page_id", pageID)
+	}
+}
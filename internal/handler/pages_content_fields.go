@@ -0,0 +1,173 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/contenttype"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// maxPageRefOptions caps the pages offered by page reference fields.
+const maxPageRefOptions = 1000
+
+// contentFieldErrorPrefix prefixes content field keys in form validation errors.
+const contentFieldErrorPrefix = "fields."
+
+// pageTypeChoices holds the page types available to pages: the built-in types
+// followed by the admin-defined content types.
+type pageTypeChoices struct {
+	Types        []string
+	Labels       map[string]string // content type names by slug
+	ContentTypes []store.ContentType
+}
+
+// loadPageTypeChoices loads the available page types. Content types that
+// cannot be loaded are left out so the built-in types keep working.
+func loadPageTypeChoices(ctx context.Context, queries *store.Queries) pageTypeChoices {
+	choices := pageTypeChoices{
+		Types:  slices.Clone(ValidPageTypes),
+		Labels: make(map[string]string),
+	}
+	types, err := queries.ListContentTypes(ctx)
+	if err != nil {
+		slog.Error("failed to list content types", "error", err)
+		return choices
+	}
+	choices.ContentTypes = types
+	for _, ct := range types {
+		choices.Types = append(choices.Types, ct.Slug)
+		choices.Labels[ct.Slug] = ct.Name
+	}
+	return choices
+}
+
+// isValid reports whether pageType is a built-in type or a content type slug.
+func (c pageTypeChoices) isValid(pageType string) bool {
+	return slices.Contains(c.Types, pageType)
+}
+
+// contentType returns the content type for a page type, or nil for built-in
+// and unknown page types.
+func (c pageTypeChoices) contentType(pageType string) *store.ContentType {
+	for i := range c.ContentTypes {
+		if c.ContentTypes[i].Slug == pageType {
+			return &c.ContentTypes[i]
+		}
+	}
+	return nil
+}
+
+// hasFieldType reports whether any content type uses a field of fieldType,
+// including repeater sub-fields.
+func (c pageTypeChoices) hasFieldType(fieldType string) bool {
+	for _, ct := range c.ContentTypes {
+		for _, f := range contenttype.ParseFields(ct.Fields) {
+			if f.Type == fieldType || slices.ContainsFunc(f.Fields, func(sub contenttype.Field) bool { return sub.Type == fieldType }) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// loadPageContentValues returns the stored content field values of a page.
+func loadPageContentValues(ctx context.Context, queries *store.Queries, pageID int64) contenttype.Values {
+	row, err := queries.GetPageContentFields(ctx, pageID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("failed to load page content fields", "error", err, "page_id", pageID)
+		}
+		return make(contenttype.Values)
+	}
+	return contenttype.ParseValues(row.Data)
+}
+
+// ValidateContentReferences checks that the media and pages referenced by
+// values exist. Errors are keyed by top-level field name.
+func ValidateContentReferences(ctx context.Context, queries *store.Queries, fields []contenttype.Field, values contenttype.Values) map[string]string {
+	errs := make(map[string]string)
+	for _, f := range fields {
+		contenttype.MapReferences([]contenttype.Field{f}, values, func(ref contenttype.Field, v any) (any, bool) {
+			if _, failed := errs[f.Name]; failed {
+				return v, true
+			}
+			id, _ := contenttype.ReferenceID(v)
+			var err error
+			if ref.Type == contenttype.FieldMedia {
+				_, err = queries.GetMediaByID(ctx, id)
+			} else {
+				_, err = queries.GetPageByID(ctx, id)
+			}
+			if err != nil {
+				errs[f.Name] = fmt.Sprintf("%s references a %s that does not exist", ref.Label, ref.Type)
+			}
+			return v, true
+		})
+	}
+	return errs
+}
+
+// parsePageContentFields validates the submitted fields of a content type.
+// It returns the normalized values, the raw input for re-rendering the form
+// and validation errors keyed for the page form.
+func (h *PagesHandler) parsePageContentFields(ctx context.Context, ct *store.ContentType, form url.Values) (contenttype.Values, map[string]any, map[string]string) {
+	fields := contenttype.ParseFields(ct.Fields)
+	input := contenttype.FormInput(fields, form)
+	values, errs := contenttype.Normalize(fields, input)
+	if len(errs) == 0 {
+		errs = ValidateContentReferences(ctx, h.queries, fields, values)
+	}
+
+	formErrors := make(map[string]string, len(errs))
+	for name, msg := range errs {
+		formErrors[contentFieldErrorPrefix+name] = msg
+	}
+	return values, input, formErrors
+}
+
+// savePageContentFields stores the content field values of a page, or removes
+// them when the page has a built-in page type.
+func (h *PagesHandler) savePageContentFields(ctx context.Context, pageID int64, ct *store.ContentType, values contenttype.Values) {
+	if ct == nil {
+		if err := h.queries.DeletePageContentFields(ctx, pageID); err != nil {
+			slog.Error("failed to delete page content fields", "error", err, "page_id", pageID)
+		}
+		return
+	}
+	if err := h.queries.UpsertPageContentFields(ctx, store.UpsertPageContentFieldsParams{
+		PageID:    pageID,
+		Data:      values.JSON(),
+		UpdatedAt: time.Now(),
+	}); err != nil {
+		slog.Error("failed to save page content fields", "error", err, "page_id", pageID)
+	}
+}
+
+// applyPageTypeChoices fills the content type data of the page form. input
+// holds the field values of the page's current content type.
+func (h *PagesHandler) applyPageTypeChoices(ctx context.Context, data *PageFormData, choices pageTypeChoices, input map[string]any) {
+	data.PageTypes = choices.Types
+	data.PageTypeLabels = choices.Labels
+	data.ContentTypes = choices.ContentTypes
+	data.ContentInput = input
+
+	if !choices.hasFieldType(contenttype.FieldPage) {
+		return
+	}
+	pages, err := h.queries.ListPages(ctx, store.ListPagesParams{Limit: maxPageRefOptions, Offset: 0})
+	if err != nil {
+		slog.Error("failed to list pages for page reference fields", "error", err)
+		return
+	}
+	data.PageRefOptions = pages
+}
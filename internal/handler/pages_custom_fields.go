@@ -0,0 +1,113 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/contenttype"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/theme"
+)
+
+// customFieldErrorPrefix prefixes custom field keys in form validation errors.
+const customFieldErrorPrefix = "custom_fields."
+
+// SetThemeManager sets the theme manager whose active theme defines the
+// custom field schema of the page editor.
+func (h *PagesHandler) SetThemeManager(tm *theme.Manager) {
+	h.themeManager = tm
+}
+
+// customFieldSchema returns the custom field schema of the active theme.
+func (h *PagesHandler) customFieldSchema() []contenttype.Field {
+	if h.themeManager == nil {
+		return nil
+	}
+	return h.themeManager.ActiveCustomFields()
+}
+
+// loadPageCustomFields returns the stored custom fields of a page.
+func loadPageCustomFields(ctx context.Context, queries *store.Queries, pageID int64) contenttype.CustomFields {
+	row, err := queries.GetPageCustomFields(ctx, pageID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("failed to load page custom fields", "error", err, "page_id", pageID)
+		}
+		return contenttype.CustomFields{}
+	}
+	return contenttype.ParseCustomFields(row.Data)
+}
+
+// ValidateCustomReferences checks that the media and pages referenced by
+// custom fields exist. Errors are keyed by custom field name.
+func ValidateCustomReferences(ctx context.Context, queries *store.Queries, fields contenttype.CustomFields) map[string]string {
+	schema := make([]contenttype.Field, 0, len(fields))
+	values := make(contenttype.Values, len(fields))
+	for _, cf := range fields {
+		schema = append(schema, contenttype.Field{Name: cf.Name, Label: cf.Name, Type: cf.Type})
+		values[cf.Name] = cf.Value
+	}
+	return ValidateContentReferences(ctx, queries, schema, values)
+}
+
+// parsePageCustomFields validates the submitted custom fields. It returns the
+// normalized fields, the raw input for re-rendering the form and validation
+// errors keyed for the page form.
+func (h *PagesHandler) parsePageCustomFields(ctx context.Context, form url.Values) (contenttype.CustomFields, []contenttype.CustomField, map[string]string) {
+	input := contenttype.CustomFormInput(h.customFieldSchema(), form)
+	fields, errs := contenttype.NormalizeCustomFields(h.customFieldSchema(), input)
+	if len(errs) == 0 {
+		errs = ValidateCustomReferences(ctx, h.queries, fields)
+	}
+
+	formErrors := make(map[string]string, len(errs))
+	for name, msg := range errs {
+		formErrors[customFieldErrorPrefix+name] = msg
+	}
+	return fields, input, formErrors
+}
+
+// savePageCustomFields stores the custom fields of a page, or removes the row
+// when the page has none.
+func (h *PagesHandler) savePageCustomFields(ctx context.Context, pageID int64, fields contenttype.CustomFields) {
+	if len(fields) == 0 {
+		if err := h.queries.DeletePageCustomFields(ctx, pageID); err != nil {
+			slog.Error("failed to delete page custom fields", "error", err, "page_id", pageID)
+		}
+		return
+	}
+	if err := h.queries.UpsertPageCustomFields(ctx, store.UpsertPageCustomFieldsParams{
+		PageID:    pageID,
+		Data:      fields.JSON(),
+		UpdatedAt: time.Now(),
+	}); err != nil {
+		slog.Error("failed to save page custom fields", "error", err, "page_id", pageID)
+	}
+}
+
+// applyCustomFields fills the custom field data of the page form. input holds
+// the stored or submitted custom fields of the page.
+func (h *PagesHandler) applyCustomFields(ctx context.Context, data *PageFormData, input []contenttype.CustomField) {
+	data.CustomFieldSchema = h.customFieldSchema()
+	data.CustomFieldInput = input
+
+	if data.PageRefOptions != nil || !slices.ContainsFunc(data.CustomFieldSchema, func(f contenttype.Field) bool {
+		return f.Type == contenttype.FieldPage
+	}) {
+		return
+	}
+	pages, err := h.queries.ListPages(ctx, store.ListPagesParams{Limit: maxPageRefOptions, Offset: 0})
+	if err != nil {
+		slog.Error("failed to list pages for page reference fields", "error", err)
+		return
+	}
+	data.PageRefOptions = pages
+}
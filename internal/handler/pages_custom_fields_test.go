@@ -0,0 +1,45 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/olegiv/ocms-go/internal/contenttype"
+)
+
+func TestParseAndSavePageCustomFields(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	user := createTestAdminUser(t, db)
+	page := newTestTypedPage(t, db, user.ID, "about", "page")
+	h := NewPagesHandler(db, nil, sm)
+
+	form := url.Values{}
+	form.Set(contenttype.CustomExtraInputName(0, "name"), "rating")
+	form.Set(contenttype.CustomExtraInputName(0, "type"), contenttype.FieldNumber)
+	form.Set(contenttype.CustomExtraInputName(0, "value"), "5")
+	fields, _, errs := h.parsePageCustomFields(context.Background(), form)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	h.savePageCustomFields(context.Background(), page.ID, fields)
+	stored := loadPageCustomFields(context.Background(), h.queries, page.ID)
+	if cf, ok := stored.Get("rating"); !ok || cf.Value != 5.0 {
+		t.Errorf("stored custom fields = %v, want rating 5", stored)
+	}
+
+	h.savePageCustomFields(context.Background(), page.ID, nil)
+	if stored := loadPageCustomFields(context.Background(), h.queries, page.ID); len(stored) != 0 {
+		t.Errorf("custom fields = %v, want them removed", stored)
+	}
+
+	form.Set(contenttype.CustomExtraInputName(0, "value"), "five")
+	_, _, errs = h.parsePageCustomFields(context.Background(), form)
+	if _, ok := errs[customFieldErrorPrefix+"rating"]; !ok {
+		t.Errorf("errors = %v, want an invalid number error", errs)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// applyPageFreshness lists the pages the edited page was translated from
+// that changed since the translation was last brought up to date.
+func (h *PagesHandler) applyPageFreshness(ctx context.Context, data *PageFormData, pageID int64) {
+	sourceIDs, err := h.queries.ListOutdatedPageTranslationSources(ctx, pageID)
+	if err != nil {
+		slog.Error("failed to list outdated translation sources", "error", err, "page_id", pageID)
+		return
+	}
+	for _, id := range sourceIDs {
+		source, err := h.queries.GetPageByID(ctx, id)
+		if err != nil {
+			slog.Error("failed to get translation source", "error", err, "page_id", pageID, "source_id", id)
+			continue
+		}
+		language, err := h.queries.GetLanguageByCode(ctx, source.LanguageCode)
+		if err != nil {
+			language = store.Language{Code: source.LanguageCode, Name: source.LanguageCode}
+		}
+		data.OutdatedSources = append(data.OutdatedSources, PageTranslationInfo{Language: language, Page: source})
+	}
+}
+
+// savePageFreshness marks the translations of the page as up to date with
+// their sources when the editor confirmed it.
+func (h *PagesHandler) savePageFreshness(ctx context.Context, pageID int64, value string) {
+	if value == "" {
+		return
+	}
+	if err := h.queries.MarkTranslationsCurrent(ctx, store.MarkTranslationsCurrentParams{
+		EntityType:    model.EntityTypePage,
+		TranslationID: pageID,
+	}); err != nil {
+		slog.Error("failed to mark page translation up to date", "error", err, "page_id", pageID)
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"slices"
+	"strconv"
+
+	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// applyPageRelations fills the series, related page, comments and access
+// choices of the page form. The selection comes from the submitted form or,
+// on the edit form, from the stored series membership, related pages,
+// comment setting and access rule.
+func (h *PagesHandler) applyPageRelations(ctx context.Context, data *PageFormData, languageCode string, pageID int64) {
+	h.applyPageCommentSetting(ctx, data, pageID)
+	h.applyPageAccess(ctx, data, pageID)
+
+	series, err := h.queries.ListPageSeriesByLanguage(ctx, languageCode)
+	if err != nil {
+		slog.Error("failed to list page series", "error", err, "language", languageCode)
+	}
+	data.SeriesOptions = series
+
+	data.RelatedOptions = slices.DeleteFunc(flattenPageTree(h.loadPageTree(ctx, languageCode), 0, 0),
+		func(opt PageParentOption) bool { return opt.ID == pageID })
+
+	if _, ok := data.FormValues["series_id"]; ok || pageID == 0 {
+		return
+	}
+	if item, err := h.queries.GetPageSeriesItem(ctx, pageID); err == nil {
+		data.FormValues["series_id"] = strconv.FormatInt(item.SeriesID, 10)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		slog.Error("failed to load page series", "error", err, "page_id", pageID)
+	}
+	related, err := h.queries.ListRelatedPages(ctx, pageID)
+	if err != nil {
+		slog.Error("failed to list related pages", "error", err, "page_id", pageID)
+		return
+	}
+	for _, p := range related {
+		data.RelatedPageIDs = append(data.RelatedPageIDs, p.ID)
+	}
+}
+
+// parsePageSeries validates the submitted series of a page. It returns an
+// error message for the page form.
+func (h *PagesHandler) parsePageSeries(ctx context.Context, raw, languageCode string) (int64, string) {
+	if raw == "" {
+		return 0, ""
+	}
+	seriesID, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || seriesID <= 0 {
+		return 0, "Invalid series"
+	}
+	series, err := h.queries.GetPageSeriesByID(ctx, seriesID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, "Series not found"
+	}
+	if err != nil {
+		slog.Error("failed to load series", "error", err, "series_id", seriesID)
+		return 0, "Error validating series"
+	}
+	if series.LanguageCode != languageCode {
+		return 0, "Series must have the same language as the page"
+	}
+	return seriesID, ""
+}
+
+// parseRelatedPageIDs returns the picked related pages in form order,
+// without duplicates and the page itself.
+func parseRelatedPageIDs(raw []string, pageID int64) []int64 {
+	var ids []int64
+	for _, s := range raw {
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || id <= 0 || id == pageID || slices.Contains(ids, id) {
+			continue
+		}
+		ids = append(ids, id)
+		if len(ids) == service.MaxRelatedPicks {
+			break
+		}
+	}
+	return ids
+}
+
+// savePageSeries stores the series of a page. A page that joins a series
+// is appended as its last part.
+func (h *PagesHandler) savePageSeries(ctx context.Context, pageID, seriesID int64) {
+	current, err := h.queries.GetPageSeriesItem(ctx, pageID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		if seriesID == 0 {
+			return
+		}
+	case err != nil:
+		slog.Error("failed to load page series", "error", err, "page_id", pageID)
+		return
+	case current.SeriesID == seriesID:
+		return
+	}
+
+	if seriesID == 0 {
+		if err := h.queries.DeletePageSeriesItem(ctx, pageID); err != nil {
+			slog.Error("failed to remove page from series", "error", err, "page_id", pageID)
+		}
+		return
+	}
+	position, err := h.queries.GetNextPageSeriesPosition(ctx, seriesID)
+	if err != nil {
+		slog.Error("failed to get series position", "error", err, "series_id", seriesID)
+		return
+	}
+	if err := h.queries.UpsertPageSeriesItem(ctx, store.UpsertPageSeriesItemParams{
+		PageID:   pageID,
+		SeriesID: seriesID,
+		Position: position,
+	}); err != nil {
+		slog.Error("failed to save page series", "error", err, "page_id", pageID)
+	}
+}
+
+// saveRelatedPages replaces the related pages picked for a page. Pages of
+// other languages are skipped.
+func (h *PagesHandler) saveRelatedPages(ctx context.Context, pageID int64, languageCode string, relatedIDs []int64) {
+	if err := h.queries.DeleteRelatedPages(ctx, pageID); err != nil {
+		slog.Error("failed to clear related pages", "error", err, "page_id", pageID)
+		return
+	}
+	position := int64(0)
+	for _, id := range relatedIDs {
+		related, err := h.queries.GetPageByID(ctx, id)
+		if err != nil || related.LanguageCode != languageCode {
+			continue
+		}
+		if err := h.queries.AddRelatedPage(ctx, store.AddRelatedPageParams{
+			PageID:        pageID,
+			RelatedPageID: id,
+			Position:      position,
+		}); err != nil {
+			slog.Error("failed to save related page", "error", err, "page_id", pageID, "related_page_id", id)
+			continue
+		}
+		position++
+	}
+}
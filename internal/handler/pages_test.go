@@ -131,6 +131,7 @@ func TestPagesValidateSlug(t *testing.T) {
 		Body:     "Content",
 		Status:   "published",
 		AuthorID: user.ID,
+		SiteID:   store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create test page: %v", err)
@@ -139,7 +140,7 @@ func TestPagesValidateSlug(t *testing.T) {
 	t.Run("slug validation for creation", func(t *testing.T) {
 		// Test valid slug
 		checkExists := func() (int64, error) {
-			return queries.SlugExists(context.Background(), "new-page")
+			return queries.SlugExists(context.Background(), store.SlugExistsParams{Slug: "new-page", SiteID: store.DefaultSiteID})
 		}
 		result := ValidateSlugWithChecker("new-page", checkExists)
 		if result != "" {
@@ -148,7 +149,7 @@ func TestPagesValidateSlug(t *testing.T) {
 
 		// Test existing slug
 		checkExistsExisting := func() (int64, error) {
-			return queries.SlugExists(context.Background(), "existing-page")
+			return queries.SlugExists(context.Background(), store.SlugExistsParams{Slug: "existing-page", SiteID: store.DefaultSiteID})
 		}
 		result = ValidateSlugWithChecker("existing-page", checkExistsExisting)
 		if result != "Slug already exists" {
@@ -419,6 +420,7 @@ func TestPageCreateQuery(t *testing.T) {
 			Body:     "<p>Hello World</p>",
 			Status:   "draft",
 			AuthorID: user.ID,
+			SiteID:   store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreatePage failed: %v", err)
@@ -444,6 +446,7 @@ func TestPageCreateQuery(t *testing.T) {
 			AuthorID:        user.ID,
 			MetaTitle:       "Custom Title",
 			MetaDescription: "Custom Description",
+			SiteID:          store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreatePage failed: %v", err)
@@ -474,6 +477,7 @@ func TestPageListQuery(t *testing.T) {
 			Body:     "Content",
 			Status:   "published",
 			AuthorID: user.ID,
+			SiteID:   store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("failed to create page %d: %v", i, err)
@@ -532,6 +536,7 @@ func TestPageUpdateQuery(t *testing.T) {
 		Body:     "Original content",
 		Status:   "draft",
 		AuthorID: user.ID,
+		SiteID:   store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create page: %v", err)
@@ -578,6 +583,7 @@ func TestPageVideoURL(t *testing.T) {
 			AuthorID:   user.ID,
 			VideoUrl:   "https://www.youtube.com/watch?v=dQw4w9WgXcQ",
 			VideoTitle: "My Video",
+			SiteID:     store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreatePage failed: %v", err)
@@ -597,6 +603,7 @@ func TestPageVideoURL(t *testing.T) {
 			Body:     "Content without video",
 			Status:   "draft",
 			AuthorID: user.ID,
+			SiteID:   store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreatePage failed: %v", err)
@@ -616,6 +623,7 @@ func TestPageVideoURL(t *testing.T) {
 			Body:     "Content",
 			Status:   "draft",
 			AuthorID: user.ID,
+			SiteID:   store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreatePage failed: %v", err)
@@ -657,6 +665,7 @@ func TestPageDeleteQuery(t *testing.T) {
 		Body:     "Content",
 		Status:   "draft",
 		AuthorID: user.ID,
+		SiteID:   store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create page: %v", err)
@@ -690,13 +699,14 @@ func TestPageSlugExists(t *testing.T) {
 		Body:     "Content",
 		Status:   "published",
 		AuthorID: user.ID,
+		SiteID:   store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create page: %v", err)
 	}
 
 	t.Run("slug exists", func(t *testing.T) {
-		count, err := queries.SlugExists(context.Background(), "existing-slug")
+		count, err := queries.SlugExists(context.Background(), store.SlugExistsParams{Slug: "existing-slug", SiteID: store.DefaultSiteID})
 		if err != nil {
 			t.Fatalf("SlugExists failed: %v", err)
 		}
@@ -706,7 +716,7 @@ func TestPageSlugExists(t *testing.T) {
 	})
 
 	t.Run("slug does not exist", func(t *testing.T) {
-		count, err := queries.SlugExists(context.Background(), "nonexistent-slug")
+		count, err := queries.SlugExists(context.Background(), store.SlugExistsParams{Slug: "nonexistent-slug", SiteID: store.DefaultSiteID})
 		if err != nil {
 			t.Fatalf("SlugExists failed: %v", err)
 		}
@@ -746,6 +756,7 @@ func createTestMedia(t *testing.T, db *sql.DB, userID int64, width, height int64
 		LanguageCode: "en",
 		CreatedAt:    now,
 		UpdatedAt:    now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create test media: %v", err)
@@ -833,6 +844,7 @@ func TestValidateFeaturedImageSize(t *testing.T) {
 			LanguageCode: "en",
 			CreatedAt:    now,
 			UpdatedAt:    now,
+			SiteID:       store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("failed to create test media: %v", err)
@@ -863,6 +875,7 @@ func TestUpdateSkipsFeaturedImageValidationWhenUnchanged(t *testing.T) {
 		Status:          "draft",
 		AuthorID:        user.ID,
 		FeaturedImageID: sql.NullInt64{Int64: smallImg.ID, Valid: true},
+		SiteID:          store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create test page: %v", err)
@@ -950,6 +963,7 @@ func TestPageSlugCannotClaimActiveLanguagePrefix(t *testing.T) {
 
 	page, err := queries.CreatePage(ctx, store.CreatePageParams{
 		Title: "Engineering", Slug: "engineering", Body: "Content", Status: "published", AuthorID: user.ID,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage failed: %v", err)
@@ -980,6 +994,7 @@ func TestPageAliasesSkipLanguagePrefixCollisions(t *testing.T) {
 	}
 	page, err := queries.CreatePage(ctx, store.CreatePageParams{
 		Title: "Engineering", Slug: "engineering", Body: "Content", Status: "published", AuthorID: user.ID,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage failed: %v", err)
@@ -1022,17 +1037,19 @@ func TestPageWritesRefuseLanguagePrefixInsideTheirTransaction(t *testing.T) {
 	_, err := h.createPageGuarded(ctx, store.CreatePageParams{
 		Title: "Engineering", Slug: "eng", Body: "Content", Status: "published",
 		AuthorID: user.ID, LanguageCode: "en", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if !errors.Is(err, errPageRouteTaken) {
 		t.Fatalf("createPageGuarded() error = %v, want the write itself to refuse", err)
 	}
-	if _, lookupErr := queries.GetPageBySlug(ctx, "eng"); lookupErr == nil {
+	if _, lookupErr := queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: "eng", SiteID: store.DefaultSiteID}); lookupErr == nil {
 		t.Fatal("the refused page was written anyway")
 	}
 
 	page, err := h.createPageGuarded(ctx, store.CreatePageParams{
 		Title: "Engineering", Slug: "engineering", Body: "Content", Status: "published",
 		AuthorID: user.ID, LanguageCode: "en", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("createPageGuarded() for a free slug error = %v", err)
@@ -0,0 +1,378 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/store"
+	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+)
+
+// PageParentOption is a page selectable as the parent of another page.
+type PageParentOption struct {
+	ID    int64
+	Title string
+	Depth int
+}
+
+// pageTreeNode is a page of the page tree with its subpages.
+type pageTreeNode struct {
+	store.ListPageTreeNodesRow
+	Children []*pageTreeNode
+}
+
+// pageTreeRequest is the JSON body of POST /admin/pages/tree.
+type pageTreeRequest struct {
+	Language string        `json:"language"`
+	Items    []ReorderItem `json:"items"`
+}
+
+// SetRedirectsMiddleware sets the redirects middleware whose cache is
+// invalidated when moving pages creates redirects.
+func (h *PagesHandler) SetRedirectsMiddleware(rm *middleware.RedirectsMiddleware) {
+	h.urlHistory.SetRedirectsMiddleware(rm)
+}
+
+// buildPageTree nests the pages of a language in tree order. Pages whose
+// parent is not among rows are shown at the top level.
+func buildPageTree(rows []store.ListPageTreeNodesRow) []*pageTreeNode {
+	nodes := make(map[int64]*pageTreeNode, len(rows))
+	for _, row := range rows {
+		nodes[row.ID] = &pageTreeNode{ListPageTreeNodesRow: row}
+	}
+	var roots []*pageTreeNode
+	for _, row := range rows {
+		node := nodes[row.ID]
+		if parent, ok := nodes[row.ParentID.Int64]; row.ParentID.Valid && ok {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	return roots
+}
+
+// flattenPageTree lists the pages of a tree depth-first, leaving out the
+// subtree of excludeID.
+func flattenPageTree(nodes []*pageTreeNode, depth int, excludeID int64) []PageParentOption {
+	var options []PageParentOption
+	for _, node := range nodes {
+		if node.ID == excludeID {
+			continue
+		}
+		options = append(options, PageParentOption{ID: node.ID, Title: node.Title, Depth: depth})
+		options = append(options, flattenPageTree(node.Children, depth+1, excludeID)...)
+	}
+	return options
+}
+
+// pageTreePaths returns the nested paths of the pages of a tree by ID.
+func pageTreePaths(nodes []*pageTreeNode, parentPath string, paths map[int64]string) map[int64]string {
+	for _, node := range nodes {
+		path := node.Slug
+		if parentPath != "" {
+			path = parentPath + "/" + node.Slug
+		}
+		paths[node.ID] = path
+		pageTreePaths(node.Children, path, paths)
+	}
+	return paths
+}
+
+// loadPageTree returns the page tree of a language.
+func (h *PagesHandler) loadPageTree(ctx context.Context, languageCode string) []*pageTreeNode {
+	rows, err := h.queries.ListPageTreeNodes(ctx, store.ListPageTreeNodesParams{LanguageCode: languageCode, SiteID: store.SiteIDFromContext(ctx)})
+	if err != nil {
+		slog.Error("failed to list page tree", "error", err, "language", languageCode)
+		return nil
+	}
+	return buildPageTree(rows)
+}
+
+// applyPageParent fills the parent page choices of the page form. The
+// selected parent comes from the submitted form or, on the edit form, from
+// the stored hierarchy.
+func (h *PagesHandler) applyPageParent(ctx context.Context, data *PageFormData, languageCode string, pageID int64) {
+	data.ParentOptions = flattenPageTree(h.loadPageTree(ctx, languageCode), 0, pageID)
+	if _, ok := data.FormValues["parent_id"]; ok || pageID == 0 {
+		return
+	}
+	current, err := h.queries.GetPageHierarchy(ctx, pageID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("failed to load page parent", "error", err, "page_id", pageID)
+		}
+		return
+	}
+	if current.ParentID.Valid {
+		data.FormValues["parent_id"] = strconv.FormatInt(current.ParentID.Int64, 10)
+	}
+}
+
+// parsePageParent validates the submitted parent page. pageID is 0 for new
+// pages. It returns an error message for the page form.
+func (h *PagesHandler) parsePageParent(ctx context.Context, raw string, pageID int64, languageCode string) (sql.NullInt64, string) {
+	if raw == "" {
+		return sql.NullInt64{}, ""
+	}
+	parentID, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || parentID <= 0 {
+		return sql.NullInt64{}, "Invalid parent page"
+	}
+	if err := service.ValidatePageParent(ctx, h.queries, pageID, parentID, languageCode); err != nil {
+		return sql.NullInt64{}, pageParentErrorMessage(err)
+	}
+	return sql.NullInt64{Int64: parentID, Valid: true}, ""
+}
+
+// pageParentErrorMessage returns the form error for a parent validation error.
+func pageParentErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, service.ErrPageParentNotFound):
+		return "Parent page not found"
+	case errors.Is(err, service.ErrPageParentLanguage):
+		return "Parent page must have the same language"
+	case errors.Is(err, service.ErrPageParentCycle):
+		return "A page cannot be moved below itself or one of its subpages"
+	case errors.Is(err, service.ErrPageTreeTooDeep):
+		return fmt.Sprintf("Pages cannot be nested more than %d levels deep", service.MaxPageDepth)
+	default:
+		slog.Error("failed to validate parent page", "error", err)
+		return "Error validating parent page"
+	}
+}
+
+// savePageParent stores the parent of a page. A page that gets a new parent
+// is appended to its siblings. It reports whether the parent changed.
+func (h *PagesHandler) savePageParent(ctx context.Context, pageID int64, parentID sql.NullInt64) bool {
+	current, err := h.queries.GetPageHierarchy(ctx, pageID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		if !parentID.Valid {
+			return false
+		}
+	case err != nil:
+		slog.Error("failed to load page parent", "error", err, "page_id", pageID)
+		return false
+	case current.ParentID == parentID:
+		return false
+	}
+
+	position, err := h.queries.GetNextPageChildPosition(ctx, parentID)
+	if err != nil {
+		slog.Error("failed to get page position", "error", err, "page_id", pageID)
+		return false
+	}
+	if err := h.queries.UpsertPageHierarchy(ctx, store.UpsertPageHierarchyParams{
+		PageID:   pageID,
+		ParentID: parentID,
+		Position: position,
+	}); err != nil {
+		slog.Error("failed to save page parent", "error", err, "page_id", pageID)
+		return false
+	}
+	return true
+}
+
+// redirectMovedPages creates permanent redirects from the old URLs of pages
+// whose path changed to their new URLs, as described at redirectMovedURLs.
+// oldURLs is taken with URLHistory.Capture before the change. It returns the
+// number of moved pages.
+func (h *PagesHandler) redirectMovedPages(ctx context.Context, languageCode string, oldURLs URLSnapshot) int {
+	moved := h.urlHistory.RedirectMoved(ctx, oldURLs, languageCode)
+	if len(moved) == 0 {
+		return 0
+	}
+	for _, owner := range moved {
+		h.invalidatePageCache(owner.ID)
+	}
+	if h.renderer != nil {
+		h.renderer.InvalidateMenuCache("")
+	}
+	return len(moved)
+}
+
+// Tree handles GET /admin/pages/tree - displays the page tree of a language.
+func (h *PagesHandler) Tree(w http.ResponseWriter, r *http.Request) {
+	lang := h.renderer.GetAdminLang(r)
+
+	languages := ListActiveLanguagesWithFallback(r.Context(), h.queries)
+	current := FindDefaultLanguage(languages)
+	if code := r.URL.Query().Get("language"); code != "" {
+		for i := range languages {
+			if languages[i].Code == code {
+				current = &languages[i]
+			}
+		}
+	}
+
+	var items []adminviews.PageTreeItemView
+	languageCode := ""
+	if current != nil {
+		languageCode = current.Code
+		items = convertPageTreeItems(h.loadPageTree(r.Context(), current.Code), 0)
+	}
+	itemsJSON, err := json.Marshal(items)
+	if err != nil {
+		slog.Error("failed to encode page tree", "error", err)
+		itemsJSON = []byte("[]")
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "pages.tree"), pagesTreeBreadcrumbs(lang))
+	renderTempl(w, r, adminviews.PageTreePage(pc, adminviews.PageTreeViewData{
+		Language:     languageCode,
+		AllLanguages: convertLanguageOptions(languages),
+		ItemsJSON:    string(itemsJSON),
+		MaxDepth:     service.MaxPageDepth,
+		IsDemoMode:   middleware.IsDemoMode(),
+	}))
+}
+
+// convertPageTreeItems flattens a page tree for the tree editor.
+func convertPageTreeItems(nodes []*pageTreeNode, depth int) []adminviews.PageTreeItemView {
+	items := make([]adminviews.PageTreeItemView, 0, len(nodes))
+	for _, node := range nodes {
+		items = append(items, adminviews.PageTreeItemView{
+			ID:     node.ID,
+			Title:  node.Title,
+			Slug:   node.Slug,
+			Status: node.Status,
+			Depth:  depth,
+		})
+		items = append(items, convertPageTreeItems(node.Children, depth+1)...)
+	}
+	return items
+}
+
+// SaveTree handles POST /admin/pages/tree - stores the order and nesting of
+// the pages of a language on the current site and redirects the URLs of
+// moved pages.
+func (h *PagesHandler) SaveTree(w http.ResponseWriter, r *http.Request) {
+	if demoGuardAPI(w) {
+		return
+	}
+
+	var req pageTreeRequest
+	if err := decodeJSONWithLimit(w, r, &req, MaxJSONBodyBytes); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	rows, err := h.queries.ListPageTreeNodes(r.Context(), store.ListPageTreeNodesParams{LanguageCode: req.Language, SiteID: store.SiteIDFromContext(r.Context())})
+	if err != nil {
+		slog.Error("failed to list page tree", "error", err, "language", req.Language)
+		writeJSONError(w, http.StatusInternalServerError, "Error loading pages")
+		return
+	}
+	if err := validatePageTreeRequest(req.Items, rows); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	oldURLs := h.urlHistory.Capture(r.Context(), false, req.Language)
+	if err := h.applyPageTree(r.Context(), req.Items); err != nil {
+		slog.Error("failed to save page tree", "error", err, "language", req.Language)
+		writeJSONError(w, http.StatusInternalServerError, "Error saving page tree")
+		return
+	}
+	moved := h.redirectMovedPages(r.Context(), req.Language, oldURLs)
+
+	slog.Info("page tree saved", "language", req.Language, "moved", moved, "updated_by", middleware.GetUserID(r))
+	_ = h.eventService.LogPageEvent(r.Context(), model.EventLevelInfo, "Page tree reordered", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"language": req.Language, "moved_pages": moved})
+
+	writeJSONSuccess(w, map[string]any{"moved": moved})
+}
+
+// validatePageTreeRequest checks that a submitted tree contains every page
+// of the language exactly once and is not deeper than MaxPageDepth.
+func validatePageTreeRequest(items []ReorderItem, rows []store.ListPageTreeNodesRow) error {
+	remaining := make(map[int64]bool, len(rows))
+	for _, row := range rows {
+		remaining[row.ID] = true
+	}
+
+	var walk func(items []ReorderItem, depth int) error
+	walk = func(items []ReorderItem, depth int) error {
+		for _, item := range items {
+			if depth > service.MaxPageDepth {
+				return service.ErrPageTreeTooDeep
+			}
+			if !remaining[item.ID] {
+				return fmt.Errorf("page %d is not part of this tree or is listed twice", item.ID)
+			}
+			delete(remaining, item.ID)
+			if err := walk(item.Children, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(items, 1); err != nil {
+		return err
+	}
+	if len(remaining) > 0 {
+		return errors.New("the page tree has changed, reload the page and try again")
+	}
+	return nil
+}
+
+// applyPageTree stores the parents and positions of a validated page tree
+// in one transaction.
+func (h *PagesHandler) applyPageTree(ctx context.Context, items []ReorderItem) error {
+	tx, err := h.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	qtx := h.queries.WithTx(tx)
+
+	var apply func(items []ReorderItem, parentID sql.NullInt64) error
+	apply = func(items []ReorderItem, parentID sql.NullInt64) error {
+		for position, item := range items {
+			if err := qtx.UpsertPageHierarchy(ctx, store.UpsertPageHierarchyParams{
+				PageID:   item.ID,
+				ParentID: parentID,
+				Position: int64(position),
+			}); err != nil {
+				return fmt.Errorf("page %d: %w", item.ID, err)
+			}
+			if err := apply(item.Children, sql.NullInt64{Int64: item.ID, Valid: true}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := apply(items, sql.NullInt64{}); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// pageFormParentLanguage returns the language whose pages are offered as
+// parents on the page form.
+func pageFormParentLanguage(data PageFormData) string {
+	if code := strings.TrimSpace(data.FormValues["language_code"]); code != "" {
+		return code
+	}
+	if data.Page != nil {
+		return data.Page.LanguageCode
+	}
+	if data.Language != nil {
+		return data.Language.Code
+	}
+	return ""
+}
@@ -0,0 +1,264 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// createNestedPage creates a published page below parentID (0 for a
+// top-level page) and returns its ID.
+func createNestedPage(t *testing.T, db *sql.DB, slug, title string, parentID, authorID int64) int64 {
+	t.Helper()
+	res, err := db.Exec(
+		`INSERT INTO pages (title, slug, body, status, author_id, page_type, published_at)
+		 VALUES (?, ?, ?, 'published', ?, 'page', CURRENT_TIMESTAMP)`,
+		title, slug, "<p>"+title+" content</p>", authorID,
+	)
+	if err != nil {
+		t.Fatalf("failed to create page %q: %v", slug, err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		t.Fatalf("failed to get page id: %v", err)
+	}
+	if parentID != 0 {
+		if _, err := db.Exec(`INSERT INTO page_hierarchy (page_id, parent_id, position) VALUES (?, ?, 0)`, id, parentID); err != nil {
+			t.Fatalf("failed to nest page %q: %v", slug, err)
+		}
+	}
+	return id
+}
+
+// newNestedPageRequest creates a GET request for a page path matched by
+// RoutePagePath.
+func newNestedPageRequest(path string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/"+path, nil)
+	rctx := chi.NewRouteContext()
+	slug, rest, _ := strings.Cut(path, "/")
+	rctx.URLParams.Add("slug", slug)
+	if rest != "" {
+		rctx.URLParams.Add("*", rest)
+	}
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestFrontendHandler_Page_NestedPath(t *testing.T) {
+	db, _ := testHandlerSetup(t)
+	admin := createTestAdminUser(t, db)
+	docs := createNestedPage(t, db, "docs", "Documentation", 0, admin.ID)
+	install := createNestedPage(t, db, "install", "Installation", docs, admin.ID)
+	createNestedPage(t, db, "linux", "Linux Setup", install, admin.ID)
+
+	h := NewFrontendHandler(db, testThemeManager(), nil, slog.Default(), nil, nil)
+
+	w := httptest.NewRecorder()
+	h.Page(w, newNestedPageRequest("docs/install"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET /docs/install status = %d, want %d", w.Code, http.StatusOK)
+	}
+	body := w.Body.String()
+	for _, want := range []string{`href="/docs"`, "Documentation", `href="/docs/install/linux"`, "Linux Setup", "BreadcrumbList"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("GET /docs/install body does not contain %q", want)
+		}
+	}
+
+	w = httptest.NewRecorder()
+	h.Page(w, newNestedPageRequest("install"))
+	if w.Code != http.StatusMovedPermanently {
+		t.Fatalf("GET /install status = %d, want %d", w.Code, http.StatusMovedPermanently)
+	}
+	if got := w.Header().Get("Location"); got != "/docs/install" {
+		t.Errorf("GET /install Location = %q, want /docs/install", got)
+	}
+
+	for _, path := range []string{"other/install", "docs/linux", "docs/install/linux/extra"} {
+		w = httptest.NewRecorder()
+		h.Page(w, newNestedPageRequest(path))
+		if w.Code != http.StatusNotFound {
+			t.Errorf("GET /%s status = %d, want %d", path, w.Code, http.StatusNotFound)
+		}
+	}
+}
+
+func TestFlattenPageTree_ExcludesSubtree(t *testing.T) {
+	parent := func(id int64) sql.NullInt64 { return sql.NullInt64{Int64: id, Valid: true} }
+	rows := []store.ListPageTreeNodesRow{
+		{ID: 1, Title: "Docs", Slug: "docs"},
+		{ID: 2, Title: "Install", Slug: "install", ParentID: parent(1)},
+		{ID: 3, Title: "Linux", Slug: "linux", ParentID: parent(2)},
+		{ID: 4, Title: "About", Slug: "about"},
+		{ID: 5, Title: "Orphan", Slug: "orphan", ParentID: parent(99)},
+	}
+	tree := buildPageTree(rows)
+
+	paths := pageTreePaths(tree, "", make(map[int64]string))
+	if paths[3] != "docs/install/linux" || paths[5] != "orphan" {
+		t.Errorf("pageTreePaths() = %v", paths)
+	}
+
+	options := flattenPageTree(tree, 0, 2)
+	var ids []int64
+	for _, opt := range options {
+		ids = append(ids, opt.ID)
+	}
+	if len(ids) != 3 || ids[0] != 1 || ids[1] != 4 || ids[2] != 5 {
+		t.Errorf("flattenPageTree(exclude 2) IDs = %v, want [1 4 5]", ids)
+	}
+}
+
+func TestValidatePageTreeRequest(t *testing.T) {
+	rows := []store.ListPageTreeNodesRow{{ID: 1}, {ID: 2}, {ID: 3}}
+
+	tests := []struct {
+		name    string
+		items   []ReorderItem
+		wantErr bool
+	}{
+		{"complete tree", []ReorderItem{{ID: 1, Children: []ReorderItem{{ID: 2}}}, {ID: 3}}, false},
+		{"missing page", []ReorderItem{{ID: 1}, {ID: 2}}, true},
+		{"duplicate page", []ReorderItem{{ID: 1}, {ID: 2}, {ID: 3, Children: []ReorderItem{{ID: 1}}}}, true},
+		{"unknown page", []ReorderItem{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePageTreeRequest(tt.items, rows)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validatePageTreeRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// A chain deeper than MaxPageDepth is rejected.
+	var chainRows []store.ListPageTreeNodesRow
+	var chain []ReorderItem
+	for id := int64(10); id > 0; id-- {
+		chainRows = append(chainRows, store.ListPageTreeNodesRow{ID: id})
+		chain = []ReorderItem{{ID: id, Children: chain}}
+	}
+	if err := validatePageTreeRequest(chain, chainRows); err == nil {
+		t.Error("validatePageTreeRequest() accepted a tree deeper than MaxPageDepth")
+	}
+}
+
+func TestPagesHandler_RedirectMovedPages(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	admin := createTestAdminUser(t, db)
+	docs := createNestedPage(t, db, "docs", "Documentation", 0, admin.ID)
+	guides := createNestedPage(t, db, "guides", "Guides", 0, admin.ID)
+	install := createNestedPage(t, db, "install", "Installation", docs, admin.ID)
+	createNestedPage(t, db, "linux", "Linux Setup", install, admin.ID)
+
+	h := NewPagesHandler(db, nil, sm)
+	ctx := context.Background()
+	if _, err := db.Exec(`INSERT INTO redirects (source_path, target_url, status_code, target_type, enabled, created_at, updated_at)
+		VALUES ('/old-install', '/docs/install', 301, '_self', 1, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`); err != nil {
+		t.Fatalf("failed to create redirect: %v", err)
+	}
+
+	oldURLs := h.urlHistory.Capture(ctx, false, "en")
+	if err := h.applyPageTree(ctx, []ReorderItem{
+		{ID: docs},
+		{ID: guides, Children: []ReorderItem{{ID: install, Children: []ReorderItem{{ID: install + 1}}}}},
+	}); err != nil {
+		t.Fatalf("applyPageTree() error = %v", err)
+	}
+	if moved := h.redirectMovedPages(ctx, "en", oldURLs); moved != 2 {
+		t.Errorf("redirectMovedPages() = %d, want 2", moved)
+	}
+
+	queries := store.New(db)
+	for source, want := range map[string]string{
+		"/docs/install":       "/guides/install",
+		"/docs/install/linux": "/guides/install/linux",
+		"/old-install":        "/guides/install",
+	} {
+		redirect, err := queries.GetRedirectBySourcePath(ctx, source)
+		if err != nil {
+			t.Errorf("redirect from %s: %v", source, err)
+			continue
+		}
+		if redirect.TargetUrl != want || redirect.StatusCode != http.StatusMovedPermanently {
+			t.Errorf("redirect from %s = %s (%d), want %s (301)", source, redirect.TargetUrl, redirect.StatusCode, want)
+		}
+	}
+
+	// Moving the subtree back removes the redirects from its restored URLs.
+	oldURLs = h.urlHistory.Capture(ctx, false, "en")
+	if err := h.applyPageTree(ctx, []ReorderItem{
+		{ID: docs, Children: []ReorderItem{{ID: install, Children: []ReorderItem{{ID: install + 1}}}}},
+		{ID: guides},
+	}); err != nil {
+		t.Fatalf("applyPageTree() error = %v", err)
+	}
+	h.redirectMovedPages(ctx, "en", oldURLs)
+	if _, err := queries.GetRedirectBySourcePath(ctx, "/docs/install"); err == nil {
+		t.Error("redirect from /docs/install still exists after moving the page back")
+	}
+	if redirect, err := queries.GetRedirectBySourcePath(ctx, "/guides/install"); err != nil || redirect.TargetUrl != "/docs/install" {
+		t.Errorf("redirect from /guides/install = %+v, %v; want /docs/install", redirect, err)
+	}
+}
+
+func TestPagesHandler_SaveTree_SiteScoped(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	admin := createTestAdminUser(t, db)
+	docs := createNestedPage(t, db, "docs", "Documentation", 0, admin.ID)
+	digest := createNestedPage(t, db, "digest", "Digest", 0, admin.ID)
+	if _, err := db.Exec(`INSERT INTO sites (id, name, host) VALUES (2, 'Digest', 'digest.example.org')`); err != nil {
+		t.Fatalf("create site: %v", err)
+	}
+	if _, err := db.Exec(`UPDATE pages SET site_id = 2 WHERE id = ?`, digest); err != nil {
+		t.Fatalf("move page: %v", err)
+	}
+	h := NewPagesHandler(db, nil, sm)
+
+	tests := []struct {
+		name       string
+		siteID     int64
+		body       string
+		wantStatus int
+	}{
+		{"own pages", store.DefaultSiteID, fmt.Sprintf(`{"language":"en","items":[{"id":%d}]}`, docs), http.StatusOK},
+		{"page of another site", store.DefaultSiteID, fmt.Sprintf(`{"language":"en","items":[{"id":%d},{"id":%d}]}`, docs, digest), http.StatusBadRequest},
+		{"other site", 2, fmt.Sprintf(`{"language":"en","items":[{"id":%d}]}`, digest), http.StatusOK},
+		{"nested under another site", 2, fmt.Sprintf(`{"language":"en","items":[{"id":%d,"children":[{"id":%d}]}]}`, digest, docs), http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/admin/pages/tree", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = addUserToContext(req, &admin)
+			req = req.WithContext(store.WithSiteID(req.Context(), tt.siteID))
+			w := httptest.NewRecorder()
+
+			h.SaveTree(w, req)
+
+			assertStatus(t, w.Code, tt.wantStatus)
+		})
+	}
+
+	// The rejected trees left the page of the default site at the top level
+	var parent sql.NullInt64
+	err := db.QueryRow(`SELECT parent_id FROM page_hierarchy WHERE page_id = ?`, docs).Scan(&parent)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("load hierarchy: %v", err)
+	}
+	if parent.Valid {
+		t.Errorf("docs parent = %d, want a top-level page", parent.Int64)
+	}
+}
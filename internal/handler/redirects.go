@@ -6,8 +6,10 @@ package handler
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -21,6 +23,7 @@ import (
 	"github.com/olegiv/ocms-go/internal/service"
 	"github.com/olegiv/ocms-go/internal/store"
 	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+	"github.com/olegiv/ocms-go/internal/webhook"
 )
 
 // RedirectsHandler handles redirect management routes.
@@ -30,6 +33,8 @@ type RedirectsHandler struct {
 	sessionManager      *scs.SessionManager
 	eventService        *service.EventService
 	redirectsMiddleware *middleware.RedirectsMiddleware
+	dispatcher          *webhook.Dispatcher
+	urlHistory          *URLHistory
 }
 
 // NewRedirectsHandler creates a new RedirectsHandler.
@@ -40,9 +45,21 @@ func NewRedirectsHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionM
 		sessionManager:      sm,
 		eventService:        service.NewEventService(db),
 		redirectsMiddleware: rm,
+		urlHistory:          NewURLHistory(db),
 	}
 }
 
+// SetDispatcher sets the webhook dispatcher for event dispatching.
+func (h *RedirectsHandler) SetDispatcher(d *webhook.Dispatcher) {
+	h.dispatcher = d
+	h.urlHistory.SetDispatcher(d)
+}
+
+// dispatchRedirectEvent dispatches a redirect-related webhook event.
+func (h *RedirectsHandler) dispatchRedirectEvent(ctx context.Context, eventType string, redirect store.Redirect) {
+	dispatchWebhookEvent(ctx, h.dispatcher, eventType, webhook.NewRedirectEventData(redirect), "redirect_id", redirect.ID)
+}
+
 // StatusCodeOption represents a status code option for the form select.
 type StatusCodeOption struct {
 	Code  int
@@ -89,15 +106,21 @@ func (h *RedirectsHandler) NewForm(w http.ResponseWriter, r *http.Request) {
 
 	viewData := adminviews.RedirectFormData{
 		StatusCodes: convertStatusCodes(ValidStatusCodes),
+		MatchTypes:  model.RedirectMatchTypes,
 		TargetTypes: model.ValidTargets,
 		Errors:      make(map[string]string),
 		FormValues: map[string]string{
 			"status_code": "301",
+			"match_type":  model.RedirectMatchExact,
 			"target_type": model.TargetSelf,
 			"enabled":     "true",
 		},
 		IsEdit: false,
 	}
+	// The 404 log links here to create a redirect for a missing path
+	if source := r.URL.Query().Get("source_path"); strings.HasPrefix(source, "/") && len(source) <= maxNotFoundPathLength {
+		viewData.FormValues["source_path"] = source
+	}
 
 	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "redirects.new"), redirectFormBreadcrumbs(lang, false))
 	renderTempl(w, r, adminviews.RedirectFormPage(pc, viewData))
@@ -122,9 +145,12 @@ func (h *RedirectsHandler) Create(w http.ResponseWriter, r *http.Request) {
 		input.Errors["source_path"] = errMsg
 	}
 
+	h.resolveRedirectChain(r.Context(), &input)
+
 	if len(input.Errors) > 0 {
 		viewData := adminviews.RedirectFormData{
 			StatusCodes: convertStatusCodes(ValidStatusCodes),
+			MatchTypes:  model.RedirectMatchTypes,
 			TargetTypes: model.ValidTargets,
 			Errors:      input.Errors,
 			FormValues:  input.FormValues,
@@ -137,13 +163,15 @@ func (h *RedirectsHandler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	now := time.Now()
-	redirect, err := h.queries.CreateRedirect(r.Context(), store.CreateRedirectParams{
+	redirect, err := h.createRedirect(r.Context(), store.CreateRedirectParams{
 		SourcePath: input.SourcePath,
 		TargetUrl:  input.TargetURL,
 		StatusCode: input.StatusCode,
 		IsWildcard: input.IsWildcard,
 		TargetType: input.TargetType,
 		Enabled:    input.Enabled,
+		IsRegex:    input.IsRegex,
+		DropQuery:  input.DropQuery,
 		CreatedAt:  now,
 		UpdatedAt:  now,
 	})
@@ -177,6 +205,7 @@ func (h *RedirectsHandler) EditForm(w http.ResponseWriter, r *http.Request) {
 	viewData := adminviews.RedirectFormData{
 		Redirect:    convertRedirectInfo(&redirect),
 		StatusCodes: convertStatusCodes(ValidStatusCodes),
+		MatchTypes:  model.RedirectMatchTypes,
 		TargetTypes: model.ValidTargets,
 		Errors:      make(map[string]string),
 		FormValues:  make(map[string]string),
@@ -219,10 +248,13 @@ func (h *RedirectsHandler) Update(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	h.resolveRedirectChain(r.Context(), &input)
+
 	if len(input.Errors) > 0 {
 		viewData := adminviews.RedirectFormData{
 			Redirect:    convertRedirectInfo(&redirect),
 			StatusCodes: convertStatusCodes(ValidStatusCodes),
+			MatchTypes:  model.RedirectMatchTypes,
 			TargetTypes: model.ValidTargets,
 			Errors:      input.Errors,
 			FormValues:  input.FormValues,
@@ -234,8 +266,7 @@ func (h *RedirectsHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	now := time.Now()
-	_, err = h.queries.UpdateRedirect(r.Context(), store.UpdateRedirectParams{
+	_, err = h.updateRedirect(r.Context(), store.UpdateRedirectParams{
 		ID:         id,
 		SourcePath: input.SourcePath,
 		TargetUrl:  input.TargetURL,
@@ -243,7 +274,9 @@ func (h *RedirectsHandler) Update(w http.ResponseWriter, r *http.Request) {
 		IsWildcard: input.IsWildcard,
 		TargetType: input.TargetType,
 		Enabled:    input.Enabled,
-		UpdatedAt:  now,
+		IsRegex:    input.IsRegex,
+		DropQuery:  input.DropQuery,
+		UpdatedAt:  time.Now(),
 	})
 	if err != nil {
 		slog.Error("failed to update redirect", "error", err, "redirect_id", id)
@@ -284,6 +317,7 @@ func (h *RedirectsHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	slog.Info("redirect deleted", "redirect_id", id, "source_path", redirect.SourcePath, "deleted_by", middleware.GetUserID(r))
 	_ = h.eventService.LogConfigEvent(r.Context(), model.EventLevelInfo, "Redirect deleted", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"redirect_id": id, "source_path": redirect.SourcePath})
 	h.redirectsMiddleware.InvalidateCache()
+	h.dispatchRedirectEvent(r.Context(), model.EventRedirectDeleted, redirect)
 
 	if r.Header.Get("HX-Request") == "true" {
 		w.WriteHeader(http.StatusOK)
@@ -325,20 +359,15 @@ func (h *RedirectsHandler) Toggle(w http.ResponseWriter, r *http.Request) {
 	slog.Info("redirect toggled", "redirect_id", id, "enabled", newStatus, "toggled_by", middleware.GetUserID(r))
 	_ = h.eventService.LogConfigEvent(r.Context(), model.EventLevelInfo, "Redirect toggled", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"redirect_id": id, "enabled": newStatus})
 	h.redirectsMiddleware.InvalidateCache()
+	toggled := redirect
+	toggled.Enabled = newStatus
+	toggled.UpdatedAt = now
+	h.dispatchRedirectEvent(r.Context(), model.EventRedirectUpdated, toggled)
 
 	if r.Header.Get("HX-Request") == "true" {
 		// Render updated row for htmx swap
 		pc := buildPageContext(r, h.sessionManager, h.renderer, "", nil)
-		item := adminviews.RedirectListItem{
-			ID:         redirect.ID,
-			SourcePath: redirect.SourcePath,
-			TargetURL:  redirect.TargetUrl,
-			StatusCode: redirect.StatusCode,
-			IsWildcard: redirect.IsWildcard,
-			TargetType: redirect.TargetType,
-			Enabled:    newStatus,
-		}
-		renderTempl(w, r, adminviews.RedirectRow(pc, item))
+		renderTempl(w, r, adminviews.RedirectRow(pc, convertRedirectListItems([]store.Redirect{toggled})[0]))
 		return
 	}
 
@@ -364,8 +393,11 @@ type redirectFormInput struct {
 	SourcePath string
 	TargetURL  string
 	StatusCode int64
+	MatchType  string
 	IsWildcard bool
+	IsRegex    bool
 	TargetType string
+	DropQuery  bool
 	Enabled    bool
 	FormValues map[string]string
 	Errors     map[string]string
@@ -374,14 +406,12 @@ type redirectFormInput struct {
 // parseRedirectFormInput parses and validates redirect form input.
 func parseRedirectFormInput(r *http.Request) redirectFormInput {
 	sourcePath := strings.TrimSpace(r.FormValue("source_path"))
-	targetURL := strings.TrimSpace(r.FormValue("target_url"))
 	statusCodeStr := r.FormValue("status_code")
-	// Auto-detect wildcards: if source path contains * or **, set isWildcard automatically
-	isWildcard := r.FormValue("is_wildcard") == "true" ||
-		r.FormValue("is_wildcard") == "on" ||
-		strings.Contains(sourcePath, "*")
-	targetType := r.FormValue("target_type")
-	enabled := r.FormValue("enabled") == "true" || r.FormValue("enabled") == "on"
+	matchType := r.FormValue("match_type")
+	// Forms without a match type only choose between exact and wildcard
+	if matchType == "" && (r.FormValue("is_wildcard") == "true" || r.FormValue("is_wildcard") == "on") {
+		matchType = model.RedirectMatchWildcard
+	}
 
 	// Parse status code
 	statusCode, err := strconv.ParseInt(statusCodeStr, 10, 64)
@@ -389,66 +419,151 @@ func parseRedirectFormInput(r *http.Request) redirectFormInput {
 		statusCode = 301 // Default to permanent redirect
 	}
 
-	// Default target type
-	if targetType == "" {
-		targetType = model.TargetSelf
+	input := redirectFormInput{
+		SourcePath: sourcePath,
+		TargetURL:  strings.TrimSpace(r.FormValue("target_url")),
+		StatusCode: statusCode,
+		MatchType:  matchType,
+		TargetType: r.FormValue("target_type"),
+		DropQuery:  r.FormValue("drop_query") == "true" || r.FormValue("drop_query") == "on",
+		Enabled:    r.FormValue("enabled") == "true" || r.FormValue("enabled") == "on",
 	}
+	validateRedirectInput(&input)
 
-	formValues := map[string]string{
-		"source_path": sourcePath,
-		"target_url":  targetURL,
+	input.FormValues = map[string]string{
+		"source_path": input.SourcePath,
+		"target_url":  input.TargetURL,
 		"status_code": statusCodeStr,
-		"target_type": targetType,
+		"match_type":  input.MatchType,
+		"target_type": input.TargetType,
 	}
-	if isWildcard {
-		formValues["is_wildcard"] = "true"
+	if input.DropQuery {
+		input.FormValues["drop_query"] = "true"
 	}
-	if enabled {
-		formValues["enabled"] = "true"
+	if input.Enabled {
+		input.FormValues["enabled"] = "true"
 	}
+	return input
+}
 
-	validationErrors := make(map[string]string)
+// createRedirect stores a new redirect, shortens the chains that now lead
+// through it, clears its path from the 404 log and dispatches
+// redirect.created. The caller invalidates the redirects cache.
+func (h *RedirectsHandler) createRedirect(ctx context.Context, params store.CreateRedirectParams) (store.Redirect, error) {
+	redirect, err := h.queries.CreateRedirect(ctx, params)
+	if err != nil {
+		return redirect, err
+	}
+	h.skipRedirectChains(ctx, redirect, params.UpdatedAt)
+	h.forgetNotFoundPath(ctx, redirect)
+	h.dispatchRedirectEvent(ctx, model.EventRedirectCreated, redirect)
+	return redirect, nil
+}
+
+// updateRedirect is createRedirect for changes to an existing redirect and
+// dispatches redirect.updated.
+func (h *RedirectsHandler) updateRedirect(ctx context.Context, params store.UpdateRedirectParams) (store.Redirect, error) {
+	redirect, err := h.queries.UpdateRedirect(ctx, params)
+	if err != nil {
+		return redirect, err
+	}
+	h.skipRedirectChains(ctx, redirect, params.UpdatedAt)
+	h.dispatchRedirectEvent(ctx, model.EventRedirectUpdated, redirect)
+	return redirect, nil
+}
+
+// resolveRedirectChain rejects an exact redirect whose target leads back to
+// its source, and points a redirect to an already redirected path straight
+// at the final destination.
+func (h *RedirectsHandler) resolveRedirectChain(ctx context.Context, input *redirectFormInput) {
+	if len(input.Errors) > 0 || input.IsWildcard || input.IsRegex {
+		return
+	}
+	target, err := resolveRedirectTarget(ctx, h.queries, input.SourcePath, input.TargetURL)
+	switch {
+	case errors.Is(err, errRedirectLoop):
+		input.Errors["target_url"] = "Target redirects back to the source path, which would create a redirect loop"
+	case err != nil:
+		slog.Error("failed to resolve redirect target", "error", err, "target_url", input.TargetURL)
+	default:
+		input.TargetURL = target
+		input.FormValues["target_url"] = target
+	}
+}
+
+// skipRedirectChains points redirects whose target is the source of an
+// enabled exact redirect at that redirect's target.
+func (h *RedirectsHandler) skipRedirectChains(ctx context.Context, redirect store.Redirect, now time.Time) {
+	if !redirect.Enabled || redirect.IsWildcard || redirect.IsRegex {
+		return
+	}
+	h.urlHistory.retargetRedirects(ctx, map[string]string{redirect.SourcePath: redirect.TargetUrl}, now)
+}
+
+// validateRedirectInput normalizes a redirect rule and records its
+// validation errors by field.
+func validateRedirectInput(input *redirectFormInput) {
+	input.Errors = make(map[string]string)
+
+	// Auto-detect wildcards: a plain source path containing * is a wildcard
+	if input.MatchType == "" {
+		input.MatchType = model.RedirectMatchExact
+	}
+	if input.MatchType == model.RedirectMatchExact && strings.Contains(input.SourcePath, "*") {
+		input.MatchType = model.RedirectMatchWildcard
+	}
+	if !model.IsValidRedirectMatchType(input.MatchType) {
+		input.Errors["match_type"] = "Invalid match type"
+		input.MatchType = model.RedirectMatchExact
+	}
+	input.IsWildcard = input.MatchType == model.RedirectMatchWildcard
+	input.IsRegex = input.MatchType == model.RedirectMatchRegex
+
+	// Default target type
+	if input.TargetType == "" {
+		input.TargetType = model.TargetSelf
+	}
 
 	// Validate source path
-	if sourcePath == "" {
-		validationErrors["source_path"] = "Source path is required"
-	} else if !strings.HasPrefix(sourcePath, "/") {
-		validationErrors["source_path"] = "Source path must start with /"
+	switch {
+	case input.SourcePath == "":
+		input.Errors["source_path"] = "Source path is required"
+	case input.IsRegex:
+		if _, err := middleware.CompileRedirectPattern(input.SourcePath); err != nil {
+			input.Errors["source_path"] = "Invalid regular expression: " + err.Error()
+		}
+	case !strings.HasPrefix(input.SourcePath, "/"):
+		input.Errors["source_path"] = "Source path must start with /"
+	default:
+		if _, query, ok := strings.Cut(input.SourcePath, "?"); ok {
+			if _, err := url.ParseQuery(query); err != nil || query == "" {
+				input.Errors["source_path"] = "Invalid query string in source path"
+			}
+		}
 	}
 
 	// Validate target URL
-	if targetURL == "" {
-		validationErrors["target_url"] = "Target URL is required"
+	if input.TargetURL == "" {
+		input.Errors["target_url"] = "Target URL is required"
 	}
 
 	// Validate status code
 	validStatusCode := false
 	for _, sc := range ValidStatusCodes {
-		if int64(sc.Code) == statusCode {
+		if int64(sc.Code) == input.StatusCode {
 			validStatusCode = true
 			break
 		}
 	}
 	if !validStatusCode {
-		validationErrors["status_code"] = "Invalid status code"
-		statusCode = 301
+		input.Errors["status_code"] = "Invalid status code"
+		input.StatusCode = 301
 	}
 
 	// Validate target type
-	if !model.IsValidTarget(targetType) {
-		validationErrors["target_type"] = "Invalid target type"
-		targetType = model.TargetSelf
-	}
-
-	return redirectFormInput{
-		SourcePath: sourcePath,
-		TargetURL:  targetURL,
-		StatusCode: statusCode,
-		IsWildcard: isWildcard,
-		TargetType: targetType,
-		Enabled:    enabled,
-		FormValues: formValues,
-		Errors:     validationErrors,
+	if !model.IsValidTarget(input.TargetType) {
+		input.Errors["target_type"] = "Invalid target type"
+		input.TargetType = model.TargetSelf
 	}
 }
 
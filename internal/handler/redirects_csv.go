@@ -0,0 +1,265 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+const (
+	// maxRedirectImportBytes caps uploaded redirect CSV files.
+	maxRedirectImportBytes = 5 << 20
+	// maxRedirectImportErrors is how many row errors the import reports.
+	maxRedirectImportErrors = 5
+)
+
+// redirectCSVColumns are the columns of exported redirect CSV files. Imports
+// accept them in any order, and files without a header row in this order.
+// hit_count and last_hit_at are informational and ignored on import.
+var redirectCSVColumns = []string{
+	"source_path", "target_url", "status_code", "match_type", "target_type",
+	"drop_query", "enabled", "hit_count", "last_hit_at",
+}
+
+// ExportCSV handles GET /admin/redirects/export - downloads all redirects as CSV.
+func (h *RedirectsHandler) ExportCSV(w http.ResponseWriter, r *http.Request) {
+	redirects, err := h.queries.ListRedirects(r.Context())
+	if err != nil {
+		logAndInternalError(w, "failed to list redirects", "error", err)
+		return
+	}
+
+	var csvBuilder strings.Builder
+	csvBuilder.WriteString(escapeCSVRow(redirectCSVColumns))
+	csvBuilder.WriteString("\n")
+	for _, rd := range redirects {
+		lastHitAt := ""
+		if rd.LastHitAt.Valid {
+			lastHitAt = rd.LastHitAt.Time.UTC().Format(time.RFC3339)
+		}
+		csvBuilder.WriteString(escapeCSVRow([]string{
+			rd.SourcePath,
+			rd.TargetUrl,
+			strconv.FormatInt(rd.StatusCode, 10),
+			model.RedirectMatchType(rd.IsWildcard, rd.IsRegex),
+			rd.TargetType,
+			strconv.FormatBool(rd.DropQuery),
+			strconv.FormatBool(rd.Enabled),
+			strconv.FormatInt(rd.HitCount, 10),
+			lastHitAt,
+		}))
+		csvBuilder.WriteString("\n")
+	}
+
+	filename := fmt.Sprintf("redirects-%s.csv", time.Now().Format("2006-01-02"))
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+	_, _ = w.Write([]byte(csvBuilder.String()))
+
+	slog.Info("redirects exported", "count", len(redirects))
+}
+
+// ImportCSV handles POST /admin/redirects/import - creates redirects from an
+// uploaded CSV file, updating those whose source path already exists.
+func (h *RedirectsHandler) ImportCSV(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentReadOnly, redirectAdminRedirects) {
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportRequestBytes(maxRedirectImportBytes))
+	if err := r.ParseMultipartForm(maxRedirectImportBytes); err != nil {
+		flashError(w, r, h.renderer, redirectAdminRedirects, "Failed to parse form: "+err.Error())
+		return
+	}
+	file, _, err := r.FormFile("csv_file")
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminRedirects, "Please select a CSV file to import")
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	rows, err := parseRedirectCSV(file)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminRedirects, "Invalid CSV file: "+err.Error())
+		return
+	}
+
+	result := h.importRedirects(r.Context(), rows)
+	h.redirectsMiddleware.InvalidateCache()
+	slog.Info("redirects imported", "created", result.Created, "updated", result.Updated, "skipped", len(result.Errors), "imported_by", middleware.GetUserID(r))
+	_ = h.eventService.LogConfigEvent(r.Context(), model.EventLevelInfo, "Redirects imported", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"created": result.Created, "updated": result.Updated, "skipped": len(result.Errors)})
+
+	message := fmt.Sprintf("Imported redirects: %d created, %d updated", result.Created, result.Updated)
+	if len(result.Errors) == 0 {
+		flashSuccess(w, r, h.renderer, redirectAdminRedirects, message)
+		return
+	}
+	shown := result.Errors[:min(len(result.Errors), maxRedirectImportErrors)]
+	message += fmt.Sprintf(", %d skipped: %s", len(result.Errors), strings.Join(shown, "; "))
+	flashError(w, r, h.renderer, redirectAdminRedirects, message)
+}
+
+// redirectCSVRow is a data row of a redirect CSV file.
+type redirectCSVRow struct {
+	Line   int
+	Values map[string]string
+}
+
+// parseRedirectCSV reads the data rows of a redirect CSV file by column name.
+func parseRedirectCSV(file io.Reader) ([]redirectCSVRow, error) {
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	columns := redirectCSVColumns
+	var rows []redirectCSVRow
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && slices.Contains(record, "source_path") {
+			columns = record
+			continue
+		}
+
+		values := make(map[string]string, len(columns))
+		for i, value := range record {
+			if i < len(columns) {
+				values[strings.TrimSpace(columns[i])] = unneutralizeCSVFormula(strings.TrimSpace(value))
+			}
+		}
+		if values["source_path"] == "" && values["target_url"] == "" {
+			continue // blank line
+		}
+		rows = append(rows, redirectCSVRow{Line: line, Values: values})
+	}
+	return rows, nil
+}
+
+// unneutralizeCSVFormula reverses neutralizeCSVFormula for imported values.
+func unneutralizeCSVFormula(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.ContainsAny(value[1:2], "=+-@") {
+		return value[1:]
+	}
+	return value
+}
+
+// redirectImportResult counts the outcome of a redirect CSV import.
+type redirectImportResult struct {
+	Created int
+	Updated int
+	Errors  []string
+}
+
+// importRedirects validates and saves imported rows. Invalid rows are skipped
+// and reported with their line number.
+func (h *RedirectsHandler) importRedirects(ctx context.Context, rows []redirectCSVRow) redirectImportResult {
+	var result redirectImportResult
+	now := time.Now()
+	for _, row := range rows {
+		input := redirectFormInput{
+			SourcePath: row.Values["source_path"],
+			TargetURL:  row.Values["target_url"],
+			StatusCode: 301,
+			MatchType:  strings.ToLower(row.Values["match_type"]),
+			TargetType: row.Values["target_type"],
+			Enabled:    true,
+		}
+		if v := row.Values["status_code"]; v != "" {
+			input.StatusCode, _ = strconv.ParseInt(v, 10, 64)
+		}
+		if v := row.Values["drop_query"]; v != "" {
+			input.DropQuery, _ = strconv.ParseBool(v)
+		}
+		if v := row.Values["enabled"]; v != "" {
+			input.Enabled, _ = strconv.ParseBool(v)
+		}
+		validateRedirectInput(&input)
+		if len(input.Errors) > 0 {
+			result.Errors = append(result.Errors, fmt.Sprintf("line %d: %s", row.Line, firstRedirectError(input.Errors)))
+			continue
+		}
+
+		created, err := h.saveImportedRedirect(ctx, input, now)
+		if err != nil {
+			slog.Error("failed to import redirect", "error", err, "source_path", input.SourcePath)
+			result.Errors = append(result.Errors, fmt.Sprintf("line %d: could not save redirect", row.Line))
+			continue
+		}
+		if created {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+	}
+	return result
+}
+
+// saveImportedRedirect creates a redirect, or updates the one with the same
+// source path, the way the redirect form does. It reports whether the
+// redirect was created.
+func (h *RedirectsHandler) saveImportedRedirect(ctx context.Context, input redirectFormInput, now time.Time) (bool, error) {
+	existing, err := h.queries.GetRedirectBySourcePath(ctx, input.SourcePath)
+	if errors.Is(err, sql.ErrNoRows) {
+		_, err = h.createRedirect(ctx, store.CreateRedirectParams{
+			SourcePath: input.SourcePath,
+			TargetUrl:  input.TargetURL,
+			StatusCode: input.StatusCode,
+			IsWildcard: input.IsWildcard,
+			TargetType: input.TargetType,
+			Enabled:    input.Enabled,
+			IsRegex:    input.IsRegex,
+			DropQuery:  input.DropQuery,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		})
+		return err == nil, err
+	}
+	if err != nil {
+		return false, err
+	}
+	_, err = h.updateRedirect(ctx, store.UpdateRedirectParams{
+		SourcePath: input.SourcePath,
+		TargetUrl:  input.TargetURL,
+		StatusCode: input.StatusCode,
+		IsWildcard: input.IsWildcard,
+		TargetType: input.TargetType,
+		Enabled:    input.Enabled,
+		IsRegex:    input.IsRegex,
+		DropQuery:  input.DropQuery,
+		UpdatedAt:  now,
+		ID:         existing.ID,
+	})
+	return false, err
+}
+
+// firstRedirectError returns the validation error of the first invalid field
+// in column order.
+func firstRedirectError(errs map[string]string) string {
+	for _, column := range redirectCSVColumns {
+		if msg, ok := errs[column]; ok {
+			return msg
+		}
+	}
+	return "invalid redirect"
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/store"
+	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+)
+
+const (
+	// NotFoundLogPerPage is the number of 404 log entries per admin page.
+	NotFoundLogPerPage = 25
+	// maxNotFoundPathLength caps logged paths and referrers.
+	maxNotFoundPathLength = 2048
+	// maxNotFoundLogEntries caps the number of logged paths, so crawlers
+	// probing random URLs cannot grow the log without bound. Paths already
+	// in the log keep counting.
+	maxNotFoundLogEntries = 10000
+)
+
+// recordNotFound counts a 404 for path in the 404 log, remembering the
+// latest non-empty referrer.
+func recordNotFound(ctx context.Context, queries *store.Queries, path, referrer string, now time.Time) error {
+	if path == "" || len(path) > maxNotFoundPathLength {
+		return nil
+	}
+	if len(referrer) > maxNotFoundPathLength {
+		referrer = ""
+	}
+
+	updated, err := queries.RecordNotFoundHit(ctx, store.RecordNotFoundHitParams{
+		Referrer:   referrer,
+		LastSeenAt: now,
+		Path:       path,
+	})
+	if err != nil || updated > 0 {
+		return err
+	}
+
+	count, err := queries.CountNotFoundLog(ctx)
+	if err != nil || count >= maxNotFoundLogEntries {
+		return err
+	}
+	return queries.CreateNotFoundLogEntry(ctx, store.CreateNotFoundLogEntryParams{
+		Path:        path,
+		Referrer:    referrer,
+		FirstSeenAt: now,
+		LastSeenAt:  now,
+	})
+}
+
+// forgetNotFoundPath removes the source path of a new exact redirect from the
+// 404 log, as requests for it are redirected from now on.
+func (h *RedirectsHandler) forgetNotFoundPath(ctx context.Context, redirect store.Redirect) {
+	if redirect.IsWildcard || redirect.IsRegex || strings.Contains(redirect.SourcePath, "?") {
+		return
+	}
+	if err := h.queries.DeleteNotFoundLogEntryByPath(ctx, redirect.SourcePath); err != nil {
+		slog.Warn("failed to remove redirected path from 404 log", "error", err, "path", redirect.SourcePath)
+	}
+}
+
+// NotFoundLog handles GET /admin/redirects/not-found - lists the paths that
+// answered 404, most hit first.
+func (h *RedirectsHandler) NotFoundLog(w http.ResponseWriter, r *http.Request) {
+	lang := h.renderer.GetAdminLang(r)
+	page := ParsePageParam(r)
+
+	totalCount, err := h.queries.CountNotFoundLog(r.Context())
+	if err != nil {
+		logAndInternalError(w, "failed to count 404 log", "error", err)
+		return
+	}
+
+	page, _ = NormalizePagination(page, int(totalCount), NotFoundLogPerPage)
+	entries, err := h.queries.ListNotFoundLog(r.Context(), store.ListNotFoundLogParams{
+		Limit:  NotFoundLogPerPage,
+		Offset: int64((page - 1) * NotFoundLogPerPage),
+	})
+	if err != nil {
+		logAndInternalError(w, "failed to list 404 log", "error", err)
+		return
+	}
+
+	items := make([]adminviews.NotFoundLogItem, len(entries))
+	for i, entry := range entries {
+		items[i] = adminviews.NotFoundLogItem{
+			ID:                entry.ID,
+			Path:              entry.Path,
+			Referrer:          entry.Referrer,
+			HitCount:          entry.HitCount,
+			FirstSeenAt:       entry.FirstSeenAt,
+			LastSeenAt:        entry.LastSeenAt,
+			CreateRedirectURL: redirectAdminRedirectsNew + "?" + url.Values{"source_path": {entry.Path}}.Encode(),
+		}
+	}
+
+	viewData := adminviews.NotFoundLogData{
+		Entries:    items,
+		TotalCount: totalCount,
+		Pagination: convertPagination(BuildAdminPagination(page, int(totalCount), NotFoundLogPerPage, redirectAdminRedirectsNotFound, r.URL.Query())),
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "redirects.not_found_log"), notFoundLogBreadcrumbs(lang))
+	renderTempl(w, r, adminviews.NotFoundLogPage(pc, viewData))
+}
+
+// DeleteNotFound handles DELETE /admin/redirects/not-found/{id} - removes a
+// path from the 404 log.
+func (h *RedirectsHandler) DeleteNotFound(w http.ResponseWriter, r *http.Request) {
+	if demoGuardAPI(w) {
+		return
+	}
+
+	id, err := ParseIDParam(r)
+	if err != nil {
+		http.Error(w, "Invalid 404 log entry ID", http.StatusBadRequest)
+		return
+	}
+
+	entry, ok := requireEntityWithError(w, "404 log entry", id,
+		func(id int64) (store.NotFoundLog, error) { return h.queries.GetNotFoundLogEntryByID(r.Context(), id) })
+	if !ok {
+		return
+	}
+
+	if err := h.queries.DeleteNotFoundLogEntry(r.Context(), id); err != nil {
+		slog.Error("failed to delete 404 log entry", "error", err, "entry_id", id)
+		http.Error(w, "Error deleting 404 log entry", http.StatusInternalServerError)
+		return
+	}
+	slog.Info("404 log entry deleted", "entry_id", id, "path", entry.Path, "deleted_by", middleware.GetUserID(r))
+
+	if r.Header.Get("HX-Request") == "true" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	flashSuccess(w, r, h.renderer, redirectAdminRedirectsNotFound, "404 log entry deleted")
+}
+
+// ClearNotFound handles POST /admin/redirects/not-found/clear - empties the
+// 404 log.
+func (h *RedirectsHandler) ClearNotFound(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentReadOnly, redirectAdminRedirectsNotFound) {
+		return
+	}
+
+	if err := h.queries.ClearNotFoundLog(r.Context()); err != nil {
+		slog.Error("failed to clear 404 log", "error", err)
+		flashError(w, r, h.renderer, redirectAdminRedirectsNotFound, "Error clearing 404 log")
+		return
+	}
+
+	slog.Info("404 log cleared", "cleared_by", middleware.GetUserID(r))
+	_ = h.eventService.LogConfigEvent(r.Context(), model.EventLevelInfo, "404 log cleared", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), nil)
+	flashSuccess(w, r, h.renderer, redirectAdminRedirectsNotFound, "404 log cleared")
+}
+
+// notFoundLogBreadcrumbs returns breadcrumbs for the 404 log page.
+func notFoundLogBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "nav.redirects"), URL: redirectAdminRedirects},
+		{Label: i18n.T(lang, "redirects.not_found_log"), Active: true},
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/testutil"
+	"github.com/olegiv/ocms-go/internal/webhook"
+)
+
+func TestValidateRedirectInput(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        redirectFormInput
+		wantMatch    string
+		wantErrField string
+	}{
+		{name: "exact", input: redirectFormInput{SourcePath: "/old", TargetURL: "/new", StatusCode: 301}, wantMatch: "exact"},
+		{name: "star makes wildcard", input: redirectFormInput{SourcePath: "/blog/*", TargetURL: "/news", StatusCode: 301}, wantMatch: "wildcard"},
+		{name: "query condition", input: redirectFormInput{SourcePath: "/index.php?page=about", TargetURL: "/about", StatusCode: 301}, wantMatch: "exact"},
+		{name: "empty query", input: redirectFormInput{SourcePath: "/index.php?", TargetURL: "/", StatusCode: 301}, wantMatch: "exact", wantErrField: "source_path"},
+		{name: "relative path", input: redirectFormInput{SourcePath: "old", TargetURL: "/new", StatusCode: 301}, wantMatch: "exact", wantErrField: "source_path"},
+		{name: "regex", input: redirectFormInput{SourcePath: `/blog/(\d+)`, TargetURL: "/posts/$1", StatusCode: 301, MatchType: "regex"}, wantMatch: "regex"},
+		{name: "invalid regex", input: redirectFormInput{SourcePath: "/blog/(", TargetURL: "/posts", StatusCode: 301, MatchType: "regex"}, wantMatch: "regex", wantErrField: "source_path"},
+		{name: "unknown match type", input: redirectFormInput{SourcePath: "/old", TargetURL: "/new", StatusCode: 301, MatchType: "glob"}, wantMatch: "exact", wantErrField: "match_type"},
+		{name: "bad status code", input: redirectFormInput{SourcePath: "/old", TargetURL: "/new", StatusCode: 200}, wantMatch: "exact", wantErrField: "status_code"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := tt.input
+			validateRedirectInput(&input)
+
+			if input.MatchType != tt.wantMatch {
+				t.Errorf("MatchType = %q, want %q", input.MatchType, tt.wantMatch)
+			}
+			if input.IsWildcard != (tt.wantMatch == "wildcard") || input.IsRegex != (tt.wantMatch == "regex") {
+				t.Errorf("IsWildcard = %v, IsRegex = %v for match type %q", input.IsWildcard, input.IsRegex, tt.wantMatch)
+			}
+			if tt.wantErrField == "" && len(input.Errors) > 0 {
+				t.Errorf("unexpected errors: %v", input.Errors)
+			}
+			if tt.wantErrField != "" && input.Errors[tt.wantErrField] == "" {
+				t.Errorf("expected error for %s, got %v", tt.wantErrField, input.Errors)
+			}
+		})
+	}
+}
+
+func TestRedirectsHandler_ImportCSV(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	h := NewRedirectsHandler(db, nil, sm, nil)
+	ctx := context.Background()
+	now := time.Now()
+
+	if _, err := h.queries.CreateRedirect(ctx, store.CreateRedirectParams{
+		SourcePath: "/existing", TargetUrl: "/before", StatusCode: 301, TargetType: "_self", Enabled: true, CreatedAt: now, UpdatedAt: now,
+	}); err != nil {
+		t.Fatalf("create redirect: %v", err)
+	}
+	if err := recordNotFound(ctx, h.queries, "/missing", "", now); err != nil {
+		t.Fatalf("record 404: %v", err)
+	}
+
+	csvData := strings.Join([]string{
+		"target_url,source_path,match_type,status_code,drop_query",
+		"/after,/existing,,302,",
+		"/found,/missing,exact,,true",
+		`/posts/$1,/blog/(\d+),regex,301,`,
+		"'=cmd,/formula,,,",
+		"/nowhere,/broken/(,regex,,",
+		",,,,",
+	}, "\n")
+
+	rows, err := parseRedirectCSV(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatalf("parseRedirectCSV: %v", err)
+	}
+	if len(rows) != 5 {
+		t.Fatalf("parsed %d rows, want 5", len(rows))
+	}
+
+	result := h.importRedirects(ctx, rows)
+	if result.Created != 3 || result.Updated != 1 {
+		t.Errorf("created %d, updated %d, want 3 and 1", result.Created, result.Updated)
+	}
+	if len(result.Errors) != 1 || !strings.HasPrefix(result.Errors[0], "line 6:") {
+		t.Errorf("errors = %v, want one error on line 6", result.Errors)
+	}
+
+	updated, err := h.queries.GetRedirectBySourcePath(ctx, "/existing")
+	if err != nil {
+		t.Fatalf("get updated redirect: %v", err)
+	}
+	if updated.TargetUrl != "/after" || updated.StatusCode != 302 {
+		t.Errorf("updated redirect = %q %d, want /after 302", updated.TargetUrl, updated.StatusCode)
+	}
+
+	created, err := h.queries.GetRedirectBySourcePath(ctx, "/missing")
+	if err != nil {
+		t.Fatalf("get created redirect: %v", err)
+	}
+	if !created.DropQuery || created.StatusCode != 301 {
+		t.Errorf("created redirect drop_query = %v, status = %d, want true 301", created.DropQuery, created.StatusCode)
+	}
+	if count, _ := h.queries.CountNotFoundLog(ctx); count != 0 {
+		t.Errorf("404 log has %d entries, want the redirected path removed", count)
+	}
+
+	regex, err := h.queries.GetRedirectBySourcePath(ctx, `/blog/(\d+)`)
+	if err != nil {
+		t.Fatalf("get regex redirect: %v", err)
+	}
+	if !regex.IsRegex || regex.IsWildcard {
+		t.Errorf("regex redirect IsRegex = %v, IsWildcard = %v", regex.IsRegex, regex.IsWildcard)
+	}
+
+	formula, err := h.queries.GetRedirectBySourcePath(ctx, "/formula")
+	if err != nil {
+		t.Fatalf("get formula redirect: %v", err)
+	}
+	if formula.TargetUrl != "=cmd" {
+		t.Errorf("formula target = %q, want the neutralizing quote removed", formula.TargetUrl)
+	}
+}
+
+func TestRecordNotFound(t *testing.T) {
+	db, _ := testHandlerSetup(t)
+	queries := store.New(db)
+	ctx := context.Background()
+	first := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	if err := recordNotFound(ctx, queries, "/gone", "https://example.com/a", first); err != nil {
+		t.Fatalf("record first hit: %v", err)
+	}
+	if err := recordNotFound(ctx, queries, "/gone", "", first.Add(time.Hour)); err != nil {
+		t.Fatalf("record second hit: %v", err)
+	}
+	if err := recordNotFound(ctx, queries, "/"+strings.Repeat("x", maxNotFoundPathLength), "", first); err != nil {
+		t.Fatalf("record long path: %v", err)
+	}
+
+	entries, err := queries.ListNotFoundLog(ctx, store.ListNotFoundLogParams{Limit: 10})
+	if err != nil {
+		t.Fatalf("list 404 log: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("404 log has %d entries, want 1", len(entries))
+	}
+	entry := entries[0]
+	if entry.HitCount != 2 || entry.Referrer != "https://example.com/a" {
+		t.Errorf("entry = %d hits from %q, want 2 hits keeping the referrer", entry.HitCount, entry.Referrer)
+	}
+	if !entry.LastSeenAt.Equal(first.Add(time.Hour)) || !entry.FirstSeenAt.Equal(first) {
+		t.Errorf("first/last seen = %v/%v", entry.FirstSeenAt, entry.LastSeenAt)
+	}
+
+	// Once the log is full, new paths are dropped but known paths keep counting.
+	if _, err := db.Exec(`WITH RECURSIVE n(i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < ?)
+		INSERT INTO not_found_log (path) SELECT '/filler/' || i FROM n`, maxNotFoundLogEntries); err != nil {
+		t.Fatalf("fill 404 log: %v", err)
+	}
+	if err := recordNotFound(ctx, queries, "/new", "", first); err != nil {
+		t.Fatalf("record over cap: %v", err)
+	}
+	if err := recordNotFound(ctx, queries, "/gone", "", first); err != nil {
+		t.Fatalf("record known path over cap: %v", err)
+	}
+	var newCount, goneHits int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM not_found_log WHERE path = '/new'`).Scan(&newCount); err != nil {
+		t.Fatalf("count new path: %v", err)
+	}
+	if err := db.QueryRow(`SELECT hit_count FROM not_found_log WHERE path = '/gone'`).Scan(&goneHits); err != nil {
+		t.Fatalf("read known path: %v", err)
+	}
+	if newCount != 0 || goneHits != 3 {
+		t.Errorf("over cap: new path logged %d times, known path has %d hits, want 0 and 3", newCount, goneHits)
+	}
+}
+
+// recordRedirectEvents starts a webhook dispatcher with a paused webhook
+// subscribed to the redirect events. A paused webhook keeps its deliveries in
+// the dead-letter queue, so events are recorded without being sent. The
+// webhook is created by the user createdBy. The returned function counts the
+// recorded deliveries of an event.
+func recordRedirectEvents(t *testing.T, db *sql.DB, createdBy int64) (*webhook.Dispatcher, func(event string) int) {
+	t.Helper()
+	ctx := context.Background()
+	queries := store.New(db)
+	now := time.Now()
+	wh, err := queries.CreateWebhook(ctx, store.CreateWebhookParams{
+		Name:          "Redirects",
+		Url:           "https://hooks.example.com/in",
+		Secret:        "secret",
+		Events:        `["redirect.created","redirect.updated","redirect.deleted"]`,
+		IsActive:      true,
+		Headers:       "{}",
+		PayloadFormat: "json",
+		CreatedBy:     createdBy,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	})
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+	if _, err := queries.PauseWebhook(ctx, store.PauseWebhookParams{
+		PausedAt: sql.NullTime{Time: now, Valid: true}, PauseReason: "test", ID: wh.ID,
+	}); err != nil {
+		t.Fatalf("PauseWebhook: %v", err)
+	}
+
+	cfg := webhook.DefaultConfig()
+	cfg.EnableDebounce = false
+	d := webhook.NewDispatcher(db, testutil.TestLoggerSilent(), cfg)
+	runCtx, cancel := context.WithCancel(ctx)
+	d.Start(runCtx)
+	t.Cleanup(func() {
+		d.Stop()
+		cancel()
+	})
+
+	return d, func(event string) int {
+		t.Helper()
+		var count int
+		if err := db.QueryRow(`SELECT COUNT(*) FROM webhook_deliveries WHERE event = ?`, event).Scan(&count); err != nil {
+			t.Fatalf("count deliveries: %v", err)
+		}
+		return count
+	}
+}
+
+func TestRedirectsHandler_ImportCSVDispatchesEvents(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	h := NewRedirectsHandler(db, nil, sm, nil)
+	d, deliveries := recordRedirectEvents(t, db, createTestAdminUser(t, db).ID)
+	h.SetDispatcher(d)
+	ctx := context.Background()
+	now := time.Now()
+
+	if _, err := h.queries.CreateRedirect(ctx, store.CreateRedirectParams{
+		SourcePath: "/existing", TargetUrl: "/before", StatusCode: 301, TargetType: "_self", Enabled: true, CreatedAt: now, UpdatedAt: now,
+	}); err != nil {
+		t.Fatalf("create redirect: %v", err)
+	}
+
+	rows, err := parseRedirectCSV(strings.NewReader("source_path,target_url\n/existing,/after\n/old,/new\n/older,/newer\n"))
+	if err != nil {
+		t.Fatalf("parseRedirectCSV: %v", err)
+	}
+	if result := h.importRedirects(ctx, rows); result.Created != 2 || result.Updated != 1 {
+		t.Fatalf("created %d, updated %d, want 2 and 1", result.Created, result.Updated)
+	}
+
+	if got := deliveries(model.EventRedirectCreated); got != 2 {
+		t.Errorf("redirect.created deliveries = %d, want 2", got)
+	}
+	if got := deliveries(model.EventRedirectUpdated); got != 1 {
+		t.Errorf("redirect.updated deliveries = %d, want 1", got)
+	}
+}
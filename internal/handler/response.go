@@ -14,6 +14,7 @@ import (
 	"github.com/olegiv/ocms-go/internal/i18n"
 	"github.com/olegiv/ocms-go/internal/middleware"
 	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/store"
 )
 
 // flashAndRedirect sets a flash message and redirects to the given URL.
@@ -54,6 +55,16 @@ func logAndInternalError(w http.ResponseWriter, logMsg string, args ...any) {
 	logAndHTTPError(w, "Internal Server Error", http.StatusInternalServerError, logMsg, args...)
 }
 
+// siteScopedErr reports an entity of another site than the one the request
+// is scoped to as not found, so the admin panel of one site cannot reach the
+// content of another by ID.
+func siteScopedErr(ctx context.Context, siteID int64, err error) error {
+	if err == nil && siteID != store.SiteIDFromContext(ctx) {
+		return sql.ErrNoRows
+	}
+	return err
+}
+
 // =============================================================================
 // GENERIC ENTITY FETCHING HELPERS
 // =============================================================================
@@ -188,6 +199,7 @@ type deleteEntityParams[T any] struct {
 	RequireFn      func(int64) (T, bool)              // function to fetch and validate entity
 	DeleteFn       func(context.Context, int64) error // function to delete entity
 	GetSlug        func(T) string                     // function to get slug for logging
+	OnDeleted      func(T)                            // optional hook run after a successful delete
 }
 
 // handleDeleteEntity performs a generic delete operation with HTMX support.
@@ -210,6 +222,9 @@ func handleDeleteEntity[T any](w http.ResponseWriter, r *http.Request, renderer
 	}
 
 	slog.Info(p.EntityName+" deleted", p.IDField, id, "slug", p.GetSlug(entity), "deleted_by", middleware.GetUserID(r))
+	if p.OnDeleted != nil {
+		p.OnDeleted(entity)
+	}
 
 	// For HTMX requests, return empty response (row removed)
 	if r.Header.Get("HX-Request") == "true" {
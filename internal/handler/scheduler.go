@@ -27,14 +27,19 @@ import (
 )
 
 const (
-	redirectAdminScheduler = "/admin/scheduler"
-	taskRunsPerPage        = 20
-	taskNameMinLen         = 3
-	taskNameMaxLen         = 100
-	taskURLMaxLen          = 2048
-	taskTimeoutMin         = 1
-	taskTimeoutMax         = 300
-	taskTimeoutDefault     = 30
+	redirectAdminScheduler   = "/admin/scheduler"
+	taskRunsPerPage          = 20
+	taskNameMinLen           = 3
+	taskNameMaxLen           = 100
+	taskURLMaxLen            = 2048
+	taskTimeoutMin           = 1
+	taskTimeoutMax           = 300
+	taskTimeoutDefault       = 30
+	taskRetryDelayMin        = 1
+	taskRetryDelayMax        = 86400
+	taskRetryDelayDefault    = 60
+	taskRetentionDaysDefault = 90
+	taskPageOptionsLimit     = 1000
 )
 
 // parseTaskTimeout parses and clamps a timeout string to the allowed range.
@@ -54,6 +59,109 @@ func parseTaskTimeout(s string) int64 {
 	return timeout
 }
 
+// parseFormInt parses an integer form value, returning def when it is empty or invalid.
+func parseFormInt(s string, def int64) int64 {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return v
+	}
+	return def
+}
+
+// scheduledTaskInput holds the parsed fields of the task form.
+type scheduledTaskInput struct {
+	Name        string
+	URL         string
+	Schedule    string
+	Timeout     int64
+	TaskType    string
+	Params      scheduler.TaskParams
+	RetryPolicy string
+	MaxRetries  int64
+	RetryDelay  int64
+}
+
+// parseScheduledTaskForm reads and validates the task form. It returns a
+// translated error message when the input is invalid. The URL is only kept
+// for URL tasks and the parameters only for the task type that uses them.
+func parseScheduledTaskForm(r *http.Request, lang string) (scheduledTaskInput, string) {
+	in := scheduledTaskInput{
+		Name:        strings.TrimSpace(r.FormValue("name")),
+		URL:         strings.TrimSpace(r.FormValue("url")),
+		Schedule:    strings.TrimSpace(r.FormValue("schedule")),
+		Timeout:     parseTaskTimeout(strings.TrimSpace(r.FormValue("timeout"))),
+		TaskType:    strings.TrimSpace(r.FormValue("task_type")),
+		RetryPolicy: strings.TrimSpace(r.FormValue("retry_policy")),
+		MaxRetries:  parseFormInt(strings.TrimSpace(r.FormValue("max_retries")), 0),
+		RetryDelay:  parseFormInt(strings.TrimSpace(r.FormValue("retry_delay")), taskRetryDelayDefault),
+	}
+	if in.TaskType == "" {
+		in.TaskType = scheduler.TaskTypeURL
+	}
+	if in.RetryPolicy == "" {
+		in.RetryPolicy = scheduler.RetryPolicyNone
+	}
+
+	if !scheduler.IsValidTaskType(in.TaskType) {
+		return in, i18n.T(lang, "scheduler.error_task_type")
+	}
+	isURLTask := in.TaskType == scheduler.TaskTypeURL
+	if !isURLTask {
+		in.URL = ""
+	}
+
+	if in.Name == "" || in.Schedule == "" || (isURLTask && in.URL == "") {
+		return in, i18n.T(lang, "scheduler.error_task_required")
+	}
+
+	// Validate name length
+	if len(in.Name) < taskNameMinLen || len(in.Name) > taskNameMaxLen {
+		return in, i18n.T(lang, "scheduler.error_name_length")
+	}
+
+	if isURLTask {
+		// Validate URL length
+		if len(in.URL) > taskURLMaxLen {
+			return in, i18n.T(lang, "scheduler.error_url_length")
+		}
+
+		// SSRF protection: validate URL before storing
+		if err := scheduler.ValidateTaskURL(in.URL); err != nil {
+			return in, i18n.T(lang, "scheduler.error_invalid_url") + ": " + err.Error()
+		}
+	}
+
+	// Validate cron schedule syntax
+	if err := validateCronSchedule(in.Schedule); err != nil {
+		return in, i18n.T(lang, "scheduler.error_invalid_schedule") + ": " + err.Error()
+	}
+
+	switch in.TaskType {
+	case scheduler.TaskTypePublishPage, scheduler.TaskTypeUnpublishPage:
+		in.Params.PageID = parseFormInt(r.FormValue("page_id"), 0)
+	case scheduler.TaskTypePurgeEvents:
+		in.Params.RetentionDays = parseFormInt(r.FormValue("retention_days"), 0)
+	case scheduler.TaskTypeExport:
+		in.Params.IncludeMedia = r.FormValue("include_media") == "1"
+		in.Params.KeepFiles = parseFormInt(r.FormValue("keep_files"), 0)
+	}
+	if err := scheduler.ValidateTaskParams(in.TaskType, in.Params); err != nil {
+		return in, i18n.T(lang, "scheduler.error_task_params") + ": " + err.Error()
+	}
+
+	if !scheduler.IsValidRetryPolicy(in.RetryPolicy) {
+		return in, i18n.T(lang, "scheduler.error_retry_policy")
+	}
+	if in.RetryPolicy == scheduler.RetryPolicyNone {
+		in.MaxRetries = 0
+	}
+	if in.MaxRetries < 0 || in.MaxRetries > scheduler.MaxTaskRetries ||
+		in.RetryDelay < taskRetryDelayMin || in.RetryDelay > taskRetryDelayMax {
+		return in, i18n.T(lang, "scheduler.error_retry_range")
+	}
+
+	return in, ""
+}
+
 // validateCronSchedule validates a cron schedule expression.
 func validateCronSchedule(schedule string) error {
 	cronParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
@@ -100,6 +208,7 @@ type SchedulerJobView struct {
 type SchedulerTaskView struct {
 	ID       int64
 	Name     string
+	TaskType string
 	URL      string
 	Schedule string
 	IsActive bool
@@ -168,6 +277,7 @@ func (h *SchedulerHandler) List(w http.ResponseWriter, r *http.Request) {
 		taskViews = append(taskViews, SchedulerTaskView{
 			ID:       task.ID,
 			Name:     task.Name,
+			TaskType: task.TaskType,
 			URL:      task.Url,
 			Schedule: task.Schedule,
 			IsActive: task.IsActive == 1,
@@ -328,8 +438,13 @@ func (h *SchedulerHandler) TaskForm(w http.ResponseWriter, r *http.Request) {
 		title = i18n.T(lang, "scheduler.edit_task")
 	}
 
+	pages, err := store.New(h.db).ListPages(r.Context(), store.ListPagesParams{Limit: taskPageOptionsLimit, Offset: 0})
+	if err != nil {
+		slog.Error("failed to list pages for task form", "error", err)
+	}
+
 	pc := buildPageContext(r, h.sessionManager, h.renderer, title, schedulerTaskFormBreadcrumbs(lang, title))
-	viewData := convertSchedulerTaskFormViewData(task, isEdit)
+	viewData := convertSchedulerTaskFormViewData(task, isEdit, pages)
 	renderTempl(w, r, adminviews.SchedulerTaskFormPage(pc, viewData))
 }
 
@@ -345,57 +460,30 @@ func (h *SchedulerHandler) TaskCreate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	lang := middleware.GetAdminLang(r)
-	name := strings.TrimSpace(r.FormValue("name"))
-	url := strings.TrimSpace(r.FormValue("url"))
-	schedule := strings.TrimSpace(r.FormValue("schedule"))
-	timeoutStr := strings.TrimSpace(r.FormValue("timeout"))
-
-	if name == "" || url == "" || schedule == "" {
-		flashError(w, r, h.renderer, redirectAdminScheduler+"/tasks/new", i18n.T(lang, "scheduler.error_task_required"))
-		return
-	}
-
-	// Validate name length
-	if len(name) < taskNameMinLen || len(name) > taskNameMaxLen {
-		flashError(w, r, h.renderer, redirectAdminScheduler+"/tasks/new", i18n.T(lang, "scheduler.error_name_length"))
-		return
-	}
-
-	// Validate URL length
-	if len(url) > taskURLMaxLen {
-		flashError(w, r, h.renderer, redirectAdminScheduler+"/tasks/new", i18n.T(lang, "scheduler.error_url_length"))
+	in, errMsg := parseScheduledTaskForm(r, lang)
+	if errMsg != "" {
+		flashError(w, r, h.renderer, redirectAdminScheduler+"/tasks/new", errMsg)
 		return
 	}
 
-	// SSRF protection: validate URL before storing
-	if err := scheduler.ValidateTaskURL(url); err != nil {
-		flashError(w, r, h.renderer, redirectAdminScheduler+"/tasks/new",
-			i18n.T(lang, "scheduler.error_invalid_url")+": "+err.Error())
-		return
-	}
-
-	// Validate cron schedule syntax
-	if cronErr := validateCronSchedule(schedule); cronErr != nil {
-		flashError(w, r, h.renderer, redirectAdminScheduler+"/tasks/new",
-			i18n.T(lang, "scheduler.error_invalid_schedule")+": "+cronErr.Error())
-		return
-	}
-
-	timeout := parseTaskTimeout(timeoutStr)
-
 	now := time.Now()
 	userID := middleware.GetUserID(r)
 
 	queries := store.New(h.db)
 	task, err := queries.CreateScheduledTask(r.Context(), store.CreateScheduledTaskParams{
-		Name:           name,
-		Url:            url,
-		Schedule:       schedule,
-		IsActive:       1,
-		TimeoutSeconds: timeout,
-		CreatedBy:      sql.NullInt64{Int64: userID, Valid: userID > 0},
-		CreatedAt:      now,
-		UpdatedAt:      now,
+		Name:              in.Name,
+		Url:               in.URL,
+		Schedule:          in.Schedule,
+		IsActive:          1,
+		TimeoutSeconds:    in.Timeout,
+		TaskType:          in.TaskType,
+		Params:            in.Params.JSON(),
+		RetryPolicy:       in.RetryPolicy,
+		MaxRetries:        in.MaxRetries,
+		RetryDelaySeconds: in.RetryDelay,
+		CreatedBy:         sql.NullInt64{Int64: userID, Valid: userID > 0},
+		CreatedAt:         now,
+		UpdatedAt:         now,
 	})
 	if err != nil {
 		slog.Error("failed to create scheduled task", "error", err)
@@ -415,13 +503,13 @@ func (h *SchedulerHandler) TaskCreate(w http.ResponseWriter, r *http.Request) {
 	if h.eventService != nil {
 		clientIP := middleware.GetClientIP(r)
 		_ = h.eventService.LogSchedulerEvent(r.Context(), model.EventLevelInfo,
-			"Scheduled task created: "+name,
+			"Scheduled task created: "+in.Name,
 			middleware.GetUserIDPtr(r), clientIP, middleware.GetRequestURL(r), map[string]any{
-				"task_id": task.ID, "name": name, "url": url, "schedule": schedule,
+				"task_id": task.ID, "name": in.Name, "task_type": in.TaskType, "url": in.URL, "schedule": in.Schedule,
 			})
 	}
 
-	slog.Info("scheduled task created", "task_id", task.ID, "name", name, "url", url, "schedule", schedule)
+	slog.Info("scheduled task created", "task_id", task.ID, "name", in.Name, "task_type", in.TaskType, "url", in.URL, "schedule", in.Schedule)
 	flashSuccess(w, r, h.renderer, redirectAdminScheduler, i18n.T(lang, "scheduler.task_created"))
 }
 
@@ -443,55 +531,25 @@ func (h *SchedulerHandler) TaskUpdate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	lang := middleware.GetAdminLang(r)
-	name := strings.TrimSpace(r.FormValue("name"))
-	taskURL := strings.TrimSpace(r.FormValue("url"))
-	schedule := strings.TrimSpace(r.FormValue("schedule"))
-	timeoutStr := strings.TrimSpace(r.FormValue("timeout"))
-
-	if name == "" || taskURL == "" || schedule == "" {
-		redirectURL := fmt.Sprintf("/admin/scheduler/tasks/%d/edit", id)
-		flashError(w, r, h.renderer, redirectURL, i18n.T(lang, "scheduler.error_task_required"))
-		return
-	}
-
-	editRedirect := fmt.Sprintf("/admin/scheduler/tasks/%d/edit", id)
-
-	// Validate name length
-	if len(name) < taskNameMinLen || len(name) > taskNameMaxLen {
-		flashError(w, r, h.renderer, editRedirect, i18n.T(lang, "scheduler.error_name_length"))
-		return
-	}
-
-	// Validate URL length
-	if len(taskURL) > taskURLMaxLen {
-		flashError(w, r, h.renderer, editRedirect, i18n.T(lang, "scheduler.error_url_length"))
+	in, errMsg := parseScheduledTaskForm(r, lang)
+	if errMsg != "" {
+		flashError(w, r, h.renderer, fmt.Sprintf("/admin/scheduler/tasks/%d/edit", id), errMsg)
 		return
 	}
 
-	// SSRF protection: validate URL before storing
-	if urlErr := scheduler.ValidateTaskURL(taskURL); urlErr != nil {
-		flashError(w, r, h.renderer, editRedirect,
-			i18n.T(lang, "scheduler.error_invalid_url")+": "+urlErr.Error())
-		return
-	}
-
-	// Validate cron schedule syntax
-	if cronErr := validateCronSchedule(schedule); cronErr != nil {
-		flashError(w, r, h.renderer, editRedirect,
-			i18n.T(lang, "scheduler.error_invalid_schedule")+": "+cronErr.Error())
-		return
-	}
-
-	timeout := parseTaskTimeout(timeoutStr)
-
 	queries := store.New(h.db)
 	task, err := queries.UpdateScheduledTask(r.Context(), store.UpdateScheduledTaskParams{
-		Name:           name,
-		Url:            taskURL,
-		Schedule:       schedule,
-		TimeoutSeconds: timeout,
-		UpdatedAt:      time.Now(),
-		ID:             id,
+		Name:              in.Name,
+		Url:               in.URL,
+		Schedule:          in.Schedule,
+		TimeoutSeconds:    in.Timeout,
+		TaskType:          in.TaskType,
+		Params:            in.Params.JSON(),
+		RetryPolicy:       in.RetryPolicy,
+		MaxRetries:        in.MaxRetries,
+		RetryDelaySeconds: in.RetryDelay,
+		UpdatedAt:         time.Now(),
+		ID:                id,
 	})
 	if err != nil {
 		slog.Error("failed to update scheduled task", "error", err, "task_id", id)
@@ -509,13 +567,13 @@ func (h *SchedulerHandler) TaskUpdate(w http.ResponseWriter, r *http.Request) {
 	if h.eventService != nil {
 		clientIP := middleware.GetClientIP(r)
 		_ = h.eventService.LogSchedulerEvent(r.Context(), model.EventLevelInfo,
-			"Scheduled task updated: "+name,
+			"Scheduled task updated: "+in.Name,
 			middleware.GetUserIDPtr(r), clientIP, middleware.GetRequestURL(r), map[string]any{
-				"task_id": id, "name": name, "url": taskURL, "schedule": schedule,
+				"task_id": id, "name": in.Name, "task_type": in.TaskType, "url": in.URL, "schedule": in.Schedule,
 			})
 	}
 
-	slog.Info("scheduled task updated", "task_id", id, "name", name, "updated_by", middleware.GetUserID(r))
+	slog.Info("scheduled task updated", "task_id", id, "name", in.Name, "updated_by", middleware.GetUserID(r))
 	flashSuccess(w, r, h.renderer, redirectAdminScheduler, i18n.T(lang, "scheduler.task_updated"))
 }
 
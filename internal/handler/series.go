@@ -0,0 +1,420 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+
+	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/util"
+	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+)
+
+const (
+	// maxSeriesNameLen caps series names.
+	maxSeriesNameLen = 255
+	// maxSeriesSlugLen caps series slugs.
+	maxSeriesSlugLen = 64
+	// maxSeriesDescriptionLen caps series descriptions.
+	maxSeriesDescriptionLen = 1000
+)
+
+// SeriesHandler handles page series management routes. Pages join a series
+// on the page form; the series form orders and removes them.
+type SeriesHandler struct {
+	db             *sql.DB
+	queries        *store.Queries
+	renderer       *render.Renderer
+	sessionManager *scs.SessionManager
+	eventService   *service.EventService
+}
+
+// NewSeriesHandler creates a new SeriesHandler.
+func NewSeriesHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionManager) *SeriesHandler {
+	return &SeriesHandler{
+		db:             db,
+		queries:        store.New(db),
+		renderer:       renderer,
+		sessionManager: sm,
+		eventService:   service.NewEventService(db),
+	}
+}
+
+// SeriesFormData holds data for the series form template.
+type SeriesFormData struct {
+	Series     *store.PageSeries
+	Languages  []store.Language
+	Pages      []store.ListPageSeriesPagesRow // Parts of the series in order
+	Errors     map[string]string
+	FormValues map[string]string
+	IsEdit     bool
+}
+
+// List handles GET /admin/series - lists page series.
+func (h *SeriesHandler) List(w http.ResponseWriter, r *http.Request) {
+	lang := middleware.GetAdminLang(r)
+
+	series, err := h.queries.ListPageSeries(r.Context())
+	if err != nil {
+		logAndInternalError(w, "failed to list page series", "error", err)
+		return
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "series.title"), seriesBreadcrumbs(lang))
+	renderTempl(w, r, adminviews.SeriesListPage(pc, convertSeriesListViewData(series)))
+}
+
+// NewForm handles GET /admin/series/new - displays the new series form.
+func (h *SeriesHandler) NewForm(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentReadOnly, redirectAdminSeries) {
+		return
+	}
+
+	languages := ListActiveLanguagesWithFallback(r.Context(), h.queries)
+	values := map[string]string{}
+	if def := FindDefaultLanguage(languages); def != nil {
+		values["language_code"] = def.Code
+	}
+
+	h.renderForm(w, r, SeriesFormData{
+		Languages:  languages,
+		Errors:     make(map[string]string),
+		FormValues: values,
+	})
+}
+
+// Create handles POST /admin/series - creates a series.
+func (h *SeriesHandler) Create(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentReadOnly, redirectAdminSeries) {
+		return
+	}
+
+	if !parseFormOrRedirect(w, r, h.renderer, redirectAdminSeriesNew) {
+		return
+	}
+
+	input := parseSeriesFormInput(r)
+	if validationErrors := h.validateSeriesForm(r.Context(), input, nil); len(validationErrors) > 0 {
+		h.renderForm(w, r, SeriesFormData{
+			Languages:  ListActiveLanguagesWithFallback(r.Context(), h.queries),
+			Errors:     validationErrors,
+			FormValues: input.toFormValues(),
+		})
+		return
+	}
+
+	now := time.Now()
+	series, err := h.queries.CreatePageSeries(r.Context(), store.CreatePageSeriesParams{
+		Name:         input.Name,
+		Slug:         input.Slug,
+		Description:  input.Description,
+		LanguageCode: input.LanguageCode,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+	if err != nil {
+		slog.Error("failed to create page series", "error", err)
+		flashError(w, r, h.renderer, redirectAdminSeriesNew, "Error creating series")
+		return
+	}
+
+	slog.Info("page series created", "series_id", series.ID, "slug", series.Slug, "created_by", middleware.GetUserID(r))
+	_ = h.eventService.LogPageEvent(r.Context(), model.EventLevelInfo, "Series created",
+		middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+		map[string]any{"series_id": series.ID, "slug": series.Slug, "language": series.LanguageCode})
+
+	flashSuccess(w, r, h.renderer, fmt.Sprintf(redirectAdminSeriesID, series.ID), "Series created successfully")
+}
+
+// EditForm handles GET /admin/series/{id} - displays the edit form.
+func (h *SeriesHandler) EditForm(w http.ResponseWriter, r *http.Request) {
+	id, err := ParseIDParam(r)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminSeries, "Invalid series ID")
+		return
+	}
+
+	series, ok := h.requireSeriesWithRedirect(w, r, id)
+	if !ok {
+		return
+	}
+
+	h.renderForm(w, r, SeriesFormData{
+		Series:    &series,
+		Languages: ListActiveLanguagesWithFallback(r.Context(), h.queries),
+		Pages:     h.seriesPages(r.Context(), id),
+		Errors:    make(map[string]string),
+		FormValues: map[string]string{
+			"name":          series.Name,
+			"slug":          series.Slug,
+			"description":   series.Description,
+			"language_code": series.LanguageCode,
+		},
+		IsEdit: true,
+	})
+}
+
+// Update handles PUT /admin/series/{id} - updates a series and the order of
+// its pages. Pages missing from the submitted order leave the series.
+func (h *SeriesHandler) Update(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentReadOnly, redirectAdminSeries) {
+		return
+	}
+
+	id, err := ParseIDParam(r)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminSeries, "Invalid series ID")
+		return
+	}
+
+	series, ok := h.requireSeriesWithRedirect(w, r, id)
+	if !ok {
+		return
+	}
+
+	editURL := fmt.Sprintf(redirectAdminSeriesID, id)
+	if !parseFormOrRedirect(w, r, h.renderer, editURL) {
+		return
+	}
+
+	input := parseSeriesFormInput(r)
+	pages := h.seriesPages(r.Context(), id)
+	if validationErrors := h.validateSeriesForm(r.Context(), input, &series); len(validationErrors) > 0 {
+		h.renderForm(w, r, SeriesFormData{
+			Series:     &series,
+			Languages:  ListActiveLanguagesWithFallback(r.Context(), h.queries),
+			Pages:      pages,
+			Errors:     validationErrors,
+			FormValues: input.toFormValues(),
+			IsEdit:     true,
+		})
+		return
+	}
+
+	if err := h.updateSeries(r.Context(), id, input, pages); err != nil {
+		slog.Error("failed to update page series", "error", err, "series_id", id)
+		flashError(w, r, h.renderer, editURL, "Error updating series")
+		return
+	}
+
+	slog.Info("page series updated", "series_id", id, "updated_by", middleware.GetUserID(r))
+	_ = h.eventService.LogPageEvent(r.Context(), model.EventLevelInfo, "Series updated",
+		middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+		map[string]any{"series_id": id, "slug": input.Slug, "pages": len(input.PageIDs)})
+
+	flashSuccess(w, r, h.renderer, editURL, "Series updated successfully")
+}
+
+// Delete handles DELETE /admin/series/{id} - deletes a series. Its pages
+// are kept and only leave the series.
+func (h *SeriesHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if middleware.IsDemoMode() {
+		h.sendDeleteError(w, middleware.DemoModeMessageDetailed(middleware.RestrictionContentReadOnly))
+		return
+	}
+
+	handleDeleteEntity(w, r, h.renderer, deleteEntityParams[store.PageSeries]{
+		EntityName:     "series",
+		IDField:        "series_id",
+		RedirectURL:    redirectAdminSeries,
+		SuccessMessage: "Series deleted successfully",
+		RequireFn: func(id int64) (store.PageSeries, bool) {
+			return requireEntityWithCustomError(w, "Series", id,
+				func(id int64) (store.PageSeries, error) { return h.queries.GetPageSeriesByID(r.Context(), id) },
+				h.sendDeleteError)
+		},
+		DeleteFn: h.queries.DeletePageSeries,
+		GetSlug:  func(s store.PageSeries) string { return s.Slug },
+		OnDeleted: func(s store.PageSeries) {
+			_ = h.eventService.LogPageEvent(r.Context(), model.EventLevelInfo, "Series deleted",
+				middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+				map[string]any{"series_id": s.ID, "slug": s.Slug, "language": s.LanguageCode})
+		},
+	})
+}
+
+// seriesFormInput holds parsed form values for series create/update.
+type seriesFormInput struct {
+	Name         string
+	Slug         string
+	Description  string
+	LanguageCode string
+	PageIDs      []int64 // Submitted order of the series' pages
+}
+
+// parseSeriesFormInput extracts series form values. An empty slug is derived
+// from the name.
+func parseSeriesFormInput(r *http.Request) seriesFormInput {
+	input := seriesFormInput{
+		Name:         strings.TrimSpace(r.FormValue("name")),
+		Slug:         strings.TrimSpace(r.FormValue("slug")),
+		Description:  strings.TrimSpace(r.FormValue("description")),
+		LanguageCode: strings.TrimSpace(r.FormValue("language_code")),
+	}
+	if input.Slug == "" {
+		input.Slug = util.Slugify(input.Name)
+	}
+	for _, raw := range r.Form["pages[]"] {
+		if id, err := strconv.ParseInt(raw, 10, 64); err == nil && !slices.Contains(input.PageIDs, id) {
+			input.PageIDs = append(input.PageIDs, id)
+		}
+	}
+	return input
+}
+
+// toFormValues converts seriesFormInput to a map for form re-rendering.
+func (input seriesFormInput) toFormValues() map[string]string {
+	return map[string]string{
+		"name":          input.Name,
+		"slug":          input.Slug,
+		"description":   input.Description,
+		"language_code": input.LanguageCode,
+	}
+}
+
+// validateSeriesForm validates series form input. existing is the series
+// being edited, nil for new series. Slugs are unique per language.
+func (h *SeriesHandler) validateSeriesForm(ctx context.Context, input seriesFormInput, existing *store.PageSeries) map[string]string {
+	validationErrors := make(map[string]string)
+
+	if input.Name == "" {
+		validationErrors["name"] = "Name is required"
+	} else if len(input.Name) > maxSeriesNameLen {
+		validationErrors["name"] = "Name must be less than 255 characters"
+	}
+	if len(input.Description) > maxSeriesDescriptionLen {
+		validationErrors["description"] = fmt.Sprintf("Description must be at most %d characters", maxSeriesDescriptionLen)
+	}
+
+	if _, err := getRoutableContentLanguage(ctx, h.queries, input.LanguageCode); err != nil {
+		validationErrors["language_code"] = "Select an active language"
+	} else if existing != nil && existing.LanguageCode != input.LanguageCode && len(input.PageIDs) > 0 {
+		// Pages keep their language, so a series with pages cannot change it
+		validationErrors["language_code"] = "Remove the pages of the series before changing its language"
+	}
+
+	var excludeID int64
+	if existing != nil {
+		excludeID = existing.ID
+	}
+	switch {
+	case input.Slug == "":
+		validationErrors["slug"] = "Slug is required"
+	case len(input.Slug) > maxSeriesSlugLen:
+		validationErrors["slug"] = fmt.Sprintf("Slug must be at most %d characters", maxSeriesSlugLen)
+	case !util.IsValidSlug(input.Slug):
+		validationErrors["slug"] = "Invalid slug format (use lowercase letters, numbers, and hyphens)"
+	case validationErrors["language_code"] != "":
+	default:
+		other, err := h.queries.GetPageSeriesBySlugAndLanguage(ctx, store.GetPageSeriesBySlugAndLanguageParams{
+			Slug:         input.Slug,
+			LanguageCode: input.LanguageCode,
+		})
+		if err == nil && other.ID != excludeID {
+			validationErrors["slug"] = "A series with this slug already exists in this language"
+		} else if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("failed to check series slug", "error", err)
+			validationErrors["slug"] = "Error checking slug"
+		}
+	}
+
+	return validationErrors
+}
+
+// updateSeries stores the series fields and the submitted page order in one
+// transaction. Pages that are not members of the series are ignored.
+func (h *SeriesHandler) updateSeries(ctx context.Context, id int64, input seriesFormInput, pages []store.ListPageSeriesPagesRow) error {
+	tx, err := h.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin series update: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	queries := h.queries.WithTx(tx)
+
+	if _, err := queries.UpdatePageSeries(ctx, store.UpdatePageSeriesParams{
+		Name:         input.Name,
+		Slug:         input.Slug,
+		Description:  input.Description,
+		LanguageCode: input.LanguageCode,
+		UpdatedAt:    time.Now(),
+		ID:           id,
+	}); err != nil {
+		return fmt.Errorf("update series: %w", err)
+	}
+
+	for _, p := range pages {
+		if !slices.Contains(input.PageIDs, p.ID) {
+			if err := queries.DeletePageSeriesItem(ctx, p.ID); err != nil {
+				return fmt.Errorf("remove page %d from series: %w", p.ID, err)
+			}
+		}
+	}
+	for position, pageID := range input.PageIDs {
+		if err := queries.UpdatePageSeriesItemPosition(ctx, store.UpdatePageSeriesItemPositionParams{
+			Position: int64(position),
+			PageID:   pageID,
+			SeriesID: id,
+		}); err != nil {
+			return fmt.Errorf("order page %d of series: %w", pageID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit series update: %w", err)
+	}
+	return nil
+}
+
+// seriesPages returns the pages of a series in order.
+func (h *SeriesHandler) seriesPages(ctx context.Context, seriesID int64) []store.ListPageSeriesPagesRow {
+	pages, err := h.queries.ListPageSeriesPages(ctx, seriesID)
+	if err != nil {
+		slog.Error("failed to list series pages", "error", err, "series_id", seriesID)
+	}
+	return pages
+}
+
+// renderForm renders the new or edit series form.
+func (h *SeriesHandler) renderForm(w http.ResponseWriter, r *http.Request, data SeriesFormData) {
+	lang := middleware.GetAdminLang(r)
+
+	title := i18n.T(lang, "series.new")
+	breadcrumbs := seriesNewBreadcrumbs(lang)
+	if data.IsEdit && data.Series != nil {
+		title = i18n.T(lang, "series.edit")
+		breadcrumbs = seriesEditBreadcrumbs(lang, *data.Series)
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, title, breadcrumbs)
+	renderTempl(w, r, adminviews.SeriesFormPage(pc, convertSeriesFormViewData(data)))
+}
+
+// requireSeriesWithRedirect fetches a series by ID and redirects with flash on error.
+func (h *SeriesHandler) requireSeriesWithRedirect(w http.ResponseWriter, r *http.Request, id int64) (store.PageSeries, bool) {
+	return requireEntityWithRedirect(w, r, h.renderer, redirectAdminSeries, "Series", id,
+		func(id int64) (store.PageSeries, error) { return h.queries.GetPageSeriesByID(r.Context(), id) })
+}
+
+// sendDeleteError sends an error response for delete operations.
+func (h *SeriesHandler) sendDeleteError(w http.ResponseWriter, message string) {
+	w.Header().Set("HX-Reswap", "none")
+	w.Header().Set("HX-Trigger", `{"showToast": "`+message+`", "toastType": "error"}`)
+	w.WriteHeader(http.StatusBadRequest)
+}
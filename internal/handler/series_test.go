@@ -0,0 +1,122 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// newTestSeries creates an English series with the given pages as parts.
+func newTestSeries(t *testing.T, queries *store.Queries, slug string, pageIDs ...int64) store.PageSeries {
+	t.Helper()
+
+	ctx := context.Background()
+	now := time.Now()
+	s, err := queries.CreatePageSeries(ctx, store.CreatePageSeriesParams{
+		Name:         slug,
+		Slug:         slug,
+		LanguageCode: "en",
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+	if err != nil {
+		t.Fatalf("CreatePageSeries: %v", err)
+	}
+	for i, id := range pageIDs {
+		if err := queries.UpsertPageSeriesItem(ctx, store.UpsertPageSeriesItemParams{
+			PageID: id, SeriesID: s.ID, Position: int64(i),
+		}); err != nil {
+			t.Fatalf("UpsertPageSeriesItem: %v", err)
+		}
+	}
+	return s
+}
+
+func TestValidateSeriesForm(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	h := NewSeriesHandler(db, nil, sm)
+	createTestLanguage(t, db, "ru", true)
+	existing := newTestSeries(t, h.queries, "go-basics")
+
+	tests := []struct {
+		name     string
+		input    seriesFormInput
+		existing *store.PageSeries
+		wantErr  string
+	}{
+		{"valid", seriesFormInput{Name: "Web", Slug: "web", LanguageCode: "en"}, nil, ""},
+		{"missing name", seriesFormInput{Slug: "x", LanguageCode: "en"}, nil, "name"},
+		{"bad slug", seriesFormInput{Name: "X", Slug: "Bad Slug", LanguageCode: "en"}, nil, "slug"},
+		{"duplicate slug", seriesFormInput{Name: "Go", Slug: "go-basics", LanguageCode: "en"}, nil, "slug"},
+		{"same slug on edit", seriesFormInput{Name: "Go", Slug: "go-basics", LanguageCode: "en"}, &existing, ""},
+		{"other language", seriesFormInput{Name: "Go", Slug: "go-basics", LanguageCode: "ru"}, nil, ""},
+		{"language change without pages", seriesFormInput{Name: "Go", Slug: "go-basics", LanguageCode: "ru"}, &existing, ""},
+		{"language change with pages", seriesFormInput{Name: "Go", Slug: "go-basics", LanguageCode: "ru", PageIDs: []int64{1}}, &existing, "language_code"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := h.validateSeriesForm(context.Background(), tt.input, tt.existing)
+			if tt.wantErr == "" && len(errs) > 0 {
+				t.Errorf("unexpected errors: %v", errs)
+			}
+			if tt.wantErr != "" && errs[tt.wantErr] == "" {
+				t.Errorf("expected %s error, got %v", tt.wantErr, errs)
+			}
+		})
+	}
+}
+
+func TestSeriesHandler_UpdateSeriesOrder(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	admin := createTestAdminUser(t, db)
+	h := NewSeriesHandler(db, nil, sm)
+	ctx := context.Background()
+	one := createNestedPage(t, db, "part-one", "Part One", 0, admin.ID)
+	two := createNestedPage(t, db, "part-two", "Part Two", 0, admin.ID)
+	three := createNestedPage(t, db, "part-three", "Part Three", 0, admin.ID)
+	series := newTestSeries(t, h.queries, "go-basics", one, two, three)
+
+	input := seriesFormInput{Name: "Go Basics", Slug: "go-basics", LanguageCode: "en", PageIDs: []int64{three, one}}
+	if err := h.updateSeries(ctx, series.ID, input, h.seriesPages(ctx, series.ID)); err != nil {
+		t.Fatalf("updateSeries() error = %v", err)
+	}
+
+	pages := h.seriesPages(ctx, series.ID)
+	if len(pages) != 2 || pages[0].ID != three || pages[1].ID != one {
+		t.Errorf("series pages = %+v, want [part-three part-one]", pages)
+	}
+	if _, err := h.queries.GetPageSeriesItem(ctx, two); err == nil {
+		t.Error("removed page is still part of the series")
+	}
+}
+
+func TestFrontendHandler_Page_SeriesNav(t *testing.T) {
+	db, _ := testHandlerSetup(t)
+	admin := createTestAdminUser(t, db)
+	one := createNestedPage(t, db, "part-one", "Part One", 0, admin.ID)
+	two := createNestedPage(t, db, "part-two", "Part Two", 0, admin.ID)
+	three := createNestedPage(t, db, "part-three", "Part Three", 0, admin.ID)
+	newTestSeries(t, store.New(db), "go-basics", one, two, three)
+
+	h := NewFrontendHandler(db, testThemeManager(), nil, slog.Default(), nil, nil)
+	w := httptest.NewRecorder()
+	h.Page(w, newNestedPageRequest("part-two"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET /part-two status = %d, want %d", w.Code, http.StatusOK)
+	}
+	body := w.Body.String()
+	for _, want := range []string{"go-basics", `href="/part-one"`, `rel="prev"`, `href="/part-three"`, `rel="next"`} {
+		if !strings.Contains(body, want) {
+			t.Errorf("GET /part-two body does not contain %q", want)
+		}
+	}
+}
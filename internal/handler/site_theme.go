@@ -0,0 +1,26 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/theme"
+)
+
+// siteTheme returns the theme of the site ctx is scoped to: the theme set on
+// the site, or the globally active theme when the site has none or it is not
+// loaded.
+func siteTheme(ctx context.Context, tm *theme.Manager) *theme.Theme {
+	if tm == nil {
+		return nil
+	}
+	if site := middleware.SiteFromContext(ctx); site != nil && site.Theme != "" {
+		if t, err := tm.GetTheme(site.Theme); err == nil {
+			return t
+		}
+	}
+	return tm.GetActiveTheme()
+}
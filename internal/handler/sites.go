@@ -0,0 +1,479 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+
+	"github.com/olegiv/ocms-go/internal/cache"
+	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/theme"
+	"github.com/olegiv/ocms-go/internal/util"
+	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+)
+
+// SitesHandler handles site management routes.
+type SitesHandler struct {
+	queries        *store.Queries
+	renderer       *render.Renderer
+	sessionManager *scs.SessionManager
+	eventService   *service.EventService
+	themeManager   *theme.Manager
+	cacheManager   *cache.Manager
+	siteMiddleware *middleware.SiteMiddleware
+}
+
+// NewSitesHandler creates a new SitesHandler.
+func NewSitesHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionManager, tm *theme.Manager, cm *cache.Manager) *SitesHandler {
+	return &SitesHandler{
+		queries:        store.New(db),
+		renderer:       renderer,
+		sessionManager: sm,
+		eventService:   service.NewEventService(db),
+		themeManager:   tm,
+		cacheManager:   cm,
+	}
+}
+
+// SetSiteMiddleware sets the site middleware whose cache is invalidated when
+// sites change.
+func (h *SitesHandler) SetSiteMiddleware(sm *middleware.SiteMiddleware) {
+	h.siteMiddleware = sm
+}
+
+// List handles GET /admin/sites - displays a list of sites.
+func (h *SitesHandler) List(w http.ResponseWriter, r *http.Request) {
+	lang := h.renderer.GetAdminLang(r)
+
+	sites, err := h.queries.ListSites(r.Context())
+	if err != nil {
+		logAndInternalError(w, "failed to list sites", "error", err)
+		return
+	}
+
+	viewData := adminviews.SitesListData{Sites: make([]adminviews.SiteListItem, len(sites))}
+	for i, site := range sites {
+		viewData.Sites[i] = adminviews.SiteListItem{
+			ID:        site.ID,
+			Name:      site.Name,
+			Host:      site.Host,
+			Theme:     site.Theme,
+			IsDefault: site.IsDefault,
+		}
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "nav.sites"), sitesBreadcrumbs(lang))
+	renderTempl(w, r, adminviews.SitesListPage(pc, viewData))
+}
+
+// NewForm handles GET /admin/sites/new - displays the new site form.
+func (h *SitesHandler) NewForm(w http.ResponseWriter, r *http.Request) {
+	lang := h.renderer.GetAdminLang(r)
+
+	viewData := adminviews.SiteFormData{
+		Themes:     h.themeOptions(),
+		Config:     siteConfigItems(lang, nil),
+		Errors:     make(map[string]string),
+		FormValues: make(map[string]string),
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "sites.new"), siteFormBreadcrumbs(lang, "", 0))
+	renderTempl(w, r, adminviews.SiteFormPage(pc, viewData))
+}
+
+// Create handles POST /admin/sites - creates a new site.
+func (h *SitesHandler) Create(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentReadOnly, redirectAdminSitesNew) {
+		return
+	}
+
+	lang := h.renderer.GetAdminLang(r)
+
+	if !parseFormOrRedirect(w, r, h.renderer, redirectAdminSitesNew) {
+		return
+	}
+
+	input := h.parseSiteFormInput(r, 0)
+	if len(input.Errors) > 0 {
+		viewData := adminviews.SiteFormData{
+			Themes:     h.themeOptions(),
+			Config:     siteConfigItems(lang, input.Config),
+			Errors:     input.Errors,
+			FormValues: input.FormValues,
+		}
+		pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "sites.new"), siteFormBreadcrumbs(lang, "", 0))
+		renderTempl(w, r, adminviews.SiteFormPage(pc, viewData))
+		return
+	}
+
+	now := time.Now()
+	site, err := h.queries.CreateSite(r.Context(), store.CreateSiteParams{
+		Name:      input.Name,
+		Host:      input.Host,
+		Theme:     input.Theme,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		slog.Error("failed to create site", "error", err)
+		flashError(w, r, h.renderer, redirectAdminSitesNew, "Error creating site")
+		return
+	}
+	if err := h.saveSiteConfig(r.Context(), site.ID, input.Config); err != nil {
+		slog.Error("failed to save site settings", "error", err, "site_id", site.ID)
+		flashError(w, r, h.renderer, fmt.Sprintf(redirectAdminSitesID, site.ID), "Site created but its settings could not be saved")
+		return
+	}
+
+	slog.Info("site created", "site_id", site.ID, "host", site.Host)
+	_ = h.eventService.LogConfigEvent(r.Context(), model.EventLevelInfo, "Site created", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"site_id": site.ID, "name": site.Name, "host": site.Host})
+	h.invalidateCaches()
+	flashSuccess(w, r, h.renderer, redirectAdminSites, "Site created successfully")
+}
+
+// EditForm handles GET /admin/sites/{id} - displays the site edit form.
+func (h *SitesHandler) EditForm(w http.ResponseWriter, r *http.Request) {
+	lang := h.renderer.GetAdminLang(r)
+
+	id, err := ParseIDParam(r)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminSites, "Invalid site ID")
+		return
+	}
+
+	site, ok := h.requireSiteWithRedirect(w, r, id)
+	if !ok {
+		return
+	}
+
+	viewData := adminviews.SiteFormData{
+		IsEdit:     true,
+		Site:       convertSiteInfo(site),
+		Themes:     h.themeOptions(),
+		Errors:     make(map[string]string),
+		FormValues: make(map[string]string),
+	}
+	if !site.IsDefault {
+		viewData.Config = siteConfigItems(lang, h.siteConfigValues(r.Context(), site.ID))
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "sites.edit"), siteFormBreadcrumbs(lang, site.Name, site.ID))
+	renderTempl(w, r, adminviews.SiteFormPage(pc, viewData))
+}
+
+// Update handles PUT /admin/sites/{id} - updates a site.
+func (h *SitesHandler) Update(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentReadOnly, redirectAdminSites) {
+		return
+	}
+
+	lang := h.renderer.GetAdminLang(r)
+
+	id, err := ParseIDParam(r)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminSites, "Invalid site ID")
+		return
+	}
+
+	site, ok := h.requireSiteWithRedirect(w, r, id)
+	if !ok {
+		return
+	}
+
+	if !parseFormOrRedirect(w, r, h.renderer, fmt.Sprintf(redirectAdminSitesID, id)) {
+		return
+	}
+
+	input := h.parseSiteFormInput(r, id)
+	if len(input.Errors) > 0 {
+		viewData := adminviews.SiteFormData{
+			IsEdit:     true,
+			Site:       convertSiteInfo(site),
+			Themes:     h.themeOptions(),
+			Errors:     input.Errors,
+			FormValues: input.FormValues,
+		}
+		if !site.IsDefault {
+			viewData.Config = siteConfigItems(lang, input.Config)
+		}
+		pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "sites.edit"), siteFormBreadcrumbs(lang, site.Name, site.ID))
+		renderTempl(w, r, adminviews.SiteFormPage(pc, viewData))
+		return
+	}
+
+	_, err = h.queries.UpdateSite(r.Context(), store.UpdateSiteParams{
+		Name:      input.Name,
+		Host:      input.Host,
+		Theme:     input.Theme,
+		UpdatedAt: time.Now(),
+		ID:        id,
+	})
+	if err != nil {
+		slog.Error("failed to update site", "error", err, "site_id", id)
+		flashError(w, r, h.renderer, fmt.Sprintf(redirectAdminSitesID, id), "Error updating site")
+		return
+	}
+	// The default site uses the global settings directly.
+	if !site.IsDefault {
+		if err := h.saveSiteConfig(r.Context(), id, input.Config); err != nil {
+			slog.Error("failed to save site settings", "error", err, "site_id", id)
+			flashError(w, r, h.renderer, fmt.Sprintf(redirectAdminSitesID, id), "Error saving site settings")
+			return
+		}
+	}
+
+	slog.Info("site updated", "site_id", id, "updated_by", middleware.GetUserID(r))
+	_ = h.eventService.LogConfigEvent(r.Context(), model.EventLevelInfo, "Site updated", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"site_id": id, "name": input.Name, "host": input.Host})
+	h.invalidateCaches()
+	flashSuccess(w, r, h.renderer, redirectAdminSites, "Site updated successfully")
+}
+
+// Delete handles DELETE /admin/sites/{id} - deletes a site. The default site
+// and sites that still own content cannot be deleted.
+func (h *SitesHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if demoGuardAPI(w) {
+		return
+	}
+
+	id, err := ParseIDParam(r)
+	if err != nil {
+		http.Error(w, "Invalid site ID", http.StatusBadRequest)
+		return
+	}
+
+	site, ok := requireEntityWithError(w, "Site", id,
+		func(id int64) (store.Site, error) { return h.queries.GetSite(r.Context(), id) })
+	if !ok {
+		return
+	}
+
+	if site.IsDefault {
+		w.Header().Set("HX-Reswap", "none")
+		http.Error(w, "Cannot delete the default site", http.StatusBadRequest)
+		return
+	}
+	count, err := h.queries.CountSiteContent(r.Context(), id)
+	if err != nil {
+		logAndInternalError(w, "failed to count site content", "error", err, "site_id", id)
+		return
+	}
+	if count > 0 {
+		w.Header().Set("HX-Reswap", "none")
+		http.Error(w, fmt.Sprintf("Cannot delete a site that still has content (%d items)", count), http.StatusConflict)
+		return
+	}
+
+	if err := h.queries.DeleteSite(r.Context(), id); err != nil {
+		logAndInternalError(w, "failed to delete site", "error", err, "site_id", id)
+		return
+	}
+
+	slog.Info("site deleted", "site_id", id, "host", site.Host, "deleted_by", middleware.GetUserID(r))
+	_ = h.eventService.LogConfigEvent(r.Context(), model.EventLevelInfo, "Site deleted", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"site_id": id, "name": site.Name})
+	h.invalidateCaches()
+
+	if r.Header.Get("HX-Request") == "true" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	flashSuccess(w, r, h.renderer, redirectAdminSites, "Site deleted successfully")
+}
+
+// Helper functions
+
+// requireSiteWithRedirect fetches a site by ID and redirects with flash on error.
+func (h *SitesHandler) requireSiteWithRedirect(w http.ResponseWriter, r *http.Request, id int64) (store.Site, bool) {
+	return requireEntityWithRedirect(w, r, h.renderer, redirectAdminSites, "Site", id,
+		func(id int64) (store.Site, error) { return h.queries.GetSite(r.Context(), id) })
+}
+
+// invalidateCaches drops the caches that hold sites and per-site data.
+func (h *SitesHandler) invalidateCaches() {
+	if h.siteMiddleware != nil {
+		h.siteMiddleware.InvalidateCache()
+	}
+	if h.cacheManager == nil {
+		return
+	}
+	h.cacheManager.InvalidateConfig()
+	h.cacheManager.InvalidateSitemap()
+}
+
+// themeOptions returns the loaded themes a site can use.
+func (h *SitesHandler) themeOptions() []adminviews.SiteThemeOption {
+	if h.themeManager == nil {
+		return nil
+	}
+	infos := h.themeManager.ListThemesWithActive()
+	options := make([]adminviews.SiteThemeOption, len(infos))
+	for i, info := range infos {
+		options[i] = adminviews.SiteThemeOption{Name: info.Name, Label: info.Config.Name}
+	}
+	return options
+}
+
+// siteConfigValues returns the settings a site overrides, by key.
+func (h *SitesHandler) siteConfigValues(ctx context.Context, siteID int64) map[string]string {
+	rows, err := h.queries.ListSiteConfig(ctx, siteID)
+	if err != nil {
+		slog.Error("failed to list site settings", "error", err, "site_id", siteID)
+	}
+	values := make(map[string]string, len(rows))
+	for _, row := range rows {
+		values[row.Key] = row.Value
+	}
+	return values
+}
+
+// saveSiteConfig stores the settings a site overrides. An empty value removes
+// the override so the global setting applies again.
+func (h *SitesHandler) saveSiteConfig(ctx context.Context, siteID int64, values map[string]string) error {
+	now := time.Now()
+	for _, field := range model.SiteConfigFields {
+		value := values[field.Key]
+		if value == "" {
+			if err := h.queries.DeleteSiteConfig(ctx, store.DeleteSiteConfigParams{SiteID: siteID, Key: field.Key}); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := h.queries.UpsertSiteConfig(ctx, store.UpsertSiteConfigParams{
+			SiteID:    siteID,
+			Key:       field.Key,
+			Value:     value,
+			UpdatedAt: now,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// siteFormInput holds parsed and validated site form input.
+type siteFormInput struct {
+	Name       string
+	Host       string
+	Theme      string
+	Config     map[string]string
+	FormValues map[string]string
+	Errors     map[string]string
+}
+
+// parseSiteFormInput parses and validates site form input. excludeID is the
+// site being edited, or 0 for a new one.
+func (h *SitesHandler) parseSiteFormInput(r *http.Request, excludeID int64) siteFormInput {
+	lang := h.renderer.GetAdminLang(r)
+	input := siteFormInput{
+		Name:   strings.TrimSpace(r.FormValue("name")),
+		Theme:  strings.TrimSpace(r.FormValue("theme")),
+		Config: make(map[string]string),
+		Errors: make(map[string]string),
+	}
+	input.FormValues = map[string]string{
+		"name":  input.Name,
+		"host":  strings.TrimSpace(r.FormValue("host")),
+		"theme": input.Theme,
+	}
+	for _, field := range model.SiteConfigFields {
+		value := strings.TrimSpace(r.FormValue("config_" + field.Key))
+		if field.Type == model.ConfigTypeBool && value != "" {
+			value = "true"
+		}
+		input.Config[field.Key] = value
+	}
+
+	if input.Name == "" {
+		input.Errors["name"] = i18n.T(lang, "sites.error_name_required")
+	}
+
+	host, err := util.NormalizeHost(input.FormValues["host"])
+	if err != nil {
+		input.Errors["host"] = i18n.T(lang, "languages.error_host_format")
+	} else if host != "" {
+		input.Host = host
+		taken, err := h.queries.SiteHostExistsExcluding(r.Context(), store.SiteHostExistsExcludingParams{Host: host, ID: excludeID})
+		if err == nil && taken == 0 {
+			taken, err = h.queries.LanguageHostExistsExcluding(r.Context(), store.LanguageHostExistsExcludingParams{Host: host})
+		}
+		if err != nil {
+			slog.Error("database error checking site host", "error", err, "host", host)
+			input.Errors["host"] = i18n.T(lang, "sites.error_host_check")
+		} else if taken != 0 {
+			input.Errors["host"] = i18n.T(lang, "languages.error_host_taken")
+		}
+	}
+
+	if input.Theme != "" && h.themeManager != nil {
+		if _, err := h.themeManager.GetTheme(input.Theme); err != nil {
+			input.Errors["theme"] = i18n.T(lang, "sites.error_theme")
+		}
+	}
+
+	return input
+}
+
+// siteConfigItems builds the site settings fields of the form from the
+// overridden values.
+func siteConfigItems(lang string, values map[string]string) []adminviews.SiteConfigItem {
+	items := make([]adminviews.SiteConfigItem, len(model.SiteConfigFields))
+	for i, field := range model.SiteConfigFields {
+		items[i] = adminviews.SiteConfigItem{
+			Key:         field.Key,
+			Label:       configKeyToLabel(field.Key, lang),
+			Description: configKeyToDescription(field.Key, field.Description, lang),
+			Type:        field.Type,
+			Value:       values[field.Key],
+		}
+	}
+	return items
+}
+
+// convertSiteInfo converts a store site to the form view SiteInfo.
+func convertSiteInfo(site store.Site) *adminviews.SiteInfo {
+	return &adminviews.SiteInfo{
+		ID:        site.ID,
+		Name:      site.Name,
+		Host:      site.Host,
+		Theme:     site.Theme,
+		IsDefault: site.IsDefault,
+		CreatedAt: site.CreatedAt,
+		UpdatedAt: site.UpdatedAt,
+	}
+}
+
+// sitesBreadcrumbs returns breadcrumbs for the sites list page.
+func sitesBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "nav.sites"), URL: redirectAdminSites, Active: true},
+	}
+}
+
+// siteFormBreadcrumbs returns breadcrumbs for the site form page. An empty
+// name is the new site form.
+func siteFormBreadcrumbs(lang string, name string, siteID int64) []render.Breadcrumb {
+	crumb := render.Breadcrumb{Label: i18n.T(lang, "sites.new"), Active: true}
+	if name != "" {
+		crumb = render.Breadcrumb{Label: name, URL: fmt.Sprintf(redirectAdminSitesID, siteID), Active: true}
+	}
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "nav.sites"), URL: redirectAdminSites},
+		crumb,
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestSitesHandler_Delete(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	admin := createTestAdminUser(t, db)
+	handler := NewSitesHandler(db, nil, sm, nil, nil)
+
+	if _, err := db.Exec(`INSERT INTO sites (id, name, host) VALUES (2, 'Digest', 'digest.example.org'), (3, 'Blog', 'blog.example.net')`); err != nil {
+		t.Fatalf("create sites: %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO menus (name, slug, site_id) VALUES ('Main', 'main', 2)`); err != nil {
+		t.Fatalf("create menu: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+		wantGone   bool
+	}{
+		{name: "default site", id: "1", wantStatus: http.StatusBadRequest},
+		{name: "site with content", id: "2", wantStatus: http.StatusConflict},
+		{name: "empty site", id: "3", wantStatus: http.StatusOK, wantGone: true},
+		{name: "unknown site", id: "99", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, w := newAuthenticatedDeleteRequest(t, sm, "/admin/sites/"+tt.id, map[string]string{"id": tt.id}, &admin)
+			req.Header.Set("HX-Request", "true")
+
+			handler.Delete(w, req)
+
+			assertStatus(t, w.Code, tt.wantStatus)
+			var count int
+			if err := db.QueryRow(`SELECT COUNT(*) FROM sites WHERE id = ?`, tt.id).Scan(&count); err != nil {
+				t.Fatalf("count sites: %v", err)
+			}
+			if gone := count == 0; gone != tt.wantGone && tt.wantStatus != http.StatusNotFound {
+				t.Errorf("site deleted = %v, want %v", gone, tt.wantGone)
+			}
+		})
+	}
+}
+
+func TestAdminSectionURL(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/admin", want: "/admin"},
+		{path: "/admin/", want: "/admin"},
+		{path: "/admin/pages", want: "/admin/pages"},
+		{path: "/admin/pages/12", want: "/admin/pages"},
+		{path: "/admin/media/4/edit", want: "/admin/media"},
+		{path: "/blog/post", want: "/admin"},
+	}
+
+	for _, tt := range tests {
+		if got := adminSectionURL(tt.path); got != tt.want {
+			t.Errorf("adminSectionURL(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,414 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+
+	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/security"
+	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/util"
+	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+)
+
+const (
+	// maxSnippetNameLen caps snippet names.
+	maxSnippetNameLen = 255
+	// maxSnippetSlugLen caps snippet slugs, which are used in shortcodes.
+	maxSnippetSlugLen = 64
+)
+
+// SnippetsHandler handles reusable content snippet management routes.
+type SnippetsHandler struct {
+	queries               *store.Queries
+	renderer              *render.Renderer
+	sessionManager        *scs.SessionManager
+	eventService          *service.EventService
+	blockSuspiciousMarkup bool
+	sanitizePageHTML      bool
+}
+
+// NewSnippetsHandler creates a new SnippetsHandler.
+func NewSnippetsHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionManager) *SnippetsHandler {
+	return &SnippetsHandler{
+		queries:        store.New(db),
+		renderer:       renderer,
+		sessionManager: sm,
+		eventService:   service.NewEventService(db),
+	}
+}
+
+// SetBlockSuspiciousMarkup configures whether snippet writes are blocked when
+// suspicious HTML tokens are detected, like page bodies.
+func (h *SnippetsHandler) SetBlockSuspiciousMarkup(block bool) {
+	h.blockSuspiciousMarkup = block
+}
+
+// SetSanitizePageHTML configures whether snippet HTML is sanitized before write.
+func (h *SnippetsHandler) SetSanitizePageHTML(sanitize bool) {
+	h.sanitizePageHTML = sanitize
+}
+
+// SnippetFormData holds data for the snippet form template.
+type SnippetFormData struct {
+	Snippet      *store.Snippet
+	Languages    []store.Language
+	Translations []store.Snippet // other languages of the same slug
+	Errors       map[string]string
+	FormValues   map[string]string
+	IsEdit       bool
+}
+
+// List handles GET /admin/snippets - lists snippets grouped by slug.
+func (h *SnippetsHandler) List(w http.ResponseWriter, r *http.Request) {
+	lang := middleware.GetAdminLang(r)
+
+	snippets, err := h.queries.ListSnippets(r.Context())
+	if err != nil {
+		logAndInternalError(w, "failed to list snippets", "error", err)
+		return
+	}
+
+	languages := ListActiveLanguagesWithFallback(r.Context(), h.queries)
+	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "snippets.title"), snippetsBreadcrumbs(lang))
+	renderTempl(w, r, adminviews.SnippetsListPage(pc, convertSnippetsListViewData(snippets, languages)))
+}
+
+// NewForm handles GET /admin/snippets/new - displays the new snippet form.
+// The slug and language query parameters prefill a translation of an
+// existing snippet.
+func (h *SnippetsHandler) NewForm(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentReadOnly, redirectAdminSnippets) {
+		return
+	}
+
+	languages := ListActiveLanguagesWithFallback(r.Context(), h.queries)
+	values := map[string]string{
+		"slug":          r.URL.Query().Get("slug"),
+		"language_code": r.URL.Query().Get("language"),
+	}
+	if values["language_code"] == "" {
+		if def := FindDefaultLanguage(languages); def != nil {
+			values["language_code"] = def.Code
+		}
+	}
+	if values["slug"] != "" {
+		if source, ok := h.translationSource(r.Context(), values["slug"], languages); ok {
+			values["name"] = source.Name
+			values["body"] = source.Body
+		}
+	}
+
+	h.renderForm(w, r, SnippetFormData{
+		Languages:  languages,
+		Errors:     make(map[string]string),
+		FormValues: values,
+	})
+}
+
+// Create handles POST /admin/snippets - creates a snippet.
+func (h *SnippetsHandler) Create(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentReadOnly, redirectAdminSnippets) {
+		return
+	}
+
+	if !parseFormOrRedirect(w, r, h.renderer, redirectAdminSnippetsNew) {
+		return
+	}
+
+	input := parseSnippetFormInput(r)
+	if validationErrors := h.validateSnippetForm(r.Context(), input, 0); len(validationErrors) > 0 {
+		h.renderForm(w, r, SnippetFormData{
+			Languages:  ListActiveLanguagesWithFallback(r.Context(), h.queries),
+			Errors:     validationErrors,
+			FormValues: input.toFormValues(),
+		})
+		return
+	}
+
+	now := time.Now()
+	snippet, err := h.queries.CreateSnippet(r.Context(), store.CreateSnippetParams{
+		Name:         input.Name,
+		Slug:         input.Slug,
+		Body:         h.normalizeSnippetBody(input.Body),
+		LanguageCode: input.LanguageCode,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+	if err != nil {
+		slog.Error("failed to create snippet", "error", err)
+		flashError(w, r, h.renderer, redirectAdminSnippetsNew, "Error creating snippet")
+		return
+	}
+
+	slog.Info("snippet created", "snippet_id", snippet.ID, "slug", snippet.Slug, "language", snippet.LanguageCode, "created_by", middleware.GetUserID(r))
+	_ = h.eventService.LogPageEvent(r.Context(), model.EventLevelInfo, "Snippet created",
+		middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+		map[string]any{"snippet_id": snippet.ID, "slug": snippet.Slug, "language": snippet.LanguageCode})
+
+	flashSuccess(w, r, h.renderer, redirectAdminSnippets, "Snippet created successfully")
+}
+
+// EditForm handles GET /admin/snippets/{id} - displays the edit form.
+func (h *SnippetsHandler) EditForm(w http.ResponseWriter, r *http.Request) {
+	id, err := ParseIDParam(r)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminSnippets, "Invalid snippet ID")
+		return
+	}
+
+	snippet, ok := h.requireSnippetWithRedirect(w, r, id)
+	if !ok {
+		return
+	}
+
+	h.renderForm(w, r, SnippetFormData{
+		Snippet:   &snippet,
+		Languages: ListActiveLanguagesWithFallback(r.Context(), h.queries),
+		Errors:    make(map[string]string),
+		FormValues: map[string]string{
+			"name":          snippet.Name,
+			"slug":          snippet.Slug,
+			"body":          snippet.Body,
+			"language_code": snippet.LanguageCode,
+		},
+		IsEdit: true,
+	})
+}
+
+// Update handles PUT /admin/snippets/{id} - updates a snippet.
+func (h *SnippetsHandler) Update(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentReadOnly, redirectAdminSnippets) {
+		return
+	}
+
+	id, err := ParseIDParam(r)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminSnippets, "Invalid snippet ID")
+		return
+	}
+
+	snippet, ok := h.requireSnippetWithRedirect(w, r, id)
+	if !ok {
+		return
+	}
+
+	editURL := fmt.Sprintf(redirectAdminSnippetsID, id)
+	if !parseFormOrRedirect(w, r, h.renderer, editURL) {
+		return
+	}
+
+	input := parseSnippetFormInput(r)
+	if validationErrors := h.validateSnippetForm(r.Context(), input, id); len(validationErrors) > 0 {
+		h.renderForm(w, r, SnippetFormData{
+			Snippet:    &snippet,
+			Languages:  ListActiveLanguagesWithFallback(r.Context(), h.queries),
+			Errors:     validationErrors,
+			FormValues: input.toFormValues(),
+			IsEdit:     true,
+		})
+		return
+	}
+
+	if _, err := h.queries.UpdateSnippet(r.Context(), store.UpdateSnippetParams{
+		Name:         input.Name,
+		Slug:         input.Slug,
+		Body:         h.normalizeSnippetBody(input.Body),
+		LanguageCode: input.LanguageCode,
+		UpdatedAt:    time.Now(),
+		ID:           id,
+	}); err != nil {
+		slog.Error("failed to update snippet", "error", err, "snippet_id", id)
+		flashError(w, r, h.renderer, editURL, "Error updating snippet")
+		return
+	}
+
+	slog.Info("snippet updated", "snippet_id", id, "updated_by", middleware.GetUserID(r))
+	_ = h.eventService.LogPageEvent(r.Context(), model.EventLevelInfo, "Snippet updated",
+		middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+		map[string]any{"snippet_id": id, "slug": input.Slug, "old_slug": snippet.Slug, "language": input.LanguageCode})
+
+	flashSuccess(w, r, h.renderer, redirectAdminSnippets, "Snippet updated successfully")
+}
+
+// Delete handles DELETE /admin/snippets/{id} - deletes a snippet.
+func (h *SnippetsHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if middleware.IsDemoMode() {
+		h.sendDeleteError(w, middleware.DemoModeMessageDetailed(middleware.RestrictionContentReadOnly))
+		return
+	}
+
+	handleDeleteEntity(w, r, h.renderer, deleteEntityParams[store.Snippet]{
+		EntityName:     "snippet",
+		IDField:        "snippet_id",
+		RedirectURL:    redirectAdminSnippets,
+		SuccessMessage: "Snippet deleted successfully",
+		RequireFn: func(id int64) (store.Snippet, bool) {
+			return requireEntityWithCustomError(w, "Snippet", id,
+				func(id int64) (store.Snippet, error) { return h.queries.GetSnippetByID(r.Context(), id) },
+				h.sendDeleteError)
+		},
+		DeleteFn: h.queries.DeleteSnippet,
+		GetSlug:  func(s store.Snippet) string { return s.Slug },
+		OnDeleted: func(s store.Snippet) {
+			_ = h.eventService.LogPageEvent(r.Context(), model.EventLevelInfo, "Snippet deleted",
+				middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+				map[string]any{"snippet_id": s.ID, "slug": s.Slug, "language": s.LanguageCode})
+		},
+	})
+}
+
+// snippetFormInput holds parsed form values for snippet create/update.
+type snippetFormInput struct {
+	Name         string
+	Slug         string
+	Body         string
+	LanguageCode string
+}
+
+// parseSnippetFormInput extracts snippet form values. An empty slug is
+// derived from the name.
+func parseSnippetFormInput(r *http.Request) snippetFormInput {
+	input := snippetFormInput{
+		Name:         strings.TrimSpace(r.FormValue("name")),
+		Slug:         strings.TrimSpace(r.FormValue("slug")),
+		Body:         r.FormValue("body"),
+		LanguageCode: strings.TrimSpace(r.FormValue("language_code")),
+	}
+	if input.Slug == "" {
+		input.Slug = util.Slugify(input.Name)
+	}
+	return input
+}
+
+// toFormValues converts snippetFormInput to a map for form re-rendering.
+func (input snippetFormInput) toFormValues() map[string]string {
+	return map[string]string{
+		"name":          input.Name,
+		"slug":          input.Slug,
+		"body":          input.Body,
+		"language_code": input.LanguageCode,
+	}
+}
+
+// validateSnippetForm validates snippet form input. excludeID is the snippet
+// being edited. Slugs are unique per language, so translations of a snippet
+// share its slug.
+func (h *SnippetsHandler) validateSnippetForm(ctx context.Context, input snippetFormInput, excludeID int64) map[string]string {
+	validationErrors := make(map[string]string)
+
+	if input.Name == "" {
+		validationErrors["name"] = "Name is required"
+	} else if len(input.Name) > maxSnippetNameLen {
+		validationErrors["name"] = "Name must be less than 255 characters"
+	}
+
+	if _, err := getRoutableContentLanguage(ctx, h.queries, input.LanguageCode); err != nil {
+		validationErrors["language_code"] = "Select an active language"
+	}
+
+	switch {
+	case input.Slug == "":
+		validationErrors["slug"] = "Slug is required"
+	case len(input.Slug) > maxSnippetSlugLen:
+		validationErrors["slug"] = fmt.Sprintf("Slug must be at most %d characters", maxSnippetSlugLen)
+	case !util.IsValidSlug(input.Slug):
+		validationErrors["slug"] = "Invalid slug format (use lowercase letters, numbers, and hyphens)"
+	case validationErrors["language_code"] != "":
+	default:
+		existing, err := h.queries.GetSnippetBySlugAndLanguage(ctx, store.GetSnippetBySlugAndLanguageParams{
+			Slug:         input.Slug,
+			LanguageCode: input.LanguageCode,
+		})
+		if err == nil && existing.ID != excludeID {
+			validationErrors["slug"] = "A snippet with this slug already exists in this language"
+		} else if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("failed to check snippet slug", "error", err)
+			validationErrors["slug"] = "Error checking slug"
+		}
+	}
+
+	if bodyErr := validatePageBodySecurityPolicy(input.Body, h.blockSuspiciousMarkup); bodyErr != "" {
+		validationErrors["body"] = bodyErr
+	}
+
+	return validationErrors
+}
+
+// normalizeSnippetBody applies the page HTML storage policy to a snippet body.
+func (h *SnippetsHandler) normalizeSnippetBody(raw string) string {
+	if !h.sanitizePageHTML {
+		return raw
+	}
+	return security.SanitizePageHTML(raw)
+}
+
+// translationSource returns the snippet to copy into a new translation of
+// slug, preferring the default language.
+func (h *SnippetsHandler) translationSource(ctx context.Context, slug string, languages []store.Language) (store.Snippet, bool) {
+	if def := FindDefaultLanguage(languages); def != nil {
+		if s, err := h.queries.GetSnippetBySlugAndLanguage(ctx, store.GetSnippetBySlugAndLanguageParams{
+			Slug: slug, LanguageCode: def.Code,
+		}); err == nil {
+			return s, true
+		}
+	}
+	for _, l := range languages {
+		if s, err := h.queries.GetSnippetBySlugAndLanguage(ctx, store.GetSnippetBySlugAndLanguageParams{
+			Slug: slug, LanguageCode: l.Code,
+		}); err == nil {
+			return s, true
+		}
+	}
+	return store.Snippet{}, false
+}
+
+// renderForm renders the new or edit snippet form.
+func (h *SnippetsHandler) renderForm(w http.ResponseWriter, r *http.Request, data SnippetFormData) {
+	lang := middleware.GetAdminLang(r)
+
+	title := i18n.T(lang, "snippets.new")
+	breadcrumbs := snippetNewBreadcrumbs(lang)
+	if data.IsEdit && data.Snippet != nil {
+		title = i18n.T(lang, "snippets.edit")
+		breadcrumbs = snippetEditBreadcrumbs(lang, *data.Snippet)
+		if all, err := h.queries.ListSnippets(r.Context()); err == nil {
+			for _, s := range all {
+				if s.Slug == data.Snippet.Slug && s.ID != data.Snippet.ID {
+					data.Translations = append(data.Translations, s)
+				}
+			}
+		}
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, title, breadcrumbs)
+	renderTempl(w, r, adminviews.SnippetFormPage(pc, convertSnippetFormViewData(data)))
+}
+
+// requireSnippetWithRedirect fetches a snippet by ID and redirects with flash on error.
+func (h *SnippetsHandler) requireSnippetWithRedirect(w http.ResponseWriter, r *http.Request, id int64) (store.Snippet, bool) {
+	return requireEntityWithRedirect(w, r, h.renderer, redirectAdminSnippets, "Snippet", id,
+		func(id int64) (store.Snippet, error) { return h.queries.GetSnippetByID(r.Context(), id) })
+}
+
+// sendDeleteError sends an error response for delete operations.
+func (h *SnippetsHandler) sendDeleteError(w http.ResponseWriter, message string) {
+	w.Header().Set("HX-Reswap", "none")
+	w.Header().Set("HX-Trigger", `{"showToast": "`+message+`", "toastType": "error"}`)
+	w.WriteHeader(http.StatusBadRequest)
+}
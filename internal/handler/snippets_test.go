@@ -0,0 +1,147 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/shortcode"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// newTestSnippet creates a snippet in the given language.
+func newTestSnippet(t *testing.T, queries *store.Queries, slug, lang, body string) store.Snippet {
+	t.Helper()
+
+	now := time.Now()
+	s, err := queries.CreateSnippet(context.Background(), store.CreateSnippetParams{
+		Name:         slug,
+		Slug:         slug,
+		Body:         body,
+		LanguageCode: lang,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+	if err != nil {
+		t.Fatalf("CreateSnippet: %v", err)
+	}
+	return s
+}
+
+func TestValidateSnippetForm(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	h := NewSnippetsHandler(db, nil, sm)
+	h.SetBlockSuspiciousMarkup(true)
+	createTestLanguage(t, db, "ru", true)
+	existing := newTestSnippet(t, h.queries, "cta", "en", "<p>Subscribe</p>")
+
+	tests := []struct {
+		name      string
+		input     snippetFormInput
+		excludeID int64
+		wantErr   string
+	}{
+		{"valid", snippetFormInput{Name: "Disclaimer", Slug: "disclaimer", LanguageCode: "en"}, 0, ""},
+		{"missing name", snippetFormInput{Slug: "x", LanguageCode: "en"}, 0, "name"},
+		{"bad slug", snippetFormInput{Name: "X", Slug: "Bad Slug", LanguageCode: "en"}, 0, "slug"},
+		{"unknown language", snippetFormInput{Name: "X", Slug: "x", LanguageCode: "de"}, 0, "language_code"},
+		{"duplicate slug", snippetFormInput{Name: "CTA", Slug: "cta", LanguageCode: "en"}, 0, "slug"},
+		{"same slug on edit", snippetFormInput{Name: "CTA", Slug: "cta", LanguageCode: "en"}, existing.ID, ""},
+		{"translation", snippetFormInput{Name: "CTA", Slug: "cta", LanguageCode: "ru"}, 0, ""},
+		{"suspicious body", snippetFormInput{Name: "X", Slug: "x", LanguageCode: "en", Body: `<img src=x onerror="alert(1)">`}, 0, "body"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := h.validateSnippetForm(context.Background(), tt.input, tt.excludeID)
+			if tt.wantErr == "" && len(errs) > 0 {
+				t.Errorf("unexpected errors: %v", errs)
+			}
+			if tt.wantErr != "" && errs[tt.wantErr] == "" {
+				t.Errorf("expected %s error, got %v", tt.wantErr, errs)
+			}
+		})
+	}
+}
+
+func TestConvertSnippetsListViewData(t *testing.T) {
+	snippets := []store.Snippet{
+		{ID: 1, Slug: "cta", Name: "CTA", LanguageCode: "en"},
+		{ID: 2, Slug: "cta", Name: "Призыв", LanguageCode: "ru"},
+		{ID: 3, Slug: "legal", Name: "Legal", LanguageCode: "en"},
+	}
+	languages := []store.Language{{Code: "en"}, {Code: "ru"}}
+
+	data := convertSnippetsListViewData(snippets, languages)
+	if len(data.Snippets) != 2 {
+		t.Fatalf("snippets = %+v, want 2 slugs", data.Snippets)
+	}
+	if got := data.Snippets[0]; len(got.Translations) != 2 || len(got.Missing) != 0 {
+		t.Errorf("cta = %+v", got)
+	}
+	if got := data.Snippets[1]; len(got.Missing) != 1 || got.Missing[0] != "ru" {
+		t.Errorf("legal missing = %v, want [ru]", got.Missing)
+	}
+}
+
+func TestFrontendExpandShortcodes(t *testing.T) {
+	db, _ := testHandlerSetup(t)
+	user := createTestAdminUser(t, db)
+	createTestLanguage(t, db, "ru", true)
+	queries := store.New(db)
+	h := NewFrontendHandler(db, testThemeManager(), nil, slog.Default(), nil, nil)
+
+	newTestSnippet(t, queries, "cta", "en", `<div class="cta">Subscribe [[form:contact]]</div>`)
+	newTestSnippet(t, queries, "cta", "ru", `<div class="cta">Подпишитесь</div>`)
+	newTestSnippet(t, queries, "legal", "en", `<p>Legal</p>`)
+	if _, err := db.Exec(`INSERT INTO forms (name, slug, title, language_code) VALUES ('Contact', 'contact', 'Contact us', 'en')`); err != nil {
+		t.Fatalf("insert form: %v", err)
+	}
+	res, err := db.Exec(`INSERT INTO media_folders (name) VALUES ('Trip')`)
+	if err != nil {
+		t.Fatalf("insert folder: %v", err)
+	}
+	folderID, _ := res.LastInsertId()
+	if _, err := db.Exec(`INSERT INTO media (uuid, filename, mime_type, size, alt, folder_id, uploaded_by) VALUES
+		('u1', 'a.jpg', 'image/jpeg', 1, 'Beach', ?, ?), ('u2', 'doc.pdf', 'application/pdf', 1, '', ?, ?)`,
+		folderID, user.ID, folderID, user.ID); err != nil {
+		t.Fatalf("insert media: %v", err)
+	}
+
+	ctx := context.Background()
+	body := h.expandShortcodes(ctx, fmt.Sprintf(`<p>[[snippet:cta]]</p><p>[[snippet:missing]]</p>[[gallery folder=%d caption="Our trip"]]`, folderID), "en", "")
+	for _, want := range []string{
+		`<div class="cta">Subscribe <div class="shortcode-form" data-form="contact"><a href="/forms/contact">Contact us</a></div></div>`,
+		`<img src="/uploads/thumbnail/u1/a.jpg" alt="Beach" loading="lazy">`,
+		"<figcaption>Our trip</figcaption>",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expanded body missing %q:\n%s", want, body)
+		}
+	}
+	if strings.Contains(body, "missing") || strings.Contains(body, "doc.pdf") {
+		t.Errorf("missing snippet or non-image media rendered:\n%s", body)
+	}
+
+	if got := h.expandShortcodes(ctx, "[[snippet:cta]] [[snippet:legal]]", "ru", "/ru"); got != `<div class="cta">Подпишитесь</div> <p>Legal</p>` {
+		t.Errorf("ru body = %q, want the translation and the default-language fallback", got)
+	}
+
+	h.SetModuleShortcodesProvider(testShortcodeProvider{"year": func(context.Context, shortcode.Call) (template.HTML, error) {
+		return "2026", nil
+	}})
+	if got := h.expandShortcodes(ctx, "© [[year]]", "en", ""); got != "© 2026" {
+		t.Errorf("module shortcode = %q", got)
+	}
+}
+
+// testShortcodeProvider is a fixed set of module shortcodes.
+type testShortcodeProvider map[string]shortcode.Func
+
+func (p testShortcodeProvider) AllShortcodes() map[string]shortcode.Func { return p }
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 
 	"github.com/olegiv/ocms-go/internal/cache"
 	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/machinetranslation"
 	"github.com/olegiv/ocms-go/internal/middleware"
 	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/render"
@@ -26,6 +28,7 @@ import (
 	"github.com/olegiv/ocms-go/internal/store"
 	"github.com/olegiv/ocms-go/internal/util"
 	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+	"github.com/olegiv/ocms-go/internal/webhook"
 )
 
 // TagsPerPage is the number of tags to display per page.
@@ -45,6 +48,9 @@ type TaxonomyHandler struct {
 	sessionManager *scs.SessionManager
 	eventService   *service.EventService
 	cacheManager   *cache.Manager
+	dispatcher     *webhook.Dispatcher
+	translator     *machinetranslation.Translator
+	urlHistory     *URLHistory
 }
 
 // SetCacheManager enables translation-cache invalidation after taxonomy writes.
@@ -52,6 +58,34 @@ func (h *TaxonomyHandler) SetCacheManager(cm *cache.Manager) {
 	h.cacheManager = cm
 }
 
+// SetMachineTranslator enables machine translation of new tag and category
+// translations.
+func (h *TaxonomyHandler) SetMachineTranslator(t *machinetranslation.Translator) {
+	h.translator = t
+}
+
+// SetDispatcher sets the webhook dispatcher for event dispatching.
+func (h *TaxonomyHandler) SetDispatcher(d *webhook.Dispatcher) {
+	h.dispatcher = d
+	h.urlHistory.SetDispatcher(d)
+}
+
+// SetRedirectsMiddleware sets the redirects middleware whose cache is
+// invalidated when a renamed tag or category gets a redirect.
+func (h *TaxonomyHandler) SetRedirectsMiddleware(rm *middleware.RedirectsMiddleware) {
+	h.urlHistory.SetRedirectsMiddleware(rm)
+}
+
+// dispatchTagEvent dispatches a tag-related webhook event.
+func (h *TaxonomyHandler) dispatchTagEvent(ctx context.Context, eventType string, tag store.Tag) {
+	dispatchWebhookEvent(ctx, h.dispatcher, eventType, webhook.NewTagEventData(tag), "tag_id", tag.ID)
+}
+
+// dispatchCategoryEvent dispatches a category-related webhook event.
+func (h *TaxonomyHandler) dispatchCategoryEvent(ctx context.Context, eventType string, category store.Category) {
+	dispatchWebhookEvent(ctx, h.dispatcher, eventType, webhook.NewCategoryEventData(category), "category_id", category.ID)
+}
+
 // NewTaxonomyHandler creates a new TaxonomyHandler.
 func NewTaxonomyHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionManager) *TaxonomyHandler {
 	return &TaxonomyHandler{
@@ -59,6 +93,7 @@ func NewTaxonomyHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionMa
 		renderer:       renderer,
 		sessionManager: sm,
 		eventService:   service.NewEventService(db),
+		urlHistory:     NewURLHistory(db),
 	}
 }
 
@@ -271,6 +306,7 @@ func (h *TaxonomyHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
 
 	slog.Info("tag created", "tag_id", newTag.ID, "slug", newTag.Slug, "created_by", middleware.GetUserID(r))
 	_ = h.eventService.LogTagEvent(r.Context(), model.EventLevelInfo, "Tag created", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"tag_id": newTag.ID, "name": newTag.Name, "slug": newTag.Slug})
+	h.dispatchTagEvent(r.Context(), model.EventTagCreated, newTag)
 	flashSuccess(w, r, h.renderer, redirectAdminTags, "Tag created successfully")
 }
 
@@ -300,6 +336,8 @@ func (h *TaxonomyHandler) EditTagForm(w http.ResponseWriter, r *http.Request) {
 		Language:         convertLanguageOptionPtr(langInfo.EntityLanguage),
 		Translations:     convertTagTranslations(langInfo.Translations),
 		MissingLanguages: convertLanguageOptions(langInfo.MissingLanguages),
+		MachineTranslation: convertMachineTranslationView(
+			loadMachineTranslation(r.Context(), h.queries, model.EntityTypeTag, id), "", h.renderer, lang),
 	}
 
 	pc := buildPageContext(r, h.sessionManager, h.renderer, tag.Name, tagEditBreadcrumbs(lang, tag.Name, tag.ID))
@@ -335,8 +373,9 @@ func (h *TaxonomyHandler) UpdateTag(w http.ResponseWriter, r *http.Request) {
 
 	// Store form values for re-rendering on error
 	formValues := map[string]string{
-		"name": name,
-		"slug": slug,
+		"name":        name,
+		"slug":        slug,
+		"mt_reviewed": r.FormValue("mt_reviewed"),
 	}
 
 	slug = autoGenerateSlug(name, slug, formValues)
@@ -361,6 +400,8 @@ func (h *TaxonomyHandler) UpdateTag(w http.ResponseWriter, r *http.Request) {
 			IsEdit:       true,
 			AllLanguages: convertLanguageOptions(langInfo.AllLanguages),
 			Language:     convertLanguageOptionPtr(langInfo.EntityLanguage),
+			MachineTranslation: convertMachineTranslationView(
+				loadMachineTranslation(r.Context(), h.queries, model.EntityTypeTag, id), formValues["mt_reviewed"], h.renderer, lang),
 		}
 
 		pc := buildPageContext(r, h.sessionManager, h.renderer, existingTag.Name, tagEditBreadcrumbs(lang, existingTag.Name, id))
@@ -382,9 +423,12 @@ func (h *TaxonomyHandler) UpdateTag(w http.ResponseWriter, r *http.Request) {
 		flashError(w, r, h.renderer, fmt.Sprintf(redirectAdminTagsID, id), "Error updating tag")
 		return
 	}
+	confirmMachineTranslation(r.Context(), h.queries, model.EntityTypeTag, id, formValues["mt_reviewed"])
+	h.urlHistory.RedirectRenamedTag(r.Context(), existingTag, updatedTag)
 
 	slog.Info("tag updated", "tag_id", updatedTag.ID, "slug", updatedTag.Slug, "updated_by", middleware.GetUserID(r))
 	_ = h.eventService.LogTagEvent(r.Context(), model.EventLevelInfo, "Tag updated", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"tag_id": updatedTag.ID, "name": updatedTag.Name, "slug": updatedTag.Slug})
+	h.dispatchTagEvent(r.Context(), model.EventTagUpdated, updatedTag)
 	flashSuccess(w, r, h.renderer, redirectAdminTags, "Tag updated successfully")
 }
 
@@ -400,6 +444,7 @@ func (h *TaxonomyHandler) DeleteTag(w http.ResponseWriter, r *http.Request) {
 	params.RequireFn = func(id int64) (store.Tag, bool) { return h.requireTagWithError(w, r, id) }
 	params.DeleteFn = h.queries.DeleteTag
 	params.GetSlug = func(t store.Tag) string { return t.Slug }
+	params.OnDeleted = func(t store.Tag) { h.dispatchTagEvent(r.Context(), model.EventTagDeleted, t) }
 	handleDeleteEntity(w, r, h.renderer, params)
 }
 
@@ -420,7 +465,8 @@ func (h *TaxonomyHandler) BulkDeleteTags(w http.ResponseWriter, r *http.Request)
 	deleted := 0
 
 	for _, id := range ids {
-		if _, err := h.queries.GetTagByID(r.Context(), id); err != nil {
+		tag, err := h.queries.GetTagByID(r.Context(), id)
+		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				failed = append(failed, bulkActionFailedItem{ID: id, Reason: "Tag not found"})
 				continue
@@ -436,6 +482,7 @@ func (h *TaxonomyHandler) BulkDeleteTags(w http.ResponseWriter, r *http.Request)
 			continue
 		}
 
+		h.dispatchTagEvent(r.Context(), model.EventTagDeleted, tag)
 		deleted++
 	}
 
@@ -499,9 +546,16 @@ func (h *TaxonomyHandler) TranslateTag(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Create the translated tag with same name
+	// Create the translated tag with same name, unless a machine
+	// translation provider pre-fills it
+	name := sourceTag.Name
+	mt := machineTranslate(r.Context(), h.translator, sourceTag.LanguageCode, setup.TargetContext.TargetLang.Code,
+		machinetranslation.Text(sourceTag.Name))
+	if mt != nil {
+		name = mt[0]
+	}
 	translatedTag, err := h.queries.CreateTag(r.Context(), store.CreateTagParams{
-		Name:         sourceTag.Name, // Keep same name (user will translate)
+		Name:         name,
 		Slug:         setup.TranslatedSlug,
 		LanguageCode: setup.TargetContext.TargetLang.Code,
 		CreatedAt:    setup.Now,
@@ -524,9 +578,16 @@ func (h *TaxonomyHandler) TranslateTag(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		slog.Error("failed to create translation link", "error", err)
 		// Tag was created, so we should still redirect to it
-	} else if h.cacheManager != nil {
-		h.cacheManager.Translation.InvalidateType(model.EntityTypeTag)
+	} else {
+		if h.cacheManager != nil {
+			h.cacheManager.Translation.InvalidateType(model.EntityTypeTag)
+		}
+		dispatchTranslationLinked(r.Context(), h.dispatcher, model.EntityTypeTag, id, translatedTag.ID, setup.TargetContext.TargetLang.Code)
+	}
+	if mt != nil {
+		markMachineTranslated(r.Context(), h.queries, h.translator, model.EntityTypeTag, translatedTag.ID)
 	}
+	h.dispatchTagEvent(r.Context(), model.EventTagCreated, translatedTag)
 
 	slog.Info("tag translation created",
 		"source_tag_id", id,
@@ -534,7 +595,8 @@ func (h *TaxonomyHandler) TranslateTag(w http.ResponseWriter, r *http.Request) {
 		"language", setup.TargetContext.TargetLang.Code,
 		"created_by", middleware.GetUserID(r))
 
-	flashSuccess(w, r, h.renderer, fmt.Sprintf(redirectAdminTagsID, translatedTag.ID), fmt.Sprintf("Translation created for %s. Please translate the name.", setup.TargetContext.TargetLang.Name))
+	flashSuccess(w, r, h.renderer, fmt.Sprintf(redirectAdminTagsID, translatedTag.ID), machineTranslationFlash(mt != nil, h.translator,
+		fmt.Sprintf("Translation created for %s.", setup.TargetContext.TargetLang.Name), "Please translate the name."))
 }
 
 // =============================================================================
@@ -843,6 +905,7 @@ func (h *TaxonomyHandler) CreateCategory(w http.ResponseWriter, r *http.Request)
 
 	slog.Info("category created", "category_id", newCategory.ID, "slug", newCategory.Slug, "created_by", middleware.GetUserID(r))
 	_ = h.eventService.LogCategoryEvent(r.Context(), model.EventLevelInfo, "Category created", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"category_id": newCategory.ID, "name": newCategory.Name, "slug": newCategory.Slug})
+	h.dispatchCategoryEvent(r.Context(), model.EventCategoryCreated, newCategory)
 	flashSuccess(w, r, h.renderer, redirectAdminCategories, "Category created successfully")
 }
 
@@ -875,6 +938,8 @@ func (h *TaxonomyHandler) EditCategoryForm(w http.ResponseWriter, r *http.Reques
 		Language:         convertLanguageOptionPtr(langInfo.EntityLanguage),
 		Translations:     convertCategoryTranslations(langInfo.Translations),
 		MissingLanguages: convertLanguageOptions(langInfo.MissingLanguages),
+		MachineTranslation: convertMachineTranslationView(
+			loadMachineTranslation(r.Context(), h.queries, model.EntityTypeCategory, id), "", h.renderer, lang),
 	}
 
 	pc := buildPageContext(r, h.sessionManager, h.renderer, category.Name, categoryEditBreadcrumbs(lang, category.Name, category.ID))
@@ -919,6 +984,7 @@ func (h *TaxonomyHandler) UpdateCategory(w http.ResponseWriter, r *http.Request)
 		"slug":        slug,
 		"description": description,
 		"parent_id":   parentIDStr,
+		"mt_reviewed": r.FormValue("mt_reviewed"),
 	}
 
 	slug = autoGenerateSlug(name, slug, formValues)
@@ -961,6 +1027,8 @@ func (h *TaxonomyHandler) UpdateCategory(w http.ResponseWriter, r *http.Request)
 			IsEdit:        true,
 			AllLanguages:  convertLanguageOptions(langInfo.AllLanguages),
 			Language:      convertLanguageOptionPtr(langInfo.EntityLanguage),
+			MachineTranslation: convertMachineTranslationView(
+				loadMachineTranslation(r.Context(), h.queries, model.EntityTypeCategory, id), formValues["mt_reviewed"], h.renderer, lang),
 		}
 
 		pc := buildPageContext(r, h.sessionManager, h.renderer, existingCategory.Name, categoryEditBreadcrumbs(lang, existingCategory.Name, id))
@@ -985,9 +1053,12 @@ func (h *TaxonomyHandler) UpdateCategory(w http.ResponseWriter, r *http.Request)
 		flashError(w, r, h.renderer, fmt.Sprintf(redirectAdminCategoriesID, id), "Error updating category")
 		return
 	}
+	confirmMachineTranslation(r.Context(), h.queries, model.EntityTypeCategory, id, formValues["mt_reviewed"])
+	h.urlHistory.RedirectRenamedCategory(r.Context(), existingCategory, updatedCategory)
 
 	slog.Info("category updated", "category_id", updatedCategory.ID, "slug", updatedCategory.Slug, "updated_by", middleware.GetUserID(r))
 	_ = h.eventService.LogCategoryEvent(r.Context(), model.EventLevelInfo, "Category updated", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"category_id": updatedCategory.ID, "name": updatedCategory.Name, "slug": updatedCategory.Slug})
+	h.dispatchCategoryEvent(r.Context(), model.EventCategoryUpdated, updatedCategory)
 	flashSuccess(w, r, h.renderer, redirectAdminCategories, "Category updated successfully")
 }
 
@@ -1007,6 +1078,9 @@ func (h *TaxonomyHandler) DeleteCategory(w http.ResponseWriter, r *http.Request)
 		RequireFn:      func(id int64) (store.Category, bool) { return h.requireCategoryWithError(w, r, id) },
 		DeleteFn:       h.queries.DeleteCategory,
 		GetSlug:        func(c store.Category) string { return c.Slug },
+		OnDeleted: func(c store.Category) {
+			h.dispatchCategoryEvent(r.Context(), model.EventCategoryDeleted, c)
+		},
 	})
 }
 
@@ -1036,11 +1110,20 @@ func (h *TaxonomyHandler) TranslateCategory(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// Create the translated category with same name
+	// Create the translated category with same name, unless a machine
+	// translation provider pre-fills it
+	name, description := sourceCategory.Name, sourceCategory.Description
+	mt := machineTranslate(r.Context(), h.translator, sourceCategory.LanguageCode, setup.TargetContext.TargetLang.Code,
+		machinetranslation.Text(sourceCategory.Name),
+		machinetranslation.Text(sourceCategory.Description.String))
+	if mt != nil {
+		name = mt[0]
+		description.String = mt[1]
+	}
 	translatedCategory, err := h.queries.CreateCategory(r.Context(), store.CreateCategoryParams{
-		Name:         sourceCategory.Name, // Keep same name (user will translate)
+		Name:         name,
 		Slug:         setup.TranslatedSlug,
-		Description:  sourceCategory.Description,
+		Description:  description,
 		ParentID:     sql.NullInt64{}, // No parent by default for translations
 		Position:     0,
 		LanguageCode: setup.TargetContext.TargetLang.Code,
@@ -1064,9 +1147,16 @@ func (h *TaxonomyHandler) TranslateCategory(w http.ResponseWriter, r *http.Reque
 	if err != nil {
 		slog.Error("failed to create translation link", "error", err)
 		// Category was created, so we should still redirect to it
-	} else if h.cacheManager != nil {
-		h.cacheManager.Translation.InvalidateType(model.EntityTypeCategory)
+	} else {
+		if h.cacheManager != nil {
+			h.cacheManager.Translation.InvalidateType(model.EntityTypeCategory)
+		}
+		dispatchTranslationLinked(r.Context(), h.dispatcher, model.EntityTypeCategory, id, translatedCategory.ID, setup.TargetContext.TargetLang.Code)
 	}
+	if mt != nil {
+		markMachineTranslated(r.Context(), h.queries, h.translator, model.EntityTypeCategory, translatedCategory.ID)
+	}
+	h.dispatchCategoryEvent(r.Context(), model.EventCategoryCreated, translatedCategory)
 
 	slog.Info("category translation created",
 		"source_category_id", id,
@@ -1074,7 +1164,8 @@ func (h *TaxonomyHandler) TranslateCategory(w http.ResponseWriter, r *http.Reque
 		"language", setup.TargetContext.TargetLang.Code,
 		"created_by", middleware.GetUserID(r))
 
-	flashSuccess(w, r, h.renderer, fmt.Sprintf(redirectAdminCategoriesID, translatedCategory.ID), fmt.Sprintf("Translation created for %s. Please translate the name.", setup.TargetContext.TargetLang.Name))
+	flashSuccess(w, r, h.renderer, fmt.Sprintf(redirectAdminCategoriesID, translatedCategory.ID), machineTranslationFlash(mt != nil, h.translator,
+		fmt.Sprintf("Translation created for %s.", setup.TargetContext.TargetLang.Name), "Please translate the name."))
 }
 
 // =============================================================================
@@ -1298,6 +1389,97 @@ func getTargetLanguageForTranslation(
 	return &translationContext{TargetLang: targetLang}, true
 }
 
+// detachFromTranslationComponent removes every translation edge touching
+// entityID and returns the removed edges. When the entity was the hub linking
+// several siblings, the remaining siblings are re-linked to each other so the
+// rest of the component stays intact. Returns no edges when the entity was
+// not linked to anything.
+func detachFromTranslationComponent(ctx context.Context, db *sql.DB, queries *store.Queries, entityType string, entityID int64) ([]store.GetRelatedTranslationsRow, error) {
+	edges, err := queries.GetRelatedTranslations(ctx, store.GetRelatedTranslationsParams{
+		EntityType:    entityType,
+		EntityID:      entityID,
+		TranslationID: entityID,
+	})
+	if err != nil || len(edges) == 0 {
+		return nil, err
+	}
+
+	// Snapshot member languages before the edges disappear.
+	members, err := queries.ListTranslationComponentMembers(ctx, store.ListTranslationComponentMembersParams{
+		SourceEntityID: entityID,
+		EntityType:     entityType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	memberLanguages := make(map[int64]int64, len(members))
+	for _, m := range members {
+		memberLanguages[m.EntityID] = m.LanguageID
+	}
+
+	var neighbors []int64
+	seen := make(map[int64]bool)
+	for _, e := range edges {
+		other := e.TranslationID
+		if other == entityID {
+			other = e.EntityID
+		}
+		if !seen[other] {
+			seen[other] = true
+			neighbors = append(neighbors, other)
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	txq := queries.WithTx(tx)
+
+	if err := txq.DeleteTranslationsRelatedToEntity(ctx, store.DeleteTranslationsRelatedToEntityParams{
+		EntityType:    entityType,
+		EntityID:      entityID,
+		TranslationID: entityID,
+	}); err != nil {
+		return nil, err
+	}
+
+	// Re-link siblings that were only connected through the detached entity.
+	hub := neighbors[0]
+	now := time.Now()
+	for _, n := range neighbors[1:] {
+		connected, err := txq.ListTranslationComponentMembers(ctx, store.ListTranslationComponentMembersParams{
+			SourceEntityID: hub,
+			EntityType:     entityType,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if slices.ContainsFunc(connected, func(m store.ListTranslationComponentMembersRow) bool { return m.EntityID == n }) {
+			continue
+		}
+		languageID, ok := memberLanguages[n]
+		if !ok {
+			continue
+		}
+		if _, err := txq.CreateTranslation(ctx, store.CreateTranslationParams{
+			EntityType:    entityType,
+			EntityID:      hub,
+			LanguageID:    languageID,
+			TranslationID: n,
+			CreatedAt:     now,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return edges, nil
+}
+
 // tagLanguageInfo is an alias for entityLanguageInfo with TagTranslationInfo.
 type tagLanguageInfo = entityLanguageInfo[TagTranslationInfo]
 
@@ -6,9 +6,17 @@ package handler
 import (
 	"context"
 	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/olegiv/ocms-go/internal/machinetranslation"
 	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/render"
 	"github.com/olegiv/ocms-go/internal/store"
 )
 
@@ -362,6 +370,7 @@ func TestPageTagAssociations(t *testing.T) {
 		Body:     "Content",
 		Status:   "published",
 		AuthorID: user.ID,
+		SiteID:   store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage failed: %v", err)
@@ -424,6 +433,7 @@ func TestPageCategoryAssociations(t *testing.T) {
 		Body:     "Content",
 		Status:   "published",
 		AuthorID: user.ID,
+		SiteID:   store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage failed: %v", err)
@@ -517,3 +527,115 @@ func TestAdminEntityPath(t *testing.T) {
 		})
 	}
 }
+
+func TestDetachFromTranslationComponent(t *testing.T) {
+	db, _ := testHandlerSetup(t)
+	q := store.New(db)
+	ctx := context.Background()
+
+	createTestLanguage(t, db, "fr", true)
+	createTestLanguage(t, db, "es", true)
+
+	tags := make(map[string]store.Tag)
+	for _, code := range []string{"en", "fr", "es"} {
+		tag, err := q.CreateTag(ctx, store.CreateTagParams{Name: "Tag " + code, Slug: "tag-" + code, LanguageCode: code})
+		if err != nil {
+			t.Fatalf("CreateTag(%q): %v", code, err)
+		}
+		tags[code] = tag
+	}
+	for _, code := range []string{"fr", "es"} {
+		lang, err := q.GetLanguageByCode(ctx, code)
+		if err != nil {
+			t.Fatalf("GetLanguageByCode(%q): %v", code, err)
+		}
+		if _, err := q.CreateTranslation(ctx, store.CreateTranslationParams{
+			EntityType: model.EntityTypeTag, EntityID: tags["en"].ID, LanguageID: lang.ID, TranslationID: tags[code].ID,
+		}); err != nil {
+			t.Fatalf("CreateTranslation(%q): %v", code, err)
+		}
+	}
+
+	// Detaching the hub removes both edges but keeps fr and es linked.
+	edges, err := detachFromTranslationComponent(ctx, db, q, model.EntityTypeTag, tags["en"].ID)
+	if err != nil {
+		t.Fatalf("detachFromTranslationComponent: %v", err)
+	}
+	if len(edges) != 2 {
+		t.Fatalf("removed %d edges, want 2", len(edges))
+	}
+
+	members := func(id int64) []store.ListTranslationComponentMembersRow {
+		rows, err := q.ListTranslationComponentMembers(ctx, store.ListTranslationComponentMembersParams{SourceEntityID: id, EntityType: model.EntityTypeTag})
+		if err != nil {
+			t.Fatalf("ListTranslationComponentMembers: %v", err)
+		}
+		return rows
+	}
+	if got := members(tags["en"].ID); len(got) != 0 {
+		t.Errorf("detached tag still has %d members", len(got))
+	}
+	if got := members(tags["fr"].ID); len(got) != 1 || got[0].EntityID != tags["es"].ID {
+		t.Errorf("fr members = %+v, want only es", got)
+	}
+
+	// A second detach is a no-op.
+	edges, err = detachFromTranslationComponent(ctx, db, q, model.EntityTypeTag, tags["en"].ID)
+	if err != nil || len(edges) != 0 {
+		t.Errorf("second detach = %d edges, %v; want 0, nil", len(edges), err)
+	}
+}
+
+func TestTranslateTag_MachineTranslation(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	admin := createTestAdminUser(t, db)
+	q := store.New(db)
+	ctx := context.Background()
+	createTestLanguage(t, db, "de", true)
+
+	renderer, err := render.New(render.Config{
+		TemplatesFS: os.DirFS("../../web/templates"), SessionManager: sm, DB: db, IsDev: true,
+	})
+	if err != nil {
+		t.Fatalf("create renderer: %v", err)
+	}
+	h := NewTaxonomyHandler(db, renderer, sm)
+	h.SetMachineTranslator(machinetranslation.New(machinetranslation.Stub{}))
+
+	source, err := q.CreateTag(ctx, store.CreateTagParams{Name: "Go Programming", Slug: "go-programming", LanguageCode: "en"})
+	if err != nil {
+		t.Fatalf("CreateTag: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/tags/1/translate/de", nil)
+	req = requestWithSession(sm, req)
+	req = addUserToContext(req, &admin)
+	req = requestWithURLParams(req, map[string]string{"id": strconv.FormatInt(source.ID, 10), "langCode": "de"})
+	h.TranslateTag(httptest.NewRecorder(), req)
+	if message := sm.GetString(req.Context(), "flash"); !strings.Contains(message, "machine translated with Stub") {
+		t.Errorf("flash = %q", message)
+	}
+
+	translated, err := q.GetTagBySlug(ctx, "go-programming-de")
+	if err != nil {
+		t.Fatalf("translated tag: %v", err)
+	}
+	if translated.Name != "[de] Go Programming" {
+		t.Errorf("translated name = %q, want the machine translation", translated.Name)
+	}
+	if mt := loadMachineTranslation(ctx, q, model.EntityTypeTag, translated.ID); mt == nil || mt.Provider != "Stub" {
+		t.Fatalf("machine translation flag = %+v, want Stub", mt)
+	}
+
+	// Saving with the reviewed checkbox ticked clears the flag.
+	form := url.Values{"name": {"Go-Programmierung"}, "slug": {translated.Slug}, "mt_reviewed": {"1"}}
+	req = httptest.NewRequest(http.MethodPut, "/admin/tags/2", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req = requestWithSession(sm, req)
+	req = addUserToContext(req, &admin)
+	req = requestWithURLParams(req, map[string]string{"id": strconv.FormatInt(translated.ID, 10)})
+	h.UpdateTag(httptest.NewRecorder(), req)
+	if mt := loadMachineTranslation(ctx, q, model.EntityTypeTag, translated.ID); mt != nil {
+		t.Errorf("machine translation flag = %+v after review, want none", mt)
+	}
+}
@@ -4,24 +4,30 @@
 package handler
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/a-h/templ"
 	"github.com/alexedwards/scs/v2"
 
+	"github.com/olegiv/ocms-go/internal/blocks"
 	"github.com/olegiv/ocms-go/internal/cache"
+	"github.com/olegiv/ocms-go/internal/contenttype"
 	"github.com/olegiv/ocms-go/internal/i18n"
 	"github.com/olegiv/ocms-go/internal/middleware"
 	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/module"
 	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/scheduler"
 	"github.com/olegiv/ocms-go/internal/store"
 	"github.com/olegiv/ocms-go/internal/theme"
 	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+	webhookpkg "github.com/olegiv/ocms-go/internal/webhook"
 )
 
 // buildPageContext creates a PageContext for templ views from the request state.
@@ -70,6 +76,17 @@ func buildPageContext(
 		})
 	}
 
+	// Populate site options for the header switcher
+	for _, site := range middleware.GetAdminSites(r) {
+		pc.SiteOptions = append(pc.SiteOptions, adminviews.SiteOption{
+			ID:   site.ID,
+			Name: site.Name,
+		})
+	}
+	if site := middleware.GetSite(r); site != nil {
+		pc.CurrentSiteID = site.ID
+	}
+
 	// Get sidebar modules
 	for _, m := range renderer.ListSidebarModules() {
 		pc.SidebarModules = append(pc.SidebarModules, adminviews.SidebarModule{
@@ -276,6 +293,40 @@ func convertEventItems(events []EventWithUser, renderer *render.Renderer, sentin
 	return items
 }
 
+// convertCommentItems converts moderation queue rows to view items. The ban
+// button is hidden for the current admin's IP address.
+func convertCommentItems(comments []store.ListCommentsByStatusRow, renderer *render.Renderer, sentinelActive bool, adminIP string) []adminviews.CommentItem {
+	items := make([]adminviews.CommentItem, len(comments))
+	isDemoMode := middleware.IsDemoMode()
+	for i, c := range comments {
+		rawIP := c.IpAddress
+		if isDemoMode {
+			rawIP = ""
+		}
+		items[i] = adminviews.CommentItem{
+			ID:           c.ID,
+			PageID:       c.PageID,
+			PageTitle:    c.PageTitle,
+			PageLanguage: c.PageLanguage,
+			AuthorName:   c.AuthorName,
+			AuthorEmail:  c.AuthorEmail,
+			Body:         c.Body,
+			Status:       c.Status,
+			IsReply:      c.ParentID.Valid,
+			IsUser:       c.UserID.Valid,
+			IPAddress:    maskIP(c.IpAddress),
+			RawIPAddress: rawIP,
+			IsOwnIP:      c.IpAddress == adminIP,
+			CreatedAt:    c.CreatedAt.Format("Jan 2, 2006 15:04"),
+		}
+		if sentinelActive && c.IpAddress != "" {
+			items[i].IsBanned = renderer.SentinelIsIPBanned(c.IpAddress)
+			items[i].IsWhitelisted = renderer.SentinelIsIPWhitelisted(c.IpAddress)
+		}
+	}
+	return items
+}
+
 // =============================================================================
 // TYPE CONVERSION HELPERS (store → view types)
 // =============================================================================
@@ -420,8 +471,13 @@ func convertRedirectListItems(redirects []store.Redirect) []adminviews.RedirectL
 			TargetURL:  r.TargetUrl,
 			StatusCode: r.StatusCode,
 			IsWildcard: r.IsWildcard,
+			IsRegex:    r.IsRegex,
 			TargetType: r.TargetType,
 			Enabled:    r.Enabled,
+			HitCount:   r.HitCount,
+		}
+		if r.LastHitAt.Valid {
+			items[i].LastHitAt = new(r.LastHitAt.Time)
 		}
 	}
 	return items
@@ -432,17 +488,24 @@ func convertRedirectInfo(rd *store.Redirect) *adminviews.RedirectInfo {
 	if rd == nil {
 		return nil
 	}
-	return &adminviews.RedirectInfo{
+	info := &adminviews.RedirectInfo{
 		ID:         rd.ID,
 		SourcePath: rd.SourcePath,
 		TargetURL:  rd.TargetUrl,
 		StatusCode: rd.StatusCode,
 		IsWildcard: rd.IsWildcard,
+		IsRegex:    rd.IsRegex,
+		DropQuery:  rd.DropQuery,
 		TargetType: rd.TargetType,
 		Enabled:    rd.Enabled,
+		HitCount:   rd.HitCount,
 		CreatedAt:  rd.CreatedAt,
 		UpdatedAt:  rd.UpdatedAt,
 	}
+	if rd.LastHitAt.Valid {
+		info.LastHitAt = new(rd.LastHitAt.Time)
+	}
+	return info
 }
 
 // convertStatusCodes converts handler StatusCodeOption slice to view RedirectStatusCodeOption slice.
@@ -1077,12 +1140,36 @@ func convertWidgetsViewData(data WidgetsListData) adminviews.WidgetsViewData {
 
 	var types []adminviews.WidgetTypeView
 	for _, wt := range data.WidgetTypes {
-		types = append(types, adminviews.WidgetTypeView{
+		tv := adminviews.WidgetTypeView{
 			ID:          wt.ID,
 			Name:        wt.Name,
 			Description: wt.Description,
+			Content:     wt.Content,
+		}
+		for _, st := range wt.Settings {
+			tv.Settings = append(tv.Settings, adminviews.WidgetSettingView{
+				Key:     st.Key,
+				Label:   st.Label,
+				Kind:    st.Kind,
+				Help:    st.Help,
+				Default: st.Default,
+			})
+		}
+		types = append(types, tv)
+	}
+
+	var categories []adminviews.WidgetCategoryOption
+	for _, c := range data.Categories {
+		categories = append(categories, adminviews.WidgetCategoryOption{
+			ID:           c.ID,
+			Name:         c.Name,
+			LanguageCode: c.LanguageCode,
 		})
 	}
+	var languages []adminviews.WidgetLanguageOption
+	for _, l := range data.Languages {
+		languages = append(languages, adminviews.WidgetLanguageOption{Code: l.Code, Name: l.Name})
+	}
 
 	themeName := ""
 	if data.Theme != nil {
@@ -1093,6 +1180,9 @@ func convertWidgetsViewData(data WidgetsListData) adminviews.WidgetsViewData {
 		ThemeName:   themeName,
 		WidgetAreas: areas,
 		WidgetTypes: types,
+		PageTypes:   data.PageTypes,
+		Categories:  categories,
+		Languages:   languages,
 	}
 }
 
@@ -1179,6 +1269,140 @@ func webhookDeliveriesBreadcrumbs(lang string, webhook store.Webhook) []render.B
 	}
 }
 
+// webhookDeadLettersBreadcrumbs returns breadcrumbs for the dead-letter queue page.
+func webhookDeadLettersBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "nav.webhooks"), URL: redirectAdminWebhooks},
+		{Label: i18n.T(lang, "webhooks.dead_letters_title"), URL: redirectAdminWebhooksDeadLetters, Active: true},
+	}
+}
+
+// webhookTestBreadcrumbs returns breadcrumbs for the test result page.
+func webhookTestBreadcrumbs(lang string, webhook store.Webhook) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "nav.webhooks"), URL: redirectAdminWebhooks},
+		{Label: webhook.Name, URL: fmt.Sprintf(redirectAdminWebhooksID, webhook.ID)},
+		{Label: i18n.T(lang, "webhooks.test_result_title"), Active: true},
+	}
+}
+
+// inboundWebhooksBreadcrumbs returns breadcrumbs for the inbound webhooks list page.
+func inboundWebhooksBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "nav.webhooks"), URL: redirectAdminWebhooks},
+		{Label: i18n.T(lang, "inbound_webhooks.title"), URL: redirectAdminInboundWebhooks, Active: true},
+	}
+}
+
+// inboundWebhookNewBreadcrumbs returns breadcrumbs for the new inbound webhook form.
+func inboundWebhookNewBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "nav.webhooks"), URL: redirectAdminWebhooks},
+		{Label: i18n.T(lang, "inbound_webhooks.title"), URL: redirectAdminInboundWebhooks},
+		{Label: i18n.T(lang, "inbound_webhooks.new"), URL: redirectAdminInboundWebhooksNew, Active: true},
+	}
+}
+
+// inboundWebhookEditBreadcrumbs returns breadcrumbs for the edit inbound webhook form.
+func inboundWebhookEditBreadcrumbs(lang string, hook store.InboundWebhook) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "nav.webhooks"), URL: redirectAdminWebhooks},
+		{Label: i18n.T(lang, "inbound_webhooks.title"), URL: redirectAdminInboundWebhooks},
+		{Label: hook.Name, URL: fmt.Sprintf(redirectAdminInboundWebhooksID, hook.ID), Active: true},
+	}
+}
+
+// contentTypesBreadcrumbs returns breadcrumbs for the content types list page.
+func contentTypesBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "content_types.title"), URL: redirectAdminContentTypes, Active: true},
+	}
+}
+
+// contentTypeNewBreadcrumbs returns breadcrumbs for the new content type form.
+func contentTypeNewBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "content_types.title"), URL: redirectAdminContentTypes},
+		{Label: i18n.T(lang, "content_types.new"), URL: redirectAdminContentTypesNew, Active: true},
+	}
+}
+
+// contentTypeEditBreadcrumbs returns breadcrumbs for the edit content type form.
+func contentTypeEditBreadcrumbs(lang string, ct store.ContentType) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "content_types.title"), URL: redirectAdminContentTypes},
+		{Label: ct.Name, URL: fmt.Sprintf(redirectAdminContentTypesID, ct.ID), Active: true},
+	}
+}
+
+// snippetsBreadcrumbs returns breadcrumbs for the snippets list page.
+func snippetsBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "snippets.title"), URL: redirectAdminSnippets, Active: true},
+	}
+}
+
+// snippetNewBreadcrumbs returns breadcrumbs for the new snippet form.
+func snippetNewBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "snippets.title"), URL: redirectAdminSnippets},
+		{Label: i18n.T(lang, "snippets.new"), URL: redirectAdminSnippetsNew, Active: true},
+	}
+}
+
+// snippetEditBreadcrumbs returns breadcrumbs for the edit snippet form.
+func snippetEditBreadcrumbs(lang string, s store.Snippet) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "snippets.title"), URL: redirectAdminSnippets},
+		{Label: s.Name, URL: fmt.Sprintf(redirectAdminSnippetsID, s.ID), Active: true},
+	}
+}
+
+// seriesBreadcrumbs returns breadcrumbs for the page series list page.
+func seriesBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "series.title"), URL: redirectAdminSeries, Active: true},
+	}
+}
+
+// commentsBreadcrumbs returns breadcrumbs for the comment moderation queue.
+func commentsBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "comments.title"), URL: redirectAdminComments, Active: true},
+	}
+}
+
+// seriesNewBreadcrumbs returns breadcrumbs for the new series form.
+func seriesNewBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "series.title"), URL: redirectAdminSeries},
+		{Label: i18n.T(lang, "series.new"), URL: redirectAdminSeriesNew, Active: true},
+	}
+}
+
+// seriesEditBreadcrumbs returns breadcrumbs for the edit series form.
+func seriesEditBreadcrumbs(lang string, s store.PageSeries) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "series.title"), URL: redirectAdminSeries},
+		{Label: s.Name, URL: fmt.Sprintf(redirectAdminSeriesID, s.ID), Active: true},
+	}
+}
+
 // convertWebhooksListViewData converts handler WebhooksListData to view WebhooksListViewData.
 func convertWebhooksListViewData(data WebhooksListData) adminviews.WebhooksListViewData {
 	var items []adminviews.WebhookListItemView
@@ -1194,6 +1418,11 @@ func convertWebhooksListViewData(data WebhooksListData) adminviews.WebhooksListV
 			TotalDead:      wh.TotalDead,
 			SuccessRate:    wh.SuccessRate,
 			HealthStatus:   wh.HealthStatus,
+			IsPaused:       wh.PausedAt.Valid,
+			PauseReason:    wh.PauseReason,
+		}
+		if wh.PausedAt.Valid {
+			item.PausedAt = wh.PausedAt.Time.Format("Jan 2, 15:04")
 		}
 		if wh.LastSuccessfulAt != nil {
 			item.HasLastSuccessfulAt = true
@@ -1203,8 +1432,9 @@ func convertWebhooksListViewData(data WebhooksListData) adminviews.WebhooksListV
 	}
 
 	return adminviews.WebhooksListViewData{
-		Webhooks:      items,
-		TotalWebhooks: data.TotalWebhooks,
+		Webhooks:        items,
+		TotalWebhooks:   data.TotalWebhooks,
+		TotalDeadLetter: data.TotalDeadLetter,
 	}
 }
 
@@ -1225,6 +1455,7 @@ func convertWebhookFormViewData(data WebhookFormData) adminviews.WebhookFormView
 		FormValues:  data.FormValues,
 		FormEvents:  data.FormEvents,
 		FormHeaders: data.FormHeaders,
+		Formats:     model.WebhookFormats(),
 	}
 
 	if data.Webhook != nil {
@@ -1236,6 +1467,37 @@ func convertWebhookFormViewData(data WebhookFormData) adminviews.WebhookFormView
 	return viewData
 }
 
+// convertWebhookTestResultViewData converts a test delivery result to view data.
+func convertWebhookTestResultViewData(webhook store.Webhook, result *webhookpkg.TestResult) adminviews.WebhookTestResultViewData {
+	format := webhook.PayloadFormat
+	if format == "" {
+		format = model.WebhookFormatJSON
+	}
+	return adminviews.WebhookTestResultViewData{
+		WebhookID:     webhook.ID,
+		WebhookName:   webhook.Name,
+		URL:           webhook.Url,
+		PayloadFormat: format,
+		DeliveryID:    result.DeliveryID,
+		Payload:       prettyJSON(result.Payload),
+		StatusCode:    result.StatusCode,
+		ResponseBody:  result.ResponseBody,
+		Error:         result.Error,
+		Success:       result.Success,
+		Duration:      result.Duration.Round(time.Millisecond).String(),
+	}
+}
+
+// prettyJSON indents a JSON document for display, returning s unchanged if it
+// is not valid JSON.
+func prettyJSON(s string) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(s), "", "  "); err != nil {
+		return s
+	}
+	return buf.String()
+}
+
 // convertWebhookDeliveriesViewData converts handler WebhookDeliveriesData to view.
 func convertWebhookDeliveriesViewData(data WebhookDeliveriesData) adminviews.WebhookDeliveriesViewData {
 	var deliveries []adminviews.WebhookDeliveryView
@@ -1274,14 +1536,250 @@ func convertWebhookDeliveriesViewData(data WebhookDeliveriesData) adminviews.Web
 	}
 
 	return adminviews.WebhookDeliveriesViewData{
-		WebhookID:   data.Webhook.ID,
-		WebhookName: data.Webhook.Name,
-		Deliveries:  deliveries,
-		TotalCount:  data.TotalCount,
-		Pagination:  convertPagination(data.Pagination),
+		WebhookID:    data.Webhook.ID,
+		WebhookName:  data.Webhook.Name,
+		Deliveries:   deliveries,
+		TotalCount:   data.TotalCount,
+		Pagination:   convertPagination(data.Pagination),
+		FilterStatus: data.FilterStatus,
+		FilterEvent:  data.FilterEvent,
+		EventOptions: data.EventOptions,
+		IsPaused:     data.Webhook.PausedAt.Valid,
+		PauseReason:  data.Webhook.PauseReason,
+	}
+}
+
+// convertWebhookDeadLettersViewData converts handler WebhookDeadLettersData to view.
+func convertWebhookDeadLettersViewData(data WebhookDeadLettersData) adminviews.WebhookDeadLettersViewData {
+	deliveries := make([]adminviews.WebhookDeadLetterView, 0, len(data.Deliveries))
+	for _, d := range data.Deliveries {
+		dv := adminviews.WebhookDeadLetterView{
+			ID:          d.ID,
+			WebhookID:   d.WebhookID,
+			WebhookName: d.WebhookName,
+			Event:       d.Event,
+			Attempts:    d.Attempts,
+			Payload:     prettyJSON(d.Payload),
+			CreatedAt:   d.CreatedAt.Format("Jan 2, 15:04:05"),
+			UpdatedAt:   d.UpdatedAt.Format("Jan 2, 15:04:05"),
+		}
+		if d.ResponseCode.Valid {
+			dv.HasResponseCode = true
+			dv.ResponseCode = d.ResponseCode.Int64
+		}
+		if d.ErrorMessage.Valid {
+			dv.ErrorMessage = d.ErrorMessage.String
+		}
+		deliveries = append(deliveries, dv)
+	}
+
+	webhooks := make([]adminviews.WebhookOptionView, 0, len(data.Webhooks))
+	for _, wh := range data.Webhooks {
+		webhooks = append(webhooks, adminviews.WebhookOptionView{
+			ID:       wh.ID,
+			Name:     wh.Name,
+			IsPaused: wh.PausedAt.Valid,
+		})
+	}
+
+	return adminviews.WebhookDeadLettersViewData{
+		Deliveries:    deliveries,
+		TotalCount:    data.TotalCount,
+		Pagination:    convertPagination(data.Pagination),
+		Webhooks:      webhooks,
+		EventOptions:  data.EventOptions,
+		FilterWebhook: data.FilterWebhook,
+		FilterEvent:   data.FilterEvent,
 	}
 }
 
+// convertInboundWebhooksListViewData converts inbound webhooks to list view data.
+func convertInboundWebhooksListViewData(hooks []store.InboundWebhook, baseURL string) adminviews.InboundWebhooksListViewData {
+	items := make([]adminviews.InboundWebhookListItemView, 0, len(hooks))
+	for _, hook := range hooks {
+		item := adminviews.InboundWebhookListItemView{
+			ID:           hook.ID,
+			Name:         hook.Name,
+			Action:       hook.Action,
+			Job:          inboundJobKey(hook.JobSource, hook.JobName),
+			EndpointURL:  baseURL + hook.Token,
+			IsActive:     hook.IsActive,
+			TriggerCount: hook.TriggerCount,
+			LastStatus:   hook.LastStatus,
+			LastError:    hook.LastError,
+		}
+		if hook.LastTriggeredAt.Valid {
+			item.LastTriggeredAt = hook.LastTriggeredAt.Time.Format("Jan 2, 15:04")
+		}
+		items = append(items, item)
+	}
+	return adminviews.InboundWebhooksListViewData{Webhooks: items}
+}
+
+// convertInboundWebhookFormViewData converts InboundWebhookFormData to view data.
+func convertInboundWebhookFormViewData(data InboundWebhookFormData, baseURL string) adminviews.InboundWebhookFormViewData {
+	jobs := make([]adminviews.InboundJobOptionView, 0, len(data.Jobs))
+	for _, job := range data.Jobs {
+		jobs = append(jobs, adminviews.InboundJobOptionView{
+			Value:       inboundJobKey(job.Source, job.Name),
+			Label:       job.Source + " / " + job.Name,
+			Description: job.Description,
+		})
+	}
+
+	viewData := adminviews.InboundWebhookFormViewData{
+		IsEdit:     data.IsEdit,
+		Errors:     data.Errors,
+		FormValues: data.FormValues,
+		Actions:    model.InboundWebhookActions(),
+		Jobs:       jobs,
+	}
+
+	if hook := data.InboundWebhook; hook != nil {
+		viewData.ID = hook.ID
+		viewData.EndpointURL = baseURL + hook.Token
+		viewData.TriggerCount = hook.TriggerCount
+		viewData.LastStatus = hook.LastStatus
+		viewData.LastError = hook.LastError
+		viewData.CreatedAt = hook.CreatedAt.Format("Jan 2, 2006 3:04 PM")
+		viewData.UpdatedAt = hook.UpdatedAt.Format("Jan 2, 2006 3:04 PM")
+		if hook.LastTriggeredAt.Valid {
+			viewData.LastTriggeredAt = hook.LastTriggeredAt.Time.Format("Jan 2, 2006 3:04 PM")
+		}
+	}
+
+	return viewData
+}
+
+// convertContentTypesListViewData converts content types to list view data.
+// counts maps content type IDs to the number of pages using them.
+func convertContentTypesListViewData(types []store.ContentType, counts map[int64]int64) adminviews.ContentTypesListViewData {
+	items := make([]adminviews.ContentTypeListItemView, 0, len(types))
+	for _, ct := range types {
+		items = append(items, adminviews.ContentTypeListItemView{
+			ID:          ct.ID,
+			Name:        ct.Name,
+			Slug:        ct.Slug,
+			Description: ct.Description,
+			Template:    ct.Template,
+			FieldCount:  len(contenttype.ParseFields(ct.Fields)),
+			PageCount:   counts[ct.ID],
+		})
+	}
+	return adminviews.ContentTypesListViewData{ContentTypes: items}
+}
+
+// convertContentTypeFormViewData converts ContentTypeFormData to view data.
+func convertContentTypeFormViewData(data ContentTypeFormData) adminviews.ContentTypeFormViewData {
+	viewData := adminviews.ContentTypeFormViewData{
+		IsEdit:     data.IsEdit,
+		Errors:     data.Errors,
+		FormValues: data.FormValues,
+		FieldTypes: contenttype.FieldTypes(),
+		PageCount:  data.PageCount,
+	}
+	if ct := data.ContentType; ct != nil {
+		viewData.ID = ct.ID
+		viewData.Slug = ct.Slug
+		viewData.CreatedAt = ct.CreatedAt.Format("Jan 2, 2006 3:04 PM")
+		viewData.UpdatedAt = ct.UpdatedAt.Format("Jan 2, 2006 3:04 PM")
+	}
+	return viewData
+}
+
+// convertSnippetsListViewData groups snippets by slug, listing the active
+// languages each snippet is still missing.
+func convertSnippetsListViewData(snippets []store.Snippet, languages []store.Language) adminviews.SnippetsListViewData {
+	var items []adminviews.SnippetListItemView
+	index := make(map[string]int)
+	for _, s := range snippets {
+		i, ok := index[s.Slug]
+		if !ok {
+			i = len(items)
+			index[s.Slug] = i
+			items = append(items, adminviews.SnippetListItemView{Slug: s.Slug, Name: s.Name})
+		}
+		items[i].Translations = append(items[i].Translations, adminviews.SnippetTranslationView{
+			ID:           s.ID,
+			LanguageCode: s.LanguageCode,
+			Name:         s.Name,
+			UpdatedAt:    s.UpdatedAt.Format("Jan 2, 2006 3:04 PM"),
+		})
+	}
+	for i := range items {
+		for _, l := range languages {
+			if !slices.ContainsFunc(items[i].Translations, func(t adminviews.SnippetTranslationView) bool { return t.LanguageCode == l.Code }) {
+				items[i].Missing = append(items[i].Missing, l.Code)
+			}
+		}
+	}
+	return adminviews.SnippetsListViewData{Snippets: items}
+}
+
+// convertSnippetFormViewData converts SnippetFormData to view data.
+func convertSnippetFormViewData(data SnippetFormData) adminviews.SnippetFormViewData {
+	viewData := adminviews.SnippetFormViewData{
+		IsEdit:     data.IsEdit,
+		Errors:     data.Errors,
+		FormValues: data.FormValues,
+		Languages:  convertLanguageOptions(data.Languages),
+	}
+	for _, t := range data.Translations {
+		viewData.Translations = append(viewData.Translations, adminviews.SnippetTranslationView{
+			ID:           t.ID,
+			LanguageCode: t.LanguageCode,
+			Name:         t.Name,
+			UpdatedAt:    t.UpdatedAt.Format("Jan 2, 2006 3:04 PM"),
+		})
+	}
+	if s := data.Snippet; s != nil {
+		viewData.ID = s.ID
+		viewData.CreatedAt = s.CreatedAt.Format("Jan 2, 2006 3:04 PM")
+		viewData.UpdatedAt = s.UpdatedAt.Format("Jan 2, 2006 3:04 PM")
+	}
+	return viewData
+}
+
+// convertSeriesListViewData converts page series to view data.
+func convertSeriesListViewData(series []store.ListPageSeriesRow) adminviews.SeriesListViewData {
+	var viewData adminviews.SeriesListViewData
+	for _, s := range series {
+		viewData.Series = append(viewData.Series, adminviews.SeriesListItemView{
+			ID:           s.ID,
+			Name:         s.Name,
+			Slug:         s.Slug,
+			LanguageCode: s.LanguageCode,
+			PageCount:    s.PageCount,
+			UpdatedAt:    s.UpdatedAt.Format("Jan 2, 2006 3:04 PM"),
+		})
+	}
+	return viewData
+}
+
+// convertSeriesFormViewData converts SeriesFormData to view data.
+func convertSeriesFormViewData(data SeriesFormData) adminviews.SeriesFormViewData {
+	viewData := adminviews.SeriesFormViewData{
+		IsEdit:     data.IsEdit,
+		Errors:     data.Errors,
+		FormValues: data.FormValues,
+		Languages:  convertLanguageOptions(data.Languages),
+	}
+	for _, p := range data.Pages {
+		viewData.Pages = append(viewData.Pages, adminviews.SeriesPageView{
+			ID:     p.ID,
+			Title:  p.Title,
+			Slug:   p.Slug,
+			Status: p.Status,
+		})
+	}
+	if s := data.Series; s != nil {
+		viewData.ID = s.ID
+		viewData.CreatedAt = s.CreatedAt.Format("Jan 2, 2006 3:04 PM")
+		viewData.UpdatedAt = s.UpdatedAt.Format("Jan 2, 2006 3:04 PM")
+	}
+	return viewData
+}
+
 // =============================================================================
 // PAGES HELPERS
 // =============================================================================
@@ -1303,6 +1801,15 @@ func pagesNewBreadcrumbs(lang string) []render.Breadcrumb {
 	}
 }
 
+// pagesTreeBreadcrumbs returns breadcrumbs for the page tree.
+func pagesTreeBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "pages.title"), URL: redirectAdminPages},
+		{Label: i18n.T(lang, "pages.tree"), URL: redirectAdminPagesTree, Active: true},
+	}
+}
+
 // pagesEditBreadcrumbs returns breadcrumbs for the edit page form.
 func pagesEditBreadcrumbs(lang string, pageTitle string, pageID int64) []render.Breadcrumb {
 	return []render.Breadcrumb{
@@ -1335,6 +1842,10 @@ func convertPagesListViewData(data PagesListData, renderer *render.Renderer, lan
 			PageType:  p.PageType,
 			UpdatedAt: renderer.FormatDateTimeLocale(p.UpdatedAt, lang),
 		}
+		item.PageTypeLabel = p.PageType
+		if label, ok := data.PageTypeLabels[p.PageType]; ok {
+			item.PageTypeLabel = label
+		}
 
 		// Scheduled check
 		if p.Status == "draft" && p.ScheduledAt.Valid {
@@ -1368,6 +1879,9 @@ func convertPagesListViewData(data PagesListData, renderer *render.Renderer, lan
 			item.Language = &adminviews.PageLanguageView{Code: l.Code, Name: l.Name}
 		}
 
+		item.NeedsUpdate = data.PagesOutdated[p.ID]
+		item.MachineTranslated = data.PagesMachineTranslated[p.ID]
+
 		// Demo mode check
 		item.IsDemoPublished = middleware.IsDemoMode() && p.Status == "published"
 
@@ -1379,19 +1893,21 @@ func convertPagesListViewData(data PagesListData, renderer *render.Renderer, lan
 	pagination.PerPageSelector = perPageSelector(data.Pagination.PerPage, perPageOptionsStandard)
 
 	return adminviews.PagesListViewData{
-		Pages:          pages,
-		TotalCount:     data.TotalCount,
-		StatusFilter:   data.StatusFilter,
-		PageTypeFilter: data.PageTypeFilter,
-		CategoryFilter: data.CategoryFilter,
-		LanguageFilter: data.LanguageFilter,
-		SearchFilter:   data.SearchFilter,
-		AllCategories:  convertPageCategoryNodes(data.AllCategories),
-		AllLanguages:   convertLanguageOptions(data.AllLanguages),
-		Statuses:       data.Statuses,
-		PageTypes:      data.PageTypes,
-		Pagination:     pagination,
-		IsDemoMode:     middleware.IsDemoMode(),
+		Pages:             pages,
+		TotalCount:        data.TotalCount,
+		StatusFilter:      data.StatusFilter,
+		PageTypeFilter:    data.PageTypeFilter,
+		CategoryFilter:    data.CategoryFilter,
+		LanguageFilter:    data.LanguageFilter,
+		SearchFilter:      data.SearchFilter,
+		TranslationFilter: data.TranslationFilter,
+		AllCategories:     convertPageCategoryNodes(data.AllCategories),
+		AllLanguages:      convertLanguageOptions(data.AllLanguages),
+		Statuses:          data.Statuses,
+		PageTypes:         data.PageTypes,
+		PageTypeLabels:    data.PageTypeLabels,
+		Pagination:        pagination,
+		IsDemoMode:        middleware.IsDemoMode(),
 	}
 }
 
@@ -1413,6 +1929,19 @@ func convertPageCategoryNodes(nodes []PageCategoryNode) []adminviews.PageCategor
 	return result
 }
 
+// convertMachineTranslationView converts a machine translation review flag
+// to the edit form notice. reviewed is the submitted checkbox value.
+func convertMachineTranslationView(mt *store.MachineTranslation, reviewed string, renderer *render.Renderer, lang string) *adminviews.MachineTranslationView {
+	if mt == nil {
+		return nil
+	}
+	return &adminviews.MachineTranslationView{
+		Provider:  mt.Provider,
+		CreatedAt: renderer.FormatDateTimeLocale(mt.CreatedAt, lang),
+		Reviewed:  reviewed != "",
+	}
+}
+
 // convertPageFormViewData converts handler PageFormData to view PageFormViewData.
 func convertPageFormViewData(data PageFormData, renderer *render.Renderer, lang string) adminviews.PageFormViewData {
 	viewData := adminviews.PageFormViewData{
@@ -1427,7 +1956,30 @@ func convertPageFormViewData(data PageFormData, renderer *render.Renderer, lang
 		AllLanguages:         convertLanguageOptions(data.AllLanguages),
 		Language:             convertLanguageOptionPtr(data.Language),
 		IsDemoMode:           middleware.IsDemoMode(),
+		PageTypeLabels:       data.PageTypeLabels,
+		AccessRoles:          pageAccessRoleOptions,
+	}
+	for _, opt := range data.ParentOptions {
+		viewData.ParentOptions = append(viewData.ParentOptions, adminviews.PageParentOptionView{
+			ID:    opt.ID,
+			Title: opt.Title,
+			Depth: opt.Depth,
+		})
+	}
+	for _, series := range data.SeriesOptions {
+		viewData.SeriesOptions = append(viewData.SeriesOptions, adminviews.PageSeriesOptionView{
+			ID:   series.ID,
+			Name: series.Name,
+		})
+	}
+	for _, opt := range data.RelatedOptions {
+		viewData.RelatedOptions = append(viewData.RelatedOptions, adminviews.PageParentOptionView{
+			ID:    opt.ID,
+			Title: opt.Title,
+			Depth: opt.Depth,
+		})
 	}
+	viewData.RelatedPageIDs = data.RelatedPageIDs
 
 	if data.Page != nil {
 		viewData.PageID = data.Page.ID
@@ -1514,6 +2066,19 @@ func convertPageFormViewData(data PageFormData, renderer *render.Renderer, lang
 		})
 	}
 
+	// Earlier public paths
+	for _, entry := range data.URLHistory {
+		item := adminviews.PageURLHistoryView{
+			Path:      entry.Path,
+			ChangedAt: entry.ChangedAt.Format("Jan 2, 2006 15:04"),
+		}
+		if entry.Redirect != nil && entry.Redirect.Enabled {
+			item.RedirectID = entry.Redirect.ID
+			item.RedirectTarget = entry.Redirect.TargetUrl
+		}
+		viewData.URLHistory = append(viewData.URLHistory, item)
+	}
+
 	// Translations
 	for _, tr := range data.Translations {
 		viewData.Translations = append(viewData.Translations, adminviews.PageTranslationView{
@@ -1524,6 +2089,18 @@ func convertPageFormViewData(data PageFormData, renderer *render.Renderer, lang
 		})
 	}
 
+	viewData.MachineTranslation = convertMachineTranslationView(data.MachineTranslation, data.FormValues["mt_reviewed"], renderer, lang)
+
+	// Sources changed since the page was translated
+	for _, src := range data.OutdatedSources {
+		viewData.OutdatedSources = append(viewData.OutdatedSources, adminviews.PageTranslationView{
+			Language: convertLanguageOption(src.Language),
+			PageID:   src.Page.ID,
+			Title:    src.Page.Title,
+			Status:   src.Page.Status,
+		})
+	}
+
 	// Missing languages
 	for _, l := range data.MissingLanguages {
 		viewData.MissingLanguages = append(viewData.MissingLanguages, convertLanguageOption(l))
@@ -1537,9 +2114,158 @@ func convertPageFormViewData(data PageFormData, renderer *render.Renderer, lang
 		viewData.VideoTitle = v
 	}
 
+	// Content type fields; only the current page type is prefilled
+	currentType := viewData.PageType
+	if v := data.FormValues["page_type"]; v != "" {
+		currentType = v
+	}
+	for _, ct := range data.ContentTypes {
+		var input map[string]any
+		if ct.Slug == currentType {
+			input = data.ContentInput
+		}
+		viewData.ContentTypes = append(viewData.ContentTypes, adminviews.PageContentTypeView{
+			Slug:   ct.Slug,
+			Name:   ct.Name,
+			Fields: convertPageContentFieldViews(contenttype.ParseFields(ct.Fields), input, data.Errors),
+		})
+	}
+	for _, p := range data.PageRefOptions {
+		viewData.PageRefOptions = append(viewData.PageRefOptions, adminviews.PageRefOptionView{ID: p.ID, Title: p.Title})
+	}
+	convertPageCustomFieldViews(&viewData, data.CustomFieldSchema, data.CustomFieldInput, data.Errors)
+	convertPageBlockEditorView(&viewData, data, lang)
+
 	return viewData
 }
 
+// blockEditorLabels are the i18n keys (without the "blocks." prefix) of the
+// labels used by the block editor script, besides block type names.
+var blockEditorLabels = []string{
+	"add_to_column", "move_up", "move_down", "remove", "column", "empty", "convert_failed",
+	"field_text", "field_level", "field_url", "field_alt", "field_caption", "field_images",
+	"field_citation", "field_language", "field_code", "field_label", "field_form",
+	"field_columns", "field_html",
+}
+
+// convertPageBlockEditorView fills the block editor data of the page form.
+func convertPageBlockEditorView(viewData *adminviews.PageFormViewData, data PageFormData, lang string) {
+	viewData.EditorMode = data.EditorMode
+	if viewData.EditorMode == "" {
+		viewData.EditorMode = EditorModeHTML
+	}
+	viewData.BlocksJSON = data.BlocksJSON
+	viewData.BlocksError = data.Errors["blocks"]
+	viewData.BlockTypes = blocks.Types()
+
+	labels := make(map[string]string, len(blockEditorLabels)+len(viewData.BlockTypes))
+	for _, key := range blockEditorLabels {
+		labels[key] = i18n.T(lang, "blocks."+key)
+	}
+	for _, t := range viewData.BlockTypes {
+		labels["type_"+t] = i18n.T(lang, "blocks.type_"+t)
+	}
+	if b, err := json.Marshal(labels); err == nil {
+		viewData.BlockLabels = string(b)
+	}
+}
+
+// convertPageCustomFieldViews fills the custom field inputs of the page form:
+// theme schema fields followed by one row per ad-hoc field.
+func convertPageCustomFieldViews(viewData *adminviews.PageFormViewData, schema []contenttype.Field, input []contenttype.CustomField, errs map[string]string) {
+	inSchema := make(map[string]bool, len(schema))
+	for _, f := range schema {
+		inSchema[f.Name] = true
+		var value any
+		if cf, ok := contenttype.CustomFields(input).Get(f.Name); ok {
+			value = cf.Value
+		}
+		view := newPageContentFieldView(f, contenttype.CustomInputName(f.Name), value)
+		view.Error = errs[customFieldErrorPrefix+f.Name]
+		viewData.CustomFields = append(viewData.CustomFields, view)
+	}
+
+	for _, cf := range input {
+		if inSchema[cf.Name] {
+			continue
+		}
+		row := len(viewData.CustomFieldRows)
+		viewData.CustomFieldRows = append(viewData.CustomFieldRows, adminviews.PageCustomFieldRowView{
+			NameInput:  contenttype.CustomExtraInputName(row, "name"),
+			TypeInput:  contenttype.CustomExtraInputName(row, "type"),
+			ValueInput: contenttype.CustomExtraInputName(row, "value"),
+			Name:       cf.Name,
+			Type:       cf.Type,
+			Value:      contenttype.FormValue(cf.Value),
+			Error:      errs[customFieldErrorPrefix+cf.Name],
+		})
+	}
+	viewData.CustomFieldRowTemplate = adminviews.PageCustomFieldRowView{
+		NameInput:  fmt.Sprintf("%s[__ROW__][name]", contenttype.CustomExtraFormPrefix),
+		TypeInput:  fmt.Sprintf("%s[__ROW__][type]", contenttype.CustomExtraFormPrefix),
+		ValueInput: fmt.Sprintf("%s[__ROW__][value]", contenttype.CustomExtraFormPrefix),
+		Type:       contenttype.FieldText,
+	}
+	viewData.CustomFieldTypes = contenttype.AdHocFieldTypes()
+	viewData.CustomFieldsError = errs[customFieldErrorPrefix+contenttype.CustomFormPrefix]
+}
+
+// convertPageContentFieldViews converts a content type schema and its values
+// to page form inputs. errs are the page form errors.
+func convertPageContentFieldViews(fields []contenttype.Field, input map[string]any, errs map[string]string) []adminviews.PageContentFieldView {
+	views := make([]adminviews.PageContentFieldView, 0, len(fields))
+	for _, f := range fields {
+		view := newPageContentFieldView(f, contenttype.InputName(f.Name), input[f.Name])
+		view.Error = errs[contentFieldErrorPrefix+f.Name]
+		if f.Type == contenttype.FieldRepeater {
+			for i, row := range contenttype.Rows(input[f.Name]) {
+				view.Rows = append(view.Rows, convertRepeaterRowViews(f, func(sub string) string {
+					return contenttype.RowInputName(f.Name, i, sub)
+				}, row))
+			}
+			view.RowTemplate = convertRepeaterRowViews(f, func(sub string) string {
+				return fmt.Sprintf("%s[%s][__ROW__][%s]", contenttype.FormPrefix, f.Name, sub)
+			}, nil)
+		}
+		views = append(views, view)
+	}
+	return views
+}
+
+// convertRepeaterRowViews converts one repeater row to page form inputs.
+func convertRepeaterRowViews(f contenttype.Field, inputName func(sub string) string, row map[string]any) []adminviews.PageContentFieldView {
+	views := make([]adminviews.PageContentFieldView, 0, len(f.Fields))
+	for _, sub := range f.Fields {
+		views = append(views, newPageContentFieldView(sub, inputName(sub.Name), row[sub.Name]))
+	}
+	return views
+}
+
+// newPageContentFieldView builds the form input of a scalar content field.
+func newPageContentFieldView(f contenttype.Field, inputName string, value any) adminviews.PageContentFieldView {
+	view := adminviews.PageContentFieldView{
+		Name:      f.Name,
+		Label:     f.Label,
+		Type:      f.Type,
+		Help:      f.Help,
+		InputName: inputName,
+		Required:  f.Required,
+		Options:   f.Options,
+		MaxLength: f.MaxLength,
+		MaxItems:  f.MaxItems,
+	}
+	if f.Type != contenttype.FieldRepeater {
+		view.Value = contenttype.FormValue(value)
+	}
+	if f.Min != nil {
+		view.Min = contenttype.FormValue(*f.Min)
+	}
+	if f.Max != nil {
+		view.Max = contenttype.FormValue(*f.Max)
+	}
+	return view
+}
+
 // convertPageVersionsViewData converts handler PageVersionsData to view.
 func convertPageVersionsViewData(data PageVersionsData, renderer *render.Renderer, lang string) adminviews.PageVersionsViewData {
 	var versions []adminviews.PageVersionView
@@ -1615,6 +2341,7 @@ func convertSchedulerListViewData(data SchedulerListData) adminviews.SchedulerLi
 		tasks = append(tasks, adminviews.SchedulerTaskViewItem{
 			ID:       t.ID,
 			Name:     t.Name,
+			TaskType: schedulerTaskType(t.TaskType),
 			URL:      t.URL,
 			Schedule: t.Schedule,
 			IsActive: t.IsActive,
@@ -1629,18 +2356,62 @@ func convertSchedulerListViewData(data SchedulerListData) adminviews.SchedulerLi
 	}
 }
 
+// schedulerTaskType returns a task's type, treating tasks created before
+// task types existed as URL tasks.
+func schedulerTaskType(taskType string) string {
+	if taskType == "" {
+		return scheduler.TaskTypeURL
+	}
+	return taskType
+}
+
 // convertSchedulerTaskFormViewData converts store.ScheduledTask to view SchedulerTaskFormViewData.
-func convertSchedulerTaskFormViewData(task store.ScheduledTask, isEdit bool) adminviews.SchedulerTaskFormViewData {
+func convertSchedulerTaskFormViewData(task store.ScheduledTask, isEdit bool, pages []store.Page) adminviews.SchedulerTaskFormViewData {
 	timeout := task.TimeoutSeconds
 	if timeout == 0 {
 		timeout = 30
 	}
+	retryPolicy := task.RetryPolicy
+	if retryPolicy == "" {
+		retryPolicy = scheduler.RetryPolicyNone
+	}
+	retryDelay := task.RetryDelaySeconds
+	if retryDelay == 0 {
+		retryDelay = taskRetryDelayDefault
+	}
+
+	params := scheduler.ParseTaskParams(task.Params)
+	if params.RetentionDays == 0 {
+		params.RetentionDays = taskRetentionDaysDefault
+	}
+
+	pageOptions := make([]adminviews.SchedulerPageOptionView, 0, len(pages))
+	for _, p := range pages {
+		pageOptions = append(pageOptions, adminviews.SchedulerPageOptionView{
+			ID:     p.ID,
+			Title:  p.Title,
+			Status: p.Status,
+		})
+	}
+
 	return adminviews.SchedulerTaskFormViewData{
 		TaskID:         task.ID,
 		Name:           task.Name,
+		TaskType:       schedulerTaskType(task.TaskType),
+		TaskTypes:      scheduler.TaskTypes(),
 		URL:            task.Url,
 		Schedule:       task.Schedule,
 		TimeoutSeconds: timeout,
+		PageID:         params.PageID,
+		Pages:          pageOptions,
+		RetentionDays:  params.RetentionDays,
+		IncludeMedia:   params.IncludeMedia,
+		KeepFiles:      params.KeepFiles,
+		RetryPolicy:    retryPolicy,
+		RetryPolicies:  scheduler.RetryPolicies(),
+		MaxRetries:     task.MaxRetries,
+		MaxRetriesMax:  scheduler.MaxTaskRetries,
+		RetryDelay:     retryDelay,
 		IsEdit:         isEdit,
 		IsDemoMode:     middleware.IsDemoMode(),
 	}
@@ -1652,7 +2423,12 @@ func convertSchedulerTaskRunsViewData(task store.ScheduledTask, runs []store.Sch
 	for _, r := range runs {
 		vr := adminviews.SchedulerTaskRunView{
 			Status:    r.Status,
+			Attempt:   max(r.Attempt, 1),
 			StartedAt: r.StartedAt.Format("2006-01-02 15:04:05"),
+			Log:       r.Log,
+		}
+		if r.ResponseBody.Valid {
+			vr.Result = r.ResponseBody.String
 		}
 		if r.StatusCode.Valid {
 			vr.HasStatusCode = true
@@ -1672,6 +2448,7 @@ func convertSchedulerTaskRunsViewData(task store.ScheduledTask, runs []store.Sch
 	return adminviews.SchedulerTaskRunsViewData{
 		TaskID:       task.ID,
 		TaskName:     task.Name,
+		TaskType:     schedulerTaskType(task.TaskType),
 		TaskURL:      task.Url,
 		TaskSchedule: task.Schedule,
 		TaskTimeout:  task.TimeoutSeconds,
@@ -16,10 +16,12 @@ import (
 	"github.com/olegiv/ocms-go/internal/cache"
 	"github.com/olegiv/ocms-go/internal/i18n"
 	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/render"
 	"github.com/olegiv/ocms-go/internal/store"
 	"github.com/olegiv/ocms-go/internal/theme"
 	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+	"github.com/olegiv/ocms-go/internal/webhook"
 )
 
 // ThemesHandler handles theme management routes.
@@ -29,6 +31,12 @@ type ThemesHandler struct {
 	sessionManager *scs.SessionManager
 	themeManager   *theme.Manager
 	cacheManager   *cache.Manager
+	dispatcher     *webhook.Dispatcher
+}
+
+// SetDispatcher sets the webhook dispatcher for event dispatching.
+func (h *ThemesHandler) SetDispatcher(d *webhook.Dispatcher) {
+	h.dispatcher = d
 }
 
 // NewThemesHandler creates a new ThemesHandler.
@@ -86,6 +94,11 @@ func (h *ThemesHandler) Activate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var previousName string
+	if active := h.themeManager.GetActiveTheme(); active != nil {
+		previousName = active.Name
+	}
+
 	// Activate the theme in manager
 	if err := h.themeManager.SetActiveTheme(themeName); err != nil {
 		slog.Error("failed to activate theme", "theme", themeName, "error", err)
@@ -117,6 +130,13 @@ func (h *ThemesHandler) Activate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	slog.Info("theme activated", "theme", themeName, "activated_by", middleware.GetUserID(r))
+	if previousName != themeName {
+		dispatchWebhookEvent(r.Context(), h.dispatcher, model.EventThemeActivated, webhook.ThemeEventData{
+			Name:         themeName,
+			PreviousName: previousName,
+			ActivatedBy:  userID,
+		}, "theme", themeName)
+	}
 	flashSuccess(w, r, h.renderer, redirectAdminThemes, "Theme activated successfully")
 }
 
@@ -0,0 +1,431 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/transfer"
+	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+)
+
+const (
+	// maxTranslationImportBytes caps uploaded XLIFF/PO files.
+	maxTranslationImportBytes = 20 << 20
+	// translationImportSessionKey holds the bundle of a dry run, so it can be
+	// applied without uploading the file again.
+	translationImportSessionKey = "translation_import_data"
+	// translationListBatchSize is the page size used to list source pages.
+	translationListBatchSize = 500
+)
+
+func translationExchangeBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "nav.translation_exchange"), URL: redirectAdminTranslationExchange, Active: true},
+	}
+}
+
+// TranslationExchange handles GET /admin/translation-exchange - shows the
+// translation export form for a language pair and the import upload.
+func (h *ImportExportHandler) TranslationExchange(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionExportData, redirectAdmin) {
+		return
+	}
+	h.renderTranslationExchange(w, r, r.URL.Query().Get("source"), r.URL.Query().Get("target"), nil)
+}
+
+// TranslationExport handles POST /admin/translation-exchange/export - downloads
+// the selected entities as an XLIFF 2.0 or PO file.
+func (h *ImportExportHandler) TranslationExport(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionExportData, redirectAdmin) {
+		return
+	}
+	if !parseFormOrRedirect(w, r, h.renderer, redirectAdminTranslationExchange) {
+		return
+	}
+
+	source, target := r.FormValue("source"), r.FormValue("target")
+	redirectURL := translationExchangeURL(source, target)
+	opts := transfer.TranslationExportOptions{
+		SourceLang:   source,
+		TargetLang:   target,
+		PageIDs:      formIDs(r, "page_ids"),
+		CategoryIDs:  formIDs(r, "category_ids"),
+		TagIDs:       formIDs(r, "tag_ids"),
+		MenuIDs:      formIDs(r, "menu_ids"),
+		IncludeMedia: r.FormValue("include_media") == "on",
+	}
+	if len(opts.PageIDs)+len(opts.CategoryIDs)+len(opts.TagIDs)+len(opts.MenuIDs) == 0 && !opts.IncludeMedia {
+		flashError(w, r, h.renderer, redirectURL, "Select at least one item to export")
+		return
+	}
+
+	bundle, err := h.newExporter().ExportTranslations(r.Context(), opts)
+	if err != nil {
+		h.logger.Error("translation export failed", "error", err)
+		flashError(w, r, h.renderer, redirectURL, "Export failed: "+err.Error())
+		return
+	}
+
+	// Encode into a buffer so a failure does not leave a truncated download
+	var buf bytes.Buffer
+	ext, contentType := "xlf", "application/xliff+xml"
+	if r.FormValue("format") == "po" {
+		ext, contentType = "po", "text/x-gettext-translation"
+		err = transfer.WritePO(&buf, bundle)
+	} else {
+		err = transfer.WriteXLIFF(&buf, bundle)
+	}
+	if err != nil {
+		logAndHTTPError(w, "Export failed", http.StatusInternalServerError, "translation export encoding failed", "error", err)
+		return
+	}
+
+	filename := fmt.Sprintf("ocms-translations-%s-%s-%s.%s", bundle.SourceLang, bundle.TargetLang, time.Now().Format("2006-01-02"), ext)
+	w.Header().Set(HeaderContentType, contentType+"; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	_, _ = w.Write(buf.Bytes())
+}
+
+// TranslationImport handles POST /admin/translation-exchange/import - imports
+// an uploaded XLIFF or PO file. A dry run reports the changes and keeps the
+// file in the session for TranslationApply.
+func (h *ImportExportHandler) TranslationImport(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionImportData, redirectAdmin) {
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportRequestBytes(maxTranslationImportBytes))
+	if err := r.ParseMultipartForm(maxTranslationImportBytes); err != nil {
+		flashError(w, r, h.renderer, redirectAdminTranslationExchange, "Failed to parse form: "+err.Error())
+		return
+	}
+	file, header, err := r.FormFile("translation_file")
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminTranslationExchange, "Please select a file to import")
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	switch strings.ToLower(filepath.Ext(header.Filename)) {
+	case ".xlf", ".xliff", ".po":
+	default:
+		flashError(w, r, h.renderer, redirectAdminTranslationExchange, "Only XLIFF (.xlf, .xliff) and PO files are supported")
+		return
+	}
+	content, err := readImportFileContent(file, maxTranslationImportBytes)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminTranslationExchange, err.Error())
+		return
+	}
+	bundle, err := transfer.ParseTranslationFile(content)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminTranslationExchange, "Invalid translation file: "+err.Error())
+		return
+	}
+
+	dryRun := r.FormValue("dry_run") == "on"
+	h.sessionManager.Remove(r.Context(), translationImportSessionKey)
+	report, ok := h.runTranslationImport(w, r, bundle, dryRun)
+	if !ok {
+		return
+	}
+	if dryRun && report.Changed() {
+		if data, err := json.Marshal(bundle); err == nil {
+			h.sessionManager.Put(r.Context(), translationImportSessionKey, string(data))
+		}
+	}
+	h.renderTranslationExchange(w, r, report.SourceLang, report.TargetLang, report)
+}
+
+// TranslationApply handles POST /admin/translation-exchange/apply - imports
+// the file of the preceding dry run.
+func (h *ImportExportHandler) TranslationApply(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionImportData, redirectAdmin) {
+		return
+	}
+
+	stored := h.sessionManager.PopString(r.Context(), translationImportSessionKey)
+	if stored == "" {
+		flashError(w, r, h.renderer, redirectAdminTranslationExchange, "No translation file found. Please upload it again.")
+		return
+	}
+	var bundle transfer.TranslationBundle
+	if err := json.Unmarshal([]byte(stored), &bundle); err != nil {
+		flashError(w, r, h.renderer, redirectAdminTranslationExchange, "Failed to read stored translation file")
+		return
+	}
+
+	report, ok := h.runTranslationImport(w, r, &bundle, false)
+	if !ok {
+		return
+	}
+	h.renderTranslationExchange(w, r, report.SourceLang, report.TargetLang, report)
+}
+
+// runTranslationImport applies the page content policy and imports a bundle.
+// It redirects with a flash message and returns false on failure.
+func (h *ImportExportHandler) runTranslationImport(w http.ResponseWriter, r *http.Request, bundle *transfer.TranslationBundle, dryRun bool) (*transfer.TranslationImportReport, bool) {
+	stage := "import"
+	if dryRun {
+		stage = "validate"
+	}
+	if err := h.applyTranslationPageSecurityPolicy(r, bundle, stage, !dryRun); err != nil {
+		flashError(w, r, h.renderer, redirectAdminTranslationExchange, "Import failed: "+err.Error())
+		return nil, false
+	}
+
+	report, err := h.newImporter().ImportTranslations(r.Context(), bundle, transfer.TranslationImportOptions{
+		DryRun: dryRun,
+		UserID: middleware.GetUserID(r),
+	})
+	if err != nil {
+		h.logger.Error("translation import failed", "error", err)
+		flashError(w, r, h.renderer, redirectAdminTranslationExchange, "Import failed: "+err.Error())
+		return nil, false
+	}
+	if dryRun {
+		return report, true
+	}
+
+	if report.Changed() && h.cacheManager != nil {
+		h.cacheManager.ClearAll()
+	}
+	created := report.Count(transfer.TranslationActionCreated)
+	updated := report.Count(transfer.TranslationActionUpdated)
+	skipped := report.Count(transfer.TranslationActionSkipped)
+	_ = h.eventService.LogPageEvent(r.Context(), model.EventLevelInfo, "Translations imported", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{
+		"source":  report.SourceLang,
+		"target":  report.TargetLang,
+		"created": created,
+		"updated": updated,
+		"skipped": skipped,
+	})
+	h.sessionManager.Put(r.Context(), "flash_success", fmt.Sprintf("Import completed: %d created, %d updated, %d skipped", created, updated, skipped))
+	return report, true
+}
+
+// applyTranslationPageSecurityPolicy audits translated page bodies for
+// suspicious markup, as applyImportPageSecurityPolicy does for site imports.
+func (h *ImportExportHandler) applyTranslationPageSecurityPolicy(r *http.Request, bundle *transfer.TranslationBundle, stage string, enforce bool) error {
+	data := &transfer.ExportData{}
+	for id, body := range bundle.TranslatedPageBodies() {
+		data.Pages = append(data.Pages, transfer.ExportPage{Slug: "page-" + strconv.FormatInt(id, 10), Body: body})
+	}
+	return h.applyImportPageSecurityPolicy(r, data, stage, enforce)
+}
+
+func (h *ImportExportHandler) renderTranslationExchange(w http.ResponseWriter, r *http.Request, source, target string, report *transfer.TranslationImportReport) {
+	ctx := r.Context()
+	lang := middleware.GetAdminLang(r)
+
+	languages, err := h.queries.ListActiveLanguages(ctx)
+	if err != nil {
+		logAndInternalError(w, "failed to list languages", "error", err)
+		return
+	}
+	source, target = translationLanguagePair(languages, source, target)
+
+	data := adminviews.TranslationExchangeViewData{
+		SourceLang: source,
+		TargetLang: target,
+	}
+	for _, l := range languages {
+		data.Languages = append(data.Languages, adminviews.TranslationLanguageOption{Code: l.Code, Name: l.Name})
+	}
+	if source != "" && target != "" {
+		if err := h.loadTranslationExchangeEntities(ctx, &data); err != nil {
+			logAndInternalError(w, "failed to list translatable content", "error", err)
+			return
+		}
+	}
+	if report != nil {
+		data.Report = translationImportReportView(report)
+		data.CanApply = report.DryRun && report.Changed() && h.sessionManager.Exists(ctx, translationImportSessionKey)
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer,
+		i18n.T(lang, "nav.translation_exchange"),
+		translationExchangeBreadcrumbs(lang))
+	renderTempl(w, r, adminviews.TranslationExchangePage(pc, data))
+}
+
+// translationLanguagePair validates the requested language pair, defaulting
+// the source to the default language and the target to the next language.
+func translationLanguagePair(languages []store.Language, source, target string) (string, string) {
+	valid := func(code string) bool {
+		for _, l := range languages {
+			if l.Code == code {
+				return true
+			}
+		}
+		return false
+	}
+	if !valid(source) {
+		source = ""
+		for _, l := range languages {
+			if l.IsDefault || source == "" {
+				source = l.Code
+			}
+			if l.IsDefault {
+				break
+			}
+		}
+	}
+	if !valid(target) || target == source {
+		target = ""
+		for _, l := range languages {
+			if l.Code != source {
+				target = l.Code
+				break
+			}
+		}
+	}
+	return source, target
+}
+
+// loadTranslationExchangeEntities lists the source language content that can
+// be exported and marks what is already translated into the target language.
+func (h *ImportExportHandler) loadTranslationExchangeEntities(ctx context.Context, data *adminviews.TranslationExchangeViewData) error {
+	translated := func(kind string, id int64) bool {
+		_, err := h.queries.GetTranslationComponentEntityByLanguage(ctx, store.GetTranslationComponentEntityByLanguageParams{
+			EntityType:   kind,
+			LanguageCode: data.TargetLang,
+			EntityID:     id,
+		})
+		return err == nil
+	}
+
+	for offset := int64(0); ; offset += translationListBatchSize {
+		pages, err := h.queries.ListPagesByLanguage(ctx, store.ListPagesByLanguageParams{
+			LanguageCode: data.SourceLang,
+			Limit:        translationListBatchSize,
+			Offset:       offset,
+		})
+		if err != nil {
+			return err
+		}
+		for _, p := range pages {
+			data.Pages = append(data.Pages, adminviews.TranslationExchangeEntity{
+				ID: p.ID, Label: p.Title, Detail: "/" + p.Slug, Translated: translated(model.EntityTypePage, p.ID),
+			})
+		}
+		if len(pages) < translationListBatchSize {
+			break
+		}
+	}
+
+	categories, err := h.queries.ListCategoriesByLanguage(ctx, data.SourceLang)
+	if err != nil {
+		return err
+	}
+	for _, c := range categories {
+		data.Categories = append(data.Categories, adminviews.TranslationExchangeEntity{
+			ID: c.ID, Label: c.Name, Detail: c.Slug, Translated: translated(model.EntityTypeCategory, c.ID),
+		})
+	}
+
+	tags, err := h.queries.ListTagsByLanguage(ctx, data.SourceLang)
+	if err != nil {
+		return err
+	}
+	for _, t := range tags {
+		data.Tags = append(data.Tags, adminviews.TranslationExchangeEntity{
+			ID: t.ID, Label: t.Name, Detail: t.Slug, Translated: translated(model.EntityTypeTag, t.ID),
+		})
+	}
+
+	menus, err := h.queries.ListMenusByLanguage(ctx, data.SourceLang)
+	if err != nil {
+		return err
+	}
+	for _, m := range menus {
+		_, err := h.queries.GetMenuBySlugAndLanguage(ctx, store.GetMenuBySlugAndLanguageParams{Slug: m.Slug, LanguageCode: data.TargetLang})
+		data.Menus = append(data.Menus, adminviews.TranslationExchangeEntity{
+			ID: m.ID, Label: m.Name, Detail: m.Slug, Translated: err == nil,
+		})
+	}
+	return nil
+}
+
+// translationImportReportView converts an import report for the view. Edit
+// links are only given for entities that exist after the import.
+func translationImportReportView(report *transfer.TranslationImportReport) *adminviews.TranslationImportReportView {
+	view := &adminviews.TranslationImportReportView{
+		DryRun:     report.DryRun,
+		SourceLang: report.SourceLang,
+		TargetLang: report.TargetLang,
+		Created:    report.Count(transfer.TranslationActionCreated),
+		Updated:    report.Count(transfer.TranslationActionUpdated),
+		Unchanged:  report.Count(transfer.TranslationActionUnchanged),
+		Skipped:    report.Count(transfer.TranslationActionSkipped),
+	}
+	for _, e := range report.Entries {
+		entry := adminviews.TranslationImportEntryView{
+			Kind:     e.Kind,
+			SourceID: e.SourceID,
+			Original: e.Original,
+			Action:   e.Action,
+			Fields:   strings.Join(e.Fields, ", "),
+			Notes:    e.Notes,
+		}
+		if e.TargetID > 0 && (!report.DryRun || e.Action != transfer.TranslationActionCreated) {
+			entry.EditURL = translationEditURL(e.Kind, e.TargetID)
+		}
+		view.Entries = append(view.Entries, entry)
+	}
+	return view
+}
+
+// translationEditURL returns the admin edit URL of a translatable entity.
+func translationEditURL(kind string, id int64) string {
+	switch kind {
+	case transfer.TranslationKindPage:
+		return fmt.Sprintf(redirectAdminPagesID, id)
+	case transfer.TranslationKindCategory:
+		return fmt.Sprintf(redirectAdminCategoriesID, id)
+	case transfer.TranslationKindTag:
+		return fmt.Sprintf(redirectAdminTagsID, id)
+	case transfer.TranslationKindMenu:
+		return fmt.Sprintf(redirectAdminMenusID, id)
+	case transfer.TranslationKindMedia:
+		return fmt.Sprintf(redirectAdminMediaID, id)
+	case model.EntityTypeForm:
+		return fmt.Sprintf(redirectAdminFormsID, id)
+	}
+	return ""
+}
+
+func translationExchangeURL(source, target string) string {
+	if source == "" || target == "" {
+		return redirectAdminTranslationExchange
+	}
+	return redirectAdminTranslationExchange + "?" + url.Values{"source": {source}, "target": {target}}.Encode()
+}
+
+// formIDs returns the positive integer IDs of a multi-value form field.
+func formIDs(r *http.Request, name string) []int64 {
+	var ids []int64
+	for _, raw := range r.Form[name] {
+		if id, err := strconv.ParseInt(raw, 10, 64); err == nil && id > 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+func TestTranslationLanguagePair(t *testing.T) {
+	languages := []store.Language{
+		{Code: "de"},
+		{Code: "en", IsDefault: true},
+		{Code: "ru"},
+	}
+	tests := []struct {
+		name, source, target   string
+		wantSource, wantTarget string
+	}{
+		{"defaults", "", "", "en", "de"},
+		{"valid pair", "ru", "en", "ru", "en"},
+		{"unknown source", "fr", "ru", "en", "ru"},
+		{"same languages", "ru", "ru", "ru", "de"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source, target := translationLanguagePair(languages, tt.source, tt.target)
+			if source != tt.wantSource || target != tt.wantTarget {
+				t.Fatalf("pair = %s/%s, want %s/%s", source, target, tt.wantSource, tt.wantTarget)
+			}
+		})
+	}
+
+	if source, target := translationLanguagePair(languages[1:2], "", ""); source != "en" || target != "" {
+		t.Fatalf("single language pair = %q/%q, want en/\"\"", source, target)
+	}
+}
+
+func TestFormIDs(t *testing.T) {
+	form := url.Values{"page_ids": {"3", "x", "-1", "0", "7"}}
+	req := httptest.NewRequest("POST", "/admin/translation-exchange/export", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if err := req.ParseForm(); err != nil {
+		t.Fatal(err)
+	}
+	if got := formIDs(req, "page_ids"); !slices.Equal(got, []int64{3, 7}) {
+		t.Fatalf("formIDs = %v, want [3 7]", got)
+	}
+	if got := formIDs(req, "tag_ids"); got != nil {
+		t.Fatalf("formIDs of missing field = %v, want nil", got)
+	}
+}
+
+func TestTranslationExchangeURL(t *testing.T) {
+	if got := translationExchangeURL("en", "ru"); got != "/admin/translation-exchange?source=en&target=ru" {
+		t.Fatalf("translationExchangeURL = %q", got)
+	}
+	if got := translationExchangeURL("", "ru"); got != "/admin/translation-exchange" {
+		t.Fatalf("translationExchangeURL without source = %q", got)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/alexedwards/scs/v2"
+
+	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/store"
+	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+)
+
+// TranslationStatusHandler shows translation coverage and the translations
+// whose source changed since they were last brought up to date.
+type TranslationStatusHandler struct {
+	queries        *store.Queries
+	renderer       *render.Renderer
+	sessionManager *scs.SessionManager
+}
+
+// NewTranslationStatusHandler creates a new TranslationStatusHandler.
+func NewTranslationStatusHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionManager) *TranslationStatusHandler {
+	return &TranslationStatusHandler{
+		queries:        store.New(db),
+		renderer:       renderer,
+		sessionManager: sm,
+	}
+}
+
+func translationStatusBreadcrumbs(lang string) []render.Breadcrumb {
+	return []render.Breadcrumb{
+		{Label: i18n.T(lang, "nav.dashboard"), URL: redirectAdmin},
+		{Label: i18n.T(lang, "nav.translation_status"), URL: redirectAdminTranslationStatus, Active: true},
+	}
+}
+
+// Status handles GET /admin/translation-status - shows the coverage matrix
+// and the outdated translations, optionally of one ?kind= and ?language=.
+func (h *TranslationStatusHandler) Status(w http.ResponseWriter, r *http.Request) {
+	lang := middleware.GetAdminLang(r)
+
+	status, err := service.LoadTranslationStatus(r.Context(), h.queries)
+	if err != nil {
+		logAndInternalError(w, "failed to load translation status", "error", err)
+		return
+	}
+
+	kindFilter := r.URL.Query().Get("kind")
+	if !slices.Contains(service.TranslationStatusKinds, kindFilter) {
+		kindFilter = ""
+	}
+	languageFilter := r.URL.Query().Get("language")
+	if !slices.ContainsFunc(status.Languages, func(l store.Language) bool { return l.Code == languageFilter }) {
+		languageFilter = ""
+	}
+
+	data := adminviews.TranslationStatusViewData{
+		DefaultLanguage: status.DefaultLanguage,
+		KindFilter:      kindFilter,
+		LanguageFilter:  languageFilter,
+		ClearFilterURL:  redirectAdminTranslationStatus,
+	}
+	for _, l := range status.Languages {
+		data.Languages = append(data.Languages, adminviews.LanguageOption{Code: l.Code, Name: l.Name, NativeName: l.NativeName})
+	}
+	for _, row := range status.Rows {
+		view := adminviews.TranslationStatusRowView{
+			Kind:  row.Kind,
+			Label: i18n.T(lang, "translation_status.kind_"+row.Kind),
+			Total: row.Total,
+		}
+		for _, l := range status.Languages {
+			cell := row.Cells[l.Code]
+			percent := 100
+			if row.Total > 0 {
+				percent = min(cell.Translated*100/row.Total, 100)
+			}
+			view.Cells = append(view.Cells, adminviews.TranslationStatusCellView{
+				Translated: cell.Translated,
+				Missing:    row.Missing(l.Code),
+				Outdated:   cell.Outdated,
+				Percent:    percent,
+				FilterURL:  translationStatusURL(row.Kind, l.Code),
+			})
+		}
+		data.Rows = append(data.Rows, view)
+	}
+	for _, o := range status.Outdated {
+		if (kindFilter != "" && o.Kind != kindFilter) || (languageFilter != "" && o.Language != languageFilter) {
+			continue
+		}
+		data.Outdated = append(data.Outdated, adminviews.OutdatedTranslationView{
+			LinkID:          o.LinkID,
+			Kind:            o.Kind,
+			SourceTitle:     o.SourceTitle,
+			SourceLanguage:  o.SourceLanguage,
+			SourceURL:       translationEditURL(o.Kind, o.SourceID),
+			SourceChangedAt: h.renderer.FormatDateTimeLocale(o.SourceChangedAt, lang),
+			Title:           o.Title,
+			Language:        o.Language,
+			EditURL:         translationEditURL(o.Kind, o.TranslationID),
+		})
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "translation_status.title"), translationStatusBreadcrumbs(lang))
+	renderTempl(w, r, adminviews.TranslationStatusPage(pc, data))
+}
+
+// MarkCurrent handles POST /admin/translation-status/{id}/current - marks a
+// translation link as up to date with the current state of its source.
+func (h *TranslationStatusHandler) MarkCurrent(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionContentReadOnly, redirectAdminTranslationStatus) {
+		return
+	}
+
+	id, err := ParseIDParam(r)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminTranslationStatus, "Invalid translation ID")
+		return
+	}
+	link, err := h.queries.GetTranslationByID(r.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		flashError(w, r, h.renderer, redirectAdminTranslationStatus, "Translation not found")
+		return
+	}
+	if err != nil {
+		logAndInternalError(w, "failed to get translation", "error", err, "translation_id", id)
+		return
+	}
+	if err := h.queries.MarkTranslationCurrent(r.Context(), link.ID); err != nil {
+		slog.Error("failed to mark translation up to date", "error", err, "translation_id", id)
+		flashError(w, r, h.renderer, redirectAdminTranslationStatus, "Error updating translation")
+		return
+	}
+
+	slog.Info("translation marked up to date", "translation_id", id, "entity_type", link.EntityType,
+		"translated_id", link.TranslationID, "marked_by", middleware.GetUserID(r))
+	flashSuccess(w, r, h.renderer, redirectAdminTranslationStatus, "Translation marked as up to date")
+}
+
+// translationStatusURL returns the status page filtered to a kind and language.
+func translationStatusURL(kind, language string) string {
+	return redirectAdminTranslationStatus + "?" + url.Values{"kind": {kind}, "language": {language}}.Encode()
+}
@@ -0,0 +1,393 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/util"
+	"github.com/olegiv/ocms-go/internal/webhook"
+)
+
+// maxRedirectChainHops bounds how many redirects are followed when a
+// redirect target is resolved.
+const maxRedirectChainHops = 10
+
+// errRedirectLoop is returned when a redirect target leads back to its source.
+var errRedirectLoop = errors.New("redirect loop")
+
+// URLOwner identifies the page, tag or category a public path belongs to.
+type URLOwner struct {
+	EntityType string // model.EntityTypePage, EntityTypeTag or EntityTypeCategory
+	ID         int64
+}
+
+// URLSnapshot holds the public paths by owner taken with Capture before a
+// change.
+type URLSnapshot struct {
+	withTaxonomy bool
+	urls         map[URLOwner]string
+}
+
+// URLHistory keeps the public URLs of pages, tags and categories working
+// when they move. The admin handlers and the API capture the URLs before a
+// change and redirect the ones that moved after it.
+type URLHistory struct {
+	queries    *store.Queries
+	dispatcher *webhook.Dispatcher
+	redirects  *middleware.RedirectsMiddleware
+}
+
+// NewURLHistory creates a new URLHistory.
+func NewURLHistory(db *sql.DB) *URLHistory {
+	return &URLHistory{queries: store.New(db)}
+}
+
+// SetDispatcher sets the webhook dispatcher for the redirect events of
+// moved URLs.
+func (u *URLHistory) SetDispatcher(d *webhook.Dispatcher) {
+	u.dispatcher = d
+}
+
+// SetRedirectsMiddleware sets the redirects middleware whose cache is
+// invalidated when moved URLs are redirected.
+func (u *URLHistory) SetRedirectsMiddleware(rm *middleware.RedirectsMiddleware) {
+	u.redirects = rm
+}
+
+// Capture returns the public paths of the published pages of the given
+// languages and, with withTaxonomy, those of their tags and categories.
+func (u *URLHistory) Capture(ctx context.Context, withTaxonomy bool, languageCodes ...string) URLSnapshot {
+	return URLSnapshot{withTaxonomy: withTaxonomy, urls: publicURLs(ctx, u.queries, withTaxonomy, languageCodes...)}
+}
+
+// RedirectMoved compares before with the current public paths of the given
+// languages and redirects the old paths of the owners whose path changed,
+// as described at redirectMovedURLs. It returns the owners whose path
+// changed.
+func (u *URLHistory) RedirectMoved(ctx context.Context, before URLSnapshot, languageCodes ...string) []URLOwner {
+	after := publicURLs(ctx, u.queries, before.withTaxonomy, languageCodes...)
+	return u.redirectMovedURLs(ctx, before.urls, after, time.Now())
+}
+
+// RedirectRenamedTag redirects the old URL of a tag whose slug or language
+// changed to its new URL. It reports whether a redirect was made.
+func (u *URLHistory) RedirectRenamedTag(ctx context.Context, before, after store.Tag) bool {
+	return u.redirectRenamedTerm(ctx, URLOwner{EntityType: model.EntityTypeTag, ID: after.ID}, redirectTag,
+		before.LanguageCode, before.Slug, after.LanguageCode, after.Slug)
+}
+
+// RedirectRenamedCategory redirects the old URL of a category whose slug or
+// language changed to its new URL. It reports whether a redirect was made.
+func (u *URLHistory) RedirectRenamedCategory(ctx context.Context, before, after store.Category) bool {
+	return u.redirectRenamedTerm(ctx, URLOwner{EntityType: model.EntityTypeCategory, ID: after.ID}, redirectCategory,
+		before.LanguageCode, before.Slug, after.LanguageCode, after.Slug)
+}
+
+// redirectRenamedTerm redirects the old URL of a tag or category to its new
+// URL. base is redirectTag or redirectCategory.
+func (u *URLHistory) redirectRenamedTerm(ctx context.Context, owner URLOwner, base, oldLanguage, oldSlug, newLanguage, newSlug string) bool {
+	oldPath := taxonomyURL(ctx, u.queries, oldLanguage, base, oldSlug)
+	newPath := taxonomyURL(ctx, u.queries, newLanguage, base, newSlug)
+	if oldPath == "" || newPath == "" || oldPath == newPath {
+		return false
+	}
+	moved := u.redirectMovedURLs(ctx, map[URLOwner]string{owner: oldPath}, map[URLOwner]string{owner: newPath}, time.Now())
+	return len(moved) > 0
+}
+
+// dispatchRedirectEvent dispatches a redirect-related webhook event.
+func (u *URLHistory) dispatchRedirectEvent(ctx context.Context, eventType string, redirect store.Redirect) {
+	dispatchWebhookEvent(ctx, u.dispatcher, eventType, webhook.NewRedirectEventData(redirect), "redirect_id", redirect.ID)
+}
+
+// publicURLs returns the public paths of the published pages of the given
+// languages by owner and, with withTaxonomy, those of their tags and
+// categories. It is taken before and after a change to find the URLs that
+// moved.
+func publicURLs(ctx context.Context, queries *store.Queries, withTaxonomy bool, languageCodes ...string) map[URLOwner]string {
+	defaultLanguage, err := queries.GetDefaultLanguage(ctx)
+	if err != nil {
+		return nil
+	}
+	urls := make(map[URLOwner]string)
+	for _, code := range languageCodes {
+		language, err := queries.GetLanguageByCode(ctx, code)
+		if err != nil {
+			continue
+		}
+		addPageURLs(ctx, queries, urls, language, defaultLanguage)
+		if withTaxonomy {
+			addTaxonomyURLs(ctx, queries, urls, language, defaultLanguage)
+		}
+	}
+	return urls
+}
+
+// addPageURLs adds the public paths of the published pages of a language.
+func addPageURLs(ctx context.Context, queries *store.Queries, urls map[URLOwner]string, language, defaultLanguage store.Language) {
+	rows, err := queries.ListPageTreeNodes(ctx, store.ListPageTreeNodesParams{LanguageCode: language.Code, SiteID: store.SiteIDFromContext(ctx)})
+	if err != nil {
+		slog.Error("failed to list page tree", "error", err, "language", language.Code)
+		return
+	}
+	treePaths := pageTreePaths(buildPageTree(rows), "", make(map[int64]string))
+	for _, row := range rows {
+		page := store.Page{ID: row.ID, Slug: row.Slug, Status: row.Status, LanguageCode: language.Code}
+		if public := publicPagePathWithLanguages(page, treePaths[row.ID], language, defaultLanguage); public != "" {
+			urls[URLOwner{EntityType: model.EntityTypePage, ID: row.ID}] = public
+		}
+	}
+}
+
+// addTaxonomyURLs adds the public paths of the tags and categories of a
+// language.
+func addTaxonomyURLs(ctx context.Context, queries *store.Queries, urls map[URLOwner]string, language, defaultLanguage store.Language) {
+	prefix, ok := languagePathPrefix(language, defaultLanguage)
+	if !ok {
+		return
+	}
+	tags, err := queries.ListTagsByLanguage(ctx, language.Code)
+	if err != nil {
+		slog.Error("failed to list tags", "error", err, "language", language.Code)
+	}
+	for _, tag := range tags {
+		if util.IsValidSlug(tag.Slug) {
+			urls[URLOwner{EntityType: model.EntityTypeTag, ID: tag.ID}] = prefix + redirectTag + tag.Slug
+		}
+	}
+	categories, err := queries.ListCategoriesByLanguage(ctx, language.Code)
+	if err != nil {
+		slog.Error("failed to list categories", "error", err, "language", language.Code)
+	}
+	for _, category := range categories {
+		if util.IsValidSlug(category.Slug) {
+			urls[URLOwner{EntityType: model.EntityTypeCategory, ID: category.ID}] = prefix + redirectCategory + category.Slug
+		}
+	}
+}
+
+// taxonomyURL returns the public path of a tag or category below base
+// ("/tag/" or "/category/"), or "" when its language is not routable.
+func taxonomyURL(ctx context.Context, queries *store.Queries, languageCode, base, slug string) string {
+	defaultLanguage, err := queries.GetDefaultLanguage(ctx)
+	if err != nil {
+		return ""
+	}
+	language, err := queries.GetLanguageByCode(ctx, languageCode)
+	if err != nil {
+		return ""
+	}
+	prefix, ok := languagePathPrefix(language, defaultLanguage)
+	if !ok || !util.IsValidSlug(slug) {
+		return ""
+	}
+	return prefix + base + slug
+}
+
+// languagePathPrefix returns the URL prefix of a language: "" for the
+// default language and "/code" for the others. Inactive, invalid and
+// reserved languages are not routable.
+func languagePathPrefix(language, defaultLanguage store.Language) (string, bool) {
+	if !language.IsActive || !util.IsValidLangCode(language.Code) || util.IsReservedLanguageCode(language.Code) {
+		return "", false
+	}
+	if language.ID == defaultLanguage.ID && language.Code == defaultLanguage.Code {
+		return "", true
+	}
+	return "/" + language.Code, true
+}
+
+// redirectMovedURLs creates permanent redirects from the old public paths of
+// the pages, tags and categories whose path changed to their new paths, and
+// records the old paths of pages in their URL history. Redirects that
+// pointed at an old path are retargeted to the new one, so chains do not
+// build up, and redirects from a new path are removed, so that moving
+// something back does not loop. It returns the owners whose path changed.
+func (u *URLHistory) redirectMovedURLs(ctx context.Context, oldURLs, newURLs map[URLOwner]string, now time.Time) []URLOwner {
+	moves := make(map[string]string)
+	var moved []URLOwner
+	for owner, newPath := range newURLs {
+		oldPath, ok := oldURLs[owner]
+		if !ok || oldPath == newPath {
+			continue
+		}
+		moves[oldPath] = newPath
+		moved = append(moved, owner)
+	}
+	if len(moved) == 0 {
+		return nil
+	}
+
+	// Retarget in one pass: when two paths trade places, retargeting them
+	// one after the other would send both to the same page.
+	u.retargetRedirects(ctx, moves, now)
+
+	for _, owner := range moved {
+		oldPath, newPath := oldURLs[owner], newURLs[owner]
+		if err := u.upsertMovedRedirect(ctx, oldPath, newPath, now); err != nil {
+			slog.Error("failed to create redirect for moved URL", "error", err, "source", oldPath, "entity", owner.EntityType, "id", owner.ID)
+		}
+		if owner.EntityType == model.EntityTypePage {
+			if err := u.queries.RecordPageURLChange(ctx, store.RecordPageURLChangeParams{
+				PageID:    owner.ID,
+				Path:      oldPath,
+				ChangedAt: now,
+			}); err != nil {
+				slog.Error("failed to record page URL history", "error", err, "page_id", owner.ID, "path", oldPath)
+			}
+		}
+	}
+
+	for _, owner := range moved {
+		newPath := newURLs[owner]
+		redirect, err := u.queries.GetRedirectBySourcePath(ctx, newPath)
+		if err == nil {
+			if err := u.queries.DeleteRedirect(ctx, redirect.ID); err != nil {
+				slog.Error("failed to delete redirect from moved URL", "error", err, "source", newPath)
+			} else {
+				u.dispatchRedirectEvent(ctx, model.EventRedirectDeleted, redirect)
+			}
+		}
+		if owner.EntityType == model.EntityTypePage {
+			if err := u.queries.DeletePageURLHistoryPath(ctx, store.DeletePageURLHistoryPathParams{
+				PageID: owner.ID,
+				Path:   newPath,
+			}); err != nil {
+				slog.Error("failed to update page URL history", "error", err, "page_id", owner.ID, "path", newPath)
+			}
+		}
+	}
+	if u.redirects != nil {
+		u.redirects.InvalidateCache()
+	}
+	return moved
+}
+
+// retargetRedirects points every redirect whose target is a key of targets
+// at the mapped path and dispatches redirect.updated for each.
+func (u *URLHistory) retargetRedirects(ctx context.Context, targets map[string]string, now time.Time) {
+	redirects, err := u.queries.ListRedirects(ctx)
+	if err != nil {
+		slog.Error("failed to list redirects", "error", err)
+		return
+	}
+	for _, rd := range redirects {
+		target, ok := targets[rd.TargetUrl]
+		if !ok {
+			continue
+		}
+		updated, err := u.queries.UpdateRedirect(ctx, store.UpdateRedirectParams{
+			SourcePath: rd.SourcePath,
+			TargetUrl:  target,
+			StatusCode: rd.StatusCode,
+			IsWildcard: rd.IsWildcard,
+			TargetType: rd.TargetType,
+			Enabled:    rd.Enabled,
+			IsRegex:    rd.IsRegex,
+			DropQuery:  rd.DropQuery,
+			UpdatedAt:  now,
+			ID:         rd.ID,
+		})
+		if err != nil {
+			slog.Error("failed to retarget redirect", "error", err, "redirect_id", rd.ID)
+			continue
+		}
+		u.dispatchRedirectEvent(ctx, model.EventRedirectUpdated, updated)
+	}
+}
+
+// upsertMovedRedirect points the redirect from source at target, creating
+// it when it does not exist, and dispatches redirect.created or
+// redirect.updated.
+func (u *URLHistory) upsertMovedRedirect(ctx context.Context, source, target string, now time.Time) error {
+	existing, err := u.queries.GetRedirectBySourcePath(ctx, source)
+	if errors.Is(err, sql.ErrNoRows) {
+		created, err := u.queries.CreateRedirect(ctx, store.CreateRedirectParams{
+			SourcePath: source,
+			TargetUrl:  target,
+			StatusCode: http.StatusMovedPermanently,
+			TargetType: model.TargetSelf,
+			Enabled:    true,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		})
+		if err != nil {
+			return err
+		}
+		u.dispatchRedirectEvent(ctx, model.EventRedirectCreated, created)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	updated, err := u.queries.UpdateRedirect(ctx, store.UpdateRedirectParams{
+		SourcePath: source,
+		TargetUrl:  target,
+		StatusCode: http.StatusMovedPermanently,
+		TargetType: model.TargetSelf,
+		Enabled:    true,
+		UpdatedAt:  now,
+		ID:         existing.ID,
+	})
+	if err != nil {
+		return err
+	}
+	u.dispatchRedirectEvent(ctx, model.EventRedirectUpdated, updated)
+	return nil
+}
+
+// applyPageURLHistory lists the earlier public paths of the edited page.
+func (h *PagesHandler) applyPageURLHistory(ctx context.Context, data *PageFormData, pageID int64) {
+	history, err := h.queries.ListPageURLHistory(ctx, pageID)
+	if err != nil {
+		slog.Error("failed to list page URL history", "error", err, "page_id", pageID)
+		return
+	}
+	for _, entry := range history {
+		item := PageURLHistoryEntry{Path: entry.Path, ChangedAt: entry.ChangedAt}
+		if redirect, err := h.queries.GetRedirectBySourcePath(ctx, entry.Path); err == nil {
+			item.Redirect = &redirect
+		}
+		data.URLHistory = append(data.URLHistory, item)
+	}
+}
+
+// resolveRedirectTarget follows the enabled exact redirects from target and
+// returns the final destination, so a redirect to an already redirected
+// path skips the chain. It returns errRedirectLoop when the chain leads
+// back to source or around in a circle.
+func resolveRedirectTarget(ctx context.Context, queries *store.Queries, source, target string) (string, error) {
+	seen := map[string]bool{source: true}
+	for range maxRedirectChainHops {
+		if seen[target] {
+			return "", errRedirectLoop
+		}
+		if !strings.HasPrefix(target, "/") || strings.HasPrefix(target, "//") {
+			return target, nil
+		}
+		seen[target] = true
+		next, err := queries.GetRedirectBySourcePath(ctx, target)
+		if errors.Is(err, sql.ErrNoRows) {
+			return target, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if !next.Enabled || next.IsWildcard || next.IsRegex {
+			return target, nil
+		}
+		target = next.TargetUrl
+	}
+	return target, nil
+}
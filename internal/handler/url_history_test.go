@@ -0,0 +1,181 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+func TestRedirectMovedURLs_SwappedPaths(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	admin := createTestAdminUser(t, db)
+	first := createNestedPage(t, db, "first", "First", 0, admin.ID)
+	second := createNestedPage(t, db, "second", "Second", 0, admin.ID)
+
+	h := NewPagesHandler(db, nil, sm)
+	d, deliveries := recordRedirectEvents(t, db, admin.ID)
+	h.SetDispatcher(d)
+	ctx := context.Background()
+	now := time.Now()
+	if err := h.urlHistory.upsertMovedRedirect(ctx, "/legacy", "/first", now); err != nil {
+		t.Fatalf("create redirect: %v", err)
+	}
+
+	oldURLs := map[URLOwner]string{
+		{EntityType: model.EntityTypePage, ID: first}:  "/first",
+		{EntityType: model.EntityTypePage, ID: second}: "/second",
+	}
+	newURLs := map[URLOwner]string{
+		{EntityType: model.EntityTypePage, ID: first}:  "/second",
+		{EntityType: model.EntityTypePage, ID: second}: "/first",
+	}
+	if moved := h.urlHistory.redirectMovedURLs(ctx, oldURLs, newURLs, now); len(moved) != 2 {
+		t.Fatalf("redirectMovedURLs() moved %d, want 2", len(moved))
+	}
+
+	// Both paths are live again, so neither may redirect; the legacy redirect
+	// follows the first page.
+	for _, source := range []string{"/first", "/second"} {
+		if _, err := h.queries.GetRedirectBySourcePath(ctx, source); err == nil {
+			t.Errorf("redirect from live path %s exists", source)
+		}
+	}
+	if legacy, err := h.queries.GetRedirectBySourcePath(ctx, "/legacy"); err != nil || legacy.TargetUrl != "/second" {
+		t.Errorf("redirect from /legacy = %q, %v; want /second", legacy.TargetUrl, err)
+	}
+
+	// The legacy redirect and the two moved paths were created, the legacy
+	// redirect retargeted and the redirects from the live paths deleted.
+	for event, want := range map[string]int{
+		model.EventRedirectCreated: 3,
+		model.EventRedirectUpdated: 1,
+		model.EventRedirectDeleted: 2,
+	} {
+		if got := deliveries(event); got != want {
+			t.Errorf("%s deliveries = %d, want %d", event, got, want)
+		}
+	}
+
+	history, err := h.queries.ListPageURLHistory(ctx, first)
+	if err != nil {
+		t.Fatalf("list URL history: %v", err)
+	}
+	if len(history) != 1 || history[0].Path != "/first" {
+		t.Errorf("history of first page = %+v, want /first", history)
+	}
+}
+
+func TestPagesHandler_ApplyPageURLHistory(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	admin := createTestAdminUser(t, db)
+	page := createNestedPage(t, db, "renamed", "Renamed", 0, admin.ID)
+
+	h := NewPagesHandler(db, nil, sm)
+	ctx := context.Background()
+	oldURLs := h.urlHistory.Capture(ctx, false, "en")
+	if _, err := db.Exec(`UPDATE pages SET slug = 'current' WHERE id = ?`, page); err != nil {
+		t.Fatalf("rename page: %v", err)
+	}
+	if moved := h.redirectMovedPages(ctx, "en", oldURLs); moved != 1 {
+		t.Fatalf("redirectMovedPages() = %d, want 1", moved)
+	}
+
+	var data PageFormData
+	h.applyPageURLHistory(ctx, &data, page)
+	if len(data.URLHistory) != 1 {
+		t.Fatalf("URL history has %d entries, want 1", len(data.URLHistory))
+	}
+	entry := data.URLHistory[0]
+	if entry.Path != "/renamed" || entry.Redirect == nil || entry.Redirect.TargetUrl != "/current" {
+		t.Errorf("URL history entry = %+v, want /renamed redirecting to /current", entry)
+	}
+}
+
+func TestPublicURLs_DefaultLanguageSwitch(t *testing.T) {
+	db, _ := testHandlerSetup(t)
+	queries := store.New(db)
+	ctx := context.Background()
+	if _, err := db.Exec(`INSERT INTO languages (code, name, native_name, is_default, is_active, position) VALUES ('ru', 'Russian', 'Русский', 0, 1, 1)`); err != nil {
+		t.Fatalf("create language: %v", err)
+	}
+	enTag, err := queries.CreateTag(ctx, store.CreateTagParams{Name: "News", Slug: "news", LanguageCode: "en"})
+	if err != nil {
+		t.Fatalf("create tag: %v", err)
+	}
+	ruTag, err := queries.CreateTag(ctx, store.CreateTagParams{Name: "Новости", Slug: "novosti", LanguageCode: "ru"})
+	if err != nil {
+		t.Fatalf("create tag: %v", err)
+	}
+
+	urlHistory := NewURLHistory(db)
+	oldURLs := urlHistory.Capture(ctx, true, "en", "ru")
+	if _, err := db.Exec(`UPDATE languages SET is_default = (code = 'ru')`); err != nil {
+		t.Fatalf("switch default language: %v", err)
+	}
+	if got := publicURLs(ctx, queries, true, "en")[URLOwner{EntityType: model.EntityTypeTag, ID: enTag.ID}]; got != "/en/tag/news" {
+		t.Errorf("English tag URL = %q, want /en/tag/news", got)
+	}
+	urlHistory.RedirectMoved(ctx, oldURLs, "en", "ru")
+	for source, want := range map[string]string{
+		"/tag/news":       "/en/tag/news",
+		"/ru/tag/novosti": "/tag/novosti",
+	} {
+		if redirect, err := queries.GetRedirectBySourcePath(ctx, source); err != nil || redirect.TargetUrl != want {
+			t.Errorf("redirect from %s = %q, %v; want %s", source, redirect.TargetUrl, err, want)
+		}
+	}
+	if got := oldURLs.urls[URLOwner{EntityType: model.EntityTypeTag, ID: ruTag.ID}]; got != "/ru/tag/novosti" {
+		t.Errorf("old Russian tag URL = %q", got)
+	}
+}
+
+func TestResolveRedirectTarget(t *testing.T) {
+	db, _ := testHandlerSetup(t)
+	queries := store.New(db)
+	urlHistory := NewURLHistory(db)
+	ctx := context.Background()
+	now := time.Now()
+	for source, target := range map[string]string{
+		"/a": "/b",
+		"/b": "/c",
+		"/x": "/y",
+		"/y": "/x",
+	} {
+		if err := urlHistory.upsertMovedRedirect(ctx, source, target, now); err != nil {
+			t.Fatalf("create redirect: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		source  string
+		target  string
+		want    string
+		wantErr error
+	}{
+		{name: "no redirect", source: "/new", target: "/plain", want: "/plain"},
+		{name: "chain", source: "/new", target: "/a", want: "/c"},
+		{name: "external", source: "/new", target: "https://example.com/a", want: "https://example.com/a"},
+		{name: "back to source", source: "/c", target: "/a", wantErr: errRedirectLoop},
+		{name: "self", source: "/same", target: "/same", wantErr: errRedirectLoop},
+		{name: "cycle", source: "/new", target: "/x", wantErr: errRedirectLoop},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveRedirectTarget(ctx, queries, tt.source, tt.target)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("resolveRedirectTarget() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
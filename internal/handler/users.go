@@ -13,6 +13,8 @@ import (
 	"net/mail"
 	"net/url"
 	"path"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -166,6 +168,7 @@ func (h *UsersHandler) NewForm(w http.ResponseWriter, r *http.Request) {
 		Errors:     make(map[string]string),
 		FormValues: make(map[string]string),
 		IsEdit:     false,
+		Sites:      h.siteOptions(r.Context()),
 	}
 
 	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "users.new"), userFormBreadcrumbs(lang, false))
@@ -195,6 +198,7 @@ func (h *UsersHandler) Create(w http.ResponseWriter, r *http.Request) {
 	linkedinURL := strings.TrimSpace(r.FormValue("linkedin_url"))
 	githubURL := strings.TrimSpace(r.FormValue("github_url"))
 	telegramURL := strings.TrimSpace(r.FormValue("telegram_url"))
+	siteIDs := parseSiteIDs(r)
 
 	// Store form values for re-rendering on error
 	formValues := map[string]string{
@@ -263,6 +267,8 @@ func (h *UsersHandler) Create(w http.ResponseWriter, r *http.Request) {
 			Errors:     validationErrors,
 			FormValues: formValues,
 			IsEdit:     false,
+			Sites:      h.siteOptions(r.Context()),
+			SiteIDs:    siteIDs,
 		}
 		pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "users.new"), userFormBreadcrumbs(lang, false))
 		renderTempl(w, r, adminviews.UserFormPage(pc, data))
@@ -299,6 +305,10 @@ func (h *UsersHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.saveUserSites(r.Context(), newUser.ID, siteIDs); err != nil {
+		slog.Error("failed to save user sites", "error", err, "user_id", newUser.ID)
+	}
+
 	slog.Info("user created", "user_id", newUser.ID, "email", newUser.Email, "created_by", middleware.GetUserID(r))
 	_ = h.eventService.LogUserEvent(r.Context(), model.EventLevelInfo, "User created", middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r), map[string]any{"user_id": newUser.ID, "email": newUser.Email, "role": newUser.Role})
 
@@ -349,7 +359,9 @@ func (h *UsersHandler) EditForm(w http.ResponseWriter, r *http.Request) {
 			"github_url":   editUser.GithubUrl,
 			"telegram_url": editUser.TelegramUrl,
 		},
-		IsEdit: true,
+		IsEdit:  true,
+		Sites:   h.siteOptions(r.Context()),
+		SiteIDs: h.userSiteIDs(r.Context(), editUser.ID),
 	}
 
 	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "users.edit"), userEditBreadcrumbs(lang, editUser.Name, editUser.ID))
@@ -397,6 +409,7 @@ func (h *UsersHandler) Update(w http.ResponseWriter, r *http.Request) {
 	linkedinURL := strings.TrimSpace(r.FormValue("linkedin_url"))
 	githubURL := strings.TrimSpace(r.FormValue("github_url"))
 	telegramURL := strings.TrimSpace(r.FormValue("telegram_url"))
+	siteIDs := parseSiteIDs(r)
 
 	// Store form values for re-rendering on error
 	formValues := map[string]string{
@@ -486,6 +499,8 @@ func (h *UsersHandler) Update(w http.ResponseWriter, r *http.Request) {
 			Errors:     validationErrors,
 			FormValues: formValues,
 			IsEdit:     true,
+			Sites:      h.siteOptions(r.Context()),
+			SiteIDs:    siteIDs,
 		}
 
 		pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "users.edit"), userEditBreadcrumbs(lang, editUser.Name, id))
@@ -514,6 +529,12 @@ func (h *UsersHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.saveUserSites(r.Context(), id, siteIDs); err != nil {
+		slog.Error("failed to save user sites", "error", err, "user_id", id)
+		flashError(w, r, h.renderer, fmt.Sprintf(redirectAdminUsersID, id), "Error updating user sites")
+		return
+	}
+
 	// Update password if provided
 	if password != "" {
 		passwordHash, err := auth.HashPassword(password)
@@ -829,6 +850,72 @@ func validateProfileFields(avatar, bio, websiteURL, linkedinURL, githubURL, tele
 	}
 }
 
+// siteOptions returns the sites a user can be restricted to.
+func (h *UsersHandler) siteOptions(ctx context.Context) []adminviews.SiteOption {
+	sites, err := h.queries.ListSites(ctx)
+	if err != nil {
+		slog.Error("failed to list sites", "error", err)
+		return nil
+	}
+	options := make([]adminviews.SiteOption, 0, len(sites))
+	for _, site := range sites {
+		options = append(options, adminviews.SiteOption{ID: site.ID, Name: site.Name})
+	}
+	return options
+}
+
+// userSiteIDs returns the sites a user is restricted to.
+func (h *UsersHandler) userSiteIDs(ctx context.Context, userID int64) map[int64]bool {
+	ids, err := h.queries.ListUserSiteIDs(ctx, userID)
+	if err != nil {
+		slog.Error("failed to list user sites", "error", err, "user_id", userID)
+	}
+	selected := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		selected[id] = true
+	}
+	return selected
+}
+
+// parseSiteIDs parses the site checkboxes of the user form.
+func parseSiteIDs(r *http.Request) map[int64]bool {
+	selected := make(map[int64]bool)
+	for _, v := range r.Form["site_ids"] {
+		if id, err := strconv.ParseInt(v, 10, 64); err == nil && id > 0 {
+			selected[id] = true
+		}
+	}
+	return selected
+}
+
+// saveUserSites replaces the sites a user is restricted to. Sites that no
+// longer exist are ignored; without any selected site the user is
+// restricted to the default site.
+func (h *UsersHandler) saveUserSites(ctx context.Context, userID int64, siteIDs map[int64]bool) error {
+	if err := h.queries.DeleteUserSites(ctx, userID); err != nil {
+		return err
+	}
+	sites, err := h.queries.ListSites(ctx)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(sites, func(s store.Site) bool { return siteIDs[s.ID] }) {
+		siteIDs = make(map[int64]bool, len(sites))
+		for _, site := range sites {
+			siteIDs[site.ID] = site.IsDefault
+		}
+	}
+	for _, site := range sites {
+		if !siteIDs[site.ID] {
+			continue
+		}
+		if err := h.queries.AddUserSite(ctx, store.AddUserSiteParams{UserID: userID, SiteID: site.ID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // isValidRole checks if a role is valid.
 func isValidRole(role string) bool {
 	for _, r := range model.ValidRoles {
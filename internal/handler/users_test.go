@@ -4,14 +4,17 @@
 package handler
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
 	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
 )
 
@@ -508,3 +511,40 @@ func TestValidateDomainURL(t *testing.T) {
 		})
 	}
 }
+
+func TestUsersHandler_SaveUserSites(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	h := NewUsersHandler(db, nil, sm)
+	ctx := context.Background()
+	editor := createTestUser(t, db, testUser{Email: "editor@example.com", Name: "Editor", Role: model.RoleEditor})
+
+	now := time.Now()
+	blog, err := h.queries.CreateSite(ctx, store.CreateSiteParams{Name: "Blog", Host: "blog.example.com", CreatedAt: now, UpdatedAt: now})
+	if err != nil {
+		t.Fatalf("CreateSite: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		siteIDs map[int64]bool
+		want    []int64
+	}{
+		{"selected site", map[int64]bool{blog.ID: true}, []int64{blog.ID}},
+		{"no selection", map[int64]bool{}, []int64{store.DefaultSiteID}},
+		{"deleted site only", map[int64]bool{blog.ID + 1: true}, []int64{store.DefaultSiteID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := h.saveUserSites(ctx, editor.ID, tt.siteIDs); err != nil {
+				t.Fatalf("saveUserSites: %v", err)
+			}
+			ids, err := h.queries.ListUserSiteIDs(ctx, editor.ID)
+			if err != nil {
+				t.Fatalf("ListUserSiteIDs: %v", err)
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(tt.want) {
+				t.Errorf("user sites = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/webhook"
+)
+
+// dispatchWebhookEvent dispatches a webhook event through d and logs failures.
+// A nil dispatcher is a no-op so handlers work without webhooks wired in.
+// logAttrs are appended to the failure log entry (e.g. "tag_id", id).
+func dispatchWebhookEvent(ctx context.Context, d *webhook.Dispatcher, eventType string, data any, logAttrs ...any) {
+	if d == nil {
+		return
+	}
+
+	if err := d.DispatchEvent(ctx, eventType, data); err != nil {
+		attrs := append([]any{"error", err, "event_type", eventType}, logAttrs...)
+		slog.Error("failed to dispatch webhook event", attrs...)
+	}
+}
+
+// dispatchTranslationLinked dispatches translation.linked after a Translate
+// action created a new translation of sourceID.
+func dispatchTranslationLinked(ctx context.Context, d *webhook.Dispatcher, entityType string, sourceID, translationID int64, languageCode string) {
+	dispatchWebhookEvent(ctx, d, model.EventTranslationLinked, webhook.TranslationEventData{
+		EntityType:    entityType,
+		EntityID:      sourceID,
+		TranslationID: translationID,
+		LanguageCode:  languageCode,
+	}, "entity_type", entityType, "entity_id", sourceID)
+}
@@ -44,6 +44,7 @@ type WebhooksHandler struct {
 	renderer       *render.Renderer
 	sessionManager *scs.SessionManager
 	eventService   *service.EventService
+	dispatcher     *webhookpkg.Dispatcher
 }
 
 // NewWebhooksHandler creates a new WebhooksHandler.
@@ -56,10 +57,16 @@ func NewWebhooksHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionMa
 	}
 }
 
+// SetDispatcher sets the webhook dispatcher used to send test events.
+func (h *WebhooksHandler) SetDispatcher(d *webhookpkg.Dispatcher) {
+	h.dispatcher = d
+}
+
 // WebhooksListData holds data for the webhooks list template.
 type WebhooksListData struct {
-	Webhooks      []WebhookWithStats
-	TotalWebhooks int64
+	Webhooks        []WebhookWithStats
+	TotalWebhooks   int64
+	TotalDeadLetter int64
 }
 
 // WebhookWithStats includes webhook data and delivery stats.
@@ -90,10 +97,13 @@ type WebhookFormData struct {
 
 // WebhookDeliveriesData holds data for the deliveries template.
 type WebhookDeliveriesData struct {
-	Webhook    store.Webhook
-	Deliveries []store.WebhookDelivery
-	TotalCount int64
-	Pagination AdminPagination
+	Webhook      store.Webhook
+	Deliveries   []store.WebhookDelivery
+	TotalCount   int64
+	Pagination   AdminPagination
+	FilterStatus string
+	FilterEvent  string
+	EventOptions []string
 }
 
 // List handles GET /admin/webhooks - displays all webhooks.
@@ -186,9 +196,15 @@ func (h *WebhooksHandler) List(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
+	totalDead, err := h.queries.CountDeadDeliveries(r.Context(), store.CountDeadDeliveriesParams{})
+	if err != nil {
+		slog.Error("failed to count dead deliveries", "error", err)
+	}
+
 	data := WebhooksListData{
-		Webhooks:      webhooksWithStats,
-		TotalWebhooks: totalWebhooks,
+		Webhooks:        webhooksWithStats,
+		TotalWebhooks:   totalWebhooks,
+		TotalDeadLetter: totalDead,
 	}
 
 	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "nav.webhooks"), webhooksBreadcrumbs(lang))
@@ -265,6 +281,9 @@ func (h *WebhooksHandler) Create(w http.ResponseWriter, r *http.Request) {
 		CreatedBy: middleware.GetUserID(r),
 		CreatedAt: now,
 		UpdatedAt: now,
+
+		PayloadFormat:   input.PayloadFormat,
+		PayloadTemplate: input.PayloadTemplate,
 	})
 	if err != nil {
 		slog.Error("failed to create webhook", "error", err)
@@ -309,9 +328,11 @@ func (h *WebhooksHandler) EditForm(w http.ResponseWriter, r *http.Request) {
 	events, headers := parseWebhookStoredData(webhook)
 
 	formValues := map[string]string{
-		"name":   webhook.Name,
-		"url":    webhook.Url,
-		"secret": webhook.Secret,
+		"name":             webhook.Name,
+		"url":              webhook.Url,
+		"secret":           webhook.Secret,
+		"payload_format":   webhook.PayloadFormat,
+		"payload_template": webhook.PayloadTemplate,
 	}
 	if webhook.IsActive {
 		formValues["is_active"] = "1"
@@ -389,6 +410,9 @@ func (h *WebhooksHandler) Update(w http.ResponseWriter, r *http.Request) {
 		IsActive:  input.IsActive,
 		Headers:   model.HeadersToJSON(input.Headers),
 		UpdatedAt: now,
+
+		PayloadFormat:   input.PayloadFormat,
+		PayloadTemplate: input.PayloadTemplate,
 	})
 	if err != nil {
 		slog.Error("failed to update webhook", "error", err)
@@ -500,9 +524,18 @@ func (h *WebhooksHandler) Deliveries(w http.ResponseWriter, r *http.Request) {
 	}
 
 	page := ParsePageParam(r)
+	filterStatus := r.URL.Query().Get("status")
+	if !isValidDeliveryStatus(filterStatus) {
+		filterStatus = ""
+	}
+	filterEvent := strings.TrimSpace(r.URL.Query().Get("event"))
 
 	// Get total count
-	totalCount, err := h.queries.CountWebhookDeliveries(r.Context(), id)
+	totalCount, err := h.queries.CountWebhookDeliveriesFiltered(r.Context(), store.CountWebhookDeliveriesFilteredParams{
+		WebhookID: id,
+		Status:    filterStatus,
+		Event:     filterEvent,
+	})
 	if err != nil {
 		logAndInternalError(w, "failed to count deliveries", "error", err)
 		return
@@ -513,8 +546,10 @@ func (h *WebhooksHandler) Deliveries(w http.ResponseWriter, r *http.Request) {
 	offset := int64((page - 1) * DeliveriesPerPage)
 
 	// Get deliveries
-	deliveries, err := h.queries.ListWebhookDeliveries(r.Context(), store.ListWebhookDeliveriesParams{
+	deliveries, err := h.queries.ListWebhookDeliveriesFiltered(r.Context(), store.ListWebhookDeliveriesFilteredParams{
 		WebhookID: id,
+		Status:    filterStatus,
+		Event:     filterEvent,
 		Limit:     DeliveriesPerPage,
 		Offset:    offset,
 	})
@@ -523,11 +558,20 @@ func (h *WebhooksHandler) Deliveries(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	eventOptions, err := h.queries.ListWebhookDeliveryEvents(r.Context(), id)
+	if err != nil {
+		logAndInternalError(w, "failed to list delivery events", "error", err)
+		return
+	}
+
 	data := WebhookDeliveriesData{
-		Webhook:    webhook,
-		Deliveries: deliveries,
-		TotalCount: totalCount,
-		Pagination: BuildAdminPagination(page, int(totalCount), DeliveriesPerPage, fmt.Sprintf(redirectAdminWebhooksIDDeliveries, id), r.URL.Query()),
+		Webhook:      webhook,
+		Deliveries:   deliveries,
+		TotalCount:   totalCount,
+		Pagination:   BuildAdminPagination(page, int(totalCount), DeliveriesPerPage, fmt.Sprintf(redirectAdminWebhooksIDDeliveries, id), r.URL.Query()),
+		FilterStatus: filterStatus,
+		FilterEvent:  filterEvent,
+		EventOptions: eventOptions,
 	}
 
 	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "webhooks.deliveries_title"), webhookDeliveriesBreadcrumbs(lang, webhook))
@@ -535,8 +579,11 @@ func (h *WebhooksHandler) Deliveries(w http.ResponseWriter, r *http.Request) {
 	renderTempl(w, r, adminviews.WebhookDeliveriesPage(pc, viewData))
 }
 
-// Test handles POST /admin/webhooks/{id}/test - sends a test event.
+// Test handles POST /admin/webhooks/{id}/test - sends a test event synchronously
+// and shows the rendered body and the endpoint's response.
 func (h *WebhooksHandler) Test(w http.ResponseWriter, r *http.Request) {
+	lang := middleware.GetAdminLang(r)
+
 	id, err := ParseIDParam(r)
 	if err != nil {
 		flashError(w, r, h.renderer, redirectAdminWebhooks, "Invalid webhook ID")
@@ -548,39 +595,32 @@ func (h *WebhooksHandler) Test(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Create a test payload
-	testPayload := map[string]interface{}{
-		"type":      "test",
-		"timestamp": time.Now().Format(time.RFC3339),
-		"data": map[string]interface{}{
-			"message":    "This is a test webhook delivery",
-			"webhook_id": webhook.ID,
-			"triggered_by": map[string]interface{}{
-				"user_id": middleware.GetUserID(r),
-				"email":   middleware.GetUserEmail(r),
-			},
-		},
+	if h.dispatcher == nil {
+		flashError(w, r, h.renderer, fmt.Sprintf(redirectAdminWebhooksIDDeliveries, id), "Webhook dispatcher is not available")
+		return
 	}
 
-	payloadBytes, _ := json.Marshal(testPayload)
-
-	// Create delivery record
-	now := time.Now()
-	_, err = h.queries.CreateWebhookDelivery(r.Context(), store.CreateWebhookDeliveryParams{
-		WebhookID: id,
-		Event:     "test",
-		Payload:   string(payloadBytes),
-		CreatedAt: now,
-		UpdatedAt: now,
+	event := webhookpkg.NewEvent("test", map[string]any{
+		"message":    "This is a test webhook delivery",
+		"webhook_id": webhook.ID,
+		"triggered_by": map[string]any{
+			"user_id": middleware.GetUserID(r),
+			"email":   middleware.GetUserEmail(r),
+		},
 	})
+
+	result, err := h.dispatcher.SendTest(r.Context(), webhook, event)
 	if err != nil {
-		slog.Error("failed to create test delivery", "error", err)
-		flashError(w, r, h.renderer, fmt.Sprintf(redirectAdminWebhooksID, id), "Error creating test delivery")
+		slog.Error("failed to send test webhook", "error", err, "webhook_id", id)
+		flashError(w, r, h.renderer, fmt.Sprintf(redirectAdminWebhooksIDDeliveries, id), "Error creating test delivery")
 		return
 	}
 
-	slog.Info("test webhook created", "webhook_id", id, "triggered_by", middleware.GetUserID(r))
-	flashSuccess(w, r, h.renderer, fmt.Sprintf(redirectAdminWebhooksIDDeliveries, id), "Test event queued for delivery")
+	slog.Info("test webhook sent", "webhook_id", id, "success", result.Success,
+		"status_code", result.StatusCode, "triggered_by", middleware.GetUserID(r))
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "webhooks.test_result_title"), webhookTestBreadcrumbs(lang, webhook))
+	renderTempl(w, r, adminviews.WebhookTestResultPage(pc, convertWebhookTestResultViewData(webhook, result)))
 }
 
 // RetryDelivery handles POST /admin/webhooks/{id}/deliveries/{did}/retry - retries a delivery.
@@ -599,6 +639,30 @@ func (h *WebhooksHandler) RetryDelivery(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	delivery, err := h.queries.GetWebhookDelivery(r.Context(), deliveryID)
+	if err != nil || delivery.WebhookID != webhookID {
+		flashError(w, r, h.renderer, fmt.Sprintf(redirectAdminWebhooksIDDeliveries, webhookID), "Delivery not found")
+		return
+	}
+
+	// Replay through the dispatcher so the delivery is sent right away;
+	// without one, reset it and let the retry worker pick it up.
+	if h.dispatcher != nil {
+		replayed, err := h.dispatcher.Replay(r.Context(), []int64{deliveryID})
+		if err != nil {
+			slog.Error("failed to replay delivery", "error", err)
+			flashError(w, r, h.renderer, fmt.Sprintf(redirectAdminWebhooksIDDeliveries, webhookID), "Error resetting delivery")
+			return
+		}
+		if replayed == 0 {
+			flashError(w, r, h.renderer, fmt.Sprintf(redirectAdminWebhooksIDDeliveries, webhookID), "Delivery cannot be replayed while the webhook is inactive or paused")
+			return
+		}
+		slog.Info("delivery replayed", "delivery_id", deliveryID, "webhook_id", webhookID, "reset_by", middleware.GetUserID(r))
+		flashSuccess(w, r, h.renderer, fmt.Sprintf(redirectAdminWebhooksIDDeliveries, webhookID), "Delivery queued for retry")
+		return
+	}
+
 	// Reset delivery for retry
 	now := time.Now()
 	err = h.queries.ResetDeliveryForRetry(r.Context(), store.ResetDeliveryForRetryParams{
@@ -643,6 +707,9 @@ type webhookFormInput struct {
 	Events   []string
 	IsActive bool
 	Headers  map[string]string
+
+	PayloadFormat   string
+	PayloadTemplate string
 }
 
 // parseWebhookFormInput extracts and parses webhook form values.
@@ -664,6 +731,14 @@ func parseWebhookFormInput(r *http.Request) webhookFormInput {
 		}
 	}
 
+	payloadFormat := strings.TrimSpace(r.FormValue("payload_format"))
+	if payloadFormat == "" {
+		payloadFormat = model.WebhookFormatJSON
+	}
+	// The template is kept for other formats too so switching back to
+	// "custom" does not lose it; it is only validated and used for "custom".
+	payloadTemplate := strings.TrimSpace(r.FormValue("payload_template"))
+
 	return webhookFormInput{
 		Name:     name,
 		URL:      webhookURL,
@@ -671,15 +746,20 @@ func parseWebhookFormInput(r *http.Request) webhookFormInput {
 		Events:   events,
 		IsActive: isActive,
 		Headers:  headers,
+
+		PayloadFormat:   payloadFormat,
+		PayloadTemplate: payloadTemplate,
 	}
 }
 
 // toFormValues converts webhookFormInput to a map for form re-rendering.
 func (input webhookFormInput) toFormValues() map[string]string {
 	formValues := map[string]string{
-		"name":   input.Name,
-		"url":    input.URL,
-		"secret": input.Secret,
+		"name":             input.Name,
+		"url":              input.URL,
+		"secret":           input.Secret,
+		"payload_format":   input.PayloadFormat,
+		"payload_template": input.PayloadTemplate,
 	}
 	if input.IsActive {
 		formValues["is_active"] = "1"
@@ -742,6 +822,14 @@ func validateWebhookForm(input webhookFormInput, validateEvents bool) map[string
 		validationErrors["headers"] = err.Error()
 	}
 
+	if input.PayloadFormat != "" && !model.IsValidWebhookFormat(input.PayloadFormat) {
+		validationErrors["payload_format"] = "Invalid payload format"
+	} else if input.PayloadFormat == model.WebhookFormatCustom {
+		if err := webhookpkg.ValidatePayloadTemplate(input.PayloadTemplate); err != nil {
+			validationErrors["payload_template"] = err.Error()
+		}
+	}
+
 	return validationErrors
 }
 
@@ -0,0 +1,233 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/i18n"
+	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+)
+
+// maxReplayAll caps how many dead deliveries a single "replay all" request
+// resets, so a large backlog does not flood the delivery queue at once.
+const maxReplayAll = 500
+
+// WebhookDeadLettersData holds data for the dead-letter queue template.
+type WebhookDeadLettersData struct {
+	Deliveries    []store.ListDeadDeliveriesWithWebhookRow
+	TotalCount    int64
+	Pagination    AdminPagination
+	Webhooks      []store.Webhook
+	EventOptions  []string
+	FilterWebhook int64
+	FilterEvent   string
+}
+
+// deadLetterFilter holds the dead-letter queue filters from a request.
+type deadLetterFilter struct {
+	WebhookID int64
+	Event     string
+}
+
+// parseDeadLetterFilter reads the webhook and event filters from query or form values.
+func parseDeadLetterFilter(r *http.Request) deadLetterFilter {
+	webhookID, _ := strconv.ParseInt(r.FormValue("webhook"), 10, 64)
+	if webhookID < 0 {
+		webhookID = 0
+	}
+	return deadLetterFilter{
+		WebhookID: webhookID,
+		Event:     strings.TrimSpace(r.FormValue("event")),
+	}
+}
+
+// redirectURL returns the dead-letter page URL preserving the filters.
+func (f deadLetterFilter) redirectURL() string {
+	q := url.Values{}
+	if f.WebhookID > 0 {
+		q.Set("webhook", strconv.FormatInt(f.WebhookID, 10))
+	}
+	if f.Event != "" {
+		q.Set("event", f.Event)
+	}
+	if len(q) == 0 {
+		return redirectAdminWebhooksDeadLetters
+	}
+	return redirectAdminWebhooksDeadLetters + "?" + q.Encode()
+}
+
+// DeadLetters handles GET /admin/webhooks/dead-letters - lists deliveries
+// that exhausted their retries or were parked while a webhook was paused.
+func (h *WebhooksHandler) DeadLetters(w http.ResponseWriter, r *http.Request) {
+	lang := middleware.GetAdminLang(r)
+	filter := parseDeadLetterFilter(r)
+	page := ParsePageParam(r)
+
+	totalCount, err := h.queries.CountDeadDeliveries(r.Context(), store.CountDeadDeliveriesParams{
+		WebhookID: filter.WebhookID,
+		Event:     filter.Event,
+	})
+	if err != nil {
+		logAndInternalError(w, "failed to count dead deliveries", "error", err)
+		return
+	}
+
+	page, _ = NormalizePagination(page, int(totalCount), DeliveriesPerPage)
+	deliveries, err := h.queries.ListDeadDeliveriesWithWebhook(r.Context(), store.ListDeadDeliveriesWithWebhookParams{
+		WebhookID: filter.WebhookID,
+		Event:     filter.Event,
+		Limit:     DeliveriesPerPage,
+		Offset:    int64((page - 1) * DeliveriesPerPage),
+	})
+	if err != nil {
+		logAndInternalError(w, "failed to list dead deliveries", "error", err)
+		return
+	}
+
+	webhooks, err := h.queries.ListWebhooks(r.Context())
+	if err != nil {
+		logAndInternalError(w, "failed to list webhooks", "error", err)
+		return
+	}
+
+	eventOptions, err := h.queries.ListDeadDeliveryEvents(r.Context())
+	if err != nil {
+		logAndInternalError(w, "failed to list dead delivery events", "error", err)
+		return
+	}
+
+	data := WebhookDeadLettersData{
+		Deliveries:    deliveries,
+		TotalCount:    totalCount,
+		Pagination:    BuildAdminPagination(page, int(totalCount), DeliveriesPerPage, redirectAdminWebhooksDeadLetters, r.URL.Query()),
+		Webhooks:      webhooks,
+		EventOptions:  eventOptions,
+		FilterWebhook: filter.WebhookID,
+		FilterEvent:   filter.Event,
+	}
+
+	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "webhooks.dead_letters_title"), webhookDeadLettersBreadcrumbs(lang))
+	renderTempl(w, r, adminviews.WebhookDeadLettersPage(pc, convertWebhookDeadLettersViewData(data)))
+}
+
+// ReplayDeadLetters handles POST /admin/webhooks/dead-letters/replay - replays
+// the selected dead deliveries, or every delivery matching the filters when
+// "all" is set.
+func (h *WebhooksHandler) ReplayDeadLetters(w http.ResponseWriter, r *http.Request) {
+	if !parseFormOrRedirect(w, r, h.renderer, redirectAdminWebhooksDeadLetters) {
+		return
+	}
+	filter := parseDeadLetterFilter(r)
+	redirectURL := filter.redirectURL()
+
+	if h.dispatcher == nil {
+		flashError(w, r, h.renderer, redirectURL, "Webhook dispatcher is not available")
+		return
+	}
+
+	var ids []int64
+	if r.FormValue("all") == "1" {
+		var err error
+		ids, err = h.queries.ListDeadDeliveryIDs(r.Context(), store.ListDeadDeliveryIDsParams{
+			WebhookID: filter.WebhookID,
+			Event:     filter.Event,
+			Limit:     maxReplayAll,
+		})
+		if err != nil {
+			slog.Error("failed to list dead deliveries", "error", err)
+			flashError(w, r, h.renderer, redirectURL, "Error replaying deliveries")
+			return
+		}
+	} else {
+		for _, raw := range r.Form["ids"] {
+			if id, err := strconv.ParseInt(raw, 10, 64); err == nil && id > 0 {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	if len(ids) == 0 {
+		flashError(w, r, h.renderer, redirectURL, "No deliveries selected")
+		return
+	}
+
+	replayed, err := h.dispatcher.Replay(r.Context(), ids)
+	if err != nil {
+		slog.Error("failed to replay deliveries", "error", err, "replayed", replayed)
+		flashError(w, r, h.renderer, redirectURL, "Error replaying deliveries")
+		return
+	}
+
+	slog.Info("dead deliveries replayed", "requested", len(ids), "replayed", replayed, "replayed_by", middleware.GetUserID(r))
+	_ = h.eventService.LogWebhookEvent(r.Context(), model.EventLevelInfo, "Dead webhook deliveries replayed",
+		middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+		map[string]any{"requested": len(ids), "replayed": replayed})
+
+	msg := fmt.Sprintf("%d of %d deliveries queued for replay", replayed, len(ids))
+	if skipped := len(ids) - replayed; skipped > 0 {
+		msg += fmt.Sprintf(" (%d skipped: webhook inactive or paused)", skipped)
+	}
+	flashAndRedirect(w, r, h.renderer, redirectURL, msg, "success")
+}
+
+// Resume handles POST /admin/webhooks/{id}/resume - closes the circuit
+// breaker of a paused webhook so it receives events again.
+func (h *WebhooksHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	if demoGuard(w, r, h.renderer, middleware.RestrictionWebhooks, redirectAdminWebhooks) {
+		return
+	}
+
+	id, err := ParseIDParam(r)
+	if err != nil {
+		flashError(w, r, h.renderer, redirectAdminWebhooks, "Invalid webhook ID")
+		return
+	}
+
+	webhook, ok := h.requireWebhookWithRedirect(w, r, id)
+	if !ok {
+		return
+	}
+
+	if !webhook.PausedAt.Valid {
+		flashAndRedirect(w, r, h.renderer, redirectAdminWebhooks, "Webhook is not paused", "info")
+		return
+	}
+
+	if err := h.queries.ResumeWebhook(r.Context(), store.ResumeWebhookParams{
+		UpdatedAt: time.Now(),
+		ID:        id,
+	}); err != nil {
+		slog.Error("failed to resume webhook", "error", err, "webhook_id", id)
+		flashError(w, r, h.renderer, redirectAdminWebhooks, "Error resuming webhook")
+		return
+	}
+
+	slog.Info("webhook resumed", "webhook_id", id, "resumed_by", middleware.GetUserID(r))
+	_ = h.eventService.LogWebhookEvent(r.Context(), model.EventLevelInfo, "Webhook resumed",
+		middleware.GetUserIDPtr(r), middleware.GetClientIP(r), middleware.GetRequestURL(r),
+		map[string]any{"webhook_id": id, "name": webhook.Name, "pause_reason": webhook.PauseReason})
+
+	flashSuccess(w, r, h.renderer, deadLetterFilter{WebhookID: id}.redirectURL(), "Webhook resumed. Replay its dead deliveries to resend missed events.")
+}
+
+// isValidDeliveryStatus reports whether status is a known delivery status.
+func isValidDeliveryStatus(status string) bool {
+	switch status {
+	case model.DeliveryStatusPending, model.DeliveryStatusDelivered,
+		model.DeliveryStatusFailed, model.DeliveryStatusDead:
+		return true
+	default:
+		return false
+	}
+}
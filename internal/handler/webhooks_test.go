@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/store"
 	webhookpkg "github.com/olegiv/ocms-go/internal/webhook"
 )
@@ -471,3 +472,78 @@ func TestValidateWebhookForm_RejectsWhenAllowlistRequiredButMissing(t *testing.T
 		t.Fatal("validateWebhookForm() expected URL error when allowlist is required but missing")
 	}
 }
+
+func TestValidateWebhookForm_PayloadTemplate(t *testing.T) {
+	base := webhookFormInput{
+		Name:     "Webhook",
+		URL:      "https://hooks.example.com/webhook",
+		Secret:   "secret",
+		Events:   []string{"page.created"},
+		IsActive: true,
+		Headers:  map[string]string{},
+	}
+
+	tests := []struct {
+		name     string
+		format   string
+		template string
+		field    string
+	}{
+		{"default format", "", "", ""},
+		{"preset ignores template", model.WebhookFormatSlack, "not json", ""},
+		{"valid custom", model.WebhookFormatCustom, `{"event": {{ json .Type }}}`, ""},
+		{"custom requires template", model.WebhookFormatCustom, "", "payload_template"},
+		{"custom must render JSON", model.WebhookFormatCustom, `event {{ .Type }}`, "payload_template"},
+		{"unknown format", "xml", "", "payload_format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := base
+			input.PayloadFormat = tt.format
+			input.PayloadTemplate = tt.template
+
+			errs := validateWebhookForm(input, true)
+			delete(errs, "url") // destination policy is covered elsewhere
+			if tt.field == "" {
+				if len(errs) != 0 {
+					t.Errorf("validateWebhookForm() unexpected errors: %v", errs)
+				}
+				return
+			}
+			if errs[tt.field] == "" {
+				t.Errorf("validateWebhookForm() expected %s error, got %v", tt.field, errs)
+			}
+		})
+	}
+}
+
+func TestDeadLetterFilterRedirectURL(t *testing.T) {
+	tests := []struct {
+		filter deadLetterFilter
+		want   string
+	}{
+		{deadLetterFilter{}, "/admin/webhooks/dead-letters"},
+		{deadLetterFilter{WebhookID: 3}, "/admin/webhooks/dead-letters?webhook=3"},
+		{deadLetterFilter{WebhookID: 3, Event: "page.created"}, "/admin/webhooks/dead-letters?event=page.created&webhook=3"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.filter.redirectURL(); got != tt.want {
+			t.Errorf("redirectURL() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestIsValidDeliveryStatus(t *testing.T) {
+	for _, status := range []string{"pending", "delivered", "failed", "dead"} {
+		if !isValidDeliveryStatus(status) {
+			t.Errorf("isValidDeliveryStatus(%q) = false, want true", status)
+		}
+	}
+	for _, status := range []string{"", "DEAD", "queued"} {
+		if isValidDeliveryStatus(status) {
+			t.Errorf("isValidDeliveryStatus(%q) = true, want false", status)
+		}
+	}
+}
@@ -4,10 +4,13 @@
 package handler
 
 import (
+	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/alexedwards/scs/v2"
@@ -19,19 +22,23 @@ import (
 	"github.com/olegiv/ocms-go/internal/theme"
 	"github.com/olegiv/ocms-go/internal/util"
 	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
+	"github.com/olegiv/ocms-go/internal/widget"
 )
 
 // WidgetsHandler handles widget management routes.
 type WidgetsHandler struct {
+	db             *sql.DB
 	queries        *store.Queries
 	renderer       *render.Renderer
 	sessionManager *scs.SessionManager
 	themeManager   *theme.Manager
+	moduleWidgets  ModuleWidgetTypesProvider
 }
 
 // NewWidgetsHandler creates a new WidgetsHandler.
 func NewWidgetsHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionManager, tm *theme.Manager) *WidgetsHandler {
 	return &WidgetsHandler{
+		db:             db,
 		queries:        store.New(db),
 		renderer:       renderer,
 		sessionManager: sm,
@@ -39,18 +46,71 @@ func NewWidgetsHandler(db *sql.DB, renderer *render.Renderer, sm *scs.SessionMan
 	}
 }
 
-// WidgetTypes defines available widget types.
-var WidgetTypes = []struct {
-	ID          string
-	Name        string
-	Description string
-}{
-	{ID: "text", Name: "Text/HTML", Description: "Custom text or HTML content"},
-	{ID: "recent_posts", Name: "Recent Posts", Description: "Display recent blog posts"},
+// ModuleWidgetTypesProvider returns widget types from active modules.
+// Calling this per-request ensures toggled modules are reflected immediately.
+type ModuleWidgetTypesProvider interface {
+	AllWidgetTypes() []widget.Type
+}
+
+// SetModuleWidgetTypesProvider sets the provider used to fetch module widget
+// types per-request.
+func (h *WidgetsHandler) SetModuleWidgetTypesProvider(p ModuleWidgetTypesProvider) {
+	h.moduleWidgets = p
+}
+
+// WidgetTypes defines the core widget types. Their renderers are bound by
+// the frontend handler.
+var WidgetTypes = []widget.Type{
+	{ID: "text", Name: "Text/HTML", Description: "Custom text or HTML content", Content: true},
+	{ID: "recent_posts", Name: "Recent Posts", Description: "Display recent blog posts", Settings: []widget.Setting{
+		{Key: "limit", Label: "Number of posts", Kind: widget.SettingNumber, Default: "5"},
+	}},
 	{ID: "categories", Name: "Categories", Description: "Display category list"},
-	{ID: "tags", Name: "Tags", Description: "Display tag cloud"},
+	{ID: "tags", Name: "Tags", Description: "Display tag cloud", Settings: []widget.Setting{
+		{Key: "limit", Label: "Number of tags", Kind: widget.SettingNumber, Default: "20"},
+	}},
 	{ID: "search", Name: "Search", Description: "Search form widget"},
-	{ID: "custom_menu", Name: "Custom Menu", Description: "Display a navigation menu"},
+	{ID: "custom_menu", Name: "Custom Menu", Description: "Display a navigation menu", Settings: []widget.Setting{
+		{Key: "menu", Label: "Menu slug", Kind: widget.SettingText, Help: "e.g. footer"},
+	}},
+	{ID: "form", Name: "Form", Description: "Link to a public form", Settings: []widget.Setting{
+		{Key: "form", Label: "Form slug", Kind: widget.SettingText},
+		{Key: "label", Label: "Link label", Kind: widget.SettingText, Help: "Defaults to the form title"},
+	}},
+	{ID: "featured_media", Name: "Featured Media", Description: "Display an image from the media library", Settings: []widget.Setting{
+		{Key: "media_id", Label: "Media ID", Kind: widget.SettingNumber},
+		{Key: "link", Label: "Link URL", Kind: widget.SettingText},
+		{Key: "caption", Label: "Caption", Kind: widget.SettingText},
+	}},
+	{ID: "gallery", Name: "Gallery", Description: "Display the images of a media folder", Settings: []widget.Setting{
+		{Key: "folder", Label: "Folder ID", Kind: widget.SettingNumber},
+		{Key: "limit", Label: "Number of images", Kind: widget.SettingNumber, Default: "6"},
+		{Key: "caption", Label: "Caption", Kind: widget.SettingText},
+	}},
+	{ID: "archives", Name: "Archives", Description: "Display posts by month", Settings: []widget.Setting{
+		{Key: "limit", Label: "Number of months", Kind: widget.SettingNumber, Default: "12"},
+	}},
+	{ID: "language_switcher", Name: "Language Switcher", Description: "Links to the site in other languages"},
+	{ID: "module_output", Name: "Module Output", Description: "Raw HTML of a module template function", Settings: []widget.Setting{
+		{Key: "func", Label: "Template function", Kind: widget.SettingText, Help: "e.g. privacyFooterLink"},
+	}},
+}
+
+// availableWidgetTypes returns the core widget types followed by those of
+// active modules. Module types cannot replace core types.
+func availableWidgetTypes(p ModuleWidgetTypesProvider) []widget.Type {
+	types := slices.Clone(WidgetTypes)
+	if p == nil {
+		return types
+	}
+	for _, t := range p.AllWidgetTypes() {
+		if slices.ContainsFunc(WidgetTypes, func(core widget.Type) bool { return core.ID == t.ID }) {
+			slog.Warn("ignoring module widget type that shadows a core type", "widget_type", t.ID)
+			continue
+		}
+		types = append(types, t)
+	}
+	return types
 }
 
 // WidgetAreaWithWidgets represents a widget area with its widgets.
@@ -63,12 +123,12 @@ type WidgetAreaWithWidgets struct {
 type WidgetsListData struct {
 	Theme       *theme.Theme
 	WidgetAreas []WidgetAreaWithWidgets
-	WidgetTypes []struct {
-		ID          string
-		Name        string
-		Description string
-	}
-	AllThemes []theme.Info
+	WidgetTypes []widget.Type
+	AllThemes   []theme.Info
+	// Choices of the visibility rules.
+	PageTypes  []string
+	Categories []store.Category
+	Languages  []store.Language
 }
 
 // List handles GET /admin/widgets - displays widget management page.
@@ -76,7 +136,7 @@ func (h *WidgetsHandler) List(w http.ResponseWriter, r *http.Request) {
 	lang := h.renderer.GetAdminLang(r)
 
 	// Get active theme
-	activeTheme := h.themeManager.GetActiveTheme()
+	activeTheme := siteTheme(r.Context(), h.themeManager)
 	if activeTheme == nil {
 		flashError(w, r, h.renderer, redirectAdmin, "No active theme found")
 		return
@@ -89,9 +149,13 @@ func (h *WidgetsHandler) List(w http.ResponseWriter, r *http.Request) {
 		widgets = []store.Widget{}
 	}
 
-	// Group widgets by area
+	// Group widgets of the current site by area
+	siteID := store.SiteIDFromContext(r.Context())
 	widgetsByArea := make(map[string][]store.Widget)
 	for _, w := range widgets {
+		if w.SiteID != siteID {
+			continue
+		}
 		widgetsByArea[w.Area] = append(widgetsByArea[w.Area], w)
 	}
 
@@ -107,8 +171,13 @@ func (h *WidgetsHandler) List(w http.ResponseWriter, r *http.Request) {
 	data := WidgetsListData{
 		Theme:       activeTheme,
 		WidgetAreas: widgetAreas,
-		WidgetTypes: WidgetTypes,
+		WidgetTypes: availableWidgetTypes(h.moduleWidgets),
 		AllThemes:   h.themeManager.ListThemesWithActive(),
+		PageTypes:   h.visibilityPageTypes(r.Context()),
+		Languages:   ListActiveLanguagesWithFallback(r.Context(), h.queries),
+	}
+	if data.Categories, err = h.queries.ListCategories(r.Context()); err != nil {
+		slog.Error("failed to list categories", "error", err)
 	}
 
 	pc := buildPageContext(r, h.sessionManager, h.renderer, i18n.T(lang, "nav.widgets"), widgetsBreadcrumbs(lang))
@@ -124,6 +193,8 @@ type CreateWidgetRequest struct {
 	Title      string `json:"title"`
 	Content    string `json:"content"`
 	Settings   string `json:"settings"`
+	// Visibility limits the pages the widget is shown on.
+	Visibility *widget.Visibility `json:"visibility"`
 }
 
 // Create handles POST /admin/widgets - creates a new widget.
@@ -151,6 +222,11 @@ func (h *WidgetsHandler) Create(w http.ResponseWriter, r *http.Request) {
 		writeJSONError(w, http.StatusBadRequest, "Widget type is required")
 		return
 	}
+	settings, visibility, errMsg := h.validateWidget(req.WidgetType, req.Settings, req.Visibility)
+	if errMsg != "" {
+		writeJSONError(w, http.StatusBadRequest, errMsg)
+		return
+	}
 
 	// Get default language for widget creation
 	defaultLang, err := h.queries.GetDefaultLanguage(r.Context())
@@ -162,26 +238,42 @@ func (h *WidgetsHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	maxPos := h.getMaxWidgetPosition(r, req.Theme, req.Area)
 
-	widget, err := h.queries.CreateWidget(r.Context(), store.CreateWidgetParams{
+	tx, err := h.db.BeginTx(r.Context(), nil)
+	if err != nil {
+		slog.Error("failed to begin transaction", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "Error creating widget")
+		return
+	}
+	defer func() { _ = tx.Rollback() }()
+	qtx := h.queries.WithTx(tx)
+
+	created, err := qtx.CreateWidget(r.Context(), store.CreateWidgetParams{
 		Theme:        req.Theme,
 		Area:         req.Area,
 		WidgetType:   req.WidgetType,
 		Title:        util.NullStringFromValue(req.Title),
 		Content:      util.NullStringFromValue(req.Content),
-		Settings:     util.NullStringFromValue(req.Settings),
+		Settings:     util.NullStringFromValue(settings),
 		Position:     maxPos + 1,
 		IsActive:     1,
 		LanguageCode: defaultLang.Code,
+		SiteID:       store.SiteIDFromContext(r.Context()),
 	})
+	if err == nil {
+		err = saveWidgetVisibility(r.Context(), qtx, created.ID, visibility)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
 	if err != nil {
 		slog.Error("failed to create widget", "error", err)
 		writeJSONError(w, http.StatusInternalServerError, "Error creating widget")
 		return
 	}
 
-	slog.Info("widget created", "widget_id", widget.ID, "theme", req.Theme, "area", req.Area)
+	slog.Info("widget created", "widget_id", created.ID, "theme", req.Theme, "area", req.Area)
 
-	writeJSONSuccess(w, map[string]any{"widget": widget})
+	writeJSONSuccess(w, map[string]any{"widget": created, "visibility": visibility})
 }
 
 // UpdateWidgetRequest represents the JSON request for updating a widget.
@@ -191,6 +283,8 @@ type UpdateWidgetRequest struct {
 	Content    string `json:"content"`
 	Settings   string `json:"settings"`
 	IsActive   bool   `json:"is_active"`
+	// Visibility replaces the visibility rules; nil keeps them.
+	Visibility *widget.Visibility `json:"visibility"`
 }
 
 // Update handles PUT /admin/widgets/{id} - updates a widget.
@@ -205,7 +299,7 @@ func (h *WidgetsHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	widget, ok := h.requireWidgetWithJSONError(w, r, id)
+	current, ok := h.requireWidgetWithJSONError(w, r, id)
 	if !ok {
 		return
 	}
@@ -216,21 +310,42 @@ func (h *WidgetsHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	settings, visibility, errMsg := h.validateWidget(req.WidgetType, req.Settings, req.Visibility)
+	if errMsg != "" {
+		writeJSONError(w, http.StatusBadRequest, errMsg)
+		return
+	}
+
 	isActive := int64(0)
 	if req.IsActive {
 		isActive = 1
 	}
 
-	updatedWidget, err := h.queries.UpdateWidget(r.Context(), store.UpdateWidgetParams{
+	tx, err := h.db.BeginTx(r.Context(), nil)
+	if err != nil {
+		slog.Error("failed to begin transaction", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "Error updating widget")
+		return
+	}
+	defer func() { _ = tx.Rollback() }()
+	qtx := h.queries.WithTx(tx)
+
+	updatedWidget, err := qtx.UpdateWidget(r.Context(), store.UpdateWidgetParams{
 		ID:           id,
 		WidgetType:   req.WidgetType,
 		Title:        util.NullStringFromValue(req.Title),
 		Content:      util.NullStringFromValue(req.Content),
-		Settings:     util.NullStringFromValue(req.Settings),
-		Position:     widget.Position,
+		Settings:     util.NullStringFromValue(settings),
+		Position:     current.Position,
 		IsActive:     isActive,
-		LanguageCode: widget.LanguageCode,
+		LanguageCode: current.LanguageCode,
 	})
+	if err == nil && req.Visibility != nil {
+		err = saveWidgetVisibility(r.Context(), qtx, id, visibility)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
 	if err != nil {
 		slog.Error("failed to update widget", "error", err, "widget_id", id)
 		writeJSONError(w, http.StatusInternalServerError, "Error updating widget")
@@ -313,12 +428,21 @@ func (h *WidgetsHandler) GetWidget(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	widget, ok := h.requireWidgetWithJSONError(w, r, id)
+	found, ok := h.requireWidgetWithJSONError(w, r, id)
 	if !ok {
 		return
 	}
 
-	writeJSONSuccess(w, map[string]any{"widget": widget})
+	visibility := widget.Visibility{}
+	if rules, err := h.queries.GetWidgetVisibility(r.Context(), id); err == nil {
+		if visibility, err = widget.ParseVisibility(rules); err != nil {
+			slog.Warn("ignoring widget visibility", "widget_id", id, "error", err)
+		}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		slog.Error("failed to get widget visibility", "error", err, "widget_id", id)
+	}
+
+	writeJSONSuccess(w, map[string]any{"widget": found, "visibility": visibility})
 }
 
 // MoveWidgetRequest represents the JSON request for moving a widget to a different area.
@@ -338,7 +462,7 @@ func (h *WidgetsHandler) MoveWidget(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	widget, ok := h.requireWidgetWithJSONError(w, r, id)
+	current, ok := h.requireWidgetWithJSONError(w, r, id)
 	if !ok {
 		return
 	}
@@ -355,18 +479,18 @@ func (h *WidgetsHandler) MoveWidget(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	maxPos := h.getMaxWidgetPosition(r, widget.Theme, req.Area)
+	maxPos := h.getMaxWidgetPosition(r, current.Theme, req.Area)
 
 	// Update widget with new area and position
 	updatedWidget, err := h.queries.UpdateWidget(r.Context(), store.UpdateWidgetParams{
 		ID:           id,
-		WidgetType:   widget.WidgetType,
-		Title:        widget.Title,
-		Content:      widget.Content,
-		Settings:     widget.Settings,
+		WidgetType:   current.WidgetType,
+		Title:        current.Title,
+		Content:      current.Content,
+		Settings:     current.Settings,
 		Position:     maxPos + 1,
-		IsActive:     widget.IsActive,
-		LanguageCode: widget.LanguageCode,
+		IsActive:     current.IsActive,
+		LanguageCode: current.LanguageCode,
 	})
 	if err != nil {
 		slog.Error("failed to move widget", "error", err, "widget_id", id)
@@ -379,9 +503,69 @@ func (h *WidgetsHandler) MoveWidget(w http.ResponseWriter, r *http.Request) {
 	writeJSONSuccess(w, map[string]any{"widget": updatedWidget})
 }
 
+// validateWidget checks the type, settings and visibility rules of a widget.
+// It returns the settings to store and an error message for the client.
+func (h *WidgetsHandler) validateWidget(widgetType, rawSettings string, vis *widget.Visibility) (string, widget.Visibility, string) {
+	types := availableWidgetTypes(h.moduleWidgets)
+	i := slices.IndexFunc(types, func(t widget.Type) bool { return t.ID == widgetType })
+	if i < 0 {
+		return "", widget.Visibility{}, "Unknown widget type"
+	}
+
+	var settings map[string]string
+	if strings.TrimSpace(rawSettings) != "" {
+		var obj map[string]any
+		if err := json.Unmarshal([]byte(rawSettings), &obj); err != nil {
+			return "", widget.Visibility{}, "Settings must be a JSON object"
+		}
+		settings = widget.ParseSettings(rawSettings)
+	}
+	for k := range settings {
+		if !slices.ContainsFunc(types[i].Settings, func(s widget.Setting) bool { return s.Key == k }) {
+			delete(settings, k)
+		}
+	}
+
+	if vis == nil {
+		return widget.EncodeSettings(settings), widget.Visibility{}, ""
+	}
+	normalized, err := vis.Normalize()
+	if err != nil {
+		return "", widget.Visibility{}, "Invalid visibility rules: " + err.Error()
+	}
+	return widget.EncodeSettings(settings), normalized, ""
+}
+
+// saveWidgetVisibility stores the visibility rules of a widget. Empty rules
+// are removed, showing the widget everywhere.
+func saveWidgetVisibility(ctx context.Context, q *store.Queries, widgetID int64, vis widget.Visibility) error {
+	if vis.IsZero() {
+		return q.DeleteWidgetVisibility(ctx, widgetID)
+	}
+	return q.UpsertWidgetVisibility(ctx, store.UpsertWidgetVisibilityParams{
+		WidgetID: widgetID,
+		Rules:    vis.Encode(),
+	})
+}
+
+// visibilityPageTypes returns the page types offered by visibility rules:
+// listings, the built-in page types and content types.
+func (h *WidgetsHandler) visibilityPageTypes(ctx context.Context) []string {
+	types := append(slices.Clone(widget.ListingPageTypes), PageTypePage, PageTypePost)
+	contentTypes, err := h.queries.ListContentTypes(ctx)
+	if err != nil {
+		slog.Error("failed to list content types", "error", err)
+	}
+	for _, ct := range contentTypes {
+		types = append(types, ct.Slug)
+	}
+	return types
+}
+
 // requireWidgetWithJSONError fetches a widget by ID and returns JSON error on failure.
 func (h *WidgetsHandler) requireWidgetWithJSONError(w http.ResponseWriter, r *http.Request, id int64) (store.Widget, bool) {
 	widget, err := h.queries.GetWidget(r.Context(), id)
+	err = siteScopedErr(r.Context(), widget.SiteID, err)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			writeJSONError(w, http.StatusNotFound, "Widget not found")
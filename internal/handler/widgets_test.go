@@ -6,9 +6,18 @@ package handler
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/go-chi/chi/v5"
+
 	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/widget"
 )
 
 func TestNewWidgetsHandler(t *testing.T) {
@@ -70,6 +79,7 @@ func TestWidgetCreate(t *testing.T) {
 		Content:    sql.NullString{String: "<p>Hello World</p>", Valid: true},
 		Position:   0,
 		IsActive:   1,
+		SiteID:     store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateWidget failed: %v", err)
@@ -96,9 +106,9 @@ func TestWidgetList(t *testing.T) {
 
 	// Create test widgets
 	widgets := []store.CreateWidgetParams{
-		{Theme: "default", Area: "sidebar", WidgetType: "text", Position: 0, IsActive: 1},
-		{Theme: "default", Area: "sidebar", WidgetType: "search", Position: 1, IsActive: 1},
-		{Theme: "default", Area: "footer", WidgetType: "text", Position: 0, IsActive: 1},
+		{Theme: "default", Area: "sidebar", WidgetType: "text", Position: 0, IsActive: 1, SiteID: store.DefaultSiteID},
+		{Theme: "default", Area: "sidebar", WidgetType: "search", Position: 1, IsActive: 1, SiteID: store.DefaultSiteID},
+		{Theme: "default", Area: "footer", WidgetType: "text", Position: 0, IsActive: 1, SiteID: store.DefaultSiteID},
 	}
 	for _, w := range widgets {
 		if _, err := queries.CreateWidget(context.Background(), w); err != nil {
@@ -142,6 +152,7 @@ func TestWidgetUpdate(t *testing.T) {
 		Title:      sql.NullString{String: "Original Title", Valid: true},
 		Position:   0,
 		IsActive:   1,
+		SiteID:     store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateWidget failed: %v", err)
@@ -186,6 +197,7 @@ func TestWidgetDelete(t *testing.T) {
 		WidgetType: "text",
 		Position:   0,
 		IsActive:   1,
+		SiteID:     store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateWidget failed: %v", err)
@@ -212,6 +224,7 @@ func TestWidgetUpdatePosition(t *testing.T) {
 		WidgetType: "text",
 		Position:   0,
 		IsActive:   1,
+		SiteID:     store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateWidget failed: %v", err)
@@ -241,9 +254,9 @@ func TestWidgetGetMaxPosition(t *testing.T) {
 
 	// Create widgets with different positions
 	widgets := []store.CreateWidgetParams{
-		{Theme: "default", Area: "sidebar", WidgetType: "text", Position: 2, IsActive: 1},
-		{Theme: "default", Area: "sidebar", WidgetType: "search", Position: 5, IsActive: 1},
-		{Theme: "default", Area: "sidebar", WidgetType: "tags", Position: 1, IsActive: 1},
+		{Theme: "default", Area: "sidebar", WidgetType: "text", Position: 2, IsActive: 1, SiteID: store.DefaultSiteID},
+		{Theme: "default", Area: "sidebar", WidgetType: "search", Position: 5, IsActive: 1, SiteID: store.DefaultSiteID},
+		{Theme: "default", Area: "sidebar", WidgetType: "tags", Position: 1, IsActive: 1, SiteID: store.DefaultSiteID},
 	}
 	for _, w := range widgets {
 		if _, err := queries.CreateWidget(context.Background(), w); err != nil {
@@ -282,6 +295,7 @@ func TestWidgetWithSettings(t *testing.T) {
 		Settings:   sql.NullString{String: settings, Valid: true},
 		Position:   0,
 		IsActive:   1,
+		SiteID:     store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateWidget failed: %v", err)
@@ -302,9 +316,9 @@ func TestWidgetActiveFilter(t *testing.T) {
 
 	// Create active and inactive widgets
 	widgets := []store.CreateWidgetParams{
-		{Theme: "default", Area: "sidebar", WidgetType: "text", Position: 0, IsActive: 1},
-		{Theme: "default", Area: "sidebar", WidgetType: "search", Position: 1, IsActive: 0},
-		{Theme: "default", Area: "sidebar", WidgetType: "tags", Position: 2, IsActive: 1},
+		{Theme: "default", Area: "sidebar", WidgetType: "text", Position: 0, IsActive: 1, SiteID: store.DefaultSiteID},
+		{Theme: "default", Area: "sidebar", WidgetType: "search", Position: 1, IsActive: 0, SiteID: store.DefaultSiteID},
+		{Theme: "default", Area: "sidebar", WidgetType: "tags", Position: 2, IsActive: 1, SiteID: store.DefaultSiteID},
 	}
 	for _, w := range widgets {
 		if _, err := queries.CreateWidget(context.Background(), w); err != nil {
@@ -425,3 +439,98 @@ func TestMoveWidgetRequest(t *testing.T) {
 		t.Errorf("Area = %q, want %q", req.Area, "footer")
 	}
 }
+
+func TestWidgetsHandler_CreateValidatesWidget(t *testing.T) {
+	db, sm := testHandlerSetup(t)
+	h := NewWidgetsHandler(db, nil, sm, nil)
+
+	create := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/admin/widgets", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.Create(w, req)
+		return w
+	}
+
+	for name, body := range map[string]string{
+		"unknown type":     `{"theme":"default","area":"sidebar","widget_type":"nope"}`,
+		"invalid settings": `{"theme":"default","area":"sidebar","widget_type":"tags","settings":"5"}`,
+		"invalid path":     `{"theme":"default","area":"sidebar","widget_type":"search","visibility":{"paths":["blog"]}}`,
+	} {
+		if w := create(body); w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400", name, w.Code)
+		}
+	}
+
+	w := create(`{"theme":"default","area":"sidebar","widget_type":"tags","settings":"{\"limit\":\"3\",\"other\":\"x\"}",
+		"visibility":{"page_types":["post"],"languages":["EN"],"paths":["/blog*"]}}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("create: status = %d, body %s", w.Code, w.Body)
+	}
+	queries := store.New(db)
+	widgets, _ := queries.GetAllWidgetsByTheme(context.Background(), "default")
+	if len(widgets) != 1 || widgets[0].Settings.String != `{"limit":"3"}` {
+		t.Fatalf("widgets = %+v, want unknown settings dropped", widgets)
+	}
+	rules, err := queries.GetWidgetVisibility(context.Background(), widgets[0].ID)
+	if err != nil || rules != `{"page_types":["post"],"languages":["en"],"paths":["/blog*"]}` {
+		t.Errorf("visibility = %q, %v", rules, err)
+	}
+
+	// Saving empty rules shows the widget everywhere again.
+	req := httptest.NewRequest(http.MethodPut, "/admin/widgets/1", strings.NewReader(`{"widget_type":"tags","is_active":true,"visibility":{}}`))
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", strconv.FormatInt(widgets[0].ID, 10))
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	w = httptest.NewRecorder()
+	h.Update(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("update: status = %d, body %s", w.Code, w.Body)
+	}
+	if _, err := queries.GetWidgetVisibility(context.Background(), widgets[0].ID); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("visibility after clearing: err = %v, want no rows", err)
+	}
+}
+
+func TestFrontendHandler_WidgetVisibility(t *testing.T) {
+	db, _ := testHandlerSetup(t)
+	queries := store.New(db)
+	ctx := context.Background()
+
+	addWidget := func(widgetType, content string, vis widget.Visibility) {
+		t.Helper()
+		w, err := queries.CreateWidget(ctx, store.CreateWidgetParams{
+			Theme: "default", Area: "sidebar", WidgetType: widgetType,
+			Content: sql.NullString{String: content, Valid: content != ""}, IsActive: 1, LanguageCode: "en",
+			SiteID: store.DefaultSiteID,
+		})
+		if err != nil {
+			t.Fatalf("CreateWidget: %v", err)
+		}
+		if err := saveWidgetVisibility(ctx, queries, w.ID, vis); err != nil {
+			t.Fatalf("saveWidgetVisibility: %v", err)
+		}
+	}
+	addWidget("text", "<p>posts only</p>", widget.Visibility{PageTypes: []string{"post"}})
+	addWidget("text", "<p>not on about</p>", widget.Visibility{Paths: []string{"!/about"}})
+	addWidget("search", "", widget.Visibility{})
+	addWidget("archives", "", widget.Visibility{})
+
+	h := NewFrontendHandler(db, loadedFrontendThemeManager(t, "default"), nil, slog.Default(), nil, nil)
+	contents := func(path, pageType string) []string {
+		r := requestWithWidgetPage(httptest.NewRequest(http.MethodGet, path, nil), pageType)
+		var out []string
+		for _, w := range h.loadWidgets(r, "en", "")["sidebar"] {
+			out = append(out, w.Type+":"+string(w.Content))
+		}
+		return out
+	}
+
+	about := contents("/about", "page")
+	if len(about) != 1 || !strings.Contains(about[0], `action="/search"`) {
+		t.Errorf("widgets on /about = %v, want only the search form", about)
+	}
+	post := contents("/hello", "post")
+	if len(post) != 3 || post[0] != "text:<p>posts only</p>" || post[1] != "text:<p>not on about</p>" {
+		t.Errorf("widgets on a post = %v, want both text widgets and search", post)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package i18n
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/text/language"
+
+	"github.com/olegiv/ocms-go/internal/util"
+)
+
+// LoadCatalogDir loads operator catalogs from dir/{lang}/messages.json,
+// typically OCMS_CUSTOM_DIR/locales. A catalog of a bundled language
+// overrides its messages, including those merged later from modules. Any
+// other language becomes an admin UI language whose missing messages fall
+// back to the default language; modules and themes loaded afterwards add
+// their catalogs for it too. It returns the languages loaded; a missing
+// directory loads none.
+func LoadCatalogDir(dir string) ([]string, error) {
+	if catalog == nil {
+		return nil, fmt.Errorf("i18n catalog not initialized")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", dir, err)
+	}
+
+	var loaded []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		lang := entry.Name()
+		if !util.IsValidLangCode(lang) {
+			return loaded, fmt.Errorf("catalog directory %q: not a language code", lang)
+		}
+		if _, err := language.Parse(lang); err != nil {
+			return loaded, fmt.Errorf("catalog directory %q: %w", lang, err)
+		}
+
+		path := filepath.Join(dir, lang, "messages.json")
+		data, err := os.ReadFile(path) // #nosec G304 -- operator-controlled custom directory
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return loaded, fmt.Errorf("reading %s: %w", path, err)
+		}
+		var msgFile MessageFile
+		if err := json.Unmarshal(data, &msgFile); err != nil {
+			return loaded, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+
+		catalog.mu.Lock()
+		err = catalog.addMessages(lang, msgFile.Messages, true)
+		if err == nil && !contains(SupportedLanguages, lang) {
+			SupportedLanguages = append(SupportedLanguages, lang)
+			catalog.matchAll()
+		}
+		catalog.mu.Unlock()
+		if err != nil {
+			return loaded, fmt.Errorf("failed to load %s: %w", path, err)
+		}
+
+		if catalog.logger != nil {
+			catalog.logger.Debug("loaded operator translations", "language", lang, "count", len(msgFile.Messages))
+		}
+		loaded = append(loaded, lang)
+	}
+	return loaded, nil
+}
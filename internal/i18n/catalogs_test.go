@@ -0,0 +1,130 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeCatalog writes dir/lang/messages.json.
+func writeCatalog(t *testing.T, dir, lang, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Join(dir, lang), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, lang, "messages.json"), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// resetCatalog initializes the catalog and restores it after the test, since
+// operator catalogs change the supported languages.
+func resetCatalog(t *testing.T) {
+	t.Helper()
+	if err := Init(nil); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := Init(nil); err != nil {
+			t.Errorf("Init failed: %v", err)
+		}
+	})
+}
+
+func TestLoadCatalogDir_AddsLanguage(t *testing.T) {
+	resetCatalog(t)
+	dir := t.TempDir()
+	writeCatalog(t, dir, "de", `{"language": "de", "messages": [
+		{"id": "btn.save", "translation": "Speichern"},
+		{"id": "pages.count", "translation": "{count} Seiten", "plural": {"one": "{count} Seite", "other": "{count} Seiten"}}
+	]}`)
+
+	loaded, err := LoadCatalogDir(dir)
+	if err != nil {
+		t.Fatalf("LoadCatalogDir: %v", err)
+	}
+	if len(loaded) != 1 || loaded[0] != "de" {
+		t.Fatalf("loaded = %v, want [de]", loaded)
+	}
+	if !IsSupported("de") {
+		t.Error("de should be a supported language")
+	}
+	if got := T("de", "btn.save"); got != "Speichern" {
+		t.Errorf("T(de, btn.save) = %q", got)
+	}
+	if got := T("de", "btn.cancel"); got != "Cancel" {
+		t.Errorf("missing message should fall back to English, got %q", got)
+	}
+	if got := T("de", "pages.count", Params{"count": 1}); got != "1 Seite" {
+		t.Errorf("singular = %q", got)
+	}
+	if got := T("de", "pages.count", Params{"count": 5}); got != "5 Seiten" {
+		t.Errorf("plural = %q", got)
+	}
+	if got := MatchLanguage("de-DE,de;q=0.9"); got != "de" {
+		t.Errorf("MatchLanguage = %q, want de", got)
+	}
+}
+
+func TestLoadCatalogDir_RussianPlurals(t *testing.T) {
+	resetCatalog(t)
+	dir := t.TempDir()
+	writeCatalog(t, dir, "ru", `{"language": "ru", "messages": [
+		{"id": "test.files", "translation": "%d файлов", "plural": {"one": "%d файл", "few": "%d файла", "many": "%d файлов", "other": "%d файла"}}
+	]}`)
+	if _, err := LoadCatalogDir(dir); err != nil {
+		t.Fatalf("LoadCatalogDir: %v", err)
+	}
+
+	for n, want := range map[int]string{1: "1 файл", 3: "3 файла", 5: "5 файлов", 21: "21 файл", 112: "112 файлов"} {
+		if got := T("ru", "test.files", n); got != want {
+			t.Errorf("T(ru, test.files, %d) = %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestLoadCatalogDir_OverridesModules(t *testing.T) {
+	resetCatalog(t)
+	dir := t.TempDir()
+	writeCatalog(t, dir, "en", `{"language": "en", "messages": [
+		{"id": "btn.save", "translation": "Store"},
+		{"id": "mymodule.title", "translation": "Operator Title"}
+	]}`)
+	if _, err := LoadCatalogDir(dir); err != nil {
+		t.Fatalf("LoadCatalogDir: %v", err)
+	}
+
+	AddTranslations("en", map[string]string{"mymodule.title": "Module Title", "mymodule.other": "Other"})
+	if got := T("en", "btn.save"); got != "Store" {
+		t.Errorf("T(en, btn.save) = %q, want Store", got)
+	}
+	if got := T("en", "mymodule.title"); got != "Operator Title" {
+		t.Errorf("module catalog overrode the operator: %q", got)
+	}
+	if got := T("en", "mymodule.other"); got != "Other" {
+		t.Errorf("T(en, mymodule.other) = %q", got)
+	}
+}
+
+func TestLoadCatalogDir_Errors(t *testing.T) {
+	resetCatalog(t)
+
+	if loaded, err := LoadCatalogDir(filepath.Join(t.TempDir(), "missing")); err != nil || loaded != nil {
+		t.Errorf("missing directory = %v, %v; want nil, nil", loaded, err)
+	}
+
+	dir := t.TempDir()
+	writeCatalog(t, dir, "not a language", `{"messages": []}`)
+	if _, err := LoadCatalogDir(dir); err == nil {
+		t.Error("expected an error for an invalid language directory")
+	}
+
+	dir = t.TempDir()
+	writeCatalog(t, dir, "de", `{"messages": [{"id": "x", "translation": "x", "plural": {"one": "x"}}]}`)
+	if _, err := LoadCatalogDir(dir); err == nil {
+		t.Error("expected an error for plural variants without other")
+	}
+}
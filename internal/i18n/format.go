@@ -0,0 +1,143 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package i18n
+
+import (
+	"fmt"
+	"regexp"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// Params holds named arguments for {name} placeholders in a message.
+// The "count" parameter also selects the plural form of the message:
+//
+//	i18n.T(lang, "pages.found", i18n.Params{"count": 3, "query": q})
+type Params map[string]any
+
+// CountParam is the named parameter that selects the plural form.
+const CountParam = "count"
+
+// pluralForms maps the CLDR plural categories used in message files.
+var pluralForms = map[string]plural.Form{
+	"zero":  plural.Zero,
+	"one":   plural.One,
+	"two":   plural.Two,
+	"few":   plural.Few,
+	"many":  plural.Many,
+	"other": plural.Other,
+}
+
+// placeholderPattern matches a {name} placeholder.
+var placeholderPattern = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// parsePluralForms validates the plural variants of a message.
+func parsePluralForms(id string, variants map[string]string) (map[plural.Form]string, error) {
+	if len(variants) == 0 {
+		return nil, nil
+	}
+	forms := make(map[plural.Form]string, len(variants))
+	for category, text := range variants {
+		form, ok := pluralForms[category]
+		if !ok {
+			return nil, fmt.Errorf("message %s: unknown plural category %q", id, category)
+		}
+		forms[form] = text
+	}
+	if _, ok := forms[plural.Other]; !ok {
+		return nil, fmt.Errorf("message %s: plural variants need an \"other\" form", id)
+	}
+	return forms, nil
+}
+
+// selectPlural picks the plural variant of a message for the count found in
+// args, using the CLDR cardinal rules of lang. The translation is returned
+// unchanged when args hold no count.
+func selectPlural(lang, translation string, forms map[plural.Form]string, args []any) string {
+	if len(forms) == 0 {
+		return translation
+	}
+	n, ok := pluralCount(args)
+	if !ok {
+		return translation
+	}
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return forms[plural.Other]
+	}
+	if n < 0 {
+		n = -n
+	}
+	if text, ok := forms[plural.Cardinal.MatchPlural(tag, n%10000000, 0, 0, 0, 0)]; ok {
+		return text
+	}
+	return forms[plural.Other]
+}
+
+// pluralCount returns the count of a message: the "count" parameter of
+// Params, or else the first integer argument.
+func pluralCount(args []any) (int, bool) {
+	if params, ok := singleParams(args); ok {
+		return toInt(params[CountParam])
+	}
+	for _, arg := range args {
+		if n, ok := toInt(arg); ok {
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+func toInt(v any) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int8:
+		return int(n), true
+	case int16:
+		return int(n), true
+	case int32:
+		return int(n), true
+	case int64:
+		return int(n), true
+	case uint:
+		return int(n), true
+	case uint8:
+		return int(n), true
+	case uint16:
+		return int(n), true
+	case uint32:
+		return int(n), true
+	case uint64:
+		return int(n), true
+	}
+	return 0, false
+}
+
+func singleParams(args []any) (Params, bool) {
+	if len(args) != 1 {
+		return nil, false
+	}
+	params, ok := args[0].(Params)
+	return params, ok
+}
+
+// Format fills a message with its arguments. A single Params argument
+// replaces {name} placeholders, leaving unknown names as they are; other
+// arguments are applied with fmt.Sprintf verbs.
+func Format(text string, args ...any) string {
+	if len(args) == 0 {
+		return text
+	}
+	if params, ok := singleParams(args); ok {
+		return placeholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+			if value, ok := params[placeholder[1:len(placeholder)-1]]; ok {
+				return fmt.Sprint(value)
+			}
+			return placeholder
+		})
+	}
+	return fmt.Sprintf(text, args...)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package i18n
+
+import "testing"
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		args []any
+		want string
+	}{
+		{"no args", "Saved {name}", nil, "Saved {name}"},
+		{"verbs", "%s deleted", []any{"Page"}, "Page deleted"},
+		{"params", "{count} pages in {lang}", []any{Params{"count": 3, "lang": "ru"}}, "3 pages in ru"},
+		{"unknown param", "Hello {who}", []any{Params{}}, "Hello {who}"},
+		{"repeated param", "{a}-{a}", []any{Params{"a": "x"}}, "x-x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.text, tt.args...); got != tt.want {
+				t.Errorf("Format(%q, %v) = %q, want %q", tt.text, tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePluralForms(t *testing.T) {
+	if _, err := parsePluralForms("k", map[string]string{"one": "a"}); err == nil {
+		t.Error("expected an error without an other form")
+	}
+	if _, err := parsePluralForms("k", map[string]string{"other": "a", "several": "b"}); err == nil {
+		t.Error("expected an error for an unknown category")
+	}
+	forms, err := parsePluralForms("k", map[string]string{"one": "a", "other": "b"})
+	if err != nil || len(forms) != 2 {
+		t.Errorf("parsePluralForms = %v, %v", forms, err)
+	}
+}
@@ -9,20 +9,25 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"sync"
 
+	"golang.org/x/text/feature/plural"
 	"golang.org/x/text/language"
 )
 
 //go:embed locales
 var localesFS embed.FS
 
-// Message represents a single translatable message.
+// Message represents a single translatable message. Plural holds variants
+// keyed by CLDR plural category (zero, one, two, few, many, other); the
+// variant for the count argument replaces Translation.
 type Message struct {
-	ID          string `json:"id"`
-	Message     string `json:"message"`
-	Translation string `json:"translation"`
+	ID          string            `json:"id"`
+	Message     string            `json:"message"`
+	Translation string            `json:"translation"`
+	Plural      map[string]string `json:"plural,omitempty"`
 }
 
 // MessageFile represents the structure of a messages JSON file.
@@ -34,7 +39,9 @@ type MessageFile struct {
 // Catalog holds all translations for all supported languages.
 type Catalog struct {
 	mu           sync.RWMutex
-	translations map[string]map[string]string // lang -> key -> translation
+	translations map[string]map[string]string                 // lang -> key -> translation
+	plurals      map[string]map[string]map[plural.Form]string // lang -> key -> plural variants
+	operator     map[string]map[string]bool                   // lang -> keys set by operator catalogs
 	matcher      language.Matcher
 	supported    []language.Tag
 	defaultLang  string
@@ -44,8 +51,12 @@ type Catalog struct {
 // catalog is the global catalog instance.
 var catalog *Catalog
 
-// SupportedLanguages lists the admin UI languages we support.
-var SupportedLanguages = []string{"en", "ru"}
+// SupportedLanguages lists the admin UI languages we support: the bundled
+// ones, followed by those added by operator catalogs (see LoadCatalogDir).
+var SupportedLanguages = slices.Clone(bundledLanguages)
+
+// bundledLanguages lists the admin UI languages embedded in the binary.
+var bundledLanguages = []string{"en", "ru"}
 
 // Init initializes the i18n system with the given logger.
 func Init(logger *slog.Logger) error {
@@ -54,23 +65,20 @@ func Init(logger *slog.Logger) error {
 
 // InitWithDefault initializes the i18n system with a custom default language.
 func InitWithDefault(logger *slog.Logger, defaultLang string) error {
+	SupportedLanguages = slices.Clone(bundledLanguages)
+
 	// Validate default language is supported
 	if !contains(SupportedLanguages, defaultLang) {
 		defaultLang = "en" // Fallback to en if not supported
 	}
 	catalog = &Catalog{
 		translations: make(map[string]map[string]string),
+		plurals:      make(map[string]map[string]map[plural.Form]string),
+		operator:     make(map[string]map[string]bool),
 		defaultLang:  defaultLang,
 		logger:       logger,
 	}
-
-	// Build supported language tags
-	tags := make([]language.Tag, 0, len(SupportedLanguages))
-	for _, lang := range SupportedLanguages {
-		tags = append(tags, language.MustParse(lang))
-	}
-	catalog.supported = tags
-	catalog.matcher = language.NewMatcher(tags)
+	catalog.matchAll()
 
 	// Load translations from embedded filesystem
 	for _, lang := range SupportedLanguages {
@@ -102,9 +110,8 @@ func (c *Catalog) loadLanguage(lang string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	c.translations[lang] = make(map[string]string)
-	for _, msg := range msgFile.Messages {
-		c.translations[lang][msg.ID] = msg.Translation
+	if err := c.addMessages(lang, msgFile.Messages, false); err != nil {
+		return fmt.Errorf("failed to load %s: %w", path, err)
 	}
 
 	if c.logger != nil {
@@ -114,9 +121,63 @@ func (c *Catalog) loadLanguage(lang string) error {
 	return nil
 }
 
+// addMessages merges messages into the catalog; the caller holds the write
+// lock. Keys set by an operator catalog keep their translation when a module
+// catalog is merged later. Nothing is merged when a message is invalid.
+func (c *Catalog) addMessages(lang string, messages []Message, operator bool) error {
+	forms := make(map[string]map[plural.Form]string)
+	for _, msg := range messages {
+		variants, err := parsePluralForms(msg.ID, msg.Plural)
+		if err != nil {
+			return err
+		}
+		if variants != nil {
+			forms[msg.ID] = variants
+		}
+	}
+
+	if c.translations[lang] == nil {
+		c.translations[lang] = make(map[string]string)
+	}
+	for _, msg := range messages {
+		if !operator && c.operator[lang][msg.ID] {
+			continue
+		}
+		c.translations[lang][msg.ID] = msg.Translation
+		if variants, ok := forms[msg.ID]; ok {
+			if c.plurals[lang] == nil {
+				c.plurals[lang] = make(map[string]map[plural.Form]string)
+			}
+			c.plurals[lang][msg.ID] = variants
+		} else {
+			delete(c.plurals[lang], msg.ID)
+		}
+		if operator {
+			if c.operator[lang] == nil {
+				c.operator[lang] = make(map[string]bool)
+			}
+			c.operator[lang][msg.ID] = true
+		}
+	}
+	return nil
+}
+
+// matchAll matches every supported language; the caller holds the write lock
+// or owns the catalog.
+func (c *Catalog) matchAll() {
+	tags := make([]language.Tag, 0, len(SupportedLanguages))
+	for _, lang := range SupportedLanguages {
+		tags = append(tags, language.MustParse(lang))
+	}
+	c.supported = tags
+	c.matcher = language.NewMatcher(tags)
+}
+
 // T translates a message key to the specified language.
 // If the key is not found, it returns the key itself.
-// Supports optional arguments for string formatting.
+// Arguments fill the message: a single Params value replaces {name}
+// placeholders, other arguments are applied as fmt.Sprintf verbs. The count
+// argument selects the plural variant of messages that have them.
 func T(lang, key string, args ...any) string {
 	if catalog == nil {
 		return key
@@ -125,43 +186,28 @@ func T(lang, key string, args ...any) string {
 	catalog.mu.RLock()
 	defer catalog.mu.RUnlock()
 
-	// Get translations for the requested language
-	langTranslations, ok := catalog.translations[lang]
-	if !ok {
-		// Fall back to default language
-		langTranslations, ok = catalog.translations[catalog.defaultLang]
-		if !ok {
-			return key
-		}
+	// Fall back to the default language for languages without a catalog
+	if _, ok := catalog.translations[lang]; !ok {
+		lang = catalog.defaultLang
 	}
 
-	// Get the translation
-	translation, ok := langTranslations[key]
-	if !ok {
+	translation, ok := catalog.translations[lang][key]
+	if !ok && lang != catalog.defaultLang {
 		// Try default language as fallback
-		if lang != catalog.defaultLang {
-			if defaultTranslations, ok := catalog.translations[catalog.defaultLang]; ok {
-				if translation, ok = defaultTranslations[key]; ok {
-					// Log missing translation
-					if catalog.logger != nil {
-						catalog.logger.Debug("missing translation, using default", "key", key, "lang", lang)
-					}
-					if len(args) > 0 {
-						return fmt.Sprintf(translation, args...)
-					}
-					return translation
-				}
+		if translation, ok = catalog.translations[catalog.defaultLang][key]; ok {
+			// Log missing translation
+			if catalog.logger != nil {
+				catalog.logger.Debug("missing translation, using default", "key", key, "lang", lang)
 			}
+			lang = catalog.defaultLang
 		}
-		return key
 	}
-
-	// Format with arguments if provided
-	if len(args) > 0 {
-		return fmt.Sprintf(translation, args...)
+	if !ok {
+		return key
 	}
 
-	return translation
+	translation = selectPlural(lang, translation, catalog.plurals[lang][key], args)
+	return Format(translation, args...)
 }
 
 // GetSupportedLanguages returns the list of supported admin UI languages.
@@ -311,9 +357,14 @@ func AddTranslations(lang string, translations map[string]string) {
 		catalog.translations[lang] = make(map[string]string)
 	}
 
-	// Merge translations (module translations can override core)
+	// Merge translations (module translations can override core, operator
+	// catalogs override both)
 	for key, value := range translations {
+		if catalog.operator[lang][key] {
+			continue
+		}
 		catalog.translations[lang][key] = value
+		delete(catalog.plurals[lang], key)
 	}
 
 	if catalog.logger != nil {
@@ -345,13 +396,12 @@ func LoadTranslationsFromFS(fs embed.FS, prefix string) error {
 			return fmt.Errorf("failed to parse %s: %w", path, err)
 		}
 
-		// Convert to map and add
-		translations := make(map[string]string)
-		for _, msg := range msgFile.Messages {
-			translations[msg.ID] = msg.Translation
+		catalog.mu.Lock()
+		err = catalog.addMessages(lang, msgFile.Messages, false)
+		catalog.mu.Unlock()
+		if err != nil {
+			return fmt.Errorf("failed to load %s: %w", path, err)
 		}
-
-		AddTranslations(lang, translations)
 	}
 
 	return nil
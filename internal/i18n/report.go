@@ -0,0 +1,231 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package i18n
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ReferenceLanguage is the language every catalog set is checked against.
+const ReferenceLanguage = "en"
+
+// CatalogSet is a group of catalogs checked together: the core admin
+// catalogs, one module's or one theme's.
+type CatalogSet struct {
+	Name string
+	// Keys holds the message IDs of each language.
+	Keys map[string]map[string]bool
+}
+
+// ReadCatalogSet reads the catalogs of dir/{lang}/messages.json. A missing
+// directory yields an empty set.
+func ReadCatalogSet(name, dir string) (CatalogSet, error) {
+	set := CatalogSet{Name: name, Keys: make(map[string]map[string]bool)}
+	if err := set.Merge(dir); err != nil {
+		return CatalogSet{}, err
+	}
+	return set, nil
+}
+
+// Merge adds the catalogs of dir/{lang}/messages.json to the set, the way
+// operator catalogs are layered over the core catalogs.
+func (s *CatalogSet) Merge(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", dir, err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name(), "messages.json")
+		data, err := os.ReadFile(path) // #nosec G304 -- paths come from the checked source tree
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", path, err)
+		}
+		var msgFile MessageFile
+		if err := json.Unmarshal(data, &msgFile); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		keys := s.Keys[entry.Name()]
+		if keys == nil {
+			keys = make(map[string]bool, len(msgFile.Messages))
+			s.Keys[entry.Name()] = keys
+		}
+		for _, msg := range msgFile.Messages {
+			if _, err := parsePluralForms(msg.ID, msg.Plural); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			keys[msg.ID] = true
+		}
+	}
+	return nil
+}
+
+// Languages returns the languages the set has catalogs for, sorted.
+func (s CatalogSet) Languages() []string {
+	langs := make([]string, 0, len(s.Keys))
+	for lang := range s.Keys {
+		langs = append(langs, lang)
+	}
+	slices.Sort(langs)
+	return langs
+}
+
+// KeyReport lists the problems found in one catalog set.
+type KeyReport struct {
+	Name string
+	// Missing holds, per language, the reference keys it does not translate.
+	Missing map[string][]string
+	// Unused holds the reference keys no source file refers to.
+	Unused []string
+}
+
+// MissingCount returns the number of missing translations in the report.
+func (r KeyReport) MissingCount() int {
+	n := 0
+	for _, keys := range r.Missing {
+		n += len(keys)
+	}
+	return n
+}
+
+// CheckKeys compares each set's catalogs with its reference language
+// catalog for the given languages and, when usage is not nil, looks up
+// which reference keys are unused. Sets without a reference catalog are
+// skipped.
+func CheckKeys(sets []CatalogSet, languages []string, usage *KeyUsage) []KeyReport {
+	reports := make([]KeyReport, 0, len(sets))
+	for _, set := range sets {
+		reference := set.Keys[ReferenceLanguage]
+		if len(reference) == 0 {
+			continue
+		}
+		keys := make([]string, 0, len(reference))
+		for key := range reference {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		report := KeyReport{Name: set.Name, Missing: make(map[string][]string)}
+		for _, lang := range languages {
+			if lang == ReferenceLanguage {
+				continue
+			}
+			for _, key := range keys {
+				if !set.Keys[lang][key] {
+					report.Missing[lang] = append(report.Missing[lang], key)
+				}
+			}
+		}
+		if usage != nil {
+			for _, key := range keys {
+				if !usage.Uses(key) {
+					report.Unused = append(report.Unused, key)
+				}
+			}
+		}
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+// stringLiteralPattern matches a double-quoted literal on a single line, as
+// message keys appear in Go, templ and theme template sources.
+var stringLiteralPattern = regexp.MustCompile(`"((?:[^"\\\n]|\\.)*)"`)
+
+// keyUsageExtensions lists the source files scanned for message keys.
+var keyUsageExtensions = []string{".go", ".templ", ".html"}
+
+// KeyUsage records the string literals found in source files.
+type KeyUsage struct {
+	literals map[string]bool
+	prefixes []string
+}
+
+// ScanKeyUsage collects the string literals of the Go, templ and HTML
+// template files under dirs, skipping tests and generated templ code.
+// Literals such as "comments.status_" or "webhooks.event_%s" are kept as
+// prefixes, since keys built from them are only known at run time.
+func ScanKeyUsage(dirs ...string) (*KeyUsage, error) {
+	usage := &KeyUsage{literals: make(map[string]bool)}
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			if d.IsDir() {
+				if name := d.Name(); path != dir && (strings.HasPrefix(name, ".") || name == "node_modules") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			name := d.Name()
+			if !slices.Contains(keyUsageExtensions, filepath.Ext(name)) ||
+				strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, "_templ.go") {
+				return nil
+			}
+			data, err := os.ReadFile(path) // #nosec G304 -- paths come from the checked source tree
+			if err != nil {
+				return err
+			}
+			for _, match := range stringLiteralPattern.FindAllStringSubmatch(string(data), -1) {
+				usage.add(match[1])
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("scanning %s: %w", dir, err)
+		}
+	}
+	return usage, nil
+}
+
+func (u *KeyUsage) add(literal string) {
+	if literal == "" || strings.ContainsAny(literal, " \t") {
+		return
+	}
+	if i := strings.IndexByte(literal, '%'); i >= 0 {
+		literal = literal[:i]
+		if strings.Contains(literal, ".") {
+			u.prefixes = append(u.prefixes, literal)
+		}
+		return
+	}
+	u.literals[literal] = true
+	if strings.Contains(literal, ".") && (strings.HasSuffix(literal, ".") || strings.HasSuffix(literal, "_")) {
+		u.prefixes = append(u.prefixes, literal)
+	}
+}
+
+// Uses reports whether a source file refers to key, literally or through a
+// key prefix.
+func (u *KeyUsage) Uses(key string) bool {
+	if u.literals[key] {
+		return true
+	}
+	for _, prefix := range u.prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestCheckKeys(t *testing.T) {
+	dir := t.TempDir()
+	locales := filepath.Join(dir, "locales")
+	writeCatalog(t, locales, "en", `{"messages": [
+		{"id": "a.used", "translation": "A"},
+		{"id": "a.status_draft", "translation": "Draft"},
+		{"id": "a.event_saved", "translation": "Saved"},
+		{"id": "a.stale", "translation": "Stale"}
+	]}`)
+	writeCatalog(t, locales, "ru", `{"messages": [
+		{"id": "a.used", "translation": "A"},
+		{"id": "a.status_draft", "translation": "Черновик"},
+		{"id": "a.event_saved", "translation": "Сохранено"}
+	]}`)
+	src := filepath.Join(dir, "src")
+	if err := os.MkdirAll(src, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	code := "package x\nvar _ = T(\"a.used\")\nvar _ = \"a.status_\" + s\nvar _ = fmt.Sprintf(\"a.event_%s\", e)\n"
+	if err := os.WriteFile(filepath.Join(src, "x.go"), []byte(code), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// Tests do not count as usage.
+	if err := os.WriteFile(filepath.Join(src, "x_test.go"), []byte(`var _ = "a.stale"`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	set, err := ReadCatalogSet("core", locales)
+	if err != nil {
+		t.Fatalf("ReadCatalogSet: %v", err)
+	}
+	if !slices.Equal(set.Languages(), []string{"en", "ru"}) {
+		t.Errorf("Languages = %v", set.Languages())
+	}
+	usage, err := ScanKeyUsage(src)
+	if err != nil {
+		t.Fatalf("ScanKeyUsage: %v", err)
+	}
+
+	reports := CheckKeys([]CatalogSet{set}, []string{"en", "ru", "de"}, usage)
+	if len(reports) != 1 {
+		t.Fatalf("got %d reports, want 1", len(reports))
+	}
+	report := reports[0]
+	if !slices.Equal(report.Missing["ru"], []string{"a.stale"}) {
+		t.Errorf("missing in ru = %v", report.Missing["ru"])
+	}
+	if len(report.Missing["de"]) != 4 {
+		t.Errorf("missing in de = %v, want all 4 keys", report.Missing["de"])
+	}
+	if report.MissingCount() != 5 {
+		t.Errorf("MissingCount = %d, want 5", report.MissingCount())
+	}
+	if !slices.Equal(report.Unused, []string{"a.stale"}) {
+		t.Errorf("unused = %v, want [a.stale]", report.Unused)
+	}
+}
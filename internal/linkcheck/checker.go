@@ -0,0 +1,478 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package linkcheck finds broken links in published page bodies, menu items
+// and widget content. It runs as a scheduled job and keeps one result per
+// link for the admin report.
+package linkcheck
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/scheduler"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/util"
+	"github.com/olegiv/ocms-go/internal/webhook"
+)
+
+// Sources of checked links.
+const (
+	SourcePage     = "page"
+	SourceMenuItem = "menu_item"
+	SourceWidget   = "widget"
+)
+
+// Link check statuses.
+const (
+	StatusOK      = "ok"
+	StatusBroken  = "broken"
+	StatusSkipped = "skipped" // Not checked, e.g. blocked by SSRF protection
+)
+
+const (
+	jobSource       = "core"
+	jobName         = "link_check"
+	defaultSchedule = "0 4 * * *" // daily at 04:00
+
+	// runTimeout bounds a whole run.
+	runTimeout = 30 * time.Minute
+	// requestTimeout bounds the check of one external URL.
+	requestTimeout = 10 * time.Second
+	// maxExternalChecks caps the external URLs requested per run; the rest
+	// are skipped until the next run.
+	maxExternalChecks = 500
+	// checkWorkers is the number of external URLs checked in parallel.
+	checkWorkers = 4
+	// pageBatchSize is the number of pages loaded at a time.
+	pageBatchSize = 100
+	// maxRedirects is the number of redirects followed for an external URL.
+	maxRedirects = 10
+)
+
+// ErrRunning is returned when a check is started while one is running.
+var ErrRunning = errors.New("link check already running")
+
+// Result is the outcome of checking a URL.
+type Result struct {
+	Status     string
+	StatusCode int64
+	Error      string
+}
+
+// Summary counts the results of a run.
+type Summary struct {
+	Links       int
+	Broken      int
+	NewlyBroken int
+	Skipped     int
+}
+
+// sourceLink is a link found in a page, menu item or widget.
+type sourceLink struct {
+	SourceType  string
+	SourceID    int64
+	SourceTitle string
+	Link
+}
+
+// Checker crawls content for links and records whether they work.
+type Checker struct {
+	queries    *store.Queries
+	logger     *slog.Logger
+	dispatcher *webhook.Dispatcher
+	uploadsDir string
+	httpClient *http.Client
+	running    atomic.Bool
+
+	// checkExternal checks an external URL; tests replace it.
+	checkExternal func(ctx context.Context, rawURL string) Result
+	// externalLimit caps the external URLs requested per run; tests lower it.
+	externalLimit int
+}
+
+// New creates a new Checker. External URLs are requested through an
+// SSRF-safe client that refuses private and reserved addresses.
+func New(db *sql.DB, logger *slog.Logger) *Checker {
+	c := &Checker{
+		queries: store.New(db),
+		logger:  logger,
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+			Transport: &http.Transport{
+				DialContext: util.SSRFSafeDialContext(&net.Dialer{
+					Timeout:   5 * time.Second,
+					KeepAlive: 30 * time.Second,
+				}),
+				MaxIdleConns:    10,
+				IdleConnTimeout: 90 * time.Second,
+			},
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if err := util.ValidateWebhookURL(req.URL.String()); err != nil {
+					return fmt.Errorf("redirect blocked (SSRF protection): %w", err)
+				}
+				if len(via) >= maxRedirects {
+					return fmt.Errorf("too many redirects")
+				}
+				return nil
+			},
+		},
+	}
+	c.checkExternal = c.fetch
+	c.externalLimit = maxExternalChecks
+	return c
+}
+
+// SetDispatcher sets the webhook dispatcher used to announce newly broken links.
+func (c *Checker) SetDispatcher(d *webhook.Dispatcher) {
+	c.dispatcher = d
+}
+
+// SetUploadsDir sets the directory links to /uploads/ are looked up in.
+func (c *Checker) SetUploadsDir(dir string) {
+	c.uploadsDir = dir
+}
+
+// Register adds the link check job to the cron instance and the scheduler
+// registry, so it shows up on the scheduler page and can be triggered there.
+func (c *Checker) Register(registry *scheduler.Registry, cronInst *cron.Cron) error {
+	schedule := defaultSchedule
+	if registry != nil {
+		schedule = registry.GetEffectiveSchedule(jobSource, jobName, defaultSchedule)
+	}
+
+	entryID, err := cronInst.AddFunc(schedule, c.runJob)
+	if err != nil {
+		return err
+	}
+
+	if registry != nil {
+		registry.Register(
+			jobSource, jobName,
+			"Check links in pages, menus and widgets for broken targets",
+			defaultSchedule,
+			cronInst, entryID, c.runJob, c.Start,
+		)
+	}
+	return nil
+}
+
+// Start runs a check in the background. It returns ErrRunning when a check
+// is already running.
+func (c *Checker) Start() error {
+	if c.running.Load() {
+		return ErrRunning
+	}
+	go c.runJob()
+	return nil
+}
+
+// Running reports whether a check is running.
+func (c *Checker) Running() bool {
+	return c.running.Load()
+}
+
+// runJob runs a check with the run timeout and logs its outcome.
+func (c *Checker) runJob() {
+	ctx, cancel := context.WithTimeout(context.Background(), runTimeout)
+	defer cancel()
+	if _, err := c.Run(ctx); err != nil && !errors.Is(err, ErrRunning) {
+		c.logger.Error("link check failed", "error", err)
+	}
+}
+
+// Run checks all links and stores the results. Results of links that are
+// gone from the content are removed. Links left out by the check limit keep
+// their previous result. A webhook event is sent for every link that is
+// broken now but was not at the previous run.
+func (c *Checker) Run(ctx context.Context) (Summary, error) {
+	if !c.running.CompareAndSwap(false, true) {
+		return Summary{}, ErrRunning
+	}
+	defer c.running.Store(false)
+
+	started := time.Now()
+	res, err := newResolver(ctx, c.queries, c.uploadsDir)
+	if err != nil {
+		return Summary{}, err
+	}
+	links, err := c.collectLinks(ctx, res)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	previous := make(map[string]store.LinkCheck)
+	existing, err := c.queries.ListLinkChecks(ctx)
+	if err != nil {
+		return Summary{}, fmt.Errorf("list link checks: %w", err)
+	}
+	for _, check := range existing {
+		previous[checkKey(check.SourceType, check.SourceID, check.Url)] = check
+	}
+
+	results := c.checkURLs(ctx, res, links)
+	if err := ctx.Err(); err != nil {
+		return Summary{}, err
+	}
+
+	summary := Summary{Links: len(links)}
+	for _, l := range links {
+		result := results[l.URL]
+		check := store.UpsertLinkCheckParams{
+			SourceType:  l.SourceType,
+			SourceID:    l.SourceID,
+			SourceTitle: l.SourceTitle,
+			Url:         l.URL,
+			AnchorText:  l.Text,
+			IsExternal:  result.external,
+			Status:      result.Status,
+			StatusCode:  result.StatusCode,
+			Error:       result.Error,
+			CheckedAt:   started,
+		}
+		prev, seen := previous[checkKey(l.SourceType, l.SourceID, l.URL)]
+		if result.limited && seen {
+			// Keep the last result of a link the check limit left out, so
+			// a broken link is not reported as newly broken next run.
+			check.Status = prev.Status
+			check.StatusCode = prev.StatusCode
+			check.Error = prev.Error
+		}
+		switch check.Status {
+		case StatusBroken:
+			summary.Broken++
+			check.BrokenSince = sql.NullTime{Time: started, Valid: true}
+			if seen && prev.Status == StatusBroken && prev.BrokenSince.Valid {
+				check.BrokenSince = prev.BrokenSince
+			}
+		case StatusSkipped:
+			summary.Skipped++
+		}
+		if err := c.queries.UpsertLinkCheck(ctx, check); err != nil {
+			return summary, fmt.Errorf("store link check: %w", err)
+		}
+		if check.Status == StatusBroken && (!seen || prev.Status != StatusBroken) {
+			summary.NewlyBroken++
+			c.dispatchBroken(ctx, check)
+		}
+	}
+
+	if err := c.queries.DeleteLinkChecksCheckedBefore(ctx, started); err != nil {
+		return summary, fmt.Errorf("remove old link checks: %w", err)
+	}
+
+	c.logger.Info("link check completed",
+		"links", summary.Links, "broken", summary.Broken, "newly_broken", summary.NewlyBroken,
+		"skipped", summary.Skipped, "duration", time.Since(started).Round(time.Millisecond))
+	return summary, nil
+}
+
+// collectLinks gathers the links of published pages, URL menu items and
+// active widgets. Links are made absolute against the page they are on.
+func (c *Checker) collectLinks(ctx context.Context, res *resolver) ([]sourceLink, error) {
+	var links []sourceLink
+	seen := make(map[string]bool)
+	add := func(sourceType string, sourceID int64, title string, base string, link Link) {
+		u, err := url.Parse(base)
+		if err != nil {
+			return
+		}
+		ref, err := u.Parse(link.URL)
+		if err != nil || (ref.Scheme != "http" && ref.Scheme != "https" && ref.Scheme != "") {
+			return
+		}
+		ref.Fragment = ""
+		link.URL = ref.String()
+		key := checkKey(sourceType, sourceID, link.URL)
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		links = append(links, sourceLink{SourceType: sourceType, SourceID: sourceID, SourceTitle: title, Link: link})
+	}
+
+	for offset := int64(0); ; offset += pageBatchSize {
+		pages, err := c.queries.ListPublishedPages(ctx, store.ListPublishedPagesParams{Limit: pageBatchSize, Offset: offset})
+		if err != nil {
+			return nil, fmt.Errorf("list published pages: %w", err)
+		}
+		for _, page := range pages {
+			base := res.pageURLs[page.ID]
+			if base == "" {
+				base = "/"
+			}
+			for _, link := range ExtractLinks(page.Body) {
+				add(SourcePage, page.ID, page.Title, base, link)
+			}
+		}
+		if len(pages) < pageBatchSize {
+			break
+		}
+	}
+
+	items, err := c.queries.ListMenuItemURLs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list menu items: %w", err)
+	}
+	for _, item := range items {
+		if checkable(item.Url.String) {
+			add(SourceMenuItem, item.ID, item.MenuName, "/", Link{URL: item.Url.String, Text: truncate(item.Title, maxAnchorTextLength)})
+		}
+	}
+
+	widgets, err := c.queries.GetAllWidgets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list widgets: %w", err)
+	}
+	for _, widget := range widgets {
+		if widget.IsActive != 1 || !widget.Content.Valid {
+			continue
+		}
+		title := widget.Title.String
+		if title == "" {
+			title = widget.WidgetType
+		}
+		for _, link := range ExtractLinks(widget.Content.String) {
+			add(SourceWidget, widget.ID, title, "/", link)
+		}
+	}
+	return links, nil
+}
+
+// urlResult is the result of a URL, whether it is external and whether it
+// was left out by the per-run check limit.
+type urlResult struct {
+	Result
+	external bool
+	limited  bool
+}
+
+// checkURLs checks every distinct URL once. Internal URLs are resolved from
+// the database; external URLs are requested by a few workers, up to
+// externalLimit per run.
+func (c *Checker) checkURLs(ctx context.Context, res *resolver, links []sourceLink) map[string]urlResult {
+	results := make(map[string]urlResult)
+	var external []string
+	for _, l := range links {
+		if _, done := results[l.URL]; done {
+			continue
+		}
+		u, err := url.Parse(l.URL)
+		if err != nil {
+			results[l.URL] = urlResult{Result: Result{Status: StatusBroken, Error: "invalid URL"}}
+			continue
+		}
+		if res.isInternal(u) {
+			results[l.URL] = urlResult{Result: res.resolve(ctx, u)}
+			continue
+		}
+		results[l.URL] = urlResult{Result: Result{Status: StatusSkipped, Error: "check limit reached"}, external: true, limited: true}
+		if len(external) < c.externalLimit {
+			external = append(external, l.URL)
+		}
+	}
+
+	var mu sync.Mutex
+	queue := make(chan string)
+	var wg sync.WaitGroup
+	for range checkWorkers {
+		wg.Go(func() {
+			for rawURL := range queue {
+				result := c.checkExternal(ctx, rawURL)
+				mu.Lock()
+				results[rawURL] = urlResult{Result: result, external: true}
+				mu.Unlock()
+			}
+		})
+	}
+	for _, rawURL := range external {
+		if ctx.Err() != nil {
+			break
+		}
+		queue <- rawURL
+	}
+	close(queue)
+	wg.Wait()
+	return results
+}
+
+// fetch requests an external URL with HEAD, falling back to GET for servers
+// that do not support HEAD. URLs that SSRF protection refuses are skipped;
+// hosts that do not resolve are broken.
+func (c *Checker) fetch(ctx context.Context, rawURL string) Result {
+	if err := util.ValidateWebhookURL(rawURL); err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) {
+			return Result{Status: StatusBroken, Error: "host not found"}
+		}
+		return Result{Status: StatusSkipped, Error: err.Error()}
+	}
+
+	status, err := c.request(ctx, http.MethodHead, rawURL)
+	if err == nil && (status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented || status == http.StatusForbidden) {
+		status, err = c.request(ctx, http.MethodGet, rawURL)
+	}
+	if err != nil {
+		return Result{Status: StatusBroken, Error: err.Error()}
+	}
+	if status >= http.StatusBadRequest {
+		return Result{Status: StatusBroken, StatusCode: int64(status), Error: http.StatusText(status)}
+	}
+	return Result{Status: StatusOK, StatusCode: int64(status)}
+}
+
+// request sends a request and returns the response status code.
+func (c *Checker) request(ctx context.Context, method, rawURL string) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; oCMS link checker)")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	return resp.StatusCode, nil
+}
+
+// dispatchBroken sends the link.broken webhook event for a link.
+func (c *Checker) dispatchBroken(ctx context.Context, check store.UpsertLinkCheckParams) {
+	if c.dispatcher == nil {
+		return
+	}
+	data := webhook.NewLinkEventData(store.LinkCheck{
+		SourceType:  check.SourceType,
+		SourceID:    check.SourceID,
+		SourceTitle: check.SourceTitle,
+		Url:         check.Url,
+		AnchorText:  check.AnchorText,
+		StatusCode:  check.StatusCode,
+		Error:       check.Error,
+	})
+	if err := c.dispatcher.DispatchEvent(ctx, model.EventLinkBroken, data); err != nil {
+		c.logger.Error("failed to dispatch webhook event", "error", err, "event_type", model.EventLinkBroken, "url", check.Url)
+	}
+}
+
+// checkKey identifies the result of a link in a source.
+func checkKey(sourceType string, sourceID int64, rawURL string) string {
+	return fmt.Sprintf("%s:%d:%s", sourceType, sourceID, rawURL)
+}
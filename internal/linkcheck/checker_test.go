@@ -0,0 +1,216 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package linkcheck
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/testutil"
+)
+
+// newTestChecker returns a checker on a migrated database whose external
+// checks report the URLs in broken as broken and the others as OK.
+func newTestChecker(t *testing.T, broken ...string) (*Checker, *sql.DB) {
+	t.Helper()
+	db, cleanup := testutil.TestDB(t)
+	t.Cleanup(cleanup)
+
+	c := New(db, testutil.TestLoggerSilent())
+	c.checkExternal = func(_ context.Context, rawURL string) Result {
+		for _, b := range broken {
+			if rawURL == b {
+				return Result{Status: StatusBroken, StatusCode: 404, Error: "Not Found"}
+			}
+		}
+		return Result{Status: StatusOK, StatusCode: 200}
+	}
+	return c, db
+}
+
+func createPublishedPage(t *testing.T, queries *store.Queries, authorID int64, slug, body string) store.Page {
+	t.Helper()
+	now := time.Now()
+	page, err := queries.CreatePage(context.Background(), store.CreatePageParams{
+		Title: slug, Slug: slug, Body: body, Status: model.PageStatusPublished,
+		AuthorID: authorID, LanguageCode: "en", PublishedAt: sql.NullTime{Time: now, Valid: true},
+		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
+	})
+	if err != nil {
+		t.Fatalf("CreatePage() error = %v", err)
+	}
+	return page
+}
+
+func linkChecksByURL(t *testing.T, queries *store.Queries) map[string]store.LinkCheck {
+	t.Helper()
+	checks, err := queries.ListLinkChecks(context.Background())
+	if err != nil {
+		t.Fatalf("ListLinkChecks() error = %v", err)
+	}
+	byURL := make(map[string]store.LinkCheck, len(checks))
+	for _, check := range checks {
+		byURL[check.Url] = check
+	}
+	return byURL
+}
+
+func TestChecker_Run(t *testing.T) {
+	c, db := newTestChecker(t, "https://example.com/gone")
+	queries := store.New(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	user, err := queries.CreateUser(ctx, store.CreateUserParams{
+		Email: "author@example.com", PasswordHash: "hash", Role: "admin", Name: "Author",
+		CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	createPublishedPage(t, queries, user.ID, "about", "<p>About us</p>")
+	home := createPublishedPage(t, queries, user.ID, "home", `
+		<a href="about">About</a>
+		<a href="/missing">Missing page</a>
+		<a href="https://example.com/ok">Fine</a>
+		<a href="https://example.com/gone">Gone</a>`)
+
+	menu, err := queries.CreateMenu(ctx, store.CreateMenuParams{Name: "Footer", Slug: "footer-links", LanguageCode: "en", CreatedAt: now, UpdatedAt: now, SiteID: store.DefaultSiteID})
+	if err != nil {
+		t.Fatalf("CreateMenu() error = %v", err)
+	}
+	if _, err := queries.CreateMenuItem(ctx, store.CreateMenuItemParams{
+		MenuID: menu.ID, Title: "Nowhere", Url: sql.NullString{String: "/nowhere", Valid: true},
+		IsActive: true, CreatedAt: now, UpdatedAt: now,
+	}); err != nil {
+		t.Fatalf("CreateMenuItem() error = %v", err)
+	}
+	if _, err := queries.CreateWidget(ctx, store.CreateWidgetParams{
+		Theme: "default", Area: "sidebar", WidgetType: "html",
+		Content:  sql.NullString{String: `<a href="/about">About</a>`, Valid: true},
+		IsActive: 1, LanguageCode: "en",
+		SiteID: store.DefaultSiteID,
+	}); err != nil {
+		t.Fatalf("CreateWidget() error = %v", err)
+	}
+
+	summary, err := c.Run(ctx)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if summary.Links != 6 || summary.Broken != 3 || summary.NewlyBroken != 3 {
+		t.Errorf("Run() = %+v, want 6 links, 3 broken, 3 newly broken", summary)
+	}
+
+	checks := linkChecksByURL(t, queries)
+	want := map[string]string{
+		"/about":                   StatusOK,
+		"/missing":                 StatusBroken,
+		"https://example.com/ok":   StatusOK,
+		"https://example.com/gone": StatusBroken,
+		"/nowhere":                 StatusBroken,
+	}
+	for rawURL, status := range want {
+		if got := checks[rawURL]; got.Status != status {
+			t.Errorf("status of %s = %q, want %q", rawURL, got.Status, status)
+		}
+	}
+	missing := checks["/missing"]
+	if missing.SourceType != SourcePage || missing.SourceID != home.ID || missing.AnchorText != "Missing page" || !missing.BrokenSince.Valid {
+		t.Errorf("check of /missing = %+v", missing)
+	}
+	if gone := checks["https://example.com/gone"]; !gone.IsExternal || gone.StatusCode != 404 {
+		t.Errorf("check of external link = %+v, want external with status 404", gone)
+	}
+	if nowhere := checks["/nowhere"]; nowhere.SourceType != SourceMenuItem || nowhere.SourceTitle != "Footer" {
+		t.Errorf("check of menu item link = %+v", nowhere)
+	}
+
+	// A second run reports nothing new and keeps when links broke; links
+	// that were removed from the content are dropped.
+	if _, err := db.Exec(`UPDATE pages SET body = '<a href="/missing">Missing page</a>' WHERE id = ?`, home.ID); err != nil {
+		t.Fatalf("update page: %v", err)
+	}
+	summary, err = c.Run(ctx)
+	if err != nil {
+		t.Fatalf("second Run() error = %v", err)
+	}
+	if summary.NewlyBroken != 0 || summary.Broken != 2 {
+		t.Errorf("second Run() = %+v, want 2 broken, none newly broken", summary)
+	}
+	again := linkChecksByURL(t, queries)
+	if !again["/missing"].BrokenSince.Time.Equal(missing.BrokenSince.Time) {
+		t.Errorf("broken_since changed from %v to %v", missing.BrokenSince.Time, again["/missing"].BrokenSince.Time)
+	}
+	if _, ok := again["https://example.com/gone"]; ok {
+		t.Error("check of removed link was kept")
+	}
+}
+
+func TestChecker_RunKeepsResultsOfLimitedLinks(t *testing.T) {
+	c, db := newTestChecker(t, "https://example.com/gone")
+	queries := store.New(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	user, err := queries.CreateUser(ctx, store.CreateUserParams{
+		Email: "author@example.com", PasswordHash: "hash", Role: "admin", Name: "Author",
+		CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	createPublishedPage(t, queries, user.ID, "home", `
+		<a href="https://example.com/ok">Fine</a>
+		<a href="https://example.com/gone">Gone</a>`)
+
+	if _, err := c.Run(ctx); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	gone := linkChecksByURL(t, queries)["https://example.com/gone"]
+	if gone.Status != StatusBroken {
+		t.Fatalf("status of broken link = %q, want %q", gone.Status, StatusBroken)
+	}
+
+	// With room for one external check the broken link is left out; it
+	// stays broken and is not reported again.
+	c.externalLimit = 1
+	summary, err := c.Run(ctx)
+	if err != nil {
+		t.Fatalf("second Run() error = %v", err)
+	}
+	if summary.NewlyBroken != 0 || summary.Broken != 1 {
+		t.Errorf("second Run() = %+v, want 1 broken, none newly broken", summary)
+	}
+	again := linkChecksByURL(t, queries)["https://example.com/gone"]
+	if again.Status != StatusBroken || again.StatusCode != 404 || !again.BrokenSince.Time.Equal(gone.BrokenSince.Time) {
+		t.Errorf("check of limited link = %+v, want broken since %v", again, gone.BrokenSince.Time)
+	}
+}
+
+func TestChecker_RunWhileRunning(t *testing.T) {
+	c, _ := newTestChecker(t)
+	c.running.Store(true)
+	if _, err := c.Run(context.Background()); !errors.Is(err, ErrRunning) {
+		t.Errorf("Run() error = %v, want ErrRunning", err)
+	}
+	if err := c.Start(); !errors.Is(err, ErrRunning) {
+		t.Errorf("Start() error = %v, want ErrRunning", err)
+	}
+}
+
+func TestChecker_FetchRefusesPrivateAddresses(t *testing.T) {
+	c, _ := newTestChecker(t)
+	for _, rawURL := range []string{"http://127.0.0.1/admin", "https://10.0.0.1/", "http://localhost:8080/"} {
+		if got := c.fetch(context.Background(), rawURL); got.Status != StatusSkipped {
+			t.Errorf("fetch(%s) = %+v, want skipped", rawURL, got)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package linkcheck
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// maxAnchorTextLength caps the stored anchor text of a link, in runes.
+const maxAnchorTextLength = 200
+
+// Link is a link found in HTML content.
+type Link struct {
+	URL  string // The href attribute as written
+	Text string // The anchor text, whitespace collapsed
+}
+
+// ExtractLinks returns the links of the <a href> elements of an HTML
+// fragment in document order. Fragment-only, mailto:, tel:, javascript: and
+// data: links are left out, as there is nothing to check.
+func ExtractLinks(body string) []Link {
+	context := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(body), context)
+	if err != nil {
+		return nil
+	}
+	var links []Link
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.DataAtom == atom.A {
+			if href := strings.TrimSpace(attr(n, "href")); checkable(href) {
+				links = append(links, Link{URL: href, Text: anchorText(n)})
+			}
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	for _, n := range nodes {
+		walk(n)
+	}
+	return links
+}
+
+// checkable reports whether a link target can be checked.
+func checkable(href string) bool {
+	if href == "" || strings.HasPrefix(href, "#") {
+		return false
+	}
+	scheme, _, found := strings.Cut(href, ":")
+	if !found || strings.ContainsAny(scheme, "/?#") {
+		return true // Relative URL
+	}
+	switch strings.ToLower(scheme) {
+	case "http", "https":
+		return true
+	default:
+		return false
+	}
+}
+
+// anchorText returns the text of a link, falling back to its title or the
+// alt text of an image inside it.
+func anchorText(a *html.Node) string {
+	var b strings.Builder
+	var alt string
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		switch {
+		case n.Type == html.TextNode:
+			b.WriteString(n.Data)
+			b.WriteByte(' ')
+		case n.Type == html.ElementNode && n.DataAtom == atom.Img && alt == "":
+			alt = attr(n, "alt")
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(a)
+
+	text := strings.Join(strings.Fields(b.String()), " ")
+	if text == "" {
+		text = strings.TrimSpace(attr(a, "title"))
+	}
+	if text == "" {
+		text = strings.TrimSpace(alt)
+	}
+	return truncate(text, maxAnchorTextLength)
+}
+
+// attr returns the value of an attribute of n, or "".
+func attr(n *html.Node, name string) string {
+	for _, a := range n.Attr {
+		if a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// truncate shortens s to at most n runes.
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n-1]) + "…"
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package linkcheck
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtractLinks(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []Link
+	}{
+		{
+			name: "text and relative links",
+			body: `<p>See <a href="/about">our  <b>team</b></a> and <a href="contact">contact</a>.</p>`,
+			want: []Link{{URL: "/about", Text: "our team"}, {URL: "contact", Text: "contact"}},
+		},
+		{
+			name: "external link",
+			body: `<a href=" https://example.com/a?b=c ">Example</a>`,
+			want: []Link{{URL: "https://example.com/a?b=c", Text: "Example"}},
+		},
+		{
+			name: "title and image alt fallbacks",
+			body: `<a href="/a" title="Title"></a><a href="/b"><img src="x.png" alt="Logo"></a>`,
+			want: []Link{{URL: "/a", Text: "Title"}, {URL: "/b", Text: "Logo"}},
+		},
+		{
+			name: "nothing to check",
+			body: `<a href="#top">Top</a><a href="mailto:a@example.com">Mail</a><a href="javascript:void(0)">JS</a><a>No href</a><a href="">Empty</a>`,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractLinks(tt.body); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractLinks() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractLinks_TruncatesAnchorText(t *testing.T) {
+	links := ExtractLinks(`<a href="/long">` + strings.Repeat("word ", 100) + `</a>`)
+	if len(links) != 1 {
+		t.Fatalf("ExtractLinks() returned %d links, want 1", len(links))
+	}
+	if n := len([]rune(links[0].Text)); n != maxAnchorTextLength || !strings.HasSuffix(links[0].Text, "…") {
+		t.Errorf("anchor text has %d runes (%q...), want %d ending in an ellipsis", n, links[0].Text[:10], maxAnchorTextLength)
+	}
+}
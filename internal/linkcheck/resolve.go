@@ -0,0 +1,212 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package linkcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/util"
+)
+
+// resolver decides whether internal links lead somewhere, from the database
+// rather than by requesting them, so checks do not show up in analytics or
+// the 404 log.
+type resolver struct {
+	queries         *store.Queries
+	uploadsDir      string
+	defaultLanguage string
+	languages       map[string]bool            // Routable active language codes
+	hostLanguages   map[string]string          // Language host -> language code
+	siteHosts       map[string]bool            // Hosts of the site URL and of the sites
+	siteIDs         []int64                    // Sites whose pages are checked
+	pagePaths       map[string]map[string]bool // Language code -> published page paths
+	pageURLs        map[int64]string           // Page ID -> public path, for relative links
+}
+
+// newResolver loads the languages, hosts and published page paths.
+func newResolver(ctx context.Context, queries *store.Queries, uploadsDir string) (*resolver, error) {
+	defaultLanguage, err := queries.GetDefaultLanguage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get default language: %w", err)
+	}
+	languages, err := queries.ListActiveLanguages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list languages: %w", err)
+	}
+
+	r := &resolver{
+		queries:         queries,
+		uploadsDir:      uploadsDir,
+		defaultLanguage: defaultLanguage.Code,
+		languages:       make(map[string]bool),
+		hostLanguages:   make(map[string]string),
+		siteHosts:       make(map[string]bool),
+		pagePaths:       make(map[string]map[string]bool),
+		pageURLs:        make(map[int64]string),
+	}
+	if cfg, err := queries.GetConfigByKey(ctx, "site_url"); err == nil {
+		if u, err := url.Parse(cfg.Value); err == nil && u.Hostname() != "" {
+			r.siteHosts[strings.ToLower(u.Hostname())] = true
+		}
+	}
+	sites, err := queries.ListSites(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sites: %w", err)
+	}
+	for _, site := range sites {
+		r.siteIDs = append(r.siteIDs, site.ID)
+		if site.Host != "" {
+			r.siteHosts[strings.ToLower(site.Host)] = true
+		}
+	}
+
+	for _, language := range languages {
+		if !util.IsRoutableLanguageCode(language.Code) {
+			continue
+		}
+		r.languages[language.Code] = true
+		if language.Host != "" {
+			r.hostLanguages[strings.ToLower(language.Host)] = language.Code
+		}
+		if err := r.loadPagePaths(ctx, language.Code, language.Code == defaultLanguage.Code); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// publishedAliasExists reports whether alias leads to a published page on
+// any site, as the page paths do.
+func (r *resolver) publishedAliasExists(ctx context.Context, alias string) bool {
+	for _, siteID := range r.siteIDs {
+		if _, err := r.queries.GetPublishedPageByAlias(ctx, store.GetPublishedPageByAliasParams{Alias: alias, SiteID: siteID}); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// loadPagePaths records the nested paths of the published pages of a
+// language on every site.
+func (r *resolver) loadPagePaths(ctx context.Context, languageCode string, isDefault bool) error {
+	var rows []store.ListPageTreeNodesRow
+	for _, siteID := range r.siteIDs {
+		siteRows, err := r.queries.ListPageTreeNodes(ctx, store.ListPageTreeNodesParams{LanguageCode: languageCode, SiteID: siteID})
+		if err != nil {
+			return fmt.Errorf("list pages of %s: %w", languageCode, err)
+		}
+		rows = append(rows, siteRows...)
+	}
+	nodes := make(map[int64]store.ListPageTreeNodesRow, len(rows))
+	for _, row := range rows {
+		nodes[row.ID] = row
+	}
+
+	prefix := ""
+	if !isDefault {
+		prefix = "/" + languageCode
+	}
+	paths := make(map[string]bool)
+	for _, row := range rows {
+		if row.Status != "published" {
+			continue
+		}
+		pagePath := row.Slug
+		seen := map[int64]bool{row.ID: true}
+		for parent := row.ParentID; parent.Valid && !seen[parent.Int64]; {
+			node, ok := nodes[parent.Int64]
+			if !ok {
+				break
+			}
+			seen[node.ID] = true
+			pagePath = node.Slug + "/" + pagePath
+			parent = node.ParentID
+		}
+		paths[pagePath] = true
+		r.pageURLs[row.ID] = prefix + "/" + pagePath
+	}
+	r.pagePaths[languageCode] = paths
+	return nil
+}
+
+// isInternal reports whether a link with host points at this site.
+func (r *resolver) isInternal(u *url.URL) bool {
+	if u.Host == "" {
+		return true
+	}
+	host := strings.ToLower(u.Hostname())
+	_, isLanguageHost := r.hostLanguages[host]
+	return r.siteHosts[host] || isLanguageHost
+}
+
+// resolve checks an internal link.
+func (r *resolver) resolve(ctx context.Context, u *url.URL) Result {
+	linkPath := u.Path
+	if linkPath == "" {
+		linkPath = "/"
+	}
+
+	// Exact redirects answer before routing
+	if redirect, err := r.queries.GetRedirectBySourcePath(ctx, linkPath); err == nil && redirect.Enabled {
+		return Result{Status: StatusOK, StatusCode: redirect.StatusCode}
+	}
+
+	language, hosted := r.hostLanguages[strings.ToLower(u.Hostname())]
+	if !hosted {
+		language = r.defaultLanguage
+	}
+	rest := strings.Trim(linkPath, "/")
+	first, remainder, _ := strings.Cut(rest, "/")
+	if !hosted && r.languages[first] {
+		language, rest = first, remainder
+		first, remainder, _ = strings.Cut(rest, "/")
+	}
+
+	var found bool
+	switch {
+	case rest == "", first == "blog", first == "search":
+		found = true
+	case first == "tag":
+		count, err := r.queries.TagSlugExistsForLanguage(ctx, store.TagSlugExistsForLanguageParams{Slug: remainder, LanguageCode: language})
+		found = err == nil && count > 0
+	case first == "category":
+		count, err := r.queries.CategorySlugExistsForLanguage(ctx, store.CategorySlugExistsForLanguageParams{Slug: remainder, LanguageCode: language})
+		found = err == nil && count > 0
+	case first == "page":
+		id, err := strconv.ParseInt(remainder, 10, 64)
+		if err == nil {
+			_, err = r.queries.GetPublishedPageByID(ctx, id)
+		}
+		found = err == nil
+	case first == "uploads":
+		if r.uploadsDir == "" {
+			return Result{Status: StatusSkipped, Error: "uploads directory not configured"}
+		}
+		info, err := os.Stat(filepath.Join(r.uploadsDir, filepath.FromSlash(path.Clean("/"+remainder))))
+		found = err == nil && !info.IsDir()
+	case util.IsReservedLanguageCode(first) || strings.Contains(first, "."):
+		// Other application routes and static files are not resolved
+		return Result{Status: StatusSkipped, Error: "not a content URL"}
+	default:
+		if r.pagePaths[language][rest] {
+			found = true
+		} else {
+			found = r.publishedAliasExists(ctx, rest)
+		}
+	}
+
+	if !found {
+		return Result{Status: StatusBroken, StatusCode: http.StatusNotFound, Error: "page not found"}
+	}
+	return Result{Status: StatusOK, StatusCode: http.StatusOK}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package machinetranslation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultDeepLURL is the API of DeepL's free plan. Paid plans use
+	// https://api.deepl.com.
+	DefaultDeepLURL = "https://api-free.deepl.com"
+
+	// deepLBatchSize is the maximum number of texts per DeepL request.
+	deepLBatchSize = 50
+
+	// deepLTimeout bounds a single DeepL request. Translations run while
+	// the editor waits for the Translate action, so keep it short.
+	deepLTimeout = 15 * time.Second
+
+	// deepLMaxResponse caps the response body read from DeepL.
+	deepLMaxResponse = 16 << 20
+)
+
+// DeepL translates with the DeepL API v2 in HTML mode.
+type DeepL struct {
+	url    string
+	apiKey string
+	client *http.Client
+}
+
+// NewDeepL creates a DeepL provider for the API at baseURL, or at
+// DefaultDeepLURL when baseURL is empty.
+func NewDeepL(baseURL, apiKey string) *DeepL {
+	if baseURL == "" {
+		baseURL = DefaultDeepLURL
+	}
+	return &DeepL{
+		url:    strings.TrimRight(baseURL, "/") + "/v2/translate",
+		apiKey: apiKey,
+		client: &http.Client{Timeout: deepLTimeout},
+	}
+}
+
+// Name returns the provider name.
+func (d *DeepL) Name() string {
+	return "DeepL"
+}
+
+type deepLRequest struct {
+	Text               []string `json:"text"`
+	SourceLang         string   `json:"source_lang"`
+	TargetLang         string   `json:"target_lang"`
+	TagHandling        string   `json:"tag_handling"`
+	IgnoreTags         []string `json:"ignore_tags"`
+	PreserveFormatting bool     `json:"preserve_formatting"`
+}
+
+type deepLResponse struct {
+	Translations []struct {
+		Text string `json:"text"`
+	} `json:"translations"`
+	Message string `json:"message"`
+}
+
+// Translate translates the texts in batches of up to 50.
+func (d *DeepL) Translate(ctx context.Context, source, target string, texts []string) ([]string, error) {
+	result := make([]string, 0, len(texts))
+	for batch := range slices.Chunk(texts, deepLBatchSize) {
+		translated, err := d.translate(ctx, source, target, batch)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, translated...)
+	}
+	return result, nil
+}
+
+func (d *DeepL) translate(ctx context.Context, source, target string, texts []string) ([]string, error) {
+	// DeepL takes the source language without a region ("EN"), but the
+	// target language with one where it matters ("PT-BR").
+	sourceLang, _, _ := strings.Cut(source, "-")
+	body, err := json.Marshal(deepLRequest{
+		Text:               texts,
+		SourceLang:         strings.ToUpper(sourceLang),
+		TargetLang:         strings.ToUpper(target),
+		TagHandling:        "html",
+		IgnoreTags:         []string{IgnoreTag},
+		PreserveFormatting: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode deepl request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create deepl request: %w", err)
+	}
+	req.Header.Set("Authorization", "DeepL-Auth-Key "+d.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("deepl request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var result deepLResponse
+	decodeErr := json.NewDecoder(io.LimitReader(resp.Body, deepLMaxResponse)).Decode(&result)
+	if resp.StatusCode != http.StatusOK {
+		switch {
+		case resp.StatusCode == http.StatusForbidden:
+			return nil, fmt.Errorf("deepl rejected the API key")
+		case resp.StatusCode == 456:
+			return nil, fmt.Errorf("deepl quota exceeded")
+		case result.Message != "":
+			return nil, fmt.Errorf("deepl returned %d: %s", resp.StatusCode, result.Message)
+		}
+		return nil, fmt.Errorf("deepl returned %d", resp.StatusCode)
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("decode deepl response: %w", decodeErr)
+	}
+	if len(result.Translations) != len(texts) {
+		return nil, fmt.Errorf("deepl returned %d translations for %d texts", len(result.Translations), len(texts))
+	}
+
+	translated := make([]string, len(texts))
+	for i, t := range result.Translations {
+		translated[i] = t.Text
+	}
+	return translated, nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package machinetranslation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDeepLTranslate(t *testing.T) {
+	var requests []deepLRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/translate" || r.Header.Get("Authorization") != "DeepL-Auth-Key secret" {
+			http.Error(w, `{"message":"bad request"}`, http.StatusBadRequest)
+			return
+		}
+		var req deepLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		requests = append(requests, req)
+		var resp deepLResponse
+		for _, text := range req.Text {
+			resp.Translations = append(resp.Translations, struct {
+				Text string `json:"text"`
+			}{Text: strings.ToUpper(text)})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	texts := make([]string, deepLBatchSize+1)
+	for i := range texts {
+		texts[i] = fmt.Sprintf("text %d", i)
+	}
+	got, err := NewDeepL(srv.URL+"/", "secret").Translate(context.Background(), "en-us", "pt-br", texts)
+	if err != nil {
+		t.Fatalf("Translate() error = %v", err)
+	}
+	if len(got) != len(texts) || got[deepLBatchSize] != "TEXT 50" {
+		t.Errorf("Translate() = %d texts ending with %q, want %d ending with TEXT 50", len(got), got[len(got)-1], len(texts))
+	}
+	if len(requests) != 2 {
+		t.Fatalf("requests = %d, want 2 batches", len(requests))
+	}
+	req := requests[0]
+	if req.SourceLang != "EN" || req.TargetLang != "PT-BR" || req.TagHandling != "html" || !slices.Equal(req.IgnoreTags, []string{IgnoreTag}) {
+		t.Errorf("request = %+v, want EN to PT-BR in HTML mode ignoring %s", req, IgnoreTag)
+	}
+}
+
+func TestDeepLErrors(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		want   string
+	}{
+		{http.StatusForbidden, `{}`, "API key"},
+		{456, `{}`, "quota"},
+		{http.StatusBadRequest, `{"message":"Value for 'target_lang' not supported."}`, "target_lang"},
+		{http.StatusOK, `{"translations":[]}`, "0 translations for 1 texts"},
+	}
+	for _, tt := range tests {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(tt.status)
+			_, _ = w.Write([]byte(tt.body))
+		}))
+		_, err := NewDeepL(srv.URL, "secret").Translate(context.Background(), "en", "xx", []string{"Hello"})
+		srv.Close()
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("status %d: error = %v, want %q", tt.status, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package machinetranslation pre-fills new translations using a machine
+// translation service. Providers translate batches of HTML fragments; the
+// Translator in front of them keeps shortcodes and plain text intact.
+package machinetranslation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Provider names accepted by NewProvider.
+const (
+	ProviderDeepL = "deepl"
+	ProviderStub  = "stub"
+)
+
+// IgnoreTag is the element the Translator wraps around text that must not be
+// translated. Providers return its content unchanged.
+const IgnoreTag = "x-sc"
+
+// Provider defines the interface for machine translation services.
+type Provider interface {
+	// Name returns the display name of the provider (e.g., "DeepL").
+	Name() string
+	// Translate translates HTML fragments from the source to the target
+	// language and returns one fragment per input, in the same order.
+	// Markup is kept and IgnoreTag elements are returned as they are.
+	Translate(ctx context.Context, source, target string, texts []string) ([]string, error)
+}
+
+// Options configures the provider created by NewProvider.
+type Options struct {
+	APIKey string // DeepL authentication key
+	APIURL string // DeepL API base URL, e.g. https://api-free.deepl.com
+}
+
+// NewProvider returns the named provider, or nil when name is empty.
+func NewProvider(name string, opts Options) (Provider, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "":
+		return nil, nil
+	case ProviderDeepL:
+		if opts.APIKey == "" {
+			return nil, fmt.Errorf("the DeepL provider needs an API key")
+		}
+		return NewDeepL(opts.APIURL, opts.APIKey), nil
+	case ProviderStub:
+		return Stub{}, nil
+	}
+	return nil, fmt.Errorf("unknown machine translation provider %q", name)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package machinetranslation
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// stubTokenPattern matches what the stub leaves alone: IgnoreTag elements
+// and any other tag.
+var stubTokenPattern = regexp.MustCompile(`<` + IgnoreTag + `>[^<]*</` + IgnoreTag + `>|<[^>]*>`)
+
+// Stub is a deterministic provider for tests and local development. It
+// prefixes every text run with the target language code in brackets, so
+// "<p>Hello</p>" becomes "<p>[de] Hello</p>".
+type Stub struct{}
+
+// Name returns the provider name.
+func (Stub) Name() string {
+	return "Stub"
+}
+
+// Translate prefixes the text runs of the texts.
+func (Stub) Translate(_ context.Context, _, target string, texts []string) ([]string, error) {
+	result := make([]string, len(texts))
+	for i, text := range texts {
+		var b strings.Builder
+		last := 0
+		for _, loc := range stubTokenPattern.FindAllStringIndex(text, -1) {
+			b.WriteString(stubPrefix(text[last:loc[0]], target))
+			b.WriteString(text[loc[0]:loc[1]])
+			last = loc[1]
+		}
+		b.WriteString(stubPrefix(text[last:], target))
+		result[i] = b.String()
+	}
+	return result, nil
+}
+
+// stubPrefix prefixes a text run that is not blank, keeping the whitespace
+// around it.
+func stubPrefix(run, target string) string {
+	trimmed := strings.TrimSpace(run)
+	if trimmed == "" {
+		return run
+	}
+	start := strings.Index(run, trimmed)
+	return run[:start] + "[" + target + "] " + run[start:]
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package machinetranslation
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// shortcodePattern matches a shortcode call as written in page bodies,
+	// see package shortcode.
+	shortcodePattern = regexp.MustCompile(`\[\[[a-z][a-z0-9_-]{0,63}(?::[^\s\]]+)?(?:\s+[^\]\n]*)?\]\]`)
+
+	// placeholderPattern matches a masked shortcode.
+	placeholderPattern = regexp.MustCompile(`<` + IgnoreTag + `>(\d+)</` + IgnoreTag + `>`)
+)
+
+// Segment is a text to translate. HTML segments are sent as they are; plain
+// text is escaped first, so characters such as "<" or "&" in a title come
+// back unchanged.
+type Segment struct {
+	Text string
+	HTML bool
+}
+
+// Text returns a plain text segment.
+func Text(s string) Segment {
+	return Segment{Text: s}
+}
+
+// HTML returns an HTML segment.
+func HTML(s string) Segment {
+	return Segment{Text: s, HTML: true}
+}
+
+// Translator translates segments with a provider, keeping shortcodes out of
+// the provider's reach.
+type Translator struct {
+	provider Provider
+}
+
+// New creates a Translator for the provider. It returns nil when provider is
+// nil, and a nil Translator is disabled.
+func New(provider Provider) *Translator {
+	if provider == nil {
+		return nil
+	}
+	return &Translator{provider: provider}
+}
+
+// Enabled reports whether a provider is configured.
+func (t *Translator) Enabled() bool {
+	return t != nil
+}
+
+// Name returns the name of the provider.
+func (t *Translator) Name() string {
+	if t == nil {
+		return ""
+	}
+	return t.provider.Name()
+}
+
+// Translate translates the segments from the source to the target language
+// and returns one text per segment. Blank segments are returned as they are
+// without calling the provider. Shortcodes are replaced by IgnoreTag
+// placeholders before translation and restored afterwards; a translation
+// that loses or duplicates a placeholder is an error.
+func (t *Translator) Translate(ctx context.Context, source, target string, segments []Segment) ([]string, error) {
+	result := make([]string, len(segments))
+	var (
+		texts     []string
+		indexes   []int
+		shortcode [][]string
+	)
+	for i, seg := range segments {
+		result[i] = seg.Text
+		if strings.TrimSpace(seg.Text) == "" {
+			continue
+		}
+		masked, codes := mask(seg)
+		texts = append(texts, masked)
+		indexes = append(indexes, i)
+		shortcode = append(shortcode, codes)
+	}
+	if len(texts) == 0 {
+		return result, nil
+	}
+
+	translated, err := t.provider.Translate(ctx, source, target, texts)
+	if err != nil {
+		return nil, err
+	}
+	if len(translated) != len(texts) {
+		return nil, fmt.Errorf("%s returned %d translations for %d texts", t.provider.Name(), len(translated), len(texts))
+	}
+	for n, i := range indexes {
+		text, err := unmask(translated[n], shortcode[n], segments[i].HTML)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", t.provider.Name(), err)
+		}
+		result[i] = text
+	}
+	return result, nil
+}
+
+// mask replaces the shortcodes of a segment with numbered placeholders and
+// escapes plain text around them.
+func mask(seg Segment) (string, []string) {
+	var (
+		b     strings.Builder
+		codes []string
+		last  int
+	)
+	write := func(s string) {
+		if !seg.HTML {
+			s = html.EscapeString(s)
+		}
+		b.WriteString(s)
+	}
+	for _, loc := range shortcodePattern.FindAllStringIndex(seg.Text, -1) {
+		write(seg.Text[last:loc[0]])
+		fmt.Fprintf(&b, "<%s>%d</%s>", IgnoreTag, len(codes), IgnoreTag)
+		codes = append(codes, seg.Text[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	write(seg.Text[last:])
+	return b.String(), codes
+}
+
+// unmask restores the shortcodes of a translated segment and unescapes
+// plain text. Every placeholder must appear exactly once.
+func unmask(text string, codes []string, isHTML bool) (string, error) {
+	var (
+		b    strings.Builder
+		seen = make([]bool, len(codes))
+		last int
+	)
+	write := func(s string) {
+		if !isHTML {
+			s = html.UnescapeString(s)
+		}
+		b.WriteString(s)
+	}
+	for _, m := range placeholderPattern.FindAllStringSubmatchIndex(text, -1) {
+		n, err := strconv.Atoi(text[m[2]:m[3]])
+		if err != nil || n >= len(codes) || seen[n] {
+			return "", fmt.Errorf("unexpected shortcode placeholder %q", text[m[0]:m[1]])
+		}
+		seen[n] = true
+		write(text[last:m[0]])
+		b.WriteString(codes[n])
+		last = m[1]
+	}
+	write(text[last:])
+	for n, ok := range seen {
+		if !ok {
+			return "", fmt.Errorf("shortcode %s lost in translation", codes[n])
+		}
+	}
+	return b.String(), nil
+}
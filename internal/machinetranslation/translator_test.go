@@ -0,0 +1,91 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package machinetranslation
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// dropProvider loses every shortcode placeholder.
+type dropProvider struct{}
+
+func (dropProvider) Name() string { return "Drop" }
+
+func (dropProvider) Translate(_ context.Context, _, _ string, texts []string) ([]string, error) {
+	result := make([]string, len(texts))
+	for i, text := range texts {
+		result[i] = placeholderPattern.ReplaceAllString(text, "")
+	}
+	return result, nil
+}
+
+func TestTranslatorTranslate(t *testing.T) {
+	tr := New(Stub{})
+	segments := []Segment{
+		Text("Tom & Jerry <3"),
+		HTML(`<p>Hello <a href="/about">world</a></p>[[form:contact]]<p>Read [[snippet:cta title="Two words"]] now</p>`),
+		Text(""),
+		HTML("  "),
+		Text("[[snippet:only]]"),
+	}
+	got, err := tr.Translate(context.Background(), "en", "de", segments)
+	if err != nil {
+		t.Fatalf("Translate() error = %v", err)
+	}
+	want := []string{
+		"[de] Tom & Jerry <3",
+		`<p>[de] Hello <a href="/about">[de] world</a></p>[[form:contact]]<p>[de] Read [[snippet:cta title="Two words"]] [de] now</p>`,
+		"",
+		"  ",
+		"[[snippet:only]]",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Translate() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestTranslatorLostShortcode(t *testing.T) {
+	_, err := New(dropProvider{}).Translate(context.Background(), "en", "de", []Segment{HTML("<p>[[form:contact]]</p>")})
+	if err == nil || !strings.Contains(err.Error(), "[[form:contact]]") {
+		t.Errorf("Translate() error = %v, want the lost shortcode", err)
+	}
+}
+
+func TestTranslatorDisabled(t *testing.T) {
+	var tr *Translator
+	if tr.Enabled() || tr.Name() != "" {
+		t.Errorf("nil Translator is enabled")
+	}
+	if New(nil) != nil {
+		t.Errorf("New(nil) != nil")
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    Options
+		want    string
+		wantErr bool
+	}{
+		{name: "", want: ""},
+		{name: "stub", want: "Stub"},
+		{name: "DeepL", opts: Options{APIKey: "key"}, want: "DeepL"},
+		{name: "deepl", wantErr: true},
+		{name: "google", wantErr: true},
+	}
+	for _, tt := range tests {
+		p, err := NewProvider(tt.name, tt.opts)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NewProvider(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if got := New(p).Name(); !tt.wantErr && got != tt.want {
+			t.Errorf("NewProvider(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package mail sends plain-text email through an SMTP server, used to notify
+// administrators about problems that need their attention and members about
+// their account.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// dialTimeout bounds connecting to the SMTP server.
+	dialTimeout = 10 * time.Second
+	// sendTimeout bounds a whole SMTP conversation.
+	sendTimeout = 30 * time.Second
+)
+
+// Sender sends an email.
+type Sender interface {
+	Send(ctx context.Context, to []string, subject, body string) error
+}
+
+// Config holds the SMTP server settings.
+type Config struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPSender sends email through an SMTP server. STARTTLS is used when the
+// server offers it; credentials are only sent over TLS or to localhost.
+type SMTPSender struct {
+	cfg Config
+}
+
+// NewSMTPSender creates a new SMTPSender.
+func NewSMTPSender(cfg Config) *SMTPSender {
+	if cfg.Port == 0 {
+		cfg.Port = 587
+	}
+	return &SMTPSender{cfg: cfg}
+}
+
+// Send sends a plain-text email.
+func (s *SMTPSender) Send(ctx context.Context, to []string, subject, body string) error {
+	if len(to) == 0 {
+		return errors.New("no recipients")
+	}
+	msg, err := buildMessage(s.cfg.From, to, subject, body, time.Now())
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+	conn, err := (&net.Dialer{Timeout: dialTimeout}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("connect to %s: %w", addr, err)
+	}
+	_ = conn.SetDeadline(time.Now().Add(sendTimeout))
+
+	c, err := smtp.NewClient(conn, s.cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer func() { _ = c.Close() }()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: s.cfg.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("starttls: %w", err)
+		}
+	}
+	if s.cfg.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+	if err := c.Mail(s.cfg.From); err != nil {
+		return fmt.Errorf("smtp MAIL FROM: %w", err)
+	}
+	for _, addr := range to {
+		if err := c.Rcpt(addr); err != nil {
+			return fmt.Errorf("smtp RCPT TO %s: %w", addr, err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("send message: %w", err)
+	}
+	return c.Quit()
+}
+
+// buildMessage formats a plain-text message. Header values may not contain
+// line breaks, so user-controlled text can't add headers.
+func buildMessage(from string, to []string, subject, body string, date time.Time) ([]byte, error) {
+	for _, v := range append([]string{from, subject}, to...) {
+		if strings.ContainsAny(v, "\r\n") {
+			return nil, errors.New("mail header contains a line break")
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(body, "\r\n", "\n"), "\n", "\r\n"))
+	return b.Bytes(), nil
+}
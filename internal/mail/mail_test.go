@@ -0,0 +1,115 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package mail
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/testutil"
+)
+
+func TestBuildMessage(t *testing.T) {
+	date := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	msg, err := buildMessage("ocms@example.com", []string{"a@example.com", "b@example.com"}, "Webhook paused: Café", "line one\nline two", date)
+	if err != nil {
+		t.Fatalf("buildMessage() error = %v", err)
+	}
+	got := string(msg)
+	for _, want := range []string{
+		"From: ocms@example.com\r\n",
+		"To: a@example.com, b@example.com\r\n",
+		"Subject: =?utf-8?q?Webhook_paused:_Caf=C3=A9?=\r\n",
+		"Content-Type: text/plain; charset=utf-8\r\n",
+		"\r\n\r\nline one\r\nline two",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("message does not contain %q:\n%s", want, got)
+		}
+	}
+
+	if _, err := buildMessage("ocms@example.com", []string{"a@example.com"}, "Hi\r\nBcc: evil@example.com", "", date); err == nil {
+		t.Error("buildMessage() accepted a subject with a line break")
+	}
+}
+
+// recordingSender records the emails it is asked to send.
+type recordingSender struct {
+	to      []string
+	subject string
+	body    string
+}
+
+func (s *recordingSender) Send(_ context.Context, to []string, subject, body string) error {
+	s.to, s.subject, s.body = to, subject, body
+	return nil
+}
+
+func TestAdminNotifier_NotifyWebhookPaused(t *testing.T) {
+	db, cleanup := testutil.TestDB(t)
+	defer cleanup()
+	queries := store.New(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	for _, u := range []struct{ email, role string }{
+		{"admin@example.com", "admin"},
+		{"editor@example.com", "editor"},
+		{"second-admin@example.com", "admin"},
+	} {
+		if _, err := queries.CreateUser(ctx, store.CreateUserParams{
+			Email: u.email, PasswordHash: "x", Role: u.role, Name: u.email, CreatedAt: now, UpdatedAt: now,
+		}); err != nil {
+			t.Fatalf("CreateUser: %v", err)
+		}
+	}
+
+	sender := &recordingSender{}
+	n := NewAdminNotifier(db, sender)
+	wh := store.Webhook{ID: 4, Name: "Chat\nops", Url: "https://hooks.example.com/in", PausedAt: sql.NullTime{Time: now, Valid: true}}
+	if err := n.NotifyWebhookPaused(ctx, wh, "10 consecutive failed deliveries"); err != nil {
+		t.Fatalf("NotifyWebhookPaused() error = %v", err)
+	}
+
+	if want := []string{"admin@example.com", "second-admin@example.com"}; !reflect.DeepEqual(sender.to, want) {
+		t.Errorf("recipients = %v, want %v", sender.to, want)
+	}
+	if sender.subject != "[oCMS] Webhook paused: Chat ops" {
+		t.Errorf("subject = %q", sender.subject)
+	}
+	for _, want := range []string{"https://hooks.example.com/in", "10 consecutive failed deliveries", "/admin/webhooks/4"} {
+		if !strings.Contains(sender.body, want) {
+			t.Errorf("body does not contain %q:\n%s", want, sender.body)
+		}
+	}
+}
+
+func TestMemberNotifier_NotifyRegistrationAttempt(t *testing.T) {
+	db, cleanup := testutil.TestDB(t)
+	defer cleanup()
+	queries := store.New(db)
+	ctx := context.Background()
+	if _, err := queries.UpsertConfig(ctx, store.UpsertConfigParams{Key: "site_url", Value: "https://example.com/", Type: "string", UpdatedAt: time.Now()}); err != nil {
+		t.Fatalf("UpsertConfig: %v", err)
+	}
+
+	sender := &recordingSender{}
+	n := NewMemberNotifier(db, sender)
+	user := store.User{ID: 7, Email: "ann@example.com", Name: "Ann"}
+	if err := n.NotifyRegistrationAttempt(ctx, user, "/ru/members/login"); err != nil {
+		t.Fatalf("NotifyRegistrationAttempt() error = %v", err)
+	}
+
+	if want := []string{"ann@example.com"}; !reflect.DeepEqual(sender.to, want) {
+		t.Errorf("recipients = %v, want %v", sender.to, want)
+	}
+	if !strings.Contains(sender.body, "https://example.com/ru/members/login") {
+		t.Errorf("body does not contain the login URL:\n%s", sender.body)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package mail
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// AdminNotifier emails all administrators.
+type AdminNotifier struct {
+	queries *store.Queries
+	sender  Sender
+}
+
+// NewAdminNotifier creates a new AdminNotifier.
+func NewAdminNotifier(db *sql.DB, sender Sender) *AdminNotifier {
+	return &AdminNotifier{
+		queries: store.New(db),
+		sender:  sender,
+	}
+}
+
+// NotifyWebhookPaused tells the administrators that the circuit breaker
+// paused a webhook.
+func (n *AdminNotifier) NotifyWebhookPaused(ctx context.Context, wh store.Webhook, reason string) error {
+	var body strings.Builder
+	fmt.Fprintf(&body, "The webhook %q was paused after repeated delivery failures.\n\n", wh.Name)
+	fmt.Fprintf(&body, "URL: %s\n", wh.Url)
+	fmt.Fprintf(&body, "Reason: %s\n\n", reason)
+	body.WriteString("New events are stored in the dead-letter queue until the webhook is resumed.\n")
+	fmt.Fprintf(&body, "Fix the endpoint, then resume the webhook and replay its dead letters at %s.\n", siteURL(ctx, n.queries, fmt.Sprintf("/admin/webhooks/%d", wh.ID)))
+
+	return n.send(ctx, fmt.Sprintf("[oCMS] Webhook paused: %s", oneLine(wh.Name)), body.String())
+}
+
+// send emails all administrators.
+func (n *AdminNotifier) send(ctx context.Context, subject, body string) error {
+	to, err := n.queries.ListUserEmailsByRole(ctx, "admin")
+	if err != nil {
+		return fmt.Errorf("list administrators: %w", err)
+	}
+	if len(to) == 0 {
+		return nil
+	}
+	return n.sender.Send(ctx, to, subject, body)
+}
+
+// MemberNotifier emails members about their account.
+type MemberNotifier struct {
+	queries *store.Queries
+	sender  Sender
+}
+
+// NewMemberNotifier creates a new MemberNotifier.
+func NewMemberNotifier(db *sql.DB, sender Sender) *MemberNotifier {
+	return &MemberNotifier{
+		queries: store.New(db),
+		sender:  sender,
+	}
+}
+
+// NotifyRegistrationAttempt tells a user that someone tried to register an
+// account with their email address. The registration form itself does not
+// say whether an address is taken.
+func (n *MemberNotifier) NotifyRegistrationAttempt(ctx context.Context, user store.User, loginPath string) error {
+	var body strings.Builder
+	fmt.Fprintf(&body, "Hello %s,\n\n", oneLine(user.Name))
+	body.WriteString("Someone tried to register a new account with this email address, which already has an account.\n\n")
+	fmt.Fprintf(&body, "If it was you, log in at %s instead.\n", siteURL(ctx, n.queries, loginPath))
+	body.WriteString("If it was not you, you can ignore this email; your account was not changed.\n")
+
+	return n.sender.Send(ctx, []string{user.Email}, "[oCMS] Registration attempt with your email address", body.String())
+}
+
+// siteURL returns an absolute URL when the site URL is configured.
+func siteURL(ctx context.Context, queries *store.Queries, path string) string {
+	cfg, err := queries.GetConfigByKey(ctx, "site_url")
+	if err != nil || cfg.Value == "" {
+		return path
+	}
+	return strings.TrimRight(cfg.Value, "/") + path
+}
+
+// oneLine replaces line breaks so text can go into a header.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -192,7 +192,7 @@ func LoadSiteConfig(db *sql.DB, cacheManager *cache.Manager) func(http.Handler)
 					siteName = name
 				}
 			} else if queries != nil {
-				cfg, err := queries.GetConfig(r.Context(), "site_name")
+				cfg, err := queries.GetSiteConfigByKey(r.Context(), "site_name")
 				if err == nil && cfg.Value != "" {
 					siteName = cfg.Value
 				}
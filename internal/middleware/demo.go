@@ -69,6 +69,9 @@ const (
 
 	// RestrictionScheduler blocks scheduler management in demo mode.
 	RestrictionScheduler DemoRestriction = "scheduler"
+
+	// RestrictionContentTypes blocks content type schema changes in demo mode.
+	RestrictionContentTypes DemoRestriction = "content_types"
 )
 
 // DemoModeMessage is the user-friendly message shown when an action is blocked.
@@ -103,6 +106,7 @@ func DemoModeMessageDetailed(restriction DemoRestriction) string {
 		RestrictionClearCache:       "Clearing cache is disabled in demo mode",
 		RestrictionSQLExecution:     "SQL execution is disabled in demo mode",
 		RestrictionScheduler:        "Scheduler management is disabled in demo mode",
+		RestrictionContentTypes:     "Content type management is disabled in demo mode",
 	}
 
 	if msg, ok := messages[restriction]; ok {
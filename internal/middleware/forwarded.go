@@ -0,0 +1,53 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+// RequestHost returns the public host the request was sent to, honouring
+// X-Forwarded-Host (leftmost value) only when the request comes from a
+// configured trusted proxy. Falls back to r.Host. Untrusted clients can set
+// this header but it is ignored to prevent spoofing the bound origin.
+func RequestHost(r *http.Request) string {
+	if WasFromTrustedProxy(r) {
+		if fwd := firstForwardedValue(r.Header.Get("X-Forwarded-Host")); fwd != "" {
+			return fwd
+		}
+	}
+	return r.Host
+}
+
+// RequestScheme returns "http" or "https" for the request, preferring the
+// TLS state on the connection, then X-Forwarded-Proto (leftmost value).
+// X-Forwarded-Proto is honoured only when the immediate peer is a trusted
+// proxy, matching the X-Forwarded-Host gate so untrusted clients cannot
+// spoof the bound scheme.
+func RequestScheme(r *http.Request) string {
+	if r.TLS != nil {
+		return "https"
+	}
+	if WasFromTrustedProxy(r) {
+		if proto := firstForwardedValue(r.Header.Get("X-Forwarded-Proto")); proto != "" {
+			return strings.ToLower(proto)
+		}
+	}
+	return "http"
+}
+
+// firstForwardedValue returns the leftmost value of a possibly comma-separated
+// forwarded header (e.g., X-Forwarded-Host, X-Forwarded-Proto), trimmed.
+// Returns "" if the input is empty or only whitespace.
+func firstForwardedValue(raw string) string {
+	v := strings.TrimSpace(raw)
+	if v == "" {
+		return ""
+	}
+	if comma := strings.Index(v, ","); comma >= 0 {
+		v = strings.TrimSpace(v[:comma])
+	}
+	return v
+}
@@ -23,6 +23,7 @@ const (
 	ContextKeyLanguage     ContextKey = "language"
 	ContextKeyLanguageCode ContextKey = "language_code"
 	ContextKeyLangPrefix   ContextKey = "language_prefix"
+	ContextKeyHostLanguage ContextKey = "host_language"
 )
 
 // LanguageCookieName is the cookie name for language preference.
@@ -33,10 +34,16 @@ const LanguageCookieName = "ocms_lang"
 // In development mode (HTTP), this should be false.
 var secureCookies = true // Default to secure (production mode)
 
-// InitLanguageCookies configures the Secure flag for language cookies.
-// Call this during application startup with isDev=true for development mode.
-func InitLanguageCookies(isDev bool) {
+// cookieDomain is the parent domain language cookies are shared with, or
+// empty for host-only cookies.
+var cookieDomain string
+
+// InitLanguageCookies configures the Secure flag and the domain of language
+// cookies. Call this during application startup with isDev=true for
+// development mode; domain is OCMS_COOKIE_DOMAIN.
+func InitLanguageCookies(isDev bool, domain string) {
 	secureCookies = !isDev
+	cookieDomain = domain
 }
 
 // LanguageInfo holds language data for the request context.
@@ -47,17 +54,21 @@ type LanguageInfo struct {
 	NativeName string
 	Direction  string
 	IsDefault  bool
+	Host       string
 }
 
 // Language creates middleware that detects and sets the current language.
 // Priority order:
 // 1. Active URL prefix (e.g., /ru/page-slug)
-// 2. Query parameter ?lang=XX (explicit language switch, updates cookie)
-// 3. For homepage only: Cookie preference, then Accept-Language header
-// 4. Default language (for all non-prefixed content pages)
+// 2. Request host mapped to a language (e.g., example.de or de.example.com)
+// 3. Query parameter ?lang=XX (explicit language switch, updates cookie)
+// 4. For homepage only: Cookie preference, then Accept-Language header
+// 5. Default language (for all non-prefixed content pages)
 //
 // This ensures that /page-slug always shows in default language,
 // while /ru/page-slug shows in Russian, and the homepage uses user preference.
+// A language mapped to a host owns the unprefixed paths of that host and is
+// served nowhere else: its prefix redirects to the same path on its host.
 // The middleware must wrap the frontend child router so it can rewrite the
 // child's RoutePath before that router selects an endpoint.
 func Language(db *sql.DB) func(http.Handler) http.Handler {
@@ -91,6 +102,13 @@ func Language(db *sql.DB) func(http.Handler) http.Handler {
 
 			langMap := activeLanguageMap(activeLangs)
 
+			// Language hosts belong to the default site; every other site
+			// serves all languages under a path prefix of its own host.
+			if store.SiteIDFromContext(ctx) != store.DefaultSiteID {
+				langMap = withoutLanguageHosts(langMap)
+				defaultLang.Host = ""
+			}
+
 			// 1. An active, non-reserved URL prefix is authoritative. Strip it
 			// from the child router's path before route matching, while retaining
 			// the original request URL for logging and canonical URL handling.
@@ -98,7 +116,12 @@ func Language(db *sql.DB) func(http.Handler) http.Handler {
 			if routeCtx := chi.RouteContext(ctx); routeCtx != nil && routeCtx.RoutePath != "" {
 				routePath = routeCtx.RoutePath
 			}
+			hostLang, onLanguageHost := matchLanguageHost(RequestHost(r), langMap)
 			if lang, strippedPath, ok := matchLanguagePrefix(routePath, langMap); ok {
+				if lang.Host != "" {
+					redirectToLanguageHost(w, r, lang, onLanguageHost && hostLang.Code == lang.Code, strippedPath)
+					return
+				}
 				if routeCtx := chi.RouteContext(ctx); routeCtx != nil {
 					routeCtx.RoutePath = strippedPath
 				}
@@ -108,11 +131,29 @@ func Language(db *sql.DB) func(http.Handler) http.Handler {
 				return
 			}
 
-			// 2. Check query parameter ?lang=XX (explicit language switch).
+			// 2. A host mapped to a language serves that language at its root,
+			// as if every path carried the language prefix.
+			if onLanguageHost {
+				ctx = setLanguageContext(ctx, hostLang)
+				ctx = context.WithValue(ctx, ContextKeyLangPrefix, hostLang.Code)
+				ctx = context.WithValue(ctx, ContextKeyHostLanguage, hostLang.Code)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			// 3. Check query parameter ?lang=XX (explicit language switch).
 			queryLang := r.URL.Query().Get("lang")
 			if queryLang != "" {
 				code := strings.ToLower(queryLang)
 				if lang, ok := langMap[code]; ok {
+					// A language with its own host is switched to there
+					if lang.Host != "" {
+						query := r.URL.Query()
+						query.Del("lang")
+						r.URL.RawQuery = query.Encode()
+						redirectToLanguageHost(w, r, lang, false, r.URL.Path)
+						return
+					}
 					// Update cookie to new language preference
 					SetLanguageCookie(w, lang.Code)
 					ctx = setLanguageContext(ctx, lang)
@@ -121,14 +162,15 @@ func Language(db *sql.DB) func(http.Handler) http.Handler {
 				}
 			}
 
-			// 3. For homepage only, check cookie and Accept-Language header
+			// 4. For homepage only, check cookie and Accept-Language header
 			// Non-prefixed content pages (/{slug}) should use default language
 			isHomepage := r.URL.Path == "/" || r.URL.Path == ""
 			if isHomepage {
-				// Check cookie preference
+				// Check cookie preference. Languages with their own host are
+				// reached through it, so a preference for one is ignored here.
 				if cookie, err := r.Cookie(LanguageCookieName); err == nil {
 					code := strings.ToLower(cookie.Value)
-					if lang, ok := langMap[code]; ok {
+					if lang, ok := langMap[code]; ok && lang.Host == "" {
 						ctx = setLanguageContext(ctx, lang)
 						next.ServeHTTP(w, r.WithContext(ctx))
 						return
@@ -138,7 +180,7 @@ func Language(db *sql.DB) func(http.Handler) http.Handler {
 				// Check Accept-Language header
 				acceptLang := r.Header.Get("Accept-Language")
 				if acceptLang != "" {
-					if lang := matchAcceptLanguage(acceptLang, langMap); lang != nil {
+					if lang := matchAcceptLanguage(acceptLang, prefixLanguageMap(langMap)); lang != nil {
 						ctx = setLanguageContext(ctx, *lang)
 						next.ServeHTTP(w, r.WithContext(ctx))
 						return
@@ -146,7 +188,7 @@ func Language(db *sql.DB) func(http.Handler) http.Handler {
 				}
 			}
 
-			// 4. Fall back to default language
+			// 5. Fall back to default language
 			if defaultRoutable {
 				ctx = setLanguageContext(ctx, defaultLang)
 			}
@@ -175,6 +217,29 @@ func activeLanguageMap(activeLangs []store.Language) map[string]store.Language {
 	return langMap
 }
 
+// prefixLanguageMap returns the languages served under a path prefix of the
+// site host, leaving out those mapped to their own host.
+func prefixLanguageMap(langMap map[string]store.Language) map[string]store.Language {
+	result := make(map[string]store.Language, len(langMap))
+	for code, lang := range langMap {
+		if lang.Host == "" {
+			result[code] = lang
+		}
+	}
+	return result
+}
+
+// withoutLanguageHosts returns a copy of langMap with every language served
+// under a path prefix instead of its own host.
+func withoutLanguageHosts(langMap map[string]store.Language) map[string]store.Language {
+	result := make(map[string]store.Language, len(langMap))
+	for code, lang := range langMap {
+		lang.Host = ""
+		result[code] = lang
+	}
+	return result
+}
+
 // matchLanguagePrefix matches the first path segment against the active
 // language map and returns the path to route after removing that prefix.
 func matchLanguagePrefix(path string, langMap map[string]store.Language) (store.Language, string, bool) {
@@ -195,6 +260,34 @@ func matchLanguagePrefix(path string, langMap map[string]store.Language) (store.
 	return lang, "/" + remainder, true
 }
 
+// matchLanguageHost returns the active language mapped to the request host.
+func matchLanguageHost(requestHost string, langMap map[string]store.Language) (store.Language, bool) {
+	if requestHost == "" {
+		return store.Language{}, false
+	}
+	for _, lang := range langMap {
+		if lang.Host != "" && util.HostsMatch(requestHost, lang.Host) {
+			return lang, true
+		}
+	}
+	return store.Language{}, false
+}
+
+// redirectToLanguageHost permanently redirects a prefixed URL of a language
+// mapped to a host to the unprefixed path on that host, keeping the query.
+// On the language's own host the redirect is relative.
+func redirectToLanguageHost(w http.ResponseWriter, r *http.Request, lang store.Language, onHost bool, path string) {
+	location := path
+	if r.URL.RawQuery != "" {
+		location += "?" + r.URL.RawQuery
+	}
+	if !onHost {
+		location = RequestScheme(r) + "://" + lang.Host + location
+	}
+	w.Header().Set("Location", location)
+	w.WriteHeader(http.StatusMovedPermanently)
+}
+
 // matchAcceptLanguage finds the best matching language from Accept-Language header.
 // Returns the matched language or nil if no match found.
 func matchAcceptLanguage(acceptLang string, langMap map[string]store.Language) *store.Language {
@@ -232,6 +325,7 @@ func setLanguageContext(ctx context.Context, lang store.Language) context.Contex
 		NativeName: lang.NativeName,
 		Direction:  lang.Direction,
 		IsDefault:  lang.IsDefault,
+		Host:       lang.Host,
 	}
 	ctx = context.WithValue(ctx, ContextKeyLanguage, info)
 	ctx = context.WithValue(ctx, ContextKeyLanguageCode, lang.Code)
@@ -255,6 +349,19 @@ func GetLanguagePrefix(r *http.Request) string {
 	return code
 }
 
+// GetHostLanguage returns the code of the language the request host is
+// mapped to, or an empty string when the request host serves no language.
+// Links within such a language need no language prefix.
+func GetHostLanguage(r *http.Request) string {
+	return HostLanguageFromContext(r.Context())
+}
+
+// HostLanguageFromContext is GetHostLanguage for a request context.
+func HostLanguageFromContext(ctx context.Context) string {
+	code, _ := ctx.Value(ContextKeyHostLanguage).(string)
+	return code
+}
+
 // SetLanguageCookie sets the language preference cookie.
 // The Secure flag is set based on the configuration from InitLanguageCookies.
 func SetLanguageCookie(w http.ResponseWriter, langCode string) {
@@ -262,6 +369,7 @@ func SetLanguageCookie(w http.ResponseWriter, langCode string) {
 		Name:     LanguageCookieName,
 		Value:    langCode,
 		Path:     "/",
+		Domain:   cookieDomain,
 		MaxAge:   365 * 24 * 60 * 60, // 1 year
 		HttpOnly: true,
 		SameSite: http.SameSiteLaxMode,
@@ -41,7 +41,8 @@ func newLanguageMiddlewareTestDB(t *testing.T) *sql.DB {
 			direction TEXT NOT NULL DEFAULT 'ltr',
 			position INTEGER NOT NULL DEFAULT 0,
 			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			host TEXT NOT NULL DEFAULT ''
 		);
 		INSERT INTO languages (code, name, native_name, is_default, is_active, position) VALUES
 			('en', 'English', 'English', 1, 1, 0),
@@ -186,6 +187,97 @@ func TestLanguageRouting(t *testing.T) {
 	}
 }
 
+func TestLanguage_HostRouting(t *testing.T) {
+	db := newLanguageMiddlewareTestDB(t)
+	if _, err := db.Exec(`UPDATE languages SET host = 'fr.example.com' WHERE code = 'fr'`); err != nil {
+		t.Fatalf("map language host: %v", err)
+	}
+	router := newLanguageMiddlewareTestRouter(db)
+
+	tests := []struct {
+		name         string
+		host         string
+		path         string
+		header       string
+		wantStatus   int
+		wantBody     string
+		wantLocation string
+	}{
+		{
+			name:       "language host serves its language at the root",
+			host:       "fr.example.com",
+			path:       "/article",
+			wantStatus: http.StatusOK,
+			wantBody:   "page|fr|fr|article",
+		},
+		{
+			name:       "language host matches any port",
+			host:       "FR.example.com:8443",
+			path:       "/",
+			wantStatus: http.StatusOK,
+			wantBody:   "home|fr|fr|",
+		},
+		{
+			name:         "prefix of a hosted language redirects to its host",
+			host:         "example.com",
+			path:         "/fr/article?page=2",
+			wantStatus:   http.StatusMovedPermanently,
+			wantLocation: "http://fr.example.com/article?page=2",
+		},
+		{
+			name:         "prefix on the language's own host is dropped",
+			host:         "fr.example.com",
+			path:         "/fr/article",
+			wantStatus:   http.StatusMovedPermanently,
+			wantLocation: "/article",
+		},
+		{
+			name:         "query switch to a hosted language redirects",
+			host:         "example.com",
+			path:         "/article?lang=fr&q=1",
+			wantStatus:   http.StatusMovedPermanently,
+			wantLocation: "http://fr.example.com/article?q=1",
+		},
+		{
+			name:       "other prefixes keep working on the site host",
+			host:       "example.com",
+			path:       "/zh-hans/tag/news",
+			wantStatus: http.StatusOK,
+			wantBody:   "tag|zh-hans|zh-hans|news",
+		},
+		{
+			name:       "Accept-Language ignores hosted languages",
+			host:       "example.com",
+			path:       "/",
+			header:     "fr",
+			wantStatus: http.StatusOK,
+			wantBody:   "home|en||",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Host = tt.host
+			if tt.header != "" {
+				req.Header.Set("Accept-Language", tt.header)
+			}
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body = %q", rr.Code, tt.wantStatus, rr.Body.String())
+			}
+			if tt.wantBody != "" && rr.Body.String() != tt.wantBody {
+				t.Errorf("body = %q, want %q", rr.Body.String(), tt.wantBody)
+			}
+			if got := rr.Header().Get("Location"); got != tt.wantLocation {
+				t.Errorf("Location = %q, want %q", got, tt.wantLocation)
+			}
+		})
+	}
+}
+
 func TestLanguage_InactiveDefaultIsNotInstalled(t *testing.T) {
 	db := newLanguageMiddlewareTestDB(t)
 	if _, err := db.Exec(`UPDATE languages SET is_active = 0 WHERE is_default = 1`); err != nil {
@@ -469,7 +561,7 @@ func TestGetLanguage(t *testing.T) {
 func TestSetLanguageCookie(t *testing.T) {
 	t.Run("production mode (secure)", func(t *testing.T) {
 		// Reset to production mode (default)
-		InitLanguageCookies(false)
+		InitLanguageCookies(false, "")
 
 		rr := httptest.NewRecorder()
 		SetLanguageCookie(rr, "ru")
@@ -505,7 +597,7 @@ func TestSetLanguageCookie(t *testing.T) {
 
 	t.Run("development mode (not secure)", func(t *testing.T) {
 		// Set to development mode
-		InitLanguageCookies(true)
+		InitLanguageCookies(true, "")
 
 		rr := httptest.NewRecorder()
 		SetLanguageCookie(rr, "en")
@@ -521,6 +613,6 @@ func TestSetLanguageCookie(t *testing.T) {
 		}
 
 		// Reset to production mode for other tests
-		InitLanguageCookies(false)
+		InitLanguageCookies(false, "")
 	})
 }
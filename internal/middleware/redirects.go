@@ -9,6 +9,8 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -23,6 +25,8 @@ type RedirectsMiddleware struct {
 	mu        sync.RWMutex
 	lastLoad  time.Time
 	cacheTTL  time.Duration
+	// patterns caches compiled regex sources; invalid ones are stored as nil.
+	patterns sync.Map
 }
 
 // NewRedirectsMiddleware creates a new redirects middleware.
@@ -48,6 +52,12 @@ func (rm *RedirectsMiddleware) loadRedirects() {
 		return
 	}
 
+	// Rules with a query condition are checked before the plain rule for the
+	// same path, which would otherwise match every query string.
+	slices.SortStableFunc(redirects, func(a, b store.Redirect) int {
+		return boolOrder(hasQueryCondition(b)) - boolOrder(hasQueryCondition(a))
+	})
+
 	rm.mu.Lock()
 	rm.redirects = redirects
 	rm.lastLoad = time.Now()
@@ -79,6 +89,7 @@ func (rm *RedirectsMiddleware) InvalidateCache() {
 	rm.mu.Lock()
 	rm.lastLoad = time.Time{} // Reset to zero time
 	rm.mu.Unlock()
+	rm.patterns.Clear()
 }
 
 // Handler returns the middleware handler function.
@@ -94,21 +105,19 @@ func (rm *RedirectsMiddleware) Handler(next http.Handler) http.Handler {
 		// Check for matching redirect
 		redirects := rm.getRedirects()
 		for _, rd := range redirects {
-			matched, captures := rm.matchPathWithCaptures(path, rd.SourcePath, rd.IsWildcard)
+			targetURL, query, matched := rm.matchRedirect(rd, path, r.URL.RawQuery)
 			if matched {
-				targetURL := rm.buildTargetURL(rd, captures)
-
 				// For _blank target, we can't do a server redirect to open a new window
 				// The browser will follow the redirect in the same window
 				// _blank is mainly useful for links, but we still process it
 				// as a regular redirect (the target_type is informational for HTML links)
 
 				// Preserve query string
-				if r.URL.RawQuery != "" {
+				if query != "" && !rd.DropQuery {
 					if strings.Contains(targetURL, "?") {
-						targetURL += "&" + r.URL.RawQuery
+						targetURL += "&" + query
 					} else {
-						targetURL += "?" + r.URL.RawQuery
+						targetURL += "?" + query
 					}
 				}
 
@@ -134,6 +143,7 @@ func (rm *RedirectsMiddleware) Handler(next http.Handler) http.Handler {
 					return
 				}
 
+				rm.recordHit(rd.ID)
 				http.Redirect(w, r, parsedTarget.String(), int(rd.StatusCode))
 				return
 			}
@@ -143,6 +153,120 @@ func (rm *RedirectsMiddleware) Handler(next http.Handler) http.Handler {
 	})
 }
 
+// matchRedirect matches a request against a redirect rule. It returns the
+// target URL with captures substituted and the part of the request query
+// string that the rule did not match on, which is appended to the target
+// unless the rule drops it.
+func (rm *RedirectsMiddleware) matchRedirect(rd store.Redirect, requestPath, rawQuery string) (string, string, bool) {
+	if rd.IsRegex {
+		return rm.matchRegexRedirect(rd, requestPath, rawQuery)
+	}
+
+	sourcePath, sourceQuery, hasQuery := strings.Cut(rd.SourcePath, "?")
+	matched, captures := rm.matchPathWithCaptures(requestPath, sourcePath, rd.IsWildcard)
+	if !matched {
+		return "", "", false
+	}
+	if !hasQuery {
+		return rm.buildTargetURL(rd, captures), rawQuery, true
+	}
+
+	// Every parameter of the source query must be present with the same
+	// value; the request may carry others, which are passed on.
+	want, err := url.ParseQuery(sourceQuery)
+	if err != nil {
+		return "", "", false
+	}
+	got, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", "", false
+	}
+	for key, values := range want {
+		for _, value := range values {
+			if !slices.Contains(got[key], value) {
+				return "", "", false
+			}
+		}
+		delete(got, key)
+	}
+	return rm.buildTargetURL(rd, captures), got.Encode(), true
+}
+
+// matchRegexRedirect matches a regex rule against the whole request path, or
+// against the path and query string when the pattern contains an escaped "?".
+// The target may refer to capture groups as $1 or ${name}.
+func (rm *RedirectsMiddleware) matchRegexRedirect(rd store.Redirect, requestPath, rawQuery string) (string, string, bool) {
+	pattern := rm.compiledPattern(rd.SourcePath)
+	if pattern == nil {
+		return "", "", false
+	}
+
+	subject := requestPath
+	if strings.Contains(rd.SourcePath, `\?`) {
+		subject += "?" + rawQuery
+		rawQuery = ""
+	}
+	match := pattern.FindStringSubmatchIndex(subject)
+	if match == nil {
+		return "", "", false
+	}
+	return string(pattern.ExpandString(nil, rd.TargetUrl, subject, match)), rawQuery, true
+}
+
+// compiledPattern returns the compiled regex of a source path, or nil when it
+// does not compile.
+func (rm *RedirectsMiddleware) compiledPattern(source string) *regexp.Regexp {
+	if cached, ok := rm.patterns.Load(source); ok {
+		return cached.(*regexp.Regexp)
+	}
+	pattern, err := CompileRedirectPattern(source)
+	if err != nil {
+		slog.Warn("invalid redirect pattern", "source", source, "error", err)
+		pattern = nil
+	}
+	rm.patterns.Store(source, pattern)
+	return pattern
+}
+
+// CompileRedirectPattern compiles the source path of a regex redirect. The
+// pattern must match the whole path, as if it were wrapped in ^ and $.
+func CompileRedirectPattern(source string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + source + ")$")
+}
+
+// recordHit counts a redirect hit in a background goroutine, so the visitor
+// is redirected without waiting for the write. Failures are logged.
+func (rm *RedirectsMiddleware) recordHit(id int64) {
+	if rm.db == nil {
+		return
+	}
+	now := time.Now()
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		err := store.New(rm.db).RecordRedirectHit(ctx, store.RecordRedirectHitParams{
+			LastHitAt: sql.NullTime{Time: now, Valid: true},
+			ID:        id,
+		})
+		if err != nil {
+			slog.Warn("failed to record redirect hit", "redirect_id", id, "error", err)
+		}
+	}()
+}
+
+// hasQueryCondition reports whether a rule only matches requests with
+// certain query parameters.
+func hasQueryCondition(rd store.Redirect) bool {
+	return !rd.IsRegex && strings.Contains(rd.SourcePath, "?")
+}
+
+func boolOrder(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 func hasPathSegmentPrefix(path, prefix string) bool {
 	return path == prefix || strings.HasPrefix(path, prefix+"/")
 }
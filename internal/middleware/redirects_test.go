@@ -4,12 +4,14 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/testutil"
 )
 
 func TestRedirectHandlerSkipsOnlyAdminAndAPISegments(t *testing.T) {
@@ -368,3 +370,109 @@ func TestBuildTargetURL(t *testing.T) {
 		})
 	}
 }
+
+func TestRedirectHandlerRegexAndQueryRules(t *testing.T) {
+	rm := &RedirectsMiddleware{
+		redirects: []store.Redirect{{
+			SourcePath: "/index.php?page=about",
+			TargetUrl:  "/about",
+			StatusCode: http.StatusMovedPermanently,
+		}, {
+			SourcePath: "/index.php",
+			TargetUrl:  "/",
+			StatusCode: http.StatusMovedPermanently,
+		}, {
+			SourcePath: `/blog/(\d{4})/(?P<slug>[a-z-]+)`,
+			TargetUrl:  "/news/${slug}?year=$1",
+			StatusCode: http.StatusMovedPermanently,
+			IsRegex:    true,
+		}, {
+			SourcePath: `/product\?id=(\d+)`,
+			TargetUrl:  "/products/$1",
+			StatusCode: http.StatusFound,
+			IsRegex:    true,
+		}, {
+			SourcePath: "/campaign",
+			TargetUrl:  "/offers",
+			StatusCode: http.StatusFound,
+			DropQuery:  true,
+		}, {
+			SourcePath: "/broken(",
+			TargetUrl:  "/never",
+			StatusCode: http.StatusFound,
+			IsRegex:    true,
+		}},
+		lastLoad: time.Now(),
+		cacheTTL: time.Hour,
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	handler := rm.Handler(next)
+
+	tests := []struct {
+		request      string
+		wantStatus   int
+		wantLocation string
+	}{
+		{"/index.php?page=about&utm_source=x", http.StatusMovedPermanently, "/about?utm_source=x"},
+		{"/index.php?page=contact", http.StatusMovedPermanently, "/?page=contact"},
+		{"/blog/2024/hello-world", http.StatusMovedPermanently, "/news/hello-world?year=2024"},
+		{"/blog/2024/hello-world/extra", http.StatusNoContent, ""},
+		{"/product?id=42", http.StatusFound, "/products/42"},
+		{"/product?id=abc", http.StatusNoContent, ""},
+		{"/campaign?utm_source=mail", http.StatusFound, "/offers"},
+		{"/broken(", http.StatusNoContent, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.request, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, tt.request, nil)
+			response := httptest.NewRecorder()
+			handler.ServeHTTP(response, request)
+			if response.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", response.Code, tt.wantStatus)
+			}
+			if got := response.Header().Get("Location"); got != tt.wantLocation {
+				t.Errorf("Location = %q, want %q", got, tt.wantLocation)
+			}
+		})
+	}
+}
+
+func TestRedirectHandlerRecordsHitsInBackground(t *testing.T) {
+	db, cleanup := testutil.TestDB(t)
+	defer cleanup()
+	now := time.Now()
+	redirect, err := store.New(db).CreateRedirect(context.Background(), store.CreateRedirectParams{
+		SourcePath: "/old", TargetUrl: "/new", StatusCode: http.StatusMovedPermanently,
+		TargetType: "_self", Enabled: true, CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateRedirect: %v", err)
+	}
+	handler := NewRedirectsMiddleware(db).Handler(http.NotFoundHandler())
+
+	for range 2 {
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/old", nil))
+		if response.Code != http.StatusMovedPermanently {
+			t.Fatalf("status = %d, want %d", response.Code, http.StatusMovedPermanently)
+		}
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		stored, err := store.New(db).GetRedirectByID(context.Background(), redirect.ID)
+		if err != nil {
+			t.Fatalf("GetRedirectByID: %v", err)
+		}
+		if stored.HitCount == 2 && stored.LastHitAt.Valid {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("hit_count = %d, want 2", stored.HitCount)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package middleware
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/alexedwards/scs/v2"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/util"
+)
+
+// ContextKeySite is the context key of the SiteInfo a request is for.
+const ContextKeySite ContextKey = "site"
+
+// ContextKeyAdminSites is the context key of the sites the admin user may
+// switch between.
+const ContextKeyAdminSites ContextKey = "admin_sites"
+
+// SessionKeyAdminSiteID is the session key of the site the admin panel
+// manages.
+const SessionKeyAdminSiteID = "admin_site_id"
+
+// SiteInfo holds site data for the request context.
+type SiteInfo struct {
+	ID        int64
+	Name      string
+	Host      string
+	Theme     string // empty for the globally active theme
+	IsDefault bool
+}
+
+// SiteMiddleware resolves the site a request is for from its host and
+// scopes the request context to it. Requests for a host no site is mapped to
+// are served by the default site. Sites are cached until InvalidateCache.
+type SiteMiddleware struct {
+	queries *store.Queries
+	mu      sync.RWMutex
+	sites   []store.Site
+	loaded  bool
+	// version counts invalidations, so a load that raced one is not cached.
+	version uint64
+}
+
+// NewSiteMiddleware creates a new site middleware.
+func NewSiteMiddleware(db *sql.DB) *SiteMiddleware {
+	return &SiteMiddleware{queries: store.New(db)}
+}
+
+// getSites returns the cached sites, loading them if necessary. A failed
+// load is not cached, so the next request tries again.
+func (sm *SiteMiddleware) getSites(ctx context.Context) []store.Site {
+	sm.mu.RLock()
+	if sm.loaded {
+		sites := sm.sites
+		sm.mu.RUnlock()
+		return sites
+	}
+	version := sm.version
+	sm.mu.RUnlock()
+
+	sites, err := sm.queries.ListSites(ctx)
+	if err != nil {
+		slog.Error("failed to load sites", "error", err)
+		return nil
+	}
+
+	sm.mu.Lock()
+	if sm.version == version {
+		sm.sites = sites
+		sm.loaded = true
+	}
+	sm.mu.Unlock()
+	return sites
+}
+
+// InvalidateCache forces a reload of sites on next request.
+func (sm *SiteMiddleware) InvalidateCache() {
+	sm.mu.Lock()
+	sm.loaded = false
+	sm.sites = nil
+	sm.version++
+	sm.mu.Unlock()
+}
+
+// Handler returns the middleware handler function.
+func (sm *SiteMiddleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sites := sm.getSites(r.Context())
+		if len(sites) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		site := matchSiteHost(RequestHost(r), sites)
+		next.ServeHTTP(w, r.WithContext(WithSite(r.Context(), site)))
+	})
+}
+
+// AdminSite creates middleware that scopes admin requests to the site chosen
+// in the session switcher instead of the site of the request host. A choice
+// the user may no longer manage falls back to the first site they may.
+// Editors who may manage no site are refused. Public users are left to
+// RequireEditor. This should be used after LoadUser middleware.
+func AdminSite(sm *scs.SessionManager, db *sql.DB) func(http.Handler) http.Handler {
+	queries := store.New(db)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user := GetUser(r)
+			if user == nil || user.Role == model.RolePublic {
+				next.ServeHTTP(w, r)
+				return
+			}
+			sites, err := ManageableSites(r.Context(), queries, *user)
+			if err != nil {
+				slog.Error("failed to list manageable sites", "error", err, "user_id", user.ID)
+				http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+				return
+			}
+			if len(sites) == 0 {
+				http.Error(w, "Forbidden: no site to manage", http.StatusForbidden)
+				return
+			}
+
+			site := sites[0]
+			if id := sm.GetInt64(r.Context(), SessionKeyAdminSiteID); id != 0 {
+				if i := slices.IndexFunc(sites, func(s store.Site) bool { return s.ID == id }); i >= 0 {
+					site = sites[i]
+				}
+			} else if current := SiteFromContext(r.Context()); current != nil {
+				if i := slices.IndexFunc(sites, func(s store.Site) bool { return s.ID == current.ID }); i >= 0 {
+					site = sites[i]
+				}
+			}
+			ctx := context.WithValue(WithSite(r.Context(), site), ContextKeyAdminSites, sites)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// GetAdminSites returns the sites the admin user may switch between, as
+// resolved by AdminSite.
+func GetAdminSites(r *http.Request) []store.Site {
+	sites, _ := r.Context().Value(ContextKeyAdminSites).([]store.Site)
+	return sites
+}
+
+// ManageableSites returns the sites a user may manage in the admin panel.
+// Admins may manage every site; other users only the sites assigned to them.
+func ManageableSites(ctx context.Context, queries *store.Queries, user store.User) ([]store.Site, error) {
+	sites, err := queries.ListSites(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == model.RoleAdmin {
+		return sites, nil
+	}
+	ids, err := queries.ListUserSiteIDs(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(sites, func(s store.Site) bool {
+		return !slices.Contains(ids, s.ID)
+	}), nil
+}
+
+// WithSite returns a context scoped to site, carrying its SiteInfo.
+func WithSite(ctx context.Context, site store.Site) context.Context {
+	ctx = store.WithSiteID(ctx, site.ID)
+	return context.WithValue(ctx, ContextKeySite, &SiteInfo{
+		ID:        site.ID,
+		Name:      site.Name,
+		Host:      site.Host,
+		Theme:     site.Theme,
+		IsDefault: site.IsDefault,
+	})
+}
+
+// matchSiteHost returns the site mapped to the request host, or the default
+// site when there is none.
+func matchSiteHost(requestHost string, sites []store.Site) store.Site {
+	fallback := sites[0]
+	for _, site := range sites {
+		if site.Host != "" && requestHost != "" && util.HostsMatch(requestHost, site.Host) {
+			return site
+		}
+		if site.IsDefault {
+			fallback = site
+		}
+	}
+	return fallback
+}
+
+// GetSite returns the site the request is for, or nil if none was resolved.
+func GetSite(r *http.Request) *SiteInfo {
+	return SiteFromContext(r.Context())
+}
+
+// SiteFromContext is GetSite for a request context.
+func SiteFromContext(ctx context.Context) *SiteInfo {
+	site, _ := ctx.Value(ContextKeySite).(*SiteInfo)
+	return site
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package middleware
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alexedwards/scs/v2"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+func addSiteTestSchema(t *testing.T, db *sql.DB) {
+	t.Helper()
+
+	_, err := db.Exec(`
+		CREATE TABLE sites (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL,
+			host TEXT NOT NULL DEFAULT '',
+			theme TEXT NOT NULL DEFAULT '',
+			is_default BOOLEAN NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+		CREATE TABLE user_sites (
+			user_id INTEGER NOT NULL,
+			site_id INTEGER NOT NULL,
+			PRIMARY KEY (user_id, site_id)
+		);
+		INSERT INTO sites (id, name, host, theme, is_default) VALUES
+			(1, 'Main', 'example.com', '', 1),
+			(2, 'Digest', 'digest.example.org', 'developer', 0),
+			(3, 'Blog', 'blog.example.net', '', 0);
+	`)
+	if err != nil {
+		t.Fatalf("create site test schema: %v", err)
+	}
+}
+
+func TestSite_HostResolution(t *testing.T) {
+	db := newLanguageMiddlewareTestDB(t)
+	addSiteTestSchema(t, db)
+
+	handler := NewSiteMiddleware(db).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		site := GetSite(r)
+		if site == nil {
+			_, _ = fmt.Fprint(w, "none")
+			return
+		}
+		_, _ = fmt.Fprintf(w, "%d|%d|%s", site.ID, store.SiteIDFromContext(r.Context()), site.Theme)
+	}))
+
+	tests := []struct {
+		host string
+		want string
+	}{
+		{host: "example.com", want: "1|1|"},
+		{host: "digest.example.org", want: "2|2|developer"},
+		{host: "DIGEST.example.org:8443", want: "2|2|developer"},
+		{host: "blog.example.net", want: "3|3|"},
+		{host: "unknown.example.com", want: "1|1|"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Host = tt.host
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			if got := rr.Body.String(); got != tt.want {
+				t.Errorf("site for %q = %q, want %q", tt.host, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSite_CachesSitesUntilInvalidated(t *testing.T) {
+	db := newLanguageMiddlewareTestDB(t)
+	addSiteTestSchema(t, db)
+
+	sm := NewSiteMiddleware(db)
+	handler := sm.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, store.SiteIDFromContext(r.Context()))
+	}))
+	siteFor := func(host string) string {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Host = host
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr.Body.String()
+	}
+
+	if got := siteFor("news.example.org"); got != "1" {
+		t.Fatalf("site before mapping = %q, want 1", got)
+	}
+	if _, err := db.Exec(`UPDATE sites SET host = 'news.example.org' WHERE id = 2`); err != nil {
+		t.Fatalf("map host: %v", err)
+	}
+	if got := siteFor("news.example.org"); got != "1" {
+		t.Errorf("site from cache = %q, want 1", got)
+	}
+	sm.InvalidateCache()
+	if got := siteFor("news.example.org"); got != "2" {
+		t.Errorf("site after invalidation = %q, want 2", got)
+	}
+}
+
+func TestSite_LanguageHostsServeDefaultSiteOnly(t *testing.T) {
+	db := newLanguageMiddlewareTestDB(t)
+	addSiteTestSchema(t, db)
+	if _, err := db.Exec(`UPDATE languages SET host = 'fr.example.com' WHERE code = 'fr'`); err != nil {
+		t.Fatalf("map language host: %v", err)
+	}
+
+	root := chi.NewRouter()
+	root.Use(NewSiteMiddleware(db).Handler)
+	root.Use(Language(db))
+	root.Get("/{slug}", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, "%s|%s", GetLanguage(r).Code, GetLanguagePrefix(r))
+	})
+
+	// On another site the French prefix is served in place instead of
+	// redirecting to the default site's French host.
+	req := httptest.NewRequest(http.MethodGet, "/fr/article", nil)
+	req.Host = "blog.example.net"
+	rr := httptest.NewRecorder()
+	root.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; Location = %q", rr.Code, http.StatusOK, rr.Header().Get("Location"))
+	}
+	if got := rr.Body.String(); got != "fr|fr" {
+		t.Errorf("body = %q, want %q", got, "fr|fr")
+	}
+}
+
+func TestManageableSites(t *testing.T) {
+	db := newLanguageMiddlewareTestDB(t)
+	addSiteTestSchema(t, db)
+	if _, err := db.Exec(`INSERT INTO user_sites (user_id, site_id) VALUES (2, 2), (3, 2), (3, 3)`); err != nil {
+		t.Fatalf("restrict users: %v", err)
+	}
+	queries := store.New(db)
+
+	// Sites are listed default first, then by name.
+	tests := []struct {
+		name string
+		user store.User
+		want []int64
+	}{
+		{name: "admin ignores restrictions", user: store.User{ID: 2, Role: model.RoleAdmin}, want: []int64{1, 3, 2}},
+		{name: "editor without sites", user: store.User{ID: 1, Role: model.RoleEditor}, want: []int64{}},
+		{name: "editor restricted to one site", user: store.User{ID: 2, Role: model.RoleEditor}, want: []int64{2}},
+		{name: "editor restricted to two sites", user: store.User{ID: 3, Role: model.RoleEditor}, want: []int64{3, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sites, err := ManageableSites(context.Background(), queries, tt.user)
+			if err != nil {
+				t.Fatalf("ManageableSites: %v", err)
+			}
+			got := make([]int64, len(sites))
+			for i, site := range sites {
+				got[i] = site.ID
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("sites = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdminSite_RefusesEditorWithoutSites(t *testing.T) {
+	db := newLanguageMiddlewareTestDB(t)
+	addSiteTestSchema(t, db)
+	if _, err := db.Exec(`INSERT INTO user_sites (user_id, site_id) VALUES (2, 2)`); err != nil {
+		t.Fatalf("restrict user: %v", err)
+	}
+	sm := scs.New()
+	handler := sm.LoadAndSave(AdminSite(sm, db)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, store.SiteIDFromContext(r.Context()))
+	})))
+
+	tests := []struct {
+		name       string
+		user       store.User
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "admin", user: store.User{ID: 1, Role: model.RoleAdmin}, wantStatus: http.StatusOK, wantBody: "1"},
+		{name: "editor with a site", user: store.User{ID: 2, Role: model.RoleEditor}, wantStatus: http.StatusOK, wantBody: "2"},
+		{name: "editor without sites", user: store.User{ID: 3, Role: model.RoleEditor}, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+			req.Host = "example.com"
+			req = req.WithContext(context.WithValue(req.Context(), ContextKeyUser, tt.user))
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rr.Code, tt.wantStatus)
+			}
+			if tt.wantBody != "" && rr.Body.String() != tt.wantBody {
+				t.Errorf("site = %q, want %q", rr.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+// Comment statuses
+const (
+	CommentStatusPending  = "pending"  // Held in the moderation queue
+	CommentStatusApproved = "approved" // Shown on the page
+	CommentStatusSpam     = "spam"     // Flagged by the spam checks or a moderator
+)
+
+// CommentStatuses lists the comment statuses in moderation queue order.
+var CommentStatuses = []string{CommentStatusPending, CommentStatusApproved, CommentStatusSpam}
+
+// IsValidCommentStatus checks if a comment status is valid.
+func IsValidCommentStatus(status string) bool {
+	for _, s := range CommentStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -28,7 +28,19 @@ const (
 	ConfigKeyCopyright           = "copyright"
 	ConfigKeyExcludedIPs         = "excluded_ips"
 	ConfigKeyRobotsContentSignal = "robots_content_signal"
+	ConfigKeyRobotsDisallowAll   = "robots_disallow_all"
+	ConfigKeyRobotsTxtExtra      = "robots_txt_extra"
+	ConfigKeySecurityContact     = "security_contact"
+	ConfigKeySecurityPolicy      = "security_policy"
+	ConfigKeySiteLogo            = "site_logo"
+	ConfigKeyCustomCSS           = "custom_css"
 	ConfigKeyMCPServerVersion    = "mcp_server_version"
+
+	ConfigKeyCommentsEnabled          = "comments_enabled"
+	ConfigKeyCommentsAllowAnonymous   = "comments_allow_anonymous"
+	ConfigKeyCommentsAutoApproveUsers = "comments_auto_approve_users"
+
+	ConfigKeyMembersRegistration = "members_registration_enabled"
 )
 
 // TranslatableConfigKeys is the list of config keys that support per-language translations.
@@ -62,6 +74,10 @@ var StandardConfigFields = []ConfigFieldDefinition{
 	{Key: ConfigKeyExcludedIPs, DefaultValue: "", Type: ConfigTypeText, Description: "IPs or CIDRs to exclude from analytics and event logging (one per line)"},
 	{Key: ConfigKeyRobotsContentSignal, DefaultValue: "", Type: ConfigTypeString, Description: "robots.txt Content-Signal directive (contentsignals.org). Leave empty for the default 'search=yes, ai-train=no, ai-input=yes', or set to 'off' / 'none' / 'disabled' to suppress."},
 	{Key: ConfigKeyMCPServerVersion, DefaultValue: "", Type: ConfigTypeString, Description: "Version string advertised in /.well-known/mcp/server-card.json (leave empty to omit)"},
+	{Key: ConfigKeyCommentsEnabled, DefaultValue: "false", Type: ConfigTypeBool, Description: "Allow visitors to comment on posts and on pages opened for comments"},
+	{Key: ConfigKeyCommentsAllowAnonymous, DefaultValue: "true", Type: ConfigTypeBool, Description: "Accept comments from visitors who are not logged in"},
+	{Key: ConfigKeyCommentsAutoApproveUsers, DefaultValue: "false", Type: ConfigTypeBool, Description: "Publish comments of logged-in users without moderation"},
+	{Key: ConfigKeyMembersRegistration, DefaultValue: "false", Type: ConfigTypeBool, Description: "Let visitors register member accounts to read members-only pages"},
 }
 
 // IsTranslatableConfigKey checks if a config key supports translations.
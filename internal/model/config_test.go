@@ -23,6 +23,10 @@ func TestStandardConfigFieldsRegistration(t *testing.T) {
 		ConfigKeyExcludedIPs,
 		ConfigKeyRobotsContentSignal,
 		ConfigKeyMCPServerVersion,
+		ConfigKeyCommentsEnabled,
+		ConfigKeyCommentsAllowAnonymous,
+		ConfigKeyCommentsAutoApproveUsers,
+		ConfigKeyMembersRegistration,
 	}
 
 	registered := make(map[string]bool, len(StandardConfigFields))
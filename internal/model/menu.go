@@ -5,6 +5,7 @@ package model
 
 import (
 	"database/sql"
+	"slices"
 	"strings"
 	"time"
 )
@@ -45,6 +46,59 @@ const (
 // ValidTargets contains all valid link target values.
 var ValidTargets = []string{TargetSelf, TargetBlank, TargetParent, TargetTop}
 
+// Menu item types. A link item points at a page (page_id) or a URL; the
+// other types resolve their URL in the language the menu is shown in.
+const (
+	MenuItemLink     = ""         // A page or URL, the default
+	MenuItemCategory = "category" // Category archive, link_id is the category
+	MenuItemTag      = "tag"      // Tag archive, link_id is the tag
+	MenuItemForm     = "form"     // Public form, link_id is the form
+	MenuItemBlog     = "blog"     // Blog archive
+	MenuItemLanguage = "language" // Homepage of link_id's language, or of the current one
+)
+
+// MenuItemTypes lists the menu item types in form order.
+var MenuItemTypes = []string{MenuItemLink, MenuItemCategory, MenuItemTag, MenuItemForm, MenuItemBlog, MenuItemLanguage}
+
+// IsValidMenuItemType checks if a menu item type is valid.
+func IsValidMenuItemType(itemType string) bool {
+	return slices.Contains(MenuItemTypes, itemType)
+}
+
+// Menu item visibility rules
+const (
+	MenuVisibilityAll     = ""        // Everyone, the default
+	MenuVisibilityMembers = "members" // Logged-in users
+	MenuVisibilityGuests  = "guests"  // Visitors who are not logged in
+	MenuVisibilityRoles   = "roles"   // Logged-in users with one of the listed roles
+)
+
+// MenuVisibilities lists the menu item visibility rules in form order.
+var MenuVisibilities = []string{MenuVisibilityAll, MenuVisibilityMembers, MenuVisibilityGuests, MenuVisibilityRoles}
+
+// IsValidMenuVisibility checks if a menu item visibility rule is valid.
+func IsValidMenuVisibility(visibility string) bool {
+	return slices.Contains(MenuVisibilities, visibility)
+}
+
+// CanSeeMenuItem reports whether a visitor with the given role sees a menu
+// item with the given visibility and roles. Admins see every role-restricted
+// item, as they may view every page; role is RoleAnonymous for visitors who
+// are not logged in.
+func CanSeeMenuItem(visibility string, roles []string, role string) bool {
+	loggedIn := role != RoleAnonymous && role != ""
+	switch visibility {
+	case MenuVisibilityMembers:
+		return loggedIn
+	case MenuVisibilityGuests:
+		return !loggedIn
+	case MenuVisibilityRoles:
+		return role == RoleAdmin || (loggedIn && slices.Contains(roles, role))
+	default:
+		return true
+	}
+}
+
 // Menu represents a navigation menu.
 type Menu struct {
 	ID        int64
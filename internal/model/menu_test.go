@@ -0,0 +1,47 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "testing"
+
+func TestCanSeeMenuItem(t *testing.T) {
+	editors := []string{RoleEditor}
+	tests := []struct {
+		name       string
+		visibility string
+		roles      []string
+		role       string
+		want       bool
+	}{
+		{"all anonymous", MenuVisibilityAll, nil, RoleAnonymous, true},
+		{"unknown rule is visible", "friends", nil, RoleAnonymous, true},
+		{"members anonymous", MenuVisibilityMembers, nil, RoleAnonymous, false},
+		{"members empty role", MenuVisibilityMembers, nil, "", false},
+		{"members public user", MenuVisibilityMembers, nil, RolePublic, true},
+		{"guests anonymous", MenuVisibilityGuests, nil, RoleAnonymous, true},
+		{"guests public user", MenuVisibilityGuests, nil, RolePublic, false},
+		{"roles listed", MenuVisibilityRoles, editors, RoleEditor, true},
+		{"roles not listed", MenuVisibilityRoles, editors, RolePublic, false},
+		{"roles anonymous", MenuVisibilityRoles, editors, RoleAnonymous, false},
+		{"roles admin", MenuVisibilityRoles, nil, RoleAdmin, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanSeeMenuItem(tt.visibility, tt.roles, tt.role); got != tt.want {
+				t.Errorf("CanSeeMenuItem(%q, %v, %q) = %v, want %v", tt.visibility, tt.roles, tt.role, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidMenuItemType(t *testing.T) {
+	for _, itemType := range MenuItemTypes {
+		if !IsValidMenuItemType(itemType) {
+			t.Errorf("IsValidMenuItemType(%q) = false, want true", itemType)
+		}
+	}
+	if IsValidMenuItemType("widget") {
+		t.Error(`IsValidMenuItemType("widget") = true, want false`)
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "slices"
+
+// Page access levels
+const (
+	PageAccessPublic  = "public"  // Everyone, the default
+	PageAccessMembers = "members" // Any logged-in user
+	PageAccessRoles   = "roles"   // Logged-in users with one of the listed roles
+)
+
+// PageAccessLevels lists the page access levels in form order.
+var PageAccessLevels = []string{PageAccessPublic, PageAccessMembers, PageAccessRoles}
+
+// IsValidPageAccess checks if a page access level is valid.
+func IsValidPageAccess(access string) bool {
+	return slices.Contains(PageAccessLevels, access)
+}
+
+// CanAccessPage reports whether a visitor with the given role may view a page
+// with the given access level and roles. Admins may view every page; role is
+// RoleAnonymous for visitors who are not logged in.
+func CanAccessPage(access string, roles []string, role string) bool {
+	switch access {
+	case PageAccessMembers:
+		return role != RoleAnonymous && role != ""
+	case PageAccessRoles:
+		return role == RoleAdmin || slices.Contains(roles, role)
+	default:
+		return true
+	}
+}
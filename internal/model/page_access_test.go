@@ -0,0 +1,45 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "testing"
+
+func TestCanAccessPage(t *testing.T) {
+	editors := []string{RoleEditor}
+	tests := []struct {
+		name   string
+		access string
+		roles  []string
+		role   string
+		want   bool
+	}{
+		{"public anonymous", PageAccessPublic, nil, RoleAnonymous, true},
+		{"unknown level is public", "", nil, RoleAnonymous, true},
+		{"members anonymous", PageAccessMembers, nil, RoleAnonymous, false},
+		{"members empty role", PageAccessMembers, nil, "", false},
+		{"members public user", PageAccessMembers, nil, RolePublic, true},
+		{"roles listed", PageAccessRoles, editors, RoleEditor, true},
+		{"roles not listed", PageAccessRoles, editors, RolePublic, false},
+		{"roles anonymous", PageAccessRoles, editors, RoleAnonymous, false},
+		{"roles admin", PageAccessRoles, nil, RoleAdmin, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanAccessPage(tt.access, tt.roles, tt.role); got != tt.want {
+				t.Errorf("CanAccessPage(%q, %v, %q) = %v, want %v", tt.access, tt.roles, tt.role, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidPageAccess(t *testing.T) {
+	for _, access := range PageAccessLevels {
+		if !IsValidPageAccess(access) {
+			t.Errorf("IsValidPageAccess(%q) = false, want true", access)
+		}
+	}
+	if IsValidPageAccess("friends") {
+		t.Error(`IsValidPageAccess("friends") = true, want false`)
+	}
+}
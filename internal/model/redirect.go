@@ -0,0 +1,34 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "slices"
+
+// Redirect match types
+const (
+	RedirectMatchExact    = "exact"    // The path equals the source path
+	RedirectMatchWildcard = "wildcard" // * matches a segment, ** any number
+	RedirectMatchRegex    = "regex"    // The source path is a regular expression
+)
+
+// RedirectMatchTypes lists the redirect match types in form order.
+var RedirectMatchTypes = []string{RedirectMatchExact, RedirectMatchWildcard, RedirectMatchRegex}
+
+// IsValidRedirectMatchType checks if a redirect match type is valid.
+func IsValidRedirectMatchType(matchType string) bool {
+	return slices.Contains(RedirectMatchTypes, matchType)
+}
+
+// RedirectMatchType returns the match type of a redirect with the given
+// flags. Regex wins over wildcard.
+func RedirectMatchType(isWildcard, isRegex bool) string {
+	switch {
+	case isRegex:
+		return RedirectMatchRegex
+	case isWildcard:
+		return RedirectMatchWildcard
+	default:
+		return RedirectMatchExact
+	}
+}
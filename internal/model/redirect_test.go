@@ -0,0 +1,28 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "testing"
+
+func TestRedirectMatchType(t *testing.T) {
+	tests := []struct {
+		isWildcard bool
+		isRegex    bool
+		want       string
+	}{
+		{false, false, RedirectMatchExact},
+		{true, false, RedirectMatchWildcard},
+		{false, true, RedirectMatchRegex},
+		{true, true, RedirectMatchRegex},
+	}
+	for _, tt := range tests {
+		got := RedirectMatchType(tt.isWildcard, tt.isRegex)
+		if got != tt.want {
+			t.Errorf("RedirectMatchType(%v, %v) = %q, want %q", tt.isWildcard, tt.isRegex, got, tt.want)
+		}
+		if !IsValidRedirectMatchType(got) {
+			t.Errorf("IsValidRedirectMatchType(%q) = false, want true", got)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+// SiteConfigFields are the config keys a site other than the default one may
+// override. An empty override falls back to the global value.
+var SiteConfigFields = []ConfigFieldDefinition{
+	{Key: ConfigKeySiteName, Type: ConfigTypeString, Description: "The name of the site"},
+	{Key: ConfigKeySiteDescription, Type: ConfigTypeString, Description: "A short description of the site"},
+	{Key: ConfigKeySiteURL, Type: ConfigTypeString, Description: "Full site URL for canonical links, sitemap and OG tags (e.g., https://example.com)"},
+	{Key: ConfigKeyDefaultOGImage, Type: ConfigTypeString, Description: "Default Open Graph image URL for social sharing"},
+	{Key: ConfigKeySiteLogo, Type: ConfigTypeString, Description: "Logo image URL"},
+	{Key: ConfigKeyCopyright, Type: ConfigTypeString, Description: "Footer copyright text"},
+	{Key: ConfigKeyCustomCSS, Type: ConfigTypeText, Description: "Extra CSS added to every page of the site"},
+	{Key: ConfigKeyRobotsContentSignal, Type: ConfigTypeString, Description: "robots.txt Content-Signal directive"},
+	{Key: ConfigKeyRobotsDisallowAll, Type: ConfigTypeBool, Description: "Disallow all crawlers in robots.txt (for staging sites)"},
+	{Key: ConfigKeyRobotsTxtExtra, Type: ConfigTypeText, Description: "Extra robots.txt rules"},
+	{Key: ConfigKeySecurityContact, Type: ConfigTypeString, Description: "security.txt Contact (e.g., mailto:security@example.com); security.txt is served only when set"},
+	{Key: ConfigKeySecurityPolicy, Type: ConfigTypeString, Description: "security.txt Policy URL"},
+}
+
+// IsSiteConfigKey checks if a site may override a config key.
+func IsSiteConfigKey(key string) bool {
+	for _, f := range SiteConfigFields {
+		if f.Key == key {
+			return true
+		}
+	}
+	return false
+}
@@ -24,6 +24,36 @@ const (
 	EventFormSubmitted   = "form.submitted"
 	EventUserCreated     = "user.created"
 	EventUserDeleted     = "user.deleted"
+
+	EventPageScheduledPublished = "page.scheduled_published"
+
+	EventTagCreated      = "tag.created"
+	EventTagUpdated      = "tag.updated"
+	EventTagDeleted      = "tag.deleted"
+	EventCategoryCreated = "category.created"
+	EventCategoryUpdated = "category.updated"
+	EventCategoryDeleted = "category.deleted"
+
+	EventMenuCreated = "menu.created"
+	EventMenuUpdated = "menu.updated"
+	EventMenuDeleted = "menu.deleted"
+
+	EventTranslationLinked   = "translation.linked"
+	EventTranslationUnlinked = "translation.unlinked"
+
+	EventRedirectCreated = "redirect.created"
+	EventRedirectUpdated = "redirect.updated"
+	EventRedirectDeleted = "redirect.deleted"
+
+	EventCommentCreated  = "comment.created"
+	EventCommentApproved = "comment.approved"
+
+	EventLinkBroken = "link.broken"
+
+	EventConfigUpdated     = "config.updated"
+	EventThemeActivated    = "theme.activated"
+	EventModuleActivated   = "module.activated"
+	EventModuleDeactivated = "module.deactivated"
 )
 
 // Webhook delivery statuses
@@ -34,6 +64,80 @@ const (
 	DeliveryStatusDead      = "dead"
 )
 
+// Webhook payload formats
+const (
+	WebhookFormatJSON    = "json"    // Signed webhook.Event JSON (default)
+	WebhookFormatCustom  = "custom"  // User-defined Go text/template
+	WebhookFormatSlack   = "slack"   // Slack incoming webhook message
+	WebhookFormatDiscord = "discord" // Discord webhook message
+	WebhookFormatTeams   = "teams"   // Microsoft Teams MessageCard
+)
+
+// WebhookFormats returns all supported payload formats in display order.
+func WebhookFormats() []string {
+	return []string{
+		WebhookFormatJSON,
+		WebhookFormatCustom,
+		WebhookFormatSlack,
+		WebhookFormatDiscord,
+		WebhookFormatTeams,
+	}
+}
+
+// IsValidWebhookFormat reports whether format is a supported payload format.
+func IsValidWebhookFormat(format string) bool {
+	for _, f := range WebhookFormats() {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// Inbound webhook actions
+const (
+	InboundActionRebuildSearch = "rebuild_search" // Rebuild the full-text search index
+	InboundActionClearCache    = "clear_cache"    // Clear all caches
+	InboundActionRunJob        = "run_job"        // Trigger a scheduler job immediately
+	InboundActionCreateDraft   = "create_draft"   // Create a draft page from the JSON payload
+)
+
+// Inbound webhook trigger outcomes
+const (
+	InboundStatusSuccess = "success"
+	InboundStatusFailed  = "failed"
+)
+
+// InboundWebhookActions returns all supported inbound actions in display order.
+func InboundWebhookActions() []string {
+	return []string{
+		InboundActionRebuildSearch,
+		InboundActionClearCache,
+		InboundActionRunJob,
+		InboundActionCreateDraft,
+	}
+}
+
+// IsValidInboundAction reports whether action is a supported inbound action.
+func IsValidInboundAction(action string) bool {
+	for _, a := range InboundWebhookActions() {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateInboundWebhookToken generates the random URL token that identifies
+// an inbound webhook endpoint.
+func GenerateInboundWebhookToken() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
 // WebhookEventInfo contains event type and description.
 type WebhookEventInfo struct {
 	Type        string
@@ -53,6 +157,28 @@ func AllWebhookEvents() []WebhookEventInfo {
 		{Type: EventFormSubmitted, Description: "When a form is submitted"},
 		{Type: EventUserCreated, Description: "When a user is created"},
 		{Type: EventUserDeleted, Description: "When a user is deleted"},
+		{Type: EventPageScheduledPublished, Description: "When the scheduler publishes a scheduled page"},
+		{Type: EventTagCreated, Description: "When a tag is created"},
+		{Type: EventTagUpdated, Description: "When a tag is updated"},
+		{Type: EventTagDeleted, Description: "When a tag is deleted"},
+		{Type: EventCategoryCreated, Description: "When a category is created"},
+		{Type: EventCategoryUpdated, Description: "When a category is updated"},
+		{Type: EventCategoryDeleted, Description: "When a category is deleted"},
+		{Type: EventMenuCreated, Description: "When a menu is created"},
+		{Type: EventMenuUpdated, Description: "When a menu or its items change"},
+		{Type: EventMenuDeleted, Description: "When a menu is deleted"},
+		{Type: EventTranslationLinked, Description: "When a translation is linked to its source"},
+		{Type: EventTranslationUnlinked, Description: "When a translation link is removed"},
+		{Type: EventRedirectCreated, Description: "When a redirect is created"},
+		{Type: EventRedirectUpdated, Description: "When a redirect is updated or toggled"},
+		{Type: EventRedirectDeleted, Description: "When a redirect is deleted"},
+		{Type: EventCommentCreated, Description: "When a visitor posts a comment"},
+		{Type: EventCommentApproved, Description: "When a comment is approved"},
+		{Type: EventLinkBroken, Description: "When the link checker finds a newly broken link"},
+		{Type: EventConfigUpdated, Description: "When site configuration is updated"},
+		{Type: EventThemeActivated, Description: "When a theme is activated"},
+		{Type: EventModuleActivated, Description: "When a module is activated"},
+		{Type: EventModuleDeactivated, Description: "When a module is deactivated"},
 	}
 }
 
@@ -68,6 +194,13 @@ type Webhook struct {
 	CreatedBy int64     `json:"created_by"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	PayloadFormat   string `json:"payload_format"`
+	PayloadTemplate string `json:"-"`
+
+	ConsecutiveFailures int64        `json:"consecutive_failures"`
+	PausedAt            sql.NullTime `json:"paused_at,omitempty"`
+	PauseReason         string       `json:"pause_reason,omitempty"`
 }
 
 // WebhookDelivery represents a webhook delivery attempt.
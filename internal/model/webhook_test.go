@@ -310,6 +310,28 @@ func TestAllWebhookEvents(t *testing.T) {
 		EventFormSubmitted,
 		EventUserCreated,
 		EventUserDeleted,
+		EventPageScheduledPublished,
+		EventTagCreated,
+		EventTagUpdated,
+		EventTagDeleted,
+		EventCategoryCreated,
+		EventCategoryUpdated,
+		EventCategoryDeleted,
+		EventMenuCreated,
+		EventMenuUpdated,
+		EventMenuDeleted,
+		EventTranslationLinked,
+		EventTranslationUnlinked,
+		EventRedirectCreated,
+		EventRedirectUpdated,
+		EventRedirectDeleted,
+		EventCommentCreated,
+		EventCommentApproved,
+		EventLinkBroken,
+		EventConfigUpdated,
+		EventThemeActivated,
+		EventModuleActivated,
+		EventModuleDeactivated,
 	}
 
 	if len(events) != len(expectedTypes) {
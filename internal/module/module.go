@@ -19,7 +19,9 @@ import (
 	"github.com/olegiv/ocms-go/internal/render"
 	"github.com/olegiv/ocms-go/internal/scheduler"
 	"github.com/olegiv/ocms-go/internal/service"
+	"github.com/olegiv/ocms-go/internal/shortcode"
 	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/widget"
 )
 
 // Context provides access to application services for modules.
@@ -105,6 +107,14 @@ type Module interface {
 	// TemplateFuncs returns template functions provided by the module.
 	TemplateFuncs() template.FuncMap
 
+	// Shortcodes returns page body shortcodes provided by the module, keyed
+	// by name (e.g. "bookmarks" for [[bookmarks limit=5]]).
+	Shortcodes() map[string]shortcode.Func
+
+	// Widgets returns widget types provided by the module, which editors
+	// can place in theme widget areas.
+	Widgets() []widget.Type
+
 	// Migrations returns migrations for the module.
 	Migrations() []Migration
 
@@ -187,6 +197,12 @@ func (m *BaseModule) RegisterAdminRoutes(_ chi.Router) {}
 // TemplateFuncs returns template functions (empty by default).
 func (m *BaseModule) TemplateFuncs() template.FuncMap { return nil }
 
+// Shortcodes returns page body shortcodes (none by default).
+func (m *BaseModule) Shortcodes() map[string]shortcode.Func { return nil }
+
+// Widgets returns widget types (none by default).
+func (m *BaseModule) Widgets() []widget.Type { return nil }
+
 // Migrations returns module migrations (empty by default).
 func (m *BaseModule) Migrations() []Migration { return nil }
 
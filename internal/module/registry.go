@@ -20,7 +20,9 @@ import (
 
 	"github.com/olegiv/ocms-go/internal/i18n"
 	"github.com/olegiv/ocms-go/internal/render"
+	"github.com/olegiv/ocms-go/internal/shortcode"
 	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/widget"
 )
 
 // errModuleNotFound is the format string for module not found errors.
@@ -662,6 +664,65 @@ func (r *Registry) AllTemplateFuncs() template.FuncMap {
 	return funcs
 }
 
+// AllShortcodes returns combined page body shortcodes from all active
+// modules. Shortcodes with invalid names are skipped.
+func (r *Registry) AllShortcodes() map[string]shortcode.Func {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	codes := make(map[string]shortcode.Func)
+	for _, name := range r.order {
+		// Default to active if not tracked (for testing or before InitAll)
+		active, exists := r.activeStatus[name]
+		if exists && !active {
+			continue
+		}
+		m, ok := r.modules[name]
+		if !ok || m == nil {
+			continue
+		}
+		for k, fn := range m.Shortcodes() {
+			if !shortcode.ValidName(k) || fn == nil {
+				r.logger.Warn("ignoring invalid module shortcode", "module", name, "shortcode", k)
+				continue
+			}
+			codes[k] = fn
+		}
+	}
+	return codes
+}
+
+// AllWidgetTypes returns the widget types of all active modules in
+// registration order. Types with invalid IDs or without a renderer are
+// skipped, as are IDs already taken by an earlier module.
+func (r *Registry) AllWidgetTypes() []widget.Type {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var types []widget.Type
+	seen := make(map[string]bool)
+	for _, name := range r.order {
+		// Default to active if not tracked (for testing or before InitAll)
+		active, exists := r.activeStatus[name]
+		if exists && !active {
+			continue
+		}
+		m, ok := r.modules[name]
+		if !ok || m == nil {
+			continue
+		}
+		for _, t := range m.Widgets() {
+			if !widget.ValidID(t.ID) || t.Render == nil || seen[t.ID] {
+				r.logger.Warn("ignoring invalid module widget type", "module", name, "widget_type", t.ID)
+				continue
+			}
+			seen[t.ID] = true
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
 // Info contains information about a registered module.
 type Info struct {
 	Name              string
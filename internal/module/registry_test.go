@@ -4,6 +4,7 @@
 package module
 
 import (
+	"context"
 	"database/sql"
 	"embed"
 	"fmt"
@@ -11,7 +12,9 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/olegiv/ocms-go/internal/shortcode"
 	"github.com/olegiv/ocms-go/internal/testutil"
+	"github.com/olegiv/ocms-go/internal/widget"
 
 	"github.com/go-chi/chi/v5"
 	_ "github.com/mattn/go-sqlite3"
@@ -31,6 +34,8 @@ type mockModule struct {
 	routeCalls   int
 	adminCalled  bool
 	funcMap      template.FuncMap
+	shortcodes   map[string]shortcode.Func
+	widgets      []widget.Type
 }
 
 func newMockModule(name, version string) *mockModule {
@@ -53,11 +58,13 @@ func (m *mockModule) RegisterRoutes(r chi.Router) {
 	m.routeCalls++
 	r.Get("/"+m.name, http.NotFound)
 }
-func (m *mockModule) RegisterAdminRoutes(_ chi.Router) { m.adminCalled = true }
-func (m *mockModule) TemplateFuncs() template.FuncMap  { return m.funcMap }
-func (m *mockModule) AdminURL() string                 { return "" }
-func (m *mockModule) SidebarLabel() string             { return "" }
-func (m *mockModule) TranslationsFS() embed.FS         { return embed.FS{} }
+func (m *mockModule) RegisterAdminRoutes(_ chi.Router)      { m.adminCalled = true }
+func (m *mockModule) TemplateFuncs() template.FuncMap       { return m.funcMap }
+func (m *mockModule) Shortcodes() map[string]shortcode.Func { return m.shortcodes }
+func (m *mockModule) Widgets() []widget.Type                { return m.widgets }
+func (m *mockModule) AdminURL() string                      { return "" }
+func (m *mockModule) SidebarLabel() string                  { return "" }
+func (m *mockModule) TranslationsFS() embed.FS              { return embed.FS{} }
 
 func createTestDB(t *testing.T) *sql.DB {
 	t.Helper()
@@ -339,6 +346,47 @@ func TestAllTemplateFuncs(t *testing.T) {
 	}
 }
 
+func TestAllShortcodes(t *testing.T) {
+	logger := testutil.TestLoggerSilent()
+	r := NewRegistry(logger)
+
+	render := func(context.Context, shortcode.Call) (template.HTML, error) { return "ok", nil }
+	m := newMockModule("codes", "1.0.0")
+	m.shortcodes = map[string]shortcode.Func{"greeting": render, "Bad Name": render, "empty": nil}
+	_ = r.Register(m)
+
+	codes := r.AllShortcodes()
+	if _, ok := codes["greeting"]; !ok {
+		t.Error("expected greeting to be in combined shortcodes")
+	}
+	if len(codes) != 1 {
+		t.Errorf("shortcodes = %v, want invalid entries skipped", codes)
+	}
+}
+
+func TestAllWidgetTypes(t *testing.T) {
+	logger := testutil.TestLoggerSilent()
+	r := NewRegistry(logger)
+
+	render := func(context.Context, widget.Call) (template.HTML, error) { return "ok", nil }
+	m1 := newMockModule("first", "1.0.0")
+	m1.widgets = []widget.Type{{ID: "popular", Render: render}, {ID: "Bad ID", Render: render}, {ID: "static"}}
+	m2 := newMockModule("second", "1.0.0")
+	m2.widgets = []widget.Type{{ID: "popular", Name: "Duplicate", Render: render}, {ID: "weather", Render: render}}
+	_ = r.Register(m1)
+	_ = r.Register(m2)
+
+	types := r.AllWidgetTypes()
+	if len(types) != 2 || types[0].ID != "popular" || types[0].Name != "" || types[1].ID != "weather" {
+		t.Errorf("widget types = %+v, want popular from the first module and weather", types)
+	}
+
+	r.activeStatus["second"] = false
+	if types := r.AllWidgetTypes(); len(types) != 1 {
+		t.Errorf("widget types = %+v, want inactive module skipped", types)
+	}
+}
+
 func TestListInfo(t *testing.T) {
 	logger := testutil.TestLoggerSilent()
 	r := NewRegistry(logger)
@@ -31,6 +31,7 @@ import (
 	"github.com/olegiv/ocms-go/internal/geoip"
 	"github.com/olegiv/ocms-go/internal/i18n"
 	"github.com/olegiv/ocms-go/internal/middleware"
+	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/service"
 	"github.com/olegiv/ocms-go/internal/store"
 	adminviews "github.com/olegiv/ocms-go/internal/views/admin"
@@ -455,7 +456,9 @@ func (r *Renderer) templateFuncs() template.FuncMap {
 			if r.menuService == nil {
 				return nil
 			}
-			return r.menuService.GetMenu(slug)
+			// Template funcs have no visitor: show the menu a visitor
+			// who is not logged in sees.
+			return service.FilterMenuItems(r.menuService.GetMenu(slug), model.RoleAnonymous)
 		},
 		// getMenuForLanguage returns menu items for a specific language with fallback.
 		// Usage in templates: {{range getMenuForLanguage "main" "ru"}}...{{end}}
@@ -463,7 +466,7 @@ func (r *Renderer) templateFuncs() template.FuncMap {
 			if r.menuService == nil {
 				return nil
 			}
-			return r.menuService.GetMenuForLanguage(slug, langCode)
+			return service.FilterMenuItems(r.menuService.GetMenuForLanguage(slug, langCode), model.RoleAnonymous)
 		},
 		"dict": func(values ...any) map[string]any {
 			if len(values)%2 != 0 {
@@ -665,6 +668,31 @@ func (r *Renderer) templateFuncs() template.FuncMap {
 		"mediaCaption": func(mediaID int64, langCode string, defaultCaption string) string {
 			return r.getMediaTranslation(mediaID, langCode, "caption", defaultCaption)
 		},
+		// childPages returns the published subpages of a page in tree order.
+		// Usage in theme templates: {{range childPages .Page.ID .LangPrefix}}<a href="{{.URL}}">{{.Title}}</a>{{end}}
+		"childPages": func(pageID int64, langPrefix string) []service.PageLink {
+			if r.db == nil || pageID == 0 {
+				return nil
+			}
+			return service.ChildPageLinks(context.Background(), store.New(r.db), pageID, langPrefix)
+		},
+		// relatedPages returns up to limit pages related to a page: editor
+		// picks, then pages sharing tags, categories or text.
+		// Usage in theme templates: {{range relatedPages .Page.ID .LangPrefix 3}}<a href="{{.URL}}">{{.Title}}</a>{{end}}
+		"relatedPages": func(pageID int64, langPrefix string, limit int) []service.PageLink {
+			if r.db == nil || pageID == 0 {
+				return nil
+			}
+			return service.NewRelatedService(r.db).RelatedPageLinks(context.Background(), pageID, langPrefix, limit)
+		},
+		// pageSeries returns the series navigation of a page, or nil.
+		// Usage in theme templates: {{with pageSeries .Page.ID .LangPrefix}}{{.Name}} {{.Position}}/{{.Total}}{{end}}
+		"pageSeries": func(pageID int64, langPrefix string) *service.SeriesNav {
+			if r.db == nil || pageID == 0 {
+				return nil
+			}
+			return service.PageSeriesNav(context.Background(), store.New(r.db), pageID, langPrefix)
+		},
 		// Placeholder functions for hCaptcha module (will be overwritten if module is loaded)
 		"hcaptchaEnabled": func() bool {
 			return false
@@ -929,6 +957,13 @@ var monthsRu = []string{
 	"июля", "августа", "сентября", "октября", "ноября", "декабря",
 }
 
+// monthsRuNominative contains Russian month names in nominative case, as
+// used on their own, e.g. in archive titles.
+var monthsRuNominative = []string{
+	"Январь", "Февраль", "Март", "Апрель", "Май", "Июнь",
+	"Июль", "Август", "Сентябрь", "Октябрь", "Ноябрь", "Декабрь",
+}
+
 // applyTimeFormatter applies a time formatting function to a value that may be time.Time or *time.Time.
 func applyTimeFormatter(t any, lang string, formatter func(time.Time, string) string) string {
 	switch v := t.(type) {
@@ -952,6 +987,14 @@ func formatDateForLocale(t time.Time, lang string) string {
 	return t.Format("Jan 2, 2006")
 }
 
+// FormatMonthLocale formats the month of t with its year, e.g. "October 2026".
+func FormatMonthLocale(t time.Time, lang string) string {
+	if lang == "ru" {
+		return fmt.Sprintf("%s %d", monthsRuNominative[t.Month()-1], t.Year())
+	}
+	return t.Format("January 2006")
+}
+
 // formatDateTimeForLocale formats a time.Time as a localized datetime string.
 func formatDateTimeForLocale(t time.Time, lang string) string {
 	if lang == "ru" {
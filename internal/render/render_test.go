@@ -472,6 +472,25 @@ func TestFormatDateTimeForLocale(t *testing.T) {
 	}
 }
 
+func TestFormatMonthLocale(t *testing.T) {
+	testTime := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		lang     string
+		expected string
+	}{
+		{"en", "October 2026"},
+		{"ru", "Октябрь 2026"},
+		{"de", "October 2026"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatMonthLocale(testTime, tt.lang); got != tt.expected {
+			t.Errorf("FormatMonthLocale(%v, %q) = %q, want %q", testTime, tt.lang, got, tt.expected)
+		}
+	}
+}
+
 func TestApplyTimeFormatter(t *testing.T) {
 	testTime := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
 	formatter := func(t time.Time, _ string) string {
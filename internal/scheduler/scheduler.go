@@ -15,15 +15,18 @@ import (
 	"github.com/robfig/cron/v3"
 
 	"github.com/olegiv/ocms-go/internal/demo"
+	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/webhook"
 )
 
 // Scheduler handles scheduled tasks like publishing pages.
 type Scheduler struct {
-	db       *sql.DB
-	cron     *cron.Cron
-	logger   *slog.Logger
-	registry *Registry
+	db         *sql.DB
+	cron       *cron.Cron
+	logger     *slog.Logger
+	registry   *Registry
+	dispatcher *webhook.Dispatcher
 }
 
 // New creates a new scheduler instance.
@@ -36,6 +39,12 @@ func New(db *sql.DB, logger *slog.Logger, registry *Registry) *Scheduler {
 	}
 }
 
+// SetDispatcher sets the webhook dispatcher used to announce scheduled publishes.
+// It must be called before Start.
+func (s *Scheduler) SetDispatcher(d *webhook.Dispatcher) {
+	s.dispatcher = d
+}
+
 // Cron returns the cron instance.
 func (s *Scheduler) Cron() *cron.Cron {
 	return s.cron
@@ -131,7 +140,7 @@ func (s *Scheduler) processScheduledPages() error {
 // publishPage publishes a single scheduled page and logs the event.
 func (s *Scheduler) publishPage(ctx context.Context, queries *store.Queries, page store.Page, now time.Time) error {
 	// Publish the page
-	_, err := queries.PublishScheduledPage(ctx, store.PublishScheduledPageParams{
+	published, err := queries.PublishScheduledPage(ctx, store.PublishScheduledPageParams{
 		PublishedAt: sql.NullTime{Time: now, Valid: true},
 		UpdatedAt:   now,
 		ID:          page.ID,
@@ -162,9 +171,31 @@ func (s *Scheduler) publishPage(ctx context.Context, queries *store.Queries, pag
 		s.logger.Warn("failed to log scheduled publish event", "error", err)
 	}
 
+	s.dispatchPublished(ctx, queries, published)
+
 	return nil
 }
 
+// dispatchPublished sends page.published and page.scheduled_published for a
+// page published by the scheduler, so subscribers of either event are notified.
+func (s *Scheduler) dispatchPublished(ctx context.Context, queries *store.Queries, page store.Page) {
+	if s.dispatcher == nil {
+		return
+	}
+
+	var authorEmail string
+	if author, err := queries.GetUserByID(ctx, page.AuthorID); err == nil {
+		authorEmail = author.Email
+	}
+	data := webhook.NewPageEventData(page, authorEmail)
+
+	for _, eventType := range []string{model.EventPagePublished, model.EventPageScheduledPublished} {
+		if err := s.dispatcher.DispatchEvent(ctx, eventType, data); err != nil {
+			s.logger.Error("failed to dispatch webhook event", "error", err, "event_type", eventType, "page_id", page.ID)
+		}
+	}
+}
+
 // AddDemoReset registers a daily job at 01:00 UTC that resets the demo
 // database and uploads, then sends SIGTERM for a clean restart.
 func (s *Scheduler) AddDemoReset(dbPath, uploadsDir, dataDir string) error {
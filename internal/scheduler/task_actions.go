@@ -0,0 +1,428 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/transfer"
+	"github.com/olegiv/ocms-go/internal/webhook"
+)
+
+// Scheduled task types
+const (
+	TaskTypeURL            = "url"             // HTTP GET a URL (default)
+	TaskTypePublishPage    = "publish_page"    // Publish a page
+	TaskTypeUnpublishPage  = "unpublish_page"  // Unpublish a page
+	TaskTypeClearCache     = "clear_cache"     // Clear all caches
+	TaskTypeRebuildSitemap = "rebuild_sitemap" // Regenerate the cached sitemap
+	TaskTypeRebuildSearch  = "rebuild_search"  // Rebuild the full-text search index
+	TaskTypePurgeEvents    = "purge_events"    // Delete old event log entries
+	TaskTypeExport         = "export"          // Write a content export to disk
+)
+
+// Retry policies for failed task runs
+const (
+	RetryPolicyNone        = "none"        // Never retry
+	RetryPolicyFixed       = "fixed"       // Retry after the same delay each time
+	RetryPolicyExponential = "exponential" // Double the delay after each failed attempt
+)
+
+const (
+	// MaxTaskRetries caps the number of retries a task may configure.
+	MaxTaskRetries = 10
+	// maxRetryDelay caps the wait between attempts for exponential backoff.
+	maxRetryDelay = time.Hour
+	// maxPurgeRetentionDays caps the retention of the purge_events task type.
+	maxPurgeRetentionDays = 3650
+	// maxExportKeepFiles caps how many export files a task may keep.
+	maxExportKeepFiles = 100
+	// maxRunLogLen caps the stored log of a single run.
+	maxRunLogLen = 16384
+)
+
+// TaskTypes returns all supported task types in display order.
+func TaskTypes() []string {
+	return []string{
+		TaskTypeURL,
+		TaskTypePublishPage,
+		TaskTypeUnpublishPage,
+		TaskTypeClearCache,
+		TaskTypeRebuildSitemap,
+		TaskTypeRebuildSearch,
+		TaskTypePurgeEvents,
+		TaskTypeExport,
+	}
+}
+
+// IsValidTaskType reports whether taskType is a supported task type.
+func IsValidTaskType(taskType string) bool {
+	return slices.Contains(TaskTypes(), taskType)
+}
+
+// RetryPolicies returns all supported retry policies in display order.
+func RetryPolicies() []string {
+	return []string{RetryPolicyNone, RetryPolicyFixed, RetryPolicyExponential}
+}
+
+// IsValidRetryPolicy reports whether policy is a supported retry policy.
+func IsValidRetryPolicy(policy string) bool {
+	return slices.Contains(RetryPolicies(), policy)
+}
+
+// TaskParams holds the typed parameters of internal task types.
+// It is stored as JSON in scheduled_tasks.params.
+type TaskParams struct {
+	PageID        int64 `json:"page_id,omitempty"`        // publish_page, unpublish_page
+	RetentionDays int64 `json:"retention_days,omitempty"` // purge_events
+	IncludeMedia  bool  `json:"include_media,omitempty"`  // export: write a zip with media files
+	KeepFiles     int64 `json:"keep_files,omitempty"`     // export: number of files to keep (0 = all)
+}
+
+// ParseTaskParams decodes stored task parameters. Invalid JSON yields zero params.
+func ParseTaskParams(raw string) TaskParams {
+	var p TaskParams
+	if raw != "" {
+		_ = json.Unmarshal([]byte(raw), &p)
+	}
+	return p
+}
+
+// JSON encodes the parameters for storage.
+func (p TaskParams) JSON() string {
+	data, _ := json.Marshal(p)
+	return string(data)
+}
+
+// ValidateTaskParams checks that params carry what taskType needs.
+func ValidateTaskParams(taskType string, p TaskParams) error {
+	switch taskType {
+	case TaskTypePublishPage, TaskTypeUnpublishPage:
+		if p.PageID <= 0 {
+			return errors.New("a page is required")
+		}
+	case TaskTypePurgeEvents:
+		if p.RetentionDays < 1 || p.RetentionDays > maxPurgeRetentionDays {
+			return fmt.Errorf("retention must be between 1 and %d days", maxPurgeRetentionDays)
+		}
+	case TaskTypeExport:
+		if p.KeepFiles < 0 || p.KeepFiles > maxExportKeepFiles {
+			return fmt.Errorf("files to keep must be between 0 and %d", maxExportKeepFiles)
+		}
+	}
+	return nil
+}
+
+// RetryDelay returns how long to wait before the attempt that follows a
+// failed attempt (1-based) under policy, or 0 when no retry should happen.
+func RetryDelay(policy string, base time.Duration, attempt int64) time.Duration {
+	switch policy {
+	case RetryPolicyFixed:
+		return base
+	case RetryPolicyExponential:
+		delay := base
+		for i := int64(1); i < attempt && delay < maxRetryDelay; i++ {
+			delay *= 2
+		}
+		return min(delay, maxRetryDelay)
+	default:
+		return 0
+	}
+}
+
+// taskTypeOf returns the task's type, treating rows created before task types
+// existed as URL tasks.
+func taskTypeOf(task store.ScheduledTask) string {
+	if task.TaskType == "" {
+		return TaskTypeURL
+	}
+	return task.TaskType
+}
+
+// taskDescription describes a task in the scheduler registry.
+func taskDescription(task store.ScheduledTask) string {
+	if taskTypeOf(task) == TaskTypeURL {
+		return task.Name + ": GET " + task.Url
+	}
+	return task.Name + ": " + taskTypeOf(task)
+}
+
+// TaskCache is the subset of the cache manager used by internal task types.
+type TaskCache interface {
+	ClearAll()
+	InvalidatePage(pageID int64)
+	InvalidateSitemap()
+	GetSitemap(ctx context.Context, siteURL string) ([]byte, error)
+}
+
+// SearchIndexer rebuilds the full-text search index.
+type SearchIndexer interface {
+	RebuildIndex(ctx context.Context) error
+}
+
+// runLog collects timestamped log lines for a single task run.
+type runLog struct {
+	lines []string
+}
+
+// Printf appends a formatted line to the log.
+func (l *runLog) Printf(format string, args ...any) {
+	l.lines = append(l.lines, time.Now().Format("15:04:05")+" "+fmt.Sprintf(format, args...))
+}
+
+// String returns the log, truncated to at most maxRunLogLen bytes on a
+// rune boundary.
+func (l *runLog) String() string {
+	s := strings.Join(l.lines, "\n")
+	if len(s) > maxRunLogLen {
+		cut := maxRunLogLen
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		s = s[:cut] + "\n... (truncated)"
+	}
+	return s
+}
+
+// runAction executes an internal task type and returns a short summary of the result.
+func (te *TaskExecutor) runAction(ctx context.Context, task store.ScheduledTask, log *runLog) (string, error) {
+	params := ParseTaskParams(task.Params)
+	if err := ValidateTaskParams(task.TaskType, params); err != nil {
+		return "", fmt.Errorf("invalid parameters: %w", err)
+	}
+
+	switch task.TaskType {
+	case TaskTypePublishPage:
+		return te.setPagePublished(ctx, params.PageID, true, log)
+	case TaskTypeUnpublishPage:
+		return te.setPagePublished(ctx, params.PageID, false, log)
+	case TaskTypeClearCache:
+		return te.clearCache(log)
+	case TaskTypeRebuildSitemap:
+		return te.rebuildSitemap(ctx, log)
+	case TaskTypeRebuildSearch:
+		return te.rebuildSearch(ctx, log)
+	case TaskTypePurgeEvents:
+		return te.purgeEvents(ctx, params.RetentionDays, log)
+	case TaskTypeExport:
+		return te.exportToDisk(ctx, task.ID, params, log)
+	default:
+		return "", fmt.Errorf("unknown task type %q", task.TaskType)
+	}
+}
+
+// setPagePublished publishes or unpublishes a page. A page already in the
+// requested state is left untouched.
+func (te *TaskExecutor) setPagePublished(ctx context.Context, pageID int64, publish bool, log *runLog) (string, error) {
+	queries := store.New(te.db)
+
+	page, err := queries.GetPageByID(ctx, pageID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("page %d not found", pageID)
+	}
+	if err != nil {
+		return "", fmt.Errorf("loading page: %w", err)
+	}
+	log.Printf("page %d %q is %s", page.ID, page.Title, page.Status)
+
+	if (page.Status == model.PageStatusPublished) == publish {
+		log.Printf("nothing to do")
+		return "page already " + page.Status, nil
+	}
+
+	now := time.Now()
+	var updated store.Page
+	var eventType, message string
+	if publish {
+		updated, err = queries.PublishPage(ctx, store.PublishPageParams{
+			PublishedAt: sql.NullTime{Time: now, Valid: true},
+			UpdatedAt:   now,
+			ID:          page.ID,
+		})
+		eventType, message = model.EventPagePublished, "Page published by scheduled task: "+page.Title
+	} else {
+		updated, err = queries.UnpublishPage(ctx, store.UnpublishPageParams{
+			UpdatedAt: now,
+			ID:        page.ID,
+		})
+		eventType, message = model.EventPageUnpublished, "Page unpublished by scheduled task: "+page.Title
+	}
+	if err != nil {
+		return "", fmt.Errorf("updating page status: %w", err)
+	}
+	log.Printf("page status changed to %s", updated.Status)
+
+	if te.cache != nil {
+		te.cache.InvalidatePage(page.ID)
+	}
+
+	metadataJSON, _ := json.Marshal(map[string]any{
+		"page_id":    page.ID,
+		"page_title": page.Title,
+		"page_slug":  page.Slug,
+	})
+	if _, err := queries.CreateEvent(ctx, store.CreateEventParams{
+		Level:     model.EventLevelInfo,
+		Category:  model.EventCategoryPage,
+		Message:   message,
+		Metadata:  string(metadataJSON),
+		CreatedAt: now,
+	}); err != nil {
+		te.logger.Warn("failed to log scheduled task page event", "error", err, "page_id", page.ID)
+	}
+
+	if te.dispatcher != nil {
+		var authorEmail string
+		if author, err := queries.GetUserByID(ctx, updated.AuthorID); err == nil {
+			authorEmail = author.Email
+		}
+		if err := te.dispatcher.DispatchEvent(ctx, eventType, webhook.NewPageEventData(updated, authorEmail)); err != nil {
+			log.Printf("webhook dispatch failed: %v", err)
+		}
+	}
+
+	return "page " + updated.Status, nil
+}
+
+// clearCache clears all caches.
+func (te *TaskExecutor) clearCache(log *runLog) (string, error) {
+	if te.cache == nil {
+		return "", errors.New("cache manager not available")
+	}
+	te.cache.ClearAll()
+	log.Printf("all caches cleared")
+	return "caches cleared", nil
+}
+
+// rebuildSitemap invalidates the cached sitemap and regenerates it for the
+// configured site URL. Without a site URL the sitemap is only invalidated.
+func (te *TaskExecutor) rebuildSitemap(ctx context.Context, log *runLog) (string, error) {
+	if te.cache == nil {
+		return "", errors.New("cache manager not available")
+	}
+	te.cache.InvalidateSitemap()
+	log.Printf("sitemap cache invalidated")
+
+	siteURL := ""
+	if cfg, err := store.New(te.db).GetConfigByKey(ctx, "site_url"); err == nil {
+		siteURL = cfg.Value
+	}
+	if siteURL == "" {
+		log.Printf("site_url is not configured; sitemap will be generated on the next request")
+		return "sitemap invalidated", nil
+	}
+
+	xml, err := te.cache.GetSitemap(ctx, siteURL)
+	if err != nil {
+		return "", fmt.Errorf("generating sitemap: %w", err)
+	}
+	log.Printf("sitemap generated for %s (%d bytes)", siteURL, len(xml))
+	return fmt.Sprintf("sitemap rebuilt (%d bytes)", len(xml)), nil
+}
+
+// rebuildSearch rebuilds the full-text search index.
+func (te *TaskExecutor) rebuildSearch(ctx context.Context, log *runLog) (string, error) {
+	if te.searchIndexer == nil {
+		return "", errors.New("search service not available")
+	}
+	if err := te.searchIndexer.RebuildIndex(ctx); err != nil {
+		return "", fmt.Errorf("rebuilding search index: %w", err)
+	}
+	log.Printf("search index rebuilt")
+	return "search index rebuilt", nil
+}
+
+// purgeEvents deletes event log entries older than retentionDays.
+func (te *TaskExecutor) purgeEvents(ctx context.Context, retentionDays int64, log *runLog) (string, error) {
+	cutoff := time.Now().AddDate(0, 0, -int(retentionDays))
+	if err := store.New(te.db).DeleteOldEvents(ctx, cutoff); err != nil {
+		return "", fmt.Errorf("deleting old events: %w", err)
+	}
+	log.Printf("deleted events older than %s", cutoff.Format("2006-01-02 15:04"))
+	return fmt.Sprintf("events older than %d days purged", retentionDays), nil
+}
+
+// exportToDisk writes a full content export into the export directory and
+// prunes older exports of the same task beyond params.KeepFiles.
+func (te *TaskExecutor) exportToDisk(ctx context.Context, taskID int64, params TaskParams, log *runLog) (string, error) {
+	if te.exporter == nil || te.exportDir == "" {
+		return "", errors.New("export directory not configured")
+	}
+	if err := os.MkdirAll(te.exportDir, 0o700); err != nil {
+		return "", fmt.Errorf("creating export directory: %w", err)
+	}
+
+	opts := transfer.DefaultExportOptions()
+	opts.IncludeMediaFiles = params.IncludeMedia
+
+	ext := ".json"
+	if params.IncludeMedia {
+		ext = ".zip"
+	}
+	name := exportFilePrefix(taskID) + time.Now().Format("20060102-150405") + ext
+	path := filepath.Join(te.exportDir, name)
+
+	// Create the file owner-only up front so the export is never readable
+	// by others, not even while it is being written.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return "", fmt.Errorf("creating export file: %w", err)
+	}
+	if params.IncludeMedia {
+		err = te.exporter.ExportWithMedia(ctx, opts, f)
+	} else {
+		err = te.exporter.ExportToWriter(ctx, opts, f)
+	}
+	if err = errors.Join(err, f.Close()); err != nil {
+		_ = os.Remove(path)
+		return "", fmt.Errorf("exporting: %w", err)
+	}
+
+	size := int64(0)
+	if info, err := os.Stat(path); err == nil {
+		size = info.Size()
+	}
+	log.Printf("wrote %s (%d bytes)", path, size)
+
+	if params.KeepFiles > 0 {
+		pruneExports(te.exportDir, taskID, int(params.KeepFiles), log)
+	}
+
+	return fmt.Sprintf("%s (%d bytes)", name, size), nil
+}
+
+// exportFilePrefix returns the file name prefix of exports written by a task.
+func exportFilePrefix(taskID int64) string {
+	return fmt.Sprintf("task-%d-", taskID)
+}
+
+// pruneExports removes all but the newest keep exports of a task. Export
+// file names embed their timestamp, so lexical order is chronological.
+func pruneExports(dir string, taskID int64, keep int, log *runLog) {
+	matches, err := filepath.Glob(filepath.Join(dir, exportFilePrefix(taskID)+"*"))
+	if err != nil || len(matches) <= keep {
+		return
+	}
+	sort.Strings(matches)
+	for _, old := range matches[:len(matches)-keep] {
+		if err := os.Remove(old); err != nil {
+			log.Printf("failed to remove old export %s: %v", filepath.Base(old), err)
+			continue
+		}
+		log.Printf("removed old export %s", filepath.Base(old))
+	}
+}
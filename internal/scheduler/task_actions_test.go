@@ -0,0 +1,307 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/testutil"
+	"github.com/olegiv/ocms-go/internal/transfer"
+)
+
+func TestRetryDelay(t *testing.T) {
+	base := 30 * time.Second
+
+	tests := []struct {
+		name    string
+		policy  string
+		attempt int64
+		want    time.Duration
+	}{
+		{"none", RetryPolicyNone, 1, 0},
+		{"unknown", "sometimes", 1, 0},
+		{"fixed first", RetryPolicyFixed, 1, base},
+		{"fixed later", RetryPolicyFixed, 4, base},
+		{"exponential first", RetryPolicyExponential, 1, base},
+		{"exponential third", RetryPolicyExponential, 3, 4 * base},
+		{"exponential capped", RetryPolicyExponential, 10, maxRetryDelay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RetryDelay(tt.policy, base, tt.attempt); got != tt.want {
+				t.Errorf("RetryDelay(%q, %s, %d) = %s, want %s", tt.policy, base, tt.attempt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateTaskParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		taskType string
+		params   TaskParams
+		wantErr  bool
+	}{
+		{"url needs no params", TaskTypeURL, TaskParams{}, false},
+		{"publish without page", TaskTypePublishPage, TaskParams{}, true},
+		{"unpublish with page", TaskTypeUnpublishPage, TaskParams{PageID: 3}, false},
+		{"purge without retention", TaskTypePurgeEvents, TaskParams{}, true},
+		{"purge too long", TaskTypePurgeEvents, TaskParams{RetentionDays: maxPurgeRetentionDays + 1}, true},
+		{"purge valid", TaskTypePurgeEvents, TaskParams{RetentionDays: 30}, false},
+		{"export negative keep", TaskTypeExport, TaskParams{KeepFiles: -1}, true},
+		{"export keep all", TaskTypeExport, TaskParams{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTaskParams(tt.taskType, tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTaskParams() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTaskParams_RoundTrip(t *testing.T) {
+	p := TaskParams{PageID: 7, IncludeMedia: true, KeepFiles: 3}
+	if got := ParseTaskParams(p.JSON()); got != p {
+		t.Errorf("ParseTaskParams(JSON()) = %+v, want %+v", got, p)
+	}
+	if got := ParseTaskParams("not json"); got != (TaskParams{}) {
+		t.Errorf("ParseTaskParams(invalid) = %+v, want zero value", got)
+	}
+}
+
+// newActionTestExecutor creates a TaskExecutor backed by a migrated test database.
+func newActionTestExecutor(t *testing.T) (*TaskExecutor, *store.Queries) {
+	t.Helper()
+
+	db, cleanup := testutil.TestDB(t)
+	t.Cleanup(cleanup)
+
+	return NewTaskExecutor(db, testutil.TestLoggerSilent(), nil, cron.New()), store.New(db)
+}
+
+// createActionTask stores a task of taskType with params.
+func createActionTask(t *testing.T, queries *store.Queries, taskType string, params TaskParams, retryPolicy string, maxRetries int64) store.ScheduledTask {
+	t.Helper()
+
+	now := time.Now()
+	task, err := queries.CreateScheduledTask(context.Background(), store.CreateScheduledTaskParams{
+		Name:              "action-" + taskType,
+		Schedule:          "@every 1h",
+		IsActive:          1,
+		TimeoutSeconds:    30,
+		TaskType:          taskType,
+		Params:            params.JSON(),
+		RetryPolicy:       retryPolicy,
+		MaxRetries:        maxRetries,
+		RetryDelaySeconds: 60,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	})
+	if err != nil {
+		t.Fatalf("CreateScheduledTask() error = %v", err)
+	}
+	return task
+}
+
+// lastRun returns the most recent run of a task.
+func lastRun(t *testing.T, queries *store.Queries, taskID int64) store.ScheduledTaskRun {
+	t.Helper()
+
+	runs, err := queries.ListScheduledTaskRuns(context.Background(), store.ListScheduledTaskRunsParams{TaskID: taskID, Limit: 1})
+	if err != nil || len(runs) == 0 {
+		t.Fatalf("ListScheduledTaskRuns() = %d runs, error = %v", len(runs), err)
+	}
+	return runs[0]
+}
+
+func TestRunAttempt_PublishAndUnpublishPage(t *testing.T) {
+	te, queries := newActionTestExecutor(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	user, err := queries.CreateUser(ctx, store.CreateUserParams{
+		Email: "author@example.com", PasswordHash: "hash", Role: "admin", Name: "Author",
+		CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	page, err := queries.CreatePage(ctx, store.CreatePageParams{
+		Title: "Launch", Slug: "launch", Status: model.PageStatusDraft,
+		AuthorID: user.ID, LanguageCode: "en", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
+	})
+	if err != nil {
+		t.Fatalf("CreatePage() error = %v", err)
+	}
+
+	publish := createActionTask(t, queries, TaskTypePublishPage, TaskParams{PageID: page.ID}, RetryPolicyNone, 0)
+	te.runAttempt(publish, 1)
+
+	got, _ := queries.GetPageByID(ctx, page.ID)
+	if got.Status != model.PageStatusPublished || !got.PublishedAt.Valid {
+		t.Fatalf("page status = %q, published_at valid = %v, want published", got.Status, got.PublishedAt.Valid)
+	}
+	run := lastRun(t, queries, publish.ID)
+	if run.Status != "success" || run.Attempt != 1 || !strings.Contains(run.Log, "page status changed to published") {
+		t.Errorf("run = status %q attempt %d log %q", run.Status, run.Attempt, run.Log)
+	}
+
+	// Running it again leaves the already published page alone.
+	te.runAttempt(publish, 1)
+	if run := lastRun(t, queries, publish.ID); run.Status != "success" || !strings.Contains(run.Log, "nothing to do") {
+		t.Errorf("second run = status %q log %q", run.Status, run.Log)
+	}
+
+	unpublish := createActionTask(t, queries, TaskTypeUnpublishPage, TaskParams{PageID: page.ID}, RetryPolicyNone, 0)
+	te.runAttempt(unpublish, 1)
+	if got, _ := queries.GetPageByID(ctx, page.ID); got.Status != model.PageStatusDraft {
+		t.Errorf("page status after unpublish = %q, want draft", got.Status)
+	}
+}
+
+func TestRunAttempt_FailureSchedulesRetry(t *testing.T) {
+	te, queries := newActionTestExecutor(t)
+
+	// No cache manager is set, so clear_cache fails.
+	task := createActionTask(t, queries, TaskTypeClearCache, TaskParams{}, RetryPolicyExponential, 2)
+	te.runAttempt(task, 1)
+
+	run := lastRun(t, queries, task.ID)
+	if run.Status != "failed" || !run.ErrorMessage.Valid || !strings.Contains(run.Log, "retrying in 1m0s") {
+		t.Fatalf("run = status %q error %v log %q", run.Status, run.ErrorMessage, run.Log)
+	}
+
+	te.mu.Lock()
+	_, pending := te.retryTimers[task.ID]
+	te.mu.Unlock()
+	if !pending {
+		t.Fatal("expected a pending retry after a failed attempt")
+	}
+
+	te.RemoveTask(task.ID)
+	te.mu.Lock()
+	_, pending = te.retryTimers[task.ID]
+	te.mu.Unlock()
+	if pending {
+		t.Error("RemoveTask() should cancel the pending retry")
+	}
+
+	// The last allowed attempt does not schedule another one.
+	te.runAttempt(task, 3)
+	if run := lastRun(t, queries, task.ID); run.Attempt != 3 || strings.Contains(run.Log, "retrying") {
+		t.Errorf("final attempt = attempt %d log %q", run.Attempt, run.Log)
+	}
+	te.mu.Lock()
+	_, pending = te.retryTimers[task.ID]
+	te.mu.Unlock()
+	if pending {
+		t.Error("no retry should be scheduled after the last attempt")
+	}
+}
+
+func TestRunAttempt_PurgeEvents(t *testing.T) {
+	te, queries := newActionTestExecutor(t)
+	ctx := context.Background()
+
+	for _, age := range []time.Duration{48 * time.Hour, time.Hour} {
+		if _, err := queries.CreateEvent(ctx, store.CreateEventParams{
+			Level: model.EventLevelInfo, Category: model.EventCategorySystem, Message: "event",
+			UserID: sql.NullInt64{}, Metadata: "{}", CreatedAt: time.Now().Add(-age),
+		}); err != nil {
+			t.Fatalf("CreateEvent() error = %v", err)
+		}
+	}
+
+	task := createActionTask(t, queries, TaskTypePurgeEvents, TaskParams{RetentionDays: 1}, RetryPolicyNone, 0)
+	te.runAttempt(task, 1)
+
+	count, err := queries.CountEvents(ctx)
+	if err != nil {
+		t.Fatalf("CountEvents() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("events after purge = %d, want 1", count)
+	}
+}
+
+func TestRunAttempt_Export(t *testing.T) {
+	te, queries := newActionTestExecutor(t)
+	dir := filepath.Join(t.TempDir(), "exports")
+	te.SetExporter(transfer.NewExporter(queries, testutil.TestLoggerSilent()), dir)
+
+	task := createActionTask(t, queries, TaskTypeExport, TaskParams{}, RetryPolicyNone, 0)
+	te.runAttempt(task, 1)
+
+	if run := lastRun(t, queries, task.ID); run.Status != "success" {
+		t.Fatalf("run = status %q error %v log %q", run.Status, run.ErrorMessage, run.Log)
+	}
+	matches, _ := filepath.Glob(filepath.Join(dir, exportFilePrefix(task.ID)+"*.json"))
+	if len(matches) != 1 {
+		t.Fatalf("export files = %v, want 1", matches)
+	}
+	info, err := os.Stat(matches[0])
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("export file mode = %o, want 600", perm)
+	}
+}
+
+func TestPruneExports(t *testing.T) {
+	dir := t.TempDir()
+	names := []string{
+		"task-1-20261001-000000.json",
+		"task-1-20261002-000000.json",
+		"task-1-20261003-000000.zip",
+		"task-2-20261001-000000.json",
+	}
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pruneExports(dir, 1, 2, &runLog{})
+
+	for _, name := range names {
+		_, err := os.Stat(filepath.Join(dir, name))
+		wantKept := name != "task-1-20261001-000000.json"
+		if (err == nil) != wantKept {
+			t.Errorf("%s kept = %v, want %v", name, err == nil, wantKept)
+		}
+	}
+}
+
+func TestRunLogTruncatesOnRuneBoundary(t *testing.T) {
+	var log runLog
+	log.lines = []string{strings.Repeat("ж", maxRunLogLen)}
+
+	s := log.String()
+	if !utf8.ValidString(s) {
+		t.Fatal("String() split a rune")
+	}
+	if !strings.HasSuffix(s, "... (truncated)") {
+		t.Errorf("String() = %q..., want truncation marker", s[:20])
+	}
+	if body := strings.TrimSuffix(s, "\n... (truncated)"); len(body) > maxRunLogLen {
+		t.Errorf("kept %d bytes, want at most %d", len(body), maxRunLogLen)
+	}
+}
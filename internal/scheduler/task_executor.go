@@ -18,7 +18,9 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/transfer"
 	"github.com/olegiv/ocms-go/internal/util"
+	"github.com/olegiv/ocms-go/internal/webhook"
 )
 
 const (
@@ -27,7 +29,8 @@ const (
 	defaultHTTPTimeout = 30 * time.Second
 )
 
-// TaskExecutor manages user-created scheduled tasks that make HTTP GET requests.
+// TaskExecutor manages user-created scheduled tasks that make HTTP GET
+// requests or run internal actions (see TaskTypes).
 type TaskExecutor struct {
 	db              *sql.DB
 	logger          *slog.Logger
@@ -37,6 +40,13 @@ type TaskExecutor struct {
 	mu              sync.Mutex
 	taskEntries     map[int64]cron.EntryID
 	triggerLimiters map[int64]*rate.Limiter
+	retryTimers     map[int64]*time.Timer
+
+	cache         TaskCache
+	searchIndexer SearchIndexer
+	dispatcher    *webhook.Dispatcher
+	exporter      *transfer.Exporter
+	exportDir     string
 }
 
 // NewTaskExecutor creates a new TaskExecutor.
@@ -69,9 +79,32 @@ func NewTaskExecutor(db *sql.DB, logger *slog.Logger, registry *Registry, cronIn
 		},
 		taskEntries:     make(map[int64]cron.EntryID),
 		triggerLimiters: make(map[int64]*rate.Limiter),
+		retryTimers:     make(map[int64]*time.Timer),
 	}
 }
 
+// SetCache sets the cache manager used by the cache, sitemap and page task types.
+func (te *TaskExecutor) SetCache(c TaskCache) {
+	te.cache = c
+}
+
+// SetSearchIndexer sets the search index used by the rebuild_search task type.
+func (te *TaskExecutor) SetSearchIndexer(s SearchIndexer) {
+	te.searchIndexer = s
+}
+
+// SetDispatcher sets the webhook dispatcher for page events of the
+// publish_page and unpublish_page task types.
+func (te *TaskExecutor) SetDispatcher(d *webhook.Dispatcher) {
+	te.dispatcher = d
+}
+
+// SetExporter sets the exporter and target directory of the export task type.
+func (te *TaskExecutor) SetExporter(e *transfer.Exporter, dir string) {
+	te.exporter = e
+	te.exportDir = dir
+}
+
 // LoadAndScheduleAll loads all active tasks from the database and schedules them.
 func (te *TaskExecutor) LoadAndScheduleAll() error {
 	ctx := context.Background()
@@ -108,6 +141,10 @@ func (te *TaskExecutor) RemoveTask(taskID int64) {
 		te.cronInst.Remove(entryID)
 		delete(te.taskEntries, taskID)
 	}
+	if timer, ok := te.retryTimers[taskID]; ok {
+		timer.Stop()
+		delete(te.retryTimers, taskID)
+	}
 	te.mu.Unlock()
 
 	if te.registry != nil {
@@ -180,7 +217,7 @@ func (te *TaskExecutor) scheduleTask(task store.ScheduledTask) error {
 		}
 		te.registry.Register(
 			taskSource, name,
-			task.Name+": GET "+task.Url,
+			taskDescription(task),
 			task.Schedule,
 			te.cronInst, entryID, jobFunc, triggerFunc,
 		)
@@ -189,8 +226,21 @@ func (te *TaskExecutor) scheduleTask(task store.ScheduledTask) error {
 	return nil
 }
 
-// executeTask performs the HTTP GET request and records the result.
+// taskResult is the outcome of a successful task attempt.
+type taskResult struct {
+	statusCode sql.NullInt64
+	summary    string
+}
+
+// executeTask runs the first attempt of a task.
 func (te *TaskExecutor) executeTask(task store.ScheduledTask) {
+	te.runAttempt(task, 1)
+}
+
+// runAttempt performs one attempt of a task, records it as a run with its
+// log, and schedules the next attempt when it fails and the task's retry
+// policy allows another one.
+func (te *TaskExecutor) runAttempt(task store.ScheduledTask, attempt int64) {
 	ctx := context.Background()
 	queries := store.New(te.db)
 	startedAt := time.Now()
@@ -198,6 +248,7 @@ func (te *TaskExecutor) executeTask(task store.ScheduledTask) {
 	// Create a run record
 	run, err := queries.CreateScheduledTaskRun(ctx, store.CreateScheduledTaskRunParams{
 		TaskID:    task.ID,
+		Attempt:   attempt,
 		StartedAt: startedAt,
 	})
 	if err != nil {
@@ -205,45 +256,84 @@ func (te *TaskExecutor) executeTask(task store.ScheduledTask) {
 		return
 	}
 
-	// Revalidate URL before each execution to prevent DNS rebinding attacks
-	if err := ValidateTaskURL(task.Url); err != nil {
-		te.recordFailure(queries, run.ID, startedAt, fmt.Sprintf("SSRF protection: %v", err))
-		return
-	}
+	log := &runLog{}
+	log.Printf("running %s task %q (attempt %d of %d)", taskTypeOf(task), task.Name, attempt, maxAttempts(task))
 
-	// Make HTTP GET with per-task timeout
+	// Apply the per-task timeout to every task type
 	timeout := time.Duration(task.TimeoutSeconds) * time.Second
 	if timeout <= 0 {
 		timeout = defaultHTTPTimeout
 	}
-
-	reqCtx, cancel := context.WithTimeout(ctx, timeout)
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, task.Url, nil)
+	var result taskResult
+	if taskTypeOf(task) == TaskTypeURL {
+		result, err = te.fetchURL(runCtx, task, log)
+	} else {
+		result.summary, err = te.runAction(runCtx, task, log)
+	}
+
 	if err != nil {
-		te.recordFailure(queries, run.ID, startedAt, fmt.Sprintf("invalid URL: %v", err))
+		log.Printf("failed: %v", err)
+		delay := te.retryDelay(task, attempt)
+		if delay > 0 {
+			log.Printf("retrying in %s", delay)
+		}
+		te.recordFailure(queries, run.ID, startedAt, err.Error(), log.String())
+		if delay > 0 {
+			te.scheduleRetry(task.ID, attempt+1, delay)
+		}
 		return
 	}
 
+	completedAt := time.Now()
+	durationMs := completedAt.Sub(startedAt).Milliseconds()
+	log.Printf("completed in %dms", durationMs)
+
+	updateErr := queries.UpdateScheduledTaskRunSuccess(ctx, store.UpdateScheduledTaskRunSuccessParams{
+		StatusCode:   result.statusCode,
+		ResponseBody: sql.NullString{String: result.summary, Valid: true},
+		Log:          log.String(),
+		DurationMs:   sql.NullInt64{Int64: durationMs, Valid: true},
+		CompletedAt:  sql.NullTime{Time: completedAt, Valid: true},
+		ID:           run.ID,
+	})
+	if updateErr != nil {
+		te.logger.Error("failed to update task run success", "error", updateErr, "run_id", run.ID)
+	}
+
+	te.logger.Debug("task executed", "task_id", task.ID, "task_name", task.Name, "task_type", taskTypeOf(task), "attempt", attempt, "duration_ms", durationMs)
+}
+
+// fetchURL performs the HTTP GET request of a URL task.
+func (te *TaskExecutor) fetchURL(ctx context.Context, task store.ScheduledTask, log *runLog) (taskResult, error) {
+	// Revalidate URL before each execution to prevent DNS rebinding attacks
+	if err := ValidateTaskURL(task.Url); err != nil {
+		return taskResult{}, fmt.Errorf("SSRF protection: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, task.Url, nil)
+	if err != nil {
+		return taskResult{}, fmt.Errorf("invalid URL: %w", err)
+	}
+
 	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible)")
+	log.Printf("GET %s", task.Url)
 
 	resp, err := te.httpClient.Do(req)
 	if err != nil {
-		te.recordFailure(queries, run.ID, startedAt, fmt.Sprintf("request failed: %v", err))
-		return
+		return taskResult{}, fmt.Errorf("request failed: %w", err)
 	}
 	if resp == nil {
-		te.recordFailure(queries, run.ID, startedAt, "nil response from server")
-		return
+		return taskResult{}, fmt.Errorf("nil response from server")
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	// Read response body to measure size (discard content for security)
 	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyLen))
 	if err != nil {
-		te.recordFailure(queries, run.ID, startedAt, fmt.Sprintf("failed to read response: %v", err))
-		return
+		return taskResult{}, fmt.Errorf("failed to read response: %w", err)
 	}
 
 	// Store content-type summary instead of raw body to prevent XSS/data leaks
@@ -251,32 +341,22 @@ func (te *TaskExecutor) executeTask(task store.ScheduledTask) {
 	if contentType == "" {
 		contentType = "unknown"
 	}
-	summary := fmt.Sprintf("%s (%d bytes)", contentType, len(body))
-
-	completedAt := time.Now()
-	durationMs := completedAt.Sub(startedAt).Milliseconds()
-
-	updateErr := queries.UpdateScheduledTaskRunSuccess(ctx, store.UpdateScheduledTaskRunSuccessParams{
-		StatusCode:   sql.NullInt64{Int64: int64(resp.StatusCode), Valid: true},
-		ResponseBody: sql.NullString{String: summary, Valid: true},
-		DurationMs:   sql.NullInt64{Int64: durationMs, Valid: true},
-		CompletedAt:  sql.NullTime{Time: completedAt, Valid: true},
-		ID:           run.ID,
-	})
-	if updateErr != nil {
-		te.logger.Error("failed to update task run success", "error", updateErr, "run_id", run.ID)
-	}
+	log.Printf("HTTP %d, %s", resp.StatusCode, contentType)
 
-	te.logger.Debug("task executed", "task_id", task.ID, "task_name", task.Name, "status_code", resp.StatusCode, "duration_ms", durationMs)
+	return taskResult{
+		statusCode: sql.NullInt64{Int64: int64(resp.StatusCode), Valid: true},
+		summary:    fmt.Sprintf("%s (%d bytes)", contentType, len(body)),
+	}, nil
 }
 
 // recordFailure updates a run record with an error.
-func (te *TaskExecutor) recordFailure(queries *store.Queries, runID int64, startedAt time.Time, errMsg string) {
+func (te *TaskExecutor) recordFailure(queries *store.Queries, runID int64, startedAt time.Time, errMsg, log string) {
 	completedAt := time.Now()
 	durationMs := completedAt.Sub(startedAt).Milliseconds()
 
 	updateErr := queries.UpdateScheduledTaskRunFailed(context.Background(), store.UpdateScheduledTaskRunFailedParams{
 		ErrorMessage: sql.NullString{String: errMsg, Valid: true},
+		Log:          log,
 		DurationMs:   sql.NullInt64{Int64: durationMs, Valid: true},
 		CompletedAt:  sql.NullTime{Time: completedAt, Valid: true},
 		ID:           runID,
@@ -288,6 +368,47 @@ func (te *TaskExecutor) recordFailure(queries *store.Queries, runID int64, start
 	te.logger.Warn("task execution failed", "run_id", runID, "error", errMsg)
 }
 
+// maxAttempts returns the total number of attempts a task's retry policy allows.
+func maxAttempts(task store.ScheduledTask) int64 {
+	if task.RetryPolicy == "" || task.RetryPolicy == RetryPolicyNone {
+		return 1
+	}
+	return 1 + min(max(task.MaxRetries, 0), MaxTaskRetries)
+}
+
+// retryDelay returns the wait before retrying a failed attempt, or 0 when the
+// task's retry policy allows no further attempts.
+func (te *TaskExecutor) retryDelay(task store.ScheduledTask, attempt int64) time.Duration {
+	if attempt >= maxAttempts(task) {
+		return 0
+	}
+	return RetryDelay(task.RetryPolicy, time.Duration(task.RetryDelaySeconds)*time.Second, attempt)
+}
+
+// scheduleRetry runs the given attempt of a task after delay. The task is
+// reloaded first so retries stop when it is deleted or disabled meanwhile.
+// A pending retry is cancelled by RemoveTask.
+func (te *TaskExecutor) scheduleRetry(taskID, attempt int64, delay time.Duration) {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+
+	if timer, ok := te.retryTimers[taskID]; ok {
+		timer.Stop()
+	}
+	te.retryTimers[taskID] = time.AfterFunc(delay, func() {
+		te.mu.Lock()
+		delete(te.retryTimers, taskID)
+		te.mu.Unlock()
+
+		task, err := store.New(te.db).GetScheduledTask(context.Background(), taskID)
+		if err != nil || task.IsActive != 1 {
+			te.logger.Info("skipping retry of removed or inactive task", "task_id", taskID, "attempt", attempt)
+			return
+		}
+		te.runAttempt(task, attempt)
+	})
+}
+
 // RegisterCleanupJob adds a daily cron job that deletes task runs older than 30 days.
 func (te *TaskExecutor) RegisterCleanupJob() {
 	const cleanupSchedule = "0 3 * * *" // daily at 03:00
@@ -287,6 +287,53 @@ func BuildArticleSchema(page *PageData, site *SiteConfig, modifiedAt time.Time)
 	return marshalJSONLD(article)
 }
 
+// Breadcrumb is an entry of a breadcrumb trail. Path is site-relative.
+type Breadcrumb struct {
+	Name string
+	Path string
+}
+
+// BuildBreadcrumbSchema creates JSON-LD BreadcrumbList structured data for a
+// breadcrumb trail, root first. The last entry is the current page.
+func BuildBreadcrumbSchema(crumbs []Breadcrumb, site *SiteConfig) template.JS {
+	if len(crumbs) == 0 {
+		return ""
+	}
+
+	list := BreadcrumbSchema{
+		Context:  "https://schema.org",
+		Type:     "BreadcrumbList",
+		ItemList: make([]BreadcrumbItem, 0, len(crumbs)),
+	}
+	for i, crumb := range crumbs {
+		list.ItemList = append(list.ItemList, BreadcrumbItem{
+			Type:     "ListItem",
+			Position: i + 1,
+			Name:     crumb.Name,
+			Item:     makeAbsoluteURL(crumb.Path, site.SiteURL),
+		})
+	}
+	return marshalJSONLD(list)
+}
+
+// CombineJSONLD merges JSON-LD documents into a single array so a page can
+// carry several schemas in one script tag. Empty documents are skipped.
+func CombineJSONLD(docs ...template.JS) template.JS {
+	var parts []json.RawMessage
+	for _, doc := range docs {
+		if doc != "" {
+			parts = append(parts, json.RawMessage(doc))
+		}
+	}
+	switch len(parts) {
+	case 0:
+		return ""
+	case 1:
+		return template.JS(parts[0])
+	}
+	return marshalJSONLD(parts)
+}
+
 // marshalJSONLD marshals structured data to JSON-LD script tag content.
 func marshalJSONLD(v any) template.JS {
 	data, err := json.MarshalIndent(v, "", "  ")
@@ -490,3 +490,40 @@ func TestMakeAbsoluteURL(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildBreadcrumbSchema(t *testing.T) {
+	site := &SiteConfig{SiteURL: "https://example.com"}
+	crumbs := []Breadcrumb{
+		{Name: "Home", Path: "/"},
+		{Name: "Docs", Path: "/docs"},
+		{Name: "Linux", Path: "/docs/install/linux"},
+	}
+
+	schema := string(BuildBreadcrumbSchema(crumbs, site))
+	for _, want := range []string{
+		`"@type": "BreadcrumbList"`,
+		`"position": 3`,
+		`"item": "https://example.com/docs/install/linux"`,
+	} {
+		if !strings.Contains(schema, want) {
+			t.Errorf("schema missing %s:\n%s", want, schema)
+		}
+	}
+
+	if got := BuildBreadcrumbSchema(nil, site); got != "" {
+		t.Errorf("empty trail = %q, want empty", got)
+	}
+}
+
+func TestCombineJSONLD(t *testing.T) {
+	if got := CombineJSONLD("", `{"a":1}`); got != `{"a":1}` {
+		t.Errorf("single document = %s", got)
+	}
+	combined := string(CombineJSONLD(`{"a":1}`, `{"b":2}`))
+	if !strings.HasPrefix(strings.TrimSpace(combined), "[") || !strings.Contains(combined, `"b": 2`) {
+		t.Errorf("combined = %s, want a JSON array", combined)
+	}
+	if got := CombineJSONLD("", ""); got != "" {
+		t.Errorf("no documents = %q", got)
+	}
+}
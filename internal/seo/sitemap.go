@@ -6,6 +6,7 @@ package seo
 
 import (
 	"encoding/xml"
+	"fmt"
 	"strings"
 	"time"
 
@@ -41,7 +42,7 @@ type Sitemap struct {
 
 // SitemapPage contains data needed to add a page to the sitemap.
 type SitemapPage struct {
-	Slug         string
+	Slug         string // Page path; subpages include the slugs of their parents
 	LanguageCode string
 	IsDefault    bool
 	UpdatedAt    time.Time
@@ -63,9 +64,20 @@ type SitemapTag struct {
 	UpdatedAt    time.Time
 }
 
+// SitemapArchive contains data needed to add a blog date archive to the
+// sitemap.
+type SitemapArchive struct {
+	Year         int
+	Month        int // 0 for a yearly archive
+	LanguageCode string
+	IsDefault    bool
+	UpdatedAt    time.Time // latest update of a post in the archive
+}
+
 // SitemapBuilder builds sitemap XML from various content types.
 type SitemapBuilder struct {
 	siteURL string
+	hosts   map[string]string // language code -> mapped host
 	urls    []SitemapURL
 }
 
@@ -73,10 +85,20 @@ type SitemapBuilder struct {
 func NewSitemapBuilder(siteURL string) *SitemapBuilder {
 	return &SitemapBuilder{
 		siteURL: strings.TrimRight(siteURL, "/"),
+		hosts:   make(map[string]string),
 		urls:    make([]SitemapURL, 0),
 	}
 }
 
+// SetLanguageHost maps a language to the host serving it. Its URLs are
+// then listed at the root of that host instead of under a language prefix
+// of the site URL. Call it before adding content.
+func (b *SitemapBuilder) SetLanguageHost(languageCode, host string) {
+	if host != "" {
+		b.hosts[languageCode] = host
+	}
+}
+
 // AddHomepage adds the homepage to the sitemap.
 func (b *SitemapBuilder) AddHomepage() {
 	b.urls = append(b.urls, SitemapURL{
@@ -88,11 +110,20 @@ func (b *SitemapBuilder) AddHomepage() {
 
 // AddLanguageHomepage adds the canonical homepage for one routable language.
 // The default remains the bare site URL; non-default homepages use the
-// slashless language root served by the frontend router.
+// slashless language root served by the frontend router, and a language
+// mapped to a host uses the root of that host.
 func (b *SitemapBuilder) AddLanguageHomepage(languageCode string, isDefault bool) {
 	if !util.IsValidLangCode(languageCode) || util.IsReservedLanguageCode(languageCode) {
 		return
 	}
+	if host := b.hosts[languageCode]; host != "" {
+		b.urls = append(b.urls, SitemapURL{
+			Loc:        util.HostURL(b.siteURL, host) + "/",
+			ChangeFreq: ChangeFreqDaily,
+			Priority:   "1.0",
+		})
+		return
+	}
 	if isDefault {
 		b.AddHomepage()
 		return
@@ -105,9 +136,9 @@ func (b *SitemapBuilder) AddLanguageHomepage(languageCode string, isDefault bool
 }
 
 // addURL adds a URL entry to the sitemap with the given parameters.
-func (b *SitemapBuilder) addURL(path string, priority string, updatedAt time.Time) {
+func (b *SitemapBuilder) addURL(loc string, priority string, updatedAt time.Time) {
 	url := SitemapURL{
-		Loc:        b.siteURL + path,
+		Loc:        loc,
 		ChangeFreq: ChangeFreqWeekly,
 		Priority:   priority,
 	}
@@ -131,13 +162,26 @@ func canonicalLanguagePath(path, languageCode string, isDefault bool) (string, b
 	return "/" + languageCode + path, true
 }
 
+// languageLoc returns the absolute canonical URL of path in a language: at
+// the root of its host when it has one, under the site URL otherwise.
+func (b *SitemapBuilder) languageLoc(path, languageCode string, isDefault bool) (string, bool) {
+	if host := b.hosts[languageCode]; host != "" && util.IsRoutableLanguageCode(languageCode) {
+		return util.HostURL(b.siteURL, host) + path, true
+	}
+	path, ok := canonicalLanguagePath(path, languageCode, isDefault)
+	if !ok {
+		return "", false
+	}
+	return b.siteURL + path, true
+}
+
 // AddPage adds a page to the sitemap.
 func (b *SitemapBuilder) AddPage(page SitemapPage) {
-	path, ok := canonicalLanguagePath("/"+page.Slug, page.LanguageCode, page.IsDefault)
+	loc, ok := b.languageLoc("/"+page.Slug, page.LanguageCode, page.IsDefault)
 	if !ok {
 		return
 	}
-	b.addURL(path, "0.8", page.UpdatedAt)
+	b.addURL(loc, "0.8", page.UpdatedAt)
 }
 
 // AddPages adds multiple pages to the sitemap.
@@ -149,11 +193,11 @@ func (b *SitemapBuilder) AddPages(pages []SitemapPage) {
 
 // AddCategory adds a category archive page to the sitemap.
 func (b *SitemapBuilder) AddCategory(cat SitemapCategory) {
-	path, ok := canonicalLanguagePath("/category/"+cat.Slug, cat.LanguageCode, cat.IsDefault)
+	loc, ok := b.languageLoc("/category/"+cat.Slug, cat.LanguageCode, cat.IsDefault)
 	if !ok {
 		return
 	}
-	b.addURL(path, "0.6", cat.UpdatedAt)
+	b.addURL(loc, "0.6", cat.UpdatedAt)
 }
 
 // AddCategories adds multiple categories to the sitemap.
@@ -165,11 +209,11 @@ func (b *SitemapBuilder) AddCategories(categories []SitemapCategory) {
 
 // AddTag adds a tag archive page to the sitemap.
 func (b *SitemapBuilder) AddTag(tag SitemapTag) {
-	path, ok := canonicalLanguagePath("/tag/"+tag.Slug, tag.LanguageCode, tag.IsDefault)
+	loc, ok := b.languageLoc("/tag/"+tag.Slug, tag.LanguageCode, tag.IsDefault)
 	if !ok {
 		return
 	}
-	b.addURL(path, "0.5", tag.UpdatedAt)
+	b.addURL(loc, "0.5", tag.UpdatedAt)
 }
 
 // AddTags adds multiple tags to the sitemap.
@@ -179,6 +223,22 @@ func (b *SitemapBuilder) AddTags(tags []SitemapTag) {
 	}
 }
 
+// AddArchive adds a yearly or monthly blog archive to the sitemap.
+func (b *SitemapBuilder) AddArchive(archive SitemapArchive) {
+	if archive.Year < 1 || archive.Year > 9999 || archive.Month < 0 || archive.Month > 12 {
+		return
+	}
+	archivePath := fmt.Sprintf("/blog/%04d", archive.Year)
+	if archive.Month > 0 {
+		archivePath += fmt.Sprintf("/%02d", archive.Month)
+	}
+	loc, ok := b.languageLoc(archivePath, archive.LanguageCode, archive.IsDefault)
+	if !ok {
+		return
+	}
+	b.addURL(loc, "0.4", archive.UpdatedAt)
+}
+
 // Build generates the sitemap XML.
 func (b *SitemapBuilder) Build() ([]byte, error) {
 	sitemap := Sitemap{
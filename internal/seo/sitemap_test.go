@@ -63,6 +63,32 @@ func TestSitemapBuilderAddLanguageHomepages(t *testing.T) {
 	}
 }
 
+func TestSitemapBuilderLanguageHosts(t *testing.T) {
+	builder := NewSitemapBuilder("http://example.com")
+	builder.SetLanguageHost("de", "example.de")
+	builder.AddLanguageHomepage("en", true)
+	builder.AddLanguageHomepage("de", false)
+	builder.AddPage(SitemapPage{Slug: "about", LanguageCode: "en", IsDefault: true})
+	builder.AddPage(SitemapPage{Slug: "ueber-uns", LanguageCode: "de"})
+	builder.AddCategory(SitemapCategory{Slug: "news", LanguageCode: "de"})
+
+	want := []string{
+		"http://example.com",
+		"http://example.de/",
+		"http://example.com/about",
+		"http://example.de/ueber-uns",
+		"http://example.de/category/news",
+	}
+	if len(builder.urls) != len(want) {
+		t.Fatalf("urls length = %d, want %d: %+v", len(builder.urls), len(want), builder.urls)
+	}
+	for i, loc := range want {
+		if builder.urls[i].Loc != loc {
+			t.Errorf("urls[%d].Loc = %q, want %q", i, builder.urls[i].Loc, loc)
+		}
+	}
+}
+
 func TestSitemapBuilderAddPage(t *testing.T) {
 	builder := NewSitemapBuilder("https://example.com")
 	updatedAt := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
@@ -172,6 +198,22 @@ func TestSitemapBuilderAddTags(t *testing.T) {
 	}
 }
 
+func TestSitemapBuilderAddArchive(t *testing.T) {
+	testSitemapTaxonomyAdd(t, func(b *SitemapBuilder) {
+		b.AddArchive(SitemapArchive{Year: 2026, LanguageCode: "en", IsDefault: true})
+	}, "https://example.com/blog/2026", "0.4")
+	testSitemapTaxonomyAdd(t, func(b *SitemapBuilder) {
+		b.AddArchive(SitemapArchive{Year: 2026, Month: 3, LanguageCode: "ru"})
+	}, "https://example.com/ru/blog/2026/03", "0.4")
+
+	builder := NewSitemapBuilder("https://example.com")
+	builder.AddArchive(SitemapArchive{Year: 2026, Month: 13, LanguageCode: "en", IsDefault: true})
+	builder.AddArchive(SitemapArchive{Year: 2026, LanguageCode: "admin"})
+	if len(builder.urls) != 0 {
+		t.Errorf("urls = %+v, want invalid archives skipped", builder.urls)
+	}
+}
+
 func TestSitemapBuilderBuild(t *testing.T) {
 	builder := NewSitemapBuilder("https://example.com")
 	builder.AddHomepage()
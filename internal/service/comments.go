@@ -0,0 +1,107 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+const (
+	// MaxCommentDepth is the number of reply levels shown. Replies to
+	// comments at the last level are shown next to them.
+	MaxCommentDepth = 3
+	// maxCommentLinks is the number of links a comment may contain before
+	// it is flagged as spam.
+	maxCommentLinks = 2
+)
+
+// CommentNode is an approved comment with its replies.
+type CommentNode struct {
+	Comment store.Comment
+	Depth   int // 0 for top-level comments
+	Replies []*CommentNode
+	parent  *CommentNode
+}
+
+// CommentsOpen reports whether a page accepts new comments. Pages without
+// their own setting are open when they are posts. The site-wide setting is
+// checked by the caller.
+func CommentsOpen(ctx context.Context, queries *store.Queries, page store.Page) bool {
+	setting, err := queries.GetPageCommentSetting(ctx, page.ID)
+	if err == nil {
+		return setting.Enabled
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		slog.Error("failed to load page comment setting", "error", err, "page_id", page.ID)
+	}
+	return page.PageType == "post"
+}
+
+// CommentThreads arranges approved comments, oldest first, into threads.
+// Replies whose parent is not shown (held, spam) become top-level comments,
+// and replies below MaxCommentDepth are attached to the last level.
+func CommentThreads(comments []store.Comment) []*CommentNode {
+	nodes := make(map[int64]*CommentNode, len(comments))
+	var threads []*CommentNode
+	for _, c := range comments {
+		node := &CommentNode{Comment: c}
+		nodes[c.ID] = node
+
+		parent := nodes[c.ParentID.Int64]
+		if !c.ParentID.Valid || parent == nil {
+			threads = append(threads, node)
+			continue
+		}
+		for parent.Depth >= MaxCommentDepth-1 && parent.parent != nil {
+			parent = parent.parent
+		}
+		node.Depth = parent.Depth + 1
+		node.parent = parent
+		parent.Replies = append(parent.Replies, node)
+	}
+	return threads
+}
+
+var (
+	commentLinkPattern   = regexp.MustCompile(`(?i)(https?://|www\.)\S+`)
+	commentMarkupPattern = regexp.MustCompile(`(?i)(<a\s|\[url[=\]]|\[link[=\]])`)
+)
+
+// CommentSpamReason returns why a comment looks like spam, or an empty
+// string. Comments are plain text, so link markup is never legitimate.
+func CommentSpamReason(authorName, body string) string {
+	switch {
+	case commentMarkupPattern.MatchString(body) || commentMarkupPattern.MatchString(authorName):
+		return "link markup"
+	case commentLinkPattern.MatchString(authorName):
+		return "link in name"
+	case len(commentLinkPattern.FindAllStringIndex(body, -1)) > maxCommentLinks:
+		return "too many links"
+	case strings.TrimSpace(commentLinkPattern.ReplaceAllString(body, "")) == "" && body != "":
+		return "only links"
+	}
+	return ""
+}
+
+// CommentStatusFor returns the status a new comment is stored with: spam
+// when spamReason is set, approved for logged-in users when autoApprove is
+// on, and pending otherwise.
+func CommentStatusFor(spamReason string, loggedIn, autoApprove bool) string {
+	switch {
+	case spamReason != "":
+		return model.CommentStatusSpam
+	case loggedIn && autoApprove:
+		return model.CommentStatusApproved
+	default:
+		return model.CommentStatusPending
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+func testComment(id, parentID int64) store.Comment {
+	c := store.Comment{ID: id, AuthorName: "a", Body: "b", Status: model.CommentStatusApproved}
+	if parentID != 0 {
+		c.ParentID = sql.NullInt64{Int64: parentID, Valid: true}
+	}
+	return c
+}
+
+func TestCommentThreads(t *testing.T) {
+	// 1 ─ 2 ─ 3 ─ 4 (too deep), 5 replies to a hidden comment, 6 is top-level
+	threads := CommentThreads([]store.Comment{
+		testComment(1, 0),
+		testComment(2, 1),
+		testComment(3, 2),
+		testComment(4, 3),
+		testComment(5, 99),
+		testComment(6, 0),
+	})
+
+	if len(threads) != 3 {
+		t.Fatalf("len(threads) = %d, want 3", len(threads))
+	}
+	for i, want := range []int64{1, 5, 6} {
+		if threads[i].Comment.ID != want || threads[i].Depth != 0 {
+			t.Errorf("threads[%d] = %d at depth %d, want %d at depth 0", i, threads[i].Comment.ID, threads[i].Depth, want)
+		}
+	}
+
+	second := threads[0].Replies
+	if len(second) != 1 || second[0].Comment.ID != 2 || second[0].Depth != 1 {
+		t.Fatalf("replies of 1 = %+v, want comment 2 at depth 1", second)
+	}
+	third := second[0].Replies
+	if len(third) != 2 {
+		t.Fatalf("len(replies of 2) = %d, want 2 (3 and the flattened 4)", len(third))
+	}
+	for i, want := range []int64{3, 4} {
+		if third[i].Comment.ID != want || third[i].Depth != MaxCommentDepth-1 {
+			t.Errorf("replies of 2 [%d] = %d at depth %d, want %d at depth %d",
+				i, third[i].Comment.ID, third[i].Depth, want, MaxCommentDepth-1)
+		}
+	}
+	if len(third[0].Replies) != 0 {
+		t.Errorf("comment 3 has %d replies, want 0", len(third[0].Replies))
+	}
+}
+
+func TestCommentSpamReason(t *testing.T) {
+	tests := []struct {
+		name   string
+		author string
+		body   string
+		spam   bool
+	}{
+		{"plain", "Ann", "Great post, thanks!", false},
+		{"one link", "Ann", "See https://example.com for more", false},
+		{"two links", "Ann", "Compare https://a.example and www.b.example here", false},
+		{"three links", "Ann", "https://a.example https://b.example https://c.example and text", true},
+		{"only a link", "Ann", "https://example.com", true},
+		{"anchor markup", "Ann", `Nice <a href="https://x.example">deal</a>`, true},
+		{"bbcode", "Ann", "[url=https://x.example]deal[/url]", true},
+		{"link in name", "www.cheap.example", "Nice post", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CommentSpamReason(tt.author, tt.body)
+			if (got != "") != tt.spam {
+				t.Errorf("CommentSpamReason(%q, %q) = %q, want spam %v", tt.author, tt.body, got, tt.spam)
+			}
+		})
+	}
+}
+
+func TestCommentStatusFor(t *testing.T) {
+	tests := []struct {
+		spamReason  string
+		loggedIn    bool
+		autoApprove bool
+		want        string
+	}{
+		{"", false, false, model.CommentStatusPending},
+		{"", false, true, model.CommentStatusPending},
+		{"", true, false, model.CommentStatusPending},
+		{"", true, true, model.CommentStatusApproved},
+		{"only links", true, true, model.CommentStatusSpam},
+	}
+	for _, tt := range tests {
+		if got := CommentStatusFor(tt.spamReason, tt.loggedIn, tt.autoApprove); got != tt.want {
+			t.Errorf("CommentStatusFor(%q, %v, %v) = %q, want %q", tt.spamReason, tt.loggedIn, tt.autoApprove, got, tt.want)
+		}
+	}
+}
+
+func TestCommentsOpen(t *testing.T) {
+	f := newPageTreeFixture(t)
+	ctx := context.Background()
+	page := f.page("about", "en", nil)
+	post := f.page("hello", "en", nil)
+	post.PageType = "post"
+
+	if CommentsOpen(ctx, f.queries, page) {
+		t.Error("CommentsOpen(page) = true, want false by default")
+	}
+	if !CommentsOpen(ctx, f.queries, post) {
+		t.Error("CommentsOpen(post) = false, want true by default")
+	}
+
+	for _, s := range []store.UpsertPageCommentSettingParams{
+		{PageID: page.ID, Enabled: true},
+		{PageID: post.ID, Enabled: false},
+	} {
+		if err := f.queries.UpsertPageCommentSetting(ctx, s); err != nil {
+			t.Fatalf("UpsertPageCommentSetting() error = %v", err)
+		}
+	}
+	if !CommentsOpen(ctx, f.queries, page) {
+		t.Error("CommentsOpen(page) = false, want true when opened")
+	}
+	if CommentsOpen(ctx, f.queries, post) {
+		t.Error("CommentsOpen(post) = true, want false when closed")
+	}
+}
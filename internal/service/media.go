@@ -156,6 +156,7 @@ func (s *MediaService) Upload(ctx context.Context, file multipart.File, header *
 			LanguageCode: languageCode,
 			CreatedAt:    now,
 			UpdatedAt:    now,
+			SiteID:       store.SiteIDFromContext(ctx),
 		})
 		if err != nil {
 			// Clean up uploaded files on error
@@ -209,6 +210,7 @@ func (s *MediaService) Upload(ctx context.Context, file multipart.File, header *
 			LanguageCode: languageCode,
 			CreatedAt:    now,
 			UpdatedAt:    now,
+			SiteID:       store.SiteIDFromContext(ctx),
 		})
 		if err != nil {
 			// Clean up on error
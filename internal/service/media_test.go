@@ -436,6 +436,7 @@ func TestMediaServiceDeleteRejectsMalformedUUIDBeforeDatabaseMutation(t *testing
 		Uuid: "legacy-media-1", Filename: "legacy.pdf", MimeType: model.MimeTypePDF, Size: 6,
 		Width: sql.NullInt64{}, Height: sql.NullInt64{}, UploadedBy: user.ID,
 		LanguageCode: language.Code, CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMedia() error = %v", err)
@@ -491,6 +492,7 @@ func TestMediaServiceDeleteKeepsCapturedRootAcrossReplacement(t *testing.T) {
 	media, err := queries.CreateMedia(ctx, store.CreateMediaParams{
 		Uuid: mediaUUID, Filename: "report.pdf", MimeType: model.MimeTypePDF, Size: 6,
 		UploadedBy: user.ID, LanguageCode: language.Code, CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -553,6 +555,7 @@ func TestMediaServiceDeleteCleanupFailureIsReturnedAfterCommit(t *testing.T) {
 	media, err := queries.CreateMedia(ctx, store.CreateMediaParams{
 		Uuid: mediaUUID, Filename: "report.pdf", MimeType: model.MimeTypePDF, Size: 6,
 		UploadedBy: user.ID, LanguageCode: language.Code, CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -599,6 +602,7 @@ func TestMediaServiceDeleteCancellationBeforeCommitPreservesRowsAndFiles(t *test
 	media, err := queries.CreateMedia(ctx, store.CreateMediaParams{
 		Uuid: mediaUUID, Filename: "report.pdf", MimeType: model.MimeTypePDF, Size: 6,
 		UploadedBy: user.ID, LanguageCode: language.Code, CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -9,8 +9,10 @@ import (
 	"database/sql"
 	"errors"
 	"sort"
+	"strings"
 
 	"github.com/olegiv/ocms-go/internal/cache"
+	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/store"
 	"github.com/olegiv/ocms-go/internal/util"
 )
@@ -21,12 +23,21 @@ type MenuItem struct {
 	Title    string
 	URL      string
 	Target   string
+	Type     string // One of the model.MenuItem* types
 	PageID   *int64
 	PageSlug string
 	CSSClass string
 	IsActive bool
 	Position int
-	Children []MenuItem
+	// MegaMenu shows the children side by side as columns; ImageURL is the
+	// optional image of a column.
+	MegaMenu bool
+	ImageURL string
+	// Visibility and Roles are the item's model.MenuVisibility* rule, applied
+	// per visitor by FilterMenuItems.
+	Visibility string
+	Roles      []string
+	Children   []MenuItem
 }
 
 // MenuService provides menu loading with tree building.
@@ -80,7 +91,13 @@ func (s *MenuService) GetMenu(slug string) []MenuItem {
 // Note: Language-specific menus are fetched directly from database since
 // MenuCache only caches by slug (not by slug+language).
 func (s *MenuService) GetMenuForLanguage(slug string, langCode string) []MenuItem {
-	ctx := context.Background()
+	return s.GetSiteMenuForLanguage(context.Background(), slug, langCode)
+}
+
+// GetSiteMenuForLanguage is GetMenuForLanguage for the menus of the site ctx
+// is scoped to.
+func (s *MenuService) GetSiteMenuForLanguage(ctx context.Context, slug string, langCode string) []MenuItem {
+	siteID := store.SiteIDFromContext(ctx)
 	// Public routing has no canonical language namespace unless there is
 	// exactly one configured default. GetDefaultLanguage enforces that
 	// cardinality, so fail closed before returning menu links in an ambiguous
@@ -108,9 +125,10 @@ func (s *MenuService) GetMenuForLanguage(slug string, langCode string) []MenuIte
 	}
 
 	// Try to get menu for specific language first
-	menu, err := s.queries.GetMenuBySlugAndLanguage(ctx, store.GetMenuBySlugAndLanguageParams{
+	menu, err := s.queries.GetSiteMenuBySlugAndLanguage(ctx, store.GetSiteMenuBySlugAndLanguageParams{
 		Slug:         slug,
 		LanguageCode: langCode,
+		SiteID:       siteID,
 	})
 	if err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
@@ -123,9 +141,10 @@ func (s *MenuService) GetMenuForLanguage(slug string, langCode string) []MenuIte
 
 		if defaultLang.Code != langCode {
 			// Try with default language
-			menu, err = s.queries.GetMenuBySlugAndLanguage(ctx, store.GetMenuBySlugAndLanguageParams{
+			menu, err = s.queries.GetSiteMenuBySlugAndLanguage(ctx, store.GetSiteMenuBySlugAndLanguageParams{
 				Slug:         slug,
 				LanguageCode: defaultLang.Code,
+				SiteID:       siteID,
 			})
 			if err != nil {
 				return nil
@@ -140,7 +159,7 @@ func (s *MenuService) GetMenuForLanguage(slug string, langCode string) []MenuIte
 		return nil
 	}
 
-	return s.buildMenuTree(items)
+	return s.buildMenuTreeForLanguage(ctx, items, langCode)
 }
 
 func routableMenuLanguage(language store.Language) bool {
@@ -169,8 +188,37 @@ func (s *MenuService) InvalidateCache(slug string) {
 	}
 }
 
-// buildMenuTree converts flat list to nested tree structure.
+// FilterMenuItems returns the items a visitor with the given role sees,
+// dropping hidden items together with their children. role is
+// model.RoleAnonymous for visitors who are not logged in.
+func FilterMenuItems(items []MenuItem, role string) []MenuItem {
+	result := make([]MenuItem, 0, len(items))
+	for _, item := range items {
+		if !model.CanSeeMenuItem(item.Visibility, item.Roles, role) {
+			continue
+		}
+		item.Children = FilterMenuItems(item.Children, role)
+		result = append(result, item)
+	}
+	return result
+}
+
+// buildMenuTree converts flat list to nested tree structure, resolving
+// item URLs in the default language.
 func (s *MenuService) buildMenuTree(items []store.ListMenuItemsWithPageRow) []MenuItem {
+	return s.buildMenuTreeForLanguage(context.Background(), items, "")
+}
+
+// buildMenuTreeForLanguage converts flat list to nested tree structure for
+// a menu shown in langCode. Items limited to other languages are dropped, and
+// taxonomy, form, blog and language items link to their langCode version.
+func (s *MenuService) buildMenuTreeForLanguage(ctx context.Context, items []store.ListMenuItemsWithPageRow, langCode string) []MenuItem {
+	if langCode == "" && s.queries != nil {
+		if defaultLang, err := s.queries.GetDefaultLanguage(ctx); err == nil {
+			langCode = defaultLang.Code
+		}
+	}
+
 	// Create a map of ID to MenuItem for quick lookup
 	itemMap := make(map[int64]*MenuItem)
 	parentMap := make(map[int64]int64) // child ID -> parent ID
@@ -182,20 +230,36 @@ func (s *MenuService) buildMenuTree(items []store.ListMenuItemsWithPageRow) []Me
 			continue
 		}
 
+		if !menuItemShownInLanguage(item.VisibleLanguages, langCode) {
+			continue
+		}
+
 		mi := MenuItem{
-			ID:       item.ID,
-			Title:    item.Title,
-			Target:   "_self",
-			IsActive: item.IsActive,
-			Position: int(item.Position),
-			Children: []MenuItem{},
+			ID:         item.ID,
+			Title:      item.Title,
+			Target:     "_self",
+			Type:       item.ItemType,
+			IsActive:   item.IsActive,
+			Position:   int(item.Position),
+			MegaMenu:   item.MegaMenu,
+			ImageURL:   item.ImageUrl,
+			Visibility: item.Visibility,
+			Roles:      splitMenuList(item.VisibleRoles),
+			Children:   []MenuItem{},
 		}
 
-		// Page-backed items must use the linked page's canonical language, not
-		// the requested menu language. This matters when a language-specific
-		// menu falls back to the default menu.
-		if item.PageID.Valid {
-			pageURL, ok := canonicalMenuPageURL(item)
+		if item.ItemType != model.MenuItemLink {
+			linkURL, ok := s.menuLinkURL(ctx, item, langCode)
+			if !ok {
+				// The target was deleted, deactivated or has no public route.
+				continue
+			}
+			mi.URL = linkURL
+		} else if item.PageID.Valid {
+			// Page-backed items must use the linked page's canonical language, not
+			// the requested menu language. This matters when a language-specific
+			// menu falls back to the default menu.
+			pageURL, ok := canonicalMenuPageURL(item, s.menuPagePath(item))
 			if !ok {
 				// Never fall back to a raw URL for a broken, inactive, orphaned, or
 				// unsafe page reference.
@@ -272,11 +336,132 @@ func (s *MenuService) buildMenuTree(items []store.ListMenuItemsWithPageRow) []Me
 	return roots
 }
 
+// menuItemShownInLanguage reports whether an item limited to the
+// comma-separated languages is shown in langCode. An empty list shows it in
+// every language.
+func menuItemShownInLanguage(languages string, langCode string) bool {
+	codes := splitMenuList(languages)
+	if len(codes) == 0 {
+		return true
+	}
+	for _, code := range codes {
+		if code == langCode {
+			return true
+		}
+	}
+	return false
+}
+
+// splitMenuList splits a comma-separated menu item list, dropping blanks.
+func splitMenuList(list string) []string {
+	var values []string
+	for _, value := range strings.Split(list, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// menuLinkURL resolves the URL of a category, tag, form, blog or language
+// item for a menu shown in langCode. Categories, tags and forms link to their
+// translation in langCode when there is one, and otherwise to the linked
+// entity in its own language.
+func (s *MenuService) menuLinkURL(ctx context.Context, item store.ListMenuItemsWithPageRow, langCode string) (string, bool) {
+	if s.queries == nil {
+		return "", false
+	}
+
+	switch item.ItemType {
+	case model.MenuItemCategory:
+		if !item.LinkID.Valid {
+			return "", false
+		}
+		category, err := s.queries.GetCategoryByID(ctx, s.translatedLinkID(ctx, model.EntityTypeCategory, item.LinkID.Int64, langCode))
+		if err != nil || !util.IsValidSlug(category.Slug) {
+			return "", false
+		}
+		return s.menuLanguageURL(ctx, category.LanguageCode, "/category/"+category.Slug)
+	case model.MenuItemTag:
+		if !item.LinkID.Valid {
+			return "", false
+		}
+		tag, err := s.queries.GetTagByID(ctx, s.translatedLinkID(ctx, model.EntityTypeTag, item.LinkID.Int64, langCode))
+		if err != nil || !util.IsValidSlug(tag.Slug) {
+			return "", false
+		}
+		return s.menuLanguageURL(ctx, tag.LanguageCode, "/tag/"+tag.Slug)
+	case model.MenuItemForm:
+		if !item.LinkID.Valid {
+			return "", false
+		}
+		form, err := s.queries.GetFormByID(ctx, s.translatedLinkID(ctx, model.EntityTypeForm, item.LinkID.Int64, langCode))
+		if err != nil || !form.IsActive || !util.IsValidSlug(form.Slug) {
+			return "", false
+		}
+		return s.menuLanguageURL(ctx, form.LanguageCode, "/forms/"+form.Slug)
+	case model.MenuItemBlog:
+		return s.menuLanguageURL(ctx, langCode, "/blog")
+	case model.MenuItemLanguage:
+		code := langCode
+		if item.LinkID.Valid {
+			language, err := s.queries.GetLanguageByID(ctx, item.LinkID.Int64)
+			if err != nil {
+				return "", false
+			}
+			code = language.Code
+		}
+		return s.menuLanguageURL(ctx, code, "")
+	default:
+		return "", false
+	}
+}
+
+// translatedLinkID returns the translation of an entity in langCode, or the
+// entity itself when it has none.
+func (s *MenuService) translatedLinkID(ctx context.Context, entityType string, entityID int64, langCode string) int64 {
+	translatedID, err := s.queries.GetTranslationComponentEntityByLanguage(ctx, store.GetTranslationComponentEntityByLanguageParams{
+		EntityType:   entityType,
+		LanguageCode: langCode,
+		EntityID:     entityID,
+	})
+	if err != nil {
+		return entityID
+	}
+	return translatedID
+}
+
+// menuLanguageURL returns path under the public prefix of a language: none
+// for the default language, /{code} for the others. The homepage of a
+// language is path "". Languages without a public route fail closed.
+func (s *MenuService) menuLanguageURL(ctx context.Context, langCode string, path string) (string, bool) {
+	language, err := s.queries.GetLanguageByCode(ctx, langCode)
+	if err != nil || !routableMenuLanguage(language) {
+		return "", false
+	}
+	if language.IsDefault {
+		if path == "" {
+			return "/", true
+		}
+		return path, true
+	}
+	return "/" + language.Code + path, true
+}
+
+// menuPagePath returns the nested path of the page linked by a menu item.
+func (s *MenuService) menuPagePath(item store.ListMenuItemsWithPageRow) string {
+	if s.queries == nil {
+		return item.PageSlug.String
+	}
+	return PagePath(context.Background(), s.queries, item.PageID.Int64, item.PageSlug.String)
+}
+
 // canonicalMenuPageURL returns the public canonical path for a page-backed
 // menu item. Active default-language pages are unprefixed. Active routable
 // non-default languages own their validated prefix. Invalid and reserved
-// legacy languages fail closed, including a misconfigured default.
-func canonicalMenuPageURL(item store.ListMenuItemsWithPageRow) (string, bool) {
+// legacy languages fail closed, including a misconfigured default. pagePath
+// is the nested path of the page below the language prefix.
+func canonicalMenuPageURL(item store.ListMenuItemsWithPageRow, pagePath string) (string, bool) {
 	if !item.PageSlug.Valid || !util.IsValidSlug(item.PageSlug.String) ||
 		!item.PageLanguageCode.Valid ||
 		!item.PageLanguageIsActive.Valid || !item.PageLanguageIsActive.Bool ||
@@ -289,8 +474,8 @@ func canonicalMenuPageURL(item store.ListMenuItemsWithPageRow) (string, bool) {
 		return "", false
 	}
 	if item.PageLanguageIsDefault.Bool {
-		return "/" + item.PageSlug.String, true
+		return "/" + pagePath, true
 	}
 
-	return "/" + languageCode + "/" + item.PageSlug.String, true
+	return "/" + languageCode + "/" + pagePath, true
 }
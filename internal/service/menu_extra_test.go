@@ -10,6 +10,7 @@ import (
 
 	_ "github.com/mattn/go-sqlite3"
 
+	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/store"
 )
 
@@ -34,7 +35,8 @@ func menuTestDB(t *testing.T) *sql.DB {
 			direction TEXT NOT NULL DEFAULT 'ltr',
 			position INTEGER NOT NULL DEFAULT 0,
 			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			host TEXT NOT NULL DEFAULT ''
 		);
 		CREATE TABLE menus (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -42,7 +44,8 @@ func menuTestDB(t *testing.T) *sql.DB {
 			slug TEXT NOT NULL,
 			language_code TEXT NOT NULL DEFAULT 'en',
 			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			site_id INTEGER NOT NULL DEFAULT 1
 		);
 		CREATE TABLE pages (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -58,7 +61,13 @@ func menuTestDB(t *testing.T) *sql.DB {
 			language_code TEXT NOT NULL DEFAULT 'en',
 			meta_title TEXT NOT NULL DEFAULT '',
 			meta_description TEXT NOT NULL DEFAULT '',
-			meta_keywords TEXT NOT NULL DEFAULT ''
+			meta_keywords TEXT NOT NULL DEFAULT '',
+			site_id INTEGER NOT NULL DEFAULT 1
+		);
+		CREATE TABLE page_hierarchy (
+			page_id INTEGER PRIMARY KEY,
+			parent_id INTEGER,
+			position INTEGER NOT NULL DEFAULT 0
 		);
 		CREATE TABLE menu_items (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -72,7 +81,14 @@ func menuTestDB(t *testing.T) *sql.DB {
 			css_class TEXT,
 			is_active INTEGER NOT NULL DEFAULT 1,
 			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			item_type TEXT NOT NULL DEFAULT '',
+			link_id INTEGER,
+			visibility TEXT NOT NULL DEFAULT '',
+			visible_roles TEXT NOT NULL DEFAULT '',
+			visible_languages TEXT NOT NULL DEFAULT '',
+			mega_menu INTEGER NOT NULL DEFAULT 0,
+			image_url TEXT NOT NULL DEFAULT ''
 		);
 	`)
 	if err != nil {
@@ -483,3 +499,78 @@ func TestGetMenuWithContext(t *testing.T) {
 		t.Errorf("items[1].Target = %q, want _blank", items[1].Target)
 	}
 }
+
+func TestGetMenuForLanguage_TypedLinksAndLanguageRules(t *testing.T) {
+	db := menuTestDB(t)
+
+	_, err := db.Exec(`
+		INSERT INTO languages (code, name, native_name, is_default, is_active, position) VALUES
+			('en', 'English', 'English', 1, 1, 0),
+			('fr', 'French', 'Français', 0, 1, 1);
+		INSERT INTO menus (name, slug, language_code) VALUES
+			('Default main', 'main', 'en');
+		INSERT INTO menu_items (menu_id, title, url, item_type, link_id, visibility, visible_languages, is_active, position) VALUES
+			(1, 'Blog', NULL, 'blog', NULL, '', '', 1, 0),
+			(1, 'English', NULL, 'language', 1, '', '', 1, 1),
+			(1, 'Home', NULL, 'language', NULL, '', '', 1, 2),
+			(1, 'French only', '/fr-only', '', NULL, '', 'fr', 1, 3),
+			(1, 'German only', '/de-only', '', NULL, '', 'de', 1, 4),
+			(1, 'Missing category', NULL, 'category', 9, '', '', 1, 5),
+			(1, 'Account', '/account', '', NULL, 'members', '', 1, 6);
+	`)
+	if err != nil {
+		t.Fatalf("seed typed menu: %v", err)
+	}
+
+	items := NewMenuService(db, nil).GetMenuForLanguage("main", "fr")
+	want := []struct{ title, url string }{
+		{"Blog", "/fr/blog"},
+		{"English", "/"},
+		{"Home", "/fr"},
+		{"French only", "/fr-only"},
+		{"Account", "/account"},
+	}
+	if len(items) != len(want) {
+		t.Fatalf("len(items) = %d, want %d: %#v", len(items), len(want), items)
+	}
+	for i, w := range want {
+		if items[i].Title != w.title || items[i].URL != w.url {
+			t.Errorf("items[%d] = %q %q, want %q %q", i, items[i].Title, items[i].URL, w.title, w.url)
+		}
+	}
+
+	visible := FilterMenuItems(items, model.RoleAnonymous)
+	if len(visible) != len(want)-1 {
+		t.Fatalf("anonymous visitor sees %d items, want %d", len(visible), len(want)-1)
+	}
+	for _, item := range visible {
+		if item.Title == "Account" {
+			t.Error("anonymous visitor sees the members-only item")
+		}
+	}
+}
+
+func TestFilterMenuItemsDropsHiddenSubtrees(t *testing.T) {
+	items := []MenuItem{
+		{Title: "Staff", Visibility: model.MenuVisibilityRoles, Roles: []string{model.RoleEditor}, Children: []MenuItem{
+			{Title: "Drafts"},
+		}},
+		{Title: "Shop", Children: []MenuItem{
+			{Title: "Sign in", Visibility: model.MenuVisibilityGuests},
+			{Title: "Orders", Visibility: model.MenuVisibilityMembers},
+		}},
+	}
+
+	visible := FilterMenuItems(items, model.RolePublic)
+	if len(visible) != 1 || visible[0].Title != "Shop" {
+		t.Fatalf("visible = %#v, want only Shop", visible)
+	}
+	if len(visible[0].Children) != 1 || visible[0].Children[0].Title != "Orders" {
+		t.Errorf("Shop children = %#v, want only Orders", visible[0].Children)
+	}
+
+	editor := FilterMenuItems(items, model.RoleEditor)
+	if len(editor) != 2 || len(editor[0].Children) != 1 {
+		t.Errorf("editor sees %#v, want Staff with its child and Shop", editor)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// PageAccessRule returns the access rule of a page. Pages without a rule are
+// public; when the rule cannot be loaded, the page is restricted to admins.
+func PageAccessRule(ctx context.Context, queries *store.Queries, pageID int64) store.PageAccess {
+	rule, err := queries.GetPageAccess(ctx, pageID)
+	if err == nil {
+		return rule
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		slog.Error("failed to load page access rule", "error", err, "page_id", pageID)
+		return store.PageAccess{PageID: pageID, Access: model.PageAccessRoles}
+	}
+	return store.PageAccess{PageID: pageID, Access: model.PageAccessPublic}
+}
+
+// PageAccessRoles returns the roles of a "roles" access rule.
+func PageAccessRoles(rule store.PageAccess) []string {
+	if rule.Roles == "" {
+		return nil
+	}
+	return strings.Split(rule.Roles, ",")
+}
+
+// PageAccessRules returns the access rules of several pages, keyed by page
+// ID, with a single query. Pages without a rule are public; when the rules
+// cannot be loaded, all the pages are restricted to admins.
+func PageAccessRules(ctx context.Context, queries *store.Queries, pageIDs []int64) map[int64]store.PageAccess {
+	rules := make(map[int64]store.PageAccess, len(pageIDs))
+	if len(pageIDs) == 0 {
+		return rules
+	}
+	rows, err := queries.ListPageAccessByPageIDs(ctx, pageIDs)
+	if err != nil {
+		slog.Error("failed to load page access rules", "error", err, "pages", len(pageIDs))
+		for _, id := range pageIDs {
+			rules[id] = store.PageAccess{PageID: id, Access: model.PageAccessRoles}
+		}
+		return rules
+	}
+	for _, id := range pageIDs {
+		rules[id] = store.PageAccess{PageID: id, Access: model.PageAccessPublic}
+	}
+	for _, rule := range rows {
+		rules[rule.PageID] = rule
+	}
+	return rules
+}
+
+// CanViewPage reports whether a visitor may view a page. user is nil for
+// visitors who are not logged in.
+func CanViewPage(ctx context.Context, queries *store.Queries, pageID int64, user *store.User) bool {
+	return CanViewPageWithRule(PageAccessRule(ctx, queries, pageID), user)
+}
+
+// CanViewPageWithRule reports whether a visitor may view a page with the
+// given access rule. user is nil for visitors who are not logged in.
+func CanViewPageWithRule(rule store.PageAccess, user *store.User) bool {
+	role := model.RoleAnonymous
+	if user != nil {
+		role = user.Role
+	}
+	return model.CanAccessPage(rule.Access, PageAccessRoles(rule), role)
+}
+
+// pageAccessFilter returns the condition, with its arguments, that limits a
+// query on pages aliased p to the pages a visitor with role may view.
+func pageAccessFilter(role string) (string, []any) {
+	switch role {
+	case model.RoleAdmin:
+		return "", nil
+	case model.RoleAnonymous, "":
+		return " AND NOT EXISTS (SELECT 1 FROM page_access pa WHERE pa.page_id = p.id)", nil
+	default:
+		return ` AND NOT EXISTS (SELECT 1 FROM page_access pa WHERE pa.page_id = p.id
+			AND pa.access = 'roles' AND instr(',' || pa.roles || ',', ',' || ? || ',') = 0)`, []any{role}
+	}
+}
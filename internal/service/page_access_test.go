@@ -0,0 +1,74 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+func TestPageAccess(t *testing.T) {
+	f := newPageTreeFixture(t)
+	ctx := context.Background()
+
+	open := f.page("guide-open", "en", nil)
+	members := f.page("guide-members", "en", nil)
+	editors := f.page("guide-editors", "en", nil)
+	for _, rule := range []store.UpsertPageAccessParams{
+		{PageID: members.ID, Access: model.PageAccessMembers},
+		{PageID: editors.ID, Access: model.PageAccessRoles, Roles: "editor"},
+	} {
+		if err := f.queries.UpsertPageAccess(ctx, rule); err != nil {
+			t.Fatalf("UpsertPageAccess(%d) error = %v", rule.PageID, err)
+		}
+	}
+
+	tests := []struct {
+		role string // "" for an anonymous visitor
+		want []int64
+	}{
+		{"", []int64{open.ID}},
+		{model.RolePublic, []int64{open.ID, members.ID}},
+		{model.RoleEditor, []int64{open.ID, members.ID, editors.ID}},
+		{model.RoleAdmin, []int64{open.ID, members.ID, editors.ID}},
+	}
+	rules := PageAccessRules(ctx, f.queries, []int64{open.ID, members.ID, editors.ID})
+	search := NewSearchService(f.db)
+	for _, tt := range tests {
+		t.Run("role "+tt.role, func(t *testing.T) {
+			var user *store.User
+			if tt.role != "" {
+				user = &store.User{Role: tt.role}
+			}
+			visible := map[int64]bool{}
+			for _, id := range tt.want {
+				visible[id] = true
+			}
+			for _, p := range []store.Page{open, members, editors} {
+				if got := CanViewPage(ctx, f.queries, p.ID, user); got != visible[p.ID] {
+					t.Errorf("CanViewPage(%s) = %v, want %v", p.Slug, got, visible[p.ID])
+				}
+				if got := CanViewPageWithRule(rules[p.ID], user); got != visible[p.ID] {
+					t.Errorf("CanViewPageWithRule(%s) = %v, want %v", p.Slug, got, visible[p.ID])
+				}
+			}
+
+			results, total, err := search.SearchPublishedPages(ctx, SearchParams{Query: "guide", Limit: 10, Role: tt.role})
+			if err != nil {
+				t.Fatalf("SearchPublishedPages() error = %v", err)
+			}
+			if int(total) != len(tt.want) || len(results) != len(tt.want) {
+				t.Fatalf("SearchPublishedPages() = %d results (total %d), want %d", len(results), total, len(tt.want))
+			}
+			for _, r := range results {
+				if !visible[r.ID] {
+					t.Errorf("SearchPublishedPages() returned restricted page %s", r.Slug)
+				}
+			}
+		})
+	}
+}
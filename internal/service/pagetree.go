@@ -0,0 +1,155 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// MaxPageDepth is the number of levels the page tree may have. Top-level
+// pages are on level one.
+const MaxPageDepth = 8
+
+// Page tree validation errors.
+var (
+	ErrPageParentNotFound = errors.New("parent page not found")
+	ErrPageParentLanguage = errors.New("parent page must have the same language")
+	ErrPageParentCycle    = errors.New("a page cannot be moved below itself or one of its subpages")
+	ErrPageTreeTooDeep    = fmt.Errorf("pages cannot be nested more than %d levels deep", MaxPageDepth)
+)
+
+// PageLink is a page of the page tree for navigation templates.
+type PageLink struct {
+	ID    int64
+	Title string
+	Slug  string
+	Path  string // Nested path below the language prefix, e.g. docs/install
+	URL   string // Path with the language prefix and a leading slash
+}
+
+// PagePath returns the URL path of a page below the language prefix: the
+// slugs of its ancestors and its own slug joined by "/". slug is returned
+// when the hierarchy cannot be loaded.
+func PagePath(ctx context.Context, queries *store.Queries, pageID int64, slug string) string {
+	path, err := queries.GetPagePath(ctx, pageID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("failed to load page path", "error", err, "page_id", pageID)
+		}
+		return slug
+	}
+	return path
+}
+
+// PageAncestors returns the ancestors of a page, root first, without the
+// page itself.
+func PageAncestors(ctx context.Context, queries *store.Queries, pageID int64) []store.ListPageAncestorsRow {
+	lineage, err := queries.ListPageAncestors(ctx, pageID)
+	if err != nil {
+		slog.Error("failed to load page ancestors", "error", err, "page_id", pageID)
+		return nil
+	}
+	if len(lineage) == 0 {
+		return nil
+	}
+	return lineage[:len(lineage)-1]
+}
+
+// ChildPageLinks returns the published children of a page in tree order.
+// URLs are prefixed with langPrefix ("" or "/ru").
+func ChildPageLinks(ctx context.Context, queries *store.Queries, pageID int64, langPrefix string) []PageLink {
+	children, err := queries.ListPublishedChildPages(ctx, sql.NullInt64{Int64: pageID, Valid: true})
+	if err != nil {
+		slog.Error("failed to list child pages", "error", err, "page_id", pageID)
+		return nil
+	}
+	if len(children) == 0 {
+		return nil
+	}
+	parentPath := PagePath(ctx, queries, pageID, "")
+	links := make([]PageLink, 0, len(children))
+	for _, child := range children {
+		path := child.Slug
+		if parentPath != "" {
+			path = parentPath + "/" + child.Slug
+		}
+		links = append(links, PageLink{
+			ID:    child.ID,
+			Title: child.Title,
+			Slug:  child.Slug,
+			Path:  path,
+			URL:   langPrefix + "/" + path,
+		})
+	}
+	return links
+}
+
+// pageLinks converts pages to links at their nested paths.
+func pageLinks(ctx context.Context, queries *store.Queries, pages []store.Page, langPrefix string) []PageLink {
+	if len(pages) == 0 {
+		return nil
+	}
+	links := make([]PageLink, 0, len(pages))
+	for _, p := range pages {
+		path := PagePath(ctx, queries, p.ID, p.Slug)
+		links = append(links, PageLink{
+			ID:    p.ID,
+			Title: p.Title,
+			Slug:  p.Slug,
+			Path:  path,
+			URL:   langPrefix + "/" + path,
+		})
+	}
+	return links
+}
+
+// ValidatePageParent checks that parentID may become the parent of a page
+// in languageCode on the site ctx is scoped to. pageID is 0 for pages that
+// do not exist yet.
+func ValidatePageParent(ctx context.Context, queries *store.Queries, pageID, parentID int64, languageCode string) error {
+	if parentID == pageID {
+		return ErrPageParentCycle
+	}
+	parent, err := queries.GetPageByID(ctx, parentID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && parent.SiteID != store.SiteIDFromContext(ctx)) {
+		return ErrPageParentNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("load parent page: %w", err)
+	}
+	if parent.LanguageCode != languageCode {
+		return ErrPageParentLanguage
+	}
+
+	lineage, err := queries.ListPageAncestors(ctx, parentID)
+	if err != nil {
+		return fmt.Errorf("load parent ancestors: %w", err)
+	}
+	for _, ancestor := range lineage {
+		if ancestor.ID == pageID {
+			return ErrPageParentCycle
+		}
+	}
+
+	height := int64(1)
+	if pageID != 0 {
+		descendants, err := queries.ListPageDescendants(ctx, sql.NullInt64{Int64: pageID, Valid: true})
+		if err != nil {
+			return fmt.Errorf("load subpages: %w", err)
+		}
+		for _, d := range descendants {
+			height = max(height, d.Depth+1)
+		}
+	}
+	if int64(len(lineage))+height > MaxPageDepth {
+		return ErrPageTreeTooDeep
+	}
+	return nil
+}
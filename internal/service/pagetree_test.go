@@ -0,0 +1,146 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/testutil"
+)
+
+// pageTreeFixture creates pages in a migrated database and nests them.
+type pageTreeFixture struct {
+	t       *testing.T
+	db      *sql.DB
+	queries *store.Queries
+	userID  int64
+}
+
+func newPageTreeFixture(t *testing.T) *pageTreeFixture {
+	t.Helper()
+	db, cleanup := testutil.TestDB(t)
+	t.Cleanup(cleanup)
+	queries := store.New(db)
+	now := time.Now()
+	user, err := queries.CreateUser(context.Background(), store.CreateUserParams{
+		Email: "tree@example.com", PasswordHash: "hash", Role: "admin", Name: "Tree",
+		CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	return &pageTreeFixture{t: t, db: db, queries: queries, userID: user.ID}
+}
+
+// page creates a published page below parent (nil for a top-level page).
+func (f *pageTreeFixture) page(slug, lang string, parent *store.Page) store.Page {
+	f.t.Helper()
+	ctx := context.Background()
+	now := time.Now()
+	p, err := f.queries.CreatePage(ctx, store.CreatePageParams{
+		Title: slug, Slug: slug, Status: "published", AuthorID: f.userID,
+		LanguageCode: lang, PageType: "page", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
+	})
+	if err != nil {
+		f.t.Fatalf("CreatePage(%s) error = %v", slug, err)
+	}
+	if parent != nil {
+		if err := f.queries.UpsertPageHierarchy(ctx, store.UpsertPageHierarchyParams{
+			PageID: p.ID, ParentID: sql.NullInt64{Int64: parent.ID, Valid: true},
+		}); err != nil {
+			f.t.Fatalf("UpsertPageHierarchy(%s) error = %v", slug, err)
+		}
+	}
+	return p
+}
+
+func TestPagePathAndAncestors(t *testing.T) {
+	f := newPageTreeFixture(t)
+	ctx := context.Background()
+	docs := f.page("docs", "en", nil)
+	install := f.page("install", "en", &docs)
+	linux := f.page("linux", "en", &install)
+
+	if got := PagePath(ctx, f.queries, linux.ID, linux.Slug); got != "docs/install/linux" {
+		t.Errorf("PagePath() = %q, want docs/install/linux", got)
+	}
+	if got := PagePath(ctx, f.queries, docs.ID, docs.Slug); got != "docs" {
+		t.Errorf("PagePath(root) = %q, want docs", got)
+	}
+
+	ancestors := PageAncestors(ctx, f.queries, linux.ID)
+	if len(ancestors) != 2 || ancestors[0].ID != docs.ID || ancestors[1].ID != install.ID {
+		t.Errorf("PageAncestors() = %+v, want docs, install", ancestors)
+	}
+
+	links := ChildPageLinks(ctx, f.queries, docs.ID, "/ru")
+	if len(links) != 1 || links[0].Path != "docs/install" || links[0].URL != "/ru/docs/install" {
+		t.Errorf("ChildPageLinks() = %+v", links)
+	}
+}
+
+func TestValidatePageParent(t *testing.T) {
+	f := newPageTreeFixture(t)
+	ctx := context.Background()
+	if _, err := f.queries.CreateLanguage(ctx, store.CreateLanguageParams{
+		Code: "ru", Name: "Russian", NativeName: "Русский", IsActive: true, Direction: "ltr",
+		CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	}); err != nil {
+		t.Fatalf("CreateLanguage() error = %v", err)
+	}
+	docs := f.page("docs", "en", nil)
+	install := f.page("install", "en", &docs)
+	about := f.page("about", "en", nil)
+	russian := f.page("o-nas", "ru", nil)
+	digest := f.page("digest", "en", nil)
+	if _, err := f.db.ExecContext(ctx, `INSERT INTO sites (id, name, host) VALUES (2, 'Digest', 'digest.example.org')`); err != nil {
+		t.Fatalf("create site: %v", err)
+	}
+	if _, err := f.db.ExecContext(ctx, `UPDATE pages SET site_id = 2 WHERE id = ?`, digest.ID); err != nil {
+		t.Fatalf("move page: %v", err)
+	}
+
+	// A chain of MaxPageDepth pages leaves no room below its last page.
+	chain := make([]store.Page, 0, MaxPageDepth)
+	for i := range MaxPageDepth {
+		var parent *store.Page
+		if i > 0 {
+			parent = &chain[i-1]
+		}
+		chain = append(chain, f.page(fmt.Sprintf("level-%d", i+1), "en", parent))
+	}
+
+	tests := []struct {
+		name     string
+		pageID   int64
+		parentID int64
+		lang     string
+		want     error
+	}{
+		{"new page", 0, install.ID, "en", nil},
+		{"move subtree", about.ID, install.ID, "en", nil},
+		{"itself", docs.ID, docs.ID, "en", ErrPageParentCycle},
+		{"own subpage", docs.ID, install.ID, "en", ErrPageParentCycle},
+		{"missing parent", 0, 99999, "en", ErrPageParentNotFound},
+		{"other language", 0, russian.ID, "en", ErrPageParentLanguage},
+		{"other site", 0, digest.ID, "en", ErrPageParentNotFound},
+		{"too deep", 0, chain[MaxPageDepth-1].ID, "en", ErrPageTreeTooDeep},
+		{"subtree too deep", docs.ID, chain[MaxPageDepth-2].ID, "en", ErrPageTreeTooDeep},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePageParent(ctx, f.queries, tt.pageID, tt.parentID, tt.lang)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("ValidatePageParent() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
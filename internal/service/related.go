@@ -0,0 +1,199 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package service
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"log/slog"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+const (
+	// DefaultRelatedLimit is the number of related pages shown by default.
+	DefaultRelatedLimit = 3
+	// MaxRelatedLimit caps the number of related pages of a request.
+	MaxRelatedLimit = 20
+	// MaxRelatedPicks caps the related pages an editor can pick for a page.
+	MaxRelatedPicks = 20
+
+	// relatedTextWeight is the score of the best full-text match, about one
+	// shared tag. Weaker matches score proportionally less.
+	relatedTextWeight = 2.0
+	// relatedMaxTerms caps the words taken from a page to find similar text.
+	relatedMaxTerms = 12
+)
+
+// RelatedService suggests pages related to a page.
+type RelatedService struct {
+	db      *sql.DB
+	queries *store.Queries
+	search  *SearchService
+}
+
+// NewRelatedService creates a new related pages service.
+func NewRelatedService(db *sql.DB) *RelatedService {
+	return &RelatedService{db: db, queries: store.New(db), search: NewSearchService(db)}
+}
+
+// RelatedPages returns up to limit published pages related to page: the
+// pages picked by editors first, then suggestions in the page's language
+// ranked by shared tags and categories and by full-text similarity.
+func (s *RelatedService) RelatedPages(ctx context.Context, page store.Page, limit int) []store.Page {
+	if limit <= 0 {
+		limit = DefaultRelatedLimit
+	}
+	limit = min(limit, MaxRelatedLimit)
+
+	seen := map[int64]bool{page.ID: true}
+	var related []store.Page
+	picks, err := s.queries.ListRelatedPages(ctx, page.ID)
+	if err != nil {
+		slog.Error("failed to list related pages", "error", err, "page_id", page.ID)
+	}
+	for _, p := range picks {
+		if len(related) == limit {
+			return related
+		}
+		if p.Status == "published" && !seen[p.ID] {
+			seen[p.ID] = true
+			related = append(related, p)
+		}
+	}
+
+	for _, id := range s.suggestions(ctx, page, limit*3) {
+		if len(related) == limit {
+			break
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		p, err := s.queries.GetPageByID(ctx, id)
+		if err != nil {
+			slog.Error("failed to load related page", "error", err, "page_id", id)
+			continue
+		}
+		related = append(related, p)
+	}
+	return related
+}
+
+// RelatedPageLinks returns the related pages of a page as links. URLs are
+// prefixed with langPrefix ("" or "/ru").
+func (s *RelatedService) RelatedPageLinks(ctx context.Context, pageID int64, langPrefix string, limit int) []PageLink {
+	page, err := s.queries.GetPageByID(ctx, pageID)
+	if err != nil {
+		return nil
+	}
+	return pageLinks(ctx, s.queries, s.RelatedPages(ctx, page, limit), langPrefix)
+}
+
+// suggestions returns the IDs of up to n pages similar to page, best first.
+func (s *RelatedService) suggestions(ctx context.Context, page store.Page, n int) []int64 {
+	scores := make(map[int64]float64)
+
+	shared, err := s.queries.ListTaxonomyRelatedPages(ctx, store.ListTaxonomyRelatedPagesParams{
+		PageID:       page.ID,
+		PageID_2:     page.ID,
+		LanguageCode: page.LanguageCode,
+		Limit:        int64(n),
+	})
+	if err != nil {
+		slog.Error("failed to list pages with shared taxonomy", "error", err, "page_id", page.ID)
+	}
+	for _, row := range shared {
+		scores[row.ID] += float64(row.Score)
+	}
+
+	similar := s.similarPages(ctx, page, n)
+	for i, id := range similar {
+		scores[id] += relatedTextWeight * float64(len(similar)-i) / float64(len(similar))
+	}
+	delete(scores, page.ID)
+
+	ids := make([]int64, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	// Equal scores prefer newer pages.
+	slices.SortFunc(ids, func(a, b int64) int {
+		if c := cmp.Compare(scores[b], scores[a]); c != 0 {
+			return c
+		}
+		return cmp.Compare(b, a)
+	})
+	if len(ids) > n {
+		ids = ids[:n]
+	}
+	return ids
+}
+
+// similarPages returns the IDs of up to n published pages whose text best
+// matches the title and keywords of page, best first.
+// SEC-005: FTS5 queries must remain as direct SQL because bm25() and MATCH
+// are SQLite FTS5-specific.
+func (s *RelatedService) similarPages(ctx context.Context, page store.Page, n int) []int64 {
+	query := s.search.escapeQuery(strings.Join(relatedTerms(page.Title+" "+page.MetaKeywords), " "))
+	if query == "" {
+		return nil
+	}
+
+	//goland:noinspection SqlResolve,SqlSignature
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT p.id
+		FROM pages p
+		INNER JOIN pages_fts ON pages_fts.rowid = p.id
+		WHERE pages_fts MATCH ? AND p.id != ? AND p.status = 'published'
+			AND p.exclude_from_lists = 0 AND p.language_code = ?
+		ORDER BY bm25(pages_fts)
+		LIMIT ?
+	`, query, page.ID, page.LanguageCode, n)
+	if err != nil {
+		// The FTS table is missing in minimal schemas
+		if !strings.Contains(err.Error(), "no such table") {
+			slog.Error("failed to find similar pages", "error", err, "page_id", page.ID)
+		}
+		return nil
+	}
+	defer func() { _ = rows.Close() }()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			slog.Error("failed to scan similar page", "error", err)
+			return nil
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		slog.Error("failed to read similar pages", "error", err)
+		return nil
+	}
+	return ids
+}
+
+// relatedTerms returns the distinct words of text with at least four
+// characters, which carry the topic better than short words.
+func relatedTerms(text string) []string {
+	var terms []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n'
+	}) {
+		if utf8.RuneCountInString(word) < 4 || slices.Contains(terms, word) {
+			continue
+		}
+		terms = append(terms, word)
+		if len(terms) == relatedMaxTerms {
+			break
+		}
+	}
+	return terms
+}
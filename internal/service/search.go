@@ -43,6 +43,7 @@ type SearchParams struct {
 	Limit        int
 	Offset       int
 	LanguageCode string // Optional: filter by language code ("" = all languages)
+	Role         string // Role of the visitor; pages they may not view are left out ("" = anonymous)
 }
 
 // NewSearchService creates a new search service.
@@ -97,8 +98,8 @@ func (s *SearchService) SearchPublishedPages(ctx context.Context, params SearchP
 		return []SearchResult{}, 0, nil
 	}
 
-	// Build language filter clause
-	var languageFilter string
+	// Build language and access filter clauses
+	var filters string
 	var countArgs []interface{}
 	var searchArgs []interface{}
 
@@ -106,17 +107,22 @@ func (s *SearchService) SearchPublishedPages(ctx context.Context, params SearchP
 	searchArgs = append(searchArgs, escapedQuery)
 
 	if params.LanguageCode != "" {
-		languageFilter = " AND p.language_code = ?"
+		filters = " AND p.language_code = ?"
 		countArgs = append(countArgs, params.LanguageCode)
 		searchArgs = append(searchArgs, params.LanguageCode)
 	}
 
+	accessFilter, accessArgs := pageAccessFilter(params.Role)
+	filters += accessFilter
+	countArgs = append(countArgs, accessArgs...)
+	searchArgs = append(searchArgs, accessArgs...)
+
 	// Count total results
 	//goland:noinspection SqlResolve
 	countQuery := `
 		SELECT COUNT(*) FROM pages p
 		INNER JOIN pages_fts ON pages_fts.rowid = p.id
-		WHERE pages_fts MATCH ? AND p.status = 'published' AND p.exclude_from_lists = 0` + languageFilter
+		WHERE pages_fts MATCH ? AND p.status = 'published' AND p.exclude_from_lists = 0` + filters
 
 	var total int64
 	err := s.db.QueryRowContext(ctx, countQuery, countArgs...).Scan(&total)
@@ -151,7 +157,7 @@ func (s *SearchService) SearchPublishedPages(ctx context.Context, params SearchP
 			snippet(pages_fts, 1, '<mark>', '</mark>', '...', 30) as highlight
 		FROM pages p
 		INNER JOIN pages_fts ON pages_fts.rowid = p.id
-		WHERE pages_fts MATCH ? AND p.status = 'published' AND p.exclude_from_lists = 0` + languageFilter + `
+		WHERE pages_fts MATCH ? AND p.status = 'published' AND p.exclude_from_lists = 0` + filters + `
 		ORDER BY rank
 		LIMIT ? OFFSET ?
 	`
@@ -347,7 +347,8 @@ func searchTestDB(t *testing.T) *sql.DB {
 			language_code TEXT NOT NULL DEFAULT 'en',
 			meta_title TEXT NOT NULL DEFAULT '',
 			meta_description TEXT NOT NULL DEFAULT '',
-			meta_keywords TEXT NOT NULL DEFAULT ''
+			meta_keywords TEXT NOT NULL DEFAULT '',
+			site_id INTEGER NOT NULL DEFAULT 1
 		)
 	`)
 	if err != nil {
@@ -0,0 +1,75 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// SeriesNav is the place of a page in its series, for prev/next navigation.
+type SeriesNav struct {
+	ID          int64
+	Name        string
+	Slug        string
+	Description string
+	Pages       []PageLink // Published parts in series order
+	Position    int        // 1-based position of the current page in Pages
+	Prev        *PageLink
+	Next        *PageLink
+}
+
+// Total returns the number of published parts of the series.
+func (n *SeriesNav) Total() int {
+	return len(n.Pages)
+}
+
+// PageSeriesNav returns the series navigation of a page, or nil when the
+// page is not a published part of a series. URLs are prefixed with
+// langPrefix ("" or "/ru").
+func PageSeriesNav(ctx context.Context, queries *store.Queries, pageID int64, langPrefix string) *SeriesNav {
+	item, err := queries.GetPageSeriesItem(ctx, pageID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("failed to load page series", "error", err, "page_id", pageID)
+		}
+		return nil
+	}
+	series, err := queries.GetPageSeriesByID(ctx, item.SeriesID)
+	if err != nil {
+		slog.Error("failed to load series", "error", err, "series_id", item.SeriesID)
+		return nil
+	}
+	pages, err := queries.ListPublishedPageSeriesPages(ctx, series.ID)
+	if err != nil {
+		slog.Error("failed to list series pages", "error", err, "series_id", series.ID)
+		return nil
+	}
+
+	nav := &SeriesNav{
+		ID:          series.ID,
+		Name:        series.Name,
+		Slug:        series.Slug,
+		Description: series.Description,
+		Pages:       pageLinks(ctx, queries, pages, langPrefix),
+	}
+	for i, link := range nav.Pages {
+		if link.ID != pageID {
+			continue
+		}
+		nav.Position = i + 1
+		if i > 0 {
+			nav.Prev = &nav.Pages[i-1]
+		}
+		if i+1 < len(nav.Pages) {
+			nav.Next = &nav.Pages[i+1]
+		}
+		return nav
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// series creates a series with the given pages as its parts, in order.
+func (f *pageTreeFixture) series(slug string, pages ...store.Page) store.PageSeries {
+	f.t.Helper()
+	ctx := context.Background()
+	now := time.Now()
+	s, err := f.queries.CreatePageSeries(ctx, store.CreatePageSeriesParams{
+		Name: slug, Slug: slug, LanguageCode: "en", CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		f.t.Fatalf("CreatePageSeries(%s) error = %v", slug, err)
+	}
+	for i, p := range pages {
+		if err := f.queries.UpsertPageSeriesItem(ctx, store.UpsertPageSeriesItemParams{
+			PageID: p.ID, SeriesID: s.ID, Position: int64(i),
+		}); err != nil {
+			f.t.Fatalf("UpsertPageSeriesItem(%s) error = %v", p.Slug, err)
+		}
+	}
+	return s
+}
+
+func TestPageSeriesNav(t *testing.T) {
+	f := newPageTreeFixture(t)
+	ctx := context.Background()
+	docs := f.page("docs", "en", nil)
+	part1 := f.page("part-one", "en", &docs)
+	part2 := f.page("part-two", "en", nil)
+	draft := f.page("part-draft", "en", nil)
+	part3 := f.page("part-three", "en", nil)
+	if _, err := f.db.Exec(`UPDATE pages SET status = 'draft' WHERE id = ?`, draft.ID); err != nil {
+		t.Fatalf("failed to unpublish page: %v", err)
+	}
+	f.series("go-basics", part1, part2, draft, part3)
+
+	nav := PageSeriesNav(ctx, f.queries, part2.ID, "/ru")
+	if nav == nil {
+		t.Fatal("PageSeriesNav() = nil, want series")
+	}
+	if nav.Position != 2 || nav.Total() != 3 {
+		t.Errorf("PageSeriesNav() position %d of %d, want 2 of 3", nav.Position, nav.Total())
+	}
+	if nav.Prev == nil || nav.Prev.URL != "/ru/docs/part-one" {
+		t.Errorf("PageSeriesNav().Prev = %+v, want /ru/docs/part-one", nav.Prev)
+	}
+	if nav.Next == nil || nav.Next.ID != part3.ID {
+		t.Errorf("PageSeriesNav().Next = %+v, want part-three (drafts are skipped)", nav.Next)
+	}
+
+	if nav := PageSeriesNav(ctx, f.queries, part1.ID, ""); nav == nil || nav.Prev != nil {
+		t.Errorf("PageSeriesNav(first part) = %+v, want no previous part", nav)
+	}
+	for _, id := range []int64{docs.ID, draft.ID} {
+		if nav := PageSeriesNav(ctx, f.queries, id, ""); nav != nil {
+			t.Errorf("PageSeriesNav(%d) = %+v, want nil", id, nav)
+		}
+	}
+}
+
+func TestRelatedPages(t *testing.T) {
+	f := newPageTreeFixture(t)
+	ctx := context.Background()
+	now := time.Now()
+	page := f.page("current", "en", nil)
+	picked := f.page("picked", "en", nil)
+	twoTags := f.page("two-tags", "en", nil)
+	oneTag := f.page("one-tag", "en", nil)
+	f.page("unrelated", "en", nil)
+	otherLang := f.page("other-lang", "ru", nil)
+
+	for _, slug := range []string{"go", "web"} {
+		tag, err := f.queries.CreateTag(ctx, store.CreateTagParams{
+			Name: slug, Slug: slug, LanguageCode: "en", CreatedAt: now, UpdatedAt: now,
+		})
+		if err != nil {
+			t.Fatalf("CreateTag(%s) error = %v", slug, err)
+		}
+		tagged := []store.Page{page, twoTags, otherLang}
+		if slug == "go" {
+			tagged = append(tagged, oneTag)
+		}
+		for _, p := range tagged {
+			if err := f.queries.AddTagToPage(ctx, store.AddTagToPageParams{PageID: p.ID, TagID: tag.ID}); err != nil {
+				t.Fatalf("AddTagToPage() error = %v", err)
+			}
+		}
+	}
+	for _, id := range []int64{picked.ID, page.ID} {
+		if err := f.queries.AddRelatedPage(ctx, store.AddRelatedPageParams{PageID: page.ID, RelatedPageID: id}); err != nil {
+			t.Fatalf("AddRelatedPage() error = %v", err)
+		}
+	}
+
+	related := NewRelatedService(f.db).RelatedPages(ctx, page, 3)
+	var slugs []string
+	for _, p := range related {
+		slugs = append(slugs, p.Slug)
+	}
+	want := []string{"picked", "two-tags", "one-tag"}
+	if len(slugs) != len(want) {
+		t.Fatalf("RelatedPages() = %v, want %v", slugs, want)
+	}
+	for i := range want {
+		if slugs[i] != want[i] {
+			t.Fatalf("RelatedPages() = %v, want %v", slugs, want)
+		}
+	}
+
+	if got := NewRelatedService(f.db).RelatedPages(ctx, page, 1); len(got) != 1 || got[0].ID != picked.ID {
+		t.Errorf("RelatedPages(limit 1) = %v, want only the picked page", got)
+	}
+}
+
+func TestRelatedTerms(t *testing.T) {
+	got := relatedTerms("Go Modules and go modules, Workspaces")
+	if len(got) != 2 || got[0] != "modules" || got[1] != "workspaces" {
+		t.Errorf("relatedTerms() = %v, want [modules workspaces]", got)
+	}
+}
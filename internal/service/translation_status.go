@@ -0,0 +1,285 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// TranslationKindMenu is the status kind of menus. Menus have no translation
+// links: a menu is translated by a menu with the same slug in another language.
+const TranslationKindMenu = "menu"
+
+// TranslationStatusKinds lists the kinds of the translation status matrix in
+// display order.
+var TranslationStatusKinds = []string{
+	model.EntityTypePage,
+	model.EntityTypeCategory,
+	model.EntityTypeTag,
+	TranslationKindMenu,
+	model.EntityTypeForm,
+}
+
+// TranslationCell is the translation state of one kind in one language.
+type TranslationCell struct {
+	Translated int // Default language entities with a translation in the language
+	Outdated   int // Translations into the language whose source changed since
+}
+
+// TranslationStatusRow is the translation state of one kind in all languages.
+type TranslationStatusRow struct {
+	Kind  string
+	Total int                        // Entities in the default language
+	Cells map[string]TranslationCell // By language code
+}
+
+// Missing returns the number of default language entities without a
+// translation in the language.
+func (r TranslationStatusRow) Missing(code string) int {
+	return max(r.Total-r.Cells[code].Translated, 0)
+}
+
+// OutdatedTranslation is a translation whose source changed after the
+// translation was last brought up to date.
+type OutdatedTranslation struct {
+	LinkID          int64 // Translation link, 0 for menus
+	Kind            string
+	SourceID        int64
+	SourceTitle     string
+	SourceLanguage  string
+	SourceChangedAt time.Time
+	TranslationID   int64
+	Title           string
+	Language        string
+}
+
+// TranslationStatus is the translation coverage and freshness of the site.
+type TranslationStatus struct {
+	DefaultLanguage string
+	Languages       []store.Language // Active languages other than the default
+	Rows            []TranslationStatusRow
+	Outdated        []OutdatedTranslation // Into the active languages
+}
+
+// LoadTranslationStatus computes the translation coverage of every kind in
+// every active language and lists the outdated translations.
+//
+// Coverage follows whole translation components, so a page translated from
+// its Russian translation still counts as translated for the default
+// language page. Freshness is per translation link: pages compare the latest
+// version of the source with the one the translation was based on, other
+// entities the source's updated_at. Menus compare the last change of the
+// menu and its items on both sides.
+func LoadTranslationStatus(ctx context.Context, queries *store.Queries) (*TranslationStatus, error) {
+	defaultLang, err := queries.GetDefaultLanguage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get default language: %w", err)
+	}
+	languages, err := queries.ListActiveLanguages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list languages: %w", err)
+	}
+	status := &TranslationStatus{DefaultLanguage: defaultLang.Code}
+	active := make(map[string]bool)
+	for _, l := range languages {
+		if l.Code != defaultLang.Code {
+			status.Languages = append(status.Languages, l)
+			active[l.Code] = true
+		}
+	}
+
+	counts, err := queries.CountTranslatableEntitiesByLanguage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count entities: %w", err)
+	}
+	rows := make(map[string]*TranslationStatusRow, len(TranslationStatusKinds))
+	for _, kind := range TranslationStatusKinds {
+		status.Rows = append(status.Rows, TranslationStatusRow{Kind: kind, Cells: make(map[string]TranslationCell)})
+	}
+	for i := range status.Rows {
+		rows[status.Rows[i].Kind] = &status.Rows[i]
+	}
+	for _, c := range counts {
+		if row := rows[c.EntityType]; row != nil && c.LanguageCode == defaultLang.Code {
+			row.Total = int(c.EntityCount)
+		}
+	}
+
+	links, err := queries.ListTranslationFreshness(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list translations: %w", err)
+	}
+	for kind, covered := range translationCoverage(links, defaultLang.Code) {
+		row := rows[kind]
+		if row == nil {
+			continue
+		}
+		for code, n := range covered {
+			cell := row.Cells[code]
+			cell.Translated = n
+			row.Cells[code] = cell
+		}
+	}
+	for _, link := range links {
+		row := rows[link.EntityType]
+		if row == nil || link.Outdated == 0 || !active[link.TranslationLanguageCode] {
+			continue
+		}
+		cell := row.Cells[link.TranslationLanguageCode]
+		cell.Outdated++
+		row.Cells[link.TranslationLanguageCode] = cell
+		status.Outdated = append(status.Outdated, OutdatedTranslation{
+			LinkID:          link.ID,
+			Kind:            link.EntityType,
+			SourceID:        link.SourceID,
+			SourceTitle:     link.SourceTitle,
+			SourceLanguage:  link.SourceLanguageCode,
+			SourceChangedAt: link.SourceUpdatedAt,
+			TranslationID:   link.TranslationID,
+			Title:           link.TranslationTitle,
+			Language:        link.TranslationLanguageCode,
+		})
+	}
+
+	menuOutdated, err := menuTranslationStatus(ctx, queries, defaultLang.Code, active, rows[TranslationKindMenu])
+	if err != nil {
+		return nil, err
+	}
+	status.Outdated = append(status.Outdated, menuOutdated...)
+	slices.SortStableFunc(status.Outdated, func(a, b OutdatedTranslation) int {
+		return b.SourceChangedAt.Compare(a.SourceChangedAt)
+	})
+	return status, nil
+}
+
+// translationCoverage counts, per kind and language, the default language
+// entities whose translation component contains an entity in the language.
+func translationCoverage(links []store.ListTranslationFreshnessRow, defaultLang string) map[string]map[string]int {
+	type node struct {
+		kind string
+		id   int64
+	}
+	parent := make(map[node]node)
+	var find func(n node) node
+	find = func(n node) node {
+		p, ok := parent[n]
+		if !ok || p == n {
+			return n
+		}
+		root := find(p)
+		parent[n] = root
+		return root
+	}
+	language := make(map[node]string)
+	for _, link := range links {
+		src := node{link.EntityType, link.SourceID}
+		trg := node{link.EntityType, link.TranslationID}
+		language[src] = link.SourceLanguageCode
+		language[trg] = link.TranslationLanguageCode
+		if a, b := find(src), find(trg); a != b {
+			parent[a] = b
+		}
+	}
+
+	members := make(map[node][]node)
+	for n := range language {
+		root := find(n)
+		members[root] = append(members[root], n)
+	}
+	coverage := make(map[string]map[string]int)
+	for root, nodes := range members {
+		languages := make(map[string]bool)
+		defaults := 0
+		for _, n := range nodes {
+			if language[n] == defaultLang {
+				defaults++
+			} else {
+				languages[language[n]] = true
+			}
+		}
+		if defaults == 0 {
+			continue
+		}
+		if coverage[root.kind] == nil {
+			coverage[root.kind] = make(map[string]int)
+		}
+		for code := range languages {
+			coverage[root.kind][code] += defaults
+		}
+	}
+	return coverage
+}
+
+// menuTranslationStatus fills the menu row. A menu translation is outdated
+// when the default language menu or its items changed after the translated
+// menu and its items were last changed.
+func menuTranslationStatus(ctx context.Context, queries *store.Queries, defaultLang string, active map[string]bool, row *TranslationStatusRow) ([]OutdatedTranslation, error) {
+	menus, err := queries.ListMenus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list menus: %w", err)
+	}
+	lastChange := func(m store.Menu) (time.Time, error) {
+		items, err := queries.ListMenuItems(ctx, m.ID)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("list menu items: %w", err)
+		}
+		changed := m.UpdatedAt
+		for _, item := range items {
+			if item.UpdatedAt.After(changed) {
+				changed = item.UpdatedAt
+			}
+		}
+		return changed, nil
+	}
+
+	sources := make(map[string]store.Menu)
+	for _, m := range menus {
+		if m.LanguageCode == defaultLang {
+			sources[m.Slug] = m
+		}
+	}
+	sourceChanged := make(map[int64]time.Time)
+	var outdated []OutdatedTranslation
+	for _, m := range menus {
+		src, ok := sources[m.Slug]
+		if !ok || !active[m.LanguageCode] {
+			continue
+		}
+		cell := row.Cells[m.LanguageCode]
+		cell.Translated++
+
+		srcChanged, ok := sourceChanged[src.ID]
+		if !ok {
+			if srcChanged, err = lastChange(src); err != nil {
+				return nil, err
+			}
+			sourceChanged[src.ID] = srcChanged
+		}
+		changed, err := lastChange(m)
+		if err != nil {
+			return nil, err
+		}
+		if srcChanged.After(changed) {
+			cell.Outdated++
+			outdated = append(outdated, OutdatedTranslation{
+				Kind:            TranslationKindMenu,
+				SourceID:        src.ID,
+				SourceTitle:     src.Name,
+				SourceLanguage:  src.LanguageCode,
+				SourceChangedAt: srcChanged,
+				TranslationID:   m.ID,
+				Title:           m.Name,
+				Language:        m.LanguageCode,
+			})
+		}
+		row.Cells[m.LanguageCode] = cell
+	}
+	return outdated, nil
+}
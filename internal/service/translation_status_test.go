@@ -0,0 +1,157 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// link creates a translation link from source to translation.
+func (f *pageTreeFixture) link(entityType string, sourceID, translationID, languageID int64) {
+	f.t.Helper()
+	if _, err := f.queries.CreateTranslation(context.Background(), store.CreateTranslationParams{
+		EntityType: entityType, EntityID: sourceID, LanguageID: languageID,
+		TranslationID: translationID, CreatedAt: time.Now(),
+	}); err != nil {
+		f.t.Fatalf("CreateTranslation() error = %v", err)
+	}
+}
+
+// version records a new version of a page.
+func (f *pageTreeFixture) version(p store.Page) {
+	f.t.Helper()
+	if _, err := f.queries.CreatePageVersion(context.Background(), store.CreatePageVersionParams{
+		PageID: p.ID, Title: p.Title, ChangedBy: f.userID, CreatedAt: time.Now(),
+	}); err != nil {
+		f.t.Fatalf("CreatePageVersion() error = %v", err)
+	}
+}
+
+func TestLoadTranslationStatus(t *testing.T) {
+	f := newPageTreeFixture(t)
+	ctx := context.Background()
+	now := time.Now()
+	var languages [2]store.Language
+	for i, code := range []string{"ru", "de"} {
+		l, err := f.queries.CreateLanguage(ctx, store.CreateLanguageParams{
+			Code: code, Name: code, NativeName: code, IsActive: true, Direction: "ltr",
+			Position: int64(i + 1), CreatedAt: now, UpdatedAt: now,
+		})
+		if err != nil {
+			t.Fatalf("CreateLanguage(%s) error = %v", code, err)
+		}
+		languages[i] = l
+	}
+	ru, de := languages[0], languages[1]
+
+	// about has a Russian translation, and a German one translated from it
+	about := f.page("about", "en", nil)
+	f.version(about)
+	aboutRU := f.page("o-nas", "ru", nil)
+	aboutDE := f.page("uber-uns", "de", nil)
+	f.link(model.EntityTypePage, about.ID, aboutRU.ID, ru.ID)
+	f.link(model.EntityTypePage, aboutRU.ID, aboutDE.ID, de.ID)
+	f.page("contact", "en", nil)
+
+	news, err := f.queries.CreateCategory(ctx, store.CreateCategoryParams{
+		Name: "News", Slug: "news", LanguageCode: "en", CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateCategory() error = %v", err)
+	}
+	newsRU, err := f.queries.CreateCategory(ctx, store.CreateCategoryParams{
+		Name: "Новости", Slug: "novosti", LanguageCode: "ru", CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateCategory() error = %v", err)
+	}
+	f.link(model.EntityTypeCategory, news.ID, newsRU.ID, ru.ID)
+
+	menu := func(lang string, updated time.Time) store.Menu {
+		m, err := f.queries.CreateMenu(ctx, store.CreateMenuParams{
+			Name: "Main " + lang, Slug: "main", LanguageCode: lang, CreatedAt: updated, UpdatedAt: updated,
+			SiteID: store.DefaultSiteID,
+		})
+		if err != nil {
+			t.Fatalf("CreateMenu(%s) error = %v", lang, err)
+		}
+		return m
+	}
+	menu("ru", now.Add(-time.Hour))
+	mainEN := menu("en", now.Add(-2*time.Hour))
+
+	status, err := LoadTranslationStatus(ctx, f.queries)
+	if err != nil {
+		t.Fatalf("LoadTranslationStatus() error = %v", err)
+	}
+	if len(status.Languages) != 2 || len(status.Outdated) != 0 {
+		t.Fatalf("LoadTranslationStatus() languages = %d, outdated = %+v, want 2 languages and nothing outdated", len(status.Languages), status.Outdated)
+	}
+	pages := status.Rows[0]
+	if pages.Total != 2 || pages.Cells["ru"].Translated != 1 || pages.Cells["de"].Translated != 1 || pages.Missing("de") != 1 {
+		t.Errorf("page row = %+v, want 2 pages with one translated into ru and de", pages)
+	}
+	if menus := status.Rows[3]; menus.Total != 1 || menus.Cells["ru"].Translated != 1 || menus.Missing("de") != 1 {
+		t.Errorf("menu row = %+v, want the menu translated into ru only", menus)
+	}
+
+	// Changing the sources outdates their translations
+	f.version(about)
+	if _, err := f.queries.UpdateCategory(ctx, store.UpdateCategoryParams{
+		Name: "Updates", Slug: "news", LanguageCode: "en", UpdatedAt: now.Add(time.Minute), ID: news.ID,
+	}); err != nil {
+		t.Fatalf("UpdateCategory() error = %v", err)
+	}
+	if _, err := f.queries.CreateMenuItem(ctx, store.CreateMenuItemParams{
+		MenuID: mainEN.ID, Title: "Blog", IsActive: true, CreatedAt: now, UpdatedAt: now,
+	}); err != nil {
+		t.Fatalf("CreateMenuItem() error = %v", err)
+	}
+
+	status, err = LoadTranslationStatus(ctx, f.queries)
+	if err != nil {
+		t.Fatalf("LoadTranslationStatus() error = %v", err)
+	}
+	got := make(map[string]OutdatedTranslation)
+	for _, o := range status.Outdated {
+		got[o.Kind+"/"+o.Language] = o
+	}
+	if len(got) != 3 || got["page/ru"].TranslationID != aboutRU.ID || got["category/ru"].SourceTitle != "Updates" || got["menu/ru"].LinkID != 0 {
+		t.Fatalf("LoadTranslationStatus().Outdated = %+v, want the ru page, category and menu", status.Outdated)
+	}
+	if status.Rows[0].Cells["ru"].Outdated != 1 || status.Rows[0].Cells["de"].Outdated != 0 {
+		t.Errorf("page row = %+v, want only the ru translation outdated", status.Rows[0])
+	}
+
+	outdated, err := f.queries.ListOutdatedPageTranslationIDs(ctx)
+	if err != nil || len(outdated) != 1 || outdated[0] != aboutRU.ID {
+		t.Errorf("ListOutdatedPageTranslationIDs() = %v, %v, want [%d]", outdated, err, aboutRU.ID)
+	}
+	params := store.ListPagesSortedParams{OutdatedOnly: true, Limit: 10}
+	if n, err := f.queries.CountPagesSorted(ctx, params); err != nil || n != 1 {
+		t.Errorf("CountPagesSorted(outdated) = %d, %v, want 1", n, err)
+	}
+
+	// Bringing the translations up to date clears them
+	if err := f.queries.MarkTranslationsCurrent(ctx, store.MarkTranslationsCurrentParams{
+		EntityType: model.EntityTypePage, TranslationID: aboutRU.ID,
+	}); err != nil {
+		t.Fatalf("MarkTranslationsCurrent() error = %v", err)
+	}
+	if err := f.queries.MarkTranslationCurrent(ctx, got["category/ru"].LinkID); err != nil {
+		t.Fatalf("MarkTranslationCurrent() error = %v", err)
+	}
+	status, err = LoadTranslationStatus(ctx, f.queries)
+	if err != nil {
+		t.Fatalf("LoadTranslationStatus() error = %v", err)
+	}
+	if len(status.Outdated) != 1 || status.Outdated[0].Kind != TranslationKindMenu {
+		t.Errorf("LoadTranslationStatus().Outdated = %+v, want only the menu", status.Outdated)
+	}
+}
@@ -9,12 +9,14 @@ import (
 	"database/sql"
 	"fmt"
 	"html/template"
+	"log/slog"
 	"sync"
 	"time"
 
 	"github.com/microcosm-cc/bluemonday"
 
 	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/widget"
 )
 
 // htmlSanitizer provides a reusable HTML sanitization policy for widget content.
@@ -31,6 +33,8 @@ type WidgetView struct {
 	Settings string
 	IsActive bool
 	Position int64
+	// Visibility limits the pages the widget is shown on.
+	Visibility widget.Visibility
 }
 
 // WidgetService provides widget fetching and caching for the frontend.
@@ -51,14 +55,16 @@ func NewWidgetService(db *sql.DB) *WidgetService {
 	}
 }
 
-// cacheKey creates a cache key for theme, area, and language.
-func cacheKey(theme, area, languageCode string) string {
-	return fmt.Sprintf("%s:%s:%s", theme, area, languageCode)
+// cacheKey creates a cache key for site, theme, area, and language.
+func cacheKey(siteID int64, theme, area, languageCode string) string {
+	return fmt.Sprintf("%d:%s:%s:%s", siteID, theme, area, languageCode)
 }
 
-// GetWidgetsForArea returns active widgets for a specific theme, area, and language.
+// GetWidgetsForArea returns active widgets of the site ctx is scoped to for a
+// specific theme, area, and language.
 func (s *WidgetService) GetWidgetsForArea(ctx context.Context, theme, area, languageCode string) []WidgetView {
-	key := cacheKey(theme, area, languageCode)
+	siteID := store.SiteIDFromContext(ctx)
+	key := cacheKey(siteID, theme, area, languageCode)
 
 	// Check cache
 	s.cacheMu.RLock()
@@ -80,6 +86,9 @@ func (s *WidgetService) GetWidgetsForArea(ctx context.Context, theme, area, lang
 
 	widgets := make([]WidgetView, 0, len(dbWidgets))
 	for _, w := range dbWidgets {
+		if w.SiteID != siteID {
+			continue
+		}
 		widgets = append(widgets, toWidgetView(w))
 	}
 
@@ -92,24 +101,85 @@ func (s *WidgetService) GetWidgetsForArea(ctx context.Context, theme, area, lang
 	return widgets
 }
 
-// GetAllWidgetsForTheme returns all active widgets for a theme, grouped by area.
+// GetAllWidgetsForTheme returns all active widgets of the site ctx is scoped
+// to for a theme, grouped by area.
 func (s *WidgetService) GetAllWidgetsForTheme(ctx context.Context, theme string) map[string][]WidgetView {
+	siteID := store.SiteIDFromContext(ctx)
 	dbWidgets, err := s.queries.GetAllWidgetsByTheme(ctx, theme)
 	if err != nil {
 		return make(map[string][]WidgetView)
 	}
 
+	visibility := s.themeVisibility(ctx, theme)
 	result := make(map[string][]WidgetView)
 	for _, w := range dbWidgets {
-		if w.IsActive != 1 {
+		if w.IsActive != 1 || w.SiteID != siteID {
 			continue
 		}
-		result[w.Area] = append(result[w.Area], toWidgetView(w))
+		view := toWidgetView(w)
+		view.Visibility = visibility[w.ID]
+		result[w.Area] = append(result[w.Area], view)
 	}
 
 	return result
 }
 
+// RenderWidgetsForPage returns the active widgets of a theme shown on a page,
+// grouped by area. Widgets whose type has a renderer get its output as
+// content; widgets rendering nothing are left out.
+func (s *WidgetService) RenderWidgetsForPage(ctx context.Context, theme string, env widget.Env, types map[string]widget.Type) map[string][]WidgetView {
+	result := make(map[string][]WidgetView)
+	for area, widgets := range s.GetAllWidgetsForTheme(ctx, theme) {
+		for _, w := range widgets {
+			if !w.Visibility.Matches(env) {
+				continue
+			}
+			t, ok := types[w.Type]
+			if !ok {
+				continue // type of an inactive module
+			}
+			if t.Render != nil {
+				content, err := t.Render(ctx, widget.Call{
+					Env:      env,
+					ID:       w.ID,
+					Title:    w.Title,
+					Content:  w.Content,
+					Settings: widget.ParseSettings(w.Settings),
+				})
+				if err != nil {
+					slog.Warn("failed to render widget", "widget_id", w.ID, "type", w.Type, "error", err)
+					continue
+				}
+				if content == "" {
+					continue
+				}
+				w.Content = content
+			}
+			result[area] = append(result[area], w)
+		}
+	}
+	return result
+}
+
+// themeVisibility returns the visibility rules of the widgets of a theme.
+func (s *WidgetService) themeVisibility(ctx context.Context, theme string) map[int64]widget.Visibility {
+	rows, err := s.queries.ListWidgetVisibilityByTheme(ctx, theme)
+	if err != nil {
+		slog.Error("failed to load widget visibility", "error", err, "theme", theme)
+		return nil
+	}
+	visibility := make(map[int64]widget.Visibility, len(rows))
+	for _, row := range rows {
+		v, err := widget.ParseVisibility(row.Rules)
+		if err != nil {
+			slog.Warn("ignoring widget visibility", "widget_id", row.WidgetID, "error", err)
+			continue
+		}
+		visibility[row.WidgetID] = v
+	}
+	return visibility
+}
+
 // InvalidateCache clears the widget cache.
 func (s *WidgetService) InvalidateCache() {
 	s.cacheMu.Lock()
@@ -37,7 +37,8 @@ func widgetTestDB(t *testing.T) *sql.DB {
 			is_active INTEGER NOT NULL DEFAULT 1,
 			language_code TEXT NOT NULL DEFAULT 'en',
 			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			site_id INTEGER NOT NULL DEFAULT 1
 		)
 	`)
 	if err != nil {
@@ -4,28 +4,39 @@
 package service
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"html/template"
 	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/testutil"
+	"github.com/olegiv/ocms-go/internal/widget"
 )
 
 func TestCacheKey(t *testing.T) {
 	tests := []struct {
+		siteID       int64
 		theme        string
 		area         string
 		languageCode string
 		want         string
 	}{
-		{"default", "sidebar", "en", "default:sidebar:en"},
-		{"custom", "footer", "ru", "custom:footer:ru"},
-		{"", "header", "en", ":header:en"},
-		{"theme", "", "en", "theme::en"},
+		{1, "default", "sidebar", "en", "1:default:sidebar:en"},
+		{1, "custom", "footer", "ru", "1:custom:footer:ru"},
+		{1, "", "header", "en", "1::header:en"},
+		{1, "theme", "", "en", "1:theme::en"},
+		{2, "default", "sidebar", "en", "2:default:sidebar:en"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.theme+"-"+tt.area, func(t *testing.T) {
-			got := cacheKey(tt.theme, tt.area, tt.languageCode)
+			got := cacheKey(tt.siteID, tt.theme, tt.area, tt.languageCode)
 			if got != tt.want {
-				t.Errorf("cacheKey(%q, %q, %q) = %q, want %q", tt.theme, tt.area, tt.languageCode, got, tt.want)
+				t.Errorf("cacheKey(%d, %q, %q, %q) = %q, want %q", tt.siteID, tt.theme, tt.area, tt.languageCode, got, tt.want)
 			}
 		})
 	}
@@ -141,3 +152,92 @@ func TestHTMLSanitizer(t *testing.T) {
 		t.Errorf("Basic HTML sanitization failed: got %q", result)
 	}
 }
+
+func TestRenderWidgetsForPage(t *testing.T) {
+	db, cleanup := testutil.TestDB(t)
+	t.Cleanup(cleanup)
+	queries := store.New(db)
+	ctx := context.Background()
+
+	add := func(widgetType, content, settings, rules string) {
+		t.Helper()
+		w, err := queries.CreateWidget(ctx, store.CreateWidgetParams{
+			Theme: "default", Area: "sidebar", WidgetType: widgetType,
+			Content:  sql.NullString{String: content, Valid: true},
+			Settings: sql.NullString{String: settings, Valid: settings != ""},
+			IsActive: 1, LanguageCode: "en",
+			SiteID: store.DefaultSiteID,
+		})
+		if err != nil {
+			t.Fatalf("CreateWidget: %v", err)
+		}
+		if rules != "" {
+			if err := queries.UpsertWidgetVisibility(ctx, store.UpsertWidgetVisibilityParams{WidgetID: w.ID, Rules: rules}); err != nil {
+				t.Fatalf("UpsertWidgetVisibility: %v", err)
+			}
+		}
+	}
+	add("text", "<p>everywhere</p><script>x</script>", "", "")
+	add("text", "<p>ru only</p>", "", `{"languages":["ru"]}`)
+	add("greeting", "", `{"name":"Ann"}`, "")
+	add("broken", "", "", "")
+	add("empty", "", "", "")
+	add("inactive_module", "<p>stale</p>", "", "")
+
+	types := map[string]widget.Type{
+		"text": {ID: "text", Content: true},
+		"greeting": {ID: "greeting", Render: func(_ context.Context, c widget.Call) (template.HTML, error) {
+			return template.HTML("<p>Hi " + c.Setting("name") + " in " + c.Lang + "</p>"), nil
+		}},
+		"broken": {ID: "broken", Render: func(context.Context, widget.Call) (template.HTML, error) {
+			return "", errors.New("boom")
+		}},
+		"empty": {ID: "empty", Render: func(context.Context, widget.Call) (template.HTML, error) { return "", nil }},
+	}
+
+	got := NewWidgetService(db).RenderWidgetsForPage(ctx, "default", widget.Env{Lang: "en", Path: "/"}, types)["sidebar"]
+	if len(got) != 2 {
+		t.Fatalf("widgets = %+v, want the text and greeting widgets", got)
+	}
+	if got[0].Content != "<p>everywhere</p>" || got[1].Content != "<p>Hi Ann in en</p>" {
+		t.Errorf("contents = %q, %q", got[0].Content, got[1].Content)
+	}
+
+	got = NewWidgetService(db).RenderWidgetsForPage(ctx, "default", widget.Env{Lang: "ru", Path: "/ru"}, types)["sidebar"]
+	if len(got) != 3 || got[1].Content != "<p>ru only</p>" {
+		t.Errorf("ru widgets = %+v, want the ru only widget too", got)
+	}
+}
+
+func TestListPostArchiveMonths(t *testing.T) {
+	db, cleanup := testutil.TestDB(t)
+	t.Cleanup(cleanup)
+	queries := store.New(db)
+	ctx := context.Background()
+
+	if _, err := db.Exec(`INSERT INTO users (id, email, password_hash, role, name) VALUES (1, 'a@example.com', 'x', 'admin', 'Admin')`); err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	for i, published := range []time.Time{
+		time.Date(2026, 9, 3, 10, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 15, 10, 0, 0, 0, time.UTC),
+	} {
+		if _, err := queries.CreatePage(ctx, store.CreatePageParams{
+			Title: "Post", Slug: fmt.Sprintf("post-%d", i), Status: "published", AuthorID: 1,
+			PublishedAt: sql.NullTime{Time: published, Valid: true}, LanguageCode: "en", PageType: "post",
+			CreatedAt: published, UpdatedAt: published,
+			SiteID: store.DefaultSiteID,
+		}); err != nil {
+			t.Fatalf("CreatePage: %v", err)
+		}
+	}
+
+	months, err := queries.ListPostArchiveMonths(ctx, store.ListPostArchiveMonthsParams{LanguageCode: "en", SiteID: store.DefaultSiteID, Limit: 10})
+	if err != nil {
+		t.Fatalf("ListPostArchiveMonths: %v", err)
+	}
+	if len(months) != 2 || months[0].Month != "2026-10" || months[0].PageCount != 2 || months[1].Month != "2026-09" {
+		t.Errorf("months = %+v, want 2026-10 (2) then 2026-09 (1)", months)
+	}
+}
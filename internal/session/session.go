@@ -21,8 +21,10 @@ func init() {
 	gob.Register(map[string]string{})
 }
 
-// New creates a new session manager configured with SQLite store.
-func New(db *sql.DB, isDev bool) *scs.SessionManager {
+// New creates a new session manager configured with SQLite store. A
+// non-empty cookieDomain shares the session cookie with the subdomains of
+// that domain, so a visitor stays signed in across language subdomains.
+func New(db *sql.DB, isDev bool, cookieDomain string) *scs.SessionManager {
 	sm := scs.New()
 
 	// Use SQLite store
@@ -51,5 +53,14 @@ func New(db *sql.DB, isDev bool) *scs.SessionManager {
 		sm.Cookie.Path = "/"
 	}
 
+	// A cookie shared across subdomains needs the Domain attribute, which
+	// __Host- forbids; __Secure- still requires Secure in production.
+	if cookieDomain != "" {
+		sm.Cookie.Domain = cookieDomain
+		if !isDev {
+			sm.Cookie.Name = "__Secure-session"
+		}
+	}
+
 	return sm
 }
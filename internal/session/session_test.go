@@ -39,7 +39,7 @@ func setupTestDB(t *testing.T) *sql.DB {
 func TestNew(t *testing.T) {
 	db := setupTestDB(t)
 
-	sm := New(db, true)
+	sm := New(db, true, "")
 
 	if sm == nil {
 		t.Fatal("expected session manager to be non-nil")
@@ -50,7 +50,7 @@ func TestNew_DevMode(t *testing.T) {
 	db := setupTestDB(t)
 
 	// Development mode
-	sm := New(db, true)
+	sm := New(db, true, "")
 
 	if sm.Cookie.Secure {
 		t.Error("expected Cookie.Secure = false in dev mode")
@@ -64,7 +64,7 @@ func TestNew_ProductionMode(t *testing.T) {
 	db := setupTestDB(t)
 
 	// Production mode
-	sm := New(db, false)
+	sm := New(db, false, "")
 
 	if !sm.Cookie.Secure {
 		t.Error("expected Cookie.Secure = true in production mode")
@@ -77,10 +77,27 @@ func TestNew_ProductionMode(t *testing.T) {
 	}
 }
 
+func TestNew_CookieDomain(t *testing.T) {
+	db := setupTestDB(t)
+
+	// A shared domain cannot use the __Host- prefix
+	sm := New(db, false, "example.com")
+
+	if sm.Cookie.Domain != "example.com" {
+		t.Errorf("expected Cookie.Domain = example.com, got %q", sm.Cookie.Domain)
+	}
+	if sm.Cookie.Name != "__Secure-session" {
+		t.Errorf("expected __Secure-session cookie name, got %q", sm.Cookie.Name)
+	}
+	if !sm.Cookie.Secure {
+		t.Error("expected Cookie.Secure = true in production mode")
+	}
+}
+
 func TestNew_SessionSettings(t *testing.T) {
 	db := setupTestDB(t)
 
-	sm := New(db, true)
+	sm := New(db, true, "")
 
 	// Check session lifetime
 	if sm.Lifetime != 24*time.Hour {
@@ -105,7 +122,7 @@ func TestNew_SameSiteStrictInProduction(t *testing.T) {
 	db := setupTestDB(t)
 
 	for _, isDev := range []bool{false, true} {
-		sm := New(db, isDev)
+		sm := New(db, isDev, "")
 		if sm.Cookie.SameSite != http.SameSiteStrictMode {
 			t.Errorf("isDev=%v: SameSite = %v, want Strict", isDev, sm.Cookie.SameSite)
 		}
@@ -115,7 +132,7 @@ func TestNew_SameSiteStrictInProduction(t *testing.T) {
 func TestNew_StoreInitialized(t *testing.T) {
 	db := setupTestDB(t)
 
-	sm := New(db, true)
+	sm := New(db, true, "")
 
 	if sm.Store == nil {
 		t.Error("expected Store to be initialized")
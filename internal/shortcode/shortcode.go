@@ -0,0 +1,177 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package shortcode expands shortcodes such as [[snippet:cta-newsletter]],
+// [[form:contact]] or [[gallery folder=3]] in page bodies at render time.
+//
+// A shortcode has a name, an optional argument after a colon and optional
+// key=value attributes; values containing spaces are quoted:
+//
+//	[[name]]
+//	[[name:argument]]
+//	[[name:argument key=value title="Two words"]]
+//
+// Shortcodes inside <pre> and <code> elements are left as written, so
+// documentation pages can show them. Unknown names are kept verbatim.
+package shortcode
+
+import (
+	"context"
+	"html"
+	"html/template"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+// MaxDepth limits nested expansion, e.g. snippets that include snippets. It
+// also stops snippets that include themselves.
+const MaxDepth = 4
+
+// Func renders a shortcode call. When it returns an error the shortcode is
+// removed from the output and the error is logged.
+type Func func(ctx context.Context, call Call) (template.HTML, error)
+
+// Env describes the page a body is expanded for.
+type Env struct {
+	Lang       string // language code of the page
+	LangPrefix string // public URL prefix of the language, "" for the default
+}
+
+// Call is a parsed shortcode.
+type Call struct {
+	Env
+	Name  string
+	Arg   string
+	Attrs map[string]string
+
+	funcs map[string]Func
+	depth int
+}
+
+// Attr returns the value of an attribute, or an empty string.
+func (c Call) Attr(key string) string {
+	return c.Attrs[key]
+}
+
+// Expand expands shortcodes in content rendered by a shortcode, such as a
+// snippet body, one nesting level deeper than the call.
+func (c Call) Expand(ctx context.Context, body string) string {
+	return expand(ctx, body, c.funcs, c.Env, c.depth+1)
+}
+
+var (
+	namePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,63}$`)
+
+	// callPattern matches a shortcode, with the paragraph an editor usually
+	// wraps it in: [[ name [:arg] [attrs] ]].
+	callPattern = regexp.MustCompile(`(<p>\s*)?\[\[([a-z][a-z0-9_-]{0,63})(?::([^\s\]]+))?((?:\s+[^\]\n]*)?)\]\](\s*</p>)?`)
+
+	attrPattern = regexp.MustCompile(`([a-z][a-z0-9_-]*)=(?:"([^"]*)"|'([^']*)'|(\S+))`)
+
+	preformattedPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?is)<pre\b.*?</pre>`),
+		regexp.MustCompile(`(?is)<code\b.*?</code>`),
+	}
+)
+
+// ValidName reports whether name can be used as a shortcode name.
+func ValidName(name string) bool {
+	return namePattern.MatchString(name)
+}
+
+// Expand replaces the shortcodes in body with the output of their functions.
+func Expand(ctx context.Context, body string, funcs map[string]Func, env Env) string {
+	return expand(ctx, body, funcs, env, 0)
+}
+
+// Strip removes all shortcodes from body, for plain-text uses such as
+// excerpts and reading time.
+func Strip(body string) string {
+	if !strings.Contains(body, "[[") {
+		return body
+	}
+	return callPattern.ReplaceAllString(body, "")
+}
+
+func expand(ctx context.Context, body string, funcs map[string]Func, env Env, depth int) string {
+	if !strings.Contains(body, "[[") || len(funcs) == 0 {
+		return body
+	}
+	skip := preformattedRanges(body)
+
+	var out strings.Builder
+	last := 0
+	for _, m := range callPattern.FindAllStringSubmatchIndex(body, -1) {
+		start, end := m[0], m[1]
+		name := body[m[4]:m[5]]
+		fn, ok := funcs[name]
+		if !ok || inRanges(skip, m[4]) {
+			continue
+		}
+
+		// Drop the wrapping paragraph only when the shortcode is its sole
+		// content; otherwise keep whichever tag was matched.
+		opened, closed := m[2] >= 0, m[10] >= 0
+		if opened && !closed {
+			start = m[3]
+		}
+		if closed && !opened {
+			end = m[10]
+		}
+
+		out.WriteString(body[last:start])
+		last = end
+
+		if depth >= MaxDepth {
+			slog.Warn("shortcode nesting too deep", "shortcode", name, "max_depth", MaxDepth)
+			continue
+		}
+		call := Call{Env: env, Name: name, Attrs: parseAttrs(body, m), funcs: funcs, depth: depth}
+		if m[6] >= 0 {
+			call.Arg = html.UnescapeString(body[m[6]:m[7]])
+		}
+		rendered, err := fn(ctx, call)
+		if err != nil {
+			slog.Warn("failed to expand shortcode", "shortcode", name, "arg", call.Arg, "error", err)
+			continue
+		}
+		out.WriteString(string(rendered))
+	}
+	if last == 0 {
+		return body
+	}
+	out.WriteString(body[last:])
+	return out.String()
+}
+
+// parseAttrs reads the key=value attributes of a match. Editors may store
+// quotes as entities, so the attribute text is unescaped first.
+func parseAttrs(body string, m []int) map[string]string {
+	attrs := make(map[string]string)
+	if m[8] < 0 {
+		return attrs
+	}
+	for _, a := range attrPattern.FindAllStringSubmatch(html.UnescapeString(body[m[8]:m[9]]), -1) {
+		attrs[a[1]] = a[2] + a[3] + a[4]
+	}
+	return attrs
+}
+
+// preformattedRanges returns the byte ranges of <pre> and <code> elements.
+func preformattedRanges(body string) [][]int {
+	var ranges [][]int
+	for _, p := range preformattedPatterns {
+		ranges = append(ranges, p.FindAllStringIndex(body, -1)...)
+	}
+	return ranges
+}
+
+func inRanges(ranges [][]int, pos int) bool {
+	for _, r := range ranges {
+		if pos >= r[0] && pos < r[1] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package shortcode
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"strings"
+	"testing"
+)
+
+func TestExpand(t *testing.T) {
+	var calls []Call
+	funcs := map[string]Func{
+		"echo": func(_ context.Context, c Call) (template.HTML, error) {
+			calls = append(calls, c)
+			return template.HTML("<div>" + c.Arg + "|" + c.Attr("title") + "|" + c.Lang + "</div>"), nil
+		},
+		"fail": func(context.Context, Call) (template.HTML, error) {
+			return "", errors.New("boom")
+		},
+	}
+	env := Env{Lang: "ru", LangPrefix: "/ru"}
+
+	tests := []struct {
+		name, body, want string
+	}{
+		{"plain", "No shortcodes", "No shortcodes"},
+		{"argument", "A [[echo:x]] B", "A <div>x||ru</div> B"},
+		{"quoted attribute", `[[echo:x title="Two words"]]`, "<div>x|Two words|ru</div>"},
+		{"entity quotes", `[[echo title=&quot;Hi there&quot;]]`, "<div>|Hi there|ru</div>"},
+		{"paragraph unwrapped", "<p>[[echo:a]]</p><p>[[echo:b]] text</p>", "<div>a||ru</div><p><div>b||ru</div> text</p>"},
+		{"unknown kept", "[[nope:a]] [[echo:b]]", "[[nope:a]] <div>b||ru</div>"},
+		{"error removed", "x[[fail]]y", "xy"},
+		{"preformatted kept", "<pre>[[echo:a]]</pre><code>[[echo:b]]</code>[[echo:c]]", "<pre>[[echo:a]]</pre><code>[[echo:b]]</code><div>c||ru</div>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Expand(context.Background(), tt.body, funcs, env); got != tt.want {
+				t.Errorf("Expand(%q) = %q, want %q", tt.body, got, tt.want)
+			}
+		})
+	}
+	if len(calls) == 0 || calls[0].LangPrefix != "/ru" {
+		t.Errorf("calls = %+v, want the page environment", calls)
+	}
+}
+
+func TestExpandNested(t *testing.T) {
+	depth := 0
+	funcs := map[string]Func{}
+	funcs["loop"] = func(ctx context.Context, c Call) (template.HTML, error) {
+		depth++
+		return template.HTML("(" + c.Expand(ctx, "[[loop]]") + ")"), nil
+	}
+	got := Expand(context.Background(), "[[loop]]", funcs, Env{})
+	if depth != MaxDepth || got != strings.Repeat("(", MaxDepth)+strings.Repeat(")", MaxDepth) {
+		t.Errorf("Expand = %q after %d calls, want nesting stopped at %d", got, depth, MaxDepth)
+	}
+}
+
+func TestStripAndValidName(t *testing.T) {
+	if got := Strip("Intro [[snippet:cta]] end"); got != "Intro  end" {
+		t.Errorf("Strip = %q", got)
+	}
+	for name, want := range map[string]bool{"snippet": true, "my_code-2": true, "Snippet": false, "2x": false, "": false} {
+		if ValidName(name) != want {
+			t.Errorf("ValidName(%q) = %v, want %v", name, !want, want)
+		}
+	}
+}
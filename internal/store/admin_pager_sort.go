@@ -32,6 +32,8 @@ type ListPagesSortedParams struct {
 	CategoryID    sql.NullInt64  `json:"category_id"`
 	SearchPattern sql.NullString `json:"search_pattern"`
 	ScheduledOnly bool           `json:"scheduled_only"`
+	OutdatedOnly  bool           `json:"outdated_only"` // Translations whose source page changed since
+	SiteID        sql.NullInt64  `json:"site_id"`
 	Limit         int64          `json:"limit"`
 	Offset        int64          `json:"offset"`
 	SortField     string         `json:"sort_field"`
@@ -50,7 +52,7 @@ SELECT
 	p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at,
 	p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords,
 	p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code,
-	p.hide_featured_image, p.page_type, p.exclude_from_lists
+	p.hide_featured_image, p.page_type, p.exclude_from_lists, p.site_id
 FROM pages p
 `
 
@@ -62,6 +64,13 @@ FROM pages p
 	if arg.ScheduledOnly {
 		clauses = append(clauses, "p.scheduled_at IS NOT NULL", "p.status = 'draft'")
 	}
+	if arg.OutdatedOnly {
+		clauses = append(clauses, outdatedPageTranslationClause)
+	}
+	if arg.SiteID.Valid {
+		clauses = append(clauses, "p.site_id = ?")
+		args = append(args, arg.SiteID.Int64)
+	}
 	if arg.Status.Valid {
 		clauses = append(clauses, "p.status = ?")
 		args = append(args, arg.Status.String)
@@ -118,6 +127,7 @@ FROM pages p
 			&i.HideFeaturedImage,
 			&i.PageType,
 			&i.ExcludeFromLists,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -149,6 +159,13 @@ func (q *Queries) CountPagesSorted(ctx context.Context, arg ListPagesSortedParam
 	if arg.ScheduledOnly {
 		clauses = append(clauses, "p.scheduled_at IS NOT NULL", "p.status = 'draft'")
 	}
+	if arg.OutdatedOnly {
+		clauses = append(clauses, outdatedPageTranslationClause)
+	}
+	if arg.SiteID.Valid {
+		clauses = append(clauses, "p.site_id = ?")
+		args = append(args, arg.SiteID.Int64)
+	}
 	if arg.Status.Valid {
 		clauses = append(clauses, "p.status = ?")
 		args = append(args, arg.Status.String)
@@ -174,6 +191,14 @@ func (q *Queries) CountPagesSorted(ctx context.Context, arg ListPagesSortedParam
 	return count, err
 }
 
+// outdatedPageTranslationClause matches pages that are translations of a page
+// with a newer version than the one the translation was based on.
+const outdatedPageTranslationClause = `EXISTS (
+	SELECT 1 FROM translations t
+	WHERE t.entity_type = 'page' AND t.translation_id = p.id
+	  AND COALESCE((SELECT MAX(pv.id) FROM page_versions pv WHERE pv.page_id = t.entity_id), 0) > COALESCE(t.source_version_id, 0)
+)`
+
 func pagesOrderExpr(field, dir string) string {
 	direction := normalizeSortDirection(dir)
 	switch field {
@@ -446,6 +471,7 @@ type ListMediaSortedParams struct {
 	SearchPattern sql.NullString `json:"search_pattern"`
 	MimeType      sql.NullString `json:"mime_type"`
 	FolderID      sql.NullInt64  `json:"folder_id"`
+	SiteID        sql.NullInt64  `json:"site_id"`
 	Limit         int64          `json:"limit"`
 	Offset        int64          `json:"offset"`
 	SortField     string         `json:"sort_field"`
@@ -462,7 +488,7 @@ func (q *Queries) ListMediaSorted(ctx context.Context, arg ListMediaSortedParams
 	query := `
 SELECT
 	m.id, m.uuid, m.filename, m.mime_type, m.size, m.width, m.height, m.alt, m.caption, m.folder_id,
-	m.uploaded_by, m.language_code, m.created_at, m.updated_at
+	m.uploaded_by, m.language_code, m.created_at, m.updated_at, m.site_id
 FROM media m
 `
 
@@ -477,6 +503,10 @@ FROM media m
 		clauses = append(clauses, "m.folder_id = ?")
 		args = append(args, arg.FolderID.Int64)
 	}
+	if arg.SiteID.Valid {
+		clauses = append(clauses, "m.site_id = ?")
+		args = append(args, arg.SiteID.Int64)
+	}
 
 	if len(clauses) > 0 {
 		query += "WHERE " + strings.Join(clauses, " AND ") + "\n"
@@ -509,6 +539,7 @@ FROM media m
 			&i.LanguageCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
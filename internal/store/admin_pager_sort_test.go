@@ -62,6 +62,7 @@ func createSortTestPage(
 		PublishedAt:       sql.NullTime{},
 		CreatedAt:         createdAt,
 		UpdatedAt:         updatedAt,
+		SiteID:            DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage(%s): %v", slug, err)
@@ -358,6 +359,7 @@ func TestListMediaSorted_WithFilters(t *testing.T) {
 		LanguageCode: lang,
 		CreatedAt:    base,
 		UpdatedAt:    base,
+		SiteID:       DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMedia zeta: %v", err)
@@ -376,6 +378,7 @@ func TestListMediaSorted_WithFilters(t *testing.T) {
 		LanguageCode: lang,
 		CreatedAt:    base.Add(time.Hour),
 		UpdatedAt:    base.Add(time.Hour),
+		SiteID:       DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMedia alpha: %v", err)
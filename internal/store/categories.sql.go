@@ -514,11 +514,17 @@ SELECT c.id, c.name, c.slug, c.description, c.parent_id, c.position, c.created_a
 FROM categories c
 INNER JOIN page_categories pc ON pc.category_id = c.id
 INNER JOIN pages p ON p.id = pc.page_id AND p.status = 'published' AND p.language_code = ?1
+    AND p.site_id = ?2
 WHERE c.language_code = ?1
 GROUP BY c.id, c.name, c.slug, c.description, c.parent_id, c.position, c.language_code, c.created_at, c.updated_at
 ORDER BY c.position, c.name
 `
 
+type GetCategoryUsageCountsByLanguageParams struct {
+	LanguageCode string `json:"language_code"`
+	SiteID       int64  `json:"site_id"`
+}
+
 type GetCategoryUsageCountsByLanguageRow struct {
 	ID           int64          `json:"id"`
 	Name         string         `json:"name"`
@@ -533,8 +539,8 @@ type GetCategoryUsageCountsByLanguageRow struct {
 }
 
 // Category usage counts filtered by both page and category language (for frontend sidebar)
-func (q *Queries) GetCategoryUsageCountsByLanguage(ctx context.Context, languageCode string) ([]GetCategoryUsageCountsByLanguageRow, error) {
-	rows, err := q.db.QueryContext(ctx, getCategoryUsageCountsByLanguage, languageCode)
+func (q *Queries) GetCategoryUsageCountsByLanguage(ctx context.Context, arg GetCategoryUsageCountsByLanguageParams) ([]GetCategoryUsageCountsByLanguageRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCategoryUsageCountsByLanguage, arg.LanguageCode, arg.SiteID)
 	if err != nil {
 		return nil, err
 	}
@@ -735,9 +741,22 @@ func (q *Queries) ListCategoriesByLanguage(ctx context.Context, languageCode str
 }
 
 const listCategoriesForSitemap = `-- name: ListCategoriesForSitemap :many
+
 SELECT c.id, c.slug, c.updated_at, c.language_code, l.is_default
 FROM categories c
 INNER JOIN languages l ON l.code = c.language_code AND l.is_active = 1
+WHERE EXISTS (
+    SELECT 1 FROM page_categories pc
+    INNER JOIN pages p ON p.id = pc.page_id
+    WHERE pc.category_id = c.id AND p.status = 'published' AND p.site_id = ?1
+) OR (
+    ?1 = (SELECT id FROM sites WHERE is_default = 1)
+    AND NOT EXISTS (
+        SELECT 1 FROM page_categories pc
+        INNER JOIN pages p ON p.id = pc.page_id
+        WHERE pc.category_id = c.id AND p.status = 'published'
+    )
+)
 ORDER BY c.updated_at DESC
 `
 
@@ -749,8 +768,9 @@ type ListCategoriesForSitemapRow struct {
 	IsDefault    bool      `json:"is_default"`
 }
 
-func (q *Queries) ListCategoriesForSitemap(ctx context.Context) ([]ListCategoriesForSitemapRow, error) {
-	rows, err := q.db.QueryContext(ctx, listCategoriesForSitemap)
+// Categories with published pages of a site.
+func (q *Queries) ListCategoriesForSitemap(ctx context.Context, siteID int64) ([]ListCategoriesForSitemapRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCategoriesForSitemap, siteID)
 	if err != nil {
 		return nil, err
 	}
@@ -815,69 +835,6 @@ func (q *Queries) ListChildCategories(ctx context.Context, parentID sql.NullInt6
 	return items, nil
 }
 
-const listPagesByCategory = `-- name: ListPagesByCategory :many
-SELECT DISTINCT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title FROM pages p
-INNER JOIN page_categories pc ON pc.page_id = p.id
-WHERE pc.category_id = ?
-ORDER BY p.updated_at DESC
-LIMIT ? OFFSET ?
-`
-
-type ListPagesByCategoryParams struct {
-	CategoryID int64 `json:"category_id"`
-	Limit      int64 `json:"limit"`
-	Offset     int64 `json:"offset"`
-}
-
-func (q *Queries) ListPagesByCategory(ctx context.Context, arg ListPagesByCategoryParams) ([]Page, error) {
-	rows, err := q.db.QueryContext(ctx, listPagesByCategory, arg.CategoryID, arg.Limit, arg.Offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []Page{}
-	for rows.Next() {
-		var i Page
-		if err := rows.Scan(
-			&i.ID,
-			&i.Title,
-			&i.Slug,
-			&i.Body,
-			&i.Status,
-			&i.AuthorID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.PublishedAt,
-			&i.FeaturedImageID,
-			&i.MetaTitle,
-			&i.MetaDescription,
-			&i.MetaKeywords,
-			&i.OgImageID,
-			&i.NoIndex,
-			&i.NoFollow,
-			&i.CanonicalUrl,
-			&i.ScheduledAt,
-			&i.LanguageCode,
-			&i.HideFeaturedImage,
-			&i.PageType,
-			&i.ExcludeFromLists,
-			&i.Summary,
-			&i.VideoUrl,
-			&i.VideoTitle,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const listRootCategories = `-- name: ListRootCategories :many
 SELECT id, name, slug, description, parent_id, position, created_at, updated_at, language_code FROM categories WHERE parent_id IS NULL ORDER BY position, name
 `
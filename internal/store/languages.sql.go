@@ -42,9 +42,9 @@ func (q *Queries) CountLanguages(ctx context.Context) (int64, error) {
 }
 
 const createLanguage = `-- name: CreateLanguage :one
-INSERT INTO languages (code, name, native_name, is_default, is_active, direction, position, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, code, name, native_name, is_default, is_active, direction, position, created_at, updated_at
+INSERT INTO languages (code, name, native_name, is_default, is_active, direction, position, created_at, updated_at, host)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, code, name, native_name, is_default, is_active, direction, position, created_at, updated_at, host
 `
 
 type CreateLanguageParams struct {
@@ -57,6 +57,7 @@ type CreateLanguageParams struct {
 	Position   int64     `json:"position"`
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
+	Host       string    `json:"host"`
 }
 
 func (q *Queries) CreateLanguage(ctx context.Context, arg CreateLanguageParams) (Language, error) {
@@ -70,6 +71,7 @@ func (q *Queries) CreateLanguage(ctx context.Context, arg CreateLanguageParams)
 		arg.Position,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.Host,
 	)
 	var i Language
 	err := row.Scan(
@@ -83,6 +85,7 @@ func (q *Queries) CreateLanguage(ctx context.Context, arg CreateLanguageParams)
 		&i.Position,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Host,
 	)
 	return i, err
 }
@@ -97,7 +100,7 @@ func (q *Queries) DeleteLanguage(ctx context.Context, id int64) error {
 }
 
 const getDefaultLanguage = `-- name: GetDefaultLanguage :one
-SELECT id, code, name, native_name, is_default, is_active, direction, position, created_at, updated_at FROM languages
+SELECT id, code, name, native_name, is_default, is_active, direction, position, created_at, updated_at, host FROM languages
 WHERE is_default = 1
   AND (SELECT COUNT(*) FROM languages WHERE is_default = 1) = 1
 `
@@ -116,12 +119,13 @@ func (q *Queries) GetDefaultLanguage(ctx context.Context) (Language, error) {
 		&i.Position,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Host,
 	)
 	return i, err
 }
 
 const getLanguageByCode = `-- name: GetLanguageByCode :one
-SELECT id, code, name, native_name, is_default, is_active, direction, position, created_at, updated_at FROM languages WHERE code = ?
+SELECT id, code, name, native_name, is_default, is_active, direction, position, created_at, updated_at, host FROM languages WHERE code = ?
 `
 
 func (q *Queries) GetLanguageByCode(ctx context.Context, code string) (Language, error) {
@@ -138,12 +142,13 @@ func (q *Queries) GetLanguageByCode(ctx context.Context, code string) (Language,
 		&i.Position,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Host,
 	)
 	return i, err
 }
 
 const getLanguageByID = `-- name: GetLanguageByID :one
-SELECT id, code, name, native_name, is_default, is_active, direction, position, created_at, updated_at FROM languages WHERE id = ?
+SELECT id, code, name, native_name, is_default, is_active, direction, position, created_at, updated_at, host FROM languages WHERE id = ?
 `
 
 func (q *Queries) GetLanguageByID(ctx context.Context, id int64) (Language, error) {
@@ -160,6 +165,7 @@ func (q *Queries) GetLanguageByID(ctx context.Context, id int64) (Language, erro
 		&i.Position,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Host,
 	)
 	return i, err
 }
@@ -202,8 +208,24 @@ func (q *Queries) LanguageCodeExistsExcluding(ctx context.Context, arg LanguageC
 	return column_1, err
 }
 
+const languageHostExistsExcluding = `-- name: LanguageHostExistsExcluding :one
+SELECT EXISTS(SELECT 1 FROM languages WHERE host = ? AND id != ?)
+`
+
+type LanguageHostExistsExcludingParams struct {
+	Host string `json:"host"`
+	ID   int64  `json:"id"`
+}
+
+func (q *Queries) LanguageHostExistsExcluding(ctx context.Context, arg LanguageHostExistsExcludingParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, languageHostExistsExcluding, arg.Host, arg.ID)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const listActiveLanguages = `-- name: ListActiveLanguages :many
-SELECT id, code, name, native_name, is_default, is_active, direction, position, created_at, updated_at FROM languages WHERE is_active = 1 ORDER BY position, name
+SELECT id, code, name, native_name, is_default, is_active, direction, position, created_at, updated_at, host FROM languages WHERE is_active = 1 ORDER BY position, name
 `
 
 func (q *Queries) ListActiveLanguages(ctx context.Context) ([]Language, error) {
@@ -226,6 +248,7 @@ func (q *Queries) ListActiveLanguages(ctx context.Context) ([]Language, error) {
 			&i.Position,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Host,
 		); err != nil {
 			return nil, err
 		}
@@ -241,7 +264,7 @@ func (q *Queries) ListActiveLanguages(ctx context.Context) ([]Language, error) {
 }
 
 const listLanguages = `-- name: ListLanguages :many
-SELECT id, code, name, native_name, is_default, is_active, direction, position, created_at, updated_at FROM languages ORDER BY position, name
+SELECT id, code, name, native_name, is_default, is_active, direction, position, created_at, updated_at, host FROM languages ORDER BY position, name
 `
 
 func (q *Queries) ListLanguages(ctx context.Context) ([]Language, error) {
@@ -264,6 +287,7 @@ func (q *Queries) ListLanguages(ctx context.Context) ([]Language, error) {
 			&i.Position,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Host,
 		); err != nil {
 			return nil, err
 		}
@@ -293,9 +317,9 @@ func (q *Queries) SetDefaultLanguage(ctx context.Context, arg SetDefaultLanguage
 }
 
 const updateLanguage = `-- name: UpdateLanguage :one
-UPDATE languages SET code = ?, name = ?, native_name = ?, is_default = ?, is_active = ?, direction = ?, position = ?, updated_at = ?
+UPDATE languages SET code = ?, name = ?, native_name = ?, is_default = ?, is_active = ?, direction = ?, position = ?, updated_at = ?, host = ?
 WHERE id = ?
-RETURNING id, code, name, native_name, is_default, is_active, direction, position, created_at, updated_at
+RETURNING id, code, name, native_name, is_default, is_active, direction, position, created_at, updated_at, host
 `
 
 type UpdateLanguageParams struct {
@@ -307,6 +331,7 @@ type UpdateLanguageParams struct {
 	Direction  string    `json:"direction"`
 	Position   int64     `json:"position"`
 	UpdatedAt  time.Time `json:"updated_at"`
+	Host       string    `json:"host"`
 	ID         int64     `json:"id"`
 }
 
@@ -320,6 +345,7 @@ func (q *Queries) UpdateLanguage(ctx context.Context, arg UpdateLanguageParams)
 		arg.Direction,
 		arg.Position,
 		arg.UpdatedAt,
+		arg.Host,
 		arg.ID,
 	)
 	var i Language
@@ -334,6 +360,7 @@ func (q *Queries) UpdateLanguage(ctx context.Context, arg UpdateLanguageParams)
 		&i.Position,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Host,
 	)
 	return i, err
 }
@@ -63,6 +63,17 @@ func (q *Queries) CountMedia(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const countMediaBySite = `-- name: CountMediaBySite :one
+SELECT COUNT(*) FROM media WHERE site_id = ?
+`
+
+func (q *Queries) CountMediaBySite(ctx context.Context, siteID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countMediaBySite, siteID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countMediaByType = `-- name: CountMediaByType :one
 SELECT COUNT(*) FROM media WHERE mime_type LIKE ?
 `
@@ -145,9 +156,9 @@ func (q *Queries) CountPagesUsingMedia(ctx context.Context, arg CountPagesUsingM
 }
 
 const createMedia = `-- name: CreateMedia :one
-INSERT INTO media (uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at
+INSERT INTO media (uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at, site_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at, site_id
 `
 
 type CreateMediaParams struct {
@@ -164,6 +175,7 @@ type CreateMediaParams struct {
 	LanguageCode string         `json:"language_code"`
 	CreatedAt    time.Time      `json:"created_at"`
 	UpdatedAt    time.Time      `json:"updated_at"`
+	SiteID       int64          `json:"site_id"`
 }
 
 func (q *Queries) CreateMedia(ctx context.Context, arg CreateMediaParams) (Medium, error) {
@@ -181,6 +193,7 @@ func (q *Queries) CreateMedia(ctx context.Context, arg CreateMediaParams) (Mediu
 		arg.LanguageCode,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.SiteID,
 	)
 	var i Medium
 	err := row.Scan(
@@ -198,6 +211,7 @@ func (q *Queries) CreateMedia(ctx context.Context, arg CreateMediaParams) (Mediu
 		&i.LanguageCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SiteID,
 	)
 	return i, err
 }
@@ -321,7 +335,7 @@ func (q *Queries) DeleteMediaVariants(ctx context.Context, mediaID int64) error
 }
 
 const getMediaByID = `-- name: GetMediaByID :one
-SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at FROM media WHERE id = ?
+SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at, site_id FROM media WHERE id = ?
 `
 
 func (q *Queries) GetMediaByID(ctx context.Context, id int64) (Medium, error) {
@@ -342,12 +356,13 @@ func (q *Queries) GetMediaByID(ctx context.Context, id int64) (Medium, error) {
 		&i.LanguageCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const getMediaByUUID = `-- name: GetMediaByUUID :one
-SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at FROM media WHERE uuid = ?
+SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at, site_id FROM media WHERE uuid = ?
 `
 
 func (q *Queries) GetMediaByUUID(ctx context.Context, uuid string) (Medium, error) {
@@ -368,6 +383,7 @@ func (q *Queries) GetMediaByUUID(ctx context.Context, uuid string) (Medium, erro
 		&i.LanguageCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SiteID,
 	)
 	return i, err
 }
@@ -565,7 +581,7 @@ func (q *Queries) GetMediaVariants(ctx context.Context, mediaID int64) ([]MediaV
 }
 
 const getRecentMedia = `-- name: GetRecentMedia :many
-SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at FROM media ORDER BY created_at DESC LIMIT ?
+SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at, site_id FROM media ORDER BY created_at DESC LIMIT ?
 `
 
 func (q *Queries) GetRecentMedia(ctx context.Context, limit int64) ([]Medium, error) {
@@ -592,6 +608,7 @@ func (q *Queries) GetRecentMedia(ctx context.Context, limit int64) ([]Medium, er
 			&i.LanguageCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -640,7 +657,7 @@ func (q *Queries) ListChildMediaFolders(ctx context.Context, parentID sql.NullIn
 }
 
 const listMedia = `-- name: ListMedia :many
-SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at FROM media ORDER BY created_at DESC LIMIT ? OFFSET ?
+SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at, site_id FROM media ORDER BY created_at DESC LIMIT ? OFFSET ?
 `
 
 type ListMediaParams struct {
@@ -672,6 +689,7 @@ func (q *Queries) ListMedia(ctx context.Context, arg ListMediaParams) ([]Medium,
 			&i.LanguageCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -687,7 +705,7 @@ func (q *Queries) ListMedia(ctx context.Context, arg ListMediaParams) ([]Medium,
 }
 
 const listMediaByType = `-- name: ListMediaByType :many
-SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at FROM media WHERE mime_type LIKE ? ORDER BY created_at DESC LIMIT ? OFFSET ?
+SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at, site_id FROM media WHERE mime_type LIKE ? ORDER BY created_at DESC LIMIT ? OFFSET ?
 `
 
 type ListMediaByTypeParams struct {
@@ -720,6 +738,7 @@ func (q *Queries) ListMediaByType(ctx context.Context, arg ListMediaByTypeParams
 			&i.LanguageCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -768,7 +787,7 @@ func (q *Queries) ListMediaFolders(ctx context.Context) ([]MediaFolder, error) {
 }
 
 const listMediaInFolder = `-- name: ListMediaInFolder :many
-SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at FROM media WHERE folder_id = ? ORDER BY created_at DESC LIMIT ? OFFSET ?
+SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at, site_id FROM media WHERE folder_id = ? ORDER BY created_at DESC LIMIT ? OFFSET ?
 `
 
 type ListMediaInFolderParams struct {
@@ -801,6 +820,7 @@ func (q *Queries) ListMediaInFolder(ctx context.Context, arg ListMediaInFolderPa
 			&i.LanguageCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -816,7 +836,7 @@ func (q *Queries) ListMediaInFolder(ctx context.Context, arg ListMediaInFolderPa
 }
 
 const listMediaInRootFolder = `-- name: ListMediaInRootFolder :many
-SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at FROM media WHERE folder_id IS NULL ORDER BY created_at DESC LIMIT ? OFFSET ?
+SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at, site_id FROM media WHERE folder_id IS NULL ORDER BY created_at DESC LIMIT ? OFFSET ?
 `
 
 type ListMediaInRootFolderParams struct {
@@ -848,6 +868,7 @@ func (q *Queries) ListMediaInRootFolder(ctx context.Context, arg ListMediaInRoot
 			&i.LanguageCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -911,7 +932,7 @@ func (q *Queries) MoveMediaToFolder(ctx context.Context, arg MoveMediaToFolderPa
 }
 
 const searchMedia = `-- name: SearchMedia :many
-SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at FROM media WHERE filename LIKE ? OR alt LIKE ? ORDER BY created_at DESC LIMIT ?
+SELECT id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at, site_id FROM media WHERE filename LIKE ? OR alt LIKE ? ORDER BY created_at DESC LIMIT ?
 `
 
 type SearchMediaParams struct {
@@ -944,6 +965,7 @@ func (q *Queries) SearchMedia(ctx context.Context, arg SearchMediaParams) ([]Med
 			&i.LanguageCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -961,7 +983,7 @@ func (q *Queries) SearchMedia(ctx context.Context, arg SearchMediaParams) ([]Med
 const updateMedia = `-- name: UpdateMedia :one
 UPDATE media SET filename = ?, alt = ?, caption = ?, folder_id = ?, language_code = ?, updated_at = ?
 WHERE id = ?
-RETURNING id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at
+RETURNING id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at, site_id
 `
 
 type UpdateMediaParams struct {
@@ -1000,6 +1022,7 @@ func (q *Queries) UpdateMedia(ctx context.Context, arg UpdateMediaParams) (Mediu
 		&i.LanguageCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SiteID,
 	)
 	return i, err
 }
@@ -1040,7 +1063,7 @@ UPDATE media
 SET filename = ?, mime_type = ?, size = ?, width = ?, height = ?, alt = ?,
     caption = ?, folder_id = ?, uploaded_by = ?, language_code = ?, updated_at = ?
 WHERE id = ?
-RETURNING id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at
+RETURNING id, uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at, site_id
 `
 
 type UpdateMediaForImportParams struct {
@@ -1089,6 +1112,7 @@ func (q *Queries) UpdateMediaForImport(ctx context.Context, arg UpdateMediaForIm
 		&i.LanguageCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SiteID,
 	)
 	return i, err
 }
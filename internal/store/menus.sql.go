@@ -49,9 +49,9 @@ func (q *Queries) CountMenus(ctx context.Context) (int64, error) {
 }
 
 const createMenu = `-- name: CreateMenu :one
-INSERT INTO menus (name, slug, language_code, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?)
-RETURNING id, name, slug, created_at, updated_at, language_code
+INSERT INTO menus (name, slug, language_code, created_at, updated_at, site_id)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING id, name, slug, created_at, updated_at, language_code, site_id
 `
 
 type CreateMenuParams struct {
@@ -60,6 +60,7 @@ type CreateMenuParams struct {
 	LanguageCode string    `json:"language_code"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
+	SiteID       int64     `json:"site_id"`
 }
 
 func (q *Queries) CreateMenu(ctx context.Context, arg CreateMenuParams) (Menu, error) {
@@ -69,6 +70,7 @@ func (q *Queries) CreateMenu(ctx context.Context, arg CreateMenuParams) (Menu, e
 		arg.LanguageCode,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.SiteID,
 	)
 	var i Menu
 	err := row.Scan(
@@ -78,29 +80,37 @@ func (q *Queries) CreateMenu(ctx context.Context, arg CreateMenuParams) (Menu, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LanguageCode,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const createMenuItem = `-- name: CreateMenuItem :one
 
-INSERT INTO menu_items (menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, created_at, updated_at
+INSERT INTO menu_items (menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, item_type, link_id, visibility, visible_roles, visible_languages, mega_menu, image_url, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, created_at, updated_at, item_type, link_id, visibility, visible_roles, visible_languages, mega_menu, image_url
 `
 
 type CreateMenuItemParams struct {
-	MenuID    int64          `json:"menu_id"`
-	ParentID  sql.NullInt64  `json:"parent_id"`
-	Title     string         `json:"title"`
-	Url       sql.NullString `json:"url"`
-	Target    sql.NullString `json:"target"`
-	PageID    sql.NullInt64  `json:"page_id"`
-	Position  int64          `json:"position"`
-	CssClass  sql.NullString `json:"css_class"`
-	IsActive  bool           `json:"is_active"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
+	MenuID           int64          `json:"menu_id"`
+	ParentID         sql.NullInt64  `json:"parent_id"`
+	Title            string         `json:"title"`
+	Url              sql.NullString `json:"url"`
+	Target           sql.NullString `json:"target"`
+	PageID           sql.NullInt64  `json:"page_id"`
+	Position         int64          `json:"position"`
+	CssClass         sql.NullString `json:"css_class"`
+	IsActive         bool           `json:"is_active"`
+	ItemType         string         `json:"item_type"`
+	LinkID           sql.NullInt64  `json:"link_id"`
+	Visibility       string         `json:"visibility"`
+	VisibleRoles     string         `json:"visible_roles"`
+	VisibleLanguages string         `json:"visible_languages"`
+	MegaMenu         bool           `json:"mega_menu"`
+	ImageUrl         string         `json:"image_url"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
 }
 
 // Menu Item queries
@@ -115,6 +125,13 @@ func (q *Queries) CreateMenuItem(ctx context.Context, arg CreateMenuItemParams)
 		arg.Position,
 		arg.CssClass,
 		arg.IsActive,
+		arg.ItemType,
+		arg.LinkID,
+		arg.Visibility,
+		arg.VisibleRoles,
+		arg.VisibleLanguages,
+		arg.MegaMenu,
+		arg.ImageUrl,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
@@ -132,6 +149,13 @@ func (q *Queries) CreateMenuItem(ctx context.Context, arg CreateMenuItemParams)
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ItemType,
+		&i.LinkID,
+		&i.Visibility,
+		&i.VisibleRoles,
+		&i.VisibleLanguages,
+		&i.MegaMenu,
+		&i.ImageUrl,
 	)
 	return i, err
 }
@@ -180,7 +204,7 @@ func (q *Queries) GetMaxMenuItemPosition(ctx context.Context, arg GetMaxMenuItem
 }
 
 const getMenuByID = `-- name: GetMenuByID :one
-SELECT id, name, slug, created_at, updated_at, language_code FROM menus WHERE id = ?
+SELECT id, name, slug, created_at, updated_at, language_code, site_id FROM menus WHERE id = ?
 `
 
 func (q *Queries) GetMenuByID(ctx context.Context, id int64) (Menu, error) {
@@ -193,12 +217,13 @@ func (q *Queries) GetMenuByID(ctx context.Context, id int64) (Menu, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LanguageCode,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const getMenuBySlug = `-- name: GetMenuBySlug :one
-SELECT id, name, slug, created_at, updated_at, language_code FROM menus WHERE slug = ?
+SELECT id, name, slug, created_at, updated_at, language_code, site_id FROM menus WHERE slug = ? ORDER BY site_id LIMIT 1
 `
 
 func (q *Queries) GetMenuBySlug(ctx context.Context, slug string) (Menu, error) {
@@ -211,13 +236,15 @@ func (q *Queries) GetMenuBySlug(ctx context.Context, slug string) (Menu, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LanguageCode,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const getMenuBySlugAndLanguage = `-- name: GetMenuBySlugAndLanguage :one
-SELECT id, name, slug, created_at, updated_at, language_code FROM menus
+SELECT id, name, slug, created_at, updated_at, language_code, site_id FROM menus
 WHERE slug = ? AND language_code = ?
+ORDER BY site_id LIMIT 1
 `
 
 type GetMenuBySlugAndLanguageParams struct {
@@ -235,12 +262,13 @@ func (q *Queries) GetMenuBySlugAndLanguage(ctx context.Context, arg GetMenuBySlu
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LanguageCode,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const getMenuForLanguageOrDefault = `-- name: GetMenuForLanguageOrDefault :one
-SELECT m.id, m.name, m.slug, m.created_at, m.updated_at, m.language_code
+SELECT m.id, m.name, m.slug, m.created_at, m.updated_at, m.language_code, m.site_id
 FROM menus m
 LEFT JOIN languages l ON m.language_code = l.code
 WHERE m.slug = ? AND (m.language_code = ? OR l.is_default = 1)
@@ -264,12 +292,13 @@ func (q *Queries) GetMenuForLanguageOrDefault(ctx context.Context, arg GetMenuFo
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LanguageCode,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const getMenuItemByID = `-- name: GetMenuItemByID :one
-SELECT id, menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, created_at, updated_at FROM menu_items WHERE id = ?
+SELECT id, menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, created_at, updated_at, item_type, link_id, visibility, visible_roles, visible_languages, mega_menu, image_url FROM menu_items WHERE id = ?
 `
 
 func (q *Queries) GetMenuItemByID(ctx context.Context, id int64) (MenuItem, error) {
@@ -288,6 +317,39 @@ func (q *Queries) GetMenuItemByID(ctx context.Context, id int64) (MenuItem, erro
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ItemType,
+		&i.LinkID,
+		&i.Visibility,
+		&i.VisibleRoles,
+		&i.VisibleLanguages,
+		&i.MegaMenu,
+		&i.ImageUrl,
+	)
+	return i, err
+}
+
+const getSiteMenuBySlugAndLanguage = `-- name: GetSiteMenuBySlugAndLanguage :one
+SELECT id, name, slug, created_at, updated_at, language_code, site_id FROM menus
+WHERE slug = ? AND language_code = ? AND site_id = ?
+`
+
+type GetSiteMenuBySlugAndLanguageParams struct {
+	Slug         string `json:"slug"`
+	LanguageCode string `json:"language_code"`
+	SiteID       int64  `json:"site_id"`
+}
+
+func (q *Queries) GetSiteMenuBySlugAndLanguage(ctx context.Context, arg GetSiteMenuBySlugAndLanguageParams) (Menu, error) {
+	row := q.db.QueryRowContext(ctx, getSiteMenuBySlugAndLanguage, arg.Slug, arg.LanguageCode, arg.SiteID)
+	var i Menu
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Slug,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LanguageCode,
+		&i.SiteID,
 	)
 	return i, err
 }
@@ -320,7 +382,7 @@ func (q *Queries) ListChildMenuItemIDs(ctx context.Context, parentID sql.NullInt
 }
 
 const listChildMenuItems = `-- name: ListChildMenuItems :many
-SELECT id, menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, created_at, updated_at FROM menu_items WHERE parent_id = ? ORDER BY position
+SELECT id, menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, created_at, updated_at, item_type, link_id, visibility, visible_roles, visible_languages, mega_menu, image_url FROM menu_items WHERE parent_id = ? ORDER BY position
 `
 
 func (q *Queries) ListChildMenuItems(ctx context.Context, parentID sql.NullInt64) ([]MenuItem, error) {
@@ -345,6 +407,13 @@ func (q *Queries) ListChildMenuItems(ctx context.Context, parentID sql.NullInt64
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ItemType,
+			&i.LinkID,
+			&i.Visibility,
+			&i.VisibleRoles,
+			&i.VisibleLanguages,
+			&i.MegaMenu,
+			&i.ImageUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -386,8 +455,55 @@ func (q *Queries) ListMenuItemIDsForPage(ctx context.Context, pageID sql.NullInt
 	return items, nil
 }
 
+const listMenuItemURLs = `-- name: ListMenuItemURLs :many
+SELECT mi.id, mi.menu_id, mi.title, mi.url, m.name AS menu_name
+FROM menu_items mi
+JOIN menus m ON m.id = mi.menu_id
+WHERE mi.is_active = 1 AND mi.item_type = '' AND mi.page_id IS NULL
+  AND mi.url IS NOT NULL AND mi.url != ''
+ORDER BY mi.id
+`
+
+type ListMenuItemURLsRow struct {
+	ID       int64          `json:"id"`
+	MenuID   int64          `json:"menu_id"`
+	Title    string         `json:"title"`
+	Url      sql.NullString `json:"url"`
+	MenuName string         `json:"menu_name"`
+}
+
+// Active link items pointing at a URL rather than a page, for the link checker.
+func (q *Queries) ListMenuItemURLs(ctx context.Context) ([]ListMenuItemURLsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listMenuItemURLs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListMenuItemURLsRow{}
+	for rows.Next() {
+		var i ListMenuItemURLsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MenuID,
+			&i.Title,
+			&i.Url,
+			&i.MenuName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMenuItems = `-- name: ListMenuItems :many
-SELECT id, menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, created_at, updated_at FROM menu_items WHERE menu_id = ? ORDER BY position
+SELECT id, menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, created_at, updated_at, item_type, link_id, visibility, visible_roles, visible_languages, mega_menu, image_url FROM menu_items WHERE menu_id = ? ORDER BY position
 `
 
 func (q *Queries) ListMenuItems(ctx context.Context, menuID int64) ([]MenuItem, error) {
@@ -412,6 +528,13 @@ func (q *Queries) ListMenuItems(ctx context.Context, menuID int64) ([]MenuItem,
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ItemType,
+			&i.LinkID,
+			&i.Visibility,
+			&i.VisibleRoles,
+			&i.VisibleLanguages,
+			&i.MegaMenu,
+			&i.ImageUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -429,7 +552,7 @@ func (q *Queries) ListMenuItems(ctx context.Context, menuID int64) ([]MenuItem,
 const listMenuItemsWithPage = `-- name: ListMenuItemsWithPage :many
 
 SELECT
-    mi.id, mi.menu_id, mi.parent_id, mi.title, mi.url, mi.target, mi.page_id, mi.position, mi.css_class, mi.is_active, mi.created_at, mi.updated_at,
+    mi.id, mi.menu_id, mi.parent_id, mi.title, mi.url, mi.target, mi.page_id, mi.position, mi.css_class, mi.is_active, mi.created_at, mi.updated_at, mi.item_type, mi.link_id, mi.visibility, mi.visible_roles, mi.visible_languages, mi.mega_menu, mi.image_url,
     p.title as page_title,
     p.slug as page_slug,
     p.language_code as page_language_code,
@@ -455,6 +578,13 @@ type ListMenuItemsWithPageRow struct {
 	IsActive              bool           `json:"is_active"`
 	CreatedAt             time.Time      `json:"created_at"`
 	UpdatedAt             time.Time      `json:"updated_at"`
+	ItemType              string         `json:"item_type"`
+	LinkID                sql.NullInt64  `json:"link_id"`
+	Visibility            string         `json:"visibility"`
+	VisibleRoles          string         `json:"visible_roles"`
+	VisibleLanguages      string         `json:"visible_languages"`
+	MegaMenu              bool           `json:"mega_menu"`
+	ImageUrl              string         `json:"image_url"`
 	PageTitle             sql.NullString `json:"page_title"`
 	PageSlug              sql.NullString `json:"page_slug"`
 	PageLanguageCode      sql.NullString `json:"page_language_code"`
@@ -485,6 +615,13 @@ func (q *Queries) ListMenuItemsWithPage(ctx context.Context, menuID int64) ([]Li
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ItemType,
+			&i.LinkID,
+			&i.Visibility,
+			&i.VisibleRoles,
+			&i.VisibleLanguages,
+			&i.MegaMenu,
+			&i.ImageUrl,
 			&i.PageTitle,
 			&i.PageSlug,
 			&i.PageLanguageCode,
@@ -506,7 +643,7 @@ func (q *Queries) ListMenuItemsWithPage(ctx context.Context, menuID int64) ([]Li
 
 const listMenuItemsWithPublishedPage = `-- name: ListMenuItemsWithPublishedPage :many
 SELECT
-    mi.id, mi.menu_id, mi.parent_id, mi.title, mi.url, mi.target, mi.page_id, mi.position, mi.css_class, mi.is_active, mi.created_at, mi.updated_at,
+    mi.id, mi.menu_id, mi.parent_id, mi.title, mi.url, mi.target, mi.page_id, mi.position, mi.css_class, mi.is_active, mi.created_at, mi.updated_at, mi.item_type, mi.link_id, mi.visibility, mi.visible_roles, mi.visible_languages, mi.mega_menu, mi.image_url,
     p.title as page_title,
     p.slug as page_slug,
     p.language_code as page_language_code
@@ -529,6 +666,13 @@ type ListMenuItemsWithPublishedPageRow struct {
 	IsActive         bool           `json:"is_active"`
 	CreatedAt        time.Time      `json:"created_at"`
 	UpdatedAt        time.Time      `json:"updated_at"`
+	ItemType         string         `json:"item_type"`
+	LinkID           sql.NullInt64  `json:"link_id"`
+	Visibility       string         `json:"visibility"`
+	VisibleRoles     string         `json:"visible_roles"`
+	VisibleLanguages string         `json:"visible_languages"`
+	MegaMenu         bool           `json:"mega_menu"`
+	ImageUrl         string         `json:"image_url"`
 	PageTitle        sql.NullString `json:"page_title"`
 	PageSlug         sql.NullString `json:"page_slug"`
 	PageLanguageCode sql.NullString `json:"page_language_code"`
@@ -556,6 +700,13 @@ func (q *Queries) ListMenuItemsWithPublishedPage(ctx context.Context, menuID int
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ItemType,
+			&i.LinkID,
+			&i.Visibility,
+			&i.VisibleRoles,
+			&i.VisibleLanguages,
+			&i.MegaMenu,
+			&i.ImageUrl,
 			&i.PageTitle,
 			&i.PageSlug,
 			&i.PageLanguageCode,
@@ -574,7 +725,7 @@ func (q *Queries) ListMenuItemsWithPublishedPage(ctx context.Context, menuID int
 }
 
 const listMenus = `-- name: ListMenus :many
-SELECT id, name, slug, created_at, updated_at, language_code FROM menus ORDER BY name
+SELECT id, name, slug, created_at, updated_at, language_code, site_id FROM menus ORDER BY name
 `
 
 func (q *Queries) ListMenus(ctx context.Context) ([]Menu, error) {
@@ -593,6 +744,7 @@ func (q *Queries) ListMenus(ctx context.Context) ([]Menu, error) {
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.LanguageCode,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -609,7 +761,7 @@ func (q *Queries) ListMenus(ctx context.Context) ([]Menu, error) {
 
 const listMenusByLanguage = `-- name: ListMenusByLanguage :many
 
-SELECT id, name, slug, created_at, updated_at, language_code FROM menus
+SELECT id, name, slug, created_at, updated_at, language_code, site_id FROM menus
 WHERE language_code = ?
 ORDER BY name
 `
@@ -631,6 +783,42 @@ func (q *Queries) ListMenusByLanguage(ctx context.Context, languageCode string)
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.LanguageCode,
+			&i.SiteID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMenusBySite = `-- name: ListMenusBySite :many
+SELECT id, name, slug, created_at, updated_at, language_code, site_id FROM menus WHERE site_id = ? ORDER BY name
+`
+
+func (q *Queries) ListMenusBySite(ctx context.Context, siteID int64) ([]Menu, error) {
+	rows, err := q.db.QueryContext(ctx, listMenusBySite, siteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Menu{}
+	for rows.Next() {
+		var i Menu
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Slug,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LanguageCode,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -646,7 +834,7 @@ func (q *Queries) ListMenusByLanguage(ctx context.Context, languageCode string)
 }
 
 const listTopLevelMenuItems = `-- name: ListTopLevelMenuItems :many
-SELECT id, menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, created_at, updated_at FROM menu_items WHERE menu_id = ? AND parent_id IS NULL ORDER BY position
+SELECT id, menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, created_at, updated_at, item_type, link_id, visibility, visible_roles, visible_languages, mega_menu, image_url FROM menu_items WHERE menu_id = ? AND parent_id IS NULL ORDER BY position
 `
 
 func (q *Queries) ListTopLevelMenuItems(ctx context.Context, menuID int64) ([]MenuItem, error) {
@@ -671,6 +859,13 @@ func (q *Queries) ListTopLevelMenuItems(ctx context.Context, menuID int64) ([]Me
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ItemType,
+			&i.LinkID,
+			&i.Visibility,
+			&i.VisibleRoles,
+			&i.VisibleLanguages,
+			&i.MegaMenu,
+			&i.ImageUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -713,33 +908,40 @@ func (q *Queries) MenuSlugExistsExcluding(ctx context.Context, arg MenuSlugExist
 }
 
 const menuSlugExistsForLanguage = `-- name: MenuSlugExistsForLanguage :one
-SELECT EXISTS(SELECT 1 FROM menus WHERE slug = ? AND language_code = ?)
+SELECT EXISTS(SELECT 1 FROM menus WHERE slug = ? AND language_code = ? AND site_id = ?)
 `
 
 type MenuSlugExistsForLanguageParams struct {
 	Slug         string `json:"slug"`
 	LanguageCode string `json:"language_code"`
+	SiteID       int64  `json:"site_id"`
 }
 
 func (q *Queries) MenuSlugExistsForLanguage(ctx context.Context, arg MenuSlugExistsForLanguageParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, menuSlugExistsForLanguage, arg.Slug, arg.LanguageCode)
+	row := q.db.QueryRowContext(ctx, menuSlugExistsForLanguage, arg.Slug, arg.LanguageCode, arg.SiteID)
 	var column_1 int64
 	err := row.Scan(&column_1)
 	return column_1, err
 }
 
 const menuSlugExistsForLanguageExcluding = `-- name: MenuSlugExistsForLanguageExcluding :one
-SELECT EXISTS(SELECT 1 FROM menus WHERE slug = ? AND language_code = ? AND id != ?)
+SELECT EXISTS(SELECT 1 FROM menus WHERE slug = ? AND language_code = ? AND site_id = ? AND id != ?)
 `
 
 type MenuSlugExistsForLanguageExcludingParams struct {
 	Slug         string `json:"slug"`
 	LanguageCode string `json:"language_code"`
+	SiteID       int64  `json:"site_id"`
 	ID           int64  `json:"id"`
 }
 
 func (q *Queries) MenuSlugExistsForLanguageExcluding(ctx context.Context, arg MenuSlugExistsForLanguageExcludingParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, menuSlugExistsForLanguageExcluding, arg.Slug, arg.LanguageCode, arg.ID)
+	row := q.db.QueryRowContext(ctx, menuSlugExistsForLanguageExcluding,
+		arg.Slug,
+		arg.LanguageCode,
+		arg.SiteID,
+		arg.ID,
+	)
 	var column_1 int64
 	err := row.Scan(&column_1)
 	return column_1, err
@@ -763,7 +965,7 @@ func (q *Queries) ReparentMenuItemChildren(ctx context.Context, arg ReparentMenu
 const updateMenu = `-- name: UpdateMenu :one
 UPDATE menus SET name = ?, slug = ?, language_code = ?, updated_at = ?
 WHERE id = ?
-RETURNING id, name, slug, created_at, updated_at, language_code
+RETURNING id, name, slug, created_at, updated_at, language_code, site_id
 `
 
 type UpdateMenuParams struct {
@@ -790,27 +992,37 @@ func (q *Queries) UpdateMenu(ctx context.Context, arg UpdateMenuParams) (Menu, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LanguageCode,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const updateMenuItem = `-- name: UpdateMenuItem :one
-UPDATE menu_items SET parent_id = ?, title = ?, url = ?, target = ?, page_id = ?, position = ?, css_class = ?, is_active = ?, updated_at = ?
+UPDATE menu_items SET parent_id = ?, title = ?, url = ?, target = ?, page_id = ?, position = ?, css_class = ?, is_active = ?,
+    item_type = ?, link_id = ?, visibility = ?, visible_roles = ?, visible_languages = ?, mega_menu = ?, image_url = ?,
+    updated_at = ?
 WHERE id = ?
-RETURNING id, menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, created_at, updated_at
+RETURNING id, menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, created_at, updated_at, item_type, link_id, visibility, visible_roles, visible_languages, mega_menu, image_url
 `
 
 type UpdateMenuItemParams struct {
-	ParentID  sql.NullInt64  `json:"parent_id"`
-	Title     string         `json:"title"`
-	Url       sql.NullString `json:"url"`
-	Target    sql.NullString `json:"target"`
-	PageID    sql.NullInt64  `json:"page_id"`
-	Position  int64          `json:"position"`
-	CssClass  sql.NullString `json:"css_class"`
-	IsActive  bool           `json:"is_active"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	ID        int64          `json:"id"`
+	ParentID         sql.NullInt64  `json:"parent_id"`
+	Title            string         `json:"title"`
+	Url              sql.NullString `json:"url"`
+	Target           sql.NullString `json:"target"`
+	PageID           sql.NullInt64  `json:"page_id"`
+	Position         int64          `json:"position"`
+	CssClass         sql.NullString `json:"css_class"`
+	IsActive         bool           `json:"is_active"`
+	ItemType         string         `json:"item_type"`
+	LinkID           sql.NullInt64  `json:"link_id"`
+	Visibility       string         `json:"visibility"`
+	VisibleRoles     string         `json:"visible_roles"`
+	VisibleLanguages string         `json:"visible_languages"`
+	MegaMenu         bool           `json:"mega_menu"`
+	ImageUrl         string         `json:"image_url"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	ID               int64          `json:"id"`
 }
 
 func (q *Queries) UpdateMenuItem(ctx context.Context, arg UpdateMenuItemParams) (MenuItem, error) {
@@ -823,6 +1035,13 @@ func (q *Queries) UpdateMenuItem(ctx context.Context, arg UpdateMenuItemParams)
 		arg.Position,
 		arg.CssClass,
 		arg.IsActive,
+		arg.ItemType,
+		arg.LinkID,
+		arg.Visibility,
+		arg.VisibleRoles,
+		arg.VisibleLanguages,
+		arg.MegaMenu,
+		arg.ImageUrl,
 		arg.UpdatedAt,
 		arg.ID,
 	)
@@ -840,6 +1059,13 @@ func (q *Queries) UpdateMenuItem(ctx context.Context, arg UpdateMenuItemParams)
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ItemType,
+		&i.LinkID,
+		&i.Visibility,
+		&i.VisibleRoles,
+		&i.VisibleLanguages,
+		&i.MegaMenu,
+		&i.ImageUrl,
 	)
 	return i, err
 }
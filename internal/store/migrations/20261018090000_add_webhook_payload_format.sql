@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE webhooks ADD COLUMN payload_format TEXT NOT NULL DEFAULT 'json';
+ALTER TABLE webhooks ADD COLUMN payload_template TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE webhooks DROP COLUMN payload_template;
+ALTER TABLE webhooks DROP COLUMN payload_format;
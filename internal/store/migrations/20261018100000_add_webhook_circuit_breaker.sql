@@ -0,0 +1,12 @@
+-- +goose Up
+ALTER TABLE webhooks ADD COLUMN consecutive_failures INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE webhooks ADD COLUMN paused_at DATETIME;
+ALTER TABLE webhooks ADD COLUMN pause_reason TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX idx_webhook_deliveries_status_created ON webhook_deliveries(status, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_webhook_deliveries_status_created;
+ALTER TABLE webhooks DROP COLUMN pause_reason;
+ALTER TABLE webhooks DROP COLUMN paused_at;
+ALTER TABLE webhooks DROP COLUMN consecutive_failures;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE inbound_webhooks (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    token TEXT NOT NULL UNIQUE,
+    secret TEXT NOT NULL,
+    action TEXT NOT NULL,
+    job_source TEXT NOT NULL DEFAULT '',
+    job_name TEXT NOT NULL DEFAULT '',
+    is_active BOOLEAN NOT NULL DEFAULT 1,
+    trigger_count INTEGER NOT NULL DEFAULT 0,
+    last_triggered_at DATETIME,
+    last_status TEXT NOT NULL DEFAULT '',
+    last_error TEXT NOT NULL DEFAULT '',
+    created_by INTEGER NOT NULL REFERENCES users(id),
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_inbound_webhooks_created_by ON inbound_webhooks(created_by);
+
+-- +goose Down
+DROP INDEX idx_inbound_webhooks_created_by;
+DROP TABLE inbound_webhooks;
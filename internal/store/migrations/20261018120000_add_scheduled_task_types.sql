@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE scheduled_tasks ADD COLUMN task_type TEXT NOT NULL DEFAULT 'url';
+ALTER TABLE scheduled_tasks ADD COLUMN params TEXT NOT NULL DEFAULT '{}';
+ALTER TABLE scheduled_tasks ADD COLUMN retry_policy TEXT NOT NULL DEFAULT 'none';
+ALTER TABLE scheduled_tasks ADD COLUMN max_retries INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE scheduled_tasks ADD COLUMN retry_delay_seconds INTEGER NOT NULL DEFAULT 60;
+
+ALTER TABLE scheduled_task_runs ADD COLUMN attempt INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE scheduled_task_runs ADD COLUMN log TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE scheduled_task_runs DROP COLUMN log;
+ALTER TABLE scheduled_task_runs DROP COLUMN attempt;
+
+ALTER TABLE scheduled_tasks DROP COLUMN retry_delay_seconds;
+ALTER TABLE scheduled_tasks DROP COLUMN max_retries;
+ALTER TABLE scheduled_tasks DROP COLUMN retry_policy;
+ALTER TABLE scheduled_tasks DROP COLUMN params;
+ALTER TABLE scheduled_tasks DROP COLUMN task_type;
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE page_custom_fields (
+    page_id INTEGER PRIMARY KEY REFERENCES pages(id) ON DELETE CASCADE,
+    data TEXT NOT NULL DEFAULT '[]',
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE page_versions ADD COLUMN custom_fields TEXT NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE page_versions DROP COLUMN custom_fields;
+DROP TABLE page_custom_fields;
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE page_blocks (
+    page_id INTEGER PRIMARY KEY REFERENCES pages(id) ON DELETE CASCADE,
+    data TEXT NOT NULL,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE page_versions ADD COLUMN blocks TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE page_versions DROP COLUMN blocks;
+DROP TABLE page_blocks;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE snippets (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    slug TEXT NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    language_code TEXT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(slug, language_code)
+);
+
+CREATE INDEX idx_snippets_language_code ON snippets(language_code);
+
+-- +goose Down
+DROP TABLE snippets;
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE page_hierarchy (
+    page_id INTEGER PRIMARY KEY REFERENCES pages(id) ON DELETE CASCADE,
+    parent_id INTEGER REFERENCES pages(id) ON DELETE SET NULL,
+    position INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_page_hierarchy_parent_id ON page_hierarchy(parent_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_page_hierarchy_parent_id;
+DROP TABLE page_hierarchy;
@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE page_series (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    slug TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    language_code TEXT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(slug, language_code)
+);
+
+-- A page belongs to at most one series.
+CREATE TABLE page_series_items (
+    page_id INTEGER PRIMARY KEY REFERENCES pages(id) ON DELETE CASCADE,
+    series_id INTEGER NOT NULL REFERENCES page_series(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_page_series_items_series_id ON page_series_items(series_id, position);
+
+-- Related pages picked by editors, shown before automatic suggestions.
+CREATE TABLE page_related (
+    page_id INTEGER NOT NULL REFERENCES pages(id) ON DELETE CASCADE,
+    related_page_id INTEGER NOT NULL REFERENCES pages(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (page_id, related_page_id)
+);
+
+-- +goose Down
+DROP TABLE page_related;
+DROP INDEX IF EXISTS idx_page_series_items_series_id;
+DROP TABLE page_series_items;
+DROP TABLE page_series;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Visibility rules of a widget as JSON: page types, categories, languages
+-- and URL patterns. Widgets without a row are shown everywhere.
+CREATE TABLE widget_visibility (
+    widget_id INTEGER PRIMARY KEY REFERENCES widgets(id) ON DELETE CASCADE,
+    rules TEXT NOT NULL DEFAULT '{}'
+);
+
+-- +goose Down
+DROP TABLE widget_visibility;
@@ -0,0 +1,32 @@
+-- +goose Up
+-- Comments on pages. Replies point to their parent comment; comments of
+-- logged-in users keep the user, anonymous comments only the given name.
+CREATE TABLE comments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    page_id INTEGER NOT NULL REFERENCES pages(id) ON DELETE CASCADE,
+    parent_id INTEGER REFERENCES comments(id) ON DELETE CASCADE,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    author_name TEXT NOT NULL,
+    author_email TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    ip_address TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_comments_page_status ON comments(page_id, status);
+CREATE INDEX idx_comments_status_created ON comments(status, created_at);
+CREATE INDEX idx_comments_parent ON comments(parent_id);
+
+-- Per-page override of whether comments are open. Pages without a row follow
+-- the default: open on posts, closed on other page types.
+CREATE TABLE page_comment_settings (
+    page_id INTEGER PRIMARY KEY REFERENCES pages(id) ON DELETE CASCADE,
+    enabled BOOLEAN NOT NULL DEFAULT 1
+);
+
+-- +goose Down
+DROP TABLE page_comment_settings;
+DROP TABLE comments;
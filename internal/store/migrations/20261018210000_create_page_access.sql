@@ -0,0 +1,11 @@
+-- +goose Up
+-- Access rule of a page: "members" for any logged-in user, "roles" for the
+-- comma-separated roles. Pages without a row are public.
+CREATE TABLE page_access (
+    page_id INTEGER PRIMARY KEY REFERENCES pages(id) ON DELETE CASCADE,
+    access TEXT NOT NULL,
+    roles TEXT NOT NULL DEFAULT ''
+);
+
+-- +goose Down
+DROP TABLE page_access;
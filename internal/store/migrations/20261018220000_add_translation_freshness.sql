@@ -0,0 +1,40 @@
+-- +goose Up
+-- State of the source a translation was last brought up to date with. For
+-- pages it is the latest page version, for other entities their updated_at.
+-- A translation is outdated once its source moves past these values.
+ALTER TABLE translations ADD COLUMN source_version_id INTEGER;
+ALTER TABLE translations ADD COLUMN source_updated_at DATETIME;
+
+-- Existing translations start out current
+UPDATE translations SET
+    source_version_id = CASE WHEN entity_type = 'page'
+        THEN (SELECT MAX(pv.id) FROM page_versions pv WHERE pv.page_id = translations.entity_id) END,
+    source_updated_at = CASE entity_type
+        WHEN 'page' THEN (SELECT p.updated_at FROM pages p WHERE p.id = translations.entity_id)
+        WHEN 'category' THEN (SELECT c.updated_at FROM categories c WHERE c.id = translations.entity_id)
+        WHEN 'tag' THEN (SELECT t.updated_at FROM tags t WHERE t.id = translations.entity_id)
+        WHEN 'form' THEN (SELECT f.updated_at FROM forms f WHERE f.id = translations.entity_id)
+    END;
+
+-- A new translation is based on the current state of its source
+-- +goose StatementBegin
+CREATE TRIGGER translations_source_state_ai AFTER INSERT ON translations
+WHEN NEW.source_version_id IS NULL AND NEW.source_updated_at IS NULL
+BEGIN
+    UPDATE translations SET
+        source_version_id = CASE WHEN NEW.entity_type = 'page'
+            THEN (SELECT MAX(pv.id) FROM page_versions pv WHERE pv.page_id = NEW.entity_id) END,
+        source_updated_at = CASE NEW.entity_type
+            WHEN 'page' THEN (SELECT p.updated_at FROM pages p WHERE p.id = NEW.entity_id)
+            WHEN 'category' THEN (SELECT c.updated_at FROM categories c WHERE c.id = NEW.entity_id)
+            WHEN 'tag' THEN (SELECT t.updated_at FROM tags t WHERE t.id = NEW.entity_id)
+            WHEN 'form' THEN (SELECT f.updated_at FROM forms f WHERE f.id = NEW.entity_id)
+        END
+    WHERE id = NEW.id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS translations_source_state_ai;
+ALTER TABLE translations DROP COLUMN source_updated_at;
+ALTER TABLE translations DROP COLUMN source_version_id;
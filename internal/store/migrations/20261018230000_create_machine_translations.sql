@@ -0,0 +1,45 @@
+-- +goose Up
+-- Translations pre-filled by a machine translation provider that an editor
+-- has not reviewed yet. The row is removed once the editor confirms it.
+CREATE TABLE machine_translations (
+    entity_type TEXT NOT NULL,
+    entity_id INTEGER NOT NULL,
+    provider TEXT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (entity_type, entity_id)
+);
+
+-- +goose StatementBegin
+CREATE TRIGGER machine_translations_page_ad AFTER DELETE ON pages
+BEGIN
+    DELETE FROM machine_translations WHERE entity_type = 'page' AND entity_id = OLD.id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER machine_translations_category_ad AFTER DELETE ON categories
+BEGIN
+    DELETE FROM machine_translations WHERE entity_type = 'category' AND entity_id = OLD.id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER machine_translations_tag_ad AFTER DELETE ON tags
+BEGIN
+    DELETE FROM machine_translations WHERE entity_type = 'tag' AND entity_id = OLD.id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER machine_translations_form_ad AFTER DELETE ON forms
+BEGIN
+    DELETE FROM machine_translations WHERE entity_type = 'form' AND entity_id = OLD.id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER machine_translations_form_ad;
+DROP TRIGGER machine_translations_tag_ad;
+DROP TRIGGER machine_translations_category_ad;
+DROP TRIGGER machine_translations_page_ad;
+DROP TABLE machine_translations;
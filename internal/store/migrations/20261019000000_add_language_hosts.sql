@@ -0,0 +1,10 @@
+-- +goose Up
+-- A language mapped to a host (example.de, de.example.com) is served from
+-- that host's root instead of a /{code} path prefix. Empty means the
+-- language uses the site URL.
+ALTER TABLE languages ADD COLUMN host TEXT NOT NULL DEFAULT '';
+CREATE UNIQUE INDEX idx_languages_host ON languages(host) WHERE host != '';
+
+-- +goose Down
+DROP INDEX idx_languages_host;
+ALTER TABLE languages DROP COLUMN host;
@@ -0,0 +1,71 @@
+-- +goose Up
+-- Sites served by one install. A request is answered by the site whose host
+-- it was sent to; every other request, and all content created before sites
+-- existed, belongs to the default site (id 1). An empty theme uses the
+-- globally active theme.
+CREATE TABLE sites (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    host TEXT NOT NULL DEFAULT '',
+    theme TEXT NOT NULL DEFAULT '',
+    is_default BOOLEAN NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_sites_host ON sites(host) WHERE host != '';
+
+INSERT INTO sites (id, name, is_default) VALUES (1, 'Default', 1);
+
+-- Content owned by a site. ALTER TABLE cannot add a REFERENCES column with a
+-- non-NULL default, so sites with content are kept by the application.
+ALTER TABLE pages ADD COLUMN site_id INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE media ADD COLUMN site_id INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE menus ADD COLUMN site_id INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE widgets ADD COLUMN site_id INTEGER NOT NULL DEFAULT 1;
+
+CREATE INDEX idx_pages_site_id ON pages(site_id);
+CREATE INDEX idx_media_site_id ON media(site_id);
+CREATE INDEX idx_widgets_site_id ON widgets(site_id);
+
+-- Each site has its own menus, so a menu slug is unique per site.
+DROP INDEX IF EXISTS idx_menus_slug_language;
+CREATE UNIQUE INDEX idx_menus_slug_language ON menus(site_id, slug, language_code);
+
+-- Settings a site overrides; keys without a row fall back to the config table.
+CREATE TABLE site_config (
+    site_id INTEGER NOT NULL REFERENCES sites(id) ON DELETE CASCADE,
+    key TEXT NOT NULL,
+    value TEXT NOT NULL DEFAULT '',
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (site_id, key)
+);
+
+-- Sites a non-admin user may manage.
+CREATE TABLE user_sites (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    site_id INTEGER NOT NULL REFERENCES sites(id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, site_id)
+);
+
+CREATE INDEX idx_user_sites_site_id ON user_sites(site_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_user_sites_site_id;
+DROP TABLE user_sites;
+DROP TABLE site_config;
+
+DROP INDEX IF EXISTS idx_menus_slug_language;
+DELETE FROM menus WHERE site_id != 1;
+CREATE UNIQUE INDEX idx_menus_slug_language ON menus(slug, language_code);
+
+DROP INDEX IF EXISTS idx_widgets_site_id;
+DROP INDEX IF EXISTS idx_media_site_id;
+DROP INDEX IF EXISTS idx_pages_site_id;
+ALTER TABLE widgets DROP COLUMN site_id;
+ALTER TABLE menus DROP COLUMN site_id;
+ALTER TABLE media DROP COLUMN site_id;
+ALTER TABLE pages DROP COLUMN site_id;
+
+DROP INDEX IF EXISTS idx_sites_host;
+DROP TABLE sites;
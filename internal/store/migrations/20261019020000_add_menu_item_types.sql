@@ -0,0 +1,26 @@
+-- +goose Up
+-- item_type is empty for items linking to a page (page_id) or a URL, and
+-- names the kind of link_id target otherwise: category, tag, form, or
+-- language (its homepage). Blog items need no target.
+ALTER TABLE menu_items ADD COLUMN item_type TEXT NOT NULL DEFAULT '';
+ALTER TABLE menu_items ADD COLUMN link_id INTEGER;
+-- Visibility is empty for everyone, "members" for logged-in users, "guests"
+-- for visitors who are not logged in, or "roles" for the comma-separated
+-- visible_roles. visible_languages limits the item to comma-separated
+-- language codes; empty shows it in every language.
+ALTER TABLE menu_items ADD COLUMN visibility TEXT NOT NULL DEFAULT '';
+ALTER TABLE menu_items ADD COLUMN visible_roles TEXT NOT NULL DEFAULT '';
+ALTER TABLE menu_items ADD COLUMN visible_languages TEXT NOT NULL DEFAULT '';
+-- A mega menu item shows its children side by side as columns, each with
+-- an optional image.
+ALTER TABLE menu_items ADD COLUMN mega_menu BOOLEAN NOT NULL DEFAULT 0;
+ALTER TABLE menu_items ADD COLUMN image_url TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE menu_items DROP COLUMN image_url;
+ALTER TABLE menu_items DROP COLUMN mega_menu;
+ALTER TABLE menu_items DROP COLUMN visible_languages;
+ALTER TABLE menu_items DROP COLUMN visible_roles;
+ALTER TABLE menu_items DROP COLUMN visibility;
+ALTER TABLE menu_items DROP COLUMN link_id;
+ALTER TABLE menu_items DROP COLUMN item_type;
@@ -0,0 +1,33 @@
+-- +goose Up
+-- A regex redirect matches source_path as a regular expression against the
+-- whole request path, and its target may use $1 or ${name} captures. A
+-- source_path with a query string matches only requests carrying those query
+-- parameters. drop_query discards the request query string instead of
+-- appending it to the target.
+ALTER TABLE redirects ADD COLUMN is_regex BOOLEAN NOT NULL DEFAULT 0;
+ALTER TABLE redirects ADD COLUMN drop_query BOOLEAN NOT NULL DEFAULT 0;
+ALTER TABLE redirects ADD COLUMN hit_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE redirects ADD COLUMN last_hit_at DATETIME;
+
+-- Paths that answered 404, with the last referrer and how often they were hit.
+CREATE TABLE not_found_log (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    path TEXT NOT NULL,
+    referrer TEXT NOT NULL DEFAULT '',
+    hit_count INTEGER NOT NULL DEFAULT 1,
+    first_seen_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_not_found_log_path ON not_found_log(path);
+CREATE INDEX idx_not_found_log_hit_count ON not_found_log(hit_count);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_not_found_log_hit_count;
+DROP INDEX IF EXISTS idx_not_found_log_path;
+DROP TABLE IF EXISTS not_found_log;
+
+ALTER TABLE redirects DROP COLUMN last_hit_at;
+ALTER TABLE redirects DROP COLUMN hit_count;
+ALTER TABLE redirects DROP COLUMN drop_query;
+ALTER TABLE redirects DROP COLUMN is_regex;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Earlier public paths of pages, recorded when a page's slug, parent or
+-- language prefix changes. The old path is redirected to the new one.
+CREATE TABLE page_url_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    page_id INTEGER NOT NULL REFERENCES pages(id) ON DELETE CASCADE,
+    path TEXT NOT NULL,
+    changed_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_page_url_history_page_path ON page_url_history(page_id, path);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_page_url_history_page_path;
+DROP TABLE IF EXISTS page_url_history;
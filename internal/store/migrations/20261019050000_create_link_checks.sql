@@ -0,0 +1,25 @@
+-- +goose Up
+-- Results of the broken link checker: one row per link found in a page
+-- body, menu item or widget. Rows not seen by the latest run are removed.
+CREATE TABLE link_checks (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    source_type TEXT NOT NULL,
+    source_id INTEGER NOT NULL,
+    source_title TEXT NOT NULL DEFAULT '',
+    url TEXT NOT NULL,
+    anchor_text TEXT NOT NULL DEFAULT '',
+    is_external BOOLEAN NOT NULL DEFAULT 0,
+    status TEXT NOT NULL DEFAULT 'ok',
+    status_code INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    broken_since DATETIME,
+    checked_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_link_checks_source_url ON link_checks(source_type, source_id, url);
+CREATE INDEX idx_link_checks_status ON link_checks(status);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_link_checks_status;
+DROP INDEX IF EXISTS idx_link_checks_source_url;
+DROP TABLE IF EXISTS link_checks;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- Non-admin users only manage the sites assigned to them. Users who were not
+-- restricted to any site keep access to the default site.
+INSERT OR IGNORE INTO user_sites (user_id, site_id)
+SELECT users.id, sites.id FROM users, sites
+WHERE sites.is_default = 1
+  AND users.role != 'admin'
+  AND NOT EXISTS (SELECT 1 FROM user_sites WHERE user_sites.user_id = users.id);
+
+-- +goose Down
+-- Rows added by the up migration cannot be told apart from assignments made
+-- since, so they are left in place.
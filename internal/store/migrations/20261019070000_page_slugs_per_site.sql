@@ -0,0 +1,229 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- Page slugs and aliases are unique per site instead of across all sites, so
+-- every site can have its own /about page.
+--
+-- The UNIQUE constraint on pages.slug can only be dropped by rebuilding the
+-- table. Dropping pages with foreign keys on would cascade into every table
+-- that references it, so foreign keys are turned off for the connection
+-- first; that is not possible inside a transaction, hence NO TRANSACTION and
+-- the explicit BEGIN/COMMIT. Triggers of other tables read pages, so the
+-- rename runs in legacy mode, which does not check them against the schema
+-- while pages is missing.
+PRAGMA foreign_keys = OFF;
+PRAGMA legacy_alter_table = ON;
+
+BEGIN;
+
+-- Refuse to go on if foreign keys are still on for this connection.
+CREATE TEMP TABLE foreign_keys_off (enabled INTEGER CHECK (enabled = 0));
+INSERT INTO foreign_keys_off SELECT foreign_keys FROM pragma_foreign_keys;
+DROP TABLE foreign_keys_off;
+
+CREATE TABLE pages_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    title TEXT NOT NULL,
+    slug TEXT NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'draft',
+    author_id INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at DATETIME,
+    featured_image_id INTEGER REFERENCES media(id) ON DELETE SET NULL,
+    meta_title TEXT NOT NULL DEFAULT '',
+    meta_description TEXT NOT NULL DEFAULT '',
+    meta_keywords TEXT NOT NULL DEFAULT '',
+    og_image_id INTEGER REFERENCES media(id) ON DELETE SET NULL,
+    no_index INTEGER NOT NULL DEFAULT 0,
+    no_follow INTEGER NOT NULL DEFAULT 0,
+    canonical_url TEXT NOT NULL DEFAULT '',
+    scheduled_at DATETIME,
+    language_code TEXT NOT NULL,
+    hide_featured_image INTEGER NOT NULL DEFAULT 0,
+    page_type TEXT NOT NULL DEFAULT 'post',
+    exclude_from_lists INTEGER NOT NULL DEFAULT 0,
+    summary TEXT NOT NULL DEFAULT '',
+    video_url TEXT NOT NULL DEFAULT '',
+    video_title TEXT NOT NULL DEFAULT '',
+    site_id INTEGER NOT NULL DEFAULT 1,
+    FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE RESTRICT
+);
+
+INSERT INTO pages_new (id, title, slug, body, status, author_id, created_at, updated_at, published_at,
+    featured_image_id, meta_title, meta_description, meta_keywords, og_image_id,
+    no_index, no_follow, canonical_url, scheduled_at, language_code,
+    hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id)
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at,
+    featured_image_id, meta_title, meta_description, meta_keywords, og_image_id,
+    no_index, no_follow, canonical_url, scheduled_at, language_code,
+    hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id
+FROM pages;
+
+DROP TABLE pages;
+ALTER TABLE pages_new RENAME TO pages;
+
+CREATE UNIQUE INDEX idx_pages_site_slug ON pages(site_id, slug);
+CREATE INDEX idx_pages_slug ON pages(slug);
+CREATE INDEX idx_pages_status ON pages(status);
+CREATE INDEX idx_pages_author_id ON pages(author_id);
+CREATE INDEX idx_pages_created_at ON pages(created_at);
+CREATE INDEX idx_pages_featured_image ON pages(featured_image_id);
+CREATE INDEX idx_pages_scheduled ON pages(scheduled_at) WHERE scheduled_at IS NOT NULL AND status = 'draft';
+CREATE INDEX idx_pages_language_code ON pages(language_code);
+CREATE INDEX idx_pages_scheduled_status ON pages(scheduled_at, status) WHERE scheduled_at IS NOT NULL;
+CREATE INDEX idx_pages_published_at ON pages(published_at) WHERE status = 'published';
+CREATE INDEX idx_pages_language_status ON pages(language_code, status);
+CREATE INDEX idx_pages_updated_at ON pages(updated_at);
+CREATE INDEX idx_pages_page_type ON pages(page_type);
+CREATE INDEX idx_pages_site_id ON pages(site_id);
+
+-- +goose StatementBegin
+CREATE TRIGGER pages_fts_ai AFTER INSERT ON pages
+WHEN NEW.status = 'published'
+BEGIN
+    INSERT INTO pages_fts(rowid, title, body, meta_title, meta_description, meta_keywords)
+    VALUES(NEW.id, NEW.title, NEW.body, NEW.meta_title, NEW.meta_description, NEW.meta_keywords);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER pages_fts_bd BEFORE DELETE ON pages BEGIN
+    DELETE FROM pages_fts WHERE rowid = OLD.id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER pages_fts_au AFTER UPDATE ON pages BEGIN
+    DELETE FROM pages_fts WHERE rowid = OLD.id;
+    INSERT INTO pages_fts(rowid, title, body, meta_title, meta_description, meta_keywords)
+    SELECT NEW.id, NEW.title, NEW.body, NEW.meta_title, NEW.meta_description, NEW.meta_keywords
+    WHERE NEW.status = 'published';
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER machine_translations_page_ad AFTER DELETE ON pages
+BEGIN
+    DELETE FROM machine_translations WHERE entity_type = 'page' AND entity_id = OLD.id;
+END;
+-- +goose StatementEnd
+
+-- An alias belongs to the site of its page.
+ALTER TABLE page_aliases ADD COLUMN site_id INTEGER NOT NULL DEFAULT 1;
+UPDATE page_aliases SET site_id = (SELECT site_id FROM pages WHERE pages.id = page_aliases.page_id);
+DROP INDEX IF EXISTS idx_page_aliases_alias;
+CREATE UNIQUE INDEX idx_page_aliases_alias ON page_aliases(site_id, alias);
+
+COMMIT;
+
+PRAGMA legacy_alter_table = OFF;
+PRAGMA foreign_keys = ON;
+
+-- +goose Down
+-- Fails while two sites share a slug or an alias.
+PRAGMA foreign_keys = OFF;
+PRAGMA legacy_alter_table = ON;
+
+BEGIN;
+
+CREATE TEMP TABLE foreign_keys_off (enabled INTEGER CHECK (enabled = 0));
+INSERT INTO foreign_keys_off SELECT foreign_keys FROM pragma_foreign_keys;
+DROP TABLE foreign_keys_off;
+
+DROP INDEX IF EXISTS idx_page_aliases_alias;
+CREATE UNIQUE INDEX idx_page_aliases_alias ON page_aliases(alias);
+ALTER TABLE page_aliases DROP COLUMN site_id;
+
+CREATE TABLE pages_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    title TEXT NOT NULL,
+    slug TEXT NOT NULL UNIQUE,
+    body TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'draft',
+    author_id INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at DATETIME,
+    featured_image_id INTEGER REFERENCES media(id) ON DELETE SET NULL,
+    meta_title TEXT NOT NULL DEFAULT '',
+    meta_description TEXT NOT NULL DEFAULT '',
+    meta_keywords TEXT NOT NULL DEFAULT '',
+    og_image_id INTEGER REFERENCES media(id) ON DELETE SET NULL,
+    no_index INTEGER NOT NULL DEFAULT 0,
+    no_follow INTEGER NOT NULL DEFAULT 0,
+    canonical_url TEXT NOT NULL DEFAULT '',
+    scheduled_at DATETIME,
+    language_code TEXT NOT NULL,
+    hide_featured_image INTEGER NOT NULL DEFAULT 0,
+    page_type TEXT NOT NULL DEFAULT 'post',
+    exclude_from_lists INTEGER NOT NULL DEFAULT 0,
+    summary TEXT NOT NULL DEFAULT '',
+    video_url TEXT NOT NULL DEFAULT '',
+    video_title TEXT NOT NULL DEFAULT '',
+    site_id INTEGER NOT NULL DEFAULT 1,
+    FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE RESTRICT
+);
+
+INSERT INTO pages_new (id, title, slug, body, status, author_id, created_at, updated_at, published_at,
+    featured_image_id, meta_title, meta_description, meta_keywords, og_image_id,
+    no_index, no_follow, canonical_url, scheduled_at, language_code,
+    hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id)
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at,
+    featured_image_id, meta_title, meta_description, meta_keywords, og_image_id,
+    no_index, no_follow, canonical_url, scheduled_at, language_code,
+    hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id
+FROM pages;
+
+DROP TABLE pages;
+ALTER TABLE pages_new RENAME TO pages;
+
+CREATE INDEX idx_pages_slug ON pages(slug);
+CREATE INDEX idx_pages_status ON pages(status);
+CREATE INDEX idx_pages_author_id ON pages(author_id);
+CREATE INDEX idx_pages_created_at ON pages(created_at);
+CREATE INDEX idx_pages_featured_image ON pages(featured_image_id);
+CREATE INDEX idx_pages_scheduled ON pages(scheduled_at) WHERE scheduled_at IS NOT NULL AND status = 'draft';
+CREATE INDEX idx_pages_language_code ON pages(language_code);
+CREATE INDEX idx_pages_scheduled_status ON pages(scheduled_at, status) WHERE scheduled_at IS NOT NULL;
+CREATE INDEX idx_pages_published_at ON pages(published_at) WHERE status = 'published';
+CREATE INDEX idx_pages_language_status ON pages(language_code, status);
+CREATE INDEX idx_pages_updated_at ON pages(updated_at);
+CREATE INDEX idx_pages_page_type ON pages(page_type);
+CREATE INDEX idx_pages_site_id ON pages(site_id);
+
+-- +goose StatementBegin
+CREATE TRIGGER pages_fts_ai AFTER INSERT ON pages
+WHEN NEW.status = 'published'
+BEGIN
+    INSERT INTO pages_fts(rowid, title, body, meta_title, meta_description, meta_keywords)
+    VALUES(NEW.id, NEW.title, NEW.body, NEW.meta_title, NEW.meta_description, NEW.meta_keywords);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER pages_fts_bd BEFORE DELETE ON pages BEGIN
+    DELETE FROM pages_fts WHERE rowid = OLD.id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER pages_fts_au AFTER UPDATE ON pages BEGIN
+    DELETE FROM pages_fts WHERE rowid = OLD.id;
+    INSERT INTO pages_fts(rowid, title, body, meta_title, meta_description, meta_keywords)
+    SELECT NEW.id, NEW.title, NEW.body, NEW.meta_title, NEW.meta_description, NEW.meta_keywords
+    WHERE NEW.status = 'published';
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER machine_translations_page_ad AFTER DELETE ON pages
+BEGIN
+    DELETE FROM machine_translations WHERE entity_type = 'page' AND entity_id = OLD.id;
+END;
+-- +goose StatementEnd
+
+COMMIT;
+
+PRAGMA legacy_alter_table = OFF;
+PRAGMA foreign_keys = ON;
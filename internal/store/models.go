@@ -49,6 +49,21 @@ type Category struct {
 	LanguageCode string         `json:"language_code"`
 }
 
+type Comment struct {
+	ID          int64         `json:"id"`
+	PageID      int64         `json:"page_id"`
+	ParentID    sql.NullInt64 `json:"parent_id"`
+	UserID      sql.NullInt64 `json:"user_id"`
+	AuthorName  string        `json:"author_name"`
+	AuthorEmail string        `json:"author_email"`
+	Body        string        `json:"body"`
+	Status      string        `json:"status"`
+	IpAddress   string        `json:"ip_address"`
+	UserAgent   string        `json:"user_agent"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
 type Config struct {
 	Key          string        `json:"key"`
 	Value        string        `json:"value"`
@@ -68,6 +83,17 @@ type ConfigTranslation struct {
 	UpdatedBy  sql.NullInt64 `json:"updated_by"`
 }
 
+type ContentType struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description"`
+	Fields      string    `json:"fields"`
+	Template    string    `json:"template"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
 type Event struct {
 	ID         int64         `json:"id"`
 	Level      string        `json:"level"`
@@ -122,6 +148,24 @@ type FormSubmission struct {
 	CreatedAt    time.Time      `json:"created_at"`
 }
 
+type InboundWebhook struct {
+	ID              int64        `json:"id"`
+	Name            string       `json:"name"`
+	Token           string       `json:"token"`
+	Secret          string       `json:"secret"`
+	Action          string       `json:"action"`
+	JobSource       string       `json:"job_source"`
+	JobName         string       `json:"job_name"`
+	IsActive        bool         `json:"is_active"`
+	TriggerCount    int64        `json:"trigger_count"`
+	LastTriggeredAt sql.NullTime `json:"last_triggered_at"`
+	LastStatus      string       `json:"last_status"`
+	LastError       string       `json:"last_error"`
+	CreatedBy       int64        `json:"created_by"`
+	CreatedAt       time.Time    `json:"created_at"`
+	UpdatedAt       time.Time    `json:"updated_at"`
+}
+
 type Language struct {
 	ID         int64     `json:"id"`
 	Code       string    `json:"code"`
@@ -133,6 +177,22 @@ type Language struct {
 	Position   int64     `json:"position"`
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
+	Host       string    `json:"host"`
+}
+
+type LinkCheck struct {
+	ID          int64        `json:"id"`
+	SourceType  string       `json:"source_type"`
+	SourceID    int64        `json:"source_id"`
+	SourceTitle string       `json:"source_title"`
+	Url         string       `json:"url"`
+	AnchorText  string       `json:"anchor_text"`
+	IsExternal  bool         `json:"is_external"`
+	Status      string       `json:"status"`
+	StatusCode  int64        `json:"status_code"`
+	Error       string       `json:"error"`
+	BrokenSince sql.NullTime `json:"broken_since"`
+	CheckedAt   time.Time    `json:"checked_at"`
 }
 
 type LoginProtection struct {
@@ -144,6 +204,13 @@ type LoginProtection struct {
 	UpdatedAt     time.Time    `json:"updated_at"`
 }
 
+type MachineTranslation struct {
+	EntityType string    `json:"entity_type"`
+	EntityID   int64     `json:"entity_id"`
+	Provider   string    `json:"provider"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
 type MediaFolder struct {
 	ID        int64         `json:"id"`
 	Name      string        `json:"name"`
@@ -187,6 +254,7 @@ type Medium struct {
 	LanguageCode string         `json:"language_code"`
 	CreatedAt    time.Time      `json:"created_at"`
 	UpdatedAt    time.Time      `json:"updated_at"`
+	SiteID       int64          `json:"site_id"`
 }
 
 type Menu struct {
@@ -196,21 +264,29 @@ type Menu struct {
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 	LanguageCode string    `json:"language_code"`
+	SiteID       int64     `json:"site_id"`
 }
 
 type MenuItem struct {
-	ID        int64          `json:"id"`
-	MenuID    int64          `json:"menu_id"`
-	ParentID  sql.NullInt64  `json:"parent_id"`
-	Title     string         `json:"title"`
-	Url       sql.NullString `json:"url"`
-	Target    sql.NullString `json:"target"`
-	PageID    sql.NullInt64  `json:"page_id"`
-	Position  int64          `json:"position"`
-	CssClass  sql.NullString `json:"css_class"`
-	IsActive  bool           `json:"is_active"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
+	ID               int64          `json:"id"`
+	MenuID           int64          `json:"menu_id"`
+	ParentID         sql.NullInt64  `json:"parent_id"`
+	Title            string         `json:"title"`
+	Url              sql.NullString `json:"url"`
+	Target           sql.NullString `json:"target"`
+	PageID           sql.NullInt64  `json:"page_id"`
+	Position         int64          `json:"position"`
+	CssClass         sql.NullString `json:"css_class"`
+	IsActive         bool           `json:"is_active"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	ItemType         string         `json:"item_type"`
+	LinkID           sql.NullInt64  `json:"link_id"`
+	Visibility       string         `json:"visibility"`
+	VisibleRoles     string         `json:"visible_roles"`
+	VisibleLanguages string         `json:"visible_languages"`
+	MegaMenu         bool           `json:"mega_menu"`
+	ImageUrl         string         `json:"image_url"`
 }
 
 type Module struct {
@@ -226,6 +302,15 @@ type ModuleMigration struct {
 	AppliedAt time.Time `json:"applied_at"`
 }
 
+type NotFoundLog struct {
+	ID          int64     `json:"id"`
+	Path        string    `json:"path"`
+	Referrer    string    `json:"referrer"`
+	HitCount    int64     `json:"hit_count"`
+	FirstSeenAt time.Time `json:"first_seen_at"`
+	LastSeenAt  time.Time `json:"last_seen_at"`
+}
+
 type Page struct {
 	ID                int64         `json:"id"`
 	Title             string        `json:"title"`
@@ -252,6 +337,13 @@ type Page struct {
 	Summary           string        `json:"summary"`
 	VideoUrl          string        `json:"video_url"`
 	VideoTitle        string        `json:"video_title"`
+	SiteID            int64         `json:"site_id"`
+}
+
+type PageAccess struct {
+	PageID int64  `json:"page_id"`
+	Access string `json:"access"`
+	Roles  string `json:"roles"`
 }
 
 type PageAlias struct {
@@ -259,6 +351,13 @@ type PageAlias struct {
 	PageID    int64     `json:"page_id"`
 	Alias     string    `json:"alias"`
 	CreatedAt time.Time `json:"created_at"`
+	SiteID    int64     `json:"site_id"`
+}
+
+type PageBlock struct {
+	PageID    int64     `json:"page_id"`
+	Data      string    `json:"data"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type PageCategory struct {
@@ -266,18 +365,72 @@ type PageCategory struct {
 	CategoryID int64 `json:"category_id"`
 }
 
+type PageCommentSetting struct {
+	PageID  int64 `json:"page_id"`
+	Enabled bool  `json:"enabled"`
+}
+
+type PageContentField struct {
+	PageID    int64     `json:"page_id"`
+	Data      string    `json:"data"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type PageCustomField struct {
+	PageID    int64     `json:"page_id"`
+	Data      string    `json:"data"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type PageHierarchy struct {
+	PageID   int64         `json:"page_id"`
+	ParentID sql.NullInt64 `json:"parent_id"`
+	Position int64         `json:"position"`
+}
+
+type PageRelated struct {
+	PageID        int64 `json:"page_id"`
+	RelatedPageID int64 `json:"related_page_id"`
+	Position      int64 `json:"position"`
+}
+
+type PageSeries struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Slug         string    `json:"slug"`
+	Description  string    `json:"description"`
+	LanguageCode string    `json:"language_code"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type PageSeriesItem struct {
+	PageID   int64 `json:"page_id"`
+	SeriesID int64 `json:"series_id"`
+	Position int64 `json:"position"`
+}
+
 type PageTag struct {
 	PageID int64 `json:"page_id"`
 	TagID  int64 `json:"tag_id"`
 }
 
-type PageVersion struct {
+type PageUrlHistory struct {
 	ID        int64     `json:"id"`
 	PageID    int64     `json:"page_id"`
-	Title     string    `json:"title"`
-	Body      string    `json:"body"`
-	ChangedBy int64     `json:"changed_by"`
-	CreatedAt time.Time `json:"created_at"`
+	Path      string    `json:"path"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+type PageVersion struct {
+	ID           int64     `json:"id"`
+	PageID       int64     `json:"page_id"`
+	Title        string    `json:"title"`
+	Body         string    `json:"body"`
+	ChangedBy    int64     `json:"changed_by"`
+	CreatedAt    time.Time `json:"created_at"`
+	CustomFields string    `json:"custom_fields"`
+	Blocks       string    `json:"blocks"`
 }
 
 type PagesFt struct {
@@ -289,27 +442,36 @@ type PagesFt struct {
 }
 
 type Redirect struct {
-	ID         int64     `json:"id"`
-	SourcePath string    `json:"source_path"`
-	TargetUrl  string    `json:"target_url"`
-	StatusCode int64     `json:"status_code"`
-	IsWildcard bool      `json:"is_wildcard"`
-	TargetType string    `json:"target_type"`
-	Enabled    bool      `json:"enabled"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	ID         int64        `json:"id"`
+	SourcePath string       `json:"source_path"`
+	TargetUrl  string       `json:"target_url"`
+	StatusCode int64        `json:"status_code"`
+	IsWildcard bool         `json:"is_wildcard"`
+	TargetType string       `json:"target_type"`
+	Enabled    bool         `json:"enabled"`
+	CreatedAt  time.Time    `json:"created_at"`
+	UpdatedAt  time.Time    `json:"updated_at"`
+	IsRegex    bool         `json:"is_regex"`
+	DropQuery  bool         `json:"drop_query"`
+	HitCount   int64        `json:"hit_count"`
+	LastHitAt  sql.NullTime `json:"last_hit_at"`
 }
 
 type ScheduledTask struct {
-	ID             int64         `json:"id"`
-	Name           string        `json:"name"`
-	Url            string        `json:"url"`
-	Schedule       string        `json:"schedule"`
-	IsActive       int64         `json:"is_active"`
-	TimeoutSeconds int64         `json:"timeout_seconds"`
-	CreatedBy      sql.NullInt64 `json:"created_by"`
-	CreatedAt      time.Time     `json:"created_at"`
-	UpdatedAt      time.Time     `json:"updated_at"`
+	ID                int64         `json:"id"`
+	Name              string        `json:"name"`
+	Url               string        `json:"url"`
+	Schedule          string        `json:"schedule"`
+	IsActive          int64         `json:"is_active"`
+	TimeoutSeconds    int64         `json:"timeout_seconds"`
+	CreatedBy         sql.NullInt64 `json:"created_by"`
+	CreatedAt         time.Time     `json:"created_at"`
+	UpdatedAt         time.Time     `json:"updated_at"`
+	TaskType          string        `json:"task_type"`
+	Params            string        `json:"params"`
+	RetryPolicy       string        `json:"retry_policy"`
+	MaxRetries        int64         `json:"max_retries"`
+	RetryDelaySeconds int64         `json:"retry_delay_seconds"`
 }
 
 type ScheduledTaskRun struct {
@@ -322,6 +484,8 @@ type ScheduledTaskRun struct {
 	DurationMs   sql.NullInt64  `json:"duration_ms"`
 	StartedAt    time.Time      `json:"started_at"`
 	CompletedAt  sql.NullTime   `json:"completed_at"`
+	Attempt      int64          `json:"attempt"`
+	Log          string         `json:"log"`
 }
 
 type SchedulerOverride struct {
@@ -337,6 +501,33 @@ type Session struct {
 	Expiry time.Time `json:"expiry"`
 }
 
+type Site struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Host      string    `json:"host"`
+	Theme     string    `json:"theme"`
+	IsDefault bool      `json:"is_default"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type SiteConfig struct {
+	SiteID    int64     `json:"site_id"`
+	Key       string    `json:"key"`
+	Value     string    `json:"value"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Snippet struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Slug         string    `json:"slug"`
+	Body         string    `json:"body"`
+	LanguageCode string    `json:"language_code"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
 type Tag struct {
 	ID           int64     `json:"id"`
 	Name         string    `json:"name"`
@@ -347,12 +538,14 @@ type Tag struct {
 }
 
 type Translation struct {
-	ID            int64     `json:"id"`
-	EntityType    string    `json:"entity_type"`
-	EntityID      int64     `json:"entity_id"`
-	LanguageID    int64     `json:"language_id"`
-	TranslationID int64     `json:"translation_id"`
-	CreatedAt     time.Time `json:"created_at"`
+	ID              int64         `json:"id"`
+	EntityType      string        `json:"entity_type"`
+	EntityID        int64         `json:"entity_id"`
+	LanguageID      int64         `json:"language_id"`
+	TranslationID   int64         `json:"translation_id"`
+	CreatedAt       time.Time     `json:"created_at"`
+	SourceVersionID sql.NullInt64 `json:"source_version_id"`
+	SourceUpdatedAt sql.NullTime  `json:"source_updated_at"`
 }
 
 type User struct {
@@ -373,17 +566,27 @@ type User struct {
 	SessionVersion int64        `json:"session_version"`
 }
 
+type UserSite struct {
+	UserID int64 `json:"user_id"`
+	SiteID int64 `json:"site_id"`
+}
+
 type Webhook struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	Url       string    `json:"url"`
-	Secret    string    `json:"secret"`
-	Events    string    `json:"events"`
-	IsActive  bool      `json:"is_active"`
-	Headers   string    `json:"headers"`
-	CreatedBy int64     `json:"created_by"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID                  int64        `json:"id"`
+	Name                string       `json:"name"`
+	Url                 string       `json:"url"`
+	Secret              string       `json:"secret"`
+	Events              string       `json:"events"`
+	IsActive            bool         `json:"is_active"`
+	Headers             string       `json:"headers"`
+	CreatedBy           int64        `json:"created_by"`
+	CreatedAt           time.Time    `json:"created_at"`
+	UpdatedAt           time.Time    `json:"updated_at"`
+	PayloadFormat       string       `json:"payload_format"`
+	PayloadTemplate     string       `json:"payload_template"`
+	ConsecutiveFailures int64        `json:"consecutive_failures"`
+	PausedAt            sql.NullTime `json:"paused_at"`
+	PauseReason         string       `json:"pause_reason"`
 }
 
 type WebhookDelivery struct {
@@ -415,4 +618,10 @@ type Widget struct {
 	LanguageCode string         `json:"language_code"`
 	CreatedAt    time.Time      `json:"created_at"`
 	UpdatedAt    time.Time      `json:"updated_at"`
+	SiteID       int64          `json:"site_id"`
+}
+
+type WidgetVisibility struct {
+	WidgetID int64  `json:"widget_id"`
+	Rules    string `json:"rules"`
 }
@@ -11,27 +11,33 @@ import (
 )
 
 const aliasExists = `-- name: AliasExists :one
-SELECT EXISTS(SELECT 1 FROM page_aliases WHERE alias = ?)
+SELECT EXISTS(SELECT 1 FROM page_aliases WHERE alias = ? AND site_id = ?)
 `
 
-func (q *Queries) AliasExists(ctx context.Context, alias string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, aliasExists, alias)
+type AliasExistsParams struct {
+	Alias  string `json:"alias"`
+	SiteID int64  `json:"site_id"`
+}
+
+func (q *Queries) AliasExists(ctx context.Context, arg AliasExistsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, aliasExists, arg.Alias, arg.SiteID)
 	var column_1 int64
 	err := row.Scan(&column_1)
 	return column_1, err
 }
 
 const aliasExistsExcludingPage = `-- name: AliasExistsExcludingPage :one
-SELECT EXISTS(SELECT 1 FROM page_aliases WHERE alias = ? AND page_id != ?)
+SELECT EXISTS(SELECT 1 FROM page_aliases WHERE alias = ? AND page_id != ? AND site_id = ?)
 `
 
 type AliasExistsExcludingPageParams struct {
 	Alias  string `json:"alias"`
 	PageID int64  `json:"page_id"`
+	SiteID int64  `json:"site_id"`
 }
 
 func (q *Queries) AliasExistsExcludingPage(ctx context.Context, arg AliasExistsExcludingPageParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, aliasExistsExcludingPage, arg.Alias, arg.PageID)
+	row := q.db.QueryRowContext(ctx, aliasExistsExcludingPage, arg.Alias, arg.PageID, arg.SiteID)
 	var column_1 int64
 	err := row.Scan(&column_1)
 	return column_1, err
@@ -48,9 +54,9 @@ func (q *Queries) ClearPageAliases(ctx context.Context, pageID int64) error {
 
 const createPageAlias = `-- name: CreatePageAlias :one
 
-INSERT INTO page_aliases (page_id, alias, created_at)
-VALUES (?, ?, ?)
-RETURNING id, page_id, alias, created_at
+INSERT INTO page_aliases (page_id, alias, created_at, site_id)
+VALUES (?1, ?2, ?3, (SELECT site_id FROM pages WHERE id = ?1))
+RETURNING id, page_id, alias, created_at, site_id
 `
 
 type CreatePageAliasParams struct {
@@ -60,6 +66,7 @@ type CreatePageAliasParams struct {
 }
 
 // Page Alias queries
+// An alias belongs to the site of its page.
 func (q *Queries) CreatePageAlias(ctx context.Context, arg CreatePageAliasParams) (PageAlias, error) {
 	row := q.db.QueryRowContext(ctx, createPageAlias, arg.PageID, arg.Alias, arg.CreatedAt)
 	var i PageAlias
@@ -68,6 +75,7 @@ func (q *Queries) CreatePageAlias(ctx context.Context, arg CreatePageAliasParams
 		&i.PageID,
 		&i.Alias,
 		&i.CreatedAt,
+		&i.SiteID,
 	)
 	return i, err
 }
@@ -82,7 +90,7 @@ func (q *Queries) DeletePageAlias(ctx context.Context, id int64) error {
 }
 
 const getAliasesForPage = `-- name: GetAliasesForPage :many
-SELECT id, page_id, alias, created_at FROM page_aliases WHERE page_id = ? ORDER BY created_at
+SELECT id, page_id, alias, created_at, site_id FROM page_aliases WHERE page_id = ? ORDER BY created_at
 `
 
 func (q *Queries) GetAliasesForPage(ctx context.Context, pageID int64) ([]PageAlias, error) {
@@ -99,6 +107,7 @@ func (q *Queries) GetAliasesForPage(ctx context.Context, pageID int64) ([]PageAl
 			&i.PageID,
 			&i.Alias,
 			&i.CreatedAt,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -114,13 +123,18 @@ func (q *Queries) GetAliasesForPage(ctx context.Context, pageID int64) ([]PageAl
 }
 
 const getPageByAlias = `-- name: GetPageByAlias :one
-SELECT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title FROM pages p
+SELECT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title, p.site_id FROM pages p
 INNER JOIN page_aliases pa ON pa.page_id = p.id
-WHERE pa.alias = ?
+WHERE pa.alias = ? AND pa.site_id = ?
 `
 
-func (q *Queries) GetPageByAlias(ctx context.Context, alias string) (Page, error) {
-	row := q.db.QueryRowContext(ctx, getPageByAlias, alias)
+type GetPageByAliasParams struct {
+	Alias  string `json:"alias"`
+	SiteID int64  `json:"site_id"`
+}
+
+func (q *Queries) GetPageByAlias(ctx context.Context, arg GetPageByAliasParams) (Page, error) {
+	row := q.db.QueryRowContext(ctx, getPageByAlias, arg.Alias, arg.SiteID)
 	var i Page
 	err := row.Scan(
 		&i.ID,
@@ -148,18 +162,24 @@ func (q *Queries) GetPageByAlias(ctx context.Context, alias string) (Page, error
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const getPublishedPageByAlias = `-- name: GetPublishedPageByAlias :one
-SELECT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title FROM pages p
+SELECT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title, p.site_id FROM pages p
 INNER JOIN page_aliases pa ON pa.page_id = p.id
-WHERE pa.alias = ? AND p.status = 'published'
+WHERE pa.alias = ? AND pa.site_id = ? AND p.status = 'published'
 `
 
-func (q *Queries) GetPublishedPageByAlias(ctx context.Context, alias string) (Page, error) {
-	row := q.db.QueryRowContext(ctx, getPublishedPageByAlias, alias)
+type GetPublishedPageByAliasParams struct {
+	Alias  string `json:"alias"`
+	SiteID int64  `json:"site_id"`
+}
+
+func (q *Queries) GetPublishedPageByAlias(ctx context.Context, arg GetPublishedPageByAliasParams) (Page, error) {
+	row := q.db.QueryRowContext(ctx, getPublishedPageByAlias, arg.Alias, arg.SiteID)
 	var i Page
 	err := row.Scan(
 		&i.ID,
@@ -187,6 +207,7 @@ func (q *Queries) GetPublishedPageByAlias(ctx context.Context, alias string) (Pa
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 	)
 	return i, err
 }
@@ -0,0 +1,27 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package store
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+// GetPagePath returns the URL path of a page below the language prefix: the
+// slugs of its ancestors and its own slug joined by "/".
+func (q *Queries) GetPagePath(ctx context.Context, pageID int64) (string, error) {
+	lineage, err := q.ListPageAncestors(ctx, pageID)
+	if err != nil {
+		return "", err
+	}
+	if len(lineage) == 0 {
+		return "", sql.ErrNoRows
+	}
+	slugs := make([]string, len(lineage))
+	for i, p := range lineage {
+		slugs[i] = p.Slug
+	}
+	return strings.Join(slugs, "/"), nil
+}
@@ -61,6 +61,24 @@ func (q *Queries) CountPages(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const countPagesByCategoryAndSite = `-- name: CountPagesByCategoryAndSite :one
+SELECT COUNT(DISTINCT p.id) FROM pages p
+INNER JOIN page_categories pc ON pc.page_id = p.id
+WHERE pc.category_id = ? AND p.site_id = ?
+`
+
+type CountPagesByCategoryAndSiteParams struct {
+	CategoryID int64 `json:"category_id"`
+	SiteID     int64 `json:"site_id"`
+}
+
+func (q *Queries) CountPagesByCategoryAndSite(ctx context.Context, arg CountPagesByCategoryAndSiteParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPagesByCategoryAndSite, arg.CategoryID, arg.SiteID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countPagesByLanguageAndStatus = `-- name: CountPagesByLanguageAndStatus :one
 SELECT COUNT(*) FROM pages WHERE language_code = ? AND status = ?
 `
@@ -88,6 +106,17 @@ func (q *Queries) CountPagesByLanguageCode(ctx context.Context, languageCode str
 	return count, err
 }
 
+const countPagesBySite = `-- name: CountPagesBySite :one
+SELECT COUNT(*) FROM pages WHERE site_id = ?
+`
+
+func (q *Queries) CountPagesBySite(ctx context.Context, siteID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPagesBySite, siteID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countPagesByStatus = `-- name: CountPagesByStatus :one
 SELECT COUNT(*) FROM pages WHERE status = ?
 `
@@ -99,6 +128,40 @@ func (q *Queries) CountPagesByStatus(ctx context.Context, status string) (int64,
 	return count, err
 }
 
+const countPagesByStatusAndSite = `-- name: CountPagesByStatusAndSite :one
+SELECT COUNT(*) FROM pages WHERE status = ? AND site_id = ?
+`
+
+type CountPagesByStatusAndSiteParams struct {
+	Status string `json:"status"`
+	SiteID int64  `json:"site_id"`
+}
+
+func (q *Queries) CountPagesByStatusAndSite(ctx context.Context, arg CountPagesByStatusAndSiteParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPagesByStatusAndSite, arg.Status, arg.SiteID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPagesForTagAndSite = `-- name: CountPagesForTagAndSite :one
+SELECT COUNT(*) FROM pages p
+INNER JOIN page_tags pt ON pt.page_id = p.id
+WHERE pt.tag_id = ? AND p.site_id = ?
+`
+
+type CountPagesForTagAndSiteParams struct {
+	TagID  int64 `json:"tag_id"`
+	SiteID int64 `json:"site_id"`
+}
+
+func (q *Queries) CountPagesForTagAndSite(ctx context.Context, arg CountPagesForTagAndSiteParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPagesForTagAndSite, arg.TagID, arg.SiteID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countPublishedPages = `-- name: CountPublishedPages :one
 SELECT COUNT(*) FROM pages WHERE status = 'published'
 `
@@ -114,11 +177,16 @@ const countPublishedPagesByCategory = `-- name: CountPublishedPagesByCategory :o
 SELECT COUNT(DISTINCT p.id) FROM pages p
 INNER JOIN page_categories pc ON pc.page_id = p.id
 WHERE pc.category_id = ? AND p.status = 'published'
-  AND p.exclude_from_lists = 0
+  AND p.exclude_from_lists = 0 AND p.site_id = ?
 `
 
-func (q *Queries) CountPublishedPagesByCategory(ctx context.Context, categoryID int64) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countPublishedPagesByCategory, categoryID)
+type CountPublishedPagesByCategoryParams struct {
+	CategoryID int64 `json:"category_id"`
+	SiteID     int64 `json:"site_id"`
+}
+
+func (q *Queries) CountPublishedPagesByCategory(ctx context.Context, arg CountPublishedPagesByCategoryParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPublishedPagesByCategory, arg.CategoryID, arg.SiteID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -128,16 +196,17 @@ const countPublishedPagesByCategoryAndLanguage = `-- name: CountPublishedPagesBy
 SELECT COUNT(DISTINCT p.id) FROM pages p
 INNER JOIN page_categories pc ON pc.page_id = p.id
 WHERE pc.category_id = ? AND p.status = 'published' AND p.language_code = ?
-  AND p.exclude_from_lists = 0
+  AND p.exclude_from_lists = 0 AND p.site_id = ?
 `
 
 type CountPublishedPagesByCategoryAndLanguageParams struct {
 	CategoryID   int64  `json:"category_id"`
 	LanguageCode string `json:"language_code"`
+	SiteID       int64  `json:"site_id"`
 }
 
 func (q *Queries) CountPublishedPagesByCategoryAndLanguage(ctx context.Context, arg CountPublishedPagesByCategoryAndLanguageParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countPublishedPagesByCategoryAndLanguage, arg.CategoryID, arg.LanguageCode)
+	row := q.db.QueryRowContext(ctx, countPublishedPagesByCategoryAndLanguage, arg.CategoryID, arg.LanguageCode, arg.SiteID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -147,11 +216,16 @@ const countPublishedPagesForTag = `-- name: CountPublishedPagesForTag :one
 SELECT COUNT(*) FROM pages p
 INNER JOIN page_tags pt ON pt.page_id = p.id
 WHERE pt.tag_id = ? AND p.status = 'published'
-  AND p.exclude_from_lists = 0
+  AND p.exclude_from_lists = 0 AND p.site_id = ?
 `
 
-func (q *Queries) CountPublishedPagesForTag(ctx context.Context, tagID int64) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countPublishedPagesForTag, tagID)
+type CountPublishedPagesForTagParams struct {
+	TagID  int64 `json:"tag_id"`
+	SiteID int64 `json:"site_id"`
+}
+
+func (q *Queries) CountPublishedPagesForTag(ctx context.Context, arg CountPublishedPagesForTagParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPublishedPagesForTag, arg.TagID, arg.SiteID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -161,27 +235,28 @@ const countPublishedPagesForTagAndLanguage = `-- name: CountPublishedPagesForTag
 SELECT COUNT(*) FROM pages p
 INNER JOIN page_tags pt ON pt.page_id = p.id
 WHERE pt.tag_id = ? AND p.status = 'published' AND p.language_code = ?
-  AND p.exclude_from_lists = 0
+  AND p.exclude_from_lists = 0 AND p.site_id = ?
 `
 
 type CountPublishedPagesForTagAndLanguageParams struct {
 	TagID        int64  `json:"tag_id"`
 	LanguageCode string `json:"language_code"`
+	SiteID       int64  `json:"site_id"`
 }
 
 func (q *Queries) CountPublishedPagesForTagAndLanguage(ctx context.Context, arg CountPublishedPagesForTagAndLanguageParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countPublishedPagesForTagAndLanguage, arg.TagID, arg.LanguageCode)
+	row := q.db.QueryRowContext(ctx, countPublishedPagesForTagAndLanguage, arg.TagID, arg.LanguageCode, arg.SiteID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const countPublishedPosts = `-- name: CountPublishedPosts :one
-SELECT COUNT(*) FROM pages WHERE status = 'published' AND page_type = 'post' AND exclude_from_lists = 0
+SELECT COUNT(*) FROM pages WHERE status = 'published' AND page_type = 'post' AND exclude_from_lists = 0 AND site_id = ?
 `
 
-func (q *Queries) CountPublishedPosts(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countPublishedPosts)
+func (q *Queries) CountPublishedPosts(ctx context.Context, siteID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPublishedPosts, siteID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -265,9 +340,9 @@ func (q *Queries) CountSearchPagesByStatus(ctx context.Context, arg CountSearchP
 }
 
 const createPage = `-- name: CreatePage :one
-INSERT INTO pages (title, slug, body, summary, status, author_id, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, published_at, created_at, updated_at, video_url, video_title)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title
+INSERT INTO pages (title, slug, body, summary, status, author_id, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, published_at, created_at, updated_at, video_url, video_title, site_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id
 `
 
 type CreatePageParams struct {
@@ -295,6 +370,7 @@ type CreatePageParams struct {
 	UpdatedAt         time.Time     `json:"updated_at"`
 	VideoUrl          string        `json:"video_url"`
 	VideoTitle        string        `json:"video_title"`
+	SiteID            int64         `json:"site_id"`
 }
 
 func (q *Queries) CreatePage(ctx context.Context, arg CreatePageParams) (Page, error) {
@@ -323,6 +399,7 @@ func (q *Queries) CreatePage(ctx context.Context, arg CreatePageParams) (Page, e
 		arg.UpdatedAt,
 		arg.VideoUrl,
 		arg.VideoTitle,
+		arg.SiteID,
 	)
 	var i Page
 	err := row.Scan(
@@ -351,23 +428,26 @@ func (q *Queries) CreatePage(ctx context.Context, arg CreatePageParams) (Page, e
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const createPageVersion = `-- name: CreatePageVersion :one
 
-INSERT INTO page_versions (page_id, title, body, changed_by, created_at)
-VALUES (?, ?, ?, ?, ?)
-RETURNING id, page_id, title, body, changed_by, created_at
+INSERT INTO page_versions (page_id, title, body, changed_by, created_at, custom_fields, blocks)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING id, page_id, title, body, changed_by, created_at, custom_fields, blocks
 `
 
 type CreatePageVersionParams struct {
-	PageID    int64     `json:"page_id"`
-	Title     string    `json:"title"`
-	Body      string    `json:"body"`
-	ChangedBy int64     `json:"changed_by"`
-	CreatedAt time.Time `json:"created_at"`
+	PageID       int64     `json:"page_id"`
+	Title        string    `json:"title"`
+	Body         string    `json:"body"`
+	ChangedBy    int64     `json:"changed_by"`
+	CreatedAt    time.Time `json:"created_at"`
+	CustomFields string    `json:"custom_fields"`
+	Blocks       string    `json:"blocks"`
 }
 
 // Page Version queries
@@ -378,6 +458,8 @@ func (q *Queries) CreatePageVersion(ctx context.Context, arg CreatePageVersionPa
 		arg.Body,
 		arg.ChangedBy,
 		arg.CreatedAt,
+		arg.CustomFields,
+		arg.Blocks,
 	)
 	var i PageVersion
 	err := row.Scan(
@@ -387,6 +469,8 @@ func (q *Queries) CreatePageVersion(ctx context.Context, arg CreatePageVersionPa
 		&i.Body,
 		&i.ChangedBy,
 		&i.CreatedAt,
+		&i.CustomFields,
+		&i.Blocks,
 	)
 	return i, err
 }
@@ -411,7 +495,7 @@ func (q *Queries) DeletePageVersions(ctx context.Context, pageID int64) error {
 
 const getFeaturedImageForPage = `-- name: GetFeaturedImageForPage :one
 
-SELECT m.id, m.uuid, m.filename, m.mime_type, m.size, m.width, m.height, m.alt, m.caption, m.folder_id, m.uploaded_by, m.language_code, m.created_at, m.updated_at FROM media m
+SELECT m.id, m.uuid, m.filename, m.mime_type, m.size, m.width, m.height, m.alt, m.caption, m.folder_id, m.uploaded_by, m.language_code, m.created_at, m.updated_at, m.site_id FROM media m
 INNER JOIN pages p ON p.featured_image_id = m.id
 WHERE p.id = ?
 `
@@ -435,12 +519,13 @@ func (q *Queries) GetFeaturedImageForPage(ctx context.Context, id int64) (Medium
 		&i.LanguageCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const getLatestPageVersion = `-- name: GetLatestPageVersion :one
-SELECT id, page_id, title, body, changed_by, created_at FROM page_versions WHERE page_id = ? ORDER BY created_at DESC LIMIT 1
+SELECT id, page_id, title, body, changed_by, created_at, custom_fields, blocks FROM page_versions WHERE page_id = ? ORDER BY created_at DESC LIMIT 1
 `
 
 func (q *Queries) GetLatestPageVersion(ctx context.Context, pageID int64) (PageVersion, error) {
@@ -453,13 +538,15 @@ func (q *Queries) GetLatestPageVersion(ctx context.Context, pageID int64) (PageV
 		&i.Body,
 		&i.ChangedBy,
 		&i.CreatedAt,
+		&i.CustomFields,
+		&i.Blocks,
 	)
 	return i, err
 }
 
 const getOGImageForPage = `-- name: GetOGImageForPage :one
 
-SELECT m.id, m.uuid, m.filename, m.mime_type, m.size, m.width, m.height, m.alt, m.caption, m.folder_id, m.uploaded_by, m.language_code, m.created_at, m.updated_at FROM media m
+SELECT m.id, m.uuid, m.filename, m.mime_type, m.size, m.width, m.height, m.alt, m.caption, m.folder_id, m.uploaded_by, m.language_code, m.created_at, m.updated_at, m.site_id FROM media m
 INNER JOIN pages p ON p.og_image_id = m.id
 WHERE p.id = ?
 `
@@ -483,6 +570,7 @@ func (q *Queries) GetOGImageForPage(ctx context.Context, id int64) (Medium, erro
 		&i.LanguageCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SiteID,
 	)
 	return i, err
 }
@@ -523,7 +611,7 @@ func (q *Queries) GetPageAuthor(ctx context.Context, id int64) (GetPageAuthorRow
 }
 
 const getPageByID = `-- name: GetPageByID :one
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages WHERE id = ?
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages WHERE id = ?
 `
 
 func (q *Queries) GetPageByID(ctx context.Context, id int64) (Page, error) {
@@ -555,16 +643,22 @@ func (q *Queries) GetPageByID(ctx context.Context, id int64) (Page, error) {
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const getPageBySlug = `-- name: GetPageBySlug :one
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages WHERE slug = ?
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages WHERE slug = ? AND site_id = ?
 `
 
-func (q *Queries) GetPageBySlug(ctx context.Context, slug string) (Page, error) {
-	row := q.db.QueryRowContext(ctx, getPageBySlug, slug)
+type GetPageBySlugParams struct {
+	Slug   string `json:"slug"`
+	SiteID int64  `json:"site_id"`
+}
+
+func (q *Queries) GetPageBySlug(ctx context.Context, arg GetPageBySlugParams) (Page, error) {
+	row := q.db.QueryRowContext(ctx, getPageBySlug, arg.Slug, arg.SiteID)
 	var i Page
 	err := row.Scan(
 		&i.ID,
@@ -592,12 +686,13 @@ func (q *Queries) GetPageBySlug(ctx context.Context, slug string) (Page, error)
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const getPageVersion = `-- name: GetPageVersion :one
-SELECT id, page_id, title, body, changed_by, created_at FROM page_versions WHERE id = ?
+SELECT id, page_id, title, body, changed_by, created_at, custom_fields, blocks FROM page_versions WHERE id = ?
 `
 
 func (q *Queries) GetPageVersion(ctx context.Context, id int64) (PageVersion, error) {
@@ -610,6 +705,8 @@ func (q *Queries) GetPageVersion(ctx context.Context, id int64) (PageVersion, er
 		&i.Body,
 		&i.ChangedBy,
 		&i.CreatedAt,
+		&i.CustomFields,
+		&i.Blocks,
 	)
 	return i, err
 }
@@ -622,6 +719,8 @@ SELECT
     pv.body,
     pv.changed_by,
     pv.created_at,
+    pv.custom_fields,
+    pv.blocks,
     u.name as changed_by_name,
     u.email as changed_by_email
 FROM page_versions pv
@@ -636,6 +735,8 @@ type GetPageVersionWithUserRow struct {
 	Body           string    `json:"body"`
 	ChangedBy      int64     `json:"changed_by"`
 	CreatedAt      time.Time `json:"created_at"`
+	CustomFields   string    `json:"custom_fields"`
+	Blocks         string    `json:"blocks"`
 	ChangedByName  string    `json:"changed_by_name"`
 	ChangedByEmail string    `json:"changed_by_email"`
 }
@@ -650,6 +751,8 @@ func (q *Queries) GetPageVersionWithUser(ctx context.Context, id int64) (GetPage
 		&i.Body,
 		&i.ChangedBy,
 		&i.CreatedAt,
+		&i.CustomFields,
+		&i.Blocks,
 		&i.ChangedByName,
 		&i.ChangedByEmail,
 	)
@@ -657,7 +760,7 @@ func (q *Queries) GetPageVersionWithUser(ctx context.Context, id int64) (GetPage
 }
 
 const getPublishedPageByID = `-- name: GetPublishedPageByID :one
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages WHERE id = ? AND status = 'published'
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages WHERE id = ? AND status = 'published'
 `
 
 func (q *Queries) GetPublishedPageByID(ctx context.Context, id int64) (Page, error) {
@@ -689,16 +792,22 @@ func (q *Queries) GetPublishedPageByID(ctx context.Context, id int64) (Page, err
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const getPublishedPageBySlug = `-- name: GetPublishedPageBySlug :one
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages WHERE slug = ? AND status = 'published'
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages WHERE slug = ? AND status = 'published' AND site_id = ?
 `
 
-func (q *Queries) GetPublishedPageBySlug(ctx context.Context, slug string) (Page, error) {
-	row := q.db.QueryRowContext(ctx, getPublishedPageBySlug, slug)
+type GetPublishedPageBySlugParams struct {
+	Slug   string `json:"slug"`
+	SiteID int64  `json:"site_id"`
+}
+
+func (q *Queries) GetPublishedPageBySlug(ctx context.Context, arg GetPublishedPageBySlugParams) (Page, error) {
+	row := q.db.QueryRowContext(ctx, getPublishedPageBySlug, arg.Slug, arg.SiteID)
 	var i Page
 	err := row.Scan(
 		&i.ID,
@@ -726,22 +835,24 @@ func (q *Queries) GetPublishedPageBySlug(ctx context.Context, slug string) (Page
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const getPublishedPageBySlugAndLanguage = `-- name: GetPublishedPageBySlugAndLanguage :one
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages
-WHERE slug = ? AND language_code = ? AND status = 'published'
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages
+WHERE slug = ? AND language_code = ? AND status = 'published' AND site_id = ?
 `
 
 type GetPublishedPageBySlugAndLanguageParams struct {
 	Slug         string `json:"slug"`
 	LanguageCode string `json:"language_code"`
+	SiteID       int64  `json:"site_id"`
 }
 
 func (q *Queries) GetPublishedPageBySlugAndLanguage(ctx context.Context, arg GetPublishedPageBySlugAndLanguageParams) (Page, error) {
-	row := q.db.QueryRowContext(ctx, getPublishedPageBySlugAndLanguage, arg.Slug, arg.LanguageCode)
+	row := q.db.QueryRowContext(ctx, getPublishedPageBySlugAndLanguage, arg.Slug, arg.LanguageCode, arg.SiteID)
 	var i Page
 	err := row.Scan(
 		&i.ID,
@@ -769,13 +880,14 @@ func (q *Queries) GetPublishedPageBySlugAndLanguage(ctx context.Context, arg Get
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const getScheduledPagesForPublishing = `-- name: GetScheduledPagesForPublishing :many
 
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages
 WHERE scheduled_at IS NOT NULL AND scheduled_at <= ? AND status = 'draft'
 ORDER BY scheduled_at
 `
@@ -816,6 +928,7 @@ func (q *Queries) GetScheduledPagesForPublishing(ctx context.Context, scheduledA
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -831,7 +944,7 @@ func (q *Queries) GetScheduledPagesForPublishing(ctx context.Context, scheduledA
 }
 
 const listPageVersions = `-- name: ListPageVersions :many
-SELECT id, page_id, title, body, changed_by, created_at FROM page_versions WHERE page_id = ? ORDER BY created_at DESC LIMIT ? OFFSET ?
+SELECT id, page_id, title, body, changed_by, created_at, custom_fields, blocks FROM page_versions WHERE page_id = ? ORDER BY created_at DESC LIMIT ? OFFSET ?
 `
 
 type ListPageVersionsParams struct {
@@ -856,6 +969,8 @@ func (q *Queries) ListPageVersions(ctx context.Context, arg ListPageVersionsPara
 			&i.Body,
 			&i.ChangedBy,
 			&i.CreatedAt,
+			&i.CustomFields,
+			&i.Blocks,
 		); err != nil {
 			return nil, err
 		}
@@ -878,6 +993,8 @@ SELECT
     pv.body,
     pv.changed_by,
     pv.created_at,
+    pv.custom_fields,
+    pv.blocks,
     u.name as changed_by_name,
     u.email as changed_by_email
 FROM page_versions pv
@@ -900,6 +1017,8 @@ type ListPageVersionsWithUserRow struct {
 	Body           string    `json:"body"`
 	ChangedBy      int64     `json:"changed_by"`
 	CreatedAt      time.Time `json:"created_at"`
+	CustomFields   string    `json:"custom_fields"`
+	Blocks         string    `json:"blocks"`
 	ChangedByName  string    `json:"changed_by_name"`
 	ChangedByEmail string    `json:"changed_by_email"`
 }
@@ -920,6 +1039,8 @@ func (q *Queries) ListPageVersionsWithUser(ctx context.Context, arg ListPageVers
 			&i.Body,
 			&i.ChangedBy,
 			&i.CreatedAt,
+			&i.CustomFields,
+			&i.Blocks,
 			&i.ChangedByName,
 			&i.ChangedByEmail,
 		); err != nil {
@@ -937,7 +1058,7 @@ func (q *Queries) ListPageVersionsWithUser(ctx context.Context, arg ListPageVers
 }
 
 const listPages = `-- name: ListPages :many
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages ORDER BY created_at DESC LIMIT ? OFFSET ?
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages ORDER BY created_at DESC LIMIT ? OFFSET ?
 `
 
 type ListPagesParams struct {
@@ -980,6 +1101,77 @@ func (q *Queries) ListPages(ctx context.Context, arg ListPagesParams) ([]Page, e
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPagesByCategoryAndSite = `-- name: ListPagesByCategoryAndSite :many
+SELECT DISTINCT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title, p.site_id FROM pages p
+INNER JOIN page_categories pc ON pc.page_id = p.id
+WHERE pc.category_id = ? AND p.site_id = ?
+ORDER BY p.updated_at DESC
+LIMIT ? OFFSET ?
+`
+
+type ListPagesByCategoryAndSiteParams struct {
+	CategoryID int64 `json:"category_id"`
+	SiteID     int64 `json:"site_id"`
+	Limit      int64 `json:"limit"`
+	Offset     int64 `json:"offset"`
+}
+
+func (q *Queries) ListPagesByCategoryAndSite(ctx context.Context, arg ListPagesByCategoryAndSiteParams) ([]Page, error) {
+	rows, err := q.db.QueryContext(ctx, listPagesByCategoryAndSite,
+		arg.CategoryID,
+		arg.SiteID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Page{}
+	for rows.Next() {
+		var i Page
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Body,
+			&i.Status,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PublishedAt,
+			&i.FeaturedImageID,
+			&i.MetaTitle,
+			&i.MetaDescription,
+			&i.MetaKeywords,
+			&i.OgImageID,
+			&i.NoIndex,
+			&i.NoFollow,
+			&i.CanonicalUrl,
+			&i.ScheduledAt,
+			&i.LanguageCode,
+			&i.HideFeaturedImage,
+			&i.PageType,
+			&i.ExcludeFromLists,
+			&i.Summary,
+			&i.VideoUrl,
+			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -996,8 +1188,8 @@ func (q *Queries) ListPages(ctx context.Context, arg ListPagesParams) ([]Page, e
 
 const listPagesByLanguageAndStatus = `-- name: ListPagesByLanguageAndStatus :many
 
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages
-WHERE language_code = ? AND status = ?
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages
+WHERE language_code = ? AND status = ? AND site_id = ?
 ORDER BY created_at DESC
 LIMIT ? OFFSET ?
 `
@@ -1005,6 +1197,7 @@ LIMIT ? OFFSET ?
 type ListPagesByLanguageAndStatusParams struct {
 	LanguageCode string `json:"language_code"`
 	Status       string `json:"status"`
+	SiteID       int64  `json:"site_id"`
 	Limit        int64  `json:"limit"`
 	Offset       int64  `json:"offset"`
 }
@@ -1014,6 +1207,7 @@ func (q *Queries) ListPagesByLanguageAndStatus(ctx context.Context, arg ListPage
 	rows, err := q.db.QueryContext(ctx, listPagesByLanguageAndStatus,
 		arg.LanguageCode,
 		arg.Status,
+		arg.SiteID,
 		arg.Limit,
 		arg.Offset,
 	)
@@ -1050,6 +1244,67 @@ func (q *Queries) ListPagesByLanguageAndStatus(ctx context.Context, arg ListPage
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPagesBySite = `-- name: ListPagesBySite :many
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages WHERE site_id = ? ORDER BY created_at DESC LIMIT ? OFFSET ?
+`
+
+type ListPagesBySiteParams struct {
+	SiteID int64 `json:"site_id"`
+	Limit  int64 `json:"limit"`
+	Offset int64 `json:"offset"`
+}
+
+func (q *Queries) ListPagesBySite(ctx context.Context, arg ListPagesBySiteParams) ([]Page, error) {
+	rows, err := q.db.QueryContext(ctx, listPagesBySite, arg.SiteID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Page{}
+	for rows.Next() {
+		var i Page
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Body,
+			&i.Status,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PublishedAt,
+			&i.FeaturedImageID,
+			&i.MetaTitle,
+			&i.MetaDescription,
+			&i.MetaKeywords,
+			&i.OgImageID,
+			&i.NoIndex,
+			&i.NoFollow,
+			&i.CanonicalUrl,
+			&i.ScheduledAt,
+			&i.LanguageCode,
+			&i.HideFeaturedImage,
+			&i.PageType,
+			&i.ExcludeFromLists,
+			&i.Summary,
+			&i.VideoUrl,
+			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1065,7 +1320,7 @@ func (q *Queries) ListPagesByLanguageAndStatus(ctx context.Context, arg ListPage
 }
 
 const listPagesByStatus = `-- name: ListPagesByStatus :many
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages WHERE status = ? ORDER BY created_at DESC LIMIT ? OFFSET ?
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages WHERE status = ? ORDER BY created_at DESC LIMIT ? OFFSET ?
 `
 
 type ListPagesByStatusParams struct {
@@ -1109,6 +1364,143 @@ func (q *Queries) ListPagesByStatus(ctx context.Context, arg ListPagesByStatusPa
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPagesByStatusAndSite = `-- name: ListPagesByStatusAndSite :many
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages WHERE status = ? AND site_id = ? ORDER BY created_at DESC LIMIT ? OFFSET ?
+`
+
+type ListPagesByStatusAndSiteParams struct {
+	Status string `json:"status"`
+	SiteID int64  `json:"site_id"`
+	Limit  int64  `json:"limit"`
+	Offset int64  `json:"offset"`
+}
+
+func (q *Queries) ListPagesByStatusAndSite(ctx context.Context, arg ListPagesByStatusAndSiteParams) ([]Page, error) {
+	rows, err := q.db.QueryContext(ctx, listPagesByStatusAndSite,
+		arg.Status,
+		arg.SiteID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Page{}
+	for rows.Next() {
+		var i Page
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Body,
+			&i.Status,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PublishedAt,
+			&i.FeaturedImageID,
+			&i.MetaTitle,
+			&i.MetaDescription,
+			&i.MetaKeywords,
+			&i.OgImageID,
+			&i.NoIndex,
+			&i.NoFollow,
+			&i.CanonicalUrl,
+			&i.ScheduledAt,
+			&i.LanguageCode,
+			&i.HideFeaturedImage,
+			&i.PageType,
+			&i.ExcludeFromLists,
+			&i.Summary,
+			&i.VideoUrl,
+			&i.VideoTitle,
+			&i.SiteID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPagesForTagAndSite = `-- name: ListPagesForTagAndSite :many
+SELECT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title, p.site_id FROM pages p
+INNER JOIN page_tags pt ON pt.page_id = p.id
+WHERE pt.tag_id = ? AND p.site_id = ?
+ORDER BY p.created_at DESC
+LIMIT ? OFFSET ?
+`
+
+type ListPagesForTagAndSiteParams struct {
+	TagID  int64 `json:"tag_id"`
+	SiteID int64 `json:"site_id"`
+	Limit  int64 `json:"limit"`
+	Offset int64 `json:"offset"`
+}
+
+func (q *Queries) ListPagesForTagAndSite(ctx context.Context, arg ListPagesForTagAndSiteParams) ([]Page, error) {
+	rows, err := q.db.QueryContext(ctx, listPagesForTagAndSite,
+		arg.TagID,
+		arg.SiteID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Page{}
+	for rows.Next() {
+		var i Page
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Body,
+			&i.Status,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PublishedAt,
+			&i.FeaturedImageID,
+			&i.MetaTitle,
+			&i.MetaDescription,
+			&i.MetaKeywords,
+			&i.OgImageID,
+			&i.NoIndex,
+			&i.NoFollow,
+			&i.CanonicalUrl,
+			&i.ScheduledAt,
+			&i.LanguageCode,
+			&i.HideFeaturedImage,
+			&i.PageType,
+			&i.ExcludeFromLists,
+			&i.Summary,
+			&i.VideoUrl,
+			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1125,7 +1517,7 @@ func (q *Queries) ListPagesByStatus(ctx context.Context, arg ListPagesByStatusPa
 
 const listPublishedPages = `-- name: ListPublishedPages :many
 
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages WHERE status = 'published' ORDER BY published_at DESC LIMIT ? OFFSET ?
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages WHERE status = 'published' ORDER BY published_at DESC LIMIT ? OFFSET ?
 `
 
 type ListPublishedPagesParams struct {
@@ -1169,6 +1561,7 @@ func (q *Queries) ListPublishedPages(ctx context.Context, arg ListPublishedPages
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1184,22 +1577,28 @@ func (q *Queries) ListPublishedPages(ctx context.Context, arg ListPublishedPages
 }
 
 const listPublishedPagesByCategory = `-- name: ListPublishedPagesByCategory :many
-SELECT DISTINCT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title FROM pages p
+SELECT DISTINCT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title, p.site_id FROM pages p
 INNER JOIN page_categories pc ON pc.page_id = p.id
 WHERE pc.category_id = ? AND p.status = 'published'
-  AND p.exclude_from_lists = 0
+  AND p.exclude_from_lists = 0 AND p.site_id = ?
 ORDER BY p.published_at DESC
 LIMIT ? OFFSET ?
 `
 
 type ListPublishedPagesByCategoryParams struct {
 	CategoryID int64 `json:"category_id"`
+	SiteID     int64 `json:"site_id"`
 	Limit      int64 `json:"limit"`
 	Offset     int64 `json:"offset"`
 }
 
 func (q *Queries) ListPublishedPagesByCategory(ctx context.Context, arg ListPublishedPagesByCategoryParams) ([]Page, error) {
-	rows, err := q.db.QueryContext(ctx, listPublishedPagesByCategory, arg.CategoryID, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listPublishedPagesByCategory,
+		arg.CategoryID,
+		arg.SiteID,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1233,6 +1632,7 @@ func (q *Queries) ListPublishedPagesByCategory(ctx context.Context, arg ListPubl
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1249,10 +1649,10 @@ func (q *Queries) ListPublishedPagesByCategory(ctx context.Context, arg ListPubl
 
 const listPublishedPagesByCategoryAndLanguage = `-- name: ListPublishedPagesByCategoryAndLanguage :many
 
-SELECT DISTINCT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title FROM pages p
+SELECT DISTINCT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title, p.site_id FROM pages p
 INNER JOIN page_categories pc ON pc.page_id = p.id
 WHERE pc.category_id = ? AND p.status = 'published' AND p.language_code = ?
-  AND p.exclude_from_lists = 0
+  AND p.exclude_from_lists = 0 AND p.site_id = ?
 ORDER BY p.published_at DESC
 LIMIT ? OFFSET ?
 `
@@ -1260,6 +1660,7 @@ LIMIT ? OFFSET ?
 type ListPublishedPagesByCategoryAndLanguageParams struct {
 	CategoryID   int64  `json:"category_id"`
 	LanguageCode string `json:"language_code"`
+	SiteID       int64  `json:"site_id"`
 	Limit        int64  `json:"limit"`
 	Offset       int64  `json:"offset"`
 }
@@ -1269,6 +1670,7 @@ func (q *Queries) ListPublishedPagesByCategoryAndLanguage(ctx context.Context, a
 	rows, err := q.db.QueryContext(ctx, listPublishedPagesByCategoryAndLanguage,
 		arg.CategoryID,
 		arg.LanguageCode,
+		arg.SiteID,
 		arg.Limit,
 		arg.Offset,
 	)
@@ -1305,6 +1707,7 @@ func (q *Queries) ListPublishedPagesByCategoryAndLanguage(ctx context.Context, a
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1323,7 +1726,8 @@ const listPublishedPagesForSitemap = `-- name: ListPublishedPagesForSitemap :man
 SELECT p.id, p.slug, p.updated_at, p.no_index, p.language_code, l.is_default
 FROM pages p
 INNER JOIN languages l ON l.code = p.language_code AND l.is_active = 1
-WHERE p.status = 'published' AND p.no_index = 0
+WHERE p.status = 'published' AND p.no_index = 0 AND p.site_id = ?
+  AND NOT EXISTS (SELECT 1 FROM page_access pa WHERE pa.page_id = p.id)
 ORDER BY p.updated_at DESC
 `
 
@@ -1336,8 +1740,9 @@ type ListPublishedPagesForSitemapRow struct {
 	IsDefault    bool      `json:"is_default"`
 }
 
-func (q *Queries) ListPublishedPagesForSitemap(ctx context.Context) ([]ListPublishedPagesForSitemapRow, error) {
-	rows, err := q.db.QueryContext(ctx, listPublishedPagesForSitemap)
+// Pages restricted to members or roles are left out.
+func (q *Queries) ListPublishedPagesForSitemap(ctx context.Context, siteID int64) ([]ListPublishedPagesForSitemapRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPublishedPagesForSitemap, siteID)
 	if err != nil {
 		return nil, err
 	}
@@ -1367,22 +1772,28 @@ func (q *Queries) ListPublishedPagesForSitemap(ctx context.Context) ([]ListPubli
 }
 
 const listPublishedPagesForTag = `-- name: ListPublishedPagesForTag :many
-SELECT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title FROM pages p
+SELECT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title, p.site_id FROM pages p
 INNER JOIN page_tags pt ON pt.page_id = p.id
 WHERE pt.tag_id = ? AND p.status = 'published'
-  AND p.exclude_from_lists = 0
+  AND p.exclude_from_lists = 0 AND p.site_id = ?
 ORDER BY p.published_at DESC
 LIMIT ? OFFSET ?
 `
 
 type ListPublishedPagesForTagParams struct {
 	TagID  int64 `json:"tag_id"`
+	SiteID int64 `json:"site_id"`
 	Limit  int64 `json:"limit"`
 	Offset int64 `json:"offset"`
 }
 
 func (q *Queries) ListPublishedPagesForTag(ctx context.Context, arg ListPublishedPagesForTagParams) ([]Page, error) {
-	rows, err := q.db.QueryContext(ctx, listPublishedPagesForTag, arg.TagID, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listPublishedPagesForTag,
+		arg.TagID,
+		arg.SiteID,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1416,6 +1827,7 @@ func (q *Queries) ListPublishedPagesForTag(ctx context.Context, arg ListPublishe
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1431,10 +1843,10 @@ func (q *Queries) ListPublishedPagesForTag(ctx context.Context, arg ListPublishe
 }
 
 const listPublishedPagesForTagAndLanguage = `-- name: ListPublishedPagesForTagAndLanguage :many
-SELECT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title FROM pages p
+SELECT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title, p.site_id FROM pages p
 INNER JOIN page_tags pt ON pt.page_id = p.id
 WHERE pt.tag_id = ? AND p.status = 'published' AND p.language_code = ?
-  AND p.exclude_from_lists = 0
+  AND p.exclude_from_lists = 0 AND p.site_id = ?
 ORDER BY p.published_at DESC
 LIMIT ? OFFSET ?
 `
@@ -1442,6 +1854,7 @@ LIMIT ? OFFSET ?
 type ListPublishedPagesForTagAndLanguageParams struct {
 	TagID        int64  `json:"tag_id"`
 	LanguageCode string `json:"language_code"`
+	SiteID       int64  `json:"site_id"`
 	Limit        int64  `json:"limit"`
 	Offset       int64  `json:"offset"`
 }
@@ -1450,6 +1863,7 @@ func (q *Queries) ListPublishedPagesForTagAndLanguage(ctx context.Context, arg L
 	rows, err := q.db.QueryContext(ctx, listPublishedPagesForTagAndLanguage,
 		arg.TagID,
 		arg.LanguageCode,
+		arg.SiteID,
 		arg.Limit,
 		arg.Offset,
 	)
@@ -1486,6 +1900,7 @@ func (q *Queries) ListPublishedPagesForTagAndLanguage(ctx context.Context, arg L
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1501,19 +1916,20 @@ func (q *Queries) ListPublishedPagesForTagAndLanguage(ctx context.Context, arg L
 }
 
 const listPublishedPosts = `-- name: ListPublishedPosts :many
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages
-WHERE status = 'published' AND page_type = 'post' AND exclude_from_lists = 0
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages
+WHERE status = 'published' AND page_type = 'post' AND exclude_from_lists = 0 AND site_id = ?
 ORDER BY published_at DESC LIMIT ? OFFSET ?
 `
 
 type ListPublishedPostsParams struct {
+	SiteID int64 `json:"site_id"`
 	Limit  int64 `json:"limit"`
 	Offset int64 `json:"offset"`
 }
 
 // Posts only (for recent posts, blog feeds - excludes static pages)
 func (q *Queries) ListPublishedPosts(ctx context.Context, arg ListPublishedPostsParams) ([]Page, error) {
-	rows, err := q.db.QueryContext(ctx, listPublishedPosts, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listPublishedPosts, arg.SiteID, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -1547,6 +1963,7 @@ func (q *Queries) ListPublishedPosts(ctx context.Context, arg ListPublishedPosts
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1562,7 +1979,7 @@ func (q *Queries) ListPublishedPosts(ctx context.Context, arg ListPublishedPosts
 }
 
 const listScheduledPages = `-- name: ListScheduledPages :many
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages WHERE scheduled_at IS NOT NULL AND status = 'draft' ORDER BY scheduled_at LIMIT ? OFFSET ?
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages WHERE scheduled_at IS NOT NULL AND status = 'draft' ORDER BY scheduled_at LIMIT ? OFFSET ?
 `
 
 type ListScheduledPagesParams struct {
@@ -1605,6 +2022,7 @@ func (q *Queries) ListScheduledPages(ctx context.Context, arg ListScheduledPages
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1645,7 +2063,7 @@ const publishPage = `-- name: PublishPage :one
 UPDATE pages
 SET status = 'published', published_at = ?, scheduled_at = NULL, updated_at = ?
 WHERE id = ?
-RETURNING id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title
+RETURNING id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id
 `
 
 type PublishPageParams struct {
@@ -1683,6 +2101,7 @@ func (q *Queries) PublishPage(ctx context.Context, arg PublishPageParams) (Page,
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 	)
 	return i, err
 }
@@ -1691,7 +2110,7 @@ const publishScheduledPage = `-- name: PublishScheduledPage :one
 UPDATE pages
 SET status = 'published', published_at = ?, scheduled_at = NULL, updated_at = ?
 WHERE id = ?
-RETURNING id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title
+RETURNING id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id
 `
 
 type PublishScheduledPageParams struct {
@@ -1729,13 +2148,14 @@ func (q *Queries) PublishScheduledPage(ctx context.Context, arg PublishScheduled
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const searchPages = `-- name: SearchPages :many
 
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages
 WHERE title LIKE ? OR body LIKE ? OR slug LIKE ?
 ORDER BY updated_at DESC
 LIMIT ? OFFSET ?
@@ -1791,6 +2211,7 @@ func (q *Queries) SearchPages(ctx context.Context, arg SearchPagesParams) ([]Pag
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1806,7 +2227,7 @@ func (q *Queries) SearchPages(ctx context.Context, arg SearchPagesParams) ([]Pag
 }
 
 const searchPagesByLanguage = `-- name: SearchPagesByLanguage :many
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages
 WHERE language_code = ? AND (title LIKE ? OR body LIKE ? OR slug LIKE ?)
 ORDER BY updated_at DESC
 LIMIT ? OFFSET ?
@@ -1863,6 +2284,7 @@ func (q *Queries) SearchPagesByLanguage(ctx context.Context, arg SearchPagesByLa
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1878,7 +2300,7 @@ func (q *Queries) SearchPagesByLanguage(ctx context.Context, arg SearchPagesByLa
 }
 
 const searchPagesByStatus = `-- name: SearchPagesByStatus :many
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages
 WHERE status = ? AND (title LIKE ? OR body LIKE ? OR slug LIKE ?)
 ORDER BY updated_at DESC
 LIMIT ? OFFSET ?
@@ -1935,6 +2357,7 @@ func (q *Queries) SearchPagesByStatus(ctx context.Context, arg SearchPagesByStat
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1950,27 +2373,33 @@ func (q *Queries) SearchPagesByStatus(ctx context.Context, arg SearchPagesByStat
 }
 
 const slugExists = `-- name: SlugExists :one
-SELECT EXISTS(SELECT 1 FROM pages WHERE slug = ?)
+SELECT EXISTS(SELECT 1 FROM pages WHERE slug = ? AND site_id = ?)
 `
 
-func (q *Queries) SlugExists(ctx context.Context, slug string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, slugExists, slug)
+type SlugExistsParams struct {
+	Slug   string `json:"slug"`
+	SiteID int64  `json:"site_id"`
+}
+
+func (q *Queries) SlugExists(ctx context.Context, arg SlugExistsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, slugExists, arg.Slug, arg.SiteID)
 	var column_1 int64
 	err := row.Scan(&column_1)
 	return column_1, err
 }
 
 const slugExistsExcluding = `-- name: SlugExistsExcluding :one
-SELECT EXISTS(SELECT 1 FROM pages WHERE slug = ? AND id != ?)
+SELECT EXISTS(SELECT 1 FROM pages WHERE slug = ? AND id != ? AND site_id = ?)
 `
 
 type SlugExistsExcludingParams struct {
-	Slug string `json:"slug"`
-	ID   int64  `json:"id"`
+	Slug   string `json:"slug"`
+	ID     int64  `json:"id"`
+	SiteID int64  `json:"site_id"`
 }
 
 func (q *Queries) SlugExistsExcluding(ctx context.Context, arg SlugExistsExcludingParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, slugExistsExcluding, arg.Slug, arg.ID)
+	row := q.db.QueryRowContext(ctx, slugExistsExcluding, arg.Slug, arg.ID, arg.SiteID)
 	var column_1 int64
 	err := row.Scan(&column_1)
 	return column_1, err
@@ -1979,20 +2408,21 @@ func (q *Queries) SlugExistsExcluding(ctx context.Context, arg SlugExistsExcludi
 const slugOrAliasExists = `-- name: SlugOrAliasExists :one
 
 SELECT EXISTS(
-    SELECT 1 FROM pages p WHERE p.slug = ?
+    SELECT 1 FROM pages p WHERE p.slug = ?1 AND p.site_id = ?2
     UNION ALL
-    SELECT 1 FROM page_aliases pa WHERE pa.alias = ?
+    SELECT 1 FROM page_aliases pa WHERE pa.alias = ?3 AND pa.site_id = ?2
 )
 `
 
 type SlugOrAliasExistsParams struct {
-	Slug  string `json:"slug"`
-	Alias string `json:"alias"`
+	Slug   string `json:"slug"`
+	SiteID int64  `json:"site_id"`
+	Alias  string `json:"alias"`
 }
 
-// Cross-table uniqueness checks (slug vs page_aliases)
+// Cross-table uniqueness checks (slug vs page_aliases), per site
 func (q *Queries) SlugOrAliasExists(ctx context.Context, arg SlugOrAliasExistsParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, slugOrAliasExists, arg.Slug, arg.Alias)
+	row := q.db.QueryRowContext(ctx, slugOrAliasExists, arg.Slug, arg.SiteID, arg.Alias)
 	var column_1 int64
 	err := row.Scan(&column_1)
 	return column_1, err
@@ -2000,15 +2430,16 @@ func (q *Queries) SlugOrAliasExists(ctx context.Context, arg SlugOrAliasExistsPa
 
 const slugOrAliasExistsExcluding = `-- name: SlugOrAliasExistsExcluding :one
 SELECT EXISTS(
-    SELECT 1 FROM pages p WHERE p.slug = ? AND p.id != ?
+    SELECT 1 FROM pages p WHERE p.slug = ?1 AND p.id != ?2 AND p.site_id = ?3
     UNION ALL
-    SELECT 1 FROM page_aliases pa WHERE pa.alias = ? AND pa.page_id != ?
+    SELECT 1 FROM page_aliases pa WHERE pa.alias = ?4 AND pa.page_id != ?5 AND pa.site_id = ?3
 )
 `
 
 type SlugOrAliasExistsExcludingParams struct {
 	Slug   string `json:"slug"`
 	ID     int64  `json:"id"`
+	SiteID int64  `json:"site_id"`
 	Alias  string `json:"alias"`
 	PageID int64  `json:"page_id"`
 }
@@ -2017,6 +2448,7 @@ func (q *Queries) SlugOrAliasExistsExcluding(ctx context.Context, arg SlugOrAlia
 	row := q.db.QueryRowContext(ctx, slugOrAliasExistsExcluding,
 		arg.Slug,
 		arg.ID,
+		arg.SiteID,
 		arg.Alias,
 		arg.PageID,
 	)
@@ -2029,7 +2461,7 @@ const unpublishPage = `-- name: UnpublishPage :one
 UPDATE pages
 SET status = 'draft', published_at = NULL, scheduled_at = NULL, updated_at = ?
 WHERE id = ?
-RETURNING id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title
+RETURNING id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id
 `
 
 type UnpublishPageParams struct {
@@ -2066,6 +2498,7 @@ func (q *Queries) UnpublishPage(ctx context.Context, arg UnpublishPageParams) (P
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 	)
 	return i, err
 }
@@ -2074,7 +2507,7 @@ const updatePage = `-- name: UpdatePage :one
 UPDATE pages
 SET title = ?, slug = ?, body = ?, summary = ?, status = ?, featured_image_id = ?, meta_title = ?, meta_description = ?, meta_keywords = ?, og_image_id = ?, no_index = ?, no_follow = ?, canonical_url = ?, scheduled_at = ?, language_code = ?, hide_featured_image = ?, page_type = ?, exclude_from_lists = ?, published_at = ?, updated_at = ?, video_url = ?, video_title = ?
 WHERE id = ?
-RETURNING id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title
+RETURNING id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id
 `
 
 type UpdatePageParams struct {
@@ -2156,6 +2589,7 @@ func (q *Queries) UpdatePage(ctx context.Context, arg UpdatePageParams) (Page, e
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 	)
 	return i, err
 }
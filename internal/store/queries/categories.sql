@@ -85,22 +85,29 @@ WITH RECURSIVE descendants AS (
 )
 SELECT id FROM descendants;
 
--- name: ListPagesByCategory :many
-SELECT DISTINCT p.* FROM pages p
-INNER JOIN page_categories pc ON pc.page_id = p.id
-WHERE pc.category_id = ?
-ORDER BY p.updated_at DESC
-LIMIT ? OFFSET ?;
-
 -- name: CountPagesByCategory :one
 SELECT COUNT(DISTINCT p.id) FROM pages p
 INNER JOIN page_categories pc ON pc.page_id = p.id
 WHERE pc.category_id = ?;
 
 -- name: ListCategoriesForSitemap :many
+-- Categories with published pages of a site. The default site also lists
+-- categories without any published pages.
 SELECT c.id, c.slug, c.updated_at, c.language_code, l.is_default
 FROM categories c
 INNER JOIN languages l ON l.code = c.language_code AND l.is_active = 1
+WHERE EXISTS (
+    SELECT 1 FROM page_categories pc
+    INNER JOIN pages p ON p.id = pc.page_id
+    WHERE pc.category_id = c.id AND p.status = 'published' AND p.site_id = ?1
+) OR (
+    ?1 = (SELECT id FROM sites WHERE is_default = 1)
+    AND NOT EXISTS (
+        SELECT 1 FROM page_categories pc
+        INNER JOIN pages p ON p.id = pc.page_id
+        WHERE pc.category_id = c.id AND p.status = 'published'
+    )
+)
 ORDER BY c.updated_at DESC;
 
 -- Language-specific category queries (no JOINs needed - language_code is directly on the table)
@@ -161,6 +168,7 @@ SELECT c.*, COUNT(p.id) as usage_count
 FROM categories c
 INNER JOIN page_categories pc ON pc.category_id = c.id
 INNER JOIN pages p ON p.id = pc.page_id AND p.status = 'published' AND p.language_code = sqlc.arg(language_code)
+    AND p.site_id = sqlc.arg(site_id)
 WHERE c.language_code = sqlc.arg(language_code)
 GROUP BY c.id, c.name, c.slug, c.description, c.parent_id, c.position, c.language_code, c.created_at, c.updated_at
 ORDER BY c.position, c.name;
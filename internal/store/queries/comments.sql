@@ -0,0 +1,49 @@
+-- Comment queries
+
+-- name: CreateComment :one
+INSERT INTO comments (page_id, parent_id, user_id, author_name, author_email, body, status, ip_address, user_agent, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetCommentByID :one
+SELECT * FROM comments WHERE id = ?;
+
+-- name: ListApprovedCommentsByPage :many
+SELECT * FROM comments
+WHERE page_id = ? AND status = 'approved'
+ORDER BY created_at, id;
+
+-- name: CountApprovedCommentsByPage :one
+SELECT COUNT(*) FROM comments WHERE page_id = ? AND status = 'approved';
+
+-- name: ListCommentsByStatus :many
+SELECT c.id, c.page_id, c.parent_id, c.user_id, c.author_name, c.author_email, c.body, c.status,
+    c.ip_address, c.created_at, p.title AS page_title, p.language_code AS page_language
+FROM comments c
+INNER JOIN pages p ON p.id = c.page_id
+WHERE c.status = ?
+ORDER BY c.created_at DESC, c.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountCommentsByStatus :one
+SELECT COUNT(*) FROM comments WHERE status = ?;
+
+-- name: CountRecentCommentsByIP :one
+SELECT COUNT(*) FROM comments WHERE ip_address = ? AND created_at >= ?;
+
+-- name: UpdateCommentStatus :exec
+UPDATE comments SET status = ?, updated_at = ? WHERE id = ?;
+
+-- name: DeleteComment :exec
+DELETE FROM comments WHERE id = ?;
+
+-- name: GetPageCommentSetting :one
+SELECT * FROM page_comment_settings WHERE page_id = ?;
+
+-- name: UpsertPageCommentSetting :exec
+INSERT INTO page_comment_settings (page_id, enabled)
+VALUES (?, ?)
+ON CONFLICT(page_id) DO UPDATE SET enabled = excluded.enabled;
+
+-- name: DeletePageCommentSetting :exec
+DELETE FROM page_comment_settings WHERE page_id = ?;
@@ -0,0 +1,38 @@
+-- name: CreateContentType :one
+INSERT INTO content_types (name, slug, description, fields, template, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetContentTypeByID :one
+SELECT * FROM content_types WHERE id = ?;
+
+-- name: GetContentTypeBySlug :one
+SELECT * FROM content_types WHERE slug = ?;
+
+-- name: ListContentTypes :many
+SELECT * FROM content_types ORDER BY name;
+
+-- name: UpdateContentType :one
+UPDATE content_types SET name = ?, slug = ?, description = ?, fields = ?, template = ?, updated_at = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: DeleteContentType :exec
+DELETE FROM content_types WHERE id = ?;
+
+-- name: CountPagesByPageType :one
+SELECT COUNT(*) FROM pages WHERE page_type = ?;
+
+-- name: RenamePageType :exec
+UPDATE pages SET page_type = sqlc.arg(new_type) WHERE page_type = sqlc.arg(old_type);
+
+-- name: GetPageContentFields :one
+SELECT * FROM page_content_fields WHERE page_id = ?;
+
+-- name: UpsertPageContentFields :exec
+INSERT INTO page_content_fields (page_id, data, updated_at)
+VALUES (?, ?, ?)
+ON CONFLICT(page_id) DO UPDATE SET data = excluded.data, updated_at = excluded.updated_at;
+
+-- name: DeletePageContentFields :exec
+DELETE FROM page_content_fields WHERE page_id = ?;
@@ -0,0 +1,29 @@
+-- name: CreateInboundWebhook :one
+INSERT INTO inbound_webhooks (name, token, secret, action, job_source, job_name, is_active, created_by, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetInboundWebhookByID :one
+SELECT * FROM inbound_webhooks WHERE id = ?;
+
+-- name: GetInboundWebhookByToken :one
+SELECT * FROM inbound_webhooks WHERE token = ?;
+
+-- name: ListInboundWebhooks :many
+SELECT * FROM inbound_webhooks ORDER BY name;
+
+-- name: UpdateInboundWebhook :one
+UPDATE inbound_webhooks SET name = ?, secret = ?, action = ?, job_source = ?, job_name = ?, is_active = ?, updated_at = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: RecordInboundWebhookTrigger :exec
+UPDATE inbound_webhooks SET
+    trigger_count = trigger_count + 1,
+    last_triggered_at = ?,
+    last_status = ?,
+    last_error = ?
+WHERE id = ?;
+
+-- name: DeleteInboundWebhook :exec
+DELETE FROM inbound_webhooks WHERE id = ?;
@@ -1,6 +1,6 @@
 -- name: CreateLanguage :one
-INSERT INTO languages (code, name, native_name, is_default, is_active, direction, position, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO languages (code, name, native_name, is_default, is_active, direction, position, created_at, updated_at, host)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: GetLanguageByID :one
@@ -21,7 +21,7 @@ SELECT * FROM languages ORDER BY position, name;
 SELECT * FROM languages WHERE is_active = 1 ORDER BY position, name;
 
 -- name: UpdateLanguage :one
-UPDATE languages SET code = ?, name = ?, native_name = ?, is_default = ?, is_active = ?, direction = ?, position = ?, updated_at = ?
+UPDATE languages SET code = ?, name = ?, native_name = ?, is_default = ?, is_active = ?, direction = ?, position = ?, updated_at = ?, host = ?
 WHERE id = ?
 RETURNING *;
 
@@ -51,3 +51,6 @@ SELECT COALESCE(MAX(position), 0) FROM languages;
 
 -- name: UpdateLanguagePosition :exec
 UPDATE languages SET position = ?, updated_at = ? WHERE id = ?;
+
+-- name: LanguageHostExistsExcluding :one
+SELECT EXISTS(SELECT 1 FROM languages WHERE host = ? AND id != ?);
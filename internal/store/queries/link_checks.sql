@@ -0,0 +1,29 @@
+-- name: CountLinkChecksByStatus :one
+SELECT COUNT(*) FROM link_checks WHERE status = ?;
+
+-- name: DeleteLinkChecksCheckedBefore :exec
+DELETE FROM link_checks WHERE checked_at < ?;
+
+-- name: ListBrokenLinkChecks :many
+SELECT * FROM link_checks
+WHERE status = 'broken'
+ORDER BY broken_since DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: ListLinkChecks :many
+SELECT * FROM link_checks ORDER BY id;
+
+-- name: UpsertLinkCheck :exec
+INSERT INTO link_checks (
+    source_type, source_id, source_title, url, anchor_text, is_external,
+    status, status_code, error, broken_since, checked_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(source_type, source_id, url) DO UPDATE SET
+    source_title = excluded.source_title,
+    anchor_text = excluded.anchor_text,
+    is_external = excluded.is_external,
+    status = excluded.status,
+    status_code = excluded.status_code,
+    error = excluded.error,
+    broken_since = excluded.broken_since,
+    checked_at = excluded.checked_at;
@@ -0,0 +1,15 @@
+-- Machine translation review queries
+
+-- name: GetMachineTranslation :one
+SELECT * FROM machine_translations WHERE entity_type = ? AND entity_id = ?;
+
+-- name: ListMachineTranslatedIDs :many
+SELECT entity_id FROM machine_translations WHERE entity_type = ? ORDER BY entity_id;
+
+-- name: UpsertMachineTranslation :exec
+INSERT INTO machine_translations (entity_type, entity_id, provider, created_at)
+VALUES (?, ?, ?, ?)
+ON CONFLICT(entity_type, entity_id) DO UPDATE SET provider = excluded.provider, created_at = excluded.created_at;
+
+-- name: DeleteMachineTranslation :exec
+DELETE FROM machine_translations WHERE entity_type = ? AND entity_id = ?;
@@ -1,6 +1,6 @@
 -- name: CreateMedia :one
-INSERT INTO media (uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO media (uuid, filename, mime_type, size, width, height, alt, caption, folder_id, uploaded_by, language_code, created_at, updated_at, site_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: GetMediaByID :one
@@ -42,6 +42,9 @@ DELETE FROM media WHERE id = ?;
 -- name: CountMedia :one
 SELECT COUNT(*) FROM media;
 
+-- name: CountMediaBySite :one
+SELECT COUNT(*) FROM media WHERE site_id = ?;
+
 -- name: CountMediaInFolder :one
 SELECT COUNT(*) FROM media WHERE folder_id = ?;
 
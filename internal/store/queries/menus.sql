@@ -1,17 +1,20 @@
 -- name: CreateMenu :one
-INSERT INTO menus (name, slug, language_code, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?)
+INSERT INTO menus (name, slug, language_code, created_at, updated_at, site_id)
+VALUES (?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: GetMenuByID :one
 SELECT * FROM menus WHERE id = ?;
 
 -- name: GetMenuBySlug :one
-SELECT * FROM menus WHERE slug = ?;
+SELECT * FROM menus WHERE slug = ? ORDER BY site_id LIMIT 1;
 
 -- name: ListMenus :many
 SELECT * FROM menus ORDER BY name;
 
+-- name: ListMenusBySite :many
+SELECT * FROM menus WHERE site_id = ? ORDER BY name;
+
 -- name: UpdateMenu :one
 UPDATE menus SET name = ?, slug = ?, language_code = ?, updated_at = ?
 WHERE id = ?
@@ -30,16 +33,16 @@ SELECT EXISTS(SELECT 1 FROM menus WHERE slug = ?);
 SELECT EXISTS(SELECT 1 FROM menus WHERE slug = ? AND id != ?);
 
 -- name: MenuSlugExistsForLanguage :one
-SELECT EXISTS(SELECT 1 FROM menus WHERE slug = ? AND language_code = ?);
+SELECT EXISTS(SELECT 1 FROM menus WHERE slug = ? AND language_code = ? AND site_id = ?);
 
 -- name: MenuSlugExistsForLanguageExcluding :one
-SELECT EXISTS(SELECT 1 FROM menus WHERE slug = ? AND language_code = ? AND id != ?);
+SELECT EXISTS(SELECT 1 FROM menus WHERE slug = ? AND language_code = ? AND site_id = ? AND id != ?);
 
 -- Menu Item queries
 
 -- name: CreateMenuItem :one
-INSERT INTO menu_items (menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO menu_items (menu_id, parent_id, title, url, target, page_id, position, css_class, is_active, item_type, link_id, visibility, visible_roles, visible_languages, mega_menu, image_url, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: GetMenuItemByID :one
@@ -55,7 +58,9 @@ SELECT * FROM menu_items WHERE menu_id = ? AND parent_id IS NULL ORDER BY positi
 SELECT * FROM menu_items WHERE parent_id = ? ORDER BY position;
 
 -- name: UpdateMenuItem :one
-UPDATE menu_items SET parent_id = ?, title = ?, url = ?, target = ?, page_id = ?, position = ?, css_class = ?, is_active = ?, updated_at = ?
+UPDATE menu_items SET parent_id = ?, title = ?, url = ?, target = ?, page_id = ?, position = ?, css_class = ?, is_active = ?,
+    item_type = ?, link_id = ?, visibility = ?, visible_roles = ?, visible_languages = ?, mega_menu = ?, image_url = ?,
+    updated_at = ?
 WHERE id = ?
 RETURNING *;
 
@@ -111,7 +116,12 @@ ORDER BY name;
 
 -- name: GetMenuBySlugAndLanguage :one
 SELECT * FROM menus
-WHERE slug = ? AND language_code = ?;
+WHERE slug = ? AND language_code = ?
+ORDER BY site_id LIMIT 1;
+
+-- name: GetSiteMenuBySlugAndLanguage :one
+SELECT * FROM menus
+WHERE slug = ? AND language_code = ? AND site_id = ?;
 
 -- Get menu by slug, preferring specified language, falling back to default
 -- name: GetMenuForLanguageOrDefault :one
@@ -131,5 +141,14 @@ UPDATE menu_items SET parent_id = ?, updated_at = ? WHERE parent_id = ?;
 -- name: ListMenuItemIDsForPage :many
 SELECT id FROM menu_items WHERE page_id = ?;
 
+-- name: ListMenuItemURLs :many
+-- Active link items pointing at a URL rather than a page, for the link checker.
+SELECT mi.id, mi.menu_id, mi.title, mi.url, m.name AS menu_name
+FROM menu_items mi
+JOIN menus m ON m.id = mi.menu_id
+WHERE mi.is_active = 1 AND mi.item_type = '' AND mi.page_id IS NULL
+  AND mi.url IS NOT NULL AND mi.url != ''
+ORDER BY mi.id;
+
 -- name: ConvertMenuItemToURL :exec
 UPDATE menu_items SET page_id = NULL, url = ?, updated_at = ? WHERE id = ?;
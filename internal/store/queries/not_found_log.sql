@@ -0,0 +1,33 @@
+-- name: ClearNotFoundLog :exec
+DELETE FROM not_found_log;
+
+-- name: CountNotFoundLog :one
+SELECT COUNT(*) FROM not_found_log;
+
+-- name: CreateNotFoundLogEntry :exec
+INSERT INTO not_found_log (path, referrer, first_seen_at, last_seen_at)
+VALUES (?, ?, ?, ?)
+ON CONFLICT(path) DO UPDATE SET
+    hit_count = hit_count + 1,
+    last_seen_at = excluded.last_seen_at;
+
+-- name: DeleteNotFoundLogEntry :exec
+DELETE FROM not_found_log WHERE id = ?;
+
+-- name: DeleteNotFoundLogEntryByPath :exec
+DELETE FROM not_found_log WHERE path = ?;
+
+-- name: GetNotFoundLogEntryByID :one
+SELECT * FROM not_found_log WHERE id = ?;
+
+-- name: ListNotFoundLog :many
+SELECT * FROM not_found_log
+ORDER BY hit_count DESC, last_seen_at DESC
+LIMIT ? OFFSET ?;
+
+-- name: RecordNotFoundHit :execrows
+UPDATE not_found_log SET
+    hit_count = hit_count + 1,
+    referrer = COALESCE(NULLIF(?, ''), referrer),
+    last_seen_at = ?
+WHERE path = ?;
@@ -0,0 +1,15 @@
+-- Page access rule queries
+
+-- name: GetPageAccess :one
+SELECT * FROM page_access WHERE page_id = ?;
+
+-- name: ListPageAccessByPageIDs :many
+SELECT * FROM page_access WHERE page_id IN (sqlc.slice('page_ids'));
+
+-- name: UpsertPageAccess :exec
+INSERT INTO page_access (page_id, access, roles)
+VALUES (?, ?, ?)
+ON CONFLICT(page_id) DO UPDATE SET access = excluded.access, roles = excluded.roles;
+
+-- name: DeletePageAccess :exec
+DELETE FROM page_access WHERE page_id = ?;
@@ -1,8 +1,9 @@
 -- Page Alias queries
 
 -- name: CreatePageAlias :one
-INSERT INTO page_aliases (page_id, alias, created_at)
-VALUES (?, ?, ?)
+-- An alias belongs to the site of its page.
+INSERT INTO page_aliases (page_id, alias, created_at, site_id)
+VALUES (sqlc.arg(page_id), sqlc.arg(alias), sqlc.arg(created_at), (SELECT site_id FROM pages WHERE id = sqlc.arg(page_id)))
 RETURNING *;
 
 -- name: GetAliasesForPage :many
@@ -17,15 +18,15 @@ DELETE FROM page_aliases WHERE id = ?;
 -- name: GetPublishedPageByAlias :one
 SELECT p.* FROM pages p
 INNER JOIN page_aliases pa ON pa.page_id = p.id
-WHERE pa.alias = ? AND p.status = 'published';
+WHERE pa.alias = ? AND pa.site_id = ? AND p.status = 'published';
 
 -- name: GetPageByAlias :one
 SELECT p.* FROM pages p
 INNER JOIN page_aliases pa ON pa.page_id = p.id
-WHERE pa.alias = ?;
+WHERE pa.alias = ? AND pa.site_id = ?;
 
 -- name: AliasExists :one
-SELECT EXISTS(SELECT 1 FROM page_aliases WHERE alias = ?);
+SELECT EXISTS(SELECT 1 FROM page_aliases WHERE alias = ? AND site_id = ?);
 
 -- name: AliasExistsExcludingPage :one
-SELECT EXISTS(SELECT 1 FROM page_aliases WHERE alias = ? AND page_id != ?);
+SELECT EXISTS(SELECT 1 FROM page_aliases WHERE alias = ? AND page_id != ? AND site_id = ?);
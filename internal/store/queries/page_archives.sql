@@ -0,0 +1,33 @@
+-- Date archive queries
+
+-- name: ListPostArchiveMonths :many
+-- Months with published posts in a language, newest first.
+SELECT substr(published_at, 1, 7) AS month, COUNT(*) AS page_count
+FROM pages
+WHERE language_code = ? AND status = 'published' AND page_type = 'post'
+  AND exclude_from_lists = 0 AND published_at IS NOT NULL AND site_id = ?
+GROUP BY month
+ORDER BY month DESC
+LIMIT ?;
+
+-- name: ListPublishedPostsByPeriod :many
+-- Published posts of a language in a year ("2026") or month ("2026-10").
+SELECT * FROM pages
+WHERE language_code = ? AND status = 'published' AND page_type = 'post'
+  AND exclude_from_lists = 0 AND published_at LIKE CAST(sqlc.arg(period) AS TEXT) || '%' AND site_id = ?
+ORDER BY published_at DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountPublishedPostsByPeriod :one
+SELECT COUNT(*) FROM pages
+WHERE language_code = ? AND status = 'published' AND page_type = 'post'
+  AND exclude_from_lists = 0 AND published_at LIKE CAST(sqlc.arg(period) AS TEXT) || '%' AND site_id = ?;
+
+-- name: ListPostDatesForSitemap :many
+-- Publication dates of the posts listed in date archives, for archive sitemap entries.
+SELECT p.published_at, p.updated_at, p.language_code, l.is_default
+FROM pages p
+INNER JOIN languages l ON l.code = p.language_code AND l.is_active = 1
+WHERE p.status = 'published' AND p.page_type = 'post' AND p.exclude_from_lists = 0
+  AND p.published_at IS NOT NULL AND p.site_id = ?
+ORDER BY p.published_at DESC;
@@ -0,0 +1,12 @@
+-- Page block queries
+
+-- name: GetPageBlocks :one
+SELECT * FROM page_blocks WHERE page_id = ?;
+
+-- name: UpsertPageBlocks :exec
+INSERT INTO page_blocks (page_id, data, updated_at)
+VALUES (?, ?, ?)
+ON CONFLICT(page_id) DO UPDATE SET data = excluded.data, updated_at = excluded.updated_at;
+
+-- name: DeletePageBlocks :exec
+DELETE FROM page_blocks WHERE page_id = ?;
@@ -0,0 +1,12 @@
+-- Page custom field queries
+
+-- name: GetPageCustomFields :one
+SELECT * FROM page_custom_fields WHERE page_id = ?;
+
+-- name: UpsertPageCustomFields :exec
+INSERT INTO page_custom_fields (page_id, data, updated_at)
+VALUES (?, ?, ?)
+ON CONFLICT(page_id) DO UPDATE SET data = excluded.data, updated_at = excluded.updated_at;
+
+-- name: DeletePageCustomFields :exec
+DELETE FROM page_custom_fields WHERE page_id = ?;
@@ -0,0 +1,53 @@
+-- Page hierarchy queries
+
+-- name: GetPageHierarchy :one
+SELECT * FROM page_hierarchy WHERE page_id = ?;
+
+-- name: UpsertPageHierarchy :exec
+INSERT INTO page_hierarchy (page_id, parent_id, position)
+VALUES (?, ?, ?)
+ON CONFLICT(page_id) DO UPDATE SET parent_id = excluded.parent_id, position = excluded.position;
+
+-- name: GetNextPageChildPosition :one
+SELECT CAST(COALESCE(MAX(position) + 1, 0) AS INTEGER) FROM page_hierarchy
+WHERE parent_id IS sqlc.narg(parent_id);
+
+-- name: ListPageAncestors :many
+-- Returns the page itself and its ancestors, root first. The depth limit
+-- guards against cycles written outside the admin.
+WITH RECURSIVE lineage(id, depth) AS (
+    SELECT CAST(sqlc.arg(page_id) AS INTEGER), 0
+    UNION ALL
+    SELECT h.parent_id, lineage.depth + 1
+    FROM page_hierarchy h
+    INNER JOIN lineage ON h.page_id = lineage.id
+    WHERE h.parent_id IS NOT NULL AND lineage.depth < 16
+)
+SELECT p.id, p.title, p.slug, p.status, p.language_code, CAST(lineage.depth AS INTEGER) AS depth
+FROM lineage
+INNER JOIN pages p ON p.id = lineage.id
+ORDER BY lineage.depth DESC;
+
+-- name: ListPageDescendants :many
+WITH RECURSIVE subtree(id, depth) AS (
+    SELECT page_id, 1 FROM page_hierarchy WHERE parent_id = ?
+    UNION ALL
+    SELECT h.page_id, subtree.depth + 1
+    FROM page_hierarchy h
+    INNER JOIN subtree ON h.parent_id = subtree.id
+    WHERE subtree.depth < 16
+)
+SELECT CAST(id AS INTEGER) AS id, CAST(depth AS INTEGER) AS depth FROM subtree ORDER BY depth;
+
+-- name: ListPageTreeNodes :many
+SELECT p.id, p.title, p.slug, p.status, h.parent_id, CAST(COALESCE(h.position, 0) AS INTEGER) AS position
+FROM pages p
+LEFT JOIN page_hierarchy h ON h.page_id = p.id
+WHERE p.language_code = ? AND p.site_id = ?
+ORDER BY position, p.title;
+
+-- name: ListPublishedChildPages :many
+SELECT p.* FROM pages p
+INNER JOIN page_hierarchy h ON h.page_id = p.id
+WHERE h.parent_id = ? AND p.status = 'published'
+ORDER BY h.position, p.title;
@@ -0,0 +1,34 @@
+-- Related page queries
+
+-- name: AddRelatedPage :exec
+INSERT INTO page_related (page_id, related_page_id, position)
+VALUES (?, ?, ?)
+ON CONFLICT(page_id, related_page_id) DO UPDATE SET position = excluded.position;
+
+-- name: DeleteRelatedPages :exec
+DELETE FROM page_related WHERE page_id = ?;
+
+-- name: ListRelatedPages :many
+SELECT p.* FROM pages p
+INNER JOIN page_related r ON r.related_page_id = p.id
+WHERE r.page_id = ?
+ORDER BY r.position, p.id;
+
+-- name: ListTaxonomyRelatedPages :many
+-- Scores pages by the tags (2 points each) and categories (1 point each)
+-- they share with a page.
+SELECT p.id, CAST(SUM(shared.weight) AS INTEGER) AS score
+FROM (
+    SELECT b.page_id, 2 AS weight FROM page_tags a
+    INNER JOIN page_tags b ON b.tag_id = a.tag_id
+    WHERE a.page_id = ?
+    UNION ALL
+    SELECT b.page_id, 1 AS weight FROM page_categories a
+    INNER JOIN page_categories b ON b.category_id = a.category_id
+    WHERE a.page_id = ?
+) shared
+INNER JOIN pages p ON p.id = shared.page_id
+WHERE p.status = 'published' AND p.exclude_from_lists = 0 AND p.language_code = ?
+GROUP BY p.id
+ORDER BY score DESC, p.published_at DESC
+LIMIT ?;
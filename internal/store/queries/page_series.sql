@@ -0,0 +1,60 @@
+-- Page series queries
+
+-- name: CreatePageSeries :one
+INSERT INTO page_series (name, slug, description, language_code, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetPageSeriesByID :one
+SELECT * FROM page_series WHERE id = ?;
+
+-- name: GetPageSeriesBySlugAndLanguage :one
+SELECT * FROM page_series WHERE slug = ? AND language_code = ?;
+
+-- name: ListPageSeries :many
+SELECT s.id, s.name, s.slug, s.description, s.language_code, s.created_at, s.updated_at,
+    CAST((SELECT COUNT(*) FROM page_series_items i WHERE i.series_id = s.id) AS INTEGER) AS page_count
+FROM page_series s
+ORDER BY s.language_code, s.name;
+
+-- name: ListPageSeriesByLanguage :many
+SELECT * FROM page_series WHERE language_code = ? ORDER BY name;
+
+-- name: UpdatePageSeries :one
+UPDATE page_series SET name = ?, slug = ?, description = ?, language_code = ?, updated_at = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: DeletePageSeries :exec
+DELETE FROM page_series WHERE id = ?;
+
+-- name: GetPageSeriesItem :one
+SELECT * FROM page_series_items WHERE page_id = ?;
+
+-- name: UpsertPageSeriesItem :exec
+INSERT INTO page_series_items (page_id, series_id, position)
+VALUES (?, ?, ?)
+ON CONFLICT(page_id) DO UPDATE SET series_id = excluded.series_id, position = excluded.position;
+
+-- name: DeletePageSeriesItem :exec
+DELETE FROM page_series_items WHERE page_id = ?;
+
+-- name: GetNextPageSeriesPosition :one
+SELECT CAST(COALESCE(MAX(position) + 1, 0) AS INTEGER) FROM page_series_items
+WHERE series_id = ?;
+
+-- name: ListPageSeriesPages :many
+SELECT p.id, p.title, p.slug, p.status, i.position
+FROM page_series_items i
+INNER JOIN pages p ON p.id = i.page_id
+WHERE i.series_id = ?
+ORDER BY i.position, p.id;
+
+-- name: ListPublishedPageSeriesPages :many
+SELECT p.* FROM pages p
+INNER JOIN page_series_items i ON i.page_id = p.id
+WHERE i.series_id = ? AND p.status = 'published'
+ORDER BY i.position, p.id;
+
+-- name: UpdatePageSeriesItemPosition :exec
+UPDATE page_series_items SET position = ? WHERE page_id = ? AND series_id = ?;
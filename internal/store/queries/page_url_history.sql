@@ -0,0 +1,12 @@
+-- Page URL history queries
+
+-- name: RecordPageURLChange :exec
+INSERT INTO page_url_history (page_id, path, changed_at)
+VALUES (?, ?, ?)
+ON CONFLICT(page_id, path) DO UPDATE SET changed_at = excluded.changed_at;
+
+-- name: ListPageURLHistory :many
+SELECT * FROM page_url_history WHERE page_id = ? ORDER BY changed_at DESC, id DESC;
+
+-- name: DeletePageURLHistoryPath :exec
+DELETE FROM page_url_history WHERE page_id = ? AND path = ?;
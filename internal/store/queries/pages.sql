@@ -1,6 +1,6 @@
 -- name: CreatePage :one
-INSERT INTO pages (title, slug, body, summary, status, author_id, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, published_at, created_at, updated_at, video_url, video_title)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO pages (title, slug, body, summary, status, author_id, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, published_at, created_at, updated_at, video_url, video_title, site_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: GetPageByID :one
@@ -10,7 +10,7 @@ SELECT * FROM pages WHERE id = ?;
 SELECT * FROM pages WHERE id = ? AND status = 'published';
 
 -- name: GetPageBySlug :one
-SELECT * FROM pages WHERE slug = ?;
+SELECT * FROM pages WHERE slug = ? AND site_id = ?;
 
 -- name: ListPages :many
 SELECT * FROM pages ORDER BY created_at DESC LIMIT ? OFFSET ?;
@@ -49,18 +49,18 @@ SELECT COUNT(*) FROM pages;
 SELECT COUNT(*) FROM pages WHERE status = ?;
 
 -- name: SlugExists :one
-SELECT EXISTS(SELECT 1 FROM pages WHERE slug = ?);
+SELECT EXISTS(SELECT 1 FROM pages WHERE slug = ? AND site_id = ?);
 
 -- name: SlugExistsExcluding :one
-SELECT EXISTS(SELECT 1 FROM pages WHERE slug = ? AND id != ?);
+SELECT EXISTS(SELECT 1 FROM pages WHERE slug = ? AND id != ? AND site_id = ?);
 
--- Cross-table uniqueness checks (slug vs page_aliases)
+-- Cross-table uniqueness checks (slug vs page_aliases), per site
 
 -- name: SlugOrAliasExists :one
 SELECT EXISTS(
-    SELECT 1 FROM pages p WHERE p.slug = ?
+    SELECT 1 FROM pages p WHERE p.slug = sqlc.arg(slug) AND p.site_id = sqlc.arg(site_id)
     UNION ALL
-    SELECT 1 FROM page_aliases pa WHERE pa.alias = ?
+    SELECT 1 FROM page_aliases pa WHERE pa.alias = sqlc.arg(alias) AND pa.site_id = sqlc.arg(site_id)
 );
 
 -- name: PageRouteExistsUnderPrefix :one
@@ -79,16 +79,16 @@ SELECT EXISTS(
 
 -- name: SlugOrAliasExistsExcluding :one
 SELECT EXISTS(
-    SELECT 1 FROM pages p WHERE p.slug = ? AND p.id != ?
+    SELECT 1 FROM pages p WHERE p.slug = sqlc.arg(slug) AND p.id != sqlc.arg(id) AND p.site_id = sqlc.arg(site_id)
     UNION ALL
-    SELECT 1 FROM page_aliases pa WHERE pa.alias = ? AND pa.page_id != ?
+    SELECT 1 FROM page_aliases pa WHERE pa.alias = sqlc.arg(alias) AND pa.page_id != sqlc.arg(page_id) AND pa.site_id = sqlc.arg(site_id)
 );
 
 -- Page Version queries
 
 -- name: CreatePageVersion :one
-INSERT INTO page_versions (page_id, title, body, changed_by, created_at)
-VALUES (?, ?, ?, ?, ?)
+INSERT INTO page_versions (page_id, title, body, changed_by, created_at, custom_fields, blocks)
+VALUES (?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: GetPageVersion :one
@@ -114,6 +114,8 @@ SELECT
     pv.body,
     pv.changed_by,
     pv.created_at,
+    pv.custom_fields,
+    pv.blocks,
     u.name as changed_by_name,
     u.email as changed_by_email
 FROM page_versions pv
@@ -130,6 +132,8 @@ SELECT
     pv.body,
     pv.changed_by,
     pv.created_at,
+    pv.custom_fields,
+    pv.blocks,
     u.name as changed_by_name,
     u.email as changed_by_email
 FROM page_versions pv
@@ -160,20 +164,20 @@ SELECT COUNT(*) FROM pages WHERE status = 'published';
 -- Posts only (for recent posts, blog feeds - excludes static pages)
 -- name: ListPublishedPosts :many
 SELECT * FROM pages
-WHERE status = 'published' AND page_type = 'post' AND exclude_from_lists = 0
+WHERE status = 'published' AND page_type = 'post' AND exclude_from_lists = 0 AND site_id = ?
 ORDER BY published_at DESC LIMIT ? OFFSET ?;
 
 -- name: CountPublishedPosts :one
-SELECT COUNT(*) FROM pages WHERE status = 'published' AND page_type = 'post' AND exclude_from_lists = 0;
+SELECT COUNT(*) FROM pages WHERE status = 'published' AND page_type = 'post' AND exclude_from_lists = 0 AND site_id = ?;
 
 -- name: GetPublishedPageBySlug :one
-SELECT * FROM pages WHERE slug = ? AND status = 'published';
+SELECT * FROM pages WHERE slug = ? AND status = 'published' AND site_id = ?;
 
 -- name: ListPublishedPagesByCategory :many
 SELECT DISTINCT p.* FROM pages p
 INNER JOIN page_categories pc ON pc.page_id = p.id
 WHERE pc.category_id = ? AND p.status = 'published'
-  AND p.exclude_from_lists = 0
+  AND p.exclude_from_lists = 0 AND p.site_id = ?
 ORDER BY p.published_at DESC
 LIMIT ? OFFSET ?;
 
@@ -181,13 +185,13 @@ LIMIT ? OFFSET ?;
 SELECT COUNT(DISTINCT p.id) FROM pages p
 INNER JOIN page_categories pc ON pc.page_id = p.id
 WHERE pc.category_id = ? AND p.status = 'published'
-  AND p.exclude_from_lists = 0;
+  AND p.exclude_from_lists = 0 AND p.site_id = ?;
 
 -- name: ListPublishedPagesForTag :many
 SELECT p.* FROM pages p
 INNER JOIN page_tags pt ON pt.page_id = p.id
 WHERE pt.tag_id = ? AND p.status = 'published'
-  AND p.exclude_from_lists = 0
+  AND p.exclude_from_lists = 0 AND p.site_id = ?
 ORDER BY p.published_at DESC
 LIMIT ? OFFSET ?;
 
@@ -195,7 +199,43 @@ LIMIT ? OFFSET ?;
 SELECT COUNT(*) FROM pages p
 INNER JOIN page_tags pt ON pt.page_id = p.id
 WHERE pt.tag_id = ? AND p.status = 'published'
-  AND p.exclude_from_lists = 0;
+  AND p.exclude_from_lists = 0 AND p.site_id = ?;
+
+-- name: ListPagesBySite :many
+SELECT * FROM pages WHERE site_id = ? ORDER BY created_at DESC LIMIT ? OFFSET ?;
+
+-- name: CountPagesBySite :one
+SELECT COUNT(*) FROM pages WHERE site_id = ?;
+
+-- name: ListPagesByStatusAndSite :many
+SELECT * FROM pages WHERE status = ? AND site_id = ? ORDER BY created_at DESC LIMIT ? OFFSET ?;
+
+-- name: CountPagesByStatusAndSite :one
+SELECT COUNT(*) FROM pages WHERE status = ? AND site_id = ?;
+
+-- name: ListPagesByCategoryAndSite :many
+SELECT DISTINCT p.* FROM pages p
+INNER JOIN page_categories pc ON pc.page_id = p.id
+WHERE pc.category_id = ? AND p.site_id = ?
+ORDER BY p.updated_at DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountPagesByCategoryAndSite :one
+SELECT COUNT(DISTINCT p.id) FROM pages p
+INNER JOIN page_categories pc ON pc.page_id = p.id
+WHERE pc.category_id = ? AND p.site_id = ?;
+
+-- name: ListPagesForTagAndSite :many
+SELECT p.* FROM pages p
+INNER JOIN page_tags pt ON pt.page_id = p.id
+WHERE pt.tag_id = ? AND p.site_id = ?
+ORDER BY p.created_at DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountPagesForTagAndSite :one
+SELECT COUNT(*) FROM pages p
+INNER JOIN page_tags pt ON pt.page_id = p.id
+WHERE pt.tag_id = ? AND p.site_id = ?;
 
 -- name: GetPageAuthor :one
 SELECT u.id, u.name, u.email, u.avatar, u.bio, u.website_url, u.linkedin_url, u.github_url, u.telegram_url FROM users u
@@ -210,10 +250,12 @@ INNER JOIN pages p ON p.og_image_id = m.id
 WHERE p.id = ?;
 
 -- name: ListPublishedPagesForSitemap :many
+-- Pages restricted to members or roles are left out.
 SELECT p.id, p.slug, p.updated_at, p.no_index, p.language_code, l.is_default
 FROM pages p
 INNER JOIN languages l ON l.code = p.language_code AND l.is_active = 1
-WHERE p.status = 'published' AND p.no_index = 0
+WHERE p.status = 'published' AND p.no_index = 0 AND p.site_id = ?
+  AND NOT EXISTS (SELECT 1 FROM page_access pa WHERE pa.page_id = p.id)
 ORDER BY p.updated_at DESC;
 
 -- Scheduled publishing queries
@@ -264,7 +306,7 @@ WHERE status = ? AND (title LIKE ? OR body LIKE ? OR slug LIKE ?);
 
 -- name: ListPagesByLanguageAndStatus :many
 SELECT * FROM pages
-WHERE language_code = ? AND status = ?
+WHERE language_code = ? AND status = ? AND site_id = ?
 ORDER BY created_at DESC
 LIMIT ? OFFSET ?;
 
@@ -283,7 +325,7 @@ WHERE language_code = ? AND (title LIKE ? OR body LIKE ? OR slug LIKE ?);
 
 -- name: GetPublishedPageBySlugAndLanguage :one
 SELECT * FROM pages
-WHERE slug = ? AND language_code = ? AND status = 'published';
+WHERE slug = ? AND language_code = ? AND status = 'published' AND site_id = ?;
 
 -- Frontend queries filtered by language (for showing pages in current language only)
 
@@ -291,7 +333,7 @@ WHERE slug = ? AND language_code = ? AND status = 'published';
 SELECT DISTINCT p.* FROM pages p
 INNER JOIN page_categories pc ON pc.page_id = p.id
 WHERE pc.category_id = ? AND p.status = 'published' AND p.language_code = ?
-  AND p.exclude_from_lists = 0
+  AND p.exclude_from_lists = 0 AND p.site_id = ?
 ORDER BY p.published_at DESC
 LIMIT ? OFFSET ?;
 
@@ -299,13 +341,13 @@ LIMIT ? OFFSET ?;
 SELECT COUNT(DISTINCT p.id) FROM pages p
 INNER JOIN page_categories pc ON pc.page_id = p.id
 WHERE pc.category_id = ? AND p.status = 'published' AND p.language_code = ?
-  AND p.exclude_from_lists = 0;
+  AND p.exclude_from_lists = 0 AND p.site_id = ?;
 
 -- name: ListPublishedPagesForTagAndLanguage :many
 SELECT p.* FROM pages p
 INNER JOIN page_tags pt ON pt.page_id = p.id
 WHERE pt.tag_id = ? AND p.status = 'published' AND p.language_code = ?
-  AND p.exclude_from_lists = 0
+  AND p.exclude_from_lists = 0 AND p.site_id = ?
 ORDER BY p.published_at DESC
 LIMIT ? OFFSET ?;
 
@@ -313,7 +355,7 @@ LIMIT ? OFFSET ?;
 SELECT COUNT(*) FROM pages p
 INNER JOIN page_tags pt ON pt.page_id = p.id
 WHERE pt.tag_id = ? AND p.status = 'published' AND p.language_code = ?
-  AND p.exclude_from_lists = 0;
+  AND p.exclude_from_lists = 0 AND p.site_id = ?;
 
 -- name: CountPagesByLanguageCode :one
 SELECT COUNT(*) FROM pages WHERE language_code = ?;
@@ -1,6 +1,6 @@
 -- name: CreateRedirect :one
-INSERT INTO redirects (source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO redirects (source_path, target_url, status_code, is_wildcard, target_type, enabled, is_regex, drop_query, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: GetRedirectByID :one
@@ -28,6 +28,8 @@ UPDATE redirects SET
     is_wildcard = ?,
     target_type = ?,
     enabled = ?,
+    is_regex = ?,
+    drop_query = ?,
     updated_at = ?
 WHERE id = ?
 RETURNING *;
@@ -46,3 +48,9 @@ SELECT EXISTS(SELECT 1 FROM redirects WHERE source_path = ? AND id != ?);
 
 -- name: ToggleRedirectEnabled :exec
 UPDATE redirects SET enabled = NOT enabled, updated_at = ? WHERE id = ?;
+
+-- name: RetargetRedirects :exec
+UPDATE redirects SET target_url = ?, updated_at = ? WHERE target_url = ?;
+
+-- name: RecordRedirectHit :exec
+UPDATE redirects SET hit_count = hit_count + 1, last_hit_at = ? WHERE id = ?;
@@ -1,8 +1,8 @@
 -- Scheduled Tasks
 
 -- name: CreateScheduledTask :one
-INSERT INTO scheduled_tasks (name, url, schedule, is_active, timeout_seconds, created_by, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO scheduled_tasks (name, url, schedule, is_active, timeout_seconds, task_type, params, retry_policy, max_retries, retry_delay_seconds, created_by, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: GetScheduledTask :one
@@ -15,7 +15,7 @@ SELECT * FROM scheduled_tasks ORDER BY name;
 SELECT * FROM scheduled_tasks WHERE is_active = 1 ORDER BY name;
 
 -- name: UpdateScheduledTask :one
-UPDATE scheduled_tasks SET name = ?, url = ?, schedule = ?, timeout_seconds = ?, updated_at = ?
+UPDATE scheduled_tasks SET name = ?, url = ?, schedule = ?, timeout_seconds = ?, task_type = ?, params = ?, retry_policy = ?, max_retries = ?, retry_delay_seconds = ?, updated_at = ?
 WHERE id = ?
 RETURNING *;
 
@@ -30,18 +30,18 @@ DELETE FROM scheduled_tasks WHERE id = ?;
 -- Scheduled Task Runs
 
 -- name: CreateScheduledTaskRun :one
-INSERT INTO scheduled_task_runs (task_id, status, started_at)
-VALUES (?, 'pending', ?)
+INSERT INTO scheduled_task_runs (task_id, status, attempt, started_at)
+VALUES (?, 'pending', ?, ?)
 RETURNING *;
 
 -- name: UpdateScheduledTaskRunSuccess :exec
 UPDATE scheduled_task_runs
-SET status = 'success', status_code = ?, response_body = ?, duration_ms = ?, completed_at = ?
+SET status = 'success', status_code = ?, response_body = ?, log = ?, duration_ms = ?, completed_at = ?
 WHERE id = ?;
 
 -- name: UpdateScheduledTaskRunFailed :exec
 UPDATE scheduled_task_runs
-SET status = 'failed', error_message = ?, duration_ms = ?, completed_at = ?
+SET status = 'failed', error_message = ?, log = ?, duration_ms = ?, completed_at = ?
 WHERE id = ?;
 
 -- name: ListScheduledTaskRuns :many
@@ -0,0 +1,63 @@
+-- name: GetSite :one
+SELECT * FROM sites WHERE id = ?;
+
+-- name: GetDefaultSite :one
+SELECT * FROM sites WHERE is_default = 1 LIMIT 1;
+
+-- name: ListSites :many
+SELECT * FROM sites ORDER BY is_default DESC, name;
+
+-- name: CountSites :one
+SELECT COUNT(*) FROM sites;
+
+-- name: CreateSite :one
+INSERT INTO sites (name, host, theme, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: UpdateSite :one
+UPDATE sites
+SET name = ?, host = ?, theme = ?, updated_at = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: DeleteSite :exec
+DELETE FROM sites WHERE id = ? AND is_default = 0;
+
+-- name: SiteHostExistsExcluding :one
+SELECT EXISTS(SELECT 1 FROM sites WHERE host = ? AND id != ?);
+
+-- name: CountSiteContent :one
+SELECT
+    (SELECT COUNT(*) FROM pages WHERE pages.site_id = sqlc.arg(site_id)) +
+    (SELECT COUNT(*) FROM media WHERE media.site_id = sqlc.arg(site_id)) +
+    (SELECT COUNT(*) FROM menus WHERE menus.site_id = sqlc.arg(site_id)) +
+    (SELECT COUNT(*) FROM widgets WHERE widgets.site_id = sqlc.arg(site_id));
+
+-- name: ListAllSiteConfig :many
+SELECT * FROM site_config ORDER BY site_id, key;
+
+-- name: ListSiteConfig :many
+SELECT * FROM site_config WHERE site_id = ? ORDER BY key;
+
+-- name: GetSiteConfigValue :one
+SELECT value FROM site_config WHERE site_id = ? AND key = ?;
+
+-- name: UpsertSiteConfig :exec
+INSERT INTO site_config (site_id, key, value, updated_at)
+VALUES (?, ?, ?, ?)
+ON CONFLICT(site_id, key) DO UPDATE SET
+    value = excluded.value,
+    updated_at = excluded.updated_at;
+
+-- name: DeleteSiteConfig :exec
+DELETE FROM site_config WHERE site_id = ? AND key = ?;
+
+-- name: ListUserSiteIDs :many
+SELECT site_id FROM user_sites WHERE user_id = ? ORDER BY site_id;
+
+-- name: AddUserSite :exec
+INSERT OR IGNORE INTO user_sites (user_id, site_id) VALUES (?, ?);
+
+-- name: DeleteUserSites :exec
+DELETE FROM user_sites WHERE user_id = ?;
@@ -0,0 +1,21 @@
+-- name: CreateSnippet :one
+INSERT INTO snippets (name, slug, body, language_code, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetSnippetByID :one
+SELECT * FROM snippets WHERE id = ?;
+
+-- name: GetSnippetBySlugAndLanguage :one
+SELECT * FROM snippets WHERE slug = ? AND language_code = ?;
+
+-- name: ListSnippets :many
+SELECT * FROM snippets ORDER BY slug, language_code;
+
+-- name: UpdateSnippet :one
+UPDATE snippets SET name = ?, slug = ?, body = ?, language_code = ?, updated_at = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: DeleteSnippet :exec
+DELETE FROM snippets WHERE id = ?;
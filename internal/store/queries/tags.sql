@@ -49,13 +49,6 @@ INNER JOIN page_tags pt ON pt.tag_id = t.id
 WHERE pt.page_id = ?
 ORDER BY t.name;
 
--- name: GetPagesForTag :many
-SELECT p.* FROM pages p
-INNER JOIN page_tags pt ON pt.page_id = p.id
-WHERE pt.tag_id = ?
-ORDER BY p.created_at DESC
-LIMIT ? OFFSET ?;
-
 -- name: CountPagesForTag :one
 SELECT COUNT(*) FROM page_tags WHERE tag_id = ?;
 
@@ -71,9 +64,23 @@ ORDER BY usage_count DESC, t.name
 LIMIT ? OFFSET ?;
 
 -- name: ListTagsForSitemap :many
+-- Tags with published pages of a site. The default site also lists tags
+-- without any published pages.
 SELECT t.id, t.slug, t.updated_at, t.language_code, l.is_default
 FROM tags t
 INNER JOIN languages l ON l.code = t.language_code AND l.is_active = 1
+WHERE EXISTS (
+    SELECT 1 FROM page_tags pt
+    INNER JOIN pages p ON p.id = pt.page_id
+    WHERE pt.tag_id = t.id AND p.status = 'published' AND p.site_id = ?1
+) OR (
+    ?1 = (SELECT id FROM sites WHERE is_default = 1)
+    AND NOT EXISTS (
+        SELECT 1 FROM page_tags pt
+        INNER JOIN pages p ON p.id = pt.page_id
+        WHERE pt.tag_id = t.id AND p.status = 'published'
+    )
+)
 ORDER BY t.updated_at DESC;
 
 -- Language-specific tag queries (no JOINs needed - language_code is directly on the table)
@@ -133,7 +140,7 @@ SELECT EXISTS(SELECT 1 FROM tags WHERE slug = ? AND id != ? AND language_code =
 SELECT t.id, t.name, t.slug, t.language_code, t.created_at, t.updated_at, COUNT(p.id) as usage_count
 FROM tags t
 INNER JOIN page_tags pt ON pt.tag_id = t.id
-INNER JOIN pages p ON p.id = pt.page_id AND p.status = 'published' AND p.language_code = ?
+INNER JOIN pages p ON p.id = pt.page_id AND p.status = 'published' AND p.language_code = ? AND p.site_id = ?
 WHERE t.language_code = ?
 GROUP BY t.id, t.name, t.slug, t.language_code, t.created_at, t.updated_at
 ORDER BY usage_count DESC, t.name
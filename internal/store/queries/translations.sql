@@ -51,6 +51,8 @@ SELECT
     t.language_id,
     t.translation_id,
     t.created_at,
+    t.source_version_id,
+    t.source_updated_at,
     l.code as language_code,
     l.name as language_name,
     l.native_name as language_native_name
@@ -114,13 +116,13 @@ SELECT COUNT(*) FROM pages WHERE language_code = ? AND status = 'published';
 -- Posts only for a specific language (for recent posts, blog feeds - excludes static pages)
 -- name: ListPublishedPostsByLanguage :many
 SELECT * FROM pages
-WHERE language_code = ? AND status = 'published' AND page_type = 'post' AND exclude_from_lists = 0
+WHERE language_code = ? AND status = 'published' AND page_type = 'post' AND exclude_from_lists = 0 AND site_id = ?
 ORDER BY published_at DESC
 LIMIT ? OFFSET ?;
 
 -- Count published posts for a specific language
 -- name: CountPublishedPostsByLanguage :one
-SELECT COUNT(*) FROM pages WHERE language_code = ? AND status = 'published' AND page_type = 'post' AND exclude_from_lists = 0;
+SELECT COUNT(*) FROM pages WHERE language_code = ? AND status = 'published' AND page_type = 'post' AND exclude_from_lists = 0 AND site_id = ?;
 
 -- Get the translation of a page in a specific language (by slug for frontend)
 -- name: GetPageTranslationBySlug :one
@@ -340,3 +342,98 @@ SELECT
     (SELECT COUNT(*) FROM translations WHERE entity_type = 'category') as category_translations,
     (SELECT COUNT(*) FROM translations WHERE entity_type = 'tag') as tag_translations
 FROM translations;
+
+-- Freshness tracking. A translation records the state of its source it was
+-- last brought up to date with: the latest page version for pages and
+-- updated_at for other entities.
+
+-- name: MarkTranslationCurrent :exec
+UPDATE translations SET
+    source_version_id = CASE WHEN entity_type = 'page'
+        THEN (SELECT MAX(pv.id) FROM page_versions pv WHERE pv.page_id = translations.entity_id) END,
+    source_updated_at = CASE entity_type
+        WHEN 'page' THEN (SELECT p.updated_at FROM pages p WHERE p.id = translations.entity_id)
+        WHEN 'category' THEN (SELECT c.updated_at FROM categories c WHERE c.id = translations.entity_id)
+        WHEN 'tag' THEN (SELECT t.updated_at FROM tags t WHERE t.id = translations.entity_id)
+        WHEN 'form' THEN (SELECT f.updated_at FROM forms f WHERE f.id = translations.entity_id)
+    END
+WHERE id = ?;
+
+-- Mark every link to a translated entity as up to date with its source
+-- name: MarkTranslationsCurrent :exec
+UPDATE translations SET
+    source_version_id = CASE WHEN entity_type = 'page'
+        THEN (SELECT MAX(pv.id) FROM page_versions pv WHERE pv.page_id = translations.entity_id) END,
+    source_updated_at = CASE entity_type
+        WHEN 'page' THEN (SELECT p.updated_at FROM pages p WHERE p.id = translations.entity_id)
+        WHEN 'category' THEN (SELECT c.updated_at FROM categories c WHERE c.id = translations.entity_id)
+        WHEN 'tag' THEN (SELECT t.updated_at FROM tags t WHERE t.id = translations.entity_id)
+        WHEN 'form' THEN (SELECT f.updated_at FROM forms f WHERE f.id = translations.entity_id)
+    END
+WHERE entity_type = ? AND translation_id = ?;
+
+-- List every translation link with both sides and whether the source
+-- changed since the translation was last brought up to date
+-- name: ListTranslationFreshness :many
+WITH entities(entity_type, id, title, language_code, updated_at) AS (
+    SELECT 'page', id, title, language_code, updated_at FROM pages
+    UNION ALL
+    SELECT 'category', id, name, language_code, updated_at FROM categories
+    UNION ALL
+    SELECT 'tag', id, name, language_code, updated_at FROM tags
+    UNION ALL
+    SELECT 'form', id, name, language_code, updated_at FROM forms
+)
+SELECT
+    t.id,
+    t.entity_type,
+    t.entity_id AS source_id,
+    src.title AS source_title,
+    src.language_code AS source_language_code,
+    src.updated_at AS source_updated_at,
+    t.translation_id,
+    trg.title AS translation_title,
+    trg.language_code AS translation_language_code,
+    CAST(CASE WHEN t.entity_type = 'page'
+        THEN COALESCE((SELECT MAX(pv.id) FROM page_versions pv WHERE pv.page_id = t.entity_id), 0) > COALESCE(t.source_version_id, 0)
+        ELSE src.updated_at IS NOT t.source_updated_at
+    END AS INTEGER) AS outdated
+FROM translations t
+INNER JOIN entities src ON src.entity_type = t.entity_type AND src.id = t.entity_id
+INNER JOIN entities trg ON trg.entity_type = t.entity_type AND trg.id = t.translation_id
+ORDER BY t.entity_type, src.title, t.id;
+
+-- Count the translatable entities of every kind per language
+-- name: CountTranslatableEntitiesByLanguage :many
+SELECT entity_type, language_code, COUNT(*) AS entity_count
+FROM (
+    SELECT 'page' AS entity_type, language_code FROM pages
+    UNION ALL
+    SELECT 'category', language_code FROM categories
+    UNION ALL
+    SELECT 'tag', language_code FROM tags
+    UNION ALL
+    SELECT 'menu', language_code FROM menus
+    UNION ALL
+    SELECT 'form', language_code FROM forms
+)
+GROUP BY entity_type, language_code
+ORDER BY entity_type, language_code;
+
+-- List pages that are translations of a page changed since they were last
+-- brought up to date
+-- name: ListOutdatedPageTranslationIDs :many
+SELECT DISTINCT t.translation_id
+FROM translations t
+WHERE t.entity_type = 'page'
+  AND COALESCE((SELECT MAX(pv.id) FROM page_versions pv WHERE pv.page_id = t.entity_id), 0) > COALESCE(t.source_version_id, 0)
+ORDER BY t.translation_id;
+
+-- List the pages a page was translated from that changed since the
+-- translation was last brought up to date
+-- name: ListOutdatedPageTranslationSources :many
+SELECT t.entity_id
+FROM translations t
+WHERE t.entity_type = 'page' AND t.translation_id = ?
+  AND COALESCE((SELECT MAX(pv.id) FROM page_versions pv WHERE pv.page_id = t.entity_id), 0) > COALESCE(t.source_version_id, 0)
+ORDER BY t.entity_id;
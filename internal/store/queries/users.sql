@@ -9,6 +9,9 @@ SELECT * FROM users WHERE email = ?;
 -- name: GetUserByID :one
 SELECT * FROM users WHERE id = ?;
 
+-- name: ListUserEmailsByRole :many
+SELECT email FROM users WHERE role = ? ORDER BY id;
+
 -- name: ListUsers :many
 SELECT * FROM users ORDER BY created_at DESC LIMIT ? OFFSET ?;
 
@@ -1,6 +1,6 @@
 -- name: CreateWebhook :one
-INSERT INTO webhooks (name, url, secret, events, is_active, headers, created_by, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO webhooks (name, url, secret, events, is_active, headers, payload_format, payload_template, created_by, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: GetWebhookByID :one
@@ -20,7 +20,7 @@ SELECT * FROM webhooks
 WHERE is_active = 1 AND events LIKE '%' || ? || '%';
 
 -- name: UpdateWebhook :one
-UPDATE webhooks SET name = ?, url = ?, secret = ?, events = ?, is_active = ?, headers = ?, updated_at = ?
+UPDATE webhooks SET name = ?, url = ?, secret = ?, events = ?, is_active = ?, headers = ?, payload_format = ?, payload_template = ?, updated_at = ?
 WHERE id = ?
 RETURNING *;
 
@@ -134,3 +134,70 @@ FROM webhook_deliveries wd
 INNER JOIN webhooks w ON w.id = wd.webhook_id
 WHERE wd.status IN ('dead', 'failed')
 ORDER BY wd.created_at DESC LIMIT ?;
+
+-- name: ListWebhookDeliveriesFiltered :many
+SELECT * FROM webhook_deliveries
+WHERE webhook_id = sqlc.arg(webhook_id)
+  AND (CAST(sqlc.arg(status) AS TEXT) = '' OR status = sqlc.arg(status))
+  AND (CAST(sqlc.arg(event) AS TEXT) = '' OR event = sqlc.arg(event))
+ORDER BY created_at DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: CountWebhookDeliveriesFiltered :one
+SELECT COUNT(*) FROM webhook_deliveries
+WHERE webhook_id = sqlc.arg(webhook_id)
+  AND (CAST(sqlc.arg(status) AS TEXT) = '' OR status = sqlc.arg(status))
+  AND (CAST(sqlc.arg(event) AS TEXT) = '' OR event = sqlc.arg(event));
+
+-- name: ListWebhookDeliveryEvents :many
+SELECT DISTINCT event FROM webhook_deliveries WHERE webhook_id = ? ORDER BY event;
+
+-- Dead-letter queue
+
+-- name: ListDeadDeliveriesWithWebhook :many
+SELECT
+    wd.*,
+    w.name as webhook_name
+FROM webhook_deliveries wd
+INNER JOIN webhooks w ON w.id = wd.webhook_id
+WHERE wd.status = 'dead'
+  AND (CAST(sqlc.arg(webhook_id) AS INTEGER) = 0 OR wd.webhook_id = sqlc.arg(webhook_id))
+  AND (CAST(sqlc.arg(event) AS TEXT) = '' OR wd.event = sqlc.arg(event))
+ORDER BY wd.created_at DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: CountDeadDeliveries :one
+SELECT COUNT(*) FROM webhook_deliveries
+WHERE status = 'dead'
+  AND (CAST(sqlc.arg(webhook_id) AS INTEGER) = 0 OR webhook_id = sqlc.arg(webhook_id))
+  AND (CAST(sqlc.arg(event) AS TEXT) = '' OR event = sqlc.arg(event));
+
+-- name: ListDeadDeliveryIDs :many
+SELECT id FROM webhook_deliveries
+WHERE status = 'dead'
+  AND (CAST(sqlc.arg(webhook_id) AS INTEGER) = 0 OR webhook_id = sqlc.arg(webhook_id))
+  AND (CAST(sqlc.arg(event) AS TEXT) = '' OR event = sqlc.arg(event))
+ORDER BY created_at
+LIMIT sqlc.arg(limit);
+
+-- name: ListDeadDeliveryEvents :many
+SELECT DISTINCT event FROM webhook_deliveries WHERE status = 'dead' ORDER BY event;
+
+-- Circuit breaker
+
+-- name: RecordWebhookFailure :one
+UPDATE webhooks SET consecutive_failures = consecutive_failures + 1
+WHERE id = ?
+RETURNING consecutive_failures;
+
+-- name: ResetWebhookFailures :exec
+UPDATE webhooks SET consecutive_failures = 0
+WHERE id = ? AND consecutive_failures > 0;
+
+-- name: PauseWebhook :execrows
+UPDATE webhooks SET paused_at = ?, pause_reason = ?
+WHERE id = ? AND paused_at IS NULL;
+
+-- name: ResumeWebhook :exec
+UPDATE webhooks SET paused_at = NULL, pause_reason = '', consecutive_failures = 0, updated_at = ?
+WHERE id = ?;
@@ -0,0 +1,17 @@
+-- Widget visibility queries
+
+-- name: DeleteWidgetVisibility :exec
+DELETE FROM widget_visibility WHERE widget_id = ?;
+
+-- name: GetWidgetVisibility :one
+SELECT rules FROM widget_visibility WHERE widget_id = ?;
+
+-- name: ListWidgetVisibilityByTheme :many
+SELECT v.widget_id, v.rules FROM widget_visibility v
+INNER JOIN widgets w ON w.id = v.widget_id
+WHERE w.theme = ?;
+
+-- name: UpsertWidgetVisibility :exec
+INSERT INTO widget_visibility (widget_id, rules)
+VALUES (?, ?)
+ON CONFLICT(widget_id) DO UPDATE SET rules = excluded.rules;
@@ -1,6 +1,6 @@
 -- name: CreateWidget :one
-INSERT INTO widgets (theme, area, widget_type, title, content, settings, position, is_active, language_code)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO widgets (theme, area, widget_type, title, content, settings, position, is_active, language_code, site_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: GetWidget :one
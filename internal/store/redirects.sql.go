@@ -7,6 +7,7 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
@@ -22,9 +23,9 @@ func (q *Queries) CountRedirects(ctx context.Context) (int64, error) {
 }
 
 const createRedirect = `-- name: CreateRedirect :one
-INSERT INTO redirects (source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at
+INSERT INTO redirects (source_path, target_url, status_code, is_wildcard, target_type, enabled, is_regex, drop_query, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at, is_regex, drop_query, hit_count, last_hit_at
 `
 
 type CreateRedirectParams struct {
@@ -34,6 +35,8 @@ type CreateRedirectParams struct {
 	IsWildcard bool      `json:"is_wildcard"`
 	TargetType string    `json:"target_type"`
 	Enabled    bool      `json:"enabled"`
+	IsRegex    bool      `json:"is_regex"`
+	DropQuery  bool      `json:"drop_query"`
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
 }
@@ -46,6 +49,8 @@ func (q *Queries) CreateRedirect(ctx context.Context, arg CreateRedirectParams)
 		arg.IsWildcard,
 		arg.TargetType,
 		arg.Enabled,
+		arg.IsRegex,
+		arg.DropQuery,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
@@ -60,6 +65,10 @@ func (q *Queries) CreateRedirect(ctx context.Context, arg CreateRedirectParams)
 		&i.Enabled,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsRegex,
+		&i.DropQuery,
+		&i.HitCount,
+		&i.LastHitAt,
 	)
 	return i, err
 }
@@ -74,7 +83,7 @@ func (q *Queries) DeleteRedirect(ctx context.Context, id int64) error {
 }
 
 const getRedirectByID = `-- name: GetRedirectByID :one
-SELECT id, source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at FROM redirects WHERE id = ?
+SELECT id, source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at, is_regex, drop_query, hit_count, last_hit_at FROM redirects WHERE id = ?
 `
 
 func (q *Queries) GetRedirectByID(ctx context.Context, id int64) (Redirect, error) {
@@ -90,12 +99,16 @@ func (q *Queries) GetRedirectByID(ctx context.Context, id int64) (Redirect, erro
 		&i.Enabled,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsRegex,
+		&i.DropQuery,
+		&i.HitCount,
+		&i.LastHitAt,
 	)
 	return i, err
 }
 
 const getRedirectBySourcePath = `-- name: GetRedirectBySourcePath :one
-SELECT id, source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at FROM redirects WHERE source_path = ?
+SELECT id, source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at, is_regex, drop_query, hit_count, last_hit_at FROM redirects WHERE source_path = ?
 `
 
 func (q *Queries) GetRedirectBySourcePath(ctx context.Context, sourcePath string) (Redirect, error) {
@@ -111,12 +124,16 @@ func (q *Queries) GetRedirectBySourcePath(ctx context.Context, sourcePath string
 		&i.Enabled,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsRegex,
+		&i.DropQuery,
+		&i.HitCount,
+		&i.LastHitAt,
 	)
 	return i, err
 }
 
 const listEnabledRedirects = `-- name: ListEnabledRedirects :many
-SELECT id, source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at FROM redirects WHERE enabled = 1 ORDER BY source_path
+SELECT id, source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at, is_regex, drop_query, hit_count, last_hit_at FROM redirects WHERE enabled = 1 ORDER BY source_path
 `
 
 func (q *Queries) ListEnabledRedirects(ctx context.Context) ([]Redirect, error) {
@@ -138,6 +155,10 @@ func (q *Queries) ListEnabledRedirects(ctx context.Context) ([]Redirect, error)
 			&i.Enabled,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.IsRegex,
+			&i.DropQuery,
+			&i.HitCount,
+			&i.LastHitAt,
 		); err != nil {
 			return nil, err
 		}
@@ -153,7 +174,7 @@ func (q *Queries) ListEnabledRedirects(ctx context.Context) ([]Redirect, error)
 }
 
 const listRedirects = `-- name: ListRedirects :many
-SELECT id, source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at FROM redirects ORDER BY source_path
+SELECT id, source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at, is_regex, drop_query, hit_count, last_hit_at FROM redirects ORDER BY source_path
 `
 
 func (q *Queries) ListRedirects(ctx context.Context) ([]Redirect, error) {
@@ -175,6 +196,10 @@ func (q *Queries) ListRedirects(ctx context.Context) ([]Redirect, error) {
 			&i.Enabled,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.IsRegex,
+			&i.DropQuery,
+			&i.HitCount,
+			&i.LastHitAt,
 		); err != nil {
 			return nil, err
 		}
@@ -190,7 +215,7 @@ func (q *Queries) ListRedirects(ctx context.Context) ([]Redirect, error) {
 }
 
 const listRedirectsPaginated = `-- name: ListRedirectsPaginated :many
-SELECT id, source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at FROM redirects
+SELECT id, source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at, is_regex, drop_query, hit_count, last_hit_at FROM redirects
 ORDER BY source_path
 LIMIT ? OFFSET ?
 `
@@ -219,6 +244,10 @@ func (q *Queries) ListRedirectsPaginated(ctx context.Context, arg ListRedirectsP
 			&i.Enabled,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.IsRegex,
+			&i.DropQuery,
+			&i.HitCount,
+			&i.LastHitAt,
 		); err != nil {
 			return nil, err
 		}
@@ -233,6 +262,20 @@ func (q *Queries) ListRedirectsPaginated(ctx context.Context, arg ListRedirectsP
 	return items, nil
 }
 
+const recordRedirectHit = `-- name: RecordRedirectHit :exec
+UPDATE redirects SET hit_count = hit_count + 1, last_hit_at = ? WHERE id = ?
+`
+
+type RecordRedirectHitParams struct {
+	LastHitAt sql.NullTime `json:"last_hit_at"`
+	ID        int64        `json:"id"`
+}
+
+func (q *Queries) RecordRedirectHit(ctx context.Context, arg RecordRedirectHitParams) error {
+	_, err := q.db.ExecContext(ctx, recordRedirectHit, arg.LastHitAt, arg.ID)
+	return err
+}
+
 const redirectSourcePathExists = `-- name: RedirectSourcePathExists :one
 SELECT EXISTS(SELECT 1 FROM redirects WHERE source_path = ?)
 `
@@ -260,6 +303,21 @@ func (q *Queries) RedirectSourcePathExistsExcluding(ctx context.Context, arg Red
 	return column_1, err
 }
 
+const retargetRedirects = `-- name: RetargetRedirects :exec
+UPDATE redirects SET target_url = ?, updated_at = ? WHERE target_url = ?
+`
+
+type RetargetRedirectsParams struct {
+	TargetUrl   string    `json:"target_url"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	TargetUrl_2 string    `json:"target_url_2"`
+}
+
+func (q *Queries) RetargetRedirects(ctx context.Context, arg RetargetRedirectsParams) error {
+	_, err := q.db.ExecContext(ctx, retargetRedirects, arg.TargetUrl, arg.UpdatedAt, arg.TargetUrl_2)
+	return err
+}
+
 const toggleRedirectEnabled = `-- name: ToggleRedirectEnabled :exec
 UPDATE redirects SET enabled = NOT enabled, updated_at = ? WHERE id = ?
 `
@@ -282,9 +340,11 @@ UPDATE redirects SET
     is_wildcard = ?,
     target_type = ?,
     enabled = ?,
+    is_regex = ?,
+    drop_query = ?,
     updated_at = ?
 WHERE id = ?
-RETURNING id, source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at
+RETURNING id, source_path, target_url, status_code, is_wildcard, target_type, enabled, created_at, updated_at, is_regex, drop_query, hit_count, last_hit_at
 `
 
 type UpdateRedirectParams struct {
@@ -294,6 +354,8 @@ type UpdateRedirectParams struct {
 	IsWildcard bool      `json:"is_wildcard"`
 	TargetType string    `json:"target_type"`
 	Enabled    bool      `json:"enabled"`
+	IsRegex    bool      `json:"is_regex"`
+	DropQuery  bool      `json:"drop_query"`
 	UpdatedAt  time.Time `json:"updated_at"`
 	ID         int64     `json:"id"`
 }
@@ -306,6 +368,8 @@ func (q *Queries) UpdateRedirect(ctx context.Context, arg UpdateRedirectParams)
 		arg.IsWildcard,
 		arg.TargetType,
 		arg.Enabled,
+		arg.IsRegex,
+		arg.DropQuery,
 		arg.UpdatedAt,
 		arg.ID,
 	)
@@ -320,6 +384,10 @@ func (q *Queries) UpdateRedirect(ctx context.Context, arg UpdateRedirectParams)
 		&i.Enabled,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsRegex,
+		&i.DropQuery,
+		&i.HitCount,
+		&i.LastHitAt,
 	)
 	return i, err
 }
@@ -24,20 +24,25 @@ func (q *Queries) CountScheduledTaskRuns(ctx context.Context, taskID int64) (int
 
 const createScheduledTask = `-- name: CreateScheduledTask :one
 
-INSERT INTO scheduled_tasks (name, url, schedule, is_active, timeout_seconds, created_by, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, name, url, schedule, is_active, timeout_seconds, created_by, created_at, updated_at
+INSERT INTO scheduled_tasks (name, url, schedule, is_active, timeout_seconds, task_type, params, retry_policy, max_retries, retry_delay_seconds, created_by, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, name, url, schedule, is_active, timeout_seconds, created_by, created_at, updated_at, task_type, params, retry_policy, max_retries, retry_delay_seconds
 `
 
 type CreateScheduledTaskParams struct {
-	Name           string        `json:"name"`
-	Url            string        `json:"url"`
-	Schedule       string        `json:"schedule"`
-	IsActive       int64         `json:"is_active"`
-	TimeoutSeconds int64         `json:"timeout_seconds"`
-	CreatedBy      sql.NullInt64 `json:"created_by"`
-	CreatedAt      time.Time     `json:"created_at"`
-	UpdatedAt      time.Time     `json:"updated_at"`
+	Name              string        `json:"name"`
+	Url               string        `json:"url"`
+	Schedule          string        `json:"schedule"`
+	IsActive          int64         `json:"is_active"`
+	TimeoutSeconds    int64         `json:"timeout_seconds"`
+	TaskType          string        `json:"task_type"`
+	Params            string        `json:"params"`
+	RetryPolicy       string        `json:"retry_policy"`
+	MaxRetries        int64         `json:"max_retries"`
+	RetryDelaySeconds int64         `json:"retry_delay_seconds"`
+	CreatedBy         sql.NullInt64 `json:"created_by"`
+	CreatedAt         time.Time     `json:"created_at"`
+	UpdatedAt         time.Time     `json:"updated_at"`
 }
 
 // Scheduled Tasks
@@ -48,6 +53,11 @@ func (q *Queries) CreateScheduledTask(ctx context.Context, arg CreateScheduledTa
 		arg.Schedule,
 		arg.IsActive,
 		arg.TimeoutSeconds,
+		arg.TaskType,
+		arg.Params,
+		arg.RetryPolicy,
+		arg.MaxRetries,
+		arg.RetryDelaySeconds,
 		arg.CreatedBy,
 		arg.CreatedAt,
 		arg.UpdatedAt,
@@ -63,25 +73,31 @@ func (q *Queries) CreateScheduledTask(ctx context.Context, arg CreateScheduledTa
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TaskType,
+		&i.Params,
+		&i.RetryPolicy,
+		&i.MaxRetries,
+		&i.RetryDelaySeconds,
 	)
 	return i, err
 }
 
 const createScheduledTaskRun = `-- name: CreateScheduledTaskRun :one
 
-INSERT INTO scheduled_task_runs (task_id, status, started_at)
-VALUES (?, 'pending', ?)
-RETURNING id, task_id, status, status_code, response_body, error_message, duration_ms, started_at, completed_at
+INSERT INTO scheduled_task_runs (task_id, status, attempt, started_at)
+VALUES (?, 'pending', ?, ?)
+RETURNING id, task_id, status, status_code, response_body, error_message, duration_ms, started_at, completed_at, attempt, log
 `
 
 type CreateScheduledTaskRunParams struct {
 	TaskID    int64     `json:"task_id"`
+	Attempt   int64     `json:"attempt"`
 	StartedAt time.Time `json:"started_at"`
 }
 
 // Scheduled Task Runs
 func (q *Queries) CreateScheduledTaskRun(ctx context.Context, arg CreateScheduledTaskRunParams) (ScheduledTaskRun, error) {
-	row := q.db.QueryRowContext(ctx, createScheduledTaskRun, arg.TaskID, arg.StartedAt)
+	row := q.db.QueryRowContext(ctx, createScheduledTaskRun, arg.TaskID, arg.Attempt, arg.StartedAt)
 	var i ScheduledTaskRun
 	err := row.Scan(
 		&i.ID,
@@ -93,6 +109,8 @@ func (q *Queries) CreateScheduledTaskRun(ctx context.Context, arg CreateSchedule
 		&i.DurationMs,
 		&i.StartedAt,
 		&i.CompletedAt,
+		&i.Attempt,
+		&i.Log,
 	)
 	return i, err
 }
@@ -130,7 +148,7 @@ func (q *Queries) DeleteScheduledTask(ctx context.Context, id int64) error {
 }
 
 const getScheduledTask = `-- name: GetScheduledTask :one
-SELECT id, name, url, schedule, is_active, timeout_seconds, created_by, created_at, updated_at FROM scheduled_tasks WHERE id = ?
+SELECT id, name, url, schedule, is_active, timeout_seconds, created_by, created_at, updated_at, task_type, params, retry_policy, max_retries, retry_delay_seconds FROM scheduled_tasks WHERE id = ?
 `
 
 func (q *Queries) GetScheduledTask(ctx context.Context, id int64) (ScheduledTask, error) {
@@ -146,12 +164,17 @@ func (q *Queries) GetScheduledTask(ctx context.Context, id int64) (ScheduledTask
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TaskType,
+		&i.Params,
+		&i.RetryPolicy,
+		&i.MaxRetries,
+		&i.RetryDelaySeconds,
 	)
 	return i, err
 }
 
 const listActiveScheduledTasks = `-- name: ListActiveScheduledTasks :many
-SELECT id, name, url, schedule, is_active, timeout_seconds, created_by, created_at, updated_at FROM scheduled_tasks WHERE is_active = 1 ORDER BY name
+SELECT id, name, url, schedule, is_active, timeout_seconds, created_by, created_at, updated_at, task_type, params, retry_policy, max_retries, retry_delay_seconds FROM scheduled_tasks WHERE is_active = 1 ORDER BY name
 `
 
 func (q *Queries) ListActiveScheduledTasks(ctx context.Context) ([]ScheduledTask, error) {
@@ -173,6 +196,11 @@ func (q *Queries) ListActiveScheduledTasks(ctx context.Context) ([]ScheduledTask
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TaskType,
+			&i.Params,
+			&i.RetryPolicy,
+			&i.MaxRetries,
+			&i.RetryDelaySeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -188,7 +216,7 @@ func (q *Queries) ListActiveScheduledTasks(ctx context.Context) ([]ScheduledTask
 }
 
 const listScheduledTaskRuns = `-- name: ListScheduledTaskRuns :many
-SELECT id, task_id, status, status_code, response_body, error_message, duration_ms, started_at, completed_at FROM scheduled_task_runs
+SELECT id, task_id, status, status_code, response_body, error_message, duration_ms, started_at, completed_at, attempt, log FROM scheduled_task_runs
 WHERE task_id = ?
 ORDER BY started_at DESC
 LIMIT ? OFFSET ?
@@ -219,6 +247,8 @@ func (q *Queries) ListScheduledTaskRuns(ctx context.Context, arg ListScheduledTa
 			&i.DurationMs,
 			&i.StartedAt,
 			&i.CompletedAt,
+			&i.Attempt,
+			&i.Log,
 		); err != nil {
 			return nil, err
 		}
@@ -234,7 +264,7 @@ func (q *Queries) ListScheduledTaskRuns(ctx context.Context, arg ListScheduledTa
 }
 
 const listScheduledTasks = `-- name: ListScheduledTasks :many
-SELECT id, name, url, schedule, is_active, timeout_seconds, created_by, created_at, updated_at FROM scheduled_tasks ORDER BY name
+SELECT id, name, url, schedule, is_active, timeout_seconds, created_by, created_at, updated_at, task_type, params, retry_policy, max_retries, retry_delay_seconds FROM scheduled_tasks ORDER BY name
 `
 
 func (q *Queries) ListScheduledTasks(ctx context.Context) ([]ScheduledTask, error) {
@@ -256,6 +286,11 @@ func (q *Queries) ListScheduledTasks(ctx context.Context) ([]ScheduledTask, erro
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TaskType,
+			&i.Params,
+			&i.RetryPolicy,
+			&i.MaxRetries,
+			&i.RetryDelaySeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -273,7 +308,7 @@ func (q *Queries) ListScheduledTasks(ctx context.Context) ([]ScheduledTask, erro
 const toggleScheduledTask = `-- name: ToggleScheduledTask :one
 UPDATE scheduled_tasks SET is_active = ?, updated_at = ?
 WHERE id = ?
-RETURNING id, name, url, schedule, is_active, timeout_seconds, created_by, created_at, updated_at
+RETURNING id, name, url, schedule, is_active, timeout_seconds, created_by, created_at, updated_at, task_type, params, retry_policy, max_retries, retry_delay_seconds
 `
 
 type ToggleScheduledTaskParams struct {
@@ -295,23 +330,33 @@ func (q *Queries) ToggleScheduledTask(ctx context.Context, arg ToggleScheduledTa
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TaskType,
+		&i.Params,
+		&i.RetryPolicy,
+		&i.MaxRetries,
+		&i.RetryDelaySeconds,
 	)
 	return i, err
 }
 
 const updateScheduledTask = `-- name: UpdateScheduledTask :one
-UPDATE scheduled_tasks SET name = ?, url = ?, schedule = ?, timeout_seconds = ?, updated_at = ?
+UPDATE scheduled_tasks SET name = ?, url = ?, schedule = ?, timeout_seconds = ?, task_type = ?, params = ?, retry_policy = ?, max_retries = ?, retry_delay_seconds = ?, updated_at = ?
 WHERE id = ?
-RETURNING id, name, url, schedule, is_active, timeout_seconds, created_by, created_at, updated_at
+RETURNING id, name, url, schedule, is_active, timeout_seconds, created_by, created_at, updated_at, task_type, params, retry_policy, max_retries, retry_delay_seconds
 `
 
 type UpdateScheduledTaskParams struct {
-	Name           string    `json:"name"`
-	Url            string    `json:"url"`
-	Schedule       string    `json:"schedule"`
-	TimeoutSeconds int64     `json:"timeout_seconds"`
-	UpdatedAt      time.Time `json:"updated_at"`
-	ID             int64     `json:"id"`
+	Name              string    `json:"name"`
+	Url               string    `json:"url"`
+	Schedule          string    `json:"schedule"`
+	TimeoutSeconds    int64     `json:"timeout_seconds"`
+	TaskType          string    `json:"task_type"`
+	Params            string    `json:"params"`
+	RetryPolicy       string    `json:"retry_policy"`
+	MaxRetries        int64     `json:"max_retries"`
+	RetryDelaySeconds int64     `json:"retry_delay_seconds"`
+	UpdatedAt         time.Time `json:"updated_at"`
+	ID                int64     `json:"id"`
 }
 
 func (q *Queries) UpdateScheduledTask(ctx context.Context, arg UpdateScheduledTaskParams) (ScheduledTask, error) {
@@ -320,6 +365,11 @@ func (q *Queries) UpdateScheduledTask(ctx context.Context, arg UpdateScheduledTa
 		arg.Url,
 		arg.Schedule,
 		arg.TimeoutSeconds,
+		arg.TaskType,
+		arg.Params,
+		arg.RetryPolicy,
+		arg.MaxRetries,
+		arg.RetryDelaySeconds,
 		arg.UpdatedAt,
 		arg.ID,
 	)
@@ -334,18 +384,24 @@ func (q *Queries) UpdateScheduledTask(ctx context.Context, arg UpdateScheduledTa
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TaskType,
+		&i.Params,
+		&i.RetryPolicy,
+		&i.MaxRetries,
+		&i.RetryDelaySeconds,
 	)
 	return i, err
 }
 
 const updateScheduledTaskRunFailed = `-- name: UpdateScheduledTaskRunFailed :exec
 UPDATE scheduled_task_runs
-SET status = 'failed', error_message = ?, duration_ms = ?, completed_at = ?
+SET status = 'failed', error_message = ?, log = ?, duration_ms = ?, completed_at = ?
 WHERE id = ?
 `
 
 type UpdateScheduledTaskRunFailedParams struct {
 	ErrorMessage sql.NullString `json:"error_message"`
+	Log          string         `json:"log"`
 	DurationMs   sql.NullInt64  `json:"duration_ms"`
 	CompletedAt  sql.NullTime   `json:"completed_at"`
 	ID           int64          `json:"id"`
@@ -354,6 +410,7 @@ type UpdateScheduledTaskRunFailedParams struct {
 func (q *Queries) UpdateScheduledTaskRunFailed(ctx context.Context, arg UpdateScheduledTaskRunFailedParams) error {
 	_, err := q.db.ExecContext(ctx, updateScheduledTaskRunFailed,
 		arg.ErrorMessage,
+		arg.Log,
 		arg.DurationMs,
 		arg.CompletedAt,
 		arg.ID,
@@ -363,13 +420,14 @@ func (q *Queries) UpdateScheduledTaskRunFailed(ctx context.Context, arg UpdateSc
 
 const updateScheduledTaskRunSuccess = `-- name: UpdateScheduledTaskRunSuccess :exec
 UPDATE scheduled_task_runs
-SET status = 'success', status_code = ?, response_body = ?, duration_ms = ?, completed_at = ?
+SET status = 'success', status_code = ?, response_body = ?, log = ?, duration_ms = ?, completed_at = ?
 WHERE id = ?
 `
 
 type UpdateScheduledTaskRunSuccessParams struct {
 	StatusCode   sql.NullInt64  `json:"status_code"`
 	ResponseBody sql.NullString `json:"response_body"`
+	Log          string         `json:"log"`
 	DurationMs   sql.NullInt64  `json:"duration_ms"`
 	CompletedAt  sql.NullTime   `json:"completed_at"`
 	ID           int64          `json:"id"`
@@ -379,6 +437,7 @@ func (q *Queries) UpdateScheduledTaskRunSuccess(ctx context.Context, arg UpdateS
 	_, err := q.db.ExecContext(ctx, updateScheduledTaskRunSuccess,
 		arg.StatusCode,
 		arg.ResponseBody,
+		arg.Log,
 		arg.DurationMs,
 		arg.CompletedAt,
 		arg.ID,
@@ -199,6 +199,7 @@ func seedMenus(ctx context.Context, queries *Queries) error {
 			LanguageCode: defaultLang.Code,
 			CreatedAt:    now,
 			UpdatedAt:    now,
+			SiteID:       DefaultSiteID,
 		})
 		if err != nil {
 			return fmt.Errorf("seeding menu %s: %w", menu.Slug, err)
@@ -440,6 +440,7 @@ func seedDemoPages(ctx context.Context, queries *Queries, authorID int64, langCo
 			PublishedAt:       publishedAt,
 			CreatedAt:         now,
 			UpdatedAt:         now,
+			SiteID:            DefaultSiteID,
 		})
 		if err != nil {
 			return fmt.Errorf("creating page %s: %w", page.Slug, err)
@@ -785,6 +786,7 @@ func createDemoImage(ctx context.Context, queries *Queries, userID int64, langCo
 		LanguageCode: langCode,
 		CreatedAt:    now,
 		UpdatedAt:    now,
+		SiteID:       DefaultSiteID,
 	})
 	if err != nil {
 		_ = os.Remove(originalPath)
@@ -0,0 +1,63 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// DefaultSiteID is the site that owns content created before sites existed
+// and serves every request whose host is not mapped to another site.
+const DefaultSiteID int64 = 1
+
+type siteIDKey struct{}
+
+// WithSiteID returns a context scoped to the given site.
+func WithSiteID(ctx context.Context, siteID int64) context.Context {
+	return context.WithValue(ctx, siteIDKey{}, siteID)
+}
+
+// SiteIDFromContext returns the site a context is scoped to, or
+// DefaultSiteID when it is not scoped to one.
+func SiteIDFromContext(ctx context.Context) int64 {
+	if id, ok := ctx.Value(siteIDKey{}).(int64); ok && id > 0 {
+		return id
+	}
+	return DefaultSiteID
+}
+
+// GetSiteConfigByKey returns a config entry with the value the site ctx is
+// scoped to overrides it with. Sites may override keys that have no global
+// entry; only the key and value of such entries are set.
+func (q *Queries) GetSiteConfigByKey(ctx context.Context, key string) (Config, error) {
+	cfg, err := q.GetConfigByKey(ctx, key)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return Config{}, err
+	}
+	siteID := SiteIDFromContext(ctx)
+	if siteID == DefaultSiteID {
+		return cfg, err
+	}
+	value, siteErr := q.GetSiteConfigValue(ctx, GetSiteConfigValueParams{SiteID: siteID, Key: key})
+	if siteErr != nil {
+		return cfg, err
+	}
+	cfg.Key = key
+	cfg.Value = value
+	return cfg, nil
+}
+
+// SiteOverridesConfig reports whether the site ctx is scoped to overrides a
+// config key. Config translations belong to the global value, so they do not
+// apply to overridden keys.
+func (q *Queries) SiteOverridesConfig(ctx context.Context, key string) bool {
+	siteID := SiteIDFromContext(ctx)
+	if siteID == DefaultSiteID {
+		return false
+	}
+	_, err := q.GetSiteConfigValue(ctx, GetSiteConfigValueParams{SiteID: siteID, Key: key})
+	return err == nil
+}
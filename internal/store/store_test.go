@@ -91,6 +91,7 @@ func createTestPage(t *testing.T, q *Queries, ctx context.Context, authorID int6
 		LanguageCode: getDefaultLangCode(t, q, ctx),
 		CreatedAt:    now,
 		UpdatedAt:    now,
+		SiteID:       DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage(%s): %v", slug, err)
@@ -128,6 +129,7 @@ func createTestMenu(t *testing.T, q *Queries, ctx context.Context, slug string)
 	return createTestEntity(t, "Menu", slug, func() (Menu, error) {
 		return q.CreateMenu(ctx, CreateMenuParams{
 			Name: "Test Menu", Slug: slug, LanguageCode: langCode, CreatedAt: now, UpdatedAt: now,
+			SiteID: DefaultSiteID,
 		})
 	})
 }
@@ -505,6 +507,7 @@ func TestCreatePage(t *testing.T) {
 		Title: "Test Page", Slug: "test-page", Body: "<p>Hello World</p>",
 		Status: "draft", AuthorID: user.ID, LanguageCode: langCode,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage: %v", err)
@@ -548,7 +551,7 @@ func TestGetPageBySlug(t *testing.T) {
 	user := createTestUser(t, q, ctx, "author@example.com")
 	createTestPage(t, q, ctx, user.ID, "slug-test")
 
-	found, err := q.GetPageBySlug(ctx, "slug-test")
+	found, err := q.GetPageBySlug(ctx, GetPageBySlugParams{Slug: "slug-test", SiteID: DefaultSiteID})
 	if err != nil {
 		t.Fatalf("GetPageBySlug: %v", err)
 	}
@@ -664,6 +667,7 @@ func TestPublishPage(t *testing.T) {
 		Status: "draft", AuthorID: user.ID, LanguageCode: langCode,
 		ScheduledAt: scheduledAt,
 		CreatedAt:   now, UpdatedAt: now,
+		SiteID: DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage: %v", err)
@@ -706,6 +710,7 @@ func TestUnpublishPageClearsScheduledAt(t *testing.T) {
 		ScheduledAt: scheduledAt,
 		PublishedAt: sql.NullTime{Time: publishTime, Valid: true},
 		CreatedAt:   now, UpdatedAt: now,
+		SiteID: DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage: %v", err)
@@ -872,6 +877,7 @@ func TestCreateMenu(t *testing.T) {
 	menu, err := q.CreateMenu(ctx, CreateMenuParams{
 		Name: "Main Menu", Slug: "main", LanguageCode: langCode,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMenu: %v", err)
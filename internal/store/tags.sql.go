@@ -100,69 +100,6 @@ func (q *Queries) DeleteTag(ctx context.Context, id int64) error {
 	return err
 }
 
-const getPagesForTag = `-- name: GetPagesForTag :many
-SELECT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title FROM pages p
-INNER JOIN page_tags pt ON pt.page_id = p.id
-WHERE pt.tag_id = ?
-ORDER BY p.created_at DESC
-LIMIT ? OFFSET ?
-`
-
-type GetPagesForTagParams struct {
-	TagID  int64 `json:"tag_id"`
-	Limit  int64 `json:"limit"`
-	Offset int64 `json:"offset"`
-}
-
-func (q *Queries) GetPagesForTag(ctx context.Context, arg GetPagesForTagParams) ([]Page, error) {
-	rows, err := q.db.QueryContext(ctx, getPagesForTag, arg.TagID, arg.Limit, arg.Offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []Page{}
-	for rows.Next() {
-		var i Page
-		if err := rows.Scan(
-			&i.ID,
-			&i.Title,
-			&i.Slug,
-			&i.Body,
-			&i.Status,
-			&i.AuthorID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.PublishedAt,
-			&i.FeaturedImageID,
-			&i.MetaTitle,
-			&i.MetaDescription,
-			&i.MetaKeywords,
-			&i.OgImageID,
-			&i.NoIndex,
-			&i.NoFollow,
-			&i.CanonicalUrl,
-			&i.ScheduledAt,
-			&i.LanguageCode,
-			&i.HideFeaturedImage,
-			&i.PageType,
-			&i.ExcludeFromLists,
-			&i.Summary,
-			&i.VideoUrl,
-			&i.VideoTitle,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const getPublishedTagUsageCounts = `-- name: GetPublishedTagUsageCounts :many
 SELECT t.id, t.name, t.slug, t.language_code, t.created_at, t.updated_at, COUNT(p.id) as usage_count
 FROM tags t
@@ -467,7 +404,7 @@ const getTagUsageCountsByLanguage = `-- name: GetTagUsageCountsByLanguage :many
 SELECT t.id, t.name, t.slug, t.language_code, t.created_at, t.updated_at, COUNT(p.id) as usage_count
 FROM tags t
 INNER JOIN page_tags pt ON pt.tag_id = t.id
-INNER JOIN pages p ON p.id = pt.page_id AND p.status = 'published' AND p.language_code = ?
+INNER JOIN pages p ON p.id = pt.page_id AND p.status = 'published' AND p.language_code = ? AND p.site_id = ?
 WHERE t.language_code = ?
 GROUP BY t.id, t.name, t.slug, t.language_code, t.created_at, t.updated_at
 ORDER BY usage_count DESC, t.name
@@ -476,6 +413,7 @@ LIMIT ? OFFSET ?
 
 type GetTagUsageCountsByLanguageParams struct {
 	LanguageCode   string `json:"language_code"`
+	SiteID         int64  `json:"site_id"`
 	LanguageCode_2 string `json:"language_code_2"`
 	Limit          int64  `json:"limit"`
 	Offset         int64  `json:"offset"`
@@ -495,6 +433,7 @@ type GetTagUsageCountsByLanguageRow struct {
 func (q *Queries) GetTagUsageCountsByLanguage(ctx context.Context, arg GetTagUsageCountsByLanguageParams) ([]GetTagUsageCountsByLanguageRow, error) {
 	rows, err := q.db.QueryContext(ctx, getTagUsageCountsByLanguage,
 		arg.LanguageCode,
+		arg.SiteID,
 		arg.LanguageCode_2,
 		arg.Limit,
 		arg.Offset,
@@ -677,9 +616,22 @@ func (q *Queries) ListTagsByLanguage(ctx context.Context, languageCode string) (
 }
 
 const listTagsForSitemap = `-- name: ListTagsForSitemap :many
+
 SELECT t.id, t.slug, t.updated_at, t.language_code, l.is_default
 FROM tags t
 INNER JOIN languages l ON l.code = t.language_code AND l.is_active = 1
+WHERE EXISTS (
+    SELECT 1 FROM page_tags pt
+    INNER JOIN pages p ON p.id = pt.page_id
+    WHERE pt.tag_id = t.id AND p.status = 'published' AND p.site_id = ?1
+) OR (
+    ?1 = (SELECT id FROM sites WHERE is_default = 1)
+    AND NOT EXISTS (
+        SELECT 1 FROM page_tags pt
+        INNER JOIN pages p ON p.id = pt.page_id
+        WHERE pt.tag_id = t.id AND p.status = 'published'
+    )
+)
 ORDER BY t.updated_at DESC
 `
 
@@ -691,8 +643,9 @@ type ListTagsForSitemapRow struct {
 	IsDefault    bool      `json:"is_default"`
 }
 
-func (q *Queries) ListTagsForSitemap(ctx context.Context) ([]ListTagsForSitemapRow, error) {
-	rows, err := q.db.QueryContext(ctx, listTagsForSitemap)
+// Tags with published pages of a site.
+func (q *Queries) ListTagsForSitemap(ctx context.Context, siteID int64) ([]ListTagsForSitemapRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTagsForSitemap, siteID)
 	if err != nil {
 		return nil, err
 	}
@@ -36,17 +36,69 @@ func (q *Queries) CountPublishedPagesByLanguage(ctx context.Context, languageCod
 }
 
 const countPublishedPostsByLanguage = `-- name: CountPublishedPostsByLanguage :one
-SELECT COUNT(*) FROM pages WHERE language_code = ? AND status = 'published' AND page_type = 'post' AND exclude_from_lists = 0
+SELECT COUNT(*) FROM pages WHERE language_code = ? AND status = 'published' AND page_type = 'post' AND exclude_from_lists = 0 AND site_id = ?
 `
 
+type CountPublishedPostsByLanguageParams struct {
+	LanguageCode string `json:"language_code"`
+	SiteID       int64  `json:"site_id"`
+}
+
 // Count published posts for a specific language
-func (q *Queries) CountPublishedPostsByLanguage(ctx context.Context, languageCode string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countPublishedPostsByLanguage, languageCode)
+func (q *Queries) CountPublishedPostsByLanguage(ctx context.Context, arg CountPublishedPostsByLanguageParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPublishedPostsByLanguage, arg.LanguageCode, arg.SiteID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
+const countTranslatableEntitiesByLanguage = `-- name: CountTranslatableEntitiesByLanguage :many
+SELECT entity_type, language_code, COUNT(*) AS entity_count
+FROM (
+    SELECT 'page' AS entity_type, language_code FROM pages
+    UNION ALL
+    SELECT 'category', language_code FROM categories
+    UNION ALL
+    SELECT 'tag', language_code FROM tags
+    UNION ALL
+    SELECT 'menu', language_code FROM menus
+    UNION ALL
+    SELECT 'form', language_code FROM forms
+)
+GROUP BY entity_type, language_code
+ORDER BY entity_type, language_code
+`
+
+type CountTranslatableEntitiesByLanguageRow struct {
+	EntityType   string `json:"entity_type"`
+	LanguageCode string `json:"language_code"`
+	EntityCount  int64  `json:"entity_count"`
+}
+
+// Count the translatable entities of every kind per language
+func (q *Queries) CountTranslatableEntitiesByLanguage(ctx context.Context) ([]CountTranslatableEntitiesByLanguageRow, error) {
+	rows, err := q.db.QueryContext(ctx, countTranslatableEntitiesByLanguage)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountTranslatableEntitiesByLanguageRow{}
+	for rows.Next() {
+		var i CountTranslatableEntitiesByLanguageRow
+		if err := rows.Scan(&i.EntityType, &i.LanguageCode, &i.EntityCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const countTranslationsForEntity = `-- name: CountTranslationsForEntity :one
 SELECT COUNT(*) FROM translations WHERE entity_type = ? AND entity_id = ?
 `
@@ -67,7 +119,7 @@ func (q *Queries) CountTranslationsForEntity(ctx context.Context, arg CountTrans
 const createTranslation = `-- name: CreateTranslation :one
 INSERT INTO translations (entity_type, entity_id, language_id, translation_id, created_at)
 VALUES (?, ?, ?, ?, ?)
-RETURNING id, entity_type, entity_id, language_id, translation_id, created_at
+RETURNING id, entity_type, entity_id, language_id, translation_id, created_at, source_version_id, source_updated_at
 `
 
 type CreateTranslationParams struct {
@@ -94,6 +146,8 @@ func (q *Queries) CreateTranslation(ctx context.Context, arg CreateTranslationPa
 		&i.LanguageID,
 		&i.TranslationID,
 		&i.CreatedAt,
+		&i.SourceVersionID,
+		&i.SourceUpdatedAt,
 	)
 	return i, err
 }
@@ -153,7 +207,7 @@ func (q *Queries) DeleteTranslationsRelatedToEntity(ctx context.Context, arg Del
 }
 
 const getAllTranslationsOfEntity = `-- name: GetAllTranslationsOfEntity :many
-SELECT t.id, t.entity_type, t.entity_id, t.language_id, t.translation_id, t.created_at, l.code as language_code, l.name as language_name, l.native_name as language_native_name
+SELECT t.id, t.entity_type, t.entity_id, t.language_id, t.translation_id, t.created_at, t.source_version_id, t.source_updated_at, l.code as language_code, l.name as language_name, l.native_name as language_native_name
 FROM translations t
 INNER JOIN languages l ON l.id = t.language_id
 WHERE t.entity_type = ? AND (t.entity_id = ? OR t.translation_id = ?)
@@ -167,15 +221,17 @@ type GetAllTranslationsOfEntityParams struct {
 }
 
 type GetAllTranslationsOfEntityRow struct {
-	ID                 int64     `json:"id"`
-	EntityType         string    `json:"entity_type"`
-	EntityID           int64     `json:"entity_id"`
-	LanguageID         int64     `json:"language_id"`
-	TranslationID      int64     `json:"translation_id"`
-	CreatedAt          time.Time `json:"created_at"`
-	LanguageCode       string    `json:"language_code"`
-	LanguageName       string    `json:"language_name"`
-	LanguageNativeName string    `json:"language_native_name"`
+	ID                 int64         `json:"id"`
+	EntityType         string        `json:"entity_type"`
+	EntityID           int64         `json:"entity_id"`
+	LanguageID         int64         `json:"language_id"`
+	TranslationID      int64         `json:"translation_id"`
+	CreatedAt          time.Time     `json:"created_at"`
+	SourceVersionID    sql.NullInt64 `json:"source_version_id"`
+	SourceUpdatedAt    sql.NullTime  `json:"source_updated_at"`
+	LanguageCode       string        `json:"language_code"`
+	LanguageName       string        `json:"language_name"`
+	LanguageNativeName string        `json:"language_native_name"`
 }
 
 func (q *Queries) GetAllTranslationsOfEntity(ctx context.Context, arg GetAllTranslationsOfEntityParams) ([]GetAllTranslationsOfEntityRow, error) {
@@ -194,6 +250,8 @@ func (q *Queries) GetAllTranslationsOfEntity(ctx context.Context, arg GetAllTran
 			&i.LanguageID,
 			&i.TranslationID,
 			&i.CreatedAt,
+			&i.SourceVersionID,
+			&i.SourceUpdatedAt,
 			&i.LanguageCode,
 			&i.LanguageName,
 			&i.LanguageNativeName,
@@ -297,7 +355,7 @@ func (q *Queries) GetPageAvailableTranslations(ctx context.Context, entityID int
 
 const getPageByLanguageFromTranslation = `-- name: GetPageByLanguageFromTranslation :one
 
-SELECT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title FROM pages p
+SELECT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title, p.site_id FROM pages p
 INNER JOIN translations t ON t.translation_id = p.id
 WHERE t.entity_type = 'page' AND t.entity_id = ? AND t.language_id = ?
 `
@@ -337,12 +395,13 @@ func (q *Queries) GetPageByLanguageFromTranslation(ctx context.Context, arg GetP
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const getPageTranslationBySlug = `-- name: GetPageTranslationBySlug :one
-SELECT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title FROM pages p
+SELECT p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title, p.site_id FROM pages p
 INNER JOIN translations t ON t.translation_id = p.id
 INNER JOIN pages source ON source.id = t.entity_id
 WHERE t.entity_type = 'page'
@@ -386,6 +445,7 @@ func (q *Queries) GetPageTranslationBySlug(ctx context.Context, arg GetPageTrans
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 	)
 	return i, err
 }
@@ -445,7 +505,7 @@ func (q *Queries) GetPageTranslationLinks(ctx context.Context, entityID int64) (
 
 const getPageWithLanguage = `-- name: GetPageWithLanguage :one
 SELECT
-    p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title,
+    p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title, p.site_id,
     l.name as language_name,
     l.native_name as language_native_name,
     l.direction as language_direction
@@ -480,6 +540,7 @@ type GetPageWithLanguageRow struct {
 	Summary            string        `json:"summary"`
 	VideoUrl           string        `json:"video_url"`
 	VideoTitle         string        `json:"video_title"`
+	SiteID             int64         `json:"site_id"`
 	LanguageName       string        `json:"language_name"`
 	LanguageNativeName string        `json:"language_native_name"`
 	LanguageDirection  string        `json:"language_direction"`
@@ -515,6 +576,7 @@ func (q *Queries) GetPageWithLanguage(ctx context.Context, id int64) (GetPageWit
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 		&i.LanguageName,
 		&i.LanguageNativeName,
 		&i.LanguageDirection,
@@ -524,7 +586,7 @@ func (q *Queries) GetPageWithLanguage(ctx context.Context, id int64) (GetPageWit
 
 const getPublishedPageWithLanguageBySlug = `-- name: GetPublishedPageWithLanguageBySlug :one
 SELECT
-    p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title,
+    p.id, p.title, p.slug, p.body, p.status, p.author_id, p.created_at, p.updated_at, p.published_at, p.featured_image_id, p.meta_title, p.meta_description, p.meta_keywords, p.og_image_id, p.no_index, p.no_follow, p.canonical_url, p.scheduled_at, p.language_code, p.hide_featured_image, p.page_type, p.exclude_from_lists, p.summary, p.video_url, p.video_title, p.site_id,
     l.name as language_name,
     l.native_name as language_native_name,
     l.direction as language_direction,
@@ -560,6 +622,7 @@ type GetPublishedPageWithLanguageBySlugRow struct {
 	Summary            string        `json:"summary"`
 	VideoUrl           string        `json:"video_url"`
 	VideoTitle         string        `json:"video_title"`
+	SiteID             int64         `json:"site_id"`
 	LanguageName       string        `json:"language_name"`
 	LanguageNativeName string        `json:"language_native_name"`
 	LanguageDirection  string        `json:"language_direction"`
@@ -596,6 +659,7 @@ func (q *Queries) GetPublishedPageWithLanguageBySlug(ctx context.Context, slug s
 		&i.Summary,
 		&i.VideoUrl,
 		&i.VideoTitle,
+		&i.SiteID,
 		&i.LanguageName,
 		&i.LanguageNativeName,
 		&i.LanguageDirection,
@@ -612,6 +676,8 @@ SELECT
     t.language_id,
     t.translation_id,
     t.created_at,
+    t.source_version_id,
+    t.source_updated_at,
     l.code as language_code,
     l.name as language_name,
     l.native_name as language_native_name
@@ -629,15 +695,17 @@ type GetRelatedTranslationsParams struct {
 }
 
 type GetRelatedTranslationsRow struct {
-	ID                 int64     `json:"id"`
-	EntityType         string    `json:"entity_type"`
-	EntityID           int64     `json:"entity_id"`
-	LanguageID         int64     `json:"language_id"`
-	TranslationID      int64     `json:"translation_id"`
-	CreatedAt          time.Time `json:"created_at"`
-	LanguageCode       string    `json:"language_code"`
-	LanguageName       string    `json:"language_name"`
-	LanguageNativeName string    `json:"language_native_name"`
+	ID                 int64         `json:"id"`
+	EntityType         string        `json:"entity_type"`
+	EntityID           int64         `json:"entity_id"`
+	LanguageID         int64         `json:"language_id"`
+	TranslationID      int64         `json:"translation_id"`
+	CreatedAt          time.Time     `json:"created_at"`
+	SourceVersionID    sql.NullInt64 `json:"source_version_id"`
+	SourceUpdatedAt    sql.NullTime  `json:"source_updated_at"`
+	LanguageCode       string        `json:"language_code"`
+	LanguageName       string        `json:"language_name"`
+	LanguageNativeName string        `json:"language_native_name"`
 }
 
 // Get all translations related to an entity (where entity is either source or target)
@@ -657,6 +725,8 @@ func (q *Queries) GetRelatedTranslations(ctx context.Context, arg GetRelatedTran
 			&i.LanguageID,
 			&i.TranslationID,
 			&i.CreatedAt,
+			&i.SourceVersionID,
+			&i.SourceUpdatedAt,
 			&i.LanguageCode,
 			&i.LanguageName,
 			&i.LanguageNativeName,
@@ -694,7 +764,7 @@ func (q *Queries) GetTranslatedEntityID(ctx context.Context, arg GetTranslatedEn
 }
 
 const getTranslation = `-- name: GetTranslation :one
-SELECT id, entity_type, entity_id, language_id, translation_id, created_at FROM translations
+SELECT id, entity_type, entity_id, language_id, translation_id, created_at, source_version_id, source_updated_at FROM translations
 WHERE entity_type = ? AND entity_id = ? AND language_id = ?
 `
 
@@ -714,12 +784,14 @@ func (q *Queries) GetTranslation(ctx context.Context, arg GetTranslationParams)
 		&i.LanguageID,
 		&i.TranslationID,
 		&i.CreatedAt,
+		&i.SourceVersionID,
+		&i.SourceUpdatedAt,
 	)
 	return i, err
 }
 
 const getTranslationByID = `-- name: GetTranslationByID :one
-SELECT id, entity_type, entity_id, language_id, translation_id, created_at FROM translations WHERE id = ?
+SELECT id, entity_type, entity_id, language_id, translation_id, created_at, source_version_id, source_updated_at FROM translations WHERE id = ?
 `
 
 func (q *Queries) GetTranslationByID(ctx context.Context, id int64) (Translation, error) {
@@ -732,6 +804,8 @@ func (q *Queries) GetTranslationByID(ctx context.Context, id int64) (Translation
 		&i.LanguageID,
 		&i.TranslationID,
 		&i.CreatedAt,
+		&i.SourceVersionID,
+		&i.SourceUpdatedAt,
 	)
 	return i, err
 }
@@ -921,7 +995,7 @@ func (q *Queries) GetTranslationStats(ctx context.Context) (GetTranslationStatsR
 }
 
 const getTranslationsForEntity = `-- name: GetTranslationsForEntity :many
-SELECT t.id, t.entity_type, t.entity_id, t.language_id, t.translation_id, t.created_at, l.code as language_code, l.name as language_name, l.native_name as language_native_name
+SELECT t.id, t.entity_type, t.entity_id, t.language_id, t.translation_id, t.created_at, t.source_version_id, t.source_updated_at, l.code as language_code, l.name as language_name, l.native_name as language_native_name
 FROM translations t
 INNER JOIN languages l ON l.id = t.language_id
 WHERE t.entity_type = ? AND t.entity_id = ?
@@ -934,15 +1008,17 @@ type GetTranslationsForEntityParams struct {
 }
 
 type GetTranslationsForEntityRow struct {
-	ID                 int64     `json:"id"`
-	EntityType         string    `json:"entity_type"`
-	EntityID           int64     `json:"entity_id"`
-	LanguageID         int64     `json:"language_id"`
-	TranslationID      int64     `json:"translation_id"`
-	CreatedAt          time.Time `json:"created_at"`
-	LanguageCode       string    `json:"language_code"`
-	LanguageName       string    `json:"language_name"`
-	LanguageNativeName string    `json:"language_native_name"`
+	ID                 int64         `json:"id"`
+	EntityType         string        `json:"entity_type"`
+	EntityID           int64         `json:"entity_id"`
+	LanguageID         int64         `json:"language_id"`
+	TranslationID      int64         `json:"translation_id"`
+	CreatedAt          time.Time     `json:"created_at"`
+	SourceVersionID    sql.NullInt64 `json:"source_version_id"`
+	SourceUpdatedAt    sql.NullTime  `json:"source_updated_at"`
+	LanguageCode       string        `json:"language_code"`
+	LanguageName       string        `json:"language_name"`
+	LanguageNativeName string        `json:"language_native_name"`
 }
 
 func (q *Queries) GetTranslationsForEntity(ctx context.Context, arg GetTranslationsForEntityParams) ([]GetTranslationsForEntityRow, error) {
@@ -961,6 +1037,8 @@ func (q *Queries) GetTranslationsForEntity(ctx context.Context, arg GetTranslati
 			&i.LanguageID,
 			&i.TranslationID,
 			&i.CreatedAt,
+			&i.SourceVersionID,
+			&i.SourceUpdatedAt,
 			&i.LanguageCode,
 			&i.LanguageName,
 			&i.LanguageNativeName,
@@ -1029,8 +1107,74 @@ func (q *Queries) GetTranslationsForPagesBatch(ctx context.Context) ([]GetTransl
 	return items, nil
 }
 
+const listOutdatedPageTranslationIDs = `-- name: ListOutdatedPageTranslationIDs :many
+SELECT DISTINCT t.translation_id
+FROM translations t
+WHERE t.entity_type = 'page'
+  AND COALESCE((SELECT MAX(pv.id) FROM page_versions pv WHERE pv.page_id = t.entity_id), 0) > COALESCE(t.source_version_id, 0)
+ORDER BY t.translation_id
+`
+
+// List pages that are translations of a page changed since they were last
+// brought up to date
+func (q *Queries) ListOutdatedPageTranslationIDs(ctx context.Context) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, listOutdatedPageTranslationIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var translation_id int64
+		if err := rows.Scan(&translation_id); err != nil {
+			return nil, err
+		}
+		items = append(items, translation_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOutdatedPageTranslationSources = `-- name: ListOutdatedPageTranslationSources :many
+SELECT t.entity_id
+FROM translations t
+WHERE t.entity_type = 'page' AND t.translation_id = ?
+  AND COALESCE((SELECT MAX(pv.id) FROM page_versions pv WHERE pv.page_id = t.entity_id), 0) > COALESCE(t.source_version_id, 0)
+ORDER BY t.entity_id
+`
+
+// List the pages a page was translated from that changed since the
+// translation was last brought up to date
+func (q *Queries) ListOutdatedPageTranslationSources(ctx context.Context, translationID int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, listOutdatedPageTranslationSources, translationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var entity_id int64
+		if err := rows.Scan(&entity_id); err != nil {
+			return nil, err
+		}
+		items = append(items, entity_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPagesByLanguage = `-- name: ListPagesByLanguage :many
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages
 WHERE language_code = ?
 ORDER BY created_at DESC
 LIMIT ? OFFSET ?
@@ -1078,6 +1222,7 @@ func (q *Queries) ListPagesByLanguage(ctx context.Context, arg ListPagesByLangua
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1093,7 +1238,7 @@ func (q *Queries) ListPagesByLanguage(ctx context.Context, arg ListPagesByLangua
 }
 
 const listPublishedPagesByLanguage = `-- name: ListPublishedPagesByLanguage :many
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages
 WHERE language_code = ? AND status = 'published'
 ORDER BY published_at DESC
 LIMIT ? OFFSET ?
@@ -1141,6 +1286,7 @@ func (q *Queries) ListPublishedPagesByLanguage(ctx context.Context, arg ListPubl
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1156,21 +1302,27 @@ func (q *Queries) ListPublishedPagesByLanguage(ctx context.Context, arg ListPubl
 }
 
 const listPublishedPostsByLanguage = `-- name: ListPublishedPostsByLanguage :many
-SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title FROM pages
-WHERE language_code = ? AND status = 'published' AND page_type = 'post' AND exclude_from_lists = 0
+SELECT id, title, slug, body, status, author_id, created_at, updated_at, published_at, featured_image_id, meta_title, meta_description, meta_keywords, og_image_id, no_index, no_follow, canonical_url, scheduled_at, language_code, hide_featured_image, page_type, exclude_from_lists, summary, video_url, video_title, site_id FROM pages
+WHERE language_code = ? AND status = 'published' AND page_type = 'post' AND exclude_from_lists = 0 AND site_id = ?
 ORDER BY published_at DESC
 LIMIT ? OFFSET ?
 `
 
 type ListPublishedPostsByLanguageParams struct {
 	LanguageCode string `json:"language_code"`
+	SiteID       int64  `json:"site_id"`
 	Limit        int64  `json:"limit"`
 	Offset       int64  `json:"offset"`
 }
 
 // Posts only for a specific language (for recent posts, blog feeds - excludes static pages)
 func (q *Queries) ListPublishedPostsByLanguage(ctx context.Context, arg ListPublishedPostsByLanguageParams) ([]Page, error) {
-	rows, err := q.db.QueryContext(ctx, listPublishedPostsByLanguage, arg.LanguageCode, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listPublishedPostsByLanguage,
+		arg.LanguageCode,
+		arg.SiteID,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1204,6 +1356,7 @@ func (q *Queries) ListPublishedPostsByLanguage(ctx context.Context, arg ListPubl
 			&i.Summary,
 			&i.VideoUrl,
 			&i.VideoTitle,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -1299,6 +1452,127 @@ func (q *Queries) ListTranslationComponentMembers(ctx context.Context, arg ListT
 	return items, nil
 }
 
+const listTranslationFreshness = `-- name: ListTranslationFreshness :many
+WITH entities(entity_type, id, title, language_code, updated_at) AS (
+    SELECT 'page', id, title, language_code, updated_at FROM pages
+    UNION ALL
+    SELECT 'category', id, name, language_code, updated_at FROM categories
+    UNION ALL
+    SELECT 'tag', id, name, language_code, updated_at FROM tags
+    UNION ALL
+    SELECT 'form', id, name, language_code, updated_at FROM forms
+)
+SELECT
+    t.id,
+    t.entity_type,
+    t.entity_id AS source_id,
+    src.title AS source_title,
+    src.language_code AS source_language_code,
+    src.updated_at AS source_updated_at,
+    t.translation_id,
+    trg.title AS translation_title,
+    trg.language_code AS translation_language_code,
+    CAST(CASE WHEN t.entity_type = 'page'
+        THEN COALESCE((SELECT MAX(pv.id) FROM page_versions pv WHERE pv.page_id = t.entity_id), 0) > COALESCE(t.source_version_id, 0)
+        ELSE src.updated_at IS NOT t.source_updated_at
+    END AS INTEGER) AS outdated
+FROM translations t
+INNER JOIN entities src ON src.entity_type = t.entity_type AND src.id = t.entity_id
+INNER JOIN entities trg ON trg.entity_type = t.entity_type AND trg.id = t.translation_id
+ORDER BY t.entity_type, src.title, t.id
+`
+
+type ListTranslationFreshnessRow struct {
+	ID                      int64     `json:"id"`
+	EntityType              string    `json:"entity_type"`
+	SourceID                int64     `json:"source_id"`
+	SourceTitle             string    `json:"source_title"`
+	SourceLanguageCode      string    `json:"source_language_code"`
+	SourceUpdatedAt         time.Time `json:"source_updated_at"`
+	TranslationID           int64     `json:"translation_id"`
+	TranslationTitle        string    `json:"translation_title"`
+	TranslationLanguageCode string    `json:"translation_language_code"`
+	Outdated                int64     `json:"outdated"`
+}
+
+// List every translation link with both sides and whether the source
+// changed since the translation was last brought up to date
+func (q *Queries) ListTranslationFreshness(ctx context.Context) ([]ListTranslationFreshnessRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTranslationFreshness)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTranslationFreshnessRow{}
+	for rows.Next() {
+		var i ListTranslationFreshnessRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntityType,
+			&i.SourceID,
+			&i.SourceTitle,
+			&i.SourceLanguageCode,
+			&i.SourceUpdatedAt,
+			&i.TranslationID,
+			&i.TranslationTitle,
+			&i.TranslationLanguageCode,
+			&i.Outdated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markTranslationCurrent = `-- name: MarkTranslationCurrent :exec
+UPDATE translations SET
+    source_version_id = CASE WHEN entity_type = 'page'
+        THEN (SELECT MAX(pv.id) FROM page_versions pv WHERE pv.page_id = translations.entity_id) END,
+    source_updated_at = CASE entity_type
+        WHEN 'page' THEN (SELECT p.updated_at FROM pages p WHERE p.id = translations.entity_id)
+        WHEN 'category' THEN (SELECT c.updated_at FROM categories c WHERE c.id = translations.entity_id)
+        WHEN 'tag' THEN (SELECT t.updated_at FROM tags t WHERE t.id = translations.entity_id)
+        WHEN 'form' THEN (SELECT f.updated_at FROM forms f WHERE f.id = translations.entity_id)
+    END
+WHERE id = ?
+`
+
+func (q *Queries) MarkTranslationCurrent(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, markTranslationCurrent, id)
+	return err
+}
+
+const markTranslationsCurrent = `-- name: MarkTranslationsCurrent :exec
+UPDATE translations SET
+    source_version_id = CASE WHEN entity_type = 'page'
+        THEN (SELECT MAX(pv.id) FROM page_versions pv WHERE pv.page_id = translations.entity_id) END,
+    source_updated_at = CASE entity_type
+        WHEN 'page' THEN (SELECT p.updated_at FROM pages p WHERE p.id = translations.entity_id)
+        WHEN 'category' THEN (SELECT c.updated_at FROM categories c WHERE c.id = translations.entity_id)
+        WHEN 'tag' THEN (SELECT t.updated_at FROM tags t WHERE t.id = translations.entity_id)
+        WHEN 'form' THEN (SELECT f.updated_at FROM forms f WHERE f.id = translations.entity_id)
+    END
+WHERE entity_type = ? AND translation_id = ?
+`
+
+type MarkTranslationsCurrentParams struct {
+	EntityType    string `json:"entity_type"`
+	TranslationID int64  `json:"translation_id"`
+}
+
+// Mark every link to a translated entity as up to date with its source
+func (q *Queries) MarkTranslationsCurrent(ctx context.Context, arg MarkTranslationsCurrentParams) error {
+	_, err := q.db.ExecContext(ctx, markTranslationsCurrent, arg.EntityType, arg.TranslationID)
+	return err
+}
+
 const translationExists = `-- name: TranslationExists :one
 SELECT EXISTS(
     SELECT 1 FROM translations
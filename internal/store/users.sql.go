@@ -186,6 +186,33 @@ func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
 	return i, err
 }
 
+const listUserEmailsByRole = `-- name: ListUserEmailsByRole :many
+SELECT email FROM users WHERE role = ? ORDER BY id
+`
+
+func (q *Queries) ListUserEmailsByRole(ctx context.Context, role string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listUserEmailsByRole, role)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		items = append(items, email)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listUsers = `-- name: ListUsers :many
 SELECT id, email, password_hash, role, name, created_at, updated_at, last_login_at, avatar, bio, website_url, linkedin_url, github_url, telegram_url, session_version FROM users ORDER BY created_at DESC LIMIT ? OFFSET ?
 `
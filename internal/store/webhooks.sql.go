@@ -22,6 +22,25 @@ func (q *Queries) CountActiveWebhooks(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const countDeadDeliveries = `-- name: CountDeadDeliveries :one
+SELECT COUNT(*) FROM webhook_deliveries
+WHERE status = 'dead'
+  AND (CAST(?1 AS INTEGER) = 0 OR webhook_id = ?1)
+  AND (CAST(?2 AS TEXT) = '' OR event = ?2)
+`
+
+type CountDeadDeliveriesParams struct {
+	WebhookID int64  `json:"webhook_id"`
+	Event     string `json:"event"`
+}
+
+func (q *Queries) CountDeadDeliveries(ctx context.Context, arg CountDeadDeliveriesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countDeadDeliveries, arg.WebhookID, arg.Event)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countDeliveriesByStatus = `-- name: CountDeliveriesByStatus :one
 SELECT COUNT(*) FROM webhook_deliveries WHERE webhook_id = ? AND status = ?
 `
@@ -49,6 +68,26 @@ func (q *Queries) CountWebhookDeliveries(ctx context.Context, webhookID int64) (
 	return count, err
 }
 
+const countWebhookDeliveriesFiltered = `-- name: CountWebhookDeliveriesFiltered :one
+SELECT COUNT(*) FROM webhook_deliveries
+WHERE webhook_id = ?1
+  AND (CAST(?2 AS TEXT) = '' OR status = ?2)
+  AND (CAST(?3 AS TEXT) = '' OR event = ?3)
+`
+
+type CountWebhookDeliveriesFilteredParams struct {
+	WebhookID int64  `json:"webhook_id"`
+	Status    string `json:"status"`
+	Event     string `json:"event"`
+}
+
+func (q *Queries) CountWebhookDeliveriesFiltered(ctx context.Context, arg CountWebhookDeliveriesFilteredParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countWebhookDeliveriesFiltered, arg.WebhookID, arg.Status, arg.Event)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countWebhooks = `-- name: CountWebhooks :one
 SELECT COUNT(*) FROM webhooks
 `
@@ -61,21 +100,23 @@ func (q *Queries) CountWebhooks(ctx context.Context) (int64, error) {
 }
 
 const createWebhook = `-- name: CreateWebhook :one
-INSERT INTO webhooks (name, url, secret, events, is_active, headers, created_by, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, name, url, secret, events, is_active, headers, created_by, created_at, updated_at
+INSERT INTO webhooks (name, url, secret, events, is_active, headers, payload_format, payload_template, created_by, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, name, url, secret, events, is_active, headers, created_by, created_at, updated_at, payload_format, payload_template, consecutive_failures, paused_at, pause_reason
 `
 
 type CreateWebhookParams struct {
-	Name      string    `json:"name"`
-	Url       string    `json:"url"`
-	Secret    string    `json:"secret"`
-	Events    string    `json:"events"`
-	IsActive  bool      `json:"is_active"`
-	Headers   string    `json:"headers"`
-	CreatedBy int64     `json:"created_by"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	Name            string    `json:"name"`
+	Url             string    `json:"url"`
+	Secret          string    `json:"secret"`
+	Events          string    `json:"events"`
+	IsActive        bool      `json:"is_active"`
+	Headers         string    `json:"headers"`
+	PayloadFormat   string    `json:"payload_format"`
+	PayloadTemplate string    `json:"payload_template"`
+	CreatedBy       int64     `json:"created_by"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
 }
 
 func (q *Queries) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error) {
@@ -86,6 +127,8 @@ func (q *Queries) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (W
 		arg.Events,
 		arg.IsActive,
 		arg.Headers,
+		arg.PayloadFormat,
+		arg.PayloadTemplate,
 		arg.CreatedBy,
 		arg.CreatedAt,
 		arg.UpdatedAt,
@@ -102,6 +145,11 @@ func (q *Queries) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (W
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PayloadFormat,
+		&i.PayloadTemplate,
+		&i.ConsecutiveFailures,
+		&i.PausedAt,
+		&i.PauseReason,
 	)
 	return i, err
 }
@@ -455,7 +503,7 @@ func (q *Queries) GetRecentFailedDeliveriesWithWebhook(ctx context.Context, limi
 }
 
 const getWebhookByID = `-- name: GetWebhookByID :one
-SELECT id, name, url, secret, events, is_active, headers, created_by, created_at, updated_at FROM webhooks WHERE id = ?
+SELECT id, name, url, secret, events, is_active, headers, created_by, created_at, updated_at, payload_format, payload_template, consecutive_failures, paused_at, pause_reason FROM webhooks WHERE id = ?
 `
 
 func (q *Queries) GetWebhookByID(ctx context.Context, id int64) (Webhook, error) {
@@ -472,6 +520,11 @@ func (q *Queries) GetWebhookByID(ctx context.Context, id int64) (Webhook, error)
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PayloadFormat,
+		&i.PayloadTemplate,
+		&i.ConsecutiveFailures,
+		&i.PausedAt,
+		&i.PauseReason,
 	)
 	return i, err
 }
@@ -558,7 +611,7 @@ func (q *Queries) GetWebhookHealthSummary(ctx context.Context, createdAt time.Ti
 }
 
 const listActiveWebhooks = `-- name: ListActiveWebhooks :many
-SELECT id, name, url, secret, events, is_active, headers, created_by, created_at, updated_at FROM webhooks WHERE is_active = 1 ORDER BY name
+SELECT id, name, url, secret, events, is_active, headers, created_by, created_at, updated_at, payload_format, payload_template, consecutive_failures, paused_at, pause_reason FROM webhooks WHERE is_active = 1 ORDER BY name
 `
 
 func (q *Queries) ListActiveWebhooks(ctx context.Context) ([]Webhook, error) {
@@ -581,6 +634,93 @@ func (q *Queries) ListActiveWebhooks(ctx context.Context) ([]Webhook, error) {
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PayloadFormat,
+			&i.PayloadTemplate,
+			&i.ConsecutiveFailures,
+			&i.PausedAt,
+			&i.PauseReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDeadDeliveriesWithWebhook = `-- name: ListDeadDeliveriesWithWebhook :many
+
+SELECT
+    wd.id, wd.webhook_id, wd.event, wd.payload, wd.response_code, wd.response_body, wd.attempts, wd.next_retry_at, wd.delivered_at, wd.status, wd.error_message, wd.created_at, wd.updated_at,
+    w.name as webhook_name
+FROM webhook_deliveries wd
+INNER JOIN webhooks w ON w.id = wd.webhook_id
+WHERE wd.status = 'dead'
+  AND (CAST(?1 AS INTEGER) = 0 OR wd.webhook_id = ?1)
+  AND (CAST(?2 AS TEXT) = '' OR wd.event = ?2)
+ORDER BY wd.created_at DESC
+LIMIT ?3 OFFSET ?4
+`
+
+type ListDeadDeliveriesWithWebhookParams struct {
+	WebhookID int64  `json:"webhook_id"`
+	Event     string `json:"event"`
+	Limit     int64  `json:"limit"`
+	Offset    int64  `json:"offset"`
+}
+
+type ListDeadDeliveriesWithWebhookRow struct {
+	ID           int64          `json:"id"`
+	WebhookID    int64          `json:"webhook_id"`
+	Event        string         `json:"event"`
+	Payload      string         `json:"payload"`
+	ResponseCode sql.NullInt64  `json:"response_code"`
+	ResponseBody sql.NullString `json:"response_body"`
+	Attempts     int64          `json:"attempts"`
+	NextRetryAt  sql.NullTime   `json:"next_retry_at"`
+	DeliveredAt  sql.NullTime   `json:"delivered_at"`
+	Status       string         `json:"status"`
+	ErrorMessage sql.NullString `json:"error_message"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	WebhookName  string         `json:"webhook_name"`
+}
+
+// Dead-letter queue
+func (q *Queries) ListDeadDeliveriesWithWebhook(ctx context.Context, arg ListDeadDeliveriesWithWebhookParams) ([]ListDeadDeliveriesWithWebhookRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDeadDeliveriesWithWebhook,
+		arg.WebhookID,
+		arg.Event,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDeadDeliveriesWithWebhookRow{}
+	for rows.Next() {
+		var i ListDeadDeliveriesWithWebhookRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.Event,
+			&i.Payload,
+			&i.ResponseCode,
+			&i.ResponseBody,
+			&i.Attempts,
+			&i.NextRetryAt,
+			&i.DeliveredAt,
+			&i.Status,
+			&i.ErrorMessage,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.WebhookName,
 		); err != nil {
 			return nil, err
 		}
@@ -595,6 +735,71 @@ func (q *Queries) ListActiveWebhooks(ctx context.Context) ([]Webhook, error) {
 	return items, nil
 }
 
+const listDeadDeliveryEvents = `-- name: ListDeadDeliveryEvents :many
+SELECT DISTINCT event FROM webhook_deliveries WHERE status = 'dead' ORDER BY event
+`
+
+func (q *Queries) ListDeadDeliveryEvents(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listDeadDeliveryEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var event string
+		if err := rows.Scan(&event); err != nil {
+			return nil, err
+		}
+		items = append(items, event)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDeadDeliveryIDs = `-- name: ListDeadDeliveryIDs :many
+SELECT id FROM webhook_deliveries
+WHERE status = 'dead'
+  AND (CAST(?1 AS INTEGER) = 0 OR webhook_id = ?1)
+  AND (CAST(?2 AS TEXT) = '' OR event = ?2)
+ORDER BY created_at
+LIMIT ?3
+`
+
+type ListDeadDeliveryIDsParams struct {
+	WebhookID int64  `json:"webhook_id"`
+	Event     string `json:"event"`
+	Limit     int64  `json:"limit"`
+}
+
+func (q *Queries) ListDeadDeliveryIDs(ctx context.Context, arg ListDeadDeliveryIDsParams) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, listDeadDeliveryIDs, arg.WebhookID, arg.Event, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
 SELECT id, webhook_id, event, payload, response_code, response_body, attempts, next_retry_at, delivered_at, status, error_message, created_at, updated_at FROM webhook_deliveries WHERE webhook_id = ?
 ORDER BY created_at DESC LIMIT ? OFFSET ?
@@ -643,8 +848,95 @@ func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeli
 	return items, nil
 }
 
+const listWebhookDeliveriesFiltered = `-- name: ListWebhookDeliveriesFiltered :many
+SELECT id, webhook_id, event, payload, response_code, response_body, attempts, next_retry_at, delivered_at, status, error_message, created_at, updated_at FROM webhook_deliveries
+WHERE webhook_id = ?1
+  AND (CAST(?2 AS TEXT) = '' OR status = ?2)
+  AND (CAST(?3 AS TEXT) = '' OR event = ?3)
+ORDER BY created_at DESC
+LIMIT ?4 OFFSET ?5
+`
+
+type ListWebhookDeliveriesFilteredParams struct {
+	WebhookID int64  `json:"webhook_id"`
+	Status    string `json:"status"`
+	Event     string `json:"event"`
+	Limit     int64  `json:"limit"`
+	Offset    int64  `json:"offset"`
+}
+
+func (q *Queries) ListWebhookDeliveriesFiltered(ctx context.Context, arg ListWebhookDeliveriesFilteredParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookDeliveriesFiltered,
+		arg.WebhookID,
+		arg.Status,
+		arg.Event,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookDelivery{}
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.Event,
+			&i.Payload,
+			&i.ResponseCode,
+			&i.ResponseBody,
+			&i.Attempts,
+			&i.NextRetryAt,
+			&i.DeliveredAt,
+			&i.Status,
+			&i.ErrorMessage,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeliveryEvents = `-- name: ListWebhookDeliveryEvents :many
+SELECT DISTINCT event FROM webhook_deliveries WHERE webhook_id = ? ORDER BY event
+`
+
+func (q *Queries) ListWebhookDeliveryEvents(ctx context.Context, webhookID int64) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookDeliveryEvents, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var event string
+		if err := rows.Scan(&event); err != nil {
+			return nil, err
+		}
+		items = append(items, event)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWebhooks = `-- name: ListWebhooks :many
-SELECT id, name, url, secret, events, is_active, headers, created_by, created_at, updated_at FROM webhooks ORDER BY name
+SELECT id, name, url, secret, events, is_active, headers, created_by, created_at, updated_at, payload_format, payload_template, consecutive_failures, paused_at, pause_reason FROM webhooks ORDER BY name
 `
 
 func (q *Queries) ListWebhooks(ctx context.Context) ([]Webhook, error) {
@@ -667,6 +959,11 @@ func (q *Queries) ListWebhooks(ctx context.Context) ([]Webhook, error) {
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PayloadFormat,
+			&i.PayloadTemplate,
+			&i.ConsecutiveFailures,
+			&i.PausedAt,
+			&i.PauseReason,
 		); err != nil {
 			return nil, err
 		}
@@ -682,7 +979,7 @@ func (q *Queries) ListWebhooks(ctx context.Context) ([]Webhook, error) {
 }
 
 const listWebhooksForEvent = `-- name: ListWebhooksForEvent :many
-SELECT id, name, url, secret, events, is_active, headers, created_by, created_at, updated_at FROM webhooks
+SELECT id, name, url, secret, events, is_active, headers, created_by, created_at, updated_at, payload_format, payload_template, consecutive_failures, paused_at, pause_reason FROM webhooks
 WHERE is_active = 1 AND events LIKE '%' || ? || '%'
 `
 
@@ -706,6 +1003,11 @@ func (q *Queries) ListWebhooksForEvent(ctx context.Context, dollar_1 sql.NullStr
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PayloadFormat,
+			&i.PayloadTemplate,
+			&i.ConsecutiveFailures,
+			&i.PausedAt,
+			&i.PauseReason,
 		); err != nil {
 			return nil, err
 		}
@@ -721,7 +1023,7 @@ func (q *Queries) ListWebhooksForEvent(ctx context.Context, dollar_1 sql.NullStr
 }
 
 const listWebhooksPaginated = `-- name: ListWebhooksPaginated :many
-SELECT id, name, url, secret, events, is_active, headers, created_by, created_at, updated_at FROM webhooks ORDER BY name LIMIT ? OFFSET ?
+SELECT id, name, url, secret, events, is_active, headers, created_by, created_at, updated_at, payload_format, payload_template, consecutive_failures, paused_at, pause_reason FROM webhooks ORDER BY name LIMIT ? OFFSET ?
 `
 
 type ListWebhooksPaginatedParams struct {
@@ -749,6 +1051,11 @@ func (q *Queries) ListWebhooksPaginated(ctx context.Context, arg ListWebhooksPag
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PayloadFormat,
+			&i.PayloadTemplate,
+			&i.ConsecutiveFailures,
+			&i.PausedAt,
+			&i.PauseReason,
 		); err != nil {
 			return nil, err
 		}
@@ -763,6 +1070,40 @@ func (q *Queries) ListWebhooksPaginated(ctx context.Context, arg ListWebhooksPag
 	return items, nil
 }
 
+const pauseWebhook = `-- name: PauseWebhook :execrows
+UPDATE webhooks SET paused_at = ?, pause_reason = ?
+WHERE id = ? AND paused_at IS NULL
+`
+
+type PauseWebhookParams struct {
+	PausedAt    sql.NullTime `json:"paused_at"`
+	PauseReason string       `json:"pause_reason"`
+	ID          int64        `json:"id"`
+}
+
+func (q *Queries) PauseWebhook(ctx context.Context, arg PauseWebhookParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, pauseWebhook, arg.PausedAt, arg.PauseReason, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const recordWebhookFailure = `-- name: RecordWebhookFailure :one
+
+UPDATE webhooks SET consecutive_failures = consecutive_failures + 1
+WHERE id = ?
+RETURNING consecutive_failures
+`
+
+// Circuit breaker
+func (q *Queries) RecordWebhookFailure(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, recordWebhookFailure, id)
+	var consecutive_failures int64
+	err := row.Scan(&consecutive_failures)
+	return consecutive_failures, err
+}
+
 const resetDeliveryForRetry = `-- name: ResetDeliveryForRetry :exec
 UPDATE webhook_deliveries
 SET status = 'pending', attempts = 0, next_retry_at = NULL, error_message = '', updated_at = ?
@@ -779,6 +1120,31 @@ func (q *Queries) ResetDeliveryForRetry(ctx context.Context, arg ResetDeliveryFo
 	return err
 }
 
+const resetWebhookFailures = `-- name: ResetWebhookFailures :exec
+UPDATE webhooks SET consecutive_failures = 0
+WHERE id = ? AND consecutive_failures > 0
+`
+
+func (q *Queries) ResetWebhookFailures(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, resetWebhookFailures, id)
+	return err
+}
+
+const resumeWebhook = `-- name: ResumeWebhook :exec
+UPDATE webhooks SET paused_at = NULL, pause_reason = '', consecutive_failures = 0, updated_at = ?
+WHERE id = ?
+`
+
+type ResumeWebhookParams struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	ID        int64     `json:"id"`
+}
+
+func (q *Queries) ResumeWebhook(ctx context.Context, arg ResumeWebhookParams) error {
+	_, err := q.db.ExecContext(ctx, resumeWebhook, arg.UpdatedAt, arg.ID)
+	return err
+}
+
 const updateDeliveryDead = `-- name: UpdateDeliveryDead :exec
 UPDATE webhook_deliveries
 SET status = 'dead', error_message = ?, attempts = attempts + 1, updated_at = ?
@@ -849,20 +1215,22 @@ func (q *Queries) UpdateDeliverySuccess(ctx context.Context, arg UpdateDeliveryS
 }
 
 const updateWebhook = `-- name: UpdateWebhook :one
-UPDATE webhooks SET name = ?, url = ?, secret = ?, events = ?, is_active = ?, headers = ?, updated_at = ?
+UPDATE webhooks SET name = ?, url = ?, secret = ?, events = ?, is_active = ?, headers = ?, payload_format = ?, payload_template = ?, updated_at = ?
 WHERE id = ?
-RETURNING id, name, url, secret, events, is_active, headers, created_by, created_at, updated_at
+RETURNING id, name, url, secret, events, is_active, headers, created_by, created_at, updated_at, payload_format, payload_template, consecutive_failures, paused_at, pause_reason
 `
 
 type UpdateWebhookParams struct {
-	Name      string    `json:"name"`
-	Url       string    `json:"url"`
-	Secret    string    `json:"secret"`
-	Events    string    `json:"events"`
-	IsActive  bool      `json:"is_active"`
-	Headers   string    `json:"headers"`
-	UpdatedAt time.Time `json:"updated_at"`
-	ID        int64     `json:"id"`
+	Name            string    `json:"name"`
+	Url             string    `json:"url"`
+	Secret          string    `json:"secret"`
+	Events          string    `json:"events"`
+	IsActive        bool      `json:"is_active"`
+	Headers         string    `json:"headers"`
+	PayloadFormat   string    `json:"payload_format"`
+	PayloadTemplate string    `json:"payload_template"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	ID              int64     `json:"id"`
 }
 
 func (q *Queries) UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (Webhook, error) {
@@ -873,6 +1241,8 @@ func (q *Queries) UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (W
 		arg.Events,
 		arg.IsActive,
 		arg.Headers,
+		arg.PayloadFormat,
+		arg.PayloadTemplate,
 		arg.UpdatedAt,
 		arg.ID,
 	)
@@ -888,6 +1258,11 @@ func (q *Queries) UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (W
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PayloadFormat,
+		&i.PayloadTemplate,
+		&i.ConsecutiveFailures,
+		&i.PausedAt,
+		&i.PauseReason,
 	)
 	return i, err
 }
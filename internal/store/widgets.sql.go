@@ -11,9 +11,9 @@ import (
 )
 
 const createWidget = `-- name: CreateWidget :one
-INSERT INTO widgets (theme, area, widget_type, title, content, settings, position, is_active, language_code)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, theme, area, widget_type, title, content, settings, position, is_active, language_code, created_at, updated_at
+INSERT INTO widgets (theme, area, widget_type, title, content, settings, position, is_active, language_code, site_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, theme, area, widget_type, title, content, settings, position, is_active, language_code, created_at, updated_at, site_id
 `
 
 type CreateWidgetParams struct {
@@ -26,6 +26,7 @@ type CreateWidgetParams struct {
 	Position     int64          `json:"position"`
 	IsActive     int64          `json:"is_active"`
 	LanguageCode string         `json:"language_code"`
+	SiteID       int64          `json:"site_id"`
 }
 
 func (q *Queries) CreateWidget(ctx context.Context, arg CreateWidgetParams) (Widget, error) {
@@ -39,6 +40,7 @@ func (q *Queries) CreateWidget(ctx context.Context, arg CreateWidgetParams) (Wid
 		arg.Position,
 		arg.IsActive,
 		arg.LanguageCode,
+		arg.SiteID,
 	)
 	var i Widget
 	err := row.Scan(
@@ -54,6 +56,7 @@ func (q *Queries) CreateWidget(ctx context.Context, arg CreateWidgetParams) (Wid
 		&i.LanguageCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SiteID,
 	)
 	return i, err
 }
@@ -77,7 +80,7 @@ func (q *Queries) DeleteWidgetsByTheme(ctx context.Context, theme string) error
 }
 
 const getAllWidgets = `-- name: GetAllWidgets :many
-SELECT id, theme, area, widget_type, title, content, settings, position, is_active, language_code, created_at, updated_at FROM widgets ORDER BY theme, area, position
+SELECT id, theme, area, widget_type, title, content, settings, position, is_active, language_code, created_at, updated_at, site_id FROM widgets ORDER BY theme, area, position
 `
 
 func (q *Queries) GetAllWidgets(ctx context.Context) ([]Widget, error) {
@@ -102,6 +105,7 @@ func (q *Queries) GetAllWidgets(ctx context.Context) ([]Widget, error) {
 			&i.LanguageCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -117,7 +121,7 @@ func (q *Queries) GetAllWidgets(ctx context.Context) ([]Widget, error) {
 }
 
 const getAllWidgetsByTheme = `-- name: GetAllWidgetsByTheme :many
-SELECT id, theme, area, widget_type, title, content, settings, position, is_active, language_code, created_at, updated_at FROM widgets
+SELECT id, theme, area, widget_type, title, content, settings, position, is_active, language_code, created_at, updated_at, site_id FROM widgets
 WHERE theme = ?
 ORDER BY area, position
 `
@@ -144,6 +148,7 @@ func (q *Queries) GetAllWidgetsByTheme(ctx context.Context, theme string) ([]Wid
 			&i.LanguageCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -159,7 +164,7 @@ func (q *Queries) GetAllWidgetsByTheme(ctx context.Context, theme string) ([]Wid
 }
 
 const getAllWidgetsByThemeAndLanguage = `-- name: GetAllWidgetsByThemeAndLanguage :many
-SELECT id, theme, area, widget_type, title, content, settings, position, is_active, language_code, created_at, updated_at FROM widgets
+SELECT id, theme, area, widget_type, title, content, settings, position, is_active, language_code, created_at, updated_at, site_id FROM widgets
 WHERE theme = ? AND language_code = ?
 ORDER BY area, position
 `
@@ -191,6 +196,7 @@ func (q *Queries) GetAllWidgetsByThemeAndLanguage(ctx context.Context, arg GetAl
 			&i.LanguageCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -224,7 +230,7 @@ func (q *Queries) GetMaxWidgetPosition(ctx context.Context, arg GetMaxWidgetPosi
 }
 
 const getWidget = `-- name: GetWidget :one
-SELECT id, theme, area, widget_type, title, content, settings, position, is_active, language_code, created_at, updated_at FROM widgets WHERE id = ?
+SELECT id, theme, area, widget_type, title, content, settings, position, is_active, language_code, created_at, updated_at, site_id FROM widgets WHERE id = ?
 `
 
 func (q *Queries) GetWidget(ctx context.Context, id int64) (Widget, error) {
@@ -243,12 +249,13 @@ func (q *Queries) GetWidget(ctx context.Context, id int64) (Widget, error) {
 		&i.LanguageCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SiteID,
 	)
 	return i, err
 }
 
 const getWidgetsByThemeAndArea = `-- name: GetWidgetsByThemeAndArea :many
-SELECT id, theme, area, widget_type, title, content, settings, position, is_active, language_code, created_at, updated_at FROM widgets
+SELECT id, theme, area, widget_type, title, content, settings, position, is_active, language_code, created_at, updated_at, site_id FROM widgets
 WHERE theme = ? AND area = ? AND language_code = ? AND is_active = 1
 ORDER BY position
 `
@@ -281,6 +288,7 @@ func (q *Queries) GetWidgetsByThemeAndArea(ctx context.Context, arg GetWidgetsBy
 			&i.LanguageCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SiteID,
 		); err != nil {
 			return nil, err
 		}
@@ -306,7 +314,7 @@ SET widget_type = ?,
     language_code = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
-RETURNING id, theme, area, widget_type, title, content, settings, position, is_active, language_code, created_at, updated_at
+RETURNING id, theme, area, widget_type, title, content, settings, position, is_active, language_code, created_at, updated_at, site_id
 `
 
 type UpdateWidgetParams struct {
@@ -345,6 +353,7 @@ func (q *Queries) UpdateWidget(ctx context.Context, arg UpdateWidgetParams) (Wid
 		&i.LanguageCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SiteID,
 	)
 	return i, err
 }
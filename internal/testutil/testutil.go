@@ -141,6 +141,9 @@ func MinimalThemeFuncMap() template.FuncMap {
 		"imageSrc":             func(u string, variant string) string { return u },
 		"imageSrcset":          func(u string) string { return "" },
 		"informerBar":          func() string { return "" },
+		"childPages":           func(pageID int64, langPrefix string) []any { return nil },
+		"relatedPages":         func(pageID int64, langPrefix string, limit int) []any { return nil },
+		"pageSeries":           func(pageID int64, langPrefix string) any { return nil },
 	}
 }
 
@@ -16,6 +16,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/olegiv/ocms-go/internal/contenttype"
 	"github.com/olegiv/ocms-go/internal/i18n"
 )
 
@@ -157,6 +158,7 @@ func (m *Manager) loadEmbeddedTheme(name string) (*Theme, error) {
 	if err := json.Unmarshal(configData, &config); err != nil {
 		return nil, fmt.Errorf("parsing theme.json: %w", err)
 	}
+	m.validateCustomFields(name, &config)
 
 	// Get theme subdirectory as fs.FS
 	themeFS, err := fs.Sub(m.embeddedFS, name)
@@ -198,6 +200,7 @@ func (m *Manager) loadFilesystemTheme(name, path string) (*Theme, error) {
 	if err := json.Unmarshal(configData, &config); err != nil {
 		return nil, fmt.Errorf("parsing theme.json: %w", err)
 	}
+	m.validateCustomFields(name, &config)
 
 	// Parse templates from filesystem
 	templatesPath := filepath.Join(path, "templates")
@@ -221,6 +224,18 @@ func (m *Manager) loadFilesystemTheme(name, path string) (*Theme, error) {
 	}, nil
 }
 
+// validateCustomFields drops an invalid custom field schema from a theme
+// config, so a broken theme.json never blocks editing pages.
+func (m *Manager) validateCustomFields(name string, config *Config) {
+	if len(config.CustomFields) == 0 {
+		return
+	}
+	if err := contenttype.ValidateCustomFieldSchema(config.CustomFields); err != nil {
+		m.logger.Warn("ignoring invalid theme custom fields", "theme", name, "error", err)
+		config.CustomFields = nil
+	}
+}
+
 // loadThemeTranslationsFromFS loads translations from an embedded filesystem.
 func (m *Manager) loadThemeTranslationsFromFS(themeFS fs.FS, localesDir string) map[string]map[string]string {
 	// Check if locales directory exists
@@ -495,6 +510,16 @@ func (m *Manager) GetActiveTheme() *Theme {
 	return m.activeTheme
 }
 
+// ActiveCustomFields returns the custom field schema of the active theme, or
+// nil when no theme is active.
+func (m *Manager) ActiveCustomFields() []contenttype.Field {
+	active := m.GetActiveTheme()
+	if active == nil {
+		return nil
+	}
+	return active.Config.CustomFields
+}
+
 // GetTheme returns a theme by name.
 func (m *Manager) GetTheme(name string) (*Theme, error) {
 	m.mu.RLock()
@@ -636,10 +661,7 @@ func (m *Manager) Translate(lang, key string, args ...any) string {
 	// Check theme-specific translation first
 	if theme != nil {
 		if translation, ok := theme.Translate(lang, key); ok {
-			if len(args) > 0 {
-				return fmt.Sprintf(translation, args...)
-			}
-			return translation
+			return i18n.Format(translation, args...)
 		}
 	}
 
@@ -8,8 +8,11 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/olegiv/ocms-go/internal/blocks"
+	"github.com/olegiv/ocms-go/internal/contenttype"
 	"github.com/olegiv/ocms-go/internal/i18n"
 	"github.com/olegiv/ocms-go/internal/testutil"
 	"github.com/olegiv/ocms-go/internal/themes"
@@ -495,6 +498,71 @@ func TestThemeWithSettings(t *testing.T) {
 	}
 }
 
+func TestThemeCustomFields(t *testing.T) {
+	m, customDir := testManager(t)
+	createTestTheme(t, customDir, "fields", Config{
+		Name: "Fields Theme",
+		CustomFields: []contenttype.Field{
+			{Name: "subtitle", Label: "Subtitle", Type: contenttype.FieldText},
+		},
+	})
+	createTestTheme(t, customDir, "broken", Config{
+		Name: "Broken Theme",
+		CustomFields: []contenttype.Field{
+			{Name: "rows", Label: "Rows", Type: contenttype.FieldRepeater, Fields: []contenttype.Field{
+				{Name: "a", Label: "A", Type: contenttype.FieldText},
+			}},
+		},
+	})
+	if err := m.LoadThemes(); err != nil {
+		t.Fatalf("LoadThemes: %v", err)
+	}
+
+	if got := m.ActiveCustomFields(); got != nil {
+		t.Errorf("ActiveCustomFields() without active theme = %v, want nil", got)
+	}
+	if err := m.SetActiveTheme("fields"); err != nil {
+		t.Fatalf("SetActiveTheme: %v", err)
+	}
+	if got := m.ActiveCustomFields(); len(got) != 1 || got[0].Name != "subtitle" {
+		t.Errorf("ActiveCustomFields() = %v, want the subtitle field", got)
+	}
+	broken, err := m.GetTheme("broken")
+	if err != nil {
+		t.Fatalf("GetTheme: %v", err)
+	}
+	if len(broken.Config.CustomFields) != 0 {
+		t.Errorf("invalid custom fields were kept: %v", broken.Config.CustomFields)
+	}
+}
+
+func TestThemeBlockOverride(t *testing.T) {
+	m, customDir := testManager(t)
+	themePath := createTestTheme(t, customDir, "blocky", Config{Name: "Blocky"})
+	partial := `<p class="lead">{{.Text}}</p>`
+	if err := os.WriteFile(filepath.Join(themePath, "templates", "partials", "block-paragraph.html"), []byte(partial), 0644); err != nil {
+		t.Fatalf("failed to write partial: %v", err)
+	}
+	createTestTheme(t, customDir, "plain", Config{Name: "Plain"})
+	if err := m.LoadThemes(); err != nil {
+		t.Fatalf("LoadThemes: %v", err)
+	}
+
+	plain, _ := m.GetTheme("plain")
+	if plain.BlockOverride() != nil {
+		t.Error("theme without block partials returned an override")
+	}
+	blocky, _ := m.GetTheme("blocky")
+	doc := blocks.Document{Blocks: []blocks.Block{
+		{Type: blocks.TypeParagraph, Text: "Hello"},
+		{Type: blocks.TypeHeading, Text: "Title", Level: 2},
+	}}
+	out := string(blocks.RenderWith(doc, blocky.BlockOverride()))
+	if !strings.Contains(out, `<p class="lead">Hello</p>`) || !strings.Contains(out, "<h2>Title</h2>") {
+		t.Errorf("rendered = %s", out)
+	}
+}
+
 func TestEmbeddedDeveloperThemeSettings(t *testing.T) {
 	m := testManagerWithEmbedded(t)
 
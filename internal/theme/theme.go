@@ -10,8 +10,12 @@ import (
 	"html/template"
 	"io"
 	"io/fs"
+	"log/slog"
 	"regexp"
 	"strings"
+
+	"github.com/olegiv/ocms-go/internal/blocks"
+	"github.com/olegiv/ocms-go/internal/contenttype"
 )
 
 // Theme engine constants.
@@ -34,6 +38,9 @@ type Config struct {
 	Templates   map[string]string `json:"templates"`
 	Settings    []Setting         `json:"settings"`
 	WidgetAreas []WidgetArea      `json:"widget_areas,omitempty"`
+	// CustomFields defines typed custom fields offered on every page while
+	// the theme is active. Invalid schemas are ignored when loading.
+	CustomFields []contenttype.Field `json:"custom_fields,omitempty"`
 }
 
 // Setting represents a configurable option for a theme.
@@ -91,6 +98,15 @@ func (t *Theme) Render(w io.Writer, templateName string, data any) error {
 	return t.Templates.ExecuteTemplate(w, templateName, data)
 }
 
+// HasPageTemplate reports whether the theme provides a content template for
+// pageName, as looked up by RenderPage.
+func (t *Theme) HasPageTemplate(pageName string) bool {
+	if t == nil || t.Templates == nil {
+		return false
+	}
+	return t.Templates.Lookup("content_"+pageName) != nil || t.Templates.Lookup("pages/"+pageName+".html") != nil
+}
+
 // RenderPage renders a page template within the base layout.
 // It handles the template composition by:
 // 1. Getting the content block for the specific page
@@ -210,6 +226,43 @@ func (t *Theme) GetSettingDefault(key string) string {
 	return ""
 }
 
+// BlockOverride returns a block renderer that uses the block partials of the
+// theme, named block-<type>.html, or nil when the theme overrides no block.
+// Partials receive a blocks.View. Only html themes can override blocks.
+func (t *Theme) BlockOverride() blocks.Override {
+	if t == nil || t.Templates == nil || t.RenderEngine() != EngineHTML {
+		return nil
+	}
+	overrides := false
+	for _, typ := range blocks.Types() {
+		if t.Templates.Lookup(blockPartial(typ)) != nil {
+			overrides = true
+			break
+		}
+	}
+	if !overrides {
+		return nil
+	}
+	return func(v blocks.View) (template.HTML, bool) {
+		tmpl := t.Templates.Lookup(blockPartial(v.Type))
+		if tmpl == nil {
+			return "", false
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, v); err != nil {
+			slog.Error("failed to render theme block partial", "error", err, "theme", t.Name, "type", v.Type)
+			return "", false
+		}
+		// #nosec G203 - output of html/template
+		return template.HTML(buf.String()), true
+	}
+}
+
+// blockPartial returns the partial name that overrides a block type.
+func blockPartial(blockType string) string {
+	return "block-" + blockType + ".html"
+}
+
 // RenderEngine returns the normalized render engine for this theme.
 // If Config.Engine is explicitly "templ" or "html" (case-insensitive), that
 // value is returned. Otherwise embedded themes default to "templ" and
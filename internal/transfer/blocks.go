@@ -0,0 +1,91 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package transfer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/blocks"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// exportPageBlocks returns the block document of a page edited with the block
+// editor, or nil for pages edited as HTML.
+func (e *Exporter) exportPageBlocks(ctx context.Context, pageID int64) (*blocks.Document, error) {
+	row, err := e.store.GetPageBlocks(ctx, pageID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	doc, err := blocks.Parse(row.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// exportedBlocksText returns the block document of an exported page as one
+// string, so media URLs in it are validated and rewritten like the body.
+func exportedBlocksText(page ExportPage) string {
+	if page.Blocks == nil {
+		return ""
+	}
+	return page.Blocks.JSON()
+}
+
+// rewriteBlockMediaURLs applies rewriteKnownMediaURLs to every media URL in
+// the block document of an exported page.
+func rewriteBlockMediaURLs(doc *blocks.Document, replacements map[string]string) *blocks.Document {
+	if doc == nil || len(replacements) == 0 {
+		return doc
+	}
+	rewritten, err := blocks.Parse(rewriteKnownMediaURLs(doc.JSON(), replacements))
+	if err != nil {
+		return doc
+	}
+	return &rewritten
+}
+
+// importPageBlocks stores the block documents of the imported pages. The
+// documents are normalized like editor input; the imported body is kept as
+// it is.
+func (i *Importer) importPageBlocks(
+	ctx context.Context,
+	queries *store.Queries,
+	pages []ExportPage,
+	written map[int64]bool,
+	pageOldToNew map[int64]int64,
+) error {
+	now := time.Now()
+	for _, page := range pages {
+		if !written[page.ID] {
+			continue
+		}
+		pageID := pageOldToNew[page.ID]
+		if page.Blocks == nil {
+			if err := queries.DeletePageBlocks(ctx, pageID); err != nil {
+				return fmt.Errorf("page %q: delete blocks: %w", page.Slug, err)
+			}
+			continue
+		}
+		doc, err := blocks.Normalize(*page.Blocks)
+		if err != nil {
+			return fmt.Errorf("page %q: %w", page.Slug, err)
+		}
+		if err := queries.UpsertPageBlocks(ctx, store.UpsertPageBlocksParams{
+			PageID:    pageID,
+			Data:      doc.JSON(),
+			UpdatedAt: now,
+		}); err != nil {
+			return fmt.Errorf("page %q: save blocks: %w", page.Slug, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package transfer
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/olegiv/ocms-go/internal/blocks"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+func TestTransferRoundTripPreservesBlocks(t *testing.T) {
+	source := setupTest(t)
+	defer source.Cleanup()
+
+	doc := blocks.Document{Version: blocks.CurrentVersion, Blocks: []blocks.Block{
+		{Type: blocks.TypeHeading, Text: "Welcome", Level: 2},
+		{Type: blocks.TypeCTA, Text: "Join us", Label: "Sign up", URL: "/signup"},
+	}}
+	page, err := source.Queries.CreatePage(source.Ctx, store.CreatePageParams{
+		Title: "Landing", Slug: "landing", Body: string(blocks.Render(doc)), Status: "published", PageType: "page",
+		AuthorID: source.User.ID, LanguageCode: "en", CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
+	})
+	require.NoError(t, err)
+	require.NoError(t, source.Queries.UpsertPageBlocks(source.Ctx, store.UpsertPageBlocksParams{
+		PageID: page.ID, Data: doc.JSON(), UpdatedAt: source.Now,
+	}))
+	_, err = source.Queries.CreatePage(source.Ctx, store.CreatePageParams{
+		Title: "Plain", Slug: "plain", Body: "<p>HTML</p>", Status: "published", PageType: "page",
+		AuthorID: source.User.ID, LanguageCode: "en", CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
+	})
+	require.NoError(t, err)
+
+	archive, err := NewExporter(source.Queries, slog.Default()).Export(source.Ctx, DefaultExportOptions())
+	require.NoError(t, err)
+	require.Len(t, archive.Pages, 2)
+
+	destination := setupTest(t)
+	defer destination.Cleanup()
+	result, err := NewImporter(destination.Queries, destination.DB, slog.Default()).
+		Import(destination.Ctx, roundTripJSON(t, archive), DefaultImportOptions())
+	require.NoError(t, err)
+	require.True(t, result.Success, "import errors: %v", result.Errors)
+
+	landing, err := destination.Queries.GetPageBySlug(destination.Ctx, store.GetPageBySlugParams{Slug: "landing", SiteID: store.DefaultSiteID})
+	require.NoError(t, err)
+	row, err := destination.Queries.GetPageBlocks(destination.Ctx, landing.ID)
+	require.NoError(t, err)
+	assert.Equal(t, doc.JSON(), row.Data)
+	assert.Equal(t, page.Body, landing.Body)
+
+	plain, err := destination.Queries.GetPageBySlug(destination.Ctx, store.GetPageBySlugParams{Slug: "plain", SiteID: store.DefaultSiteID})
+	require.NoError(t, err)
+	_, err = destination.Queries.GetPageBlocks(destination.Ctx, plain.ID)
+	assert.Error(t, err, "pages edited as HTML must not get a block document")
+}
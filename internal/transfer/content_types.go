@@ -0,0 +1,261 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package transfer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/contenttype"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/util"
+)
+
+// exportContentTypes exports all content types. They travel with pages since
+// they define the page types and field values of content type pages.
+func (e *Exporter) exportContentTypes(ctx context.Context, data *ExportData) error {
+	types, err := e.store.ListContentTypes(ctx)
+	if err != nil {
+		return err
+	}
+	data.ContentTypes = make([]ExportContentType, 0, len(types))
+	for _, ct := range types {
+		data.ContentTypes = append(data.ContentTypes, ExportContentType{
+			Name:        ct.Name,
+			Slug:        ct.Slug,
+			Description: ct.Description,
+			Template:    ct.Template,
+			Fields:      contenttype.ParseFields(ct.Fields),
+			CreatedAt:   ct.CreatedAt,
+			UpdatedAt:   ct.UpdatedAt,
+		})
+	}
+	return nil
+}
+
+// exportPageContentFields returns the field values of a content type page
+// with media references replaced by their ExportMediaRef. References to media
+// that cannot be resolved are left out.
+func (e *Exporter) exportPageContentFields(
+	ctx context.Context,
+	page store.Page,
+	schemas map[string][]contenttype.Field,
+	mediaMap map[int64]ExportMediaRef,
+) (map[string]any, error) {
+	fields, ok := schemas[page.PageType]
+	if !ok {
+		return nil, nil
+	}
+	row, err := e.store.GetPageContentFields(ctx, page.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	values := contenttype.MapReferences(fields, contenttype.ParseValues(row.Data), func(f contenttype.Field, v any) (any, bool) {
+		id, ok := contenttype.ReferenceID(v)
+		if !ok {
+			return nil, false
+		}
+		if f.Type == contenttype.FieldMedia {
+			ref, ok := mediaMap[id]
+			return ref, ok
+		}
+		return id, true
+	})
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return values, nil
+}
+
+// exportedContentFieldStrings returns the string leaves and media references
+// of exported field values, for media identity validation.
+func exportedContentFieldStrings(v any, strs *[]string, refs *[]ExportMediaRef) {
+	switch val := v.(type) {
+	case string:
+		*strs = append(*strs, val)
+	case ExportMediaRef:
+		*refs = append(*refs, val)
+	case map[string]any:
+		for _, item := range val {
+			exportedContentFieldStrings(item, strs, refs)
+		}
+	case []map[string]any:
+		for _, item := range val {
+			exportedContentFieldStrings(item, strs, refs)
+		}
+	case []any:
+		for _, item := range val {
+			exportedContentFieldStrings(item, strs, refs)
+		}
+	}
+}
+
+// validateContentTypes checks the content types of an archive: valid unique
+// slugs that do not shadow built-in page types and well-formed field schemas.
+func validateContentTypes(errs []ImportError, types []ExportContentType) []ImportError {
+	errs = validateEntities(errs, types, "content_type", "slug",
+		func(ct ExportContentType) string { return ct.Slug }, "name",
+		func(ct ExportContentType) string { return ct.Name })
+	seen := make(map[string]bool, len(types))
+	for _, ct := range types {
+		if ct.Slug == "" {
+			continue
+		}
+		switch {
+		case !util.IsValidSlug(ct.Slug):
+			errs = append(errs, ImportError{Entity: "content_type", ID: ct.Slug, Message: "invalid content type slug"})
+		case contenttype.IsBuiltinPageType(ct.Slug):
+			errs = append(errs, ImportError{Entity: "content_type", ID: ct.Slug, Message: "content type slug is reserved for a built-in page type"})
+		case seen[ct.Slug]:
+			errs = append(errs, ImportError{Entity: "content_type", ID: ct.Slug, Message: "duplicate content type slug"})
+		}
+		seen[ct.Slug] = true
+		if err := contenttype.ValidateFields(ct.Fields); err != nil {
+			errs = append(errs, ImportError{Entity: "content_type", ID: ct.Slug, Message: err.Error()})
+		}
+	}
+	return errs
+}
+
+// importContentTypes imports content types by slug. Renaming is not possible
+// because pages refer to content types by slug, so ConflictRename keeps the
+// existing content type like ConflictSkip.
+func (i *Importer) importContentTypes(ctx context.Context, queries *store.Queries, types []ExportContentType, opts ImportOptions, result *ImportResult) error {
+	now := time.Now()
+	for _, ct := range types {
+		fields := contenttype.FieldsJSON(ct.Fields)
+		existing, err := queries.GetContentTypeBySlug(ctx, ct.Slug)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			result.AddError("content_type", ct.Slug, fmt.Sprintf("failed to check for existing content type: %v", err))
+			return err
+		}
+		if err == nil {
+			if opts.ConflictStrategy != ConflictOverwrite {
+				result.IncrementSkipped("content_types")
+				continue
+			}
+			if _, err := queries.UpdateContentType(ctx, store.UpdateContentTypeParams{
+				ID:          existing.ID,
+				Name:        ct.Name,
+				Slug:        ct.Slug,
+				Description: ct.Description,
+				Fields:      fields,
+				Template:    ct.Template,
+				UpdatedAt:   now,
+			}); err != nil {
+				result.AddError("content_type", ct.Slug, fmt.Sprintf("update: %v", err))
+				return err
+			}
+			result.IncrementUpdated("content_types")
+			continue
+		}
+		if _, err := queries.CreateContentType(ctx, store.CreateContentTypeParams{
+			Name:        ct.Name,
+			Slug:        ct.Slug,
+			Description: ct.Description,
+			Fields:      fields,
+			Template:    ct.Template,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}); err != nil {
+			result.AddError("content_type", ct.Slug, fmt.Sprintf("create: %v", err))
+			return err
+		}
+		result.IncrementCreated("content_types")
+	}
+	return nil
+}
+
+// importPageContentFields stores the field values of the imported pages once
+// every page has its destination ID, so page references between imported
+// pages resolve. Media are matched by UUID and pages through the export IDs;
+// references that cannot be resolved are dropped.
+func (i *Importer) importPageContentFields(
+	ctx context.Context,
+	queries *store.Queries,
+	pages []ExportPage,
+	written map[int64]bool,
+	pageOldToNew map[int64]int64,
+	mediaMap map[string]int64,
+) error {
+	schemas := make(map[string][]contenttype.Field)
+	now := time.Now()
+	for _, page := range pages {
+		if !written[page.ID] {
+			continue
+		}
+		pageID := pageOldToNew[page.ID]
+		if contenttype.IsBuiltinPageType(pageTypeOrDefault(page.PageType)) || len(page.Fields) == 0 {
+			if err := queries.DeletePageContentFields(ctx, pageID); err != nil {
+				return fmt.Errorf("page %q: delete fields: %w", page.Slug, err)
+			}
+			continue
+		}
+		fields, ok := schemas[page.PageType]
+		if !ok {
+			ct, err := queries.GetContentTypeBySlug(ctx, page.PageType)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("page %q: load content type: %w", page.Slug, err)
+			}
+			fields = contenttype.ParseFields(ct.Fields)
+			schemas[page.PageType] = fields
+		}
+		values := contenttype.MapReferences(fields, page.Fields, func(f contenttype.Field, v any) (any, bool) {
+			if f.Type == contenttype.FieldMedia {
+				return importedMediaReference(v, mediaMap)
+			}
+			oldID, ok := contenttype.ReferenceID(v)
+			if !ok {
+				return nil, false
+			}
+			newID, ok := pageOldToNew[oldID]
+			return newID, ok
+		})
+		// Normalize drops unknown keys and keeps stored values well-formed
+		// when an archive was edited by hand or the schema changed.
+		values, _ = contenttype.Normalize(fields, values)
+		if err := queries.UpsertPageContentFields(ctx, store.UpsertPageContentFieldsParams{
+			PageID:    pageID,
+			Data:      values.JSON(),
+			UpdatedAt: now,
+		}); err != nil {
+			return fmt.Errorf("page %q: save fields: %w", page.Slug, err)
+		}
+	}
+	return nil
+}
+
+// importedMediaReference resolves an exported media reference to the ID of
+// the destination media with the same UUID.
+func importedMediaReference(v any, mediaMap map[string]int64) (any, bool) {
+	ref, ok := v.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	mediaUUID, _ := ref["uuid"].(string)
+	if mediaUUID == "" {
+		return nil, false
+	}
+	if id, ok := mediaMap[mediaUUID]; ok {
+		return id, true
+	}
+	id, ok := mediaMap[strings.ToLower(mediaUUID)]
+	return id, ok
+}
+
+// pageTypeOrDefault returns the page type of an imported page. Archives
+// written before page types were exported default to posts.
+func pageTypeOrDefault(pageType string) string {
+	if pageType == "" {
+		return "post"
+	}
+	return pageType
+}
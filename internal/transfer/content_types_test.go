@@ -0,0 +1,136 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package transfer
+
+import (
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/olegiv/ocms-go/internal/contenttype"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+func TestTransferRoundTripPreservesContentTypesAndFields(t *testing.T) {
+	source := setupTest(t)
+	defer source.Cleanup()
+
+	_, err := source.Queries.CreateContentType(source.Ctx, store.CreateContentTypeParams{
+		Name: "Event", Slug: "event", Template: "event",
+		Fields: `[{"name":"venue","label":"Venue","type":"text"},` +
+			`{"name":"poster","label":"Poster","type":"media"},` +
+			`{"name":"related","label":"Related","type":"page"}]`,
+		CreatedAt: source.Now, UpdatedAt: source.Now,
+	})
+	require.NoError(t, err)
+	mediaUUID := "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"
+	media, err := source.Queries.CreateMedia(source.Ctx, store.CreateMediaParams{
+		Uuid: mediaUUID, Filename: "poster.jpg", MimeType: "image/jpeg", UploadedBy: source.User.ID,
+		LanguageCode: "en", CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
+	})
+	require.NoError(t, err)
+	venuePage, err := source.Queries.CreatePage(source.Ctx, store.CreatePageParams{
+		Title: "Venue", Slug: "venue", Status: "published", PageType: "page", AuthorID: source.User.ID,
+		LanguageCode: "en", CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
+	})
+	require.NoError(t, err)
+	eventPage, err := source.Queries.CreatePage(source.Ctx, store.CreatePageParams{
+		Title: "Launch", Slug: "launch", Status: "published", PageType: "event", AuthorID: source.User.ID,
+		LanguageCode: "en", CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
+	})
+	require.NoError(t, err)
+	require.NoError(t, source.Queries.UpsertPageContentFields(source.Ctx, store.UpsertPageContentFieldsParams{
+		PageID:    eventPage.ID,
+		Data:      contenttype.Values{"venue": "Hall", "poster": media.ID, "related": venuePage.ID}.JSON(),
+		UpdatedAt: source.Now,
+	}))
+
+	archive, err := NewExporter(source.Queries, slog.Default()).Export(source.Ctx, DefaultExportOptions())
+	require.NoError(t, err)
+	require.Len(t, archive.ContentTypes, 1)
+	assert.Equal(t, "event", archive.ContentTypes[0].Slug)
+	var exported ExportPage
+	for _, page := range archive.Pages {
+		if page.Slug == "launch" {
+			exported = page
+		}
+	}
+	assert.Equal(t, "event", exported.PageType)
+	assert.Equal(t, ExportMediaRef{UUID: mediaUUID, Filename: "poster.jpg"}, exported.Fields["poster"])
+
+	destination := setupTest(t)
+	defer destination.Cleanup()
+	// Shift destination page IDs so page references must be remapped.
+	_, err = destination.Queries.CreatePage(destination.Ctx, store.CreatePageParams{
+		Title: "Existing", Slug: "existing", Status: "draft", PageType: "post", AuthorID: destination.User.ID,
+		LanguageCode: "en", CreatedAt: destination.Now, UpdatedAt: destination.Now,
+		SiteID: store.DefaultSiteID,
+	})
+	require.NoError(t, err)
+
+	result, err := NewImporter(destination.Queries, destination.DB, slog.Default()).
+		Import(destination.Ctx, roundTripJSON(t, archive), DefaultImportOptions())
+	require.NoError(t, err)
+	require.True(t, result.Success, "import errors: %v", result.Errors)
+	assert.Equal(t, 1, result.Created["content_types"])
+
+	ct, err := destination.Queries.GetContentTypeBySlug(destination.Ctx, "event")
+	require.NoError(t, err)
+	assert.Equal(t, "event", ct.Template)
+	gotEvent, err := destination.Queries.GetPageBySlug(destination.Ctx, store.GetPageBySlugParams{Slug: "launch", SiteID: store.DefaultSiteID})
+	require.NoError(t, err)
+	assert.Equal(t, "event", gotEvent.PageType)
+	gotVenue, err := destination.Queries.GetPageBySlug(destination.Ctx, store.GetPageBySlugParams{Slug: "venue", SiteID: store.DefaultSiteID})
+	require.NoError(t, err)
+	assert.Equal(t, "page", gotVenue.PageType)
+	gotMedia, err := destination.Queries.GetMediaByUUID(destination.Ctx, mediaUUID)
+	require.NoError(t, err)
+
+	row, err := destination.Queries.GetPageContentFields(destination.Ctx, gotEvent.ID)
+	require.NoError(t, err)
+	values := contenttype.ParseValues(row.Data)
+	assert.Equal(t, "Hall", values["venue"])
+	posterID, _ := contenttype.ReferenceID(values["poster"])
+	assert.Equal(t, gotMedia.ID, posterID)
+	relatedID, _ := contenttype.ReferenceID(values["related"])
+	assert.Equal(t, gotVenue.ID, relatedID)
+}
+
+func TestImportRejectsInvalidContentTypeSchemas(t *testing.T) {
+	ts := setupTest(t)
+	defer ts.Cleanup()
+
+	data := &ExportData{
+		Version: ExportVersion,
+		ContentTypes: []ExportContentType{
+			{Name: "Posts", Slug: "post"},
+			{Name: "Bad", Slug: "bad", Fields: []contenttype.Field{{Name: "x", Label: "X", Type: "bogus"}}},
+		},
+	}
+	errs := NewImporter(ts.Queries, ts.DB, slog.Default()).Validate(data)
+	ids := make([]string, 0, len(errs))
+	for _, e := range errs {
+		if e.Entity == "content_type" {
+			ids = append(ids, e.ID)
+		}
+	}
+	assert.ElementsMatch(t, []string{"post", "bad"}, ids)
+}
+
+// roundTripJSON encodes and decodes an archive the way an export file does,
+// so field values reach the importer as generic JSON values.
+func roundTripJSON(t *testing.T, data *ExportData) *ExportData {
+	t.Helper()
+	raw, err := json.Marshal(data)
+	require.NoError(t, err)
+	var decoded ExportData
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	return &decoded
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package transfer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/contenttype"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// exportPageCustomFields returns the key/value custom fields of a page with
+// media references replaced by their ExportMediaRef. References to media that
+// cannot be resolved are left out.
+func (e *Exporter) exportPageCustomFields(ctx context.Context, pageID int64, mediaMap map[int64]ExportMediaRef) ([]contenttype.CustomField, error) {
+	row, err := e.store.GetPageCustomFields(ctx, pageID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	fields := contenttype.MapCustomReferences(contenttype.ParseCustomFields(row.Data), func(f contenttype.Field, v any) (any, bool) {
+		id, ok := contenttype.ReferenceID(v)
+		if !ok {
+			return nil, false
+		}
+		if f.Type == contenttype.FieldMedia {
+			ref, ok := mediaMap[id]
+			return ref, ok
+		}
+		return id, true
+	})
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// importPageCustomFields stores the custom fields of the imported pages once
+// every page has its destination ID. References are resolved like content
+// type field references. Ad-hoc and theme fields are imported as they are;
+// the schema of the destination theme applies the next time a page is saved.
+func (i *Importer) importPageCustomFields(
+	ctx context.Context,
+	queries *store.Queries,
+	pages []ExportPage,
+	written map[int64]bool,
+	pageOldToNew map[int64]int64,
+	mediaMap map[string]int64,
+) error {
+	now := time.Now()
+	for _, page := range pages {
+		if !written[page.ID] {
+			continue
+		}
+		pageID := pageOldToNew[page.ID]
+		fields := contenttype.MapCustomReferences(page.CustomFields, func(f contenttype.Field, v any) (any, bool) {
+			if f.Type == contenttype.FieldMedia {
+				return importedMediaReference(v, mediaMap)
+			}
+			oldID, ok := contenttype.ReferenceID(v)
+			if !ok {
+				return nil, false
+			}
+			newID, ok := pageOldToNew[oldID]
+			return newID, ok
+		})
+		if len(fields) == 0 {
+			if err := queries.DeletePageCustomFields(ctx, pageID); err != nil {
+				return fmt.Errorf("page %q: delete custom fields: %w", page.Slug, err)
+			}
+			continue
+		}
+		if err := queries.UpsertPageCustomFields(ctx, store.UpsertPageCustomFieldsParams{
+			PageID:    pageID,
+			Data:      fields.JSON(),
+			UpdatedAt: now,
+		}); err != nil {
+			return fmt.Errorf("page %q: save custom fields: %w", page.Slug, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package transfer
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/olegiv/ocms-go/internal/contenttype"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+func TestTransferRoundTripPreservesCustomFields(t *testing.T) {
+	source := setupTest(t)
+	defer source.Cleanup()
+
+	mediaUUID := "bbbbbbbb-cccc-dddd-eeee-ffffffffffff"
+	media, err := source.Queries.CreateMedia(source.Ctx, store.CreateMediaParams{
+		Uuid: mediaUUID, Filename: "cover.jpg", MimeType: "image/jpeg", UploadedBy: source.User.ID,
+		LanguageCode: "en", CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
+	})
+	require.NoError(t, err)
+	page, err := source.Queries.CreatePage(source.Ctx, store.CreatePageParams{
+		Title: "About", Slug: "about", Status: "published", PageType: "page", AuthorID: source.User.ID,
+		LanguageCode: "en", CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
+	})
+	require.NoError(t, err)
+	require.NoError(t, source.Queries.UpsertPageCustomFields(source.Ctx, store.UpsertPageCustomFieldsParams{
+		PageID: page.ID,
+		Data: contenttype.CustomFields{
+			{Name: "subtitle", Type: contenttype.FieldText, Value: "Hello"},
+			{Name: "cover", Type: contenttype.FieldMedia, Value: media.ID},
+		}.JSON(),
+		UpdatedAt: source.Now,
+	}))
+
+	archive, err := NewExporter(source.Queries, slog.Default()).Export(source.Ctx, DefaultExportOptions())
+	require.NoError(t, err)
+	require.Len(t, archive.Pages, 1)
+	require.Len(t, archive.Pages[0].CustomFields, 2)
+	assert.Equal(t, ExportMediaRef{UUID: mediaUUID, Filename: "cover.jpg"}, archive.Pages[0].CustomFields[1].Value)
+
+	destination := setupTest(t)
+	defer destination.Cleanup()
+	// Shift destination media IDs so media references must be remapped.
+	_, err = destination.Queries.CreateMedia(destination.Ctx, store.CreateMediaParams{
+		Uuid: "cccccccc-dddd-eeee-ffff-000000000000", Filename: "other.jpg", MimeType: "image/jpeg",
+		UploadedBy: destination.User.ID, LanguageCode: "en", CreatedAt: destination.Now, UpdatedAt: destination.Now,
+		SiteID: store.DefaultSiteID,
+	})
+	require.NoError(t, err)
+
+	result, err := NewImporter(destination.Queries, destination.DB, slog.Default()).
+		Import(destination.Ctx, roundTripJSON(t, archive), DefaultImportOptions())
+	require.NoError(t, err)
+	require.True(t, result.Success, "import errors: %v", result.Errors)
+
+	gotPage, err := destination.Queries.GetPageBySlug(destination.Ctx, store.GetPageBySlugParams{Slug: "about", SiteID: store.DefaultSiteID})
+	require.NoError(t, err)
+	gotMedia, err := destination.Queries.GetMediaByUUID(destination.Ctx, mediaUUID)
+	require.NoError(t, err)
+	row, err := destination.Queries.GetPageCustomFields(destination.Ctx, gotPage.ID)
+	require.NoError(t, err)
+	fields := contenttype.ParseCustomFields(row.Data)
+	subtitle, ok := fields.Get("subtitle")
+	require.True(t, ok)
+	assert.Equal(t, "Hello", subtitle.Value)
+	cover, ok := fields.Get("cover")
+	require.True(t, ok)
+	coverID, _ := contenttype.ReferenceID(cover.Value)
+	assert.Equal(t, gotMedia.ID, coverID)
+}
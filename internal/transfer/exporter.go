@@ -20,6 +20,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/olegiv/ocms-go/internal/contenttype"
 	"github.com/olegiv/ocms-go/internal/imaging"
 	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/store"
@@ -156,6 +157,13 @@ func (e *Exporter) Export(ctx context.Context, opts ExportOptions) (*ExportData,
 		}
 	}
 
+	// Export content types with the pages that use them
+	if opts.IncludePages {
+		if err := e.exportContentTypes(ctx, data); err != nil {
+			return nil, fmt.Errorf("export content types: %w", err)
+		}
+	}
+
 	// Export pages
 	if opts.IncludePages {
 		if err := e.exportPages(ctx, data, opts, userMap, mediaMap); err != nil {
@@ -256,6 +264,7 @@ func validateExportedContentMediaIdentities(data *ExportData) error {
 		}{
 			{fmt.Sprintf("page %q body", page.Slug), page.Body},
 			{fmt.Sprintf("page %q video URL", page.Slug), page.VideoURL},
+			{fmt.Sprintf("page %q blocks", page.Slug), exportedBlocksText(page)},
 		}
 		if page.SEO != nil {
 			values = append(values, struct {
@@ -268,6 +277,22 @@ func validateExportedContentMediaIdentities(data *ExportData) error {
 				return err
 			}
 		}
+		var fieldStrings []string
+		var fieldRefs []ExportMediaRef
+		exportedContentFieldStrings(page.Fields, &fieldStrings, &fieldRefs)
+		for _, cf := range page.CustomFields {
+			exportedContentFieldStrings(cf.Value, &fieldStrings, &fieldRefs)
+		}
+		for _, ref := range fieldRefs {
+			if err := recordUUID(fmt.Sprintf("page %q field media", page.Slug), ref.UUID); err != nil {
+				return err
+			}
+		}
+		for _, value := range fieldStrings {
+			if err := validateValue(fmt.Sprintf("page %q fields", page.Slug), value); err != nil {
+				return err
+			}
+		}
 	}
 	for _, category := range data.Categories {
 		if err := validateValue(fmt.Sprintf("category %q description", category.Slug), category.Description); err != nil {
@@ -788,6 +813,7 @@ func (e *Exporter) exportLanguages(ctx context.Context, data *ExportData) error
 			IsActive:   lang.IsActive,
 			Direction:  lang.Direction,
 			Position:   lang.Position,
+			Host:       lang.Host,
 		})
 	}
 
@@ -1004,6 +1030,10 @@ func (e *Exporter) exportPages(
 	for _, page := range pages {
 		includedPageIDs[page.ID] = struct{}{}
 	}
+	schemas := make(map[string][]contenttype.Field, len(data.ContentTypes))
+	for _, ct := range data.ContentTypes {
+		schemas[ct.Slug] = ct.Fields
+	}
 	data.Pages = make([]ExportPage, 0, len(pages))
 	for _, page := range pages {
 		exportPage := ExportPage{
@@ -1034,6 +1064,21 @@ func (e *Exporter) exportPages(
 		// Handle language
 		exportPage.LanguageCode = page.LanguageCode
 
+		// Handle page type and custom field values
+		exportPage.PageType = page.PageType
+		exportPage.Fields, err = e.exportPageContentFields(ctx, page, schemas, mediaMap)
+		if err != nil {
+			return fmt.Errorf("page %q fields: %w", page.Slug, err)
+		}
+		exportPage.CustomFields, err = e.exportPageCustomFields(ctx, page.ID, mediaMap)
+		if err != nil {
+			return fmt.Errorf("page %q custom fields: %w", page.Slug, err)
+		}
+		exportPage.Blocks, err = e.exportPageBlocks(ctx, page.ID)
+		if err != nil {
+			return fmt.Errorf("page %q blocks: %w", page.Slug, err)
+		}
+
 		// Get categories for page
 		categories, err := e.store.GetCategoriesForPage(ctx, page.ID)
 		if err == nil && len(categories) > 0 {
@@ -1135,7 +1180,7 @@ func (e *Exporter) exportMenus(
 			exportMenu.Items = make([]ExportMenuItem, 0, len(tree.roots))
 			for _, item := range tree.roots {
 				exportItem, include, exportErr := e.exportMenuItem(
-					item, tree.children, pageMap, strictPageReferences)
+					ctx, item, tree.children, pageMap, strictPageReferences)
 				if exportErr != nil {
 					return fmt.Errorf("export item %d for menu %q: %w", item.ID, menu.Slug, exportErr)
 				}
@@ -1153,6 +1198,7 @@ func (e *Exporter) exportMenus(
 
 // exportMenuItem exports a menu item recursively (for nested menus).
 func (e *Exporter) exportMenuItem(
+	ctx context.Context,
 	item store.MenuItem,
 	childrenByParent map[int64][]store.MenuItem,
 	pageMap map[int64]string,
@@ -1166,6 +1212,27 @@ func (e *Exporter) exportMenuItem(
 		CSSClass: nullStringToString(item.CssClass),
 		IsActive: item.IsActive,
 		Position: item.Position,
+
+		ItemType:         item.ItemType,
+		Visibility:       item.Visibility,
+		VisibleRoles:     item.VisibleRoles,
+		VisibleLanguages: item.VisibleLanguages,
+		MegaMenu:         item.MegaMenu,
+		ImageURL:         item.ImageUrl,
+	}
+
+	// Resolve the linked category, tag, form or language. Items whose target
+	// was deleted are not shown on the site, so they are not exported.
+	if item.LinkID.Valid {
+		linkSlug, linkLanguage, err := e.menuItemLink(ctx, item)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ExportMenuItem{}, false, nil
+		}
+		if err != nil {
+			return ExportMenuItem{}, false, fmt.Errorf("resolve link of menu item %d: %w", item.ID, err)
+		}
+		exportItem.LinkSlug = linkSlug
+		exportItem.LinkLanguage = linkLanguage
 	}
 
 	// Resolve page slug
@@ -1188,7 +1255,7 @@ func (e *Exporter) exportMenuItem(
 		exportItem.Children = make([]ExportMenuItem, 0, len(children))
 		for _, child := range children {
 			exportedChild, include, exportErr := e.exportMenuItem(
-				child, childrenByParent, pageMap, strictPageReferences)
+				ctx, child, childrenByParent, pageMap, strictPageReferences)
 			if exportErr != nil {
 				return ExportMenuItem{}, false, exportErr
 			}
@@ -1201,6 +1268,27 @@ func (e *Exporter) exportMenuItem(
 	return exportItem, true, nil
 }
 
+// menuItemLink returns the slug and language of the category, tag or form a
+// menu item links to, or the code of its language.
+func (e *Exporter) menuItemLink(ctx context.Context, item store.MenuItem) (string, string, error) {
+	switch item.ItemType {
+	case model.MenuItemCategory:
+		category, err := e.store.GetCategoryByID(ctx, item.LinkID.Int64)
+		return category.Slug, category.LanguageCode, err
+	case model.MenuItemTag:
+		tag, err := e.store.GetTagByID(ctx, item.LinkID.Int64)
+		return tag.Slug, tag.LanguageCode, err
+	case model.MenuItemForm:
+		form, err := e.store.GetFormByID(ctx, item.LinkID.Int64)
+		return form.Slug, form.LanguageCode, err
+	case model.MenuItemLanguage:
+		language, err := e.store.GetLanguageByID(ctx, item.LinkID.Int64)
+		return language.Code, "", err
+	default:
+		return "", "", nil
+	}
+}
+
 type menuItemTree struct {
 	roots    []store.MenuItem
 	children map[int64][]store.MenuItem
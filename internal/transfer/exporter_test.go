@@ -15,6 +15,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/store"
 	"github.com/olegiv/ocms-go/internal/testutil"
 
@@ -103,6 +104,7 @@ func TestExportWithData(t *testing.T) {
 		LanguageCode: lang.Code,
 		CreatedAt:    ts.Now,
 		UpdatedAt:    ts.Now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create page: %v", err)
@@ -189,6 +191,7 @@ func TestFilteredPageExportOmitsTranslationsToExcludedPages(t *testing.T) {
 	published, err := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 		Title: "Published", Slug: "published-translation-source", Status: "published",
 		AuthorID: ts.User.ID, LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -196,6 +199,7 @@ func TestFilteredPageExportOmitsTranslationsToExcludedPages(t *testing.T) {
 	draft, err := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 		Title: "Draft", Slug: "draft-translation-target", Status: "draft",
 		AuthorID: ts.User.ID, LanguageCode: "fr", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -234,12 +238,14 @@ func TestFilteredPageExportOmitsMenuItemsLinkedOnlyToExcludedPages(t *testing.T)
 	draft, err := source.Queries.CreatePage(source.Ctx, store.CreatePageParams{
 		Title: "Draft", Slug: "draft-menu-target", Status: "draft", AuthorID: source.User.ID,
 		LanguageCode: "en", CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 	menu, err := source.Queries.CreateMenu(source.Ctx, store.CreateMenuParams{
 		Name: "Main", Slug: "main", LanguageCode: "en", CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -331,6 +337,7 @@ func TestMenuOnlyExportResolvesExistingDestinationPage(t *testing.T) {
 		LanguageCode: "en",
 		CreatedAt:    destination.Now,
 		UpdatedAt:    destination.Now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -343,6 +350,7 @@ func TestMenuOnlyExportResolvesExistingDestinationPage(t *testing.T) {
 		LanguageCode: "en",
 		CreatedAt:    destination.Now,
 		UpdatedAt:    destination.Now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -474,6 +482,7 @@ func TestMenuOnlyExportPropagatesPageLookupFailure(t *testing.T) {
 
 func TestMenuOnlyExportRejectsUnresolvedPageReference(t *testing.T) {
 	item, included, err := NewExporter(nil, slog.Default()).exportMenuItem(
+		context.Background(),
 		store.MenuItem{
 			ID:     43,
 			Title:  "Stale page",
@@ -490,6 +499,7 @@ func TestExportMenusPropagatesListMenuItemsFailure(t *testing.T) {
 	t.Cleanup(ts.Cleanup)
 	if _, err := ts.Queries.CreateMenu(ts.Ctx, store.CreateMenuParams{
 		Name: "Main", Slug: "main", LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatal(err)
 	}
@@ -573,6 +583,7 @@ func TestExportMenusRejectsInvalidStoredHierarchy(t *testing.T) {
 		childMenu, err := ts.Queries.CreateMenu(ts.Ctx, store.CreateMenuParams{
 			Name: "A child menu", Slug: "child", LanguageCode: "en",
 			CreatedAt: ts.Now, UpdatedAt: ts.Now,
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatal(err)
@@ -580,6 +591,7 @@ func TestExportMenusRejectsInvalidStoredHierarchy(t *testing.T) {
 		parentMenu, err := ts.Queries.CreateMenu(ts.Ctx, store.CreateMenuParams{
 			Name: "B parent menu", Slug: "parent", LanguageCode: "en",
 			CreatedAt: ts.Now, UpdatedAt: ts.Now,
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatal(err)
@@ -612,6 +624,7 @@ func TestExportMenusRejectsInvalidStoredHierarchy(t *testing.T) {
 		t.Cleanup(ts.Cleanup)
 		menu, err := ts.Queries.CreateMenu(ts.Ctx, store.CreateMenuParams{
 			Name: "Main", Slug: "main", LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatal(err)
@@ -679,6 +692,7 @@ func TestPageLookupMapsPaginateWithStableTimestampTie(t *testing.T) {
 			LanguageCode: "en",
 			CreatedAt:    ts.Now,
 			UpdatedAt:    ts.Now,
+			SiteID:       store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatal(err)
@@ -740,6 +754,7 @@ func exportMenuOnlyHierarchy(t *testing.T) *ExportData {
 		LanguageCode: "en",
 		CreatedAt:    source.Now,
 		UpdatedAt:    source.Now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -752,12 +767,14 @@ func exportMenuOnlyHierarchy(t *testing.T) *ExportData {
 		LanguageCode: "en",
 		CreatedAt:    source.Now,
 		UpdatedAt:    source.Now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 	menu, err := source.Queries.CreateMenu(source.Ctx, store.CreateMenuParams{
 		Name: "Main", Slug: "main", LanguageCode: "en", CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -798,6 +815,91 @@ func exportMenuOnlyHierarchy(t *testing.T) *ExportData {
 	return data
 }
 
+func TestMenuOnlyExportResolvesTypedItemsBySlug(t *testing.T) {
+	source := setupTest(t)
+	t.Cleanup(source.Cleanup)
+	category, err := source.Queries.CreateCategory(source.Ctx, store.CreateCategoryParams{
+		Name: "News", Slug: "news", LanguageCode: "en", CreatedAt: source.Now, UpdatedAt: source.Now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	menu, err := source.Queries.CreateMenu(source.Ctx, store.CreateMenuParams{
+		Name: "Main", Slug: "main", LanguageCode: "en", CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := source.Queries.CreateMenuItem(source.Ctx, store.CreateMenuItemParams{
+		MenuID: menu.ID, Title: "News", ItemType: model.MenuItemCategory,
+		LinkID:     sql.NullInt64{Int64: category.ID, Valid: true},
+		Visibility: model.MenuVisibilityRoles, VisibleRoles: model.RoleEditor, VisibleLanguages: "en",
+		MegaMenu: true, ImageUrl: "/uploads/news.jpg", IsActive: true,
+		CreatedAt: source.Now, UpdatedAt: source.Now,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := source.Queries.CreateMenuItem(source.Ctx, store.CreateMenuItemParams{
+		MenuID: menu.ID, Title: "Deleted tag", ItemType: model.MenuItemTag,
+		LinkID:   sql.NullInt64{Int64: 999, Valid: true},
+		Position: 1, IsActive: true, CreatedAt: source.Now, UpdatedAt: source.Now,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := NewExporter(source.Queries, slog.Default()).Export(source.Ctx, ExportOptions{IncludeMenus: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Menus) != 1 || len(data.Menus[0].Items) != 1 {
+		t.Fatalf("exported menus = %+v, want only the item with a live target", data.Menus)
+	}
+	exported := data.Menus[0].Items[0]
+	if exported.ItemType != model.MenuItemCategory || exported.LinkSlug != "news" ||
+		exported.Visibility != model.MenuVisibilityRoles || exported.VisibleRoles != model.RoleEditor ||
+		exported.VisibleLanguages != "en" || !exported.MegaMenu || exported.ImageURL != "/uploads/news.jpg" {
+		t.Fatalf("exported item = %+v, want typed link fields", exported)
+	}
+
+	destination := setupTest(t)
+	t.Cleanup(destination.Cleanup)
+	for _, slug := range []string{"events", "news"} {
+		category, err = destination.Queries.CreateCategory(destination.Ctx, store.CreateCategoryParams{
+			Name: slug, Slug: slug, LanguageCode: "en", CreatedAt: destination.Now, UpdatedAt: destination.Now,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	importer := NewImporter(destination.Queries, destination.DB, slog.Default())
+	result, err := importer.Import(destination.Ctx, data, ImportOptions{ConflictStrategy: ConflictSkip, ImportMenus: true})
+	if err != nil {
+		t.Fatalf("import typed menu: %v (%+v)", err, result.Errors)
+	}
+	imported, err := destination.Queries.GetMenuBySlugAndLanguage(destination.Ctx,
+		store.GetMenuBySlugAndLanguageParams{Slug: "main", LanguageCode: "en"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	items, err := destination.Queries.ListTopLevelMenuItems(destination.Ctx, imported.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || items[0].ItemType != model.MenuItemCategory ||
+		!items[0].LinkID.Valid || items[0].LinkID.Int64 != category.ID ||
+		items[0].VisibleRoles != model.RoleEditor || !items[0].MegaMenu || items[0].ImageUrl != "/uploads/news.jpg" {
+		t.Fatalf("imported items = %+v, want category %d", items, category.ID)
+	}
+
+	data.Menus[0].Items[0].LinkSlug = "missing"
+	data.Menus[0].Slug = "other"
+	if _, err := importer.Import(destination.Ctx, data, ImportOptions{ConflictStrategy: ConflictSkip, ImportMenus: true}); err == nil ||
+		!strings.Contains(err.Error(), `referenced category "missing" was not imported`) {
+		t.Fatalf("import with missing category = %v, want unresolved link failure", err)
+	}
+}
+
 func importErrorsContain(errs []ImportError, text string) bool {
 	for _, err := range errs {
 		if strings.Contains(err.Message, text) {
@@ -826,6 +928,7 @@ func TestExportOptionsFiltering(t *testing.T) {
 		LanguageCode: lang.Code,
 		CreatedAt:    ts.Now,
 		UpdatedAt:    ts.Now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create published page: %v", err)
@@ -840,6 +943,7 @@ func TestExportOptionsFiltering(t *testing.T) {
 		LanguageCode: lang.Code,
 		CreatedAt:    ts.Now,
 		UpdatedAt:    ts.Now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create draft page: %v", err)
@@ -1031,6 +1135,7 @@ func TestExportMenuWithItems(t *testing.T) {
 		LanguageCode: lang.Code,
 		CreatedAt:    now,
 		UpdatedAt:    now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create menu: %v", err)
@@ -1208,6 +1313,7 @@ func TestExportPageWithTranslations(t *testing.T) {
 		LanguageCode: enLang.Code,
 		CreatedAt:    ts.Now,
 		UpdatedAt:    ts.Now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create English page: %v", err)
@@ -1223,6 +1329,7 @@ func TestExportPageWithTranslations(t *testing.T) {
 		LanguageCode: ruLang.Code,
 		CreatedAt:    ts.Now,
 		UpdatedAt:    ts.Now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create Russian page: %v", err)
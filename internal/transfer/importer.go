@@ -29,6 +29,7 @@ import (
 
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/olegiv/ocms-go/internal/contenttype"
 	"github.com/olegiv/ocms-go/internal/imaging"
 	"github.com/olegiv/ocms-go/internal/model"
 	"github.com/olegiv/ocms-go/internal/store"
@@ -271,6 +272,7 @@ func normalizedTransferMediaIdentities(data *ExportData, normalizeContentReferen
 	for index := range normalized.Pages {
 		page := &normalized.Pages[index]
 		page.Body = rewriteKnownMediaURLs(page.Body, uuidReplacements)
+		page.Blocks = rewriteBlockMediaURLs(page.Blocks, uuidReplacements)
 		page.VideoURL = rewriteKnownMediaURLs(page.VideoURL, uuidReplacements)
 		if page.FeaturedImage != nil {
 			ref := *page.FeaturedImage
@@ -596,6 +598,11 @@ func (i *Importer) importSelectedEntities(
 			return fmt.Errorf("build media map: %w", err)
 		}
 	}
+	if opts.ImportPages && len(data.ContentTypes) > 0 {
+		if err := i.importContentTypes(ctx, queries, data.ContentTypes, opts, result); err != nil {
+			return fmt.Errorf("failed to import content types: %w", err)
+		}
+	}
 	if opts.ImportPages && len(data.Pages) > 0 {
 		if err := i.importPages(ctx, queries, data.Pages, userMap, categoryMap, tagMap, mediaMap,
 			defaultLangCode, opts, result); err != nil {
@@ -621,14 +628,16 @@ func (i *Importer) importSelectedEntities(
 		}
 	}
 
+	// Forms are imported before menus so menu items can link to them.
+	if opts.ImportForms && len(data.Forms) > 0 {
+		i.importForms(ctx, queries, data.Forms, defaultLangCode, opts, result)
+	}
 	if opts.ImportMenus && len(data.Menus) > 0 {
-		if err := i.importMenus(ctx, queries, data.Menus, pageMap, defaultLangCode, opts, result); err != nil {
+		resolveLink := i.menuItemLinkResolver(ctx, queries, data, defaultLangCode, result)
+		if err := i.importMenus(ctx, queries, data.Menus, pageMap, resolveLink, defaultLangCode, opts, result); err != nil {
 			return fmt.Errorf("failed to import menus: %w", err)
 		}
 	}
-	if opts.ImportForms && len(data.Forms) > 0 {
-		i.importForms(ctx, queries, data.Forms, defaultLangCode, opts, result)
-	}
 	if opts.ImportConfig && len(data.Config) > 0 {
 		i.importConfig(ctx, queries, data.Config, userMap, defaultLangCode, opts, result)
 	}
@@ -731,6 +740,9 @@ func validateImportedContentMediaURLs(
 			if err := validateValue(fmt.Sprintf("page %q body", page.Slug), page.Body); err != nil {
 				return err
 			}
+			if err := validateValue(fmt.Sprintf("page %q blocks", page.Slug), exportedBlocksText(page)); err != nil {
+				return err
+			}
 			if err := validateValue(fmt.Sprintf("page %q video URL", page.Slug), page.VideoURL); err != nil {
 				return err
 			}
@@ -824,6 +836,7 @@ func importNeedsMediaIdentityResolution(data *ExportData, opts ImportOptions) bo
 		for _, page := range data.Pages {
 			if page.FeaturedImage != nil || (page.SEO != nil && page.SEO.OgImage != nil) ||
 				containsKnownMediaURL(page.Body) || containsKnownMediaURL(page.VideoURL) ||
+				containsKnownMediaURL(exportedBlocksText(page)) ||
 				(page.SEO != nil && containsKnownMediaURL(page.SEO.CanonicalURL)) {
 				return true
 			}
@@ -2426,6 +2439,7 @@ func (i *Importer) validate(data *ExportData, importLanguages, importMedia bool)
 			func(l ExportLanguage) string { return l.Code }, "name",
 			func(l ExportLanguage) string { return l.Name })
 		activeDefaults := 0
+		seenHosts := make(map[string]bool)
 		for idx, lang := range data.Languages {
 			if lang.IsDefault && !lang.IsActive {
 				errs = append(errs, ImportError{
@@ -2437,6 +2451,24 @@ func (i *Importer) validate(data *ExportData, importLanguages, importMedia bool)
 			if lang.IsDefault && lang.IsActive {
 				activeDefaults++
 			}
+			if lang.Host != "" {
+				// Hosts select a language on their own, so they must already be
+				// in the normalized form the admin form stores, and unique.
+				if host, err := util.NormalizeHost(lang.Host); err != nil || host != lang.Host {
+					errs = append(errs, ImportError{
+						Entity:  "language",
+						ID:      strconv.Itoa(idx),
+						Message: fmt.Sprintf("language %q has an invalid host %q", lang.Code, lang.Host),
+					})
+				} else if seenHosts[lang.Host] {
+					errs = append(errs, ImportError{
+						Entity:  "language",
+						ID:      strconv.Itoa(idx),
+						Message: fmt.Sprintf("language host %q is used more than once", lang.Host),
+					})
+				}
+				seenHosts[lang.Host] = true
+			}
 			// Inactive legacy rows remain importable so administrators can rename or
 			// delete them after restore. Only active rows participate in public URL
 			// routing, so those must satisfy the same shared policy as the admin form.
@@ -2466,6 +2498,8 @@ func (i *Importer) validate(data *ExportData, importLanguages, importMedia bool)
 		}
 	}
 
+	errs = validateContentTypes(errs, data.ContentTypes)
+
 	errs = validateEntities(errs, data.Users, "user", "email",
 		func(u ExportUser) string { return u.Email }, "role",
 		func(u ExportUser) string { return u.Role })
@@ -2800,7 +2834,7 @@ func (i *Importer) validateExistingSlugLanguages(
 	}
 	if opts.ImportPages {
 		for _, page := range data.Pages {
-			existing, err := i.store.GetPageBySlug(ctx, page.Slug)
+			existing, err := i.store.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: page.Slug, SiteID: store.SiteIDFromContext(ctx)})
 			if err == nil {
 				checkLanguage("page", page.Slug,
 					importedLanguageCode(page.LanguageCode, defaultLangCode), existing.LanguageCode)
@@ -2866,7 +2900,7 @@ func (i *Importer) preflightRelations(ctx context.Context, data *ExportData, opt
 		if exists, cached := pageExists[slug]; cached {
 			return exists, nil
 		}
-		_, err := i.store.GetPageBySlug(ctx, slug)
+		_, err := i.store.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: slug, SiteID: store.SiteIDFromContext(ctx)})
 		exists, err := lookupExists(err)
 		if err == nil {
 			pageExists[slug] = exists
@@ -3428,7 +3462,7 @@ func (i *Importer) ValidateData(ctx context.Context, data *ExportData) (*Validat
 
 	// Pages
 	for _, page := range data.Pages {
-		_, err := i.store.GetPageBySlug(ctx, page.Slug)
+		_, err := i.store.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: page.Slug, SiteID: store.SiteIDFromContext(ctx)})
 		exists, err := lookupExists(err)
 		if err != nil {
 			return nil, fmt.Errorf("check page %q conflict: %w", page.Slug, err)
@@ -3593,8 +3627,14 @@ func (i *Importer) countEntities(
 		}
 	}
 	if opts.ImportPages {
+		for _, ct := range data.ContentTypes {
+			_, err := i.store.GetContentTypeBySlug(ctx, ct.Slug)
+			if err := countLookup("content_types", ct.Slug, err); err != nil {
+				return err
+			}
+		}
 		for _, page := range data.Pages {
-			_, err := i.store.GetPageBySlug(ctx, page.Slug)
+			_, err := i.store.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: page.Slug, SiteID: store.SiteIDFromContext(ctx)})
 			if err := countLookup("pages", page.Slug, err); err != nil {
 				return err
 			}
@@ -3681,6 +3721,7 @@ func (i *Importer) importLanguages(ctx context.Context, queries *store.Queries,
 					Direction:  lang.Direction,
 					Position:   lang.Position,
 					UpdatedAt:  now,
+					Host:       lang.Host,
 				})
 				if err != nil {
 					result.AddError("language", lang.Code, err.Error())
@@ -3706,6 +3747,7 @@ func (i *Importer) importLanguages(ctx context.Context, queries *store.Queries,
 			Position:   lang.Position,
 			CreatedAt:  now,
 			UpdatedAt:  now,
+			Host:       lang.Host,
 		})
 		if err != nil {
 			result.AddError("language", lang.Code, err.Error())
@@ -4116,6 +4158,7 @@ func (i *Importer) importMedia(ctx context.Context, queries *store.Queries, medi
 			LanguageCode: langCode,
 			CreatedAt:    now,
 			UpdatedAt:    now,
+			SiteID:       store.SiteIDFromContext(ctx),
 		})
 		if err != nil {
 			result.AddError("media", m.UUID, err.Error())
@@ -4172,10 +4215,22 @@ func (i *Importer) importPages(
 	now := time.Now()
 
 	pageOldToNew := make(map[int64]int64) // maps export ID to new ID
+	written := make(map[int64]bool)       // export IDs of created or updated pages
 
 	for _, page := range pages {
+		if pageType := pageTypeOrDefault(page.PageType); !contenttype.IsBuiltinPageType(pageType) {
+			if _, err := queries.GetContentTypeBySlug(ctx, pageType); err != nil {
+				if !errors.Is(err, sql.ErrNoRows) {
+					result.AddError("page", page.Slug, fmt.Sprintf("failed to check content type: %v", err))
+					return err
+				}
+				result.AddError("page", page.Slug, fmt.Sprintf("unknown page type %q", pageType))
+				continue
+			}
+		}
+
 		// Check if page exists
-		existing, existsErr := queries.GetPageBySlug(ctx, page.Slug)
+		existing, existsErr := queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: page.Slug, SiteID: store.SiteIDFromContext(ctx)})
 		if existsErr != nil && !errors.Is(existsErr, sql.ErrNoRows) {
 			result.AddError("page", page.Slug, fmt.Sprintf("failed to check for existing page: %v", existsErr))
 			continue
@@ -4262,6 +4317,7 @@ func (i *Importer) importPages(
 			}
 		}
 		pageOldToNew[page.ID] = pageID
+		written[page.ID] = true
 		if createdPage {
 			result.IncrementCreated("pages")
 		}
@@ -4270,6 +4326,19 @@ func (i *Importer) importPages(
 		}
 	}
 
+	if err := i.importPageContentFields(ctx, queries, pages, written, pageOldToNew, mediaMap); err != nil {
+		result.AddError("page", "", err.Error())
+		return err
+	}
+	if err := i.importPageCustomFields(ctx, queries, pages, written, pageOldToNew, mediaMap); err != nil {
+		result.AddError("page", "", err.Error())
+		return err
+	}
+	if err := i.importPageBlocks(ctx, queries, pages, written, pageOldToNew); err != nil {
+		result.AddError("page", "", err.Error())
+		return err
+	}
+
 	// Store mapping for use later
 	for oldID, newID := range pageOldToNew {
 		result.GetIDMap("pages")[oldID] = newID
@@ -4356,6 +4425,7 @@ func (i *Importer) updateExistingPage(
 		Slug:            page.Slug,
 		Body:            page.Body,
 		Status:          page.Status,
+		PageType:        pageTypeOrDefault(page.PageType),
 		FeaturedImageID: f.FeaturedImageID,
 		MetaTitle:       f.MetaTitle,
 		MetaDescription: f.MetaDescription,
@@ -4400,6 +4470,7 @@ func (i *Importer) createNewPage(
 		Slug:            page.Slug,
 		Body:            page.Body,
 		Status:          page.Status,
+		PageType:        pageTypeOrDefault(page.PageType),
 		AuthorID:        authorID,
 		FeaturedImageID: f.FeaturedImageID,
 		MetaTitle:       f.MetaTitle,
@@ -4415,6 +4486,7 @@ func (i *Importer) createNewPage(
 		VideoTitle:      page.VideoTitle,
 		CreatedAt:       now,
 		UpdatedAt:       now,
+		SiteID:          store.SiteIDFromContext(ctx),
 	})
 	if err != nil {
 		return 0, err
@@ -4423,7 +4495,7 @@ func (i *Importer) createNewPage(
 	return created.ID, nil
 }
 
-func (i *Importer) importMenus(ctx context.Context, queries *store.Queries, menus []ExportMenu, pageMap map[string]int64, defaultLangCode string, opts ImportOptions, result *ImportResult) error {
+func (i *Importer) importMenus(ctx context.Context, queries *store.Queries, menus []ExportMenu, pageMap map[string]int64, resolveLink menuItemLinkResolver, defaultLangCode string, opts ImportOptions, result *ImportResult) error {
 	now := time.Now()
 
 	for _, menu := range menus {
@@ -4488,6 +4560,7 @@ func (i *Importer) importMenus(ctx context.Context, queries *store.Queries, menu
 				LanguageCode: languageCode,
 				CreatedAt:    now,
 				UpdatedAt:    now,
+				SiteID:       store.SiteIDFromContext(ctx),
 			})
 			if createErr != nil {
 				result.AddError("menu", menu.Slug, createErr.Error())
@@ -4498,7 +4571,7 @@ func (i *Importer) importMenus(ctx context.Context, queries *store.Queries, menu
 		}
 
 		// Import menu items
-		if err := i.importMenuItems(ctx, queries, menuID, menu.Items, pageMap, sql.NullInt64{}, now); err != nil {
+		if err := i.importMenuItems(ctx, queries, menuID, menu.Items, pageMap, resolveLink, sql.NullInt64{}, now); err != nil {
 			result.AddError("menu", menu.Slug, fmt.Sprintf("failed to import menu items: %v", err))
 			return err
 		}
@@ -4511,7 +4584,7 @@ func (i *Importer) importMenus(ctx context.Context, queries *store.Queries, menu
 	return nil
 }
 
-func (i *Importer) importMenuItems(ctx context.Context, queries *store.Queries, menuID int64, items []ExportMenuItem, pageMap map[string]int64, parentID sql.NullInt64, now time.Time) error {
+func (i *Importer) importMenuItems(ctx context.Context, queries *store.Queries, menuID int64, items []ExportMenuItem, pageMap map[string]int64, resolveLink menuItemLinkResolver, parentID sql.NullInt64, now time.Time) error {
 	for _, item := range items {
 		// Get page ID if linked
 		pageID := sql.NullInt64{}
@@ -4523,18 +4596,30 @@ func (i *Importer) importMenuItems(ctx context.Context, queries *store.Queries,
 			pageID = sql.NullInt64{Int64: id, Valid: true}
 		}
 
+		linkID, err := resolveLink(item)
+		if err != nil {
+			return err
+		}
+
 		created, err := queries.CreateMenuItem(ctx, store.CreateMenuItemParams{
-			MenuID:    menuID,
-			ParentID:  parentID,
-			Title:     item.Title,
-			Url:       toNullString(item.URL),
-			Target:    toNullString(item.Target),
-			PageID:    pageID,
-			Position:  item.Position,
-			CssClass:  toNullString(item.CSSClass),
-			IsActive:  item.IsActive,
-			CreatedAt: now,
-			UpdatedAt: now,
+			MenuID:           menuID,
+			ParentID:         parentID,
+			Title:            item.Title,
+			Url:              toNullString(item.URL),
+			Target:           toNullString(item.Target),
+			PageID:           pageID,
+			Position:         item.Position,
+			CssClass:         toNullString(item.CSSClass),
+			IsActive:         item.IsActive,
+			ItemType:         item.ItemType,
+			LinkID:           linkID,
+			Visibility:       item.Visibility,
+			VisibleRoles:     item.VisibleRoles,
+			VisibleLanguages: item.VisibleLanguages,
+			MegaMenu:         item.MegaMenu,
+			ImageUrl:         item.ImageURL,
+			CreatedAt:        now,
+			UpdatedAt:        now,
 		})
 		if err != nil {
 			return err
@@ -4543,7 +4628,7 @@ func (i *Importer) importMenuItems(ctx context.Context, queries *store.Queries,
 		// Import children recursively
 		if len(item.Children) > 0 {
 			newParentID := sql.NullInt64{Int64: created.ID, Valid: true}
-			if err := i.importMenuItems(ctx, queries, menuID, item.Children, pageMap, newParentID, now); err != nil {
+			if err := i.importMenuItems(ctx, queries, menuID, item.Children, pageMap, resolveLink, newParentID, now); err != nil {
 				return err
 			}
 		}
@@ -4552,6 +4637,77 @@ func (i *Importer) importMenuItems(ctx context.Context, queries *store.Queries,
 	return nil
 }
 
+// menuItemLinkResolver returns the ID of the category, tag, form or language
+// an imported menu item links to.
+type menuItemLinkResolver func(item ExportMenuItem) (sql.NullInt64, error)
+
+// menuItemLinkResolver resolves menu item links by slug. Targets imported from
+// the same archive use their imported IDs, so renamed slugs still resolve;
+// anything else must already exist on the site.
+func (i *Importer) menuItemLinkResolver(ctx context.Context, queries *store.Queries, data *ExportData, defaultLangCode string, result *ImportResult) menuItemLinkResolver {
+	return func(item ExportMenuItem) (sql.NullInt64, error) {
+		if item.ItemType == model.MenuItemLink || item.ItemType == model.MenuItemBlog ||
+			(item.ItemType == model.MenuItemLanguage && item.LinkSlug == "") {
+			return sql.NullInt64{}, nil
+		}
+		if !model.IsValidMenuItemType(item.ItemType) {
+			return sql.NullInt64{}, fmt.Errorf("menu item %q has unknown type %q", item.Title, item.ItemType)
+		}
+
+		var id int64
+		var err error
+		switch item.ItemType {
+		case model.MenuItemCategory:
+			for _, category := range data.Categories {
+				if category.Slug == item.LinkSlug {
+					if newID, ok := result.GetIDMap("categories")[category.ID]; ok {
+						return sql.NullInt64{Int64: newID, Valid: true}, nil
+					}
+				}
+			}
+			var category store.Category
+			category, err = queries.GetCategoryBySlug(ctx, item.LinkSlug)
+			id = category.ID
+		case model.MenuItemTag:
+			for _, tag := range data.Tags {
+				if tag.Slug == item.LinkSlug {
+					if newID, ok := result.GetIDMap("tags")[tag.ID]; ok {
+						return sql.NullInt64{Int64: newID, Valid: true}, nil
+					}
+				}
+			}
+			var tag store.Tag
+			tag, err = queries.GetTagBySlug(ctx, item.LinkSlug)
+			id = tag.ID
+		case model.MenuItemForm:
+			langCode := importedLanguageCode(item.LinkLanguage, defaultLangCode)
+			for _, form := range data.Forms {
+				if form.Slug == item.LinkSlug && importedLanguageCode(form.LanguageCode, defaultLangCode) == langCode {
+					if newID, ok := result.GetIDMap("forms")[form.ID]; ok {
+						return sql.NullInt64{Int64: newID, Valid: true}, nil
+					}
+				}
+			}
+			var form store.Form
+			form, err = queries.GetFormBySlugAndLanguage(ctx, store.GetFormBySlugAndLanguageParams{
+				Slug: item.LinkSlug, LanguageCode: langCode,
+			})
+			id = form.ID
+		case model.MenuItemLanguage:
+			var language store.Language
+			language, err = queries.GetLanguageByCode(ctx, item.LinkSlug)
+			id = language.ID
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return sql.NullInt64{}, fmt.Errorf("referenced %s %q was not imported", item.ItemType, item.LinkSlug)
+		}
+		if err != nil {
+			return sql.NullInt64{}, err
+		}
+		return sql.NullInt64{Int64: id, Valid: true}, nil
+	}
+}
+
 func (i *Importer) importForms(ctx context.Context, queries *store.Queries, forms []ExportForm, defaultLangCode string, opts ImportOptions, result *ImportResult) {
 	now := time.Now()
 
@@ -5013,7 +5169,7 @@ func lookupExistingTranslationEntity(
 ) (int64, error) {
 	switch entityType {
 	case "page":
-		entity, err := queries.GetPageBySlug(ctx, slug)
+		entity, err := queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: slug, SiteID: store.SiteIDFromContext(ctx)})
 		return entity.ID, err
 	case "category":
 		entity, err := queries.GetCategoryBySlug(ctx, slug)
@@ -5179,7 +5335,7 @@ func (i *Importer) countTranslationEdges(
 			slug := slugs[set.entityType][set.sourceOldID]
 			switch set.entityType {
 			case "page":
-				entity, err := i.store.GetPageBySlug(ctx, slug)
+				entity, err := i.store.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: slug, SiteID: store.SiteIDFromContext(ctx)})
 				if err == nil {
 					sourceID = entity.ID
 				} else if !errors.Is(err, sql.ErrNoRows) {
@@ -5527,7 +5683,7 @@ func (i *Importer) generateUniqueSlug(ctx context.Context, queries *store.Querie
 
 		switch entityType {
 		case "page":
-			count, e := queries.SlugExists(ctx, slug)
+			count, e := queries.SlugExists(ctx, store.SlugExistsParams{Slug: slug, SiteID: store.SiteIDFromContext(ctx)})
 			exists = count > 0
 			err = e
 		case "category":
@@ -5573,7 +5729,7 @@ func (i *Importer) generateUniqueLanguageSlug(
 		switch entityType {
 		case "menu":
 			exists, err = queries.MenuSlugExistsForLanguage(ctx, store.MenuSlugExistsForLanguageParams{
-				Slug: slug, LanguageCode: languageCode,
+				Slug: slug, LanguageCode: languageCode, SiteID: store.SiteIDFromContext(ctx),
 			})
 		case "form":
 			exists, err = queries.FormSlugExistsForLanguage(ctx, store.FormSlugExistsForLanguageParams{
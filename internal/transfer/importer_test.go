@@ -72,7 +72,7 @@ func TestJSONImportRejectsMediaFileRestoreOption(t *testing.T) {
 			})
 		}
 	}
-	if _, err := ts.Queries.GetPageBySlug(ts.Ctx, "zip-only-files"); !errors.Is(err, sql.ErrNoRows) {
+	if _, err := ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "zip-only-files", SiteID: store.DefaultSiteID}); !errors.Is(err, sql.ErrNoRows) {
 		t.Fatalf("rejected JSON import page lookup = %v, want sql.ErrNoRows", err)
 	}
 }
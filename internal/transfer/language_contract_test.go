@@ -83,7 +83,7 @@ func TestContentOnlyPreviewDryRunAndImportIgnoreUnusedArchiveLanguageState(t *te
 			require.NoError(t, err)
 			require.True(t, result.Success, "result errors: %+v", result.Errors)
 			assert.Equal(t, 1, result.Created["pages"])
-			_, lookupErr := ts.Queries.GetPageBySlug(ts.Ctx, "content-only")
+			_, lookupErr := ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "content-only", SiteID: store.DefaultSiteID})
 			if dryRun {
 				require.ErrorIs(t, lookupErr, sql.ErrNoRows)
 			} else {
@@ -236,7 +236,7 @@ func TestImporterRejectsUnknownEntityLanguageBeforeWriting(t *testing.T) {
 	result, err := importer.Import(ts.Ctx, data, opts)
 	require.ErrorContains(t, err, "unknown language")
 	require.NotNil(t, result)
-	_, lookupErr := ts.Queries.GetPageBySlug(ts.Ctx, "french-page")
+	_, lookupErr := ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "french-page", SiteID: store.DefaultSiteID})
 	require.ErrorIs(t, lookupErr, sql.ErrNoRows)
 }
 
@@ -262,7 +262,7 @@ func TestImporterRejectsUnroutableDestinationDefaultBeforeDryRunAndWrites(t *tes
 				require.NotNil(t, result)
 				require.False(t, result.Success)
 			}
-			_, err = ts.Queries.GetPageBySlug(ts.Ctx, "imported")
+			_, err = ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "imported", SiteID: store.DefaultSiteID})
 			require.ErrorIs(t, err, sql.ErrNoRows)
 		})
 	}
@@ -294,7 +294,7 @@ func TestImporterRejectsExplicitUnroutableDestinationLanguage(t *testing.T) {
 				require.NotNil(t, result)
 				require.False(t, result.Success)
 			}
-			_, err = ts.Queries.GetPageBySlug(ts.Ctx, "imported")
+			_, err = ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "imported", SiteID: store.DefaultSiteID})
 			require.ErrorIs(t, err, sql.ErrNoRows)
 		})
 	}
@@ -322,6 +322,7 @@ func TestValidateAndDryRunScopeMenusAndFormsByLanguage(t *testing.T) {
 	_, err := ts.Queries.CreateMenu(ts.Ctx, store.CreateMenuParams{
 		Name: "Deutsch", Slug: "main", LanguageCode: "de",
 		CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	require.NoError(t, err)
 	_, err = ts.Queries.CreateForm(ts.Ctx, store.CreateFormParams{
@@ -363,7 +364,7 @@ func TestLanguageScopedImportDoesNotCreateAfterOperationalLookupFailure(t *testi
 			name: "menu", table: "menus", entity: "menu",
 			importItem: func(ctx context.Context, importer *Importer, queries *store.Queries, result *ImportResult) {
 				_ = importer.importMenus(ctx, queries, []ExportMenu{{Name: "Main", Slug: "main", LanguageCode: "en"}},
-					nil, "en", ImportOptions{ConflictStrategy: ConflictSkip}, result)
+					nil, nil, "en", ImportOptions{ConflictStrategy: ConflictSkip}, result)
 			},
 		},
 		{
@@ -419,7 +420,7 @@ func TestPreviewDryRunAndImportRejectAmbiguousDestinationDefault(t *testing.T) {
 		})
 		require.ErrorContains(t, importErr, "exactly one default")
 		require.NotNil(t, result)
-		_, lookupErr := ts.Queries.GetPageBySlug(ts.Ctx, "page")
+		_, lookupErr := ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "page", SiteID: store.DefaultSiteID})
 		require.ErrorIs(t, lookupErr, sql.ErrNoRows)
 	}
 }
@@ -486,11 +487,13 @@ func TestConflictOverwriteReplacesIncomingAndOutgoingTranslationEdges(t *testing
 	pageTarget, err := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 		Title: "English page", Slug: "page-en", Status: "published", AuthorID: ts.User.ID,
 		LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	require.NoError(t, err)
 	pageSource, err := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 		Title: "French page", Slug: "page-fr", Status: "published", AuthorID: ts.User.ID,
 		LanguageCode: "fr", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	require.NoError(t, err)
 
@@ -622,6 +625,7 @@ func TestPreviewUsesArchiveDefaultForBlankLanguageConflicts(t *testing.T) {
 	defer ts.Cleanup()
 	_, err := ts.Queries.CreateMenu(ts.Ctx, store.CreateMenuParams{
 		Name: "English main", Slug: "main", LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	require.NoError(t, err)
 	_, err = ts.Queries.CreateForm(ts.Ctx, store.CreateFormParams{
@@ -686,6 +690,7 @@ func TestConflictSkipDoesNotCreateCrossLanguageTranslation(t *testing.T) {
 	_, err = ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 		Title: "Administrator page", Slug: "bonjour", Status: "published", AuthorID: ts.User.ID,
 		LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	require.NoError(t, err)
 	data := &ExportData{Version: ExportVersion, Pages: []ExportPage{
@@ -702,7 +707,7 @@ func TestConflictSkipDoesNotCreateCrossLanguageTranslation(t *testing.T) {
 	require.NoError(t, ts.DB.QueryRowContext(ts.Ctx,
 		`SELECT COUNT(*) FROM translations WHERE language_id = ?`, fr.ID).Scan(&translationCount))
 	require.Zero(t, translationCount)
-	_, helloErr := ts.Queries.GetPageBySlug(ts.Ctx, "hello")
+	_, helloErr := ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "hello", SiteID: store.DefaultSiteID})
 	require.ErrorIs(t, helloErr, sql.ErrNoRows)
 }
 
@@ -712,6 +717,7 @@ func TestDryRunRenameCountsCreatedAndInvalidStrategyFails(t *testing.T) {
 	_, err := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 		Title: "Existing", Slug: "existing", Status: "draft", AuthorID: ts.User.ID,
 		LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	require.NoError(t, err)
 	data := &ExportData{Version: ExportVersion, Pages: []ExportPage{{
@@ -742,6 +748,7 @@ func TestConflictRenameMenuItemTargetsRenamedImportedPage(t *testing.T) {
 	existing, err := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 		Title: "Existing", Slug: "about", Status: "published", AuthorID: ts.User.ID,
 		LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	require.NoError(t, err)
 	data := &ExportData{
@@ -782,6 +789,7 @@ func TestConflictRenameFailedPageDoesNotRebindMenuToExistingPage(t *testing.T) {
 	existing, err := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 		Title: "Existing", Slug: "about", Status: "published", AuthorID: ts.User.ID,
 		LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	require.NoError(t, err)
 	_, err = ts.DB.ExecContext(ts.Ctx, `
@@ -811,7 +819,7 @@ func TestConflictRenameFailedPageDoesNotRebindMenuToExistingPage(t *testing.T) {
 		Slug: "main", LanguageCode: "en",
 	})
 	require.ErrorIs(t, err, sql.ErrNoRows)
-	unchanged, err := ts.Queries.GetPageBySlug(ts.Ctx, "about")
+	unchanged, err := ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "about", SiteID: store.DefaultSiteID})
 	require.NoError(t, err)
 	assert.Equal(t, existing.ID, unchanged.ID)
 }
@@ -857,7 +865,7 @@ func TestConflictRenameFailedTaxonomyRollsBackInsteadOfRebinding(t *testing.T) {
 	require.ErrorContains(t, err, "imported parent category")
 	require.False(t, result.Success)
 	require.Zero(t, result.GetIDMap("pages")[4])
-	_, err = ts.Queries.GetPageBySlug(ts.Ctx, "imported-page")
+	_, err = ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "imported-page", SiteID: store.DefaultSiteID})
 	require.ErrorIs(t, err, sql.ErrNoRows)
 	_, err = ts.Queries.GetCategoryBySlug(ts.Ctx, "child")
 	require.ErrorIs(t, err, sql.ErrNoRows)
@@ -888,7 +896,7 @@ func TestTaxonomyAssociationFailureRollsBackImport(t *testing.T) {
 	})
 	require.ErrorContains(t, err, "forced page tag failure")
 	require.False(t, result.Success)
-	_, err = ts.Queries.GetPageBySlug(ts.Ctx, "imported")
+	_, err = ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "imported", SiteID: store.DefaultSiteID})
 	require.ErrorIs(t, err, sql.ErrNoRows)
 	_, err = ts.Queries.GetTagBySlug(ts.Ctx, "topic")
 	require.ErrorIs(t, err, sql.ErrNoRows)
@@ -1237,16 +1245,19 @@ func TestConflictSkipRejectsMergingDestinationTranslationComponents(t *testing.T
 				existingSource, createErr := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 					Title: "Existing source", Slug: "existing-source", Status: "draft", AuthorID: ts.User.ID,
 					LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+					SiteID: store.DefaultSiteID,
 				})
 				require.NoError(t, createErr)
 				source, createErr := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 					Title: "Archive source", Slug: "archive-source", Status: "draft", AuthorID: ts.User.ID,
 					LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+					SiteID: store.DefaultSiteID,
 				})
 				require.NoError(t, createErr)
 				target, createErr := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 					Title: "French target", Slug: "french-target", Status: "draft", AuthorID: ts.User.ID,
 					LanguageCode: "fr", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+					SiteID: store.DefaultSiteID,
 				})
 				require.NoError(t, createErr)
 				existingSourceID, sourceID, targetID = existingSource.ID, source.ID, target.ID
@@ -1334,11 +1345,13 @@ func TestConflictSkipPreflightAggregatesAllDestinationComponents(t *testing.T) {
 		existingEN, err := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 			Title: "Existing English", Slug: "existing-english", Status: "draft", AuthorID: ts.User.ID,
 			LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+			SiteID: store.DefaultSiteID,
 		})
 		require.NoError(t, err)
 		targetFR, err := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 			Title: "French target", Slug: "french-target", Status: "draft", AuthorID: ts.User.ID,
 			LanguageCode: "fr", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+			SiteID: store.DefaultSiteID,
 		})
 		require.NoError(t, err)
 		_, err = ts.Queries.CreateTranslation(ts.Ctx, store.CreateTranslationParams{
@@ -1374,6 +1387,7 @@ func TestConflictSkipPreflightAggregatesAllDestinationComponents(t *testing.T) {
 			page, createErr := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 				Title: title, Slug: slug, Status: "draft", AuthorID: ts.User.ID,
 				LanguageCode: language, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+				SiteID: store.DefaultSiteID,
 			})
 			require.NoError(t, createErr)
 			return page
@@ -1413,6 +1427,7 @@ func TestPreviewAllowsConflictsResolvableByRenameOrOverwrite(t *testing.T) {
 	_, err = ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 		Title: "English", Slug: "about", Status: "published", AuthorID: ts.User.ID,
 		LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	require.NoError(t, err)
 	data := &ExportData{Version: ExportVersion, Pages: []ExportPage{{
@@ -1519,11 +1534,13 @@ func TestTransferRoundTripPreservesLanguagesAndTranslations(t *testing.T) {
 	enPage, err := source.Queries.CreatePage(source.Ctx, store.CreatePageParams{
 		Title: "Hello", Slug: "hello", Status: "published", AuthorID: source.User.ID,
 		LanguageCode: en.Code, CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	require.NoError(t, err)
 	frPage, err := source.Queries.CreatePage(source.Ctx, store.CreatePageParams{
 		Title: "Bonjour", Slug: "bonjour", Status: "published", AuthorID: source.User.ID,
 		LanguageCode: fr.Code, CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	require.NoError(t, err)
 
@@ -1552,11 +1569,13 @@ func TestTransferRoundTripPreservesLanguagesAndTranslations(t *testing.T) {
 	_, err = source.Queries.CreateMedia(source.Ctx, store.CreateMediaParams{
 		Uuid: mediaUUID, Filename: "photo.jpg", MimeType: "image/jpeg", UploadedBy: source.User.ID,
 		LanguageCode: fr.Code, CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	require.NoError(t, err)
 	_, err = source.Queries.CreateMenu(source.Ctx, store.CreateMenuParams{
 		Name: "Principal", Slug: "main", LanguageCode: fr.Code,
 		CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	require.NoError(t, err)
 	exporter := NewExporter(source.Queries, slog.Default())
@@ -1606,9 +1625,9 @@ func TestTransferRoundTripPreservesLanguagesAndTranslations(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, fr.Code, gotFRForm.LanguageCode)
 
-	gotENPage, err := destination.Queries.GetPageBySlug(destination.Ctx, enPage.Slug)
+	gotENPage, err := destination.Queries.GetPageBySlug(destination.Ctx, store.GetPageBySlugParams{Slug: enPage.Slug, SiteID: store.DefaultSiteID})
 	require.NoError(t, err)
-	gotFRPage, err := destination.Queries.GetPageBySlug(destination.Ctx, frPage.Slug)
+	gotFRPage, err := destination.Queries.GetPageBySlug(destination.Ctx, store.GetPageBySlugParams{Slug: frPage.Slug, SiteID: store.DefaultSiteID})
 	require.NoError(t, err)
 	gotENCategory, err := destination.Queries.GetCategoryBySlug(destination.Ctx, enCategory.Slug)
 	require.NoError(t, err)
@@ -1684,7 +1703,7 @@ func TestImportRejectsPageSlugShadowedByLanguagePrefix(t *testing.T) {
 				}
 				return false
 			})
-			_, lookupErr := ts.Queries.GetPageBySlug(ts.Ctx, "eng")
+			_, lookupErr := ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "eng", SiteID: store.DefaultSiteID})
 			assert.ErrorIs(t, lookupErr, sql.ErrNoRows)
 		})
 	}
@@ -29,6 +29,7 @@ func createExportMedia(t *testing.T, ts *testSetup, mediaUUID, filename, mimeTyp
 		Uuid: mediaUUID, Filename: filename, MimeType: mimeType, Size: size,
 		Width: sql.NullInt64{}, Height: sql.NullInt64{}, UploadedBy: ts.User.ID,
 		LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -521,6 +522,7 @@ func TestMetadataOnlyTransferRejectsUnsafeMediaMetadata(t *testing.T) {
 				Uuid: mediaUUID, Filename: testCase.filename, MimeType: mimeType, Size: testCase.size,
 				Width: testCase.width, Height: testCase.height, UploadedBy: ts.User.ID,
 				LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+				SiteID: store.DefaultSiteID,
 			})
 			if err != nil {
 				t.Fatal(err)
@@ -558,6 +560,7 @@ func TestPageOnlyExportRejectsOnlyReferencedInvalidMediaUUID(t *testing.T) {
 	if _, err := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 		Title: "Unrelated", Slug: "unrelated", Body: "body", Status: "draft",
 		AuthorID: ts.User.ID, LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatal(err)
 	}
@@ -570,6 +573,7 @@ func TestPageOnlyExportRejectsOnlyReferencedInvalidMediaUUID(t *testing.T) {
 		Title: "Referenced", Slug: "referenced", Body: "body", Status: "draft",
 		AuthorID: ts.User.ID, FeaturedImageID: sql.NullInt64{Int64: legacy.ID, Valid: true},
 		LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatal(err)
 	}
@@ -600,6 +604,7 @@ func TestPageOnlyExportRejectsCaseFoldAmbiguousMediaReferences(t *testing.T) {
 			Body: "body", Status: "draft", AuthorID: ts.User.ID,
 			FeaturedImageID: sql.NullInt64{Int64: medium.ID, Valid: true},
 			LanguageCode:    language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+			SiteID: store.DefaultSiteID,
 		}); err != nil {
 			t.Fatal(err)
 		}
@@ -627,6 +632,7 @@ func TestPageOnlyExportRejectsInvalidEmbeddedMediaUUID(t *testing.T) {
 		Title: "Legacy image", Slug: "legacy-image",
 		Body: `<img src="/uploads/originals/legacy-media-1/photo.jpg">`, Status: "draft",
 		AuthorID: ts.User.ID, LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatal(err)
 	}
@@ -660,6 +666,7 @@ func TestExportIgnoresExternalUploadLikeURLs(t *testing.T) {
 			`<a href="//cdn.example/uploads/originals/team/photo.png">team</a>`,
 		Status: "draft", AuthorID: ts.User.ID, LanguageCode: language.Code,
 		CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatal(err)
 	}
@@ -693,6 +700,7 @@ func TestExportStillRejectsRootRelativeInvalidMediaURL(t *testing.T) {
 			if _, err := ts.Queries.CreatePage(ts.Ctx, store.CreatePageParams{
 				Title: "Local", Slug: "local", Body: body, Status: "draft",
 				AuthorID: ts.User.ID, LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+				SiteID: store.DefaultSiteID,
 			}); err != nil {
 				t.Fatal(err)
 			}
@@ -723,6 +731,7 @@ func TestExportRejectsCaseFoldAmbiguityAcrossSelectedContent(t *testing.T) {
 		Title: "Lower", Slug: "lower",
 		Body: `<img src="/uploads/originals/` + lowerUUID + `/photo.jpg">`, Status: "draft",
 		AuthorID: ts.User.ID, LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatal(err)
 	}
@@ -760,6 +769,7 @@ func TestExportAllowsCarriedMediaToNormalizeMixedCaseReferences(t *testing.T) {
 		Body: `<a href="/uploads/originals/` + lowerUUID + `/report.pdf">report</a>`, Status: "draft",
 		AuthorID: source.User.ID, FeaturedImageID: sql.NullInt64{Int64: medium.ID, Valid: true},
 		LanguageCode: language.Code, CreatedAt: source.Now, UpdatedAt: source.Now,
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatal(err)
 	}
@@ -788,7 +798,7 @@ func TestExportAllowsCarriedMediaToNormalizeMixedCaseReferences(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	page, err := destination.Queries.GetPageBySlug(destination.Ctx, "mixed-case")
+	page, err := destination.Queries.GetPageBySlug(destination.Ctx, store.GetPageBySlugParams{Slug: "mixed-case", SiteID: store.DefaultSiteID})
 	if err != nil || page.FeaturedImageID.Int64 != importedMedia.ID || !strings.Contains(page.Body, lowerUUID) {
 		t.Fatalf("imported page = %+v, error = %v", page, err)
 	}
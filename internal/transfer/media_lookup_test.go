@@ -27,6 +27,7 @@ func TestMediaLookupPaginationIsCompleteWithEqualTimestamps(t *testing.T) {
 			Uuid: mediaUUID, Filename: fmt.Sprintf("file-%d.pdf", index), MimeType: model.MimeTypePDF,
 			Size: 1, Width: sql.NullInt64{}, Height: sql.NullInt64{}, UploadedBy: ts.User.ID,
 			LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+			SiteID: store.DefaultSiteID,
 		}); err != nil {
 			t.Fatalf("CreateMedia(%d): %v", index, err)
 		}
@@ -583,6 +583,7 @@ func TestImportFromZipUppercaseArchiveMatchesLowercaseDestinationIdentity(t *tes
 	existing, err := ts.Queries.CreateMedia(ts.Ctx, store.CreateMediaParams{
 		Uuid: lowerUUID, Filename: "report.pdf", MimeType: model.MimeTypePDF, Size: 6,
 		UploadedBy: ts.User.ID, LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -608,7 +609,7 @@ func TestImportFromZipUppercaseArchiveMatchesLowercaseDestinationIdentity(t *tes
 	if err != nil || len(media) != 1 || media[0].ID != existing.ID || media[0].Uuid != lowerUUID {
 		t.Fatalf("destination media = %+v, error = %v", media, err)
 	}
-	page, err := ts.Queries.GetPageBySlug(ts.Ctx, "embedded-media")
+	page, err := ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "embedded-media", SiteID: store.DefaultSiteID})
 	if err != nil || !strings.Contains(page.Body, "/uploads/originals/"+lowerUUID+"/report.pdf") ||
 		page.FeaturedImageID.Int64 != existing.ID {
 		t.Fatalf("normalized page = %+v, error = %v", page, err)
@@ -634,6 +635,7 @@ func TestMediaImportRejectsLegacyDestinationCaseCollisionBeforeWrites(t *testing
 	if _, err := ts.Queries.CreateMedia(ts.Ctx, store.CreateMediaParams{
 		Uuid: upperUUID, Filename: "report.pdf", MimeType: model.MimeTypePDF, Size: 6,
 		UploadedBy: ts.User.ID, LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatal(err)
 	}
@@ -688,6 +690,7 @@ func TestPageOnlyImportPreservesExactUppercaseMediaIdentityAndURLs(t *testing.T)
 	medium, err := ts.Queries.CreateMedia(ts.Ctx, store.CreateMediaParams{
 		Uuid: upperUUID, Filename: "report.pdf", MimeType: model.MimeTypePDF, Size: 6,
 		UploadedBy: ts.User.ID, LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -712,7 +715,7 @@ func TestPageOnlyImportPreservesExactUppercaseMediaIdentityAndURLs(t *testing.T)
 	if err != nil || result == nil || !result.Success {
 		t.Fatalf("Import() = (%+v, %v)", result, err)
 	}
-	page, err := ts.Queries.GetPageBySlug(ts.Ctx, "legacy-media-case")
+	page, err := ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "legacy-media-case", SiteID: store.DefaultSiteID})
 	if err != nil || page.FeaturedImageID.Int64 != medium.ID || page.OgImageID.Int64 != medium.ID ||
 		!strings.Contains(page.Body, mediaURL) {
 		t.Fatalf("imported page = %+v, error = %v", page, err)
@@ -735,6 +738,7 @@ func TestFullArchiveMediaDeselectedPreservesExactUppercasePageReferences(t *test
 			medium, err := ts.Queries.CreateMedia(ts.Ctx, store.CreateMediaParams{
 				Uuid: upperUUID, Filename: "report.pdf", MimeType: model.MimeTypePDF, Size: 6,
 				UploadedBy: ts.User.ID, LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+				SiteID: store.DefaultSiteID,
 			})
 			if err != nil {
 				t.Fatal(err)
@@ -760,12 +764,12 @@ func TestFullArchiveMediaDeselectedPreservesExactUppercasePageReferences(t *test
 				t.Fatalf("Import() = (%+v, %v)", result, err)
 			}
 			if dryRun {
-				if _, err := ts.Queries.GetPageBySlug(ts.Ctx, "media-deselected"); !errors.Is(err, sql.ErrNoRows) {
+				if _, err := ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "media-deselected", SiteID: store.DefaultSiteID}); !errors.Is(err, sql.ErrNoRows) {
 					t.Fatalf("dry-run page lookup = %v, want sql.ErrNoRows", err)
 				}
 				return
 			}
-			page, err := ts.Queries.GetPageBySlug(ts.Ctx, "media-deselected")
+			page, err := ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "media-deselected", SiteID: store.DefaultSiteID})
 			if err != nil || page.FeaturedImageID.Int64 != medium.ID || !strings.Contains(page.Body, mediaURL) {
 				t.Fatalf("imported page = %+v, error = %v", page, err)
 			}
@@ -790,6 +794,7 @@ func TestPreviewAllowsInvalidOptionalMediaOnlyWithViableContentImport(t *testing
 		existing, err := ts.Queries.CreateMedia(ts.Ctx, store.CreateMediaParams{
 			Uuid: mediaUUID, Filename: "safe.png", MimeType: model.MimeTypePNG, Size: 1,
 			UploadedBy: ts.User.ID, LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatal(err)
@@ -824,7 +829,7 @@ func TestPreviewAllowsInvalidOptionalMediaOnlyWithViableContentImport(t *testing
 				t.Fatalf("dry_run=%t page-only Import() = (%+v, %v)", dryRun, result, err)
 			}
 		}
-		page, err := ts.Queries.GetPageBySlug(ts.Ctx, "optional-media")
+		page, err := ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "optional-media", SiteID: store.DefaultSiteID})
 		if err != nil || !page.FeaturedImageID.Valid || page.FeaturedImageID.Int64 != existing.ID {
 			t.Fatalf("imported page = %+v, error = %v", page, err)
 		}
@@ -875,6 +880,7 @@ func TestFileOnlyRestoreNormalizesCarriedMediaReferences(t *testing.T) {
 			medium, err := ts.Queries.CreateMedia(ts.Ctx, store.CreateMediaParams{
 				Uuid: lowerUUID, Filename: "report.pdf", MimeType: model.MimeTypePDF, Size: 6,
 				UploadedBy: ts.User.ID, LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+				SiteID: store.DefaultSiteID,
 			})
 			if err != nil {
 				t.Fatal(err)
@@ -908,7 +914,7 @@ func TestFileOnlyRestoreNormalizesCarriedMediaReferences(t *testing.T) {
 				}
 				return
 			}
-			page, err := ts.Queries.GetPageBySlug(ts.Ctx, "file-restore")
+			page, err := ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: "file-restore", SiteID: store.DefaultSiteID})
 			if err != nil || page.FeaturedImageID.Int64 != medium.ID ||
 				!strings.Contains(page.Body, "/uploads/originals/"+lowerUUID+"/report.pdf") {
 				t.Fatalf("imported page = %+v, error = %v", page, err)
@@ -929,6 +935,7 @@ func TestFileOnlyRestoreRejectsInvalidMediaMetadataBeforeOpeningUploads(t *testi
 	if _, err := ts.Queries.CreateMedia(ts.Ctx, store.CreateMediaParams{
 		Uuid: mediaUUID, Filename: "photo.jpg", MimeType: model.MimeTypePNG, Size: int64(len(pngBytes)),
 		UploadedBy: ts.User.ID, LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatal(err)
 	}
@@ -986,6 +993,7 @@ func TestURLOnlyContentRequiresExactDestinationMediaIdentity(t *testing.T) {
 			if _, err := ts.Queries.CreateMedia(ts.Ctx, store.CreateMediaParams{
 				Uuid: upperUUID, Filename: "report.pdf", MimeType: model.MimeTypePDF, Size: 6,
 				UploadedBy: ts.User.ID, LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+				SiteID: store.DefaultSiteID,
 			}); err != nil {
 				t.Fatal(err)
 			}
@@ -1004,7 +1012,7 @@ func TestURLOnlyContentRequiresExactDestinationMediaIdentity(t *testing.T) {
 				}
 			}
 			if len(testCase.data.Pages) > 0 {
-				if _, err := ts.Queries.GetPageBySlug(ts.Ctx, testCase.data.Pages[0].Slug); !errors.Is(err, sql.ErrNoRows) {
+				if _, err := ts.Queries.GetPageBySlug(ts.Ctx, store.GetPageBySlugParams{Slug: testCase.data.Pages[0].Slug, SiteID: store.DefaultSiteID}); !errors.Is(err, sql.ErrNoRows) {
 					t.Fatalf("rejected page lookup error = %v", err)
 				}
 			}
@@ -1154,6 +1162,7 @@ func TestImportFromZipFileOnlyRestoreRequiresMatchingDestination(t *testing.T) {
 			Uuid: mediaUUID, Filename: "report.pdf", MimeType: model.MimeTypePDF, Size: 6,
 			Width: sql.NullInt64{}, Height: sql.NullInt64{}, UploadedBy: ts.User.ID,
 			LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreateMedia() error = %v", err)
@@ -1184,6 +1193,7 @@ func TestImportFromZipFileOnlyRestoreRequiresMatchingDestination(t *testing.T) {
 			Uuid: mediaUUID, Filename: "report.pdf", MimeType: model.MimeTypePDF, Size: 6,
 			Width: sql.NullInt64{}, Height: sql.NullInt64{}, UploadedBy: ts.User.ID,
 			LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreateMedia() error = %v", err)
@@ -1701,6 +1711,7 @@ func TestImportFromZipOverwriteReplacesMediaAndVariantMetadata(t *testing.T) {
 		Width: sql.NullInt64{}, Height: sql.NullInt64{}, Alt: sql.NullString{String: "old alt", Valid: true},
 		Caption: sql.NullString{String: "old caption", Valid: true}, UploadedBy: ts.User.ID,
 		LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -1834,6 +1845,7 @@ func TestImportFromZipRejectsCorruptImagesWhenVariantGenerationIsSkipped(t *test
 						Uuid: mediaUUID, Filename: "image.png", MimeType: model.MimeTypePNG,
 						Size: int64(len(corrupt)), UploadedBy: ts.User.ID, LanguageCode: language.Code,
 						CreatedAt: ts.Now, UpdatedAt: ts.Now,
+						SiteID: store.DefaultSiteID,
 					}); err != nil {
 						t.Fatal(err)
 					}
@@ -1970,6 +1982,7 @@ func TestImportFromZipSkipAndFileOnlyDoNotGenerateVariants(t *testing.T) {
 				Size: int64(len(original)), Width: sql.NullInt64{Int64: width, Valid: true},
 				Height: sql.NullInt64{Int64: height, Valid: true}, UploadedBy: ts.User.ID,
 				LanguageCode: language.Code, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+				SiteID: store.DefaultSiteID,
 			})
 			if err != nil {
 				t.Fatal(err)
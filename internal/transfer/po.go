@@ -0,0 +1,220 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package transfer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// poSourceLanguageHeader names the PO header carrying the source language,
+// which gettext has no standard header for.
+const poSourceLanguageHeader = "X-Source-Language"
+
+// WritePO writes a bundle as a gettext PO file. Every unit becomes an entry
+// whose msgctxt is "<item key>/<unit id>", e.g. "page-12/title".
+func WritePO(w io.Writer, b *TranslationBundle) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, `msgid ""`)
+	fmt.Fprintln(bw, `msgstr ""`)
+	fmt.Fprintln(bw, `"Content-Type: text/plain; charset=UTF-8\n"`)
+	fmt.Fprintf(bw, "\"Language: %s\\n\"\n", b.TargetLang)
+	fmt.Fprintf(bw, "\"%s: %s\\n\"\n", poSourceLanguageHeader, b.SourceLang)
+
+	for _, item := range b.Items {
+		for _, unit := range item.Units {
+			fmt.Fprintln(bw)
+			if item.Original != "" {
+				fmt.Fprintf(bw, "#: %s\n", item.Original)
+			}
+			writePOString(bw, "msgctxt", item.Key()+"/"+unit.ID)
+			writePOString(bw, "msgid", unit.Source)
+			writePOString(bw, "msgstr", unit.Target)
+		}
+	}
+	return bw.Flush()
+}
+
+// writePOString writes a keyword with a quoted string. Multi-line strings are
+// split after each newline, the usual gettext layout.
+func writePOString(w io.Writer, keyword, s string) {
+	if !strings.Contains(s, "\n") {
+		fmt.Fprintf(w, "%s %s\n", keyword, quotePO(s))
+		return
+	}
+	fmt.Fprintf(w, "%s \"\"\n", keyword)
+	for line := range strings.SplitAfterSeq(s, "\n") {
+		if line != "" {
+			fmt.Fprintln(w, quotePO(line))
+		}
+	}
+}
+
+func quotePO(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// unquotePO decodes a quoted PO string. Go's unquoting handles the same
+// escapes gettext writes.
+func unquotePO(s string) (string, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return "", fmt.Errorf("invalid string %s", s)
+	}
+	return strconv.Unquote(s)
+}
+
+// poEntry is a parsed PO entry.
+type poEntry struct {
+	reference string
+	fuzzy     bool
+	context   string
+	id        string
+	str       string
+}
+
+// ParsePO reads a PO file written by WritePO and returned by a translator.
+// Fuzzy entries are treated as untranslated; plural forms are not supported.
+func ParsePO(r io.Reader) (*TranslationBundle, error) {
+	entries, err := readPOEntries(r)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &TranslationBundle{}
+	index := make(map[string]int)
+	for _, e := range entries {
+		if e.context == "" && e.id == "" {
+			b.SourceLang, b.TargetLang = parsePOHeader(e.str)
+			continue
+		}
+		key, unitID, ok := strings.Cut(e.context, "/")
+		if !ok || unitID == "" {
+			return nil, fmt.Errorf("invalid msgctxt %q", e.context)
+		}
+		kind, id, err := parseTranslationItemKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("msgctxt %q: %w", e.context, err)
+		}
+		pos, seen := index[key]
+		if !seen {
+			pos = len(b.Items)
+			index[key] = pos
+			b.Items = append(b.Items, TranslationItem{Kind: kind, ID: id, Original: e.reference})
+		}
+		unit := TranslationUnit{ID: unitID, Source: e.id}
+		if !e.fuzzy {
+			unit.Target = e.str
+		}
+		b.Items[pos].Units = append(b.Items[pos].Units, unit)
+	}
+	if b.SourceLang == "" {
+		return nil, fmt.Errorf("PO file has no %s header", poSourceLanguageHeader)
+	}
+	return b, nil
+}
+
+// parsePOHeader returns the source and target language from a PO header.
+func parsePOHeader(header string) (string, string) {
+	var source, target string
+	for line := range strings.SplitSeq(header, "\n") {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		switch strings.TrimSpace(name) {
+		case poSourceLanguageHeader:
+			source = strings.TrimSpace(value)
+		case "Language":
+			target = strings.TrimSpace(value)
+		}
+	}
+	return source, target
+}
+
+func readPOEntries(r io.Reader) ([]poEntry, error) {
+	var entries []poEntry
+	var cur poEntry
+	var field *string
+	started := false
+	flush := func() {
+		if started {
+			entries = append(entries, cur)
+		}
+		cur, field, started = poEntry{}, nil, false
+	}
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := strings.TrimSpace(sc.Text())
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "#~"):
+			// Obsolete entry
+		case strings.HasPrefix(line, "#"):
+			if field != nil {
+				flush()
+			}
+			if ref, ok := strings.CutPrefix(line, "#:"); ok {
+				cur.reference = strings.TrimSpace(ref)
+			} else if flags, ok := strings.CutPrefix(line, "#,"); ok && strings.Contains(flags, "fuzzy") {
+				cur.fuzzy = true
+			}
+		case strings.HasPrefix(line, `"`):
+			if field == nil {
+				return nil, fmt.Errorf("PO line %d: string without keyword", lineNo)
+			}
+			s, err := unquotePO(line)
+			if err != nil {
+				return nil, fmt.Errorf("PO line %d: %w", lineNo, err)
+			}
+			*field += s
+		default:
+			keyword, value, _ := strings.Cut(line, " ")
+			s, err := unquotePO(strings.TrimSpace(value))
+			if err != nil {
+				return nil, fmt.Errorf("PO line %d: %w", lineNo, err)
+			}
+			if keyword == "msgctxt" && field != nil {
+				flush()
+			}
+			switch keyword {
+			case "msgctxt":
+				field = &cur.context
+			case "msgid":
+				field = &cur.id
+			case "msgstr":
+				field = &cur.str
+			case "msgid_plural", "msgstr[0]":
+				return nil, errors.New("PO plural forms are not supported")
+			default:
+				return nil, fmt.Errorf("PO line %d: unknown keyword %q", lineNo, keyword)
+			}
+			started = true
+			*field = s
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read PO file: %w", err)
+	}
+	flush()
+	return entries, nil
+}
+
+// ParseTranslationFile reads an XLIFF 2.0 or PO file, detected by content.
+func ParseTranslationFile(data []byte) (*TranslationBundle, error) {
+	trimmed := strings.TrimLeft(string(data), "\ufeff \t\r\n")
+	if strings.HasPrefix(trimmed, "<") {
+		return ParseXLIFF(strings.NewReader(trimmed))
+	}
+	return ParsePO(strings.NewReader(trimmed))
+}
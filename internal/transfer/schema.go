@@ -4,25 +4,31 @@
 // Package transfer provides import/export functionality for oCMS content.
 package transfer
 
-import "time"
+import (
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/blocks"
+	"github.com/olegiv/ocms-go/internal/contenttype"
+)
 
 // ExportVersion is the current version of the export format.
 const ExportVersion = "1.0"
 
 // ExportData represents the complete export structure.
 type ExportData struct {
-	Version    string            `json:"version"`
-	ExportedAt time.Time         `json:"exported_at"`
-	Site       ExportSite        `json:"site"`
-	Languages  []ExportLanguage  `json:"languages,omitempty"`
-	Users      []ExportUser      `json:"users,omitempty"`
-	Pages      []ExportPage      `json:"pages,omitempty"`
-	Categories []ExportCategory  `json:"categories,omitempty"`
-	Tags       []ExportTag       `json:"tags,omitempty"`
-	Media      []ExportMedia     `json:"media,omitempty"`
-	Menus      []ExportMenu      `json:"menus,omitempty"`
-	Forms      []ExportForm      `json:"forms,omitempty"`
-	Config     map[string]string `json:"config,omitempty"`
+	Version      string              `json:"version"`
+	ExportedAt   time.Time           `json:"exported_at"`
+	Site         ExportSite          `json:"site"`
+	Languages    []ExportLanguage    `json:"languages,omitempty"`
+	Users        []ExportUser        `json:"users,omitempty"`
+	Pages        []ExportPage        `json:"pages,omitempty"`
+	Categories   []ExportCategory    `json:"categories,omitempty"`
+	Tags         []ExportTag         `json:"tags,omitempty"`
+	Media        []ExportMedia       `json:"media,omitempty"`
+	Menus        []ExportMenu        `json:"menus,omitempty"`
+	Forms        []ExportForm        `json:"forms,omitempty"`
+	ContentTypes []ExportContentType `json:"content_types,omitempty"`
+	Config       map[string]string   `json:"config,omitempty"`
 }
 
 // ExportSite contains basic site information.
@@ -41,6 +47,7 @@ type ExportLanguage struct {
 	IsActive   bool   `json:"is_active"`
 	Direction  string `json:"direction"`
 	Position   int64  `json:"position"`
+	Host       string `json:"host,omitempty"`
 }
 
 // ExportUser represents a user for export (no passwords).
@@ -71,6 +78,17 @@ type ExportPage struct {
 	ScheduledAt   *time.Time       `json:"scheduled_at,omitempty"`
 	VideoURL      string           `json:"video_url,omitempty"`
 	VideoTitle    string           `json:"video_title,omitempty"`
+	PageType      string           `json:"page_type,omitempty"`
+	// Fields holds the custom field values of content type pages. Media
+	// references are exported as ExportMediaRef and page references keep the
+	// exported page ID.
+	Fields map[string]any `json:"fields,omitempty"`
+	// CustomFields holds the key/value custom fields of the page, with
+	// references exported like Fields.
+	CustomFields []contenttype.CustomField `json:"custom_fields,omitempty"`
+	// Blocks holds the block document of pages edited with the block editor.
+	// Body still carries the rendered HTML.
+	Blocks *blocks.Document `json:"blocks,omitempty"`
 }
 
 // ExportPageSEO contains SEO metadata for a page.
@@ -90,6 +108,17 @@ type ExportMediaRef struct {
 	Filename string `json:"filename"`
 }
 
+// ExportContentType represents a content type and its field schema.
+type ExportContentType struct {
+	Name        string              `json:"name"`
+	Slug        string              `json:"slug"`
+	Description string              `json:"description,omitempty"`
+	Template    string              `json:"template,omitempty"`
+	Fields      []contenttype.Field `json:"fields"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
 // ExportCategory represents a category with hierarchy support.
 type ExportCategory struct {
 	ID           int64            `json:"id"`
@@ -155,15 +184,26 @@ type ExportMenu struct {
 
 // ExportMenuItem represents a menu item.
 type ExportMenuItem struct {
-	ID       int64            `json:"id"`
-	Title    string           `json:"title"`
-	URL      string           `json:"url,omitempty"`
-	Target   string           `json:"target,omitempty"`
-	PageSlug string           `json:"page_slug,omitempty"`
-	CSSClass string           `json:"css_class,omitempty"`
-	IsActive bool             `json:"is_active"`
-	Position int64            `json:"position"`
-	Children []ExportMenuItem `json:"children,omitempty"`
+	ID       int64  `json:"id"`
+	Title    string `json:"title"`
+	URL      string `json:"url,omitempty"`
+	Target   string `json:"target,omitempty"`
+	PageSlug string `json:"page_slug,omitempty"`
+	CSSClass string `json:"css_class,omitempty"`
+	IsActive bool   `json:"is_active"`
+	Position int64  `json:"position"`
+	// ItemType is empty for page and URL items. Category, tag and form items
+	// link to LinkSlug (in LinkLanguage for forms); language items to the
+	// language whose code is LinkSlug, or to the current one without it.
+	ItemType         string           `json:"item_type,omitempty"`
+	LinkSlug         string           `json:"link_slug,omitempty"`
+	LinkLanguage     string           `json:"link_language,omitempty"`
+	Visibility       string           `json:"visibility,omitempty"`
+	VisibleRoles     string           `json:"visible_roles,omitempty"`
+	VisibleLanguages string           `json:"visible_languages,omitempty"`
+	MegaMenu         bool             `json:"mega_menu,omitempty"`
+	ImageURL         string           `json:"image_url,omitempty"`
+	Children         []ExportMenuItem `json:"children,omitempty"`
 }
 
 // ExportForm represents a form definition.
@@ -0,0 +1,894 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package transfer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/contenttype"
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/util"
+)
+
+// Actions of translation import report entries.
+const (
+	TranslationActionCreated   = "created"
+	TranslationActionUpdated   = "updated"
+	TranslationActionUnchanged = "unchanged"
+	TranslationActionSkipped   = "skipped"
+)
+
+// TranslationImportOptions configures a translation import.
+type TranslationImportOptions struct {
+	DryRun bool  // Report what would change without writing anything
+	UserID int64 // Author of created pages and of new page versions
+}
+
+// TranslationImportEntry reports what the import did with one bundle item.
+type TranslationImportEntry struct {
+	Kind     string
+	SourceID int64
+	TargetID int64 // Translated entity; 0 when skipped or not created yet
+	Original string
+	Action   string
+	Fields   []string // Fields written to the translation
+	Notes    []string // Skip reasons and warnings
+
+	sourceChanged bool // A unit's source text changed since the export
+}
+
+// TranslationImportReport is the result of a translation import.
+type TranslationImportReport struct {
+	DryRun     bool
+	SourceLang string
+	TargetLang string
+	Entries    []TranslationImportEntry
+}
+
+// Count returns the number of entries with the given action.
+func (r *TranslationImportReport) Count(action string) int {
+	n := 0
+	for _, e := range r.Entries {
+		if e.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// Changed reports whether the import created or updated anything.
+func (r *TranslationImportReport) Changed() bool {
+	return r.Count(TranslationActionCreated)+r.Count(TranslationActionUpdated) > 0
+}
+
+// ImportTranslations writes the targets of a bundle to the translations of
+// its source entities. Missing translations are created the same way the
+// admin "Translate" action creates them and linked to the source; existing
+// ones are updated. Units without a target are left alone, so a partially
+// translated file never clears text.
+//
+// The import runs in one transaction. A dry run performs the same work and
+// rolls it back, so the report is exactly what a real import would do.
+func (i *Importer) ImportTranslations(ctx context.Context, bundle *TranslationBundle, opts TranslationImportOptions) (*TranslationImportReport, error) {
+	if bundle == nil {
+		return nil, errors.New("translation bundle is empty")
+	}
+	source, target, err := translationLanguages(ctx, i.store, bundle.SourceLang, bundle.TargetLang)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := i.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	ti := &translationImport{
+		queries: i.store.WithTx(tx),
+		source:  source,
+		target:  target,
+		userID:  opts.UserID,
+		now:     time.Now(),
+	}
+	report := &TranslationImportReport{
+		DryRun:     opts.DryRun,
+		SourceLang: source.Code,
+		TargetLang: target.Code,
+	}
+	for _, item := range bundle.Items {
+		entry, err := ti.importItem(ctx, item)
+		if err != nil {
+			return nil, fmt.Errorf("import %s: %w", item.Key(), err)
+		}
+		if err := ti.markCurrent(ctx, entry); err != nil {
+			return nil, fmt.Errorf("import %s: %w", item.Key(), err)
+		}
+		report.Entries = append(report.Entries, entry)
+	}
+
+	if opts.DryRun {
+		return report, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+	if i.logger != nil {
+		i.logger.Info("translations imported",
+			"source", source.Code,
+			"target", target.Code,
+			"created", report.Count(TranslationActionCreated),
+			"updated", report.Count(TranslationActionUpdated),
+			"skipped", report.Count(TranslationActionSkipped))
+	}
+	return report, nil
+}
+
+// translationImport holds the state of one ImportTranslations call.
+type translationImport struct {
+	queries *store.Queries
+	source  store.Language
+	target  store.Language
+	userID  int64
+	now     time.Time
+}
+
+func (ti *translationImport) importItem(ctx context.Context, item TranslationItem) (TranslationImportEntry, error) {
+	entry := TranslationImportEntry{Kind: item.Kind, SourceID: item.ID, Original: item.Original}
+	switch item.Kind {
+	case TranslationKindPage:
+		return ti.importPage(ctx, item, entry)
+	case TranslationKindCategory:
+		return ti.importCategory(ctx, item, entry)
+	case TranslationKindTag:
+		return ti.importTag(ctx, item, entry)
+	case TranslationKindMenu:
+		return ti.importMenu(ctx, item, entry)
+	case TranslationKindMedia:
+		return ti.importMedia(ctx, item, entry)
+	}
+	return skipTranslation(entry, fmt.Sprintf("unknown kind %q", item.Kind)), nil
+}
+
+func skipTranslation(entry TranslationImportEntry, note string) TranslationImportEntry {
+	entry.Action = TranslationActionSkipped
+	entry.Notes = append(entry.Notes, note)
+	return entry
+}
+
+// targets returns the translated text of the item keyed by unit ID. current
+// holds the source text of every known unit; units it does not list are
+// ignored, and units whose source changed since the export are noted.
+func (ti *translationImport) targets(entry *TranslationImportEntry, item TranslationItem, current map[string]string) map[string]string {
+	texts := make(map[string]string)
+	for _, unit := range item.Units {
+		src, ok := current[unit.ID]
+		if !ok {
+			entry.Notes = append(entry.Notes, fmt.Sprintf("unknown unit %q ignored", unit.ID))
+			continue
+		}
+		if unit.Source != src {
+			entry.sourceChanged = true
+		}
+		if unit.Target == "" {
+			continue
+		}
+		if unit.Source != src {
+			entry.Notes = append(entry.Notes, fmt.Sprintf("source text of %q changed since export", unit.ID))
+		}
+		texts[unit.ID] = unit.Target
+	}
+	return texts
+}
+
+// setText copies a translated field to dst and records the field when the
+// value changes.
+func setText(entry *TranslationImportEntry, texts map[string]string, field string, dst *string) {
+	if v, ok := texts[field]; ok && v != *dst {
+		*dst = v
+		entry.Fields = append(entry.Fields, field)
+	}
+}
+
+// textOr returns the translated field or fallback when it is not translated.
+func textOr(entry *TranslationImportEntry, texts map[string]string, field, fallback string) string {
+	if v, ok := texts[field]; ok {
+		entry.Fields = append(entry.Fields, field)
+		return v
+	}
+	return fallback
+}
+
+func (ti *translationImport) linkTranslation(ctx context.Context, kind string, sourceID, translationID int64) error {
+	_, err := ti.queries.CreateTranslation(ctx, store.CreateTranslationParams{
+		EntityType:    kind,
+		EntityID:      sourceID,
+		LanguageID:    ti.target.ID,
+		TranslationID: translationID,
+		CreatedAt:     ti.now,
+	})
+	if err != nil {
+		return fmt.Errorf("link %s %d to translation %d: %w", kind, sourceID, translationID, err)
+	}
+	return nil
+}
+
+// markCurrent marks an updated or unchanged translation as up to date with
+// its source, unless the file was translated from older source text. Created
+// translations are up to date from the start.
+func (ti *translationImport) markCurrent(ctx context.Context, entry TranslationImportEntry) error {
+	if entry.sourceChanged || entry.TargetID == 0 ||
+		(entry.Action != TranslationActionUpdated && entry.Action != TranslationActionUnchanged) {
+		return nil
+	}
+	switch entry.Kind {
+	case TranslationKindPage, TranslationKindCategory, TranslationKindTag:
+		if err := ti.queries.MarkTranslationsCurrent(ctx, store.MarkTranslationsCurrentParams{
+			EntityType:    entry.Kind,
+			TranslationID: entry.TargetID,
+		}); err != nil {
+			return fmt.Errorf("mark %s %d up to date: %w", entry.Kind, entry.TargetID, err)
+		}
+	}
+	return nil
+}
+
+func (ti *translationImport) importPage(ctx context.Context, item TranslationItem, entry TranslationImportEntry) (TranslationImportEntry, error) {
+	page, err := ti.queries.GetPageByID(ctx, item.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return skipTranslation(entry, "source page not found"), nil
+	}
+	if err != nil {
+		return entry, err
+	}
+	if page.LanguageCode != ti.source.Code {
+		return skipTranslation(entry, fmt.Sprintf("source page is in %s", page.LanguageCode)), nil
+	}
+
+	texts := ti.targets(&entry, item, map[string]string{
+		TranslationFieldTitle:           page.Title,
+		TranslationFieldSummary:         page.Summary,
+		TranslationFieldBody:            page.Body,
+		TranslationFieldMetaTitle:       page.MetaTitle,
+		TranslationFieldMetaDescription: page.MetaDescription,
+		TranslationFieldMetaKeywords:    page.MetaKeywords,
+	})
+	if len(texts) == 0 {
+		return skipTranslation(entry, "no translated text"), nil
+	}
+
+	tid, ok, err := translatedEntityID(ctx, ti.queries, TranslationKindPage, page.ID, ti.target.Code)
+	if err != nil {
+		return entry, err
+	}
+	if ok {
+		return ti.updatePage(ctx, tid, texts, entry)
+	}
+	return ti.createPage(ctx, page, texts, entry)
+}
+
+// createPage creates a draft translation of a page, mirroring the admin
+// "Translate" action: settings, custom and content fields are inherited, the
+// text comes from the bundle.
+func (ti *translationImport) createPage(ctx context.Context, page store.Page, texts map[string]string, entry TranslationImportEntry) (TranslationImportEntry, error) {
+	slug, err := translationSlug(page.Slug, ti.target.Code, func(slug string) (int64, error) {
+		// A slug an active language prefix would shadow counts as taken
+		if conflict, err := languagePrefixConflict(ctx, ti.queries, slug); err != nil || conflict {
+			return 1, err
+		}
+		return ti.queries.SlugExists(ctx, store.SlugExistsParams{Slug: slug, SiteID: page.SiteID})
+	})
+	if err != nil {
+		return entry, err
+	}
+
+	created, err := ti.queries.CreatePage(ctx, store.CreatePageParams{
+		Title:             textOr(&entry, texts, TranslationFieldTitle, page.Title),
+		Slug:              slug,
+		Body:              textOr(&entry, texts, TranslationFieldBody, ""),
+		Summary:           textOr(&entry, texts, TranslationFieldSummary, ""),
+		Status:            model.PageStatusDraft,
+		AuthorID:          ti.userID,
+		FeaturedImageID:   page.FeaturedImageID,
+		MetaTitle:         textOr(&entry, texts, TranslationFieldMetaTitle, ""),
+		MetaDescription:   textOr(&entry, texts, TranslationFieldMetaDescription, ""),
+		MetaKeywords:      textOr(&entry, texts, TranslationFieldMetaKeywords, ""),
+		LanguageCode:      ti.target.Code,
+		HideFeaturedImage: page.HideFeaturedImage,
+		PageType:          page.PageType,
+		ExcludeFromLists:  page.ExcludeFromLists,
+		CreatedAt:         ti.now,
+		UpdatedAt:         ti.now,
+		VideoUrl:          page.VideoUrl,
+		VideoTitle:        page.VideoTitle,
+		SiteID:            page.SiteID,
+	})
+	if err != nil {
+		return entry, fmt.Errorf("create page: %w", err)
+	}
+
+	customFields := contenttype.CustomFields{}
+	if row, err := ti.queries.GetPageCustomFields(ctx, page.ID); err == nil {
+		customFields = contenttype.ParseCustomFields(row.Data)
+		if err := ti.queries.UpsertPageCustomFields(ctx, store.UpsertPageCustomFieldsParams{
+			PageID:    created.ID,
+			Data:      row.Data,
+			UpdatedAt: ti.now,
+		}); err != nil {
+			return entry, fmt.Errorf("copy custom fields: %w", err)
+		}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return entry, fmt.Errorf("get custom fields: %w", err)
+	}
+	if row, err := ti.queries.GetPageContentFields(ctx, page.ID); err == nil {
+		if err := ti.queries.UpsertPageContentFields(ctx, store.UpsertPageContentFieldsParams{
+			PageID:    created.ID,
+			Data:      row.Data,
+			UpdatedAt: ti.now,
+		}); err != nil {
+			return entry, fmt.Errorf("copy content fields: %w", err)
+		}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return entry, fmt.Errorf("get content fields: %w", err)
+	}
+
+	if _, err := ti.queries.CreatePageVersion(ctx, store.CreatePageVersionParams{
+		PageID:       created.ID,
+		Title:        created.Title,
+		Body:         created.Body,
+		ChangedBy:    ti.userID,
+		CreatedAt:    ti.now,
+		CustomFields: customFields.JSON(),
+	}); err != nil {
+		return entry, fmt.Errorf("create page version: %w", err)
+	}
+	if err := ti.linkTranslation(ctx, TranslationKindPage, page.ID, created.ID); err != nil {
+		return entry, err
+	}
+
+	entry.Action = TranslationActionCreated
+	entry.TargetID = created.ID
+	return entry, nil
+}
+
+// updatePage writes the translated text to an existing translation and
+// records a page version. A new body replaces the block document, as saving
+// the page in the HTML editor does.
+func (ti *translationImport) updatePage(ctx context.Context, id int64, texts map[string]string, entry TranslationImportEntry) (TranslationImportEntry, error) {
+	page, err := ti.queries.GetPageByID(ctx, id)
+	if err != nil {
+		return entry, fmt.Errorf("get page %d: %w", id, err)
+	}
+	entry.TargetID = id
+
+	updated := page
+	setText(&entry, texts, TranslationFieldTitle, &updated.Title)
+	setText(&entry, texts, TranslationFieldSummary, &updated.Summary)
+	setText(&entry, texts, TranslationFieldBody, &updated.Body)
+	setText(&entry, texts, TranslationFieldMetaTitle, &updated.MetaTitle)
+	setText(&entry, texts, TranslationFieldMetaDescription, &updated.MetaDescription)
+	setText(&entry, texts, TranslationFieldMetaKeywords, &updated.MetaKeywords)
+	if len(entry.Fields) == 0 {
+		entry.Action = TranslationActionUnchanged
+		return entry, nil
+	}
+
+	if _, err := ti.queries.UpdatePage(ctx, store.UpdatePageParams{
+		Title:             updated.Title,
+		Slug:              updated.Slug,
+		Body:              updated.Body,
+		Summary:           updated.Summary,
+		Status:            updated.Status,
+		FeaturedImageID:   updated.FeaturedImageID,
+		MetaTitle:         updated.MetaTitle,
+		MetaDescription:   updated.MetaDescription,
+		MetaKeywords:      updated.MetaKeywords,
+		OgImageID:         updated.OgImageID,
+		NoIndex:           updated.NoIndex,
+		NoFollow:          updated.NoFollow,
+		CanonicalUrl:      updated.CanonicalUrl,
+		ScheduledAt:       updated.ScheduledAt,
+		LanguageCode:      updated.LanguageCode,
+		HideFeaturedImage: updated.HideFeaturedImage,
+		PageType:          updated.PageType,
+		ExcludeFromLists:  updated.ExcludeFromLists,
+		PublishedAt:       updated.PublishedAt,
+		UpdatedAt:         ti.now,
+		VideoUrl:          updated.VideoUrl,
+		VideoTitle:        updated.VideoTitle,
+		ID:                id,
+	}); err != nil {
+		return entry, fmt.Errorf("update page %d: %w", id, err)
+	}
+
+	var blocks string
+	if row, err := ti.queries.GetPageBlocks(ctx, id); err == nil {
+		if updated.Body != page.Body {
+			if err := ti.queries.DeletePageBlocks(ctx, id); err != nil {
+				return entry, fmt.Errorf("delete block document of page %d: %w", id, err)
+			}
+			entry.Notes = append(entry.Notes, "block document replaced by the translated HTML body")
+		} else {
+			blocks = row.Data
+		}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return entry, fmt.Errorf("get block document of page %d: %w", id, err)
+	}
+
+	customFields := contenttype.CustomFields{}
+	if row, err := ti.queries.GetPageCustomFields(ctx, id); err == nil {
+		customFields = contenttype.ParseCustomFields(row.Data)
+	}
+	if _, err := ti.queries.CreatePageVersion(ctx, store.CreatePageVersionParams{
+		PageID:       id,
+		Title:        updated.Title,
+		Body:         updated.Body,
+		ChangedBy:    ti.userID,
+		CreatedAt:    ti.now,
+		CustomFields: customFields.JSON(),
+		Blocks:       blocks,
+	}); err != nil {
+		return entry, fmt.Errorf("create page version: %w", err)
+	}
+
+	entry.Action = TranslationActionUpdated
+	return entry, nil
+}
+
+func (ti *translationImport) importCategory(ctx context.Context, item TranslationItem, entry TranslationImportEntry) (TranslationImportEntry, error) {
+	cat, err := ti.queries.GetCategoryByID(ctx, item.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return skipTranslation(entry, "source category not found"), nil
+	}
+	if err != nil {
+		return entry, err
+	}
+	if cat.LanguageCode != ti.source.Code {
+		return skipTranslation(entry, fmt.Sprintf("source category is in %s", cat.LanguageCode)), nil
+	}
+
+	texts := ti.targets(&entry, item, map[string]string{
+		TranslationFieldName:        cat.Name,
+		TranslationFieldDescription: cat.Description.String,
+	})
+	if len(texts) == 0 {
+		return skipTranslation(entry, "no translated text"), nil
+	}
+
+	tid, ok, err := translatedEntityID(ctx, ti.queries, TranslationKindCategory, cat.ID, ti.target.Code)
+	if err != nil {
+		return entry, err
+	}
+
+	if !ok {
+		slug, err := translationSlug(cat.Slug, ti.target.Code, func(slug string) (int64, error) {
+			return ti.queries.CategorySlugExists(ctx, slug)
+		})
+		if err != nil {
+			return entry, err
+		}
+		// Nest the translation under the parent's translation when there is one
+		var parentID sql.NullInt64
+		if cat.ParentID.Valid {
+			if pid, ok, err := translatedEntityID(ctx, ti.queries, TranslationKindCategory, cat.ParentID.Int64, ti.target.Code); err != nil {
+				return entry, err
+			} else if ok {
+				parentID = sql.NullInt64{Int64: pid, Valid: true}
+			}
+		}
+		description := textOr(&entry, texts, TranslationFieldDescription, "")
+		created, err := ti.queries.CreateCategory(ctx, store.CreateCategoryParams{
+			Name:         textOr(&entry, texts, TranslationFieldName, cat.Name),
+			Slug:         slug,
+			Description:  sql.NullString{String: description, Valid: description != ""},
+			ParentID:     parentID,
+			Position:     cat.Position,
+			LanguageCode: ti.target.Code,
+			CreatedAt:    ti.now,
+			UpdatedAt:    ti.now,
+		})
+		if err != nil {
+			return entry, fmt.Errorf("create category: %w", err)
+		}
+		if err := ti.linkTranslation(ctx, TranslationKindCategory, cat.ID, created.ID); err != nil {
+			return entry, err
+		}
+		entry.Action = TranslationActionCreated
+		entry.TargetID = created.ID
+		return entry, nil
+	}
+
+	existing, err := ti.queries.GetCategoryByID(ctx, tid)
+	if err != nil {
+		return entry, fmt.Errorf("get category %d: %w", tid, err)
+	}
+	entry.TargetID = tid
+	name, description := existing.Name, existing.Description.String
+	setText(&entry, texts, TranslationFieldName, &name)
+	setText(&entry, texts, TranslationFieldDescription, &description)
+	if len(entry.Fields) == 0 {
+		entry.Action = TranslationActionUnchanged
+		return entry, nil
+	}
+	if _, err := ti.queries.UpdateCategory(ctx, store.UpdateCategoryParams{
+		Name:         name,
+		Slug:         existing.Slug,
+		Description:  sql.NullString{String: description, Valid: description != ""},
+		ParentID:     existing.ParentID,
+		Position:     existing.Position,
+		LanguageCode: existing.LanguageCode,
+		UpdatedAt:    ti.now,
+		ID:           tid,
+	}); err != nil {
+		return entry, fmt.Errorf("update category %d: %w", tid, err)
+	}
+	entry.Action = TranslationActionUpdated
+	return entry, nil
+}
+
+func (ti *translationImport) importTag(ctx context.Context, item TranslationItem, entry TranslationImportEntry) (TranslationImportEntry, error) {
+	tag, err := ti.queries.GetTagByID(ctx, item.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return skipTranslation(entry, "source tag not found"), nil
+	}
+	if err != nil {
+		return entry, err
+	}
+	if tag.LanguageCode != ti.source.Code {
+		return skipTranslation(entry, fmt.Sprintf("source tag is in %s", tag.LanguageCode)), nil
+	}
+
+	texts := ti.targets(&entry, item, map[string]string{TranslationFieldName: tag.Name})
+	if len(texts) == 0 {
+		return skipTranslation(entry, "no translated text"), nil
+	}
+
+	tid, ok, err := translatedEntityID(ctx, ti.queries, TranslationKindTag, tag.ID, ti.target.Code)
+	if err != nil {
+		return entry, err
+	}
+
+	if !ok {
+		slug, err := translationSlug(tag.Slug, ti.target.Code, func(slug string) (int64, error) {
+			return ti.queries.TagSlugExists(ctx, slug)
+		})
+		if err != nil {
+			return entry, err
+		}
+		created, err := ti.queries.CreateTag(ctx, store.CreateTagParams{
+			Name:         textOr(&entry, texts, TranslationFieldName, tag.Name),
+			Slug:         slug,
+			LanguageCode: ti.target.Code,
+			CreatedAt:    ti.now,
+			UpdatedAt:    ti.now,
+		})
+		if err != nil {
+			return entry, fmt.Errorf("create tag: %w", err)
+		}
+		if err := ti.linkTranslation(ctx, TranslationKindTag, tag.ID, created.ID); err != nil {
+			return entry, err
+		}
+		entry.Action = TranslationActionCreated
+		entry.TargetID = created.ID
+		return entry, nil
+	}
+
+	existing, err := ti.queries.GetTagByID(ctx, tid)
+	if err != nil {
+		return entry, fmt.Errorf("get tag %d: %w", tid, err)
+	}
+	entry.TargetID = tid
+	name := existing.Name
+	setText(&entry, texts, TranslationFieldName, &name)
+	if len(entry.Fields) == 0 {
+		entry.Action = TranslationActionUnchanged
+		return entry, nil
+	}
+	if _, err := ti.queries.UpdateTag(ctx, store.UpdateTagParams{
+		Name:         name,
+		Slug:         existing.Slug,
+		LanguageCode: existing.LanguageCode,
+		UpdatedAt:    ti.now,
+		ID:           tid,
+	}); err != nil {
+		return entry, fmt.Errorf("update tag %d: %w", tid, err)
+	}
+	entry.Action = TranslationActionUpdated
+	return entry, nil
+}
+
+// importMenu writes the menu name and item titles to the menu with the same
+// slug in the target language. A missing target menu is created as a copy of
+// the source menu, with page links pointing to the page translations where
+// they exist.
+func (ti *translationImport) importMenu(ctx context.Context, item TranslationItem, entry TranslationImportEntry) (TranslationImportEntry, error) {
+	menu, err := ti.queries.GetMenuByID(ctx, item.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return skipTranslation(entry, "source menu not found"), nil
+	}
+	if err != nil {
+		return entry, err
+	}
+	if menu.LanguageCode != ti.source.Code {
+		return skipTranslation(entry, fmt.Sprintf("source menu is in %s", menu.LanguageCode)), nil
+	}
+	items, err := ti.queries.ListMenuItems(ctx, menu.ID)
+	if err != nil {
+		return entry, fmt.Errorf("list items of menu %d: %w", menu.ID, err)
+	}
+	items = orderMenuItemsForTranslation(items)
+
+	current := map[string]string{TranslationFieldName: menu.Name}
+	for _, mi := range items {
+		current[menuItemUnitID(mi.ID)] = mi.Title
+	}
+	texts := ti.targets(&entry, item, current)
+	if len(texts) == 0 {
+		return skipTranslation(entry, "no translated text"), nil
+	}
+
+	existing, err := ti.queries.GetMenuBySlugAndLanguage(ctx, store.GetMenuBySlugAndLanguageParams{
+		Slug:         menu.Slug,
+		LanguageCode: ti.target.Code,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return ti.createMenu(ctx, menu, items, texts, entry)
+	}
+	if err != nil {
+		return entry, fmt.Errorf("get menu %q in %s: %w", menu.Slug, ti.target.Code, err)
+	}
+	entry.TargetID = existing.ID
+
+	name := existing.Name
+	setText(&entry, texts, TranslationFieldName, &name)
+	if name != existing.Name {
+		if _, err := ti.queries.UpdateMenu(ctx, store.UpdateMenuParams{
+			Name:         name,
+			Slug:         existing.Slug,
+			LanguageCode: existing.LanguageCode,
+			UpdatedAt:    ti.now,
+			ID:           existing.ID,
+		}); err != nil {
+			return entry, fmt.Errorf("update menu %d: %w", existing.ID, err)
+		}
+	}
+
+	targetItems, err := ti.queries.ListMenuItems(ctx, existing.ID)
+	if err != nil {
+		return entry, fmt.Errorf("list items of menu %d: %w", existing.ID, err)
+	}
+	targetItems = orderMenuItemsForTranslation(targetItems)
+	if !sameMenuStructure(items, targetItems) {
+		if _, hasName := texts[TranslationFieldName]; len(texts) > 1 || !hasName {
+			entry.Notes = append(entry.Notes, "menu items differ in the target language; item titles were not imported")
+		}
+	} else {
+		for idx, src := range items {
+			dst := targetItems[idx]
+			title := dst.Title
+			setText(&entry, texts, menuItemUnitID(src.ID), &title)
+			if title == dst.Title {
+				continue
+			}
+			if _, err := ti.queries.UpdateMenuItem(ctx, store.UpdateMenuItemParams{
+				ParentID:         dst.ParentID,
+				Title:            title,
+				Url:              dst.Url,
+				Target:           dst.Target,
+				PageID:           dst.PageID,
+				Position:         dst.Position,
+				CssClass:         dst.CssClass,
+				IsActive:         dst.IsActive,
+				ItemType:         dst.ItemType,
+				LinkID:           dst.LinkID,
+				Visibility:       dst.Visibility,
+				VisibleRoles:     dst.VisibleRoles,
+				VisibleLanguages: dst.VisibleLanguages,
+				MegaMenu:         dst.MegaMenu,
+				ImageUrl:         dst.ImageUrl,
+				UpdatedAt:        ti.now,
+				ID:               dst.ID,
+			}); err != nil {
+				return entry, fmt.Errorf("update menu item %d: %w", dst.ID, err)
+			}
+		}
+	}
+
+	if len(entry.Fields) == 0 {
+		entry.Action = TranslationActionUnchanged
+	} else {
+		entry.Action = TranslationActionUpdated
+	}
+	return entry, nil
+}
+
+func (ti *translationImport) createMenu(ctx context.Context, menu store.Menu, items []store.MenuItem, texts map[string]string, entry TranslationImportEntry) (TranslationImportEntry, error) {
+	created, err := ti.queries.CreateMenu(ctx, store.CreateMenuParams{
+		Name:         textOr(&entry, texts, TranslationFieldName, menu.Name),
+		Slug:         menu.Slug,
+		LanguageCode: ti.target.Code,
+		CreatedAt:    ti.now,
+		UpdatedAt:    ti.now,
+		SiteID:       menu.SiteID,
+	})
+	if err != nil {
+		return entry, fmt.Errorf("create menu: %w", err)
+	}
+
+	// Items are in depth-first order, so parents are created first
+	idMap := make(map[int64]int64, len(items))
+	for _, src := range items {
+		var parentID sql.NullInt64
+		if src.ParentID.Valid {
+			if pid, ok := idMap[src.ParentID.Int64]; ok {
+				parentID = sql.NullInt64{Int64: pid, Valid: true}
+			}
+		}
+		pageID := src.PageID
+		if pageID.Valid {
+			if tid, ok, err := translatedEntityID(ctx, ti.queries, TranslationKindPage, pageID.Int64, ti.target.Code); err != nil {
+				return entry, err
+			} else if ok {
+				pageID = sql.NullInt64{Int64: tid, Valid: true}
+			}
+		}
+		// Typed links keep the source target; menus resolve it to the
+		// target-language translation when they are rendered.
+		mi, err := ti.queries.CreateMenuItem(ctx, store.CreateMenuItemParams{
+			MenuID:           created.ID,
+			ParentID:         parentID,
+			Title:            textOr(&entry, texts, menuItemUnitID(src.ID), src.Title),
+			Url:              src.Url,
+			Target:           src.Target,
+			PageID:           pageID,
+			Position:         src.Position,
+			CssClass:         src.CssClass,
+			IsActive:         src.IsActive,
+			ItemType:         src.ItemType,
+			LinkID:           src.LinkID,
+			Visibility:       src.Visibility,
+			VisibleRoles:     src.VisibleRoles,
+			VisibleLanguages: src.VisibleLanguages,
+			MegaMenu:         src.MegaMenu,
+			ImageUrl:         src.ImageUrl,
+			CreatedAt:        ti.now,
+			UpdatedAt:        ti.now,
+		})
+		if err != nil {
+			return entry, fmt.Errorf("create menu item: %w", err)
+		}
+		idMap[src.ID] = mi.ID
+	}
+
+	entry.Action = TranslationActionCreated
+	entry.TargetID = created.ID
+	return entry, nil
+}
+
+func menuItemUnitID(id int64) string {
+	return translationMenuItemPrefix + strconv.FormatInt(id, 10)
+}
+
+// importMedia writes the alt text and caption of a media item in the target
+// language: to the media row when it is in the target language, otherwise to
+// media_translations.
+func (ti *translationImport) importMedia(ctx context.Context, item TranslationItem, entry TranslationImportEntry) (TranslationImportEntry, error) {
+	m, err := ti.queries.GetMediaByID(ctx, item.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return skipTranslation(entry, "media not found"), nil
+	}
+	if err != nil {
+		return entry, err
+	}
+	srcAlt, srcCaption, err := mediaText(ctx, ti.queries, m, ti.source)
+	if err != nil {
+		return entry, err
+	}
+	texts := ti.targets(&entry, item, map[string]string{
+		TranslationFieldAlt:     srcAlt,
+		TranslationFieldCaption: srcCaption,
+	})
+	if len(texts) == 0 {
+		return skipTranslation(entry, "no translated text"), nil
+	}
+	entry.TargetID = m.ID
+
+	alt, caption, err := mediaText(ctx, ti.queries, m, ti.target)
+	if err != nil {
+		return entry, err
+	}
+	wasEmpty := alt == "" && caption == ""
+	setText(&entry, texts, TranslationFieldAlt, &alt)
+	setText(&entry, texts, TranslationFieldCaption, &caption)
+	if len(entry.Fields) == 0 {
+		entry.Action = TranslationActionUnchanged
+		return entry, nil
+	}
+
+	if m.LanguageCode == ti.target.Code {
+		if _, err := ti.queries.UpdateMedia(ctx, store.UpdateMediaParams{
+			Filename:     m.Filename,
+			Alt:          sql.NullString{String: alt, Valid: alt != ""},
+			Caption:      sql.NullString{String: caption, Valid: caption != ""},
+			FolderID:     m.FolderID,
+			LanguageCode: m.LanguageCode,
+			UpdatedAt:    ti.now,
+			ID:           m.ID,
+		}); err != nil {
+			return entry, fmt.Errorf("update media %d: %w", m.ID, err)
+		}
+	} else if _, err := ti.queries.UpsertMediaTranslation(ctx, store.UpsertMediaTranslationParams{
+		MediaID:    m.ID,
+		LanguageID: ti.target.ID,
+		Alt:        alt,
+		Caption:    caption,
+	}); err != nil {
+		return entry, fmt.Errorf("save translation of media %d: %w", m.ID, err)
+	}
+
+	if wasEmpty {
+		entry.Action = TranslationActionCreated
+	} else {
+		entry.Action = TranslationActionUpdated
+	}
+	return entry, nil
+}
+
+// translationSlug returns "<slug>-<lang>", numbered when taken, the slug the
+// admin "Translate" action gives new translations.
+func translationSlug(baseSlug, langCode string, exists func(string) (int64, error)) (string, error) {
+	slug := baseSlug + "-" + langCode
+	for counter := 2; ; counter++ {
+		n, err := exists(slug)
+		if err != nil {
+			return "", fmt.Errorf("check slug %q: %w", slug, err)
+		}
+		if n == 0 {
+			return slug, nil
+		}
+		slug = fmt.Sprintf("%s-%s-%d", baseSlug, langCode, counter)
+	}
+}
+
+// languagePrefixConflict reports whether a page slug is the URL prefix of an
+// active language, which would make the page unreachable.
+func languagePrefixConflict(ctx context.Context, queries *store.Queries, slug string) (bool, error) {
+	if !util.IsRoutableLanguageCode(slug) {
+		return false, nil
+	}
+	languages, err := queries.ListActiveLanguages(ctx)
+	if err != nil {
+		return false, fmt.Errorf("list active languages: %w", err)
+	}
+	for _, language := range languages {
+		if language.Code == slug {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// TranslatedPageBodies returns the translated page bodies of a bundle keyed
+// by source page ID, for content policy checks before an import.
+func (b *TranslationBundle) TranslatedPageBodies() map[int64]string {
+	bodies := make(map[int64]string)
+	for _, item := range b.Items {
+		if item.Kind != TranslationKindPage {
+			continue
+		}
+		for _, unit := range item.Units {
+			if unit.ID == TranslationFieldBody && strings.TrimSpace(unit.Target) != "" {
+				bodies[item.ID] = unit.Target
+			}
+		}
+	}
+	return bodies
+}
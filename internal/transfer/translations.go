@@ -0,0 +1,447 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package transfer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// Kinds of entities in a translation bundle.
+const (
+	TranslationKindPage     = model.EntityTypePage
+	TranslationKindCategory = model.EntityTypeCategory
+	TranslationKindTag      = model.EntityTypeTag
+	TranslationKindMenu     = "menu"
+	TranslationKindMedia    = "media"
+)
+
+// Translatable fields, used as unit IDs in exchange files.
+const (
+	TranslationFieldTitle           = "title"
+	TranslationFieldSummary         = "summary"
+	TranslationFieldBody            = "body"
+	TranslationFieldMetaTitle       = "meta_title"
+	TranslationFieldMetaDescription = "meta_description"
+	TranslationFieldMetaKeywords    = "meta_keywords"
+	TranslationFieldName            = "name"
+	TranslationFieldDescription     = "description"
+	TranslationFieldAlt             = "alt"
+	TranslationFieldCaption         = "caption"
+
+	// translationMenuItemPrefix prefixes the unit IDs of menu item titles.
+	translationMenuItemPrefix = "item-"
+)
+
+// translationFields lists the translatable fields of each kind in export order.
+// Menu item units are not listed; they are derived from the menu items.
+var translationFields = map[string][]string{
+	TranslationKindPage: {
+		TranslationFieldTitle, TranslationFieldSummary, TranslationFieldBody,
+		TranslationFieldMetaTitle, TranslationFieldMetaDescription, TranslationFieldMetaKeywords,
+	},
+	TranslationKindCategory: {TranslationFieldName, TranslationFieldDescription},
+	TranslationKindTag:      {TranslationFieldName},
+	TranslationKindMenu:     {TranslationFieldName},
+	TranslationKindMedia:    {TranslationFieldAlt, TranslationFieldCaption},
+}
+
+// IsTranslationKind reports whether kind is a known translation bundle kind.
+func IsTranslationKind(kind string) bool {
+	_, ok := translationFields[kind]
+	return ok
+}
+
+// TranslationBundle is the format-neutral content of an XLIFF or PO file:
+// the translatable text of a set of entities in a source language together
+// with the translations into a target language, if any.
+type TranslationBundle struct {
+	SourceLang string
+	TargetLang string
+	Items      []TranslationItem
+}
+
+// TranslationItem holds the translatable units of one source entity.
+type TranslationItem struct {
+	Kind     string
+	ID       int64  // ID of the source entity
+	Original string // Human-readable reference, e.g. "page/about"
+	Units    []TranslationUnit
+}
+
+// TranslationUnit is a single translatable string. Target is empty when the
+// string is not translated yet.
+type TranslationUnit struct {
+	ID     string
+	Source string
+	Target string
+}
+
+// Key returns the identifier of the item in exchange files, e.g. "page-12".
+func (it TranslationItem) Key() string {
+	return it.Kind + "-" + strconv.FormatInt(it.ID, 10)
+}
+
+// addUnit appends a unit unless the source text is empty.
+func (it *TranslationItem) addUnit(id, source, target string) {
+	if strings.TrimSpace(source) == "" {
+		return
+	}
+	it.Units = append(it.Units, TranslationUnit{ID: id, Source: source, Target: target})
+}
+
+// parseTranslationItemKey splits an item key such as "page-12" into its kind
+// and source entity ID.
+func parseTranslationItemKey(key string) (string, int64, error) {
+	idx := strings.LastIndex(key, "-")
+	if idx <= 0 {
+		return "", 0, fmt.Errorf("invalid item id %q", key)
+	}
+	kind := key[:idx]
+	if !IsTranslationKind(kind) {
+		return "", 0, fmt.Errorf("unknown item kind %q", kind)
+	}
+	id, err := strconv.ParseInt(key[idx+1:], 10, 64)
+	if err != nil || id <= 0 {
+		return "", 0, fmt.Errorf("invalid item id %q", key)
+	}
+	return kind, id, nil
+}
+
+// TranslationExportOptions selects the entities of a translation export.
+// All selected entities must be in the source language.
+type TranslationExportOptions struct {
+	SourceLang   string
+	TargetLang   string
+	PageIDs      []int64
+	CategoryIDs  []int64
+	TagIDs       []int64
+	MenuIDs      []int64
+	IncludeMedia bool // Alt texts and captions of all media
+}
+
+// ExportTranslations collects the translatable text of the selected entities.
+// Existing translations into the target language are included as targets, so
+// an agency can review and update them instead of starting over.
+func (e *Exporter) ExportTranslations(ctx context.Context, opts TranslationExportOptions) (*TranslationBundle, error) {
+	source, target, err := translationLanguages(ctx, e.store, opts.SourceLang, opts.TargetLang)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &TranslationBundle{SourceLang: source.Code, TargetLang: target.Code}
+	for _, id := range opts.PageIDs {
+		item, err := e.pageTranslationItem(ctx, id, source, target)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Items = append(bundle.Items, item)
+	}
+	for _, id := range opts.CategoryIDs {
+		item, err := e.categoryTranslationItem(ctx, id, source, target)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Items = append(bundle.Items, item)
+	}
+	for _, id := range opts.TagIDs {
+		item, err := e.tagTranslationItem(ctx, id, source, target)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Items = append(bundle.Items, item)
+	}
+	for _, id := range opts.MenuIDs {
+		item, err := e.menuTranslationItem(ctx, id, source, target)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Items = append(bundle.Items, item)
+	}
+	if opts.IncludeMedia {
+		items, err := e.mediaTranslationItems(ctx, source, target)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Items = append(bundle.Items, items...)
+	}
+
+	return bundle, nil
+}
+
+func (e *Exporter) pageTranslationItem(ctx context.Context, id int64, source, target store.Language) (TranslationItem, error) {
+	page, err := e.store.GetPageByID(ctx, id)
+	if err != nil {
+		return TranslationItem{}, fmt.Errorf("get page %d: %w", id, err)
+	}
+	if page.LanguageCode != source.Code {
+		return TranslationItem{}, fmt.Errorf("page %d is not in %s", id, source.Code)
+	}
+
+	var translated store.Page
+	if tid, ok, err := translatedEntityID(ctx, e.store, TranslationKindPage, id, target.Code); err != nil {
+		return TranslationItem{}, err
+	} else if ok {
+		if translated, err = e.store.GetPageByID(ctx, tid); err != nil {
+			return TranslationItem{}, fmt.Errorf("get page %d: %w", tid, err)
+		}
+	}
+
+	item := TranslationItem{Kind: TranslationKindPage, ID: id, Original: "page/" + page.Slug}
+	item.addUnit(TranslationFieldTitle, page.Title, translated.Title)
+	item.addUnit(TranslationFieldSummary, page.Summary, translated.Summary)
+	item.addUnit(TranslationFieldBody, page.Body, translated.Body)
+	item.addUnit(TranslationFieldMetaTitle, page.MetaTitle, translated.MetaTitle)
+	item.addUnit(TranslationFieldMetaDescription, page.MetaDescription, translated.MetaDescription)
+	item.addUnit(TranslationFieldMetaKeywords, page.MetaKeywords, translated.MetaKeywords)
+	return item, nil
+}
+
+func (e *Exporter) categoryTranslationItem(ctx context.Context, id int64, source, target store.Language) (TranslationItem, error) {
+	cat, err := e.store.GetCategoryByID(ctx, id)
+	if err != nil {
+		return TranslationItem{}, fmt.Errorf("get category %d: %w", id, err)
+	}
+	if cat.LanguageCode != source.Code {
+		return TranslationItem{}, fmt.Errorf("category %d is not in %s", id, source.Code)
+	}
+
+	var translated store.Category
+	if tid, ok, err := translatedEntityID(ctx, e.store, TranslationKindCategory, id, target.Code); err != nil {
+		return TranslationItem{}, err
+	} else if ok {
+		if translated, err = e.store.GetCategoryByID(ctx, tid); err != nil {
+			return TranslationItem{}, fmt.Errorf("get category %d: %w", tid, err)
+		}
+	}
+
+	item := TranslationItem{Kind: TranslationKindCategory, ID: id, Original: "category/" + cat.Slug}
+	item.addUnit(TranslationFieldName, cat.Name, translated.Name)
+	item.addUnit(TranslationFieldDescription, cat.Description.String, translated.Description.String)
+	return item, nil
+}
+
+func (e *Exporter) tagTranslationItem(ctx context.Context, id int64, source, target store.Language) (TranslationItem, error) {
+	tag, err := e.store.GetTagByID(ctx, id)
+	if err != nil {
+		return TranslationItem{}, fmt.Errorf("get tag %d: %w", id, err)
+	}
+	if tag.LanguageCode != source.Code {
+		return TranslationItem{}, fmt.Errorf("tag %d is not in %s", id, source.Code)
+	}
+
+	var translated store.Tag
+	if tid, ok, err := translatedEntityID(ctx, e.store, TranslationKindTag, id, target.Code); err != nil {
+		return TranslationItem{}, err
+	} else if ok {
+		if translated, err = e.store.GetTagByID(ctx, tid); err != nil {
+			return TranslationItem{}, fmt.Errorf("get tag %d: %w", tid, err)
+		}
+	}
+
+	item := TranslationItem{Kind: TranslationKindTag, ID: id, Original: "tag/" + tag.Slug}
+	item.addUnit(TranslationFieldName, tag.Name, translated.Name)
+	return item, nil
+}
+
+// menuTranslationItem exports the menu name and its item titles. Menus are
+// linked across languages by slug; item translations are taken from the
+// target menu when it has the same structure.
+func (e *Exporter) menuTranslationItem(ctx context.Context, id int64, source, target store.Language) (TranslationItem, error) {
+	menu, err := e.store.GetMenuByID(ctx, id)
+	if err != nil {
+		return TranslationItem{}, fmt.Errorf("get menu %d: %w", id, err)
+	}
+	if menu.LanguageCode != source.Code {
+		return TranslationItem{}, fmt.Errorf("menu %d is not in %s", id, source.Code)
+	}
+	items, err := e.store.ListMenuItems(ctx, id)
+	if err != nil {
+		return TranslationItem{}, fmt.Errorf("list items of menu %d: %w", id, err)
+	}
+	items = orderMenuItemsForTranslation(items)
+
+	var translated store.Menu
+	var translatedItems []store.MenuItem
+	targetMenu, err := e.store.GetMenuBySlugAndLanguage(ctx, store.GetMenuBySlugAndLanguageParams{
+		Slug:         menu.Slug,
+		LanguageCode: target.Code,
+	})
+	switch {
+	case err == nil:
+		translated = targetMenu
+		targetItems, err := e.store.ListMenuItems(ctx, targetMenu.ID)
+		if err != nil {
+			return TranslationItem{}, fmt.Errorf("list items of menu %d: %w", targetMenu.ID, err)
+		}
+		if targetItems = orderMenuItemsForTranslation(targetItems); sameMenuStructure(items, targetItems) {
+			translatedItems = targetItems
+		}
+	case !errors.Is(err, sql.ErrNoRows):
+		return TranslationItem{}, fmt.Errorf("get menu %q in %s: %w", menu.Slug, target.Code, err)
+	}
+
+	item := TranslationItem{Kind: TranslationKindMenu, ID: id, Original: "menu/" + menu.Slug}
+	item.addUnit(TranslationFieldName, menu.Name, translated.Name)
+	for idx, mi := range items {
+		var targetTitle string
+		if translatedItems != nil {
+			targetTitle = translatedItems[idx].Title
+		}
+		item.addUnit(translationMenuItemPrefix+strconv.FormatInt(mi.ID, 10), mi.Title, targetTitle)
+	}
+	return item, nil
+}
+
+// mediaTranslationItems exports the alt text and caption of every media item
+// that has either in the source language.
+func (e *Exporter) mediaTranslationItems(ctx context.Context, source, target store.Language) ([]TranslationItem, error) {
+	var items []TranslationItem
+	for offset := int64(0); ; offset += pageLookupBatchSize {
+		batch, err := e.store.ListMedia(ctx, store.ListMediaParams{Limit: pageLookupBatchSize, Offset: offset})
+		if err != nil {
+			return nil, fmt.Errorf("list media: %w", err)
+		}
+		for _, m := range batch {
+			srcAlt, srcCaption, err := mediaText(ctx, e.store, m, source)
+			if err != nil {
+				return nil, err
+			}
+			if strings.TrimSpace(srcAlt) == "" && strings.TrimSpace(srcCaption) == "" {
+				continue
+			}
+			trgAlt, trgCaption, err := mediaText(ctx, e.store, m, target)
+			if err != nil {
+				return nil, err
+			}
+			item := TranslationItem{Kind: TranslationKindMedia, ID: m.ID, Original: "media/" + m.Filename}
+			item.addUnit(TranslationFieldAlt, srcAlt, trgAlt)
+			item.addUnit(TranslationFieldCaption, srcCaption, trgCaption)
+			items = append(items, item)
+		}
+		if int64(len(batch)) < pageLookupBatchSize {
+			return items, nil
+		}
+	}
+}
+
+// mediaText returns the alt text and caption of a media item in a language.
+// The media row holds the text of its own language; other languages are
+// stored in media_translations.
+func mediaText(ctx context.Context, queries *store.Queries, m store.Medium, lang store.Language) (string, string, error) {
+	if m.LanguageCode == lang.Code {
+		return m.Alt.String, m.Caption.String, nil
+	}
+	tr, err := queries.GetMediaTranslation(ctx, store.GetMediaTranslationParams{MediaID: m.ID, LanguageID: lang.ID})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("get translation of media %d: %w", m.ID, err)
+	}
+	return tr.Alt, tr.Caption, nil
+}
+
+// translationLanguages loads and validates the languages of a translation
+// export or import.
+func translationLanguages(ctx context.Context, queries *store.Queries, sourceCode, targetCode string) (store.Language, store.Language, error) {
+	if sourceCode == "" || targetCode == "" {
+		return store.Language{}, store.Language{}, errors.New("source and target language are required")
+	}
+	if sourceCode == targetCode {
+		return store.Language{}, store.Language{}, errors.New("source and target language must differ")
+	}
+	source, err := queries.GetLanguageByCode(ctx, sourceCode)
+	if err != nil {
+		return store.Language{}, store.Language{}, fmt.Errorf("unknown source language %q", sourceCode)
+	}
+	target, err := queries.GetLanguageByCode(ctx, targetCode)
+	if err != nil {
+		return store.Language{}, store.Language{}, fmt.Errorf("unknown target language %q", targetCode)
+	}
+	return source, target, nil
+}
+
+// translatedEntityID returns the ID of the translation of an entity into a
+// language, following the whole translation group.
+func translatedEntityID(ctx context.Context, queries *store.Queries, kind string, id int64, langCode string) (int64, bool, error) {
+	tid, err := queries.GetTranslationComponentEntityByLanguage(ctx, store.GetTranslationComponentEntityByLanguageParams{
+		EntityType:   kind,
+		LanguageCode: langCode,
+		EntityID:     id,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("find %s %d translation in %s: %w", kind, id, langCode, err)
+	}
+	return tid, true, nil
+}
+
+// orderMenuItemsForTranslation returns menu items in depth-first order, the
+// order in which they appear in the menu.
+func orderMenuItemsForTranslation(items []store.MenuItem) []store.MenuItem {
+	children := make(map[int64][]store.MenuItem)
+	known := make(map[int64]bool, len(items))
+	for _, item := range items {
+		known[item.ID] = true
+	}
+	for _, item := range items {
+		parent := int64(0)
+		if item.ParentID.Valid && known[item.ParentID.Int64] {
+			parent = item.ParentID.Int64
+		}
+		children[parent] = append(children[parent], item)
+	}
+
+	ordered := make([]store.MenuItem, 0, len(items))
+	var walk func(parent int64)
+	walk = func(parent int64) {
+		for _, item := range children[parent] {
+			ordered = append(ordered, item)
+			walk(item.ID)
+		}
+	}
+	walk(0)
+	return ordered
+}
+
+// sameMenuStructure reports whether two depth-first ordered item lists have
+// the same tree shape, so items can be paired by position.
+func sameMenuStructure(a, b []store.MenuItem) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	indexA := make(map[int64]int, len(a))
+	indexB := make(map[int64]int, len(b))
+	for i := range a {
+		indexA[a[i].ID] = i
+		indexB[b[i].ID] = i
+	}
+	for i := range a {
+		pa, pb := -1, -1
+		if a[i].ParentID.Valid {
+			if idx, ok := indexA[a[i].ParentID.Int64]; ok {
+				pa = idx
+			}
+		}
+		if b[i].ParentID.Valid {
+			if idx, ok := indexB[b[i].ParentID.Int64]; ok {
+				pb = idx
+			}
+		}
+		if pa != pb {
+			return false
+		}
+	}
+	return true
+}
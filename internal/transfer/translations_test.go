@@ -0,0 +1,240 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package transfer
+
+import (
+	"database/sql"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// setTranslationTargets fills the targets of a bundle with "[ru] <source>".
+func setTranslationTargets(b *TranslationBundle) {
+	for i := range b.Items {
+		for j := range b.Items[i].Units {
+			b.Items[i].Units[j].Target = "[ru] " + b.Items[i].Units[j].Source
+		}
+	}
+}
+
+func TestTranslationExportImport(t *testing.T) {
+	ts := setupTest(t)
+	defer ts.Cleanup()
+	q := ts.Queries
+
+	ru, err := q.CreateLanguage(ts.Ctx, store.CreateLanguageParams{
+		Code: "ru", Name: "Russian", NativeName: "Русский", IsActive: true,
+		Direction: "ltr", Position: 1, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+	})
+	require.NoError(t, err)
+
+	page, err := q.CreatePage(ts.Ctx, store.CreatePageParams{
+		Title: "About", Slug: "about", Body: "<p>About us</p>", Summary: "Who we are",
+		Status: "published", AuthorID: ts.User.ID, LanguageCode: "en", PageType: "page",
+		CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
+	})
+	require.NoError(t, err)
+
+	parent, err := q.CreateCategory(ts.Ctx, store.CreateCategoryParams{
+		Name: "Guides", Slug: "guides", LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+	})
+	require.NoError(t, err)
+	parentRU, err := q.CreateCategory(ts.Ctx, store.CreateCategoryParams{
+		Name: "Руководства", Slug: "guides-ru", LanguageCode: "ru", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+	})
+	require.NoError(t, err)
+	_, err = q.CreateTranslation(ts.Ctx, store.CreateTranslationParams{
+		EntityType: model.EntityTypeCategory, EntityID: parent.ID, LanguageID: ru.ID,
+		TranslationID: parentRU.ID, CreatedAt: ts.Now,
+	})
+	require.NoError(t, err)
+	child, err := q.CreateCategory(ts.Ctx, store.CreateCategoryParams{
+		Name: "Setup", Slug: "setup", Description: sql.NullString{String: "Getting started", Valid: true},
+		ParentID: sql.NullInt64{Int64: parent.ID, Valid: true}, LanguageCode: "en",
+		CreatedAt: ts.Now, UpdatedAt: ts.Now,
+	})
+	require.NoError(t, err)
+
+	tag, err := q.CreateTag(ts.Ctx, store.CreateTagParams{
+		Name: "News", Slug: "news", LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+	})
+	require.NoError(t, err)
+
+	menu, err := q.CreateMenu(ts.Ctx, store.CreateMenuParams{
+		Name: "Main", Slug: "main", LanguageCode: "en", CreatedAt: ts.Now, UpdatedAt: ts.Now,
+		SiteID: store.DefaultSiteID,
+	})
+	require.NoError(t, err)
+	top, err := q.CreateMenuItem(ts.Ctx, store.CreateMenuItemParams{
+		MenuID: menu.ID, Title: "Company", IsActive: true, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+	})
+	require.NoError(t, err)
+	_, err = q.CreateMenuItem(ts.Ctx, store.CreateMenuItemParams{
+		MenuID: menu.ID, ParentID: sql.NullInt64{Int64: top.ID, Valid: true}, Title: "About",
+		PageID: sql.NullInt64{Int64: page.ID, Valid: true}, IsActive: true, CreatedAt: ts.Now, UpdatedAt: ts.Now,
+	})
+	require.NoError(t, err)
+
+	media := createExportMedia(t, ts, "uuid-1", "logo.png", "image/png", 10)
+	_, err = q.UpdateMedia(ts.Ctx, store.UpdateMediaParams{
+		Filename: media.Filename, Alt: sql.NullString{String: "Company logo", Valid: true},
+		LanguageCode: "en", UpdatedAt: ts.Now, ID: media.ID,
+	})
+	require.NoError(t, err)
+
+	exporter := NewExporter(q, slog.Default())
+	importer := NewImporter(q, ts.DB, slog.Default())
+	opts := TranslationExportOptions{
+		SourceLang:   "en",
+		TargetLang:   "ru",
+		PageIDs:      []int64{page.ID},
+		CategoryIDs:  []int64{child.ID},
+		TagIDs:       []int64{tag.ID},
+		MenuIDs:      []int64{menu.ID},
+		IncludeMedia: true,
+	}
+
+	bundle, err := exporter.ExportTranslations(ts.Ctx, opts)
+	require.NoError(t, err)
+	require.Len(t, bundle.Items, 5)
+	assert.Equal(t, "page/about", bundle.Items[0].Original)
+	// Empty meta fields are not exported
+	assert.Len(t, bundle.Items[0].Units, 3)
+	assert.Equal(t, []TranslationUnit{{ID: "name", Source: "Main"}, {ID: menuItemUnitID(top.ID), Source: "Company"}}, bundle.Items[3].Units[:2])
+
+	setTranslationTargets(bundle)
+
+	t.Run("dry run writes nothing", func(t *testing.T) {
+		report, err := importer.ImportTranslations(ts.Ctx, bundle, TranslationImportOptions{DryRun: true, UserID: ts.User.ID})
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, 5, report.Count(TranslationActionCreated))
+
+		_, ok, err := translatedEntityID(ts.Ctx, q, TranslationKindPage, page.ID, "ru")
+		require.NoError(t, err)
+		assert.False(t, ok)
+		_, err = q.GetMenuBySlugAndLanguage(ts.Ctx, store.GetMenuBySlugAndLanguageParams{Slug: "main", LanguageCode: "ru"})
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	report, err := importer.ImportTranslations(ts.Ctx, bundle, TranslationImportOptions{UserID: ts.User.ID})
+	require.NoError(t, err)
+	require.Equal(t, 5, report.Count(TranslationActionCreated), report.Entries)
+
+	pageRUID, ok, err := translatedEntityID(ts.Ctx, q, TranslationKindPage, page.ID, "ru")
+	require.NoError(t, err)
+	require.True(t, ok)
+	pageRU, err := q.GetPageByID(ts.Ctx, pageRUID)
+	require.NoError(t, err)
+	assert.Equal(t, "about-ru", pageRU.Slug)
+	assert.Equal(t, model.PageStatusDraft, pageRU.Status)
+	assert.Equal(t, "[ru] About", pageRU.Title)
+	assert.Equal(t, "[ru] <p>About us</p>", pageRU.Body)
+	versions, err := q.CountPageVersions(ts.Ctx, pageRUID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), versions)
+
+	childRUID, ok, err := translatedEntityID(ts.Ctx, q, TranslationKindCategory, child.ID, "ru")
+	require.NoError(t, err)
+	require.True(t, ok)
+	childRU, err := q.GetCategoryByID(ts.Ctx, childRUID)
+	require.NoError(t, err)
+	assert.Equal(t, parentRU.ID, childRU.ParentID.Int64, "translation is nested under the parent's translation")
+	assert.Equal(t, "[ru] Getting started", childRU.Description.String)
+
+	menuRU, err := q.GetMenuBySlugAndLanguage(ts.Ctx, store.GetMenuBySlugAndLanguageParams{Slug: "main", LanguageCode: "ru"})
+	require.NoError(t, err)
+	assert.Equal(t, "[ru] Main", menuRU.Name)
+	itemsRU, err := q.ListMenuItems(ts.Ctx, menuRU.ID)
+	require.NoError(t, err)
+	itemsRU = orderMenuItemsForTranslation(itemsRU)
+	require.Len(t, itemsRU, 2)
+	assert.Equal(t, "[ru] About", itemsRU[1].Title)
+	assert.Equal(t, itemsRU[0].ID, itemsRU[1].ParentID.Int64)
+	assert.Equal(t, pageRUID, itemsRU[1].PageID.Int64, "menu links point to the page translation")
+
+	alt, err := q.GetMediaTranslationAlt(ts.Ctx, store.GetMediaTranslationAltParams{MediaID: media.ID, Code: "ru"})
+	require.NoError(t, err)
+	assert.Equal(t, "[ru] Company logo", alt)
+
+	t.Run("export includes existing translations", func(t *testing.T) {
+		again, err := exporter.ExportTranslations(ts.Ctx, opts)
+		require.NoError(t, err)
+		for _, item := range again.Items {
+			for _, unit := range item.Units {
+				assert.Equal(t, "[ru] "+unit.Source, unit.Target, "%s %s", item.Key(), unit.ID)
+			}
+		}
+	})
+
+	t.Run("reimport updates changed fields only", func(t *testing.T) {
+		report, err := importer.ImportTranslations(ts.Ctx, bundle, TranslationImportOptions{UserID: ts.User.ID})
+		require.NoError(t, err)
+		assert.Equal(t, 5, report.Count(TranslationActionUnchanged))
+		assert.False(t, report.Changed())
+
+		bundle.Items[0].Units[0].Target = "О компании"
+		report, err = importer.ImportTranslations(ts.Ctx, bundle, TranslationImportOptions{UserID: ts.User.ID})
+		require.NoError(t, err)
+		require.Equal(t, TranslationActionUpdated, report.Entries[0].Action)
+		assert.Equal(t, []string{TranslationFieldTitle}, report.Entries[0].Fields)
+		assert.Equal(t, pageRUID, report.Entries[0].TargetID)
+
+		versions, err := q.CountPageVersions(ts.Ctx, pageRUID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), versions)
+	})
+
+	t.Run("reimport brings outdated translations up to date", func(t *testing.T) {
+		_, err := q.CreatePageVersion(ts.Ctx, store.CreatePageVersionParams{
+			PageID: page.ID, Title: page.Title, Body: page.Body, ChangedBy: ts.User.ID, CreatedAt: ts.Now,
+		})
+		require.NoError(t, err)
+		outdated, err := q.ListOutdatedPageTranslationIDs(ts.Ctx)
+		require.NoError(t, err)
+		require.Equal(t, []int64{pageRUID}, outdated)
+
+		// A file translated from older source text leaves the translation outdated
+		stale := *bundle
+		stale.Items = []TranslationItem{bundle.Items[0]}
+		stale.Items[0].Units = append([]TranslationUnit(nil), bundle.Items[0].Units...)
+		stale.Items[0].Units[1].Source = "Who we were"
+		_, err = importer.ImportTranslations(ts.Ctx, &stale, TranslationImportOptions{UserID: ts.User.ID})
+		require.NoError(t, err)
+		outdated, err = q.ListOutdatedPageTranslationIDs(ts.Ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{pageRUID}, outdated)
+
+		report, err := importer.ImportTranslations(ts.Ctx, bundle, TranslationImportOptions{UserID: ts.User.ID})
+		require.NoError(t, err)
+		assert.Equal(t, TranslationActionUnchanged, report.Entries[0].Action)
+		outdated, err = q.ListOutdatedPageTranslationIDs(ts.Ctx)
+		require.NoError(t, err)
+		assert.Empty(t, outdated)
+	})
+
+	t.Run("skips and warnings", func(t *testing.T) {
+		b := &TranslationBundle{SourceLang: "en", TargetLang: "ru", Items: []TranslationItem{
+			{Kind: TranslationKindPage, ID: 99999, Units: []TranslationUnit{{ID: "title", Source: "x", Target: "y"}}},
+			{Kind: TranslationKindTag, ID: tag.ID, Units: []TranslationUnit{{ID: "name", Source: "Old news", Target: "Новости"}, {ID: "slug", Source: "news", Target: "novosti"}}},
+			{Kind: TranslationKindPage, ID: pageRUID, Units: []TranslationUnit{{ID: "title", Source: "x", Target: "y"}}},
+		}}
+		report, err := importer.ImportTranslations(ts.Ctx, b, TranslationImportOptions{DryRun: true, UserID: ts.User.ID})
+		require.NoError(t, err)
+		assert.Equal(t, TranslationActionSkipped, report.Entries[0].Action)
+		assert.Equal(t, TranslationActionUpdated, report.Entries[1].Action)
+		assert.Equal(t, []string{`source text of "name" changed since export`, `unknown unit "slug" ignored`}, report.Entries[1].Notes)
+		assert.Equal(t, TranslationActionSkipped, report.Entries[2].Action, "source must be in the source language")
+
+		_, err = importer.ImportTranslations(ts.Ctx, &TranslationBundle{SourceLang: "en", TargetLang: "en"}, TranslationImportOptions{})
+		require.Error(t, err)
+	})
+}
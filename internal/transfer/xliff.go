@@ -0,0 +1,166 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package transfer
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// XLIFFNamespace is the namespace of XLIFF 2.0 documents.
+const XLIFFNamespace = "urn:oasis:names:tc:xliff:document:2.0"
+
+// XLIFF segment states.
+const (
+	xliffStateInitial    = "initial"
+	xliffStateTranslated = "translated"
+)
+
+// xliffDocument maps the subset of XLIFF 2.0 core used for translation
+// exchange: one <file> per entity and one <unit> per translatable field.
+type xliffDocument struct {
+	XMLName xml.Name    `xml:"urn:oasis:names:tc:xliff:document:2.0 xliff"`
+	Version string      `xml:"version,attr"`
+	SrcLang string      `xml:"srcLang,attr"`
+	TrgLang string      `xml:"trgLang,attr,omitempty"`
+	Files   []xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	ID       string      `xml:"id,attr"`
+	Original string      `xml:"original,attr,omitempty"`
+	Units    []xliffUnit `xml:"unit"`
+}
+
+type xliffUnit struct {
+	ID   string `xml:"id,attr"`
+	Name string `xml:"name,attr,omitempty"`
+	// Parts holds the <segment> and <ignorable> elements in document order.
+	// Other children such as <notes> are ignored on import.
+	Parts []xliffPart `xml:",any"`
+}
+
+type xliffPart struct {
+	XMLName xml.Name
+	State   string     `xml:"state,attr,omitempty"`
+	Source  xliffText  `xml:"source"`
+	Target  *xliffText `xml:"target"`
+}
+
+type xliffText struct {
+	Space string `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	Text  string `xml:",chardata"`
+	// Inline holds inline elements (<ph>, <pc>, ...). The exchange files carry
+	// markup as escaped text, so inline codes in a returned file are rejected
+	// rather than silently dropped.
+	Inline []xliffInline `xml:",any"`
+}
+
+type xliffInline struct {
+	XMLName xml.Name
+}
+
+// WriteXLIFF writes a bundle as an XLIFF 2.0 document. Each entity becomes a
+// <file> with the item key as ID, each field a <unit> with a single segment.
+func WriteXLIFF(w io.Writer, b *TranslationBundle) error {
+	doc := xliffDocument{
+		Version: "2.0",
+		SrcLang: b.SourceLang,
+		TrgLang: b.TargetLang,
+	}
+	for _, item := range b.Items {
+		file := xliffFile{ID: item.Key(), Original: item.Original}
+		for _, unit := range item.Units {
+			part := xliffPart{
+				XMLName: xml.Name{Local: "segment"},
+				State:   xliffStateInitial,
+				Source:  xliffText{Space: "preserve", Text: unit.Source},
+			}
+			if unit.Target != "" {
+				part.State = xliffStateTranslated
+				part.Target = &xliffText{Space: "preserve", Text: unit.Target}
+			}
+			file.Units = append(file.Units, xliffUnit{ID: unit.ID, Parts: []xliffPart{part}})
+		}
+		doc.Files = append(doc.Files, file)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("encode XLIFF: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// ParseXLIFF reads an XLIFF 2.0 document written by WriteXLIFF and returned
+// by a translator. Segments of a unit are joined, so files re-segmented by a
+// CAT tool import as well. A unit counts as translated only when all of its
+// segments have a target.
+func ParseXLIFF(r io.Reader) (*TranslationBundle, error) {
+	var doc xliffDocument
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid XLIFF document: %w", err)
+	}
+	if doc.Version != "2.0" {
+		return nil, fmt.Errorf("unsupported XLIFF version %q, expected 2.0", doc.Version)
+	}
+	if doc.SrcLang == "" {
+		return nil, errors.New("XLIFF document has no srcLang")
+	}
+
+	b := &TranslationBundle{SourceLang: doc.SrcLang, TargetLang: doc.TrgLang}
+	for _, file := range doc.Files {
+		kind, id, err := parseTranslationItemKey(file.ID)
+		if err != nil {
+			return nil, fmt.Errorf("file %q: %w", file.ID, err)
+		}
+		item := TranslationItem{Kind: kind, ID: id, Original: file.Original}
+		for _, unit := range file.Units {
+			tu, err := unit.translationUnit()
+			if err != nil {
+				return nil, fmt.Errorf("file %q, unit %q: %w", file.ID, unit.ID, err)
+			}
+			item.Units = append(item.Units, tu)
+		}
+		b.Items = append(b.Items, item)
+	}
+	return b, nil
+}
+
+func (u xliffUnit) translationUnit() (TranslationUnit, error) {
+	var source, target strings.Builder
+	complete := true
+	for _, part := range u.Parts {
+		if part.XMLName.Local != "segment" && part.XMLName.Local != "ignorable" {
+			continue
+		}
+		if len(part.Source.Inline) > 0 || (part.Target != nil && len(part.Target.Inline) > 0) {
+			return TranslationUnit{}, errors.New("inline markup elements are not supported")
+		}
+		source.WriteString(part.Source.Text)
+		switch {
+		case part.Target != nil:
+			target.WriteString(part.Target.Text)
+		case part.XMLName.Local == "ignorable":
+			// An ignorable without target keeps its source text
+			target.WriteString(part.Source.Text)
+		default:
+			complete = false
+		}
+	}
+
+	tu := TranslationUnit{ID: u.ID, Source: source.String()}
+	if complete {
+		tu.Target = target.String()
+	}
+	return tu, nil
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package transfer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleTranslationBundle() *TranslationBundle {
+	return &TranslationBundle{
+		SourceLang: "en",
+		TargetLang: "ru",
+		Items: []TranslationItem{
+			{
+				Kind:     TranslationKindPage,
+				ID:       12,
+				Original: "page/about",
+				Units: []TranslationUnit{
+					{ID: TranslationFieldTitle, Source: "About \"us\"", Target: "О нас"},
+					{ID: TranslationFieldBody, Source: "<p>Line one</p>\n<p>Line\ttwo & more</p>\n"},
+				},
+			},
+			{
+				Kind:     TranslationKindMenu,
+				ID:       3,
+				Original: "menu/main",
+				Units: []TranslationUnit{
+					{ID: "item-7", Source: "Home", Target: "Главная"},
+				},
+			},
+		},
+	}
+}
+
+func TestXLIFFRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteXLIFF(&buf, sampleTranslationBundle()))
+
+	out := buf.String()
+	assert.Contains(t, out, `<xliff xmlns="urn:oasis:names:tc:xliff:document:2.0" version="2.0" srcLang="en" trgLang="ru">`)
+	assert.Contains(t, out, `<file id="page-12" original="page/about">`)
+	assert.Contains(t, out, `xml:space="preserve"`)
+	assert.Contains(t, out, `&lt;p&gt;Line one&lt;/p&gt;`)
+
+	parsed, err := ParseXLIFF(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, sampleTranslationBundle(), parsed)
+}
+
+func TestPORoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WritePO(&buf, sampleTranslationBundle()))
+
+	out := buf.String()
+	assert.Contains(t, out, `"X-Source-Language: en\n"`)
+	assert.Contains(t, out, "#: page/about\nmsgctxt \"page-12/title\"\nmsgid \"About \\\"us\\\"\"\nmsgstr \"О нас\"")
+	assert.Contains(t, out, "msgid \"\"\n\"<p>Line one</p>\\n\"\n\"<p>Line\\ttwo & more</p>\\n\"")
+
+	parsed, err := ParsePO(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, sampleTranslationBundle(), parsed)
+}
+
+func TestParseXLIFFSegments(t *testing.T) {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<xliff xmlns="urn:oasis:names:tc:xliff:document:2.0" version="2.0" srcLang="en" trgLang="de">
+  <file id="tag-4">
+    <unit id="name">
+      <notes><note>Keep short</note></notes>
+      <segment><source>Hello</source><target>Hallo</target></segment>
+      <ignorable><source> </source></ignorable>
+      <segment><source>world</source><target>Welt</target></segment>
+    </unit>
+  </file>
+  <file id="category-5">
+    <unit id="name">
+      <segment><source>One</source><target>Eins</target></segment>
+      <segment><source>Two</source></segment>
+    </unit>
+  </file>
+</xliff>`
+
+	b, err := ParseXLIFF(strings.NewReader(doc))
+	require.NoError(t, err)
+	require.Len(t, b.Items, 2)
+	assert.Equal(t, TranslationUnit{ID: "name", Source: "Hello world", Target: "Hallo Welt"}, b.Items[0].Units[0])
+	assert.Equal(t, "", b.Items[1].Units[0].Target, "partially translated unit counts as untranslated")
+}
+
+func TestParseXLIFFRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{
+			name: "version 1.2",
+			doc:  `<xliff xmlns="urn:oasis:names:tc:xliff:document:2.0" version="1.2" srcLang="en"></xliff>`,
+			want: "unsupported XLIFF version",
+		},
+		{
+			name: "inline markup",
+			doc: `<xliff xmlns="urn:oasis:names:tc:xliff:document:2.0" version="2.0" srcLang="en">
+<file id="page-1"><unit id="title"><segment><source>A</source><target>B <ph id="1"/></target></segment></unit></file></xliff>`,
+			want: "inline markup",
+		},
+		{
+			name: "unknown kind",
+			doc:  `<xliff xmlns="urn:oasis:names:tc:xliff:document:2.0" version="2.0" srcLang="en"><file id="widget-1"></file></xliff>`,
+			want: "unknown item kind",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseXLIFF(strings.NewReader(tt.doc))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestParsePOFuzzyAndDetection(t *testing.T) {
+	po := "\ufeffmsgid \"\"\nmsgstr \"\"\n\"Language: ru\\n\"\n\"X-Source-Language: en\\n\"\n\n" +
+		"#, fuzzy\nmsgctxt \"tag-2/name\"\nmsgid \"News\"\nmsgstr \"Новости\"\n\n" +
+		"msgctxt \"tag-3/name\"\nmsgid \"Sport\"\nmsgstr \"Спорт\"\n"
+
+	b, err := ParseTranslationFile([]byte(po))
+	require.NoError(t, err)
+	assert.Equal(t, "en", b.SourceLang)
+	assert.Equal(t, "ru", b.TargetLang)
+	require.Len(t, b.Items, 2)
+	assert.Equal(t, "", b.Items[0].Units[0].Target, "fuzzy entries are not imported")
+	assert.Equal(t, "Спорт", b.Items[1].Units[0].Target)
+
+	_, err = ParsePO(strings.NewReader("msgctxt \"tag-2/name\"\nmsgid \"a\"\nmsgid_plural \"b\"\n"))
+	require.Error(t, err)
+}
@@ -57,6 +57,7 @@ func TestExportWithMediaToZip(t *testing.T) {
 		LanguageCode: lang.Code,
 		CreatedAt:    ts.Now,
 		UpdatedAt:    ts.Now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create media record: %v", err)
@@ -204,6 +205,7 @@ func TestImportFromZip(t *testing.T) {
 		LanguageCode: lang.Code,
 		CreatedAt:    now,
 		UpdatedAt:    now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create media record: %v", err)
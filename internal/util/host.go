@@ -0,0 +1,101 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package util
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// maxHostLength is the maximum length of a DNS name.
+const maxHostLength = 253
+
+// NormalizeHost validates a public host name such as "example.de" or
+// "de.example.com:8443" and returns it in lowercase. A scheme, path,
+// credentials or trailing dot are rejected, so the result can be compared
+// with a request Host header as-is. An empty input normalizes to "".
+func NormalizeHost(raw string) (string, error) {
+	host := strings.ToLower(strings.TrimSpace(raw))
+	if host == "" {
+		return "", nil
+	}
+	if strings.ContainsAny(host, "/?#@\\ ") {
+		return "", errors.New("host must be a host name without scheme or path, e.g. example.de")
+	}
+
+	name, port := host, ""
+	if strings.Contains(host, ":") {
+		var err error
+		name, port, err = net.SplitHostPort(host)
+		if err != nil {
+			return "", errors.New("invalid host port")
+		}
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return "", errors.New("invalid host port")
+		}
+	}
+	if len(name) > maxHostLength || net.ParseIP(name) != nil {
+		return "", errors.New("host must be a domain name")
+	}
+	labels := strings.Split(name, ".")
+	for _, label := range labels {
+		if !isHostLabel(label) {
+			return "", errors.New("host must be a domain name")
+		}
+	}
+	if len(labels) < 2 && name != "localhost" {
+		return "", errors.New("host must be a fully qualified domain name")
+	}
+	return host, nil
+}
+
+func isHostLabel(label string) bool {
+	if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for _, r := range label {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// HostsMatch reports whether a request Host header names host. A port
+// omitted from host matches any port of the request, so a mapping of
+// "example.de" also serves "example.de:443".
+func HostsMatch(requestHost, host string) bool {
+	requestHost = strings.ToLower(strings.TrimSuffix(requestHost, "."))
+	if requestHost == host {
+		return true
+	}
+	if strings.Contains(host, ":") {
+		return false
+	}
+	name, _, err := net.SplitHostPort(requestHost)
+	return err == nil && name == host
+}
+
+// HostInDomain reports whether host, with or without a port, is domain or
+// one of its subdomains, i.e. whether a cookie set for domain reaches it.
+func HostInDomain(host, domain string) bool {
+	name := host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		name = h
+	}
+	return name == domain || strings.HasSuffix(name, "."+domain)
+}
+
+// HostURL returns the origin of host using the scheme of siteURL, falling
+// back to https when siteURL has none.
+func HostURL(siteURL, host string) string {
+	scheme := "https"
+	if u, err := url.Parse(siteURL); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		scheme = u.Scheme
+	}
+	return scheme + "://" + host
+}
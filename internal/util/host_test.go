@@ -0,0 +1,98 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package util
+
+import "testing"
+
+func TestNormalizeHost(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty", input: "  ", want: ""},
+		{name: "domain", input: "Example.DE", want: "example.de"},
+		{name: "subdomain with port", input: "de.example.com:8443", want: "de.example.com:8443"},
+		{name: "localhost", input: "localhost:8080", want: "localhost:8080"},
+		{name: "scheme", input: "https://example.de", wantErr: true},
+		{name: "path", input: "example.de/de", wantErr: true},
+		{name: "credentials", input: "user@example.de", wantErr: true},
+		{name: "single label", input: "intranet", wantErr: true},
+		{name: "ip address", input: "192.0.2.1", wantErr: true},
+		{name: "bad port", input: "example.de:0", wantErr: true},
+		{name: "trailing dot", input: "example.de.", wantErr: true},
+		{name: "leading hyphen", input: "-de.example.com", wantErr: true},
+		{name: "underscore", input: "de_site.example.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeHost(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeHost(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeHost(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHostsMatch(t *testing.T) {
+	tests := []struct {
+		requestHost string
+		host        string
+		want        bool
+	}{
+		{"example.de", "example.de", true},
+		{"EXAMPLE.de.", "example.de", true},
+		{"example.de:443", "example.de", true},
+		{"example.de:8443", "example.de:8443", true},
+		{"example.de", "example.de:8443", false},
+		{"example.de:80", "example.de:8443", false},
+		{"www.example.de", "example.de", false},
+		{"example.com", "example.de", false},
+	}
+	for _, tt := range tests {
+		if got := HostsMatch(tt.requestHost, tt.host); got != tt.want {
+			t.Errorf("HostsMatch(%q, %q) = %v, want %v", tt.requestHost, tt.host, got, tt.want)
+		}
+	}
+}
+
+func TestHostInDomain(t *testing.T) {
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{"example.com", true},
+		{"de.example.com", true},
+		{"de.example.com:8443", true},
+		{"example.de", false},
+		{"badexample.com", false},
+		{"example.com.evil.net", false},
+	}
+	for _, tt := range tests {
+		if got := HostInDomain(tt.host, "example.com"); got != tt.want {
+			t.Errorf("HostInDomain(%q, example.com) = %v, want %v", tt.host, got, tt.want)
+		}
+	}
+}
+
+func TestHostURL(t *testing.T) {
+	tests := []struct {
+		siteURL string
+		want    string
+	}{
+		{"https://example.com", "https://example.de"},
+		{"http://localhost:8080", "http://example.de"},
+		{"", "https://example.de"},
+		{"ftp://example.com", "https://example.de"},
+	}
+	for _, tt := range tests {
+		if got := HostURL(tt.siteURL, "example.de"); got != tt.want {
+			t.Errorf("HostURL(%q) = %q, want %q", tt.siteURL, got, tt.want)
+		}
+	}
+}
@@ -114,7 +114,7 @@ func IsReservedLanguageCode(s string) bool {
 	switch s {
 	case "admin", "api", "blog", "uploads", "static", "themes", "health",
 		"login", "logout", "language", "forms", "search", "tag", "category",
-		"page", "session":
+		"page", "session", "members":
 		return true
 	default:
 		return false
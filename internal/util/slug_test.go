@@ -583,7 +583,7 @@ func TestIsReservedLanguageCode(t *testing.T) {
 	reserved := []string{
 		"admin", "api", "blog", "uploads", "static", "themes", "health",
 		"login", "logout", "language", "forms", "search", "tag", "category",
-		"page", "session",
+		"page", "session", "members",
 	}
 
 	for _, code := range reserved {
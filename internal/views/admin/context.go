@@ -16,6 +16,12 @@ type LangOption struct {
 	Name string
 }
 
+// SiteOption represents a site in the admin site switcher.
+type SiteOption struct {
+	ID   int64
+	Name string
+}
+
 // PageContext carries shared data for all admin templ views.
 type PageContext struct {
 	Title          string
@@ -26,6 +32,8 @@ type PageContext struct {
 	CurrentPath    string
 	AdminLang      string
 	LangOptions    []LangOption
+	SiteOptions    []SiteOption
+	CurrentSiteID  int64
 	Breadcrumbs    []Breadcrumb
 	SidebarModules []SidebarModule
 }
@@ -0,0 +1,371 @@
+// Code generated by templ - DO NOT EDIT.
+
+// templ: version: v0.3.1020
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package admin
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import templruntime "github.com/a-h/templ/runtime"
+
+import "github.com/olegiv/ocms-go/internal/views/components/button"
+
+// Page editor modes, matching the editor_mode form values.
+const (
+	editorModeHTML   = "html"
+	editorModeBlocks = "blocks"
+)
+
+func pageEditorModeSwitch(pc *PageContext, data PageFormViewData) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var1 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var1 == nil {
+			templ_7745c5c3_Var1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<input type=\"hidden\" id=\"editor_mode\" name=\"editor_mode\" value=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var2 string
+		templ_7745c5c3_Var2, templ_7745c5c3_Err = templ.ResolveAttributeValue(data.EditorMode)
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/admin/page_blocks.templ`, Line: 15, Col: 81}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var2)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 2, "\"><div class=\"mb-2 flex gap-2\" role=\"group\" aria-label=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var3 string
+		templ_7745c5c3_Var3, templ_7745c5c3_Err = templ.ResolveAttributeValue(pc.T("blocks.editor"))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/admin/page_blocks.templ`, Line: 16, Col: 77}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var3)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 3, "\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Var4 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+			templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+			if !templ_7745c5c3_IsBuffer {
+				defer func() {
+					templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+					if templ_7745c5c3_Err == nil {
+						templ_7745c5c3_Err = templ_7745c5c3_BufErr
+					}
+				}()
+			}
+			ctx = templ.InitializeContext(ctx)
+			var templ_7745c5c3_Var5 string
+			templ_7745c5c3_Var5, templ_7745c5c3_Err = templ.JoinStringErrs(pc.T("blocks.mode_html"))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/admin/page_blocks.templ`, Line: 18, Col: 29}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var5))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			return nil
+		})
+		templ_7745c5c3_Err = button.Button(button.Props{Variant: button.VariantOutline, Size: button.SizeSm, Attributes: templ.Attributes{"data-editor-mode": editorModeHTML, "aria-pressed": data.EditorMode != editorModeBlocks}}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var4), templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Var6 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+			templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+			if !templ_7745c5c3_IsBuffer {
+				defer func() {
+					templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+					if templ_7745c5c3_Err == nil {
+						templ_7745c5c3_Err = templ_7745c5c3_BufErr
+					}
+				}()
+			}
+			ctx = templ.InitializeContext(ctx)
+			var templ_7745c5c3_Var7 string
+			templ_7745c5c3_Var7, templ_7745c5c3_Err = templ.JoinStringErrs(pc.T("blocks.mode_blocks"))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/admin/page_blocks.templ`, Line: 21, Col: 31}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var7))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			return nil
+		})
+		templ_7745c5c3_Err = button.Button(button.Props{Variant: button.VariantOutline, Size: button.SizeSm, Attributes: templ.Attributes{"data-editor-mode": editorModeBlocks, "aria-pressed": data.EditorMode == editorModeBlocks}}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var6), templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 4, "</div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+func pageBlockEditor(pc *PageContext, data PageFormViewData) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var8 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var8 == nil {
+			templ_7745c5c3_Var8 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 5, "<div id=\"block-editor\" class=\"block-editor\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if data.EditorMode != editorModeBlocks {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 6, " hidden")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, " data-labels=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var9 string
+		templ_7745c5c3_Var9, templ_7745c5c3_Err = templ.ResolveAttributeValue(data.BlockLabels)
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/admin/page_blocks.templ`, Line: 27, Col: 123}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var9)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "\" data-convert-url=\"/admin/pages/blocks/convert\"><span class=\"form-hint\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var10 string
+		templ_7745c5c3_Var10, templ_7745c5c3_Err = templ.JoinStringErrs(pc.T("blocks.hint"))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/admin/page_blocks.templ`, Line: 28, Col: 47}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var10))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, "</span> <input type=\"hidden\" id=\"blocks\" name=\"blocks\" value=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var11 string
+		templ_7745c5c3_Var11, templ_7745c5c3_Err = templ.ResolveAttributeValue(data.BlocksJSON)
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/admin/page_blocks.templ`, Line: 29, Col: 72}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var11)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "\"><div class=\"mt-2 space-y-3\" data-block-list></div><div class=\"mt-2 flex flex-wrap items-center gap-2\"><select class=\"form-select\" data-block-type aria-label=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var12 string
+		templ_7745c5c3_Var12, templ_7745c5c3_Err = templ.ResolveAttributeValue(pc.T("blocks.add_block"))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/admin/page_blocks.templ`, Line: 32, Col: 84}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var12)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, "\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		for _, t := range data.BlockTypes {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, "<option value=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var13 string
+			templ_7745c5c3_Var13, templ_7745c5c3_Err = templ.ResolveAttributeValue(t)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/admin/page_blocks.templ`, Line: 34, Col: 22}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var13)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var14 string
+			templ_7745c5c3_Var14, templ_7745c5c3_Err = templ.JoinStringErrs(pc.T("blocks.type_" + t))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/admin/page_blocks.templ`, Line: 34, Col: 51}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var14))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, "</option>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 15, "</select>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Var15 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+			templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+			if !templ_7745c5c3_IsBuffer {
+				defer func() {
+					templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+					if templ_7745c5c3_Err == nil {
+						templ_7745c5c3_Err = templ_7745c5c3_BufErr
+					}
+				}()
+			}
+			ctx = templ.InitializeContext(ctx)
+			templ_7745c5c3_Err = iconPlus14().Render(ctx, templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 16, " ")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var16 string
+			templ_7745c5c3_Var16, templ_7745c5c3_Err = templ.JoinStringErrs(pc.T("blocks.add_block"))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/admin/page_blocks.templ`, Line: 39, Col: 30}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var16))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			return nil
+		})
+		templ_7745c5c3_Err = button.Button(button.Props{Variant: button.VariantOutline, Size: button.SizeSm, Attributes: templ.Attributes{"data-block-add": true}}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var15), templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 17, "</div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if data.BlocksError != "" {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 18, "<span class=\"form-error mt-2\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var17 string
+			templ_7745c5c3_Var17, templ_7745c5c3_Err = templ.JoinStringErrs(data.BlocksError)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/admin/page_blocks.templ`, Line: 43, Col: 51}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var17))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 19, "</span>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 20, "</div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = pageBlockEditorScript().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+func pageBlockEditorScript() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var18 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var18 == nil {
+			templ_7745c5c3_Var18 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 21, "<script nonce=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var19 string
+		templ_7745c5c3_Var19, templ_7745c5c3_Err = templ.ResolveAttributeValue(templ.GetNonce(ctx))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/admin/page_blocks.templ`, Line: 50, Col: 36}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var19)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 22, "\">\n\t(function() {\n\t\tconst root = document.getElementById('block-editor');\n\t\tif (!root) return;\n\t\tconst L = JSON.parse(root.dataset.labels || '{}');\n\t\tconst modeInput = document.getElementById('editor_mode');\n\t\tconst store = document.getElementById('blocks');\n\t\tconst htmlPane = document.getElementById('html-editor-pane');\n\t\tconst list = root.querySelector('[data-block-list]');\n\t\tconst buttonClass = 'inline-flex items-center justify-center rounded-md text-sm font-medium border bg-background shadow-xs hover:bg-accent h-8 px-2 cursor-pointer disabled:opacity-50';\n\n\t\t// Inputs per block type: [property, kind].\n\t\tconst fields = {\n\t\t\tparagraph: [['text', 'textarea']],\n\t\t\theading: [['level', 'level'], ['text', 'input']],\n\t\t\timage: [['url', 'input'], ['alt', 'input'], ['caption', 'input']],\n\t\t\tgallery: [['images', 'images'], ['caption', 'input']],\n\t\t\tquote: [['text', 'textarea'], ['citation', 'input']],\n\t\t\tembed: [['url', 'input'], ['caption', 'input']],\n\t\t\tcode: [['language', 'input'], ['text', 'code']],\n\t\t\tcolumns: [],\n\t\t\tcta: [['text', 'textarea'], ['label', 'input'], ['url', 'input']],\n\t\t\tform: [['form', 'input'], ['label', 'input']],\n\t\t\thtml: [['html', 'code']]\n\t\t};\n\n\t\tlet doc = { version: 1, blocks: [] };\n\t\tlet parsed = true;\n\t\ttry {\n\t\t\tif (store.value) doc = JSON.parse(store.value);\n\t\t} catch (e) {\n\t\t\t// Keep the submitted document until the editor changes it.\n\t\t\tparsed = false;\n\t\t}\n\t\tif (!Array.isArray(doc.blocks)) doc.blocks = [];\n\n\t\tfunction save() {\n\t\t\tstore.value = JSON.stringify({ version: 1, blocks: doc.blocks });\n\t\t\tparsed = true;\n\t\t}\n\n\t\tfunction el(tag, cls, text) {\n\t\t\tconst e = document.createElement(tag);\n\t\t\tif (cls) e.className = cls;\n\t\t\tif (text) e.textContent = text;\n\t\t\treturn e;\n\t\t}\n\n\t\tfunction btn(text, title, action, disabled) {\n\t\t\tconst b = el('button', buttonClass, text);\n\t\t\tb.type = 'button';\n\t\t\tb.title = title;\n\t\t\tb.setAttribute('aria-label', title);\n\t\t\tb.disabled = !!disabled;\n\t\t\tb.addEventListener('click', function(e) { e.preventDefault(); action(); });\n\t\t\treturn b;\n\t\t}\n\n\t\tfunction newBlock(type) {\n\t\t\tconst b = { type: type };\n\t\t\tif (type === 'heading') b.level = 2;\n\t\t\tif (type === 'columns') b.columns = [[], []];\n\t\t\treturn b;\n\t\t}\n\n\t\tfunction typeSelect(nested) {\n\t\t\tconst s = el('select', 'form-select');\n\t\t\tObject.keys(fields).forEach(function(t) {\n\t\t\t\tif (nested && t === 'columns') return;\n\t\t\t\tconst o = el('option', '', L['type_' + t] || t);\n\t\t\t\to.value = t;\n\t\t\t\ts.appendChild(o);\n\t\t\t});\n\t\t\treturn s;\n\t\t}\n\n\t\tfunction field(block, key, kind) {\n\t\t\tconst wrap = el('label', 'block');\n\t\t\tconst labelKey = kind === 'code' && key === 'text' ? 'field_code' : 'field_' + key;\n\t\t\twrap.appendChild(el('span', 'mb-1 block text-sm', L[labelKey] || key));\n\t\t\tlet input;\n\t\t\tif (kind === 'level') {\n\t\t\t\tinput = el('select', 'form-select');\n\t\t\t\tfor (let i = 2; i <= 6; i++) {\n\t\t\t\t\tconst o = el('option', '', 'H' + i);\n\t\t\t\t\to.value = String(i);\n\t\t\t\t\to.selected = (block.level || 2) === i;\n\t\t\t\t\tinput.appendChild(o);\n\t\t\t\t}\n\t\t\t\tinput.addEventListener('change', function() { block.level = parseInt(input.value, 10); save(); });\n\t\t\t} else if (kind === 'images') {\n\t\t\t\tinput = el('textarea', 'form-textarea');\n\t\t\t\tinput.rows = 4;\n\t\t\t\tinput.value = (block.images || []).map(function(img) { return img.alt ? img.url + ' | ' + img.alt : img.url; }).join('\\n');\n\t\t\t\tinput.addEventListener('input', function() {\n\t\t\t\t\tblock.images = input.value.split('\\n').map(function(l) { return l.trim(); }).filter(Boolean).map(function(l) {\n\t\t\t\t\t\tconst parts = l.split('|');\n\t\t\t\t\t\treturn { url: parts[0].trim(), alt: parts.slice(1).join('|').trim() };\n\t\t\t\t\t});\n\t\t\t\t\tsave();\n\t\t\t\t});\n\t\t\t} else {\n\t\t\t\tinput = el(kind === 'input' ? 'input' : 'textarea', kind === 'input' ? 'form-input' : 'form-textarea');\n\t\t\t\tif (kind === 'input') input.type = 'text';\n\t\t\t\telse input.rows = kind === 'code' ? 8 : 4;\n\t\t\t\tif (kind === 'code') input.classList.add('font-mono');\n\t\t\t\tinput.value = block[key] || '';\n\t\t\t\tinput.addEventListener('input', function() { block[key] = input.value; save(); });\n\t\t\t}\n\t\t\twrap.appendChild(input);\n\t\t\treturn wrap;\n\t\t}\n\n\t\tfunction columnsEditor(block) {\n\t\t\tconst wrap = el('div', 'space-y-2');\n\t\t\tconst count = el('label', 'block');\n\t\t\tcount.appendChild(el('span', 'mb-1 block text-sm', L.field_columns));\n\t\t\tconst countSelect = el('select', 'form-select');\n\t\t\tfor (let n = 2; n <= 4; n++) {\n\t\t\t\tconst o = el('option', '', String(n));\n\t\t\t\to.value = String(n);\n\t\t\t\to.selected = block.columns.length === n;\n\t\t\t\tcountSelect.appendChild(o);\n\t\t\t}\n\t\t\tcountSelect.addEventListener('change', function() {\n\t\t\t\tconst n = parseInt(countSelect.value, 10);\n\t\t\t\twhile (block.columns.length < n) block.columns.push([]);\n\t\t\t\t// Blocks of removed columns move into the last remaining column.\n\t\t\t\twhile (block.columns.length > n) {\n\t\t\t\t\tconst removed = block.columns.pop();\n\t\t\t\t\tblock.columns[block.columns.length - 1] = block.columns[block.columns.length - 1].concat(removed);\n\t\t\t\t}\n\t\t\t\trerender();\n\t\t\t});\n\t\t\tcount.appendChild(countSelect);\n\t\t\twrap.appendChild(count);\n\n\t\t\tconst grid = el('div', 'grid gap-3');\n\t\t\tgrid.style.gridTemplateColumns = 'repeat(' + block.columns.length + ', minmax(0, 1fr))';\n\t\t\tblock.columns.forEach(function(col, i) {\n\t\t\t\tconst column = el('div', 'rounded-md border border-dashed border-gray-300 p-2 dark:border-gray-600');\n\t\t\t\tcolumn.appendChild(el('div', 'mb-2 text-sm font-medium', (L.column || 'Column') + ' ' + (i + 1)));\n\t\t\t\tconst items = el('div', 'space-y-2');\n\t\t\t\trenderList(items, col, true);\n\t\t\t\tcolumn.appendChild(items);\n\t\t\t\tconst add = el('div', 'mt-2 flex gap-2');\n\t\t\t\tconst select = typeSelect(true);\n\t\t\t\tadd.appendChild(select);\n\t\t\t\tadd.appendChild(btn('+', L.add_to_column, function() { col.push(newBlock(select.value)); rerender(); }));\n\t\t\t\tcolumn.appendChild(add);\n\t\t\t\tgrid.appendChild(column);\n\t\t\t});\n\t\t\twrap.appendChild(grid);\n\t\t\treturn wrap;\n\t\t}\n\n\t\tfunction renderList(container, arr, nested) {\n\t\t\tcontainer.replaceChildren();\n\t\t\tif (!arr.length && !nested) container.appendChild(el('p', 'form-hint', L.empty));\n\t\t\tarr.forEach(function(block, i) {\n\t\t\t\tconst card = el('div', 'rounded-md border border-gray-200 p-3 dark:border-gray-700');\n\t\t\t\tconst head = el('div', 'mb-2 flex items-center justify-between gap-2');\n\t\t\t\thead.appendChild(el('strong', 'text-sm', L['type_' + block.type] || block.type));\n\t\t\t\tconst tools = el('div', 'flex gap-1');\n\t\t\t\ttools.appendChild(btn('↑', L.move_up, function() { arr.splice(i - 1, 0, arr.splice(i, 1)[0]); rerender(); }, i === 0));\n\t\t\t\ttools.appendChild(btn('↓', L.move_down, function() { arr.splice(i + 1, 0, arr.splice(i, 1)[0]); rerender(); }, i === arr.length - 1));\n\t\t\t\ttools.appendChild(btn('×', L.remove, function() { arr.splice(i, 1); rerender(); }));\n\t\t\t\thead.appendChild(tools);\n\t\t\t\tcard.appendChild(head);\n\t\t\t\tconst body = el('div', 'space-y-2');\n\t\t\t\t(fields[block.type] || []).forEach(function(f) { body.appendChild(field(block, f[0], f[1])); });\n\t\t\t\tif (block.type === 'columns') body.appendChild(columnsEditor(block));\n\t\t\t\tcard.appendChild(body);\n\t\t\t\tcontainer.appendChild(card);\n\t\t\t});\n\t\t}\n\n\t\tfunction rerender() {\n\t\t\trenderList(list, doc.blocks, false);\n\t\t\tsave();\n\t\t}\n\n\t\tfunction setMode(mode) {\n\t\t\tmodeInput.value = mode;\n\t\t\troot.hidden = mode !== 'blocks';\n\t\t\tif (htmlPane) htmlPane.hidden = mode === 'blocks';\n\t\t\tdocument.querySelectorAll('[data-editor-mode]').forEach(function(b) {\n\t\t\t\tb.setAttribute('aria-pressed', String(b.dataset.editorMode === mode));\n\t\t\t});\n\t\t}\n\n\t\tfunction convert(payload) {\n\t\t\treturn fetch(root.dataset.convertUrl, {\n\t\t\t\tmethod: 'POST',\n\t\t\t\theaders: { 'Content-Type': 'application/json' },\n\t\t\t\tbody: JSON.stringify(payload)\n\t\t\t}).then(function(r) { return r.json(); }).then(function(data) {\n\t\t\t\tif (!data.success) throw new Error(data.error || '');\n\t\t\t\treturn data;\n\t\t\t});\n\t\t}\n\n\t\tfunction convertFailed(err) {\n\t\t\talert(L.convert_failed + (err && err.message ? ': ' + err.message : ''));\n\t\t}\n\n\t\tdocument.querySelectorAll('[data-editor-mode]').forEach(function(b) {\n\t\t\tb.addEventListener('click', function(e) {\n\t\t\t\te.preventDefault();\n\t\t\t\tconst target = b.dataset.editorMode;\n\t\t\t\tif (target === modeInput.value) return;\n\t\t\t\tconst editor = window.tinymce ? window.tinymce.get('editor') : null;\n\t\t\t\tconst textarea = document.getElementById('editor');\n\t\t\t\tif (target === 'blocks') {\n\t\t\t\t\tconvert({ to: 'blocks', html: editor ? editor.getContent() : textarea.value }).then(function(data) {\n\t\t\t\t\t\tdoc = data.blocks;\n\t\t\t\t\t\trerender();\n\t\t\t\t\t\tsetMode('blocks');\n\t\t\t\t\t}).catch(convertFailed);\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tconvert({ to: 'html', blocks: { version: 1, blocks: doc.blocks } }).then(function(data) {\n\t\t\t\t\tif (editor) editor.setContent(data.html);\n\t\t\t\t\ttextarea.value = data.html;\n\t\t\t\t\tsetMode('html');\n\t\t\t\t}).catch(convertFailed);\n\t\t\t});\n\t\t});\n\n\t\troot.querySelector('[data-block-add]').addEventListener('click', function(e) {\n\t\t\te.preventDefault();\n\t\t\tdoc.blocks.push(newBlock(root.querySelector('[data-block-type]').value));\n\t\t\trerender();\n\t\t});\n\n\t\tconst form = root.closest('form');\n\t\tif (form) form.addEventListener('submit', function() { if (parsed) save(); });\n\t\trenderList(list, doc.blocks, false);\n\t})();\n\t</script>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+var _ = templruntime.GeneratedTemplate
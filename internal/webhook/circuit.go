@@ -0,0 +1,180 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/model"
+	"github.com/olegiv/ocms-go/internal/store"
+)
+
+// pauseNotifyTimeout bounds notifying administrators of a paused webhook.
+const pauseNotifyTimeout = time.Minute
+
+// ErrWebhookPaused is recorded on deliveries that were not attempted because
+// the webhook's circuit breaker is open.
+var ErrWebhookPaused = errors.New("webhook paused: circuit breaker open")
+
+// PauseNotifier notifies administrators that the circuit breaker paused a
+// webhook.
+type PauseNotifier interface {
+	NotifyWebhookPaused(ctx context.Context, wh store.Webhook, reason string) error
+}
+
+// SetPauseNotifier sets the notifier told when a webhook is paused.
+func (d *Dispatcher) SetPauseNotifier(n PauseNotifier) {
+	d.notifier = n
+}
+
+// recordSuccess closes the failure streak for a webhook.
+func (d *Dispatcher) recordSuccess(ctx context.Context, webhookID int64) {
+	if err := d.queries.ResetWebhookFailures(ctx, webhookID); err != nil {
+		d.logger.Error("failed to reset webhook failure count", "error", err, "webhook_id", webhookID)
+	}
+}
+
+// recordFailure counts a failed attempt against the webhook's circuit breaker
+// and pauses the webhook once CircuitBreakerThreshold is reached.
+func (d *Dispatcher) recordFailure(ctx context.Context, webhookID int64, lastError string) {
+	threshold := d.config.CircuitBreakerThreshold
+	if threshold <= 0 {
+		return
+	}
+
+	failures, err := d.queries.RecordWebhookFailure(ctx, webhookID)
+	if err != nil {
+		d.logger.Error("failed to record webhook failure", "error", err, "webhook_id", webhookID)
+		return
+	}
+	if failures < int64(threshold) {
+		return
+	}
+
+	reason := fmt.Sprintf("%d consecutive failed deliveries", failures)
+	if lastError != "" {
+		reason += "; last error: " + lastError
+	}
+
+	paused, err := d.queries.PauseWebhook(ctx, store.PauseWebhookParams{
+		PausedAt:    sql.NullTime{Time: time.Now(), Valid: true},
+		PauseReason: reason,
+		ID:          webhookID,
+	})
+	if err != nil {
+		d.logger.Error("failed to pause webhook", "error", err, "webhook_id", webhookID)
+		return
+	}
+	if paused == 0 {
+		return // already paused by a concurrent worker
+	}
+
+	d.logger.Warn("webhook paused by circuit breaker",
+		"webhook_id", webhookID,
+		"consecutive_failures", failures,
+		"last_error", lastError)
+	_ = d.events.LogWebhookEvent(ctx, model.EventLevelWarning, "Webhook paused by circuit breaker", nil, "", "", map[string]any{
+		"webhook_id":           webhookID,
+		"consecutive_failures": failures,
+		"last_error":           lastError,
+	})
+
+	if d.notifier == nil {
+		return
+	}
+	// Notify in the background so a slow mail server does not hold up the
+	// delivery worker; Stop waits for the notification.
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.notifyPaused(context.WithoutCancel(ctx), webhookID, reason)
+	}()
+}
+
+// notifyPaused tells the notifier that a webhook was paused.
+func (d *Dispatcher) notifyPaused(ctx context.Context, webhookID int64, reason string) {
+	ctx, cancel := context.WithTimeout(ctx, pauseNotifyTimeout)
+	defer cancel()
+	wh, err := d.queries.GetWebhookByID(ctx, webhookID)
+	if err != nil {
+		d.logger.Error("failed to load paused webhook", "error", err, "webhook_id", webhookID)
+		return
+	}
+	if err := d.notifier.NotifyWebhookPaused(ctx, wh, reason); err != nil {
+		d.logger.Error("failed to notify administrators of paused webhook", "error", err, "webhook_id", webhookID)
+	}
+}
+
+// Replay resets dead or failed deliveries to pending and queues them for
+// immediate delivery. Deliveries whose webhook is inactive or paused are
+// skipped and stay in the dead-letter queue. It returns the number of
+// deliveries replayed.
+func (d *Dispatcher) Replay(ctx context.Context, deliveryIDs []int64) (int, error) {
+	d.mu.RLock()
+	running := d.running
+	d.mu.RUnlock()
+
+	webhooks := make(map[int64]store.Webhook)
+	replayed := 0
+	for _, id := range deliveryIDs {
+		delivery, err := d.queries.GetWebhookDelivery(ctx, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return replayed, fmt.Errorf("loading delivery %d: %w", id, err)
+		}
+		if delivery.Status != model.DeliveryStatusDead && delivery.Status != model.DeliveryStatusFailed {
+			continue
+		}
+
+		wh, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			wh, err = d.queries.GetWebhookByID(ctx, delivery.WebhookID)
+			if err != nil {
+				return replayed, fmt.Errorf("loading webhook %d: %w", delivery.WebhookID, err)
+			}
+			webhooks[wh.ID] = wh
+		}
+		if !wh.IsActive || wh.PausedAt.Valid {
+			continue
+		}
+
+		if err := d.queries.ResetDeliveryForRetry(ctx, store.ResetDeliveryForRetryParams{
+			ID:        delivery.ID,
+			UpdatedAt: time.Now(),
+		}); err != nil {
+			return replayed, fmt.Errorf("resetting delivery %d: %w", id, err)
+		}
+		replayed++
+
+		// Without running workers the retry worker picks the delivery up later.
+		if !running {
+			continue
+		}
+		qd := &QueuedDelivery{
+			DeliveryID: delivery.ID,
+			WebhookID:  wh.ID,
+			Event:      delivery.Event,
+			Payload:    []byte(delivery.Payload),
+			URL:        wh.Url,
+			Secret:     wh.Secret,
+			Headers:    webhookToModel(wh).GetHeaders(),
+		}
+		select {
+		case d.queue <- qd:
+		default:
+			d.logger.Warn("delivery queue full, replay will be picked up by retry worker", "delivery_id", delivery.ID)
+		}
+	}
+
+	if replayed > 0 {
+		d.logger.Info("webhook deliveries replayed", "count", replayed)
+	}
+	return replayed, nil
+}
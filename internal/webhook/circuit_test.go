@@ -0,0 +1,207 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/store"
+	"github.com/olegiv/ocms-go/internal/testutil"
+)
+
+// newCircuitTestDispatcher returns a dispatcher backed by a migrated database
+// and one active webhook.
+func newCircuitTestDispatcher(t *testing.T, threshold int) (*Dispatcher, store.Webhook) {
+	t.Helper()
+
+	db, cleanup := testutil.TestDB(t)
+	t.Cleanup(cleanup)
+
+	ctx := context.Background()
+	queries := store.New(db)
+	now := time.Now()
+	user, err := queries.CreateUser(ctx, store.CreateUserParams{
+		Email:        "circuit@example.com",
+		PasswordHash: "x",
+		Role:         "admin",
+		Name:         "Circuit",
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	wh, err := queries.CreateWebhook(ctx, store.CreateWebhookParams{
+		Name:          "Hook",
+		Url:           "https://hooks.example.com/in",
+		Secret:        "secret",
+		Events:        `["page.created"]`,
+		IsActive:      true,
+		Headers:       "{}",
+		PayloadFormat: "json",
+		CreatedBy:     user.ID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	})
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+
+	cfg := DefaultConfig()
+	cfg.EnableDebounce = false
+	cfg.CircuitBreakerThreshold = threshold
+	return NewDispatcher(db, testutil.TestLoggerSilent(), cfg), wh
+}
+
+func TestCircuitBreaker_PausesAfterThreshold(t *testing.T) {
+	d, wh := newCircuitTestDispatcher(t, 3)
+	ctx := context.Background()
+
+	d.recordFailure(ctx, wh.ID, "HTTP 500")
+	d.recordFailure(ctx, wh.ID, "HTTP 500")
+	got, _ := d.queries.GetWebhookByID(ctx, wh.ID)
+	if got.PausedAt.Valid {
+		t.Fatal("webhook paused before reaching the threshold")
+	}
+
+	// A success closes the streak.
+	d.recordSuccess(ctx, wh.ID)
+	got, _ = d.queries.GetWebhookByID(ctx, wh.ID)
+	if got.ConsecutiveFailures != 0 {
+		t.Fatalf("ConsecutiveFailures = %d after success, want 0", got.ConsecutiveFailures)
+	}
+
+	for range 3 {
+		d.recordFailure(ctx, wh.ID, "HTTP 503")
+	}
+	got, _ = d.queries.GetWebhookByID(ctx, wh.ID)
+	if !got.PausedAt.Valid {
+		t.Fatal("webhook not paused after reaching the threshold")
+	}
+	if got.PauseReason == "" {
+		t.Error("PauseReason is empty")
+	}
+}
+
+// recordingNotifier records the webhooks it is told about.
+type recordingNotifier struct {
+	paused  []store.Webhook
+	reasons []string
+}
+
+func (n *recordingNotifier) NotifyWebhookPaused(_ context.Context, wh store.Webhook, reason string) error {
+	n.paused = append(n.paused, wh)
+	n.reasons = append(n.reasons, reason)
+	return nil
+}
+
+func TestCircuitBreaker_NotifiesOnPause(t *testing.T) {
+	d, wh := newCircuitTestDispatcher(t, 2)
+	notifier := &recordingNotifier{}
+	d.SetPauseNotifier(notifier)
+	ctx := context.Background()
+
+	d.recordFailure(ctx, wh.ID, "HTTP 500")
+	if len(notifier.paused) != 0 {
+		t.Fatal("notified before the webhook was paused")
+	}
+	d.recordFailure(ctx, wh.ID, "HTTP 502")
+	// Failures of an already paused webhook don't notify again.
+	d.recordFailure(ctx, wh.ID, "HTTP 502")
+	d.wg.Wait() // the notification is sent in the background
+
+	if len(notifier.paused) != 1 {
+		t.Fatalf("notified %d times, want 1", len(notifier.paused))
+	}
+	if notifier.paused[0].ID != wh.ID || !notifier.paused[0].PausedAt.Valid {
+		t.Errorf("notified webhook = %+v, want paused webhook %d", notifier.paused[0], wh.ID)
+	}
+	if !strings.Contains(notifier.reasons[0], "HTTP 502") {
+		t.Errorf("reason = %q, want the last error", notifier.reasons[0])
+	}
+}
+
+// blockingNotifier blocks until it is released, like a slow mail server.
+type blockingNotifier struct {
+	release chan struct{}
+}
+
+func (n *blockingNotifier) NotifyWebhookPaused(context.Context, store.Webhook, string) error {
+	<-n.release
+	return nil
+}
+
+func TestCircuitBreaker_NotifiesInBackground(t *testing.T) {
+	d, wh := newCircuitTestDispatcher(t, 1)
+	notifier := &blockingNotifier{release: make(chan struct{})}
+	d.SetPauseNotifier(notifier)
+
+	done := make(chan struct{})
+	go func() {
+		d.recordFailure(context.Background(), wh.ID, "HTTP 500")
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("recordFailure waited for the notification")
+	}
+	close(notifier.release)
+	d.wg.Wait()
+}
+
+func TestCircuitBreaker_Disabled(t *testing.T) {
+	d, wh := newCircuitTestDispatcher(t, 0)
+	ctx := context.Background()
+
+	for range 20 {
+		d.recordFailure(ctx, wh.ID, "HTTP 500")
+	}
+	got, _ := d.queries.GetWebhookByID(ctx, wh.ID)
+	if got.PausedAt.Valid || got.ConsecutiveFailures != 0 {
+		t.Errorf("disabled breaker changed webhook: paused=%v failures=%d", got.PausedAt.Valid, got.ConsecutiveFailures)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	d, wh := newCircuitTestDispatcher(t, 3)
+	ctx := context.Background()
+	now := time.Now()
+
+	d.recordDeadDelivery(ctx, wh.ID, "page.created", []byte(`{"type":"page.created"}`), "HTTP 500", now)
+	deliveries, err := d.queries.ListWebhookDeliveries(ctx, store.ListWebhookDeliveriesParams{WebhookID: wh.ID, Limit: 10})
+	if err != nil || len(deliveries) != 1 {
+		t.Fatalf("ListWebhookDeliveries = %v, %v", deliveries, err)
+	}
+	id := deliveries[0].ID
+
+	// Paused webhooks keep their dead letters.
+	if _, err := d.queries.PauseWebhook(ctx, store.PauseWebhookParams{
+		PausedAt: sql.NullTime{Time: now, Valid: true},
+		ID:       wh.ID,
+	}); err != nil {
+		t.Fatalf("PauseWebhook: %v", err)
+	}
+	replayed, err := d.Replay(ctx, []int64{id})
+	if err != nil || replayed != 0 {
+		t.Fatalf("Replay on paused webhook = %d, %v; want 0, nil", replayed, err)
+	}
+
+	if err := d.queries.ResumeWebhook(ctx, store.ResumeWebhookParams{UpdatedAt: now, ID: wh.ID}); err != nil {
+		t.Fatalf("ResumeWebhook: %v", err)
+	}
+	replayed, err = d.Replay(ctx, []int64{id, id + 100})
+	if err != nil || replayed != 1 {
+		t.Fatalf("Replay = %d, %v; want 1, nil", replayed, err)
+	}
+
+	delivery, _ := d.queries.GetWebhookDelivery(ctx, id)
+	if delivery.Status != "pending" || delivery.Attempts != 0 {
+		t.Errorf("replayed delivery status=%q attempts=%d, want pending/0", delivery.Status, delivery.Attempts)
+	}
+}
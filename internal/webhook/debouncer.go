@@ -92,6 +92,31 @@ func eventKey(event *Event) string {
 		entityID = data.SubmissionID
 	case *FormEventData:
 		entityID = data.SubmissionID
+	case TagEventData:
+		entityID = data.ID
+	case *TagEventData:
+		entityID = data.ID
+	case CategoryEventData:
+		entityID = data.ID
+	case *CategoryEventData:
+		entityID = data.ID
+	case MenuEventData:
+		entityID = data.ID
+	case *MenuEventData:
+		entityID = data.ID
+	case TranslationEventData:
+		entityID = data.TranslationID
+	case *TranslationEventData:
+		entityID = data.TranslationID
+	case RedirectEventData:
+		entityID = data.ID
+	case *RedirectEventData:
+		entityID = data.ID
+	case ModuleEventData:
+		// Modules are keyed by name; toggling two modules must not coalesce.
+		return event.Type + ":" + data.Name
+	case *ModuleEventData:
+		return event.Type + ":" + data.Name
 	case map[string]any:
 		if id, ok := data["id"].(int64); ok {
 			entityID = id
@@ -91,11 +91,32 @@ func (d *Dispatcher) processDelivery(ctx context.Context, delivery *QueuedDelive
 		return
 	}
 
+	// Park the delivery in the dead-letter queue if the circuit opened
+	// while it was waiting in the queue.
+	webhook, err := d.queries.GetWebhookByID(ctx, delivery.WebhookID)
+	if err != nil {
+		d.logger.Error("failed to get webhook for delivery",
+			"error", err,
+			"webhook_id", delivery.WebhookID,
+			"delivery_id", delivery.DeliveryID)
+		return
+	}
+	if webhook.PausedAt.Valid {
+		_ = d.queries.UpdateDeliveryDead(ctx, store.UpdateDeliveryDeadParams{
+			ErrorMessage: sql.NullString{String: ErrWebhookPaused.Error(), Valid: true},
+			UpdatedAt:    time.Now(),
+			ID:           delivery.DeliveryID,
+		})
+		return
+	}
+
 	// Attempt the HTTP delivery
 	result := d.attemptDelivery(ctx, delivery)
 	now := time.Now()
 
 	if result.Success {
+		d.recordSuccess(ctx, delivery.WebhookID)
+
 		// Delivery succeeded
 		err = d.queries.UpdateDeliverySuccess(ctx, store.UpdateDeliverySuccessParams{
 			ResponseCode: sql.NullInt64{Int64: int64(result.StatusCode), Valid: true},
@@ -119,6 +140,11 @@ func (d *Dispatcher) processDelivery(ctx context.Context, delivery *QueuedDelive
 
 	// Delivery failed
 	newAttempts := record.Attempts + 1
+	lastErr := ""
+	if result.Error != nil {
+		lastErr = result.Error.Error()
+	}
+	d.recordFailure(ctx, delivery.WebhookID, lastErr)
 
 	if !result.ShouldRetry || newAttempts >= MaxAttempts {
 		// Mark as dead - no more retries
@@ -175,6 +201,86 @@ func (d *Dispatcher) processDelivery(ctx context.Context, delivery *QueuedDelive
 	}
 }
 
+// TestResult describes the outcome of a synchronous test delivery.
+type TestResult struct {
+	DeliveryID   int64
+	Payload      string
+	StatusCode   int
+	ResponseBody string
+	Error        string
+	Success      bool
+	Duration     time.Duration
+}
+
+// SendTest renders event in wh's payload format and delivers it synchronously,
+// bypassing the queue and retries. The attempt is recorded in the delivery
+// history. Rendering and HTTP failures are reported in the result; the error
+// return is reserved for database failures.
+func (d *Dispatcher) SendTest(ctx context.Context, wh store.Webhook, event *Event) (*TestResult, error) {
+	payload, err := RenderPayload(wh.PayloadFormat, wh.PayloadTemplate, event)
+	if err != nil {
+		return &TestResult{Error: "payload template: " + err.Error()}, nil
+	}
+
+	now := time.Now()
+	delivery, err := d.queries.CreateWebhookDelivery(ctx, store.CreateWebhookDeliveryParams{
+		WebhookID: wh.ID,
+		Event:     event.Type,
+		Payload:   string(payload),
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating test delivery: %w", err)
+	}
+
+	start := time.Now()
+	result := d.attemptDelivery(ctx, &QueuedDelivery{
+		DeliveryID: delivery.ID,
+		WebhookID:  wh.ID,
+		Event:      event.Type,
+		Payload:    payload,
+		URL:        wh.Url,
+		Secret:     wh.Secret,
+		Headers:    webhookToModel(wh).GetHeaders(),
+	})
+
+	test := &TestResult{
+		DeliveryID:   delivery.ID,
+		Payload:      string(payload),
+		StatusCode:   result.StatusCode,
+		ResponseBody: result.ResponseBody,
+		Success:      result.Success,
+		Duration:     time.Since(start),
+	}
+
+	now = time.Now()
+	if result.Success {
+		err = d.queries.UpdateDeliverySuccess(ctx, store.UpdateDeliverySuccessParams{
+			ResponseCode: sql.NullInt64{Int64: int64(result.StatusCode), Valid: true},
+			ResponseBody: sql.NullString{String: result.ResponseBody, Valid: true},
+			DeliveredAt:  sql.NullTime{Time: now, Valid: true},
+			UpdatedAt:    now,
+			ID:           delivery.ID,
+		})
+	} else {
+		if result.Error != nil {
+			test.Error = result.Error.Error()
+		}
+		// Test deliveries are never retried automatically.
+		err = d.queries.UpdateDeliveryDead(ctx, store.UpdateDeliveryDeadParams{
+			ErrorMessage: sql.NullString{String: test.Error, Valid: test.Error != ""},
+			UpdatedAt:    now,
+			ID:           delivery.ID,
+		})
+	}
+	if err != nil {
+		d.logger.Error("failed to record test delivery outcome", "error", err, "delivery_id", delivery.ID)
+	}
+
+	return test, nil
+}
+
 // attemptDelivery performs the actual HTTP POST request.
 func (d *Dispatcher) attemptDelivery(ctx context.Context, delivery *QueuedDelivery) DeliveryResult {
 	// Re-validate destination at dispatch time to enforce current outbound
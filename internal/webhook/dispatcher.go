@@ -14,6 +14,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/olegiv/ocms-go/internal/service"
 	"github.com/olegiv/ocms-go/internal/store"
 )
 
@@ -30,6 +31,8 @@ type Dispatcher struct {
 	running   bool
 	debouncer *Debouncer // Optional event debouncer for batching rapid-fire events
 	config    Config
+	events    *service.EventService
+	notifier  PauseNotifier // Optional; told when the circuit breaker pauses a webhook
 }
 
 // QueuedDelivery represents a delivery queued for processing.
@@ -48,6 +51,10 @@ type Config struct {
 	Workers        int            // Number of concurrent delivery workers
 	EnableDebounce bool           // Enable event debouncing
 	Debounce       DebounceConfig // Debounce configuration
+
+	// CircuitBreakerThreshold is the number of consecutive failed attempts
+	// after which a webhook is paused. Zero disables the circuit breaker.
+	CircuitBreakerThreshold int
 }
 
 // DefaultConfig returns default dispatcher configuration.
@@ -56,6 +63,8 @@ func DefaultConfig() Config {
 		Workers:        3,
 		EnableDebounce: true,
 		Debounce:       DefaultDebounceConfig(),
+
+		CircuitBreakerThreshold: DefaultCircuitBreakerThreshold,
 	}
 }
 
@@ -76,6 +85,7 @@ func NewDispatcher(db *sql.DB, logger *slog.Logger, cfg Config) *Dispatcher {
 		workers: cfg.Workers,
 		done:    make(chan struct{}),
 		config:  cfg,
+		events:  service.NewEventService(db),
 	}
 
 	// Initialize debouncer if enabled
@@ -94,6 +104,10 @@ const (
 	DeliveryRetention = 30 * 24 * time.Hour // How long to keep delivered/dead entries (30 days)
 )
 
+// DefaultCircuitBreakerThreshold is the default number of consecutive failed
+// delivery attempts after which a webhook is paused.
+const DefaultCircuitBreakerThreshold = 10
+
 // Start starts the dispatcher workers.
 func (d *Dispatcher) Start(ctx context.Context) {
 	d.mu.Lock()
@@ -230,7 +244,15 @@ func (d *Dispatcher) processRetries(ctx context.Context) {
 			continue
 		}
 
-		// Check if webhook is still active
+		// Check if webhook is still active and its circuit is closed
+		if webhook.PausedAt.Valid {
+			_ = d.queries.UpdateDeliveryDead(ctx, store.UpdateDeliveryDeadParams{
+				ErrorMessage: sql.NullString{String: ErrWebhookPaused.Error(), Valid: true},
+				UpdatedAt:    now,
+				ID:           delivery.ID,
+			})
+			continue
+		}
 		if !webhook.IsActive {
 			d.logger.Debug("skipping retry for inactive webhook",
 				"webhook_id", webhook.ID,
@@ -307,13 +329,6 @@ func (d *Dispatcher) Dispatch(ctx context.Context, event *Event) error {
 		return nil
 	}
 
-	// Serialize the event payload
-	payload, err := json.Marshal(event)
-	if err != nil {
-		d.logger.Error("failed to marshal event payload", "error", err, "event_type", event.Type)
-		return err
-	}
-
 	now := time.Now()
 
 	// Create delivery records for each webhook
@@ -324,6 +339,26 @@ func (d *Dispatcher) Dispatch(ctx context.Context, event *Event) error {
 			continue
 		}
 
+		// Render the body in the webhook's payload format. The rendered body is
+		// stored on the delivery so retries resend (and re-sign) the same bytes.
+		payload, err := RenderPayload(wh.PayloadFormat, wh.PayloadTemplate, event)
+		if err != nil {
+			d.logger.Error("failed to render webhook payload",
+				"error", err,
+				"webhook_id", wh.ID,
+				"event_type", event.Type)
+			raw, _ := json.Marshal(event)
+			d.recordDeadDelivery(ctx, wh.ID, event.Type, raw, "payload template: "+err.Error(), now)
+			continue
+		}
+
+		// A paused webhook keeps its events in the dead-letter queue so they
+		// can be replayed once the endpoint recovers.
+		if wh.PausedAt.Valid {
+			d.recordDeadDelivery(ctx, wh.ID, event.Type, payload, ErrWebhookPaused.Error(), now)
+			continue
+		}
+
 		// Create delivery record
 		delivery, err := d.queries.CreateWebhookDelivery(ctx, store.CreateWebhookDeliveryParams{
 			WebhookID: wh.ID,
@@ -368,6 +403,30 @@ func (d *Dispatcher) Dispatch(ctx context.Context, event *Event) error {
 	return nil
 }
 
+// recordDeadDelivery stores a delivery that is dead on arrival (payload
+// rendering failed or the webhook is paused) so it shows up in the
+// dead-letter queue.
+func (d *Dispatcher) recordDeadDelivery(ctx context.Context, webhookID int64, eventType string, payload []byte, reason string, now time.Time) {
+	delivery, err := d.queries.CreateWebhookDelivery(ctx, store.CreateWebhookDeliveryParams{
+		WebhookID: webhookID,
+		Event:     eventType,
+		Payload:   string(payload),
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		d.logger.Error("failed to create delivery record", "error", err, "webhook_id", webhookID, "event_type", eventType)
+		return
+	}
+	if err := d.queries.UpdateDeliveryDead(ctx, store.UpdateDeliveryDeadParams{
+		ErrorMessage: sql.NullString{String: reason, Valid: true},
+		UpdatedAt:    now,
+		ID:           delivery.ID,
+	}); err != nil {
+		d.logger.Error("failed to update delivery as dead", "error", err, "delivery_id", delivery.ID)
+	}
+}
+
 // DispatchEvent is a convenience method to dispatch an event with the given type and data.
 // If debouncing is enabled, events will be debounced; otherwise, they are dispatched immediately.
 func (d *Dispatcher) DispatchEvent(ctx context.Context, eventType string, data any) error {
@@ -6,6 +6,8 @@ package webhook
 
 import (
 	"time"
+
+	"github.com/olegiv/ocms-go/internal/store"
 )
 
 // Event represents a webhook event to be dispatched.
@@ -36,6 +38,25 @@ type PageEventData struct {
 	PublishedAt  *string `json:"published_at,omitempty"`
 }
 
+// NewPageEventData builds the page event payload from a stored page.
+func NewPageEventData(page store.Page, authorEmail string) PageEventData {
+	data := PageEventData{
+		ID:          page.ID,
+		Title:       page.Title,
+		Slug:        page.Slug,
+		Status:      page.Status,
+		AuthorID:    page.AuthorID,
+		AuthorEmail: authorEmail,
+	}
+	if page.LanguageCode != "" {
+		data.LanguageCode = new(page.LanguageCode)
+	}
+	if page.PublishedAt.Valid {
+		data.PublishedAt = new(page.PublishedAt.Time.Format(time.RFC3339))
+	}
+	return data
+}
+
 // MediaEventData contains data for media-related events.
 type MediaEventData struct {
 	ID         int64  `json:"id"`
@@ -46,6 +67,18 @@ type MediaEventData struct {
 	UploaderID int64  `json:"uploader_id"`
 }
 
+// NewMediaEventData builds the media event payload from a stored media item.
+func NewMediaEventData(media store.Medium) MediaEventData {
+	return MediaEventData{
+		ID:         media.ID,
+		UUID:       media.Uuid,
+		Filename:   media.Filename,
+		MimeType:   media.MimeType,
+		Size:       media.Size,
+		UploaderID: media.UploadedBy,
+	}
+}
+
 // FormEventData contains data for form submission events.
 type FormEventData struct {
 	FormID       int64             `json:"form_id"`
@@ -70,3 +103,187 @@ type TestEventData struct {
 	Message   string    `json:"message"`
 	Timestamp time.Time `json:"timestamp"`
 }
+
+// TagEventData contains data for tag-related events.
+type TagEventData struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	LanguageCode string `json:"language_code"`
+}
+
+// NewTagEventData builds the tag event payload from a stored tag.
+func NewTagEventData(tag store.Tag) TagEventData {
+	return TagEventData{
+		ID:           tag.ID,
+		Name:         tag.Name,
+		Slug:         tag.Slug,
+		LanguageCode: tag.LanguageCode,
+	}
+}
+
+// CategoryEventData contains data for category-related events.
+type CategoryEventData struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	ParentID     *int64 `json:"parent_id,omitempty"`
+	LanguageCode string `json:"language_code"`
+}
+
+// NewCategoryEventData builds the category event payload from a stored category.
+func NewCategoryEventData(category store.Category) CategoryEventData {
+	data := CategoryEventData{
+		ID:           category.ID,
+		Name:         category.Name,
+		Slug:         category.Slug,
+		LanguageCode: category.LanguageCode,
+	}
+	if category.ParentID.Valid {
+		data.ParentID = new(category.ParentID.Int64)
+	}
+	return data
+}
+
+// Menu change kinds reported in MenuEventData.Change for menu.updated events.
+const (
+	MenuChangeDetails        = "details"
+	MenuChangeItemAdded      = "item_added"
+	MenuChangeItemUpdated    = "item_updated"
+	MenuChangeItemDeleted    = "item_deleted"
+	MenuChangeItemsReordered = "items_reordered"
+)
+
+// MenuEventData contains data for menu-related events.
+type MenuEventData struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	LanguageCode string `json:"language_code"`
+	Change       string `json:"change,omitempty"`
+	ItemID       int64  `json:"item_id,omitempty"`
+}
+
+// NewMenuEventData builds the menu event payload from a stored menu.
+func NewMenuEventData(menu store.Menu, change string) MenuEventData {
+	return MenuEventData{
+		ID:           menu.ID,
+		Name:         menu.Name,
+		Slug:         menu.Slug,
+		LanguageCode: menu.LanguageCode,
+		Change:       change,
+	}
+}
+
+// TranslationEventData contains data for translation link events.
+// EntityID is the source entity and TranslationID the translated entity.
+type TranslationEventData struct {
+	EntityType    string `json:"entity_type"`
+	EntityID      int64  `json:"entity_id"`
+	TranslationID int64  `json:"translation_id"`
+	LanguageCode  string `json:"language_code"`
+}
+
+// RedirectEventData contains data for redirect-related events.
+type RedirectEventData struct {
+	ID         int64  `json:"id"`
+	SourcePath string `json:"source_path"`
+	TargetURL  string `json:"target_url"`
+	StatusCode int64  `json:"status_code"`
+	IsWildcard bool   `json:"is_wildcard"`
+	IsRegex    bool   `json:"is_regex"`
+	DropQuery  bool   `json:"drop_query"`
+	Enabled    bool   `json:"enabled"`
+}
+
+// NewRedirectEventData builds the redirect event payload from a stored redirect.
+func NewRedirectEventData(redirect store.Redirect) RedirectEventData {
+	return RedirectEventData{
+		ID:         redirect.ID,
+		SourcePath: redirect.SourcePath,
+		TargetURL:  redirect.TargetUrl,
+		StatusCode: redirect.StatusCode,
+		IsWildcard: redirect.IsWildcard,
+		IsRegex:    redirect.IsRegex,
+		DropQuery:  redirect.DropQuery,
+		Enabled:    redirect.Enabled,
+	}
+}
+
+// CommentEventData contains data for comment events. The author's email and
+// IP address are not included.
+type CommentEventData struct {
+	ID         int64  `json:"id"`
+	PageID     int64  `json:"page_id"`
+	PageTitle  string `json:"page_title"`
+	ParentID   *int64 `json:"parent_id,omitempty"`
+	UserID     *int64 `json:"user_id,omitempty"`
+	AuthorName string `json:"author_name"`
+	Body       string `json:"body"`
+	Status     string `json:"status"`
+}
+
+// NewCommentEventData builds the comment event payload from a stored comment
+// and the title of its page.
+func NewCommentEventData(comment store.Comment, pageTitle string) CommentEventData {
+	data := CommentEventData{
+		ID:         comment.ID,
+		PageID:     comment.PageID,
+		PageTitle:  pageTitle,
+		AuthorName: comment.AuthorName,
+		Body:       comment.Body,
+		Status:     comment.Status,
+	}
+	if comment.ParentID.Valid {
+		data.ParentID = new(comment.ParentID.Int64)
+	}
+	if comment.UserID.Valid {
+		data.UserID = new(comment.UserID.Int64)
+	}
+	return data
+}
+
+// LinkEventData contains data for broken link events.
+type LinkEventData struct {
+	URL         string `json:"url"`
+	SourceType  string `json:"source_type"`
+	SourceID    int64  `json:"source_id"`
+	SourceTitle string `json:"source_title"`
+	AnchorText  string `json:"anchor_text"`
+	StatusCode  int64  `json:"status_code,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// NewLinkEventData builds the broken link event payload from a link check result.
+func NewLinkEventData(check store.LinkCheck) LinkEventData {
+	return LinkEventData{
+		URL:         check.Url,
+		SourceType:  check.SourceType,
+		SourceID:    check.SourceID,
+		SourceTitle: check.SourceTitle,
+		AnchorText:  check.AnchorText,
+		StatusCode:  check.StatusCode,
+		Error:       check.Error,
+	}
+}
+
+// ConfigEventData contains data for configuration update events.
+// Only the changed keys are reported; values may hold secrets.
+type ConfigEventData struct {
+	Keys      []string `json:"keys"`
+	UpdatedBy int64    `json:"updated_by,omitempty"`
+}
+
+// ThemeEventData contains data for theme activation events.
+type ThemeEventData struct {
+	Name         string `json:"name"`
+	PreviousName string `json:"previous_name,omitempty"`
+	ActivatedBy  int64  `json:"activated_by,omitempty"`
+}
+
+// ModuleEventData contains data for module activation events.
+type ModuleEventData struct {
+	Name      string `json:"name"`
+	Active    bool   `json:"active"`
+	ChangedBy int64  `json:"changed_by,omitempty"`
+}
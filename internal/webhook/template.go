@@ -0,0 +1,187 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/model"
+)
+
+// MaxPayloadTemplateLen is the maximum length of a custom payload template.
+const MaxPayloadTemplateLen = 16 * 1024
+
+// MaxRenderedPayloadSize is the maximum size of a payload rendered from a
+// template. Rendering stops as soon as a template writes more.
+const MaxRenderedPayloadSize = 64 * 1024
+
+// ErrInvalidPayloadJSON is returned when a rendered payload is not valid JSON.
+var ErrInvalidPayloadJSON = errors.New("rendered payload is not valid JSON")
+
+// ErrPayloadTooLarge is returned when a template renders more than
+// MaxRenderedPayloadSize bytes.
+var ErrPayloadTooLarge = fmt.Errorf("rendered payload exceeds %d bytes", MaxRenderedPayloadSize)
+
+// presetTemplates holds the built-in payload templates for chat services.
+var presetTemplates = map[string]string{
+	model.WebhookFormatSlack:   `{"text": {{ json .Summary }}}`,
+	model.WebhookFormatDiscord: `{"content": {{ json .Summary }}}`,
+	model.WebhookFormatTeams: `{"@type": "MessageCard", "@context": "https://schema.org/extensions", ` +
+		`"summary": {{ json .Summary }}, "title": {{ json .Type }}, "text": {{ json .Summary }}}`,
+}
+
+// summaryLabelKeys lists event data fields, in priority order, used to
+// describe the affected entity in a human-readable summary.
+var summaryLabelKeys = []string{"title", "name", "filename", "form_name", "source_path", "email", "message"}
+
+// TemplateData is the value passed to payload templates.
+type TemplateData struct {
+	Type      string         // Event type, e.g. "page.published"
+	Timestamp string         // Event time in RFC 3339 format
+	Data      map[string]any // Event data as decoded from its JSON form
+	Summary   string         // One-line human-readable description
+}
+
+// templateFuncs are the functions available to payload templates.
+var templateFuncs = template.FuncMap{
+	// json encodes a value as JSON, e.g. {{ json .Data.title }} yields a quoted string.
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	},
+}
+
+// PresetTemplate returns the built-in template for a chat preset format.
+func PresetTemplate(format string) (string, bool) {
+	tmpl, ok := presetTemplates[format]
+	return tmpl, ok
+}
+
+// RenderPayload renders the HTTP body for event according to a webhook's
+// payload format. The default format is the signed webhook.Event JSON;
+// other formats render a Go text/template whose output must be valid JSON.
+func RenderPayload(format, tmpl string, event *Event) ([]byte, error) {
+	switch format {
+	case "", model.WebhookFormatJSON:
+		return json.Marshal(event)
+	case model.WebhookFormatCustom:
+		return renderTemplate(tmpl, event)
+	default:
+		preset, ok := presetTemplates[format]
+		if !ok {
+			return nil, fmt.Errorf("unknown payload format %q", format)
+		}
+		return renderTemplate(preset, event)
+	}
+}
+
+// ValidatePayloadTemplate parses tmpl and renders it against a sample event
+// to make sure it produces valid JSON.
+func ValidatePayloadTemplate(tmpl string) error {
+	if strings.TrimSpace(tmpl) == "" {
+		return errors.New("template is required")
+	}
+	if len(tmpl) > MaxPayloadTemplateLen {
+		return fmt.Errorf("template must be less than %d characters", MaxPayloadTemplateLen)
+	}
+	_, err := renderTemplate(tmpl, NewEvent("test", TestEventData{
+		Message:   "This is a test webhook delivery",
+		Timestamp: time.Now().UTC(),
+	}))
+	return err
+}
+
+// renderTemplate executes a payload template for event.
+func renderTemplate(tmpl string, event *Event) ([]byte, error) {
+	t, err := template.New("payload").Funcs(templateFuncs).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("parse template: %w", err)
+	}
+
+	data, err := newTemplateData(event)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &limitedBuffer{limit: MaxRenderedPayloadSize}
+	if err := t.Execute(buf, data); err != nil {
+		if errors.Is(err, ErrPayloadTooLarge) {
+			return nil, ErrPayloadTooLarge
+		}
+		return nil, fmt.Errorf("execute template: %w", err)
+	}
+
+	out := bytes.TrimSpace(buf.Bytes())
+	if !json.Valid(out) {
+		return nil, ErrInvalidPayloadJSON
+	}
+	return out, nil
+}
+
+// limitedBuffer is a bytes.Buffer that fails writes beyond limit, which
+// aborts template execution.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.limit {
+		return 0, ErrPayloadTooLarge
+	}
+	return b.Buffer.Write(p)
+}
+
+// newTemplateData converts event into TemplateData. Event data is round-tripped
+// through JSON so templates address fields by their JSON names (.Data.title).
+func newTemplateData(event *Event) (TemplateData, error) {
+	data := make(map[string]any)
+	if event.Data != nil {
+		raw, err := json.Marshal(event.Data)
+		if err != nil {
+			return TemplateData{}, fmt.Errorf("marshal event data: %w", err)
+		}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			// Non-object data is exposed under a single key.
+			var v any
+			_ = json.Unmarshal(raw, &v)
+			data = map[string]any{"value": v}
+		}
+	}
+
+	return TemplateData{
+		Type:      event.Type,
+		Timestamp: event.Timestamp.UTC().Format(time.RFC3339),
+		Data:      data,
+		Summary:   summarizeEvent(event.Type, data),
+	}, nil
+}
+
+// summarizeEvent builds a short description such as
+// "[oCMS] page.published: About us".
+func summarizeEvent(eventType string, data map[string]any) string {
+	summary := "[oCMS] " + eventType
+	for _, key := range summaryLabelKeys {
+		if s, ok := data[key].(string); ok && s != "" {
+			return summary + ": " + s
+		}
+	}
+	if keys, ok := data["keys"].([]any); ok && len(keys) > 0 {
+		parts := make([]string, 0, len(keys))
+		for _, k := range keys {
+			parts = append(parts, fmt.Sprint(k))
+		}
+		return summary + ": " + strings.Join(parts, ", ")
+	}
+	return summary
+}
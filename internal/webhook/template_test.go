@@ -0,0 +1,164 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/model"
+)
+
+func testPageEvent() *Event {
+	return &Event{
+		Type:      model.EventPagePublished,
+		Timestamp: time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC),
+		Data:      PageEventData{ID: 7, Title: "About \"us\"", Slug: "about", Status: "published"},
+	}
+}
+
+func TestRenderPayload_DefaultJSON(t *testing.T) {
+	event := testPageEvent()
+	want, _ := json.Marshal(event)
+
+	for _, format := range []string{"", model.WebhookFormatJSON} {
+		got, err := RenderPayload(format, "ignored", event)
+		if err != nil {
+			t.Fatalf("RenderPayload(%q): %v", format, err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("RenderPayload(%q) = %s, want %s", format, got, want)
+		}
+	}
+}
+
+func TestRenderPayload_Presets(t *testing.T) {
+	event := testPageEvent()
+	wantSummary := `[oCMS] page.published: About "us"`
+
+	tests := []struct {
+		format string
+		key    string
+	}{
+		{model.WebhookFormatSlack, "text"},
+		{model.WebhookFormatDiscord, "content"},
+		{model.WebhookFormatTeams, "text"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			got, err := RenderPayload(tt.format, "", event)
+			if err != nil {
+				t.Fatalf("RenderPayload: %v", err)
+			}
+			var body map[string]any
+			if err := json.Unmarshal(got, &body); err != nil {
+				t.Fatalf("invalid JSON %s: %v", got, err)
+			}
+			if body[tt.key] != wantSummary {
+				t.Errorf("%s = %v, want %q", tt.key, body[tt.key], wantSummary)
+			}
+		})
+	}
+
+	got, _ := RenderPayload(model.WebhookFormatTeams, "", event)
+	var card map[string]any
+	_ = json.Unmarshal(got, &card)
+	if card["@type"] != "MessageCard" || card["title"] != model.EventPagePublished {
+		t.Errorf("unexpected Teams card: %s", got)
+	}
+}
+
+func TestRenderPayload_Custom(t *testing.T) {
+	tmpl := `{"event": {{ json .Type }}, "at": {{ json .Timestamp }}, "page": {{ json .Data.slug }}, "id": {{ .Data.id }}}`
+
+	got, err := RenderPayload(model.WebhookFormatCustom, tmpl, testPageEvent())
+	if err != nil {
+		t.Fatalf("RenderPayload: %v", err)
+	}
+	want := `{"event": "page.published", "at": "2026-10-18T09:00:00Z", "page": "about", "id": 7}`
+	if string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestRenderPayload_Errors(t *testing.T) {
+	event := testPageEvent()
+
+	if _, err := RenderPayload(model.WebhookFormatCustom, `{"title": {{ .Data.title }}}`, event); !errors.Is(err, ErrInvalidPayloadJSON) {
+		t.Errorf("unquoted string: err = %v, want ErrInvalidPayloadJSON", err)
+	}
+	if _, err := RenderPayload(model.WebhookFormatCustom, `{{ .Type `, event); err == nil || !strings.Contains(err.Error(), "parse template") {
+		t.Errorf("bad syntax: err = %v, want parse error", err)
+	}
+	if _, err := RenderPayload("xml", "", event); err == nil {
+		t.Error("unknown format: expected error")
+	}
+}
+
+func TestRenderPayload_OutputLimit(t *testing.T) {
+	event := testPageEvent()
+
+	// One huge write and many small ones both stop at the limit.
+	for name, tmpl := range map[string]string{
+		"printf": `{"text": "{{ printf "%070000d" 1 }}"}`,
+		"range":  `{"text": "{{ range $i, $_ := .Data }}{{ range $j, $_ := $.Data }}{{ printf "%5000s" $.Summary }}{{ end }}{{ end }}"}`,
+	} {
+		if _, err := RenderPayload(model.WebhookFormatCustom, tmpl, event); !errors.Is(err, ErrPayloadTooLarge) {
+			t.Errorf("%s: err = %v, want ErrPayloadTooLarge", name, err)
+		}
+	}
+
+	tmpl := `{"text": "{{ printf "%060000d" 1 }}"}`
+	if _, err := RenderPayload(model.WebhookFormatCustom, tmpl, event); err != nil {
+		t.Errorf("payload under the limit: err = %v", err)
+	}
+}
+
+func TestValidatePayloadTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		tmpl    string
+		wantErr bool
+	}{
+		{"valid", `{"msg": {{ json .Summary }}}`, false},
+		{"empty", "  ", true},
+		{"not json", `hello {{ .Type }}`, true},
+		{"parse error", `{{ if }}`, true},
+		{"too long", `{"a": "` + strings.Repeat("x", MaxPayloadTemplateLen) + `"}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePayloadTemplate(tt.tmpl)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePayloadTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSummarizeEvent(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]any
+		want string
+	}{
+		{"title wins", map[string]any{"title": "Home", "name": "x"}, "[oCMS] e: Home"},
+		{"filename", map[string]any{"filename": "a.png"}, "[oCMS] e: a.png"},
+		{"config keys", map[string]any{"keys": []any{"site_name", "site_url"}}, "[oCMS] e: site_name, site_url"},
+		{"no label", map[string]any{"id": 1.0}, "[oCMS] e"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := summarizeEvent("e", tt.data); got != tt.want {
+				t.Errorf("summarizeEvent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ package webhook
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net"
@@ -1186,3 +1188,60 @@ func TestShouldRetryURLValidationError_WrappedNetError(t *testing.T) {
 		t.Error("shouldRetryURLValidationError() should return true for wrapped DNS timeout error")
 	}
 }
+
+// ---------------------------------------------------------------------------
+// Event payload builders
+// ---------------------------------------------------------------------------
+
+func TestNewPageEventData(t *testing.T) {
+	published := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	data := NewPageEventData(store.Page{
+		ID:           7,
+		Title:        "Hello",
+		Slug:         "hello",
+		Status:       "published",
+		AuthorID:     2,
+		LanguageCode: "en",
+		PublishedAt:  sql.NullTime{Time: published, Valid: true},
+	}, "admin@example.com")
+
+	if data.ID != 7 || data.Slug != "hello" || data.AuthorEmail != "admin@example.com" {
+		t.Errorf("NewPageEventData() = %+v", data)
+	}
+	if data.LanguageCode == nil || *data.LanguageCode != "en" {
+		t.Errorf("LanguageCode = %v, want en", data.LanguageCode)
+	}
+	if data.PublishedAt == nil || *data.PublishedAt != published.Format(time.RFC3339) {
+		t.Errorf("PublishedAt = %v, want %s", data.PublishedAt, published.Format(time.RFC3339))
+	}
+
+	draft := NewPageEventData(store.Page{ID: 8}, "")
+	if draft.LanguageCode != nil || draft.PublishedAt != nil {
+		t.Errorf("draft page payload should omit language and publish time, got %+v", draft)
+	}
+}
+
+func TestNewCategoryEventData_ParentID(t *testing.T) {
+	root := NewCategoryEventData(store.Category{ID: 1, Slug: "news"})
+	if root.ParentID != nil {
+		t.Errorf("root category ParentID = %v, want nil", *root.ParentID)
+	}
+
+	child := NewCategoryEventData(store.Category{ID: 2, ParentID: sql.NullInt64{Int64: 1, Valid: true}})
+	if child.ParentID == nil || *child.ParentID != 1 {
+		t.Errorf("child category ParentID = %v, want 1", child.ParentID)
+	}
+}
+
+func TestConfigEventData_OmitsValues(t *testing.T) {
+	payload, err := json.Marshal(NewEvent("config.updated", ConfigEventData{Keys: []string{"site_name"}, UpdatedBy: 1}))
+	if err != nil {
+		t.Fatalf("json.Marshal() error: %v", err)
+	}
+	if strings.Contains(string(payload), "value") {
+		t.Errorf("config payload must not carry values: %s", payload)
+	}
+	if !strings.Contains(string(payload), `"keys":["site_name"]`) {
+		t.Errorf("config payload missing keys: %s", payload)
+	}
+}
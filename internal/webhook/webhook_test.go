@@ -285,6 +285,26 @@ func TestEventKey(t *testing.T) {
 			}),
 			expected: "custom.event:888",
 		},
+		{
+			name:     "TagEventData",
+			event:    NewEvent("tag.updated", TagEventData{ID: 12, Slug: "go"}),
+			expected: "tag.updated:12",
+		},
+		{
+			name:     "MenuEventData pointer",
+			event:    NewEvent("menu.updated", &MenuEventData{ID: 3, Change: MenuChangeItemAdded}),
+			expected: "menu.updated:3",
+		},
+		{
+			name:     "TranslationEventData keyed by translation",
+			event:    NewEvent("translation.linked", TranslationEventData{EntityType: "page", EntityID: 1, TranslationID: 9}),
+			expected: "translation.linked:9",
+		},
+		{
+			name:     "ModuleEventData keyed by name",
+			event:    NewEvent("module.activated", ModuleEventData{Name: "sentinel", Active: true}),
+			expected: "module.activated:sentinel",
+		},
 		{
 			name:     "unknown type",
 			event:    NewEvent("unknown.event", "string data"),
@@ -0,0 +1,174 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package widget
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	// MaxVisibilityValues caps the values of each visibility rule.
+	MaxVisibilityValues = 50
+	// maxPathPatternLen caps the length of a URL pattern.
+	maxPathPatternLen = 255
+)
+
+var (
+	pageTypePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,49}$`)
+	langPattern     = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})?$`)
+)
+
+// Visibility limits the pages a widget is shown on. Each non-empty rule must
+// match: the page type, one of the categories, the language and the URL.
+// A zero Visibility shows the widget everywhere.
+//
+// Paths are URL patterns where "*" matches any characters, including "/".
+// Patterns starting with "!" exclude URLs; when only exclusions are given,
+// all other URLs match. Patterns are matched against the request path with
+// its language prefix, e.g. "/blog*" or "!/ru/*".
+type Visibility struct {
+	PageTypes   []string `json:"page_types,omitempty"`
+	CategoryIDs []int64  `json:"category_ids,omitempty"`
+	Languages   []string `json:"languages,omitempty"`
+	Paths       []string `json:"paths,omitempty"`
+}
+
+// ParseVisibility decodes stored visibility rules. Empty input yields a zero
+// Visibility.
+func ParseVisibility(raw string) (Visibility, error) {
+	var v Visibility
+	if strings.TrimSpace(raw) == "" {
+		return v, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return Visibility{}, fmt.Errorf("invalid visibility rules: %w", err)
+	}
+	return v, nil
+}
+
+// IsZero reports whether v has no rules.
+func (v Visibility) IsZero() bool {
+	return len(v.PageTypes) == 0 && len(v.CategoryIDs) == 0 && len(v.Languages) == 0 && len(v.Paths) == 0
+}
+
+// Encode encodes v for storage.
+func (v Visibility) Encode() string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// Normalize trims and deduplicates the rules and validates their values.
+func (v Visibility) Normalize() (Visibility, error) {
+	var out Visibility
+	for _, t := range v.PageTypes {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || slices.Contains(out.PageTypes, t) {
+			continue
+		}
+		if !pageTypePattern.MatchString(t) {
+			return Visibility{}, fmt.Errorf("invalid page type %q", t)
+		}
+		out.PageTypes = append(out.PageTypes, t)
+	}
+	for _, id := range v.CategoryIDs {
+		if id <= 0 {
+			return Visibility{}, fmt.Errorf("invalid category %d", id)
+		}
+		if !slices.Contains(out.CategoryIDs, id) {
+			out.CategoryIDs = append(out.CategoryIDs, id)
+		}
+	}
+	for _, l := range v.Languages {
+		l = strings.ToLower(strings.TrimSpace(l))
+		if l == "" || slices.Contains(out.Languages, l) {
+			continue
+		}
+		if !langPattern.MatchString(l) {
+			return Visibility{}, fmt.Errorf("invalid language %q", l)
+		}
+		out.Languages = append(out.Languages, l)
+	}
+	for _, p := range v.Paths {
+		p = strings.TrimSpace(p)
+		if p == "" || slices.Contains(out.Paths, p) {
+			continue
+		}
+		if len(p) > maxPathPatternLen || !strings.HasPrefix(strings.TrimPrefix(p, "!"), "/") {
+			return Visibility{}, fmt.Errorf("invalid URL pattern %q: patterns start with / or !/", p)
+		}
+		out.Paths = append(out.Paths, p)
+	}
+	if max(len(out.PageTypes), len(out.CategoryIDs), len(out.Languages), len(out.Paths)) > MaxVisibilityValues {
+		return Visibility{}, errors.New("too many visibility values")
+	}
+	return out, nil
+}
+
+// Matches reports whether a widget with these rules is shown on env.
+func (v Visibility) Matches(env Env) bool {
+	if len(v.PageTypes) > 0 && !slices.Contains(v.PageTypes, env.PageType) {
+		return false
+	}
+	if len(v.CategoryIDs) > 0 && !slices.ContainsFunc(v.CategoryIDs, func(id int64) bool {
+		return slices.Contains(env.CategoryIDs, id)
+	}) {
+		return false
+	}
+	if len(v.Languages) > 0 && !slices.Contains(v.Languages, env.Lang) {
+		return false
+	}
+	return v.matchesPath(env.Path)
+}
+
+func (v Visibility) matchesPath(path string) bool {
+	included, hasIncludes := false, false
+	for _, p := range v.Paths {
+		if exclude, ok := strings.CutPrefix(p, "!"); ok {
+			if MatchPath(exclude, path) {
+				return false
+			}
+			continue
+		}
+		hasIncludes = true
+		if !included && MatchPath(p, path) {
+			included = true
+		}
+	}
+	return included || !hasIncludes
+}
+
+// MatchPath reports whether path matches a URL pattern where "*" matches any
+// characters. A trailing slash is ignored on both sides.
+func MatchPath(pattern, path string) bool {
+	pattern, path = trimSlash(pattern), trimSlash(path)
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == path
+	}
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+	path = path[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(path, part)
+		if i < 0 {
+			return false
+		}
+		path = path[i+len(part):]
+	}
+	return len(path) >= len(last) && strings.HasSuffix(path, last)
+}
+
+func trimSlash(s string) string {
+	if len(s) > 1 {
+		return strings.TrimSuffix(s, "/")
+	}
+	return s
+}
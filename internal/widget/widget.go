@@ -0,0 +1,152 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package widget defines the types of widgets placed in theme widget areas,
+// the settings they take and the visibility rules that decide on which
+// pages a widget is shown.
+//
+// Core types are registered by the frontend handler; modules add their own
+// through module.Module.Widgets. A type renders a widget per request from
+// its stored title, content and settings:
+//
+//	widget.Type{
+//		ID:       "bookmarks",
+//		Name:     "Bookmarks",
+//		Settings: []widget.Setting{{Key: "limit", Label: "Limit", Kind: widget.SettingNumber}},
+//		Render:   renderBookmarks,
+//	}
+package widget
+
+import (
+	"context"
+	"encoding/json"
+	"html/template"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Func renders a widget. An empty result hides the widget; an error hides it
+// and is logged.
+type Func func(ctx context.Context, call Call) (template.HTML, error)
+
+// Setting kinds shown by the widget editor.
+const (
+	SettingText     = "text"
+	SettingNumber   = "number"
+	SettingTextarea = "textarea"
+)
+
+// Setting describes a value a widget type reads from its settings.
+type Setting struct {
+	Key     string `json:"key"`
+	Label   string `json:"label"`
+	Kind    string `json:"kind"`
+	Help    string `json:"help,omitempty"`
+	Default string `json:"default,omitempty"`
+}
+
+// Type is a kind of widget an editor can place in a widget area. A type
+// without Render shows its sanitized content, like the text widget.
+type Type struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Settings    []Setting `json:"settings,omitempty"`
+	// Content reports whether the editor shows the content field.
+	Content bool `json:"content,omitempty"`
+	Render  Func `json:"-"`
+}
+
+// Page types of listings, matched by Visibility.PageTypes next to the page
+// types of single pages.
+const (
+	PageHome     = "home"
+	PageBlog     = "blog"
+	PageCategory = "category"
+	PageTag      = "tag"
+	PageSearch   = "search"
+	PageArchive  = "archive" // yearly and monthly blog archives
+)
+
+// ListingPageTypes are the page types of listings.
+var ListingPageTypes = []string{PageHome, PageBlog, PageArchive, PageCategory, PageTag, PageSearch}
+
+// Env describes the page widgets are rendered for.
+type Env struct {
+	Lang       string // language code of the page
+	LangPrefix string // public URL prefix of the language, "" for the default
+	Path       string // request path, including the language prefix
+	// PageType is the page type ("page", "post" or a content type) on
+	// single pages, or one of ListingPageTypes.
+	PageType    string
+	CategoryIDs []int64 // categories of the page, or the listed category
+}
+
+// Call is a widget rendered for a page.
+type Call struct {
+	Env
+	ID       int64
+	Title    string
+	Content  template.HTML // sanitized widget content
+	Settings map[string]string
+}
+
+// Setting returns a setting value, or an empty string.
+func (c Call) Setting(key string) string {
+	return c.Settings[key]
+}
+
+// IntSetting returns a positive numeric setting, def when it is missing or
+// invalid, capped at limit.
+func (c Call) IntSetting(key string, def, limit int) int {
+	n, err := strconv.Atoi(strings.TrimSpace(c.Settings[key]))
+	if err != nil || n <= 0 {
+		n = def
+	}
+	return min(n, limit)
+}
+
+var idPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// ValidID reports whether id can be used as a widget type ID.
+func ValidID(id string) bool {
+	return idPattern.MatchString(id)
+}
+
+// ParseSettings decodes stored widget settings, a JSON object of strings.
+// Numbers and booleans are kept in their text form; anything else, including
+// settings stored before widget types had settings, yields an empty map.
+func ParseSettings(raw string) map[string]string {
+	settings := make(map[string]string)
+	var values map[string]any
+	if err := json.Unmarshal([]byte(raw), &values); err != nil {
+		return settings
+	}
+	for k, v := range values {
+		switch v := v.(type) {
+		case string:
+			settings[k] = v
+		case float64:
+			settings[k] = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			settings[k] = strconv.FormatBool(v)
+		}
+	}
+	return settings
+}
+
+// EncodeSettings encodes settings for storage. Empty values are dropped.
+func EncodeSettings(settings map[string]string) string {
+	values := make(map[string]string, len(settings))
+	for k, v := range settings {
+		if v = strings.TrimSpace(v); v != "" {
+			values[k] = v
+		}
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(values)
+	return string(b)
+}
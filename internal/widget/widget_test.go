@@ -0,0 +1,121 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package widget
+
+import (
+	"testing"
+)
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		pattern, path string
+		want          bool
+	}{
+		{"/", "/", true},
+		{"/about", "/about/", true},
+		{"/about", "/about-us", false},
+		{"/blog*", "/blog", true},
+		{"/blog*", "/blog/2026/10", true},
+		{"/blog/*", "/blogroll", false},
+		{"/docs/*/install", "/docs/v2/install", true},
+		{"/docs/*/install", "/docs/v2/setup", false},
+		{"*.html", "/legacy/page.html", true},
+		{"/ru/*", "/ru", false},
+	}
+	for _, tt := range tests {
+		if got := MatchPath(tt.pattern, tt.path); got != tt.want {
+			t.Errorf("MatchPath(%q, %q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestVisibilityMatches(t *testing.T) {
+	post := Env{Lang: "en", Path: "/hello", PageType: "post", CategoryIDs: []int64{3, 7}}
+	ruBlog := Env{Lang: "ru", LangPrefix: "/ru", Path: "/ru/blog", PageType: "blog"}
+
+	tests := []struct {
+		name string
+		v    Visibility
+		env  Env
+		want bool
+	}{
+		{"no rules", Visibility{}, post, true},
+		{"page type", Visibility{PageTypes: []string{"post", "page"}}, post, true},
+		{"other page type", Visibility{PageTypes: []string{"blog"}}, post, false},
+		{"category", Visibility{CategoryIDs: []int64{7}}, post, true},
+		{"other category", Visibility{CategoryIDs: []int64{1}}, post, false},
+		{"listing without categories", Visibility{CategoryIDs: []int64{7}}, ruBlog, false},
+		{"language", Visibility{Languages: []string{"ru"}}, ruBlog, true},
+		{"other language", Visibility{Languages: []string{"ru"}}, post, false},
+		{"path", Visibility{Paths: []string{"/ru/*"}}, ruBlog, true},
+		{"excluded path", Visibility{Paths: []string{"!/ru/*"}}, ruBlog, false},
+		{"only exclusions", Visibility{Paths: []string{"!/ru/*"}}, post, true},
+		{"include and exclude", Visibility{Paths: []string{"/*", "!/hello"}}, post, false},
+		{"all rules", Visibility{PageTypes: []string{"post"}, CategoryIDs: []int64{3}, Languages: []string{"en"}, Paths: []string{"/h*"}}, post, true},
+		{"one rule fails", Visibility{PageTypes: []string{"post"}, Languages: []string{"ru"}}, post, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Matches(tt.env); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVisibilityNormalize(t *testing.T) {
+	v, err := Visibility{
+		PageTypes:   []string{" Post ", "post", ""},
+		CategoryIDs: []int64{2, 2},
+		Languages:   []string{"RU"},
+		Paths:       []string{" /blog* ", "!/blog/draft"},
+	}.Normalize()
+	if err != nil {
+		t.Fatalf("Normalize: %v", err)
+	}
+	if len(v.PageTypes) != 1 || v.PageTypes[0] != "post" || len(v.CategoryIDs) != 1 ||
+		v.Languages[0] != "ru" || v.Paths[0] != "/blog*" || len(v.Paths) != 2 {
+		t.Errorf("Normalize() = %+v", v)
+	}
+
+	for _, bad := range []Visibility{
+		{PageTypes: []string{"no spaces"}},
+		{CategoryIDs: []int64{0}},
+		{Languages: []string{"english"}},
+		{Paths: []string{"blog"}},
+	} {
+		if _, err := bad.Normalize(); err == nil {
+			t.Errorf("Normalize(%+v) succeeded, want error", bad)
+		}
+	}
+
+	parsed, err := ParseVisibility(v.Encode())
+	if err != nil || len(parsed.Paths) != 2 {
+		t.Errorf("ParseVisibility(Encode()) = %+v, %v", parsed, err)
+	}
+	if _, err := ParseVisibility("{"); err == nil {
+		t.Error("ParseVisibility accepted invalid JSON")
+	}
+}
+
+func TestSettings(t *testing.T) {
+	s := ParseSettings(`{"limit": 5, "menu": "footer", "show": true, "nested": {"a": 1}}`)
+	if s["limit"] != "5" || s["menu"] != "footer" || s["show"] != "true" || len(s) != 3 {
+		t.Errorf("ParseSettings = %v", s)
+	}
+	if s := ParseSettings("legacy text"); len(s) != 0 {
+		t.Errorf("ParseSettings(legacy) = %v, want empty", s)
+	}
+	if got := EncodeSettings(map[string]string{"limit": " 5 ", "empty": ""}); got != `{"limit":"5"}` {
+		t.Errorf("EncodeSettings = %q", got)
+	}
+
+	call := Call{Settings: map[string]string{"limit": "500", "bad": "x"}}
+	if got := call.IntSetting("limit", 5, 20); got != 20 {
+		t.Errorf("IntSetting(limit) = %d, want capped 20", got)
+	}
+	if got := call.IntSetting("bad", 5, 20); got != 5 {
+		t.Errorf("IntSetting(bad) = %d, want default 5", got)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package analytics_int
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/widget"
+)
+
+const (
+	// maxPopularPages caps the limit setting of the popular pages widget.
+	maxPopularPages = 20
+	// maxPopularDays caps the days setting of the popular pages widget.
+	maxPopularDays = 365
+)
+
+var popularPagesTemplate = template.Must(template.New("popular_pages").Parse(
+	`<ul class="category-list">{{range .}}<li><a href="{{.Path}}">{{.PageTitle}}</a> <span class="count">({{.Views}})</span></li>{{end}}</ul>`))
+
+// Widgets returns the widget types provided by the module.
+func (m *Module) Widgets() []widget.Type {
+	return []widget.Type{{
+		ID:          "popular_pages",
+		Name:        "Popular Pages",
+		Description: "Most viewed pages from internal analytics",
+		Settings: []widget.Setting{
+			{Key: "limit", Label: "Number of pages", Kind: widget.SettingNumber, Default: "5"},
+			{Key: "days", Label: "Period in days", Kind: widget.SettingNumber, Default: "30"},
+		},
+		Render: m.renderPopularPages,
+	}}
+}
+
+// renderPopularPages lists the most viewed pages of the widget language.
+func (m *Module) renderPopularPages(ctx context.Context, call widget.Call) (template.HTML, error) {
+	if m.ctx == nil || m.ctx.DB == nil || m.settings == nil || !m.settings.Enabled {
+		return "", nil
+	}
+	end := time.Now()
+	start := end.AddDate(0, 0, -call.IntSetting("days", 30, maxPopularDays))
+	pages := m.getPopularPages(ctx, call.Lang, call.LangPrefix, start, end, call.IntSetting("limit", 5, maxPopularPages))
+	if len(pages) == 0 {
+		return "", nil
+	}
+	var buf bytes.Buffer
+	if err := popularPagesTemplate.Execute(&buf, pages); err != nil {
+		return "", err
+	}
+	return template.HTML(buf.String()), nil
+}
+
+// getPopularPages returns the most viewed published pages of a language.
+// Views are recorded by path, so a path counts for a page when it is below
+// the language prefix and its last segment is the slug of a page in that
+// language. The home page and listings are left out.
+func (m *Module) getPopularPages(ctx context.Context, langCode, langPrefix string, start, end time.Time, limit int) []TopPage {
+	otherPrefixes := m.otherLanguagePrefixes(ctx, langCode)
+	var pages []TopPage
+	for _, p := range m.getTopPages(ctx, start, end, limit*5) {
+		rest, ok := strings.CutPrefix(p.Path, langPrefix+"/")
+		if !ok || rest == "" || slices.ContainsFunc(otherPrefixes, func(prefix string) bool {
+			return strings.HasPrefix(p.Path, prefix+"/") || p.Path == prefix
+		}) {
+			continue
+		}
+		slug := rest[strings.LastIndex(strings.TrimSuffix(rest, "/"), "/")+1:]
+		var title string
+		if err := m.ctx.DB.QueryRowContext(ctx, `
+			SELECT title FROM pages
+			WHERE slug = ? AND language_code = ? AND status = 'published' AND exclude_from_lists = 0
+			LIMIT 1
+		`, strings.TrimSuffix(slug, "/"), langCode).Scan(&title); err != nil {
+			continue
+		}
+		p.PageTitle = title
+		pages = append(pages, p)
+		if len(pages) == limit {
+			break
+		}
+	}
+	return pages
+}
+
+// otherLanguagePrefixes returns the URL prefixes of the active languages
+// other than langCode. The default language has no prefix.
+func (m *Module) otherLanguagePrefixes(ctx context.Context, langCode string) []string {
+	rows, err := m.ctx.DB.QueryContext(ctx, `
+		SELECT code FROM languages WHERE is_active = 1 AND is_default = 0 AND code != ?
+	`, langCode)
+	if err != nil {
+		return nil
+	}
+	defer func() { _ = rows.Close() }()
+	var prefixes []string
+	for rows.Next() {
+		var code string
+		if rows.Scan(&code) == nil {
+			prefixes = append(prefixes, "/"+code)
+		}
+	}
+	return prefixes
+}
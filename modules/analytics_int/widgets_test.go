@@ -0,0 +1,86 @@
+// Copyright (c) 2025-2026 Oleg Ivanchenko
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package analytics_int
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olegiv/ocms-go/internal/testutil"
+	"github.com/olegiv/ocms-go/internal/widget"
+)
+
+func TestPopularPagesWidget(t *testing.T) {
+	db, cleanup := testutil.TestDB(t)
+	defer cleanup()
+
+	m := testModule(t, db)
+	defer func() { _ = m.Shutdown() }()
+	m.settings.Enabled = true
+
+	ctx := context.Background()
+	if _, err := db.Exec(`INSERT INTO users (id, email, password_hash, role, name) VALUES (1, 'a@example.com', 'x', 'admin', 'Admin')`); err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO languages (code, name, native_name, is_default, is_active, direction, position)
+		VALUES ('ru', 'Russian', 'Русский', 0, 1, 'ltr', 1)`); err != nil {
+		t.Fatalf("insert language: %v", err)
+	}
+	for _, p := range []struct{ slug, title, lang, status string }{
+		{"guide", "Guide", "en", "published"},
+		{"news", "News", "en", "published"},
+		{"draft", "Draft", "en", "draft"},
+		{"novosti", "Новости", "ru", "published"},
+	} {
+		if _, err := db.Exec(`INSERT INTO pages (title, slug, body, status, author_id, language_code) VALUES (?, ?, '', ?, 1, ?)`,
+			p.title, p.slug, p.status, p.lang); err != nil {
+			t.Fatalf("insert page: %v", err)
+		}
+	}
+
+	now := time.Now()
+	for path, views := range map[string]int{"/news": 3, "/docs/guide": 2, "/draft": 5, "/": 9, "/ru/novosti": 4} {
+		for i := range views {
+			if err := m.insertPageView(&PageView{VisitorHash: path, Path: path, SessionHash: "s", DeviceType: "desktop", CreatedAt: now.Add(-time.Duration(i) * time.Minute)}); err != nil {
+				t.Fatalf("insertPageView: %v", err)
+			}
+		}
+	}
+
+	types := m.Widgets()
+	if len(types) != 1 || types[0].ID != "popular_pages" || !widget.ValidID(types[0].ID) {
+		t.Fatalf("Widgets() = %+v", types)
+	}
+	render := types[0].Render
+
+	out, err := render(ctx, widget.Call{Env: widget.Env{Lang: "en"}})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(out)
+	if !strings.Contains(html, `href="/news">News</a> <span class="count">(3)</span>`) ||
+		!strings.Contains(html, `href="/docs/guide">Guide</a>`) || strings.Index(html, "News") > strings.Index(html, "Guide") {
+		t.Errorf("popular pages = %s, want News then Guide", html)
+	}
+	if strings.Contains(html, "Draft") || strings.Contains(html, "novosti") {
+		t.Errorf("popular pages = %s, want drafts and other languages left out", html)
+	}
+
+	out, _ = render(ctx, widget.Call{Env: widget.Env{Lang: "en"}, Settings: map[string]string{"limit": "1"}})
+	if strings.Contains(string(out), "Guide") {
+		t.Errorf("limited popular pages = %s, want one page", out)
+	}
+
+	out, _ = render(ctx, widget.Call{Env: widget.Env{Lang: "ru", LangPrefix: "/ru"}})
+	if !strings.Contains(string(out), `href="/ru/novosti"`) || strings.Contains(string(out), "News") {
+		t.Errorf("ru popular pages = %s", out)
+	}
+
+	m.settings.Enabled = false
+	if out, _ := render(ctx, widget.Call{Env: widget.Env{Lang: "en"}}); out != "" {
+		t.Errorf("disabled popular pages = %s, want nothing", out)
+	}
+}
@@ -412,6 +412,7 @@ func TestDeleteAllGeneratedItemsRetainsMediaAfterUnsafeCleanupTarget(t *testing.
 		Uuid: "not-a-canonical-uuid", Filename: "unsafe.jpg", MimeType: model.MimeTypeJPEG,
 		Size: 1, UploadedBy: fixtures.User.ID, LanguageCode: fixtures.Language.Code,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -451,6 +452,7 @@ func TestDeleteAllGeneratedItemsKeepsFilesAndVariantsWhenMediaDeleteFails(t *tes
 		Uuid: mediaUUID, Filename: "must-remain.jpg", MimeType: model.MimeTypeJPEG,
 		Size: 1, UploadedBy: fixtures.User.ID, LanguageCode: fixtures.Language.Code,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -576,6 +578,7 @@ func TestDeleteAllGeneratedItemsRetriesCommittedMediaCleanupAfterRestart(t *test
 		Uuid: mediaUUID, Filename: "retry.jpg", MimeType: model.MimeTypeJPEG,
 		Size: 1, UploadedBy: fixtures.User.ID, LanguageCode: fixtures.Language.Code,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -636,6 +636,7 @@ func (m *Module) generateMedia(ctx context.Context, uploaderID int64, defaultLan
 			LanguageCode: defaultLanguageCode,
 			CreatedAt:    now,
 			UpdatedAt:    now,
+			SiteID:       store.SiteIDFromContext(ctx),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create media record: %w", err)
@@ -768,6 +769,7 @@ func (m *Module) generatePages(ctx context.Context, languages []store.Language,
 			PageType:        "post",
 			CreatedAt:       now,
 			UpdatedAt:       now,
+			SiteID:          store.SiteIDFromContext(ctx),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create page: %w", err)
@@ -825,6 +827,7 @@ func (m *Module) generatePages(ctx context.Context, languages []store.Language,
 				PageType:        "post",
 				CreatedAt:       now,
 				UpdatedAt:       now,
+				SiteID:          store.SiteIDFromContext(ctx),
 			})
 			if err != nil {
 				return nil, fmt.Errorf("failed to create page translation: %w", err)
@@ -127,6 +127,7 @@ func createTestFixtures(t *testing.T, db *sql.DB) *testFixtures {
 			LanguageCode: lang.Code,
 			CreatedAt:    now,
 			UpdatedAt:    now,
+			SiteID:       store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreateMenu: %v", err)
@@ -586,6 +587,7 @@ func TestGenerateMenuItems(t *testing.T) {
 			LanguageCode: fixtures.Language.Code,
 			PageType:     "post",
 			CreatedAt:    now, UpdatedAt: now,
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreatePage: %v", err)
@@ -641,6 +643,7 @@ func TestGenerateMenuItemsWithLanguageSpecificMenus(t *testing.T) {
 		LanguageCode: fixtures.Language.Code,
 		CreatedAt:    now,
 		UpdatedAt:    now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMenu EN: %v", err)
@@ -652,6 +655,7 @@ func TestGenerateMenuItemsWithLanguageSpecificMenus(t *testing.T) {
 		LanguageCode: fixtures.Language2.Code,
 		CreatedAt:    now,
 		UpdatedAt:    now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMenu RU: %v", err)
@@ -665,6 +669,7 @@ func TestGenerateMenuItemsWithLanguageSpecificMenus(t *testing.T) {
 			LanguageCode: fixtures.Language.Code,
 			PageType:     "post",
 			CreatedAt:    now, UpdatedAt: now,
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreatePage EN: %v", err)
@@ -676,6 +681,7 @@ func TestGenerateMenuItemsWithLanguageSpecificMenus(t *testing.T) {
 			LanguageCode: fixtures.Language2.Code,
 			PageType:     "post",
 			CreatedAt:    now, UpdatedAt: now,
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreatePage RU: %v", err)
@@ -207,6 +207,7 @@ func seedDraftPage(t *testing.T, q *store.Queries, authorID int64, title, slug,
 		PageType:     pageType,
 		CreatedAt:    now,
 		UpdatedAt:    now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("seedDraftPage %q: %v", slug, err)
@@ -263,6 +264,7 @@ func seedPublishedPage(t *testing.T, q *store.Queries, authorID int64, title, sl
 		PublishedAt:  sql.NullTime{Time: now, Valid: true},
 		CreatedAt:    now,
 		UpdatedAt:    now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("seedPublishedPage %q: %v", slug, err)
@@ -930,6 +932,7 @@ func TestGenerateUserGuideMarkdown_MenuHidesDraftPageItem(t *testing.T) {
 		LanguageCode: "en",
 		CreatedAt:    now,
 		UpdatedAt:    now,
+		SiteID:       store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMenu: %v", err)
@@ -1008,6 +1011,7 @@ func TestKnowledgeBaseUsesCanonicalLanguageURLsAndFailsClosedWithoutOneDefault(t
 			Title: title, Slug: slug, Body: "<p>Body</p>", Status: "published",
 			AuthorID: authorID, LanguageCode: languageCode, PageType: "page",
 			PublishedAt: sql.NullTime{Time: now, Valid: true}, CreatedAt: now, UpdatedAt: now,
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreatePage(%s): %v", slug, err)
@@ -1081,6 +1085,7 @@ func TestKnowledgeBaseUsesCanonicalLanguageURLsAndFailsClosedWithoutOneDefault(t
 
 	menu, err := q.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Main", Slug: "main", LanguageCode: "en", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMenu: %v", err)
@@ -399,6 +399,7 @@ func TestDeleteImportedItemsRespectsForeignKeys(t *testing.T) {
 		Title: "Imported", Slug: "imported", Status: "published",
 		AuthorID: user.ID, LanguageCode: lang.Code, PageType: "page",
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create page: %v", err)
@@ -428,6 +429,7 @@ func TestDeleteImportedItemsRespectsForeignKeys(t *testing.T) {
 
 	menu, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Main", Slug: "main", LanguageCode: lang.Code, CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create menu: %v", err)
@@ -522,6 +524,7 @@ func TestDeleteImportedAliasDoesNotDependOnParentPageDeletion(t *testing.T) {
 		Title: "Untracked parent", Slug: "untracked-parent", Status: "draft",
 		AuthorID: author.ID, LanguageCode: lang.Code, PageType: "page",
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -1060,6 +1063,7 @@ func TestDeleteImportedItemsRefusesWhileImportRunning(t *testing.T) {
 		Title: "Imported", Slug: "imported-race", Status: "published",
 		AuthorID: user.ID, LanguageCode: lang.Code, PageType: "page",
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create page: %v", err)
@@ -1110,6 +1114,7 @@ func TestDeleteImportedItemsRefusesStaleHeartbeatOwnedByCurrentRun(t *testing.T)
 		Title: "Still importing", Slug: "still-importing", Status: "published",
 		AuthorID: user.ID, LanguageCode: lang.Code, PageType: "page",
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -1162,6 +1167,7 @@ func TestDeleteImportedItemsAllowsStaleForeignRun(t *testing.T) {
 		Title: "Abandoned", Slug: "abandoned-import", Status: "published",
 		AuthorID: user.ID, LanguageCode: lang.Code, PageType: "page",
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -1275,6 +1281,7 @@ func TestDeleteImportedItemsClearsParentTrackingWhenTrackedChildIsShared(t *test
 		Title: "Original", Slug: "shared-child-original", Status: "published",
 		AuthorID: author.ID, LanguageCode: lang.Code, PageType: "page",
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -1329,6 +1336,7 @@ func TestDeleteImportedItemsRetainsDependenciesOfFailedTrackedPage(t *testing.T)
 		Uuid: "12345678-1234-1234-1234-123456789abc", Filename: "failed-page.jpg",
 		MimeType: "image/jpeg", Size: 1, UploadedBy: user.ID,
 		LanguageCode: lang.Code, CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -1338,6 +1346,7 @@ func TestDeleteImportedItemsRetainsDependenciesOfFailedTrackedPage(t *testing.T)
 		AuthorID:     user.ID,
 		Body:         fmt.Sprintf(`<img src="/uploads/originals/%s/failed-page.jpg">`, media.Uuid),
 		LanguageCode: lang.Code, PageType: "page", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -1453,6 +1462,7 @@ func TestDeleteImportedItemsDoesNotRetainUnrelatedSharedDependenciesAfterPageFai
 		Title: "Unrelated failed page", Slug: "unrelated-failed-page", Status: "published",
 		AuthorID: failingAuthor.ID, LanguageCode: lang.Code, PageType: "page",
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -1468,6 +1478,7 @@ func TestDeleteImportedItemsDoesNotRetainUnrelatedSharedDependenciesAfterPageFai
 		Uuid: "87654321-4321-4321-4321-cba987654321", Filename: "shared.jpg",
 		MimeType: "image/jpeg", Size: 1, UploadedBy: sharedUser.ID,
 		LanguageCode: lang.Code, CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -1477,6 +1488,7 @@ func TestDeleteImportedItemsDoesNotRetainUnrelatedSharedDependenciesAfterPageFai
 		AuthorID:     sharedUser.ID,
 		Body:         fmt.Sprintf(`<img src="/uploads/originals/%s/shared.jpg">`, sharedMedia.Uuid),
 		LanguageCode: lang.Code, PageType: "page", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -1578,6 +1590,7 @@ func TestDeleteImportedItemsRetainsMenuWhenTrackedItemDeleteFails(t *testing.T)
 	page, err := queries.CreatePage(ctx, store.CreatePageParams{
 		Title: "Retry target", Slug: "retry-target", Status: "published", AuthorID: author.ID,
 		LanguageCode: lang.Code, PageType: "page", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -1585,6 +1598,7 @@ func TestDeleteImportedItemsRetainsMenuWhenTrackedItemDeleteFails(t *testing.T)
 	menu, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Retry menu", Slug: "retry-menu", LanguageCode: lang.Code,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -1748,6 +1762,7 @@ func TestDeleteImportedItemsRetainsTaxonomyTargetOfFailedMenuItem(t *testing.T)
 	menu, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Taxonomy", Slug: "taxonomy", LanguageCode: language.Code,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -1892,6 +1907,7 @@ func TestDeleteImportedItemsKeepsTaxonomyUsedByOriginalPages(t *testing.T) {
 		Title: "Original", Slug: "original", Status: "published",
 		AuthorID: author.ID, LanguageCode: lang.Code, PageType: "page",
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create original page: %v", err)
@@ -2007,6 +2023,7 @@ func TestDeleteImportedItemsKeepsAdminChildrenAndSharedMedia(t *testing.T) {
 		Uuid: "11111111-2222-3333-4444-555555555555", Filename: "hero.jpg",
 		MimeType: "image/jpeg", Size: 1, UploadedBy: author.ID,
 		LanguageCode: lang.Code, CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create media: %v", err)
@@ -2015,6 +2032,7 @@ func TestDeleteImportedItemsKeepsAdminChildrenAndSharedMedia(t *testing.T) {
 		Uuid: "66666666-7777-8888-9999-000000000000", Filename: "unused.jpg",
 		MimeType: "image/jpeg", Size: 1, UploadedBy: author.ID,
 		LanguageCode: lang.Code, CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create media: %v", err)
@@ -2025,6 +2043,7 @@ func TestDeleteImportedItemsKeepsAdminChildrenAndSharedMedia(t *testing.T) {
 		Title: "Original", Slug: "original-keeps", Status: "published",
 		AuthorID: author.ID, LanguageCode: lang.Code, PageType: "page",
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create page: %v", err)
@@ -2036,6 +2055,7 @@ func TestDeleteImportedItemsKeepsAdminChildrenAndSharedMedia(t *testing.T) {
 
 	menu, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Main", Slug: "main-keeps", LanguageCode: lang.Code, CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create menu: %v", err)
@@ -2143,6 +2163,7 @@ func TestDeleteImportedItemsDetachesAdminMenuItemsFromDeletedPages(t *testing.T)
 		Title: "Imported", Slug: "imported-page", Status: "published",
 		AuthorID: author.ID, LanguageCode: lang.Code, PageType: "page",
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create page: %v", err)
@@ -2150,6 +2171,7 @@ func TestDeleteImportedItemsDetachesAdminMenuItemsFromDeletedPages(t *testing.T)
 
 	menu, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Main", Slug: "main-detach", LanguageCode: lang.Code, CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create menu: %v", err)
@@ -2214,6 +2236,7 @@ func TestDeleteImportedItemsKeepsMenuTargetWithAmbiguousDefaults(t *testing.T) {
 	page, err := queries.CreatePage(ctx, store.CreatePageParams{
 		Title: "Imported", Slug: "ambiguous-imported", Status: "published", AuthorID: author.ID,
 		LanguageCode: defaultLang.Code, PageType: "page", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -2221,6 +2244,7 @@ func TestDeleteImportedItemsKeepsMenuTargetWithAmbiguousDefaults(t *testing.T) {
 	menu, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Main", Slug: "ambiguous-main", LanguageCode: defaultLang.Code,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -2285,6 +2309,7 @@ func TestDeleteImportedItemsDetachesNonDefaultMenuItemsAtomically(t *testing.T)
 	menu, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Main", Slug: "main-translated-detach", LanguageCode: defaultLang.Code,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create menu: %v", err)
@@ -2295,6 +2320,7 @@ func TestDeleteImportedItemsDetachesNonDefaultMenuItemsAtomically(t *testing.T)
 			Title: "Imported " + slug, Slug: slug, Status: "published",
 			AuthorID: author.ID, LanguageCode: "fr", PageType: "page",
 			CreatedAt: now, UpdatedAt: now,
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("failed to create page %q: %v", slug, err)
@@ -2396,6 +2422,7 @@ func TestDeleteImportedItemsKeepsUnrouteableAdminMenuTargets(t *testing.T) {
 	menu, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Main", Slug: "main-unrouteable-detach", LanguageCode: defaultLang.Code,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create menu: %v", err)
@@ -2411,6 +2438,7 @@ func TestDeleteImportedItemsKeepsUnrouteableAdminMenuTargets(t *testing.T) {
 			Title: "Imported " + code, Slug: "unrouteable-" + code, Status: "published",
 			AuthorID: author.ID, LanguageCode: code, PageType: "page",
 			CreatedAt: now, UpdatedAt: now,
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("failed to create page for %q: %v", code, err)
@@ -2432,6 +2460,7 @@ func TestDeleteImportedItemsKeepsUnrouteableAdminMenuTargets(t *testing.T) {
 		Title: "Unlinked orphan", Slug: "unlinked-orphan", Status: "published",
 		AuthorID: author.ID, LanguageCode: "zz", PageType: "page",
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create unlinked orphan page: %v", err)
@@ -2525,6 +2554,7 @@ func TestDeleteImportedItemsKeepsSharedUsersAndRetainsFailedTracking(t *testing.
 		Title: "Drupal page", Slug: "drupal-page", Status: "published",
 		AuthorID: shared.ID, LanguageCode: lang.Code, PageType: "page",
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatalf("failed to create page: %v", err)
 	}
@@ -2641,6 +2671,7 @@ func TestDeleteImportedItemsKeepsMenusHoldingAdminItems(t *testing.T) {
 	sharedMenu, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Imported", Slug: "imported-menu", LanguageCode: lang.Code,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create menu: %v", err)
@@ -2648,6 +2679,7 @@ func TestDeleteImportedItemsKeepsMenusHoldingAdminItems(t *testing.T) {
 	emptyMenu, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Empty", Slug: "empty-menu", LanguageCode: lang.Code,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create menu: %v", err)
@@ -2729,6 +2761,7 @@ func TestDeleteImportedMediaQueuesFilesOnlyAfterTheRowIsGone(t *testing.T) {
 		Uuid: "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", Filename: "a.jpg",
 		MimeType: "image/jpeg", Size: 1, UploadedBy: author.ID,
 		LanguageCode: lang.Code, CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create media: %v", err)
@@ -291,7 +291,7 @@ type importState struct {
 	// languages do not incorrectly shadow one another.
 	// nodeID is non-zero for a node owner and zero for a taxonomy owner.
 	aliasReservations map[string]aliasReservation
-	// Page slugs are globally unique in storage even though public routes are
+	// Page slugs are unique per site in storage even though public routes are
 	// language-scoped. A single-segment source alias therefore chooses one
 	// deterministic node that may retain the unsuffixed stored slug. A
 	// default-language owner wins regardless of source-row/import order; other
@@ -1070,6 +1070,7 @@ func (s *Source) importOneFile(ctx context.Context, st *importState, source medi
 		LanguageCode: st.defaultLang,
 		CreatedAt:    unixOrNow(f.Created, now),
 		UpdatedAt:    now,
+		SiteID:       store.SiteIDFromContext(ctx),
 	}
 
 	var variantSource string
@@ -1256,7 +1257,8 @@ func (s *Source) loadPathAliases(ctx context.Context, st *importState) error {
 // redirects regardless of source row order; otherwise a lower-ID term alias
 // could create a redirect that permanently shadows the page URL written later.
 // Concrete ownership is language-aware, while the separate single-segment
-// slug owner remains global because pages.slug is globally unique in storage.
+// slug owner is shared by all languages because pages.slug is unique per site
+// rather than per language.
 func (s *Source) loadNodeAliases(ctx context.Context, st *importState) error {
 	if err := s.loadPathAliases(ctx, st); err != nil {
 		return err
@@ -1296,7 +1298,7 @@ func (s *Source) loadNodeAliases(ctx context.Context, st *importState) error {
 		}
 	}
 
-	// Prefer the default-language node for the one globally unsuffixed stored
+	// Prefer the default-language node for the one unsuffixed stored
 	// slug, independent of path_alias row order. A second pass gives an alias
 	// with no default-language owner to the first deterministic source row.
 	for pass := 0; pass < 2; pass++ {
@@ -1568,7 +1570,9 @@ func (s *Source) importNode(ctx context.Context, st *importState, n Node, now ti
 	nodeLanguage := st.languageFor(n)
 
 	if st.opts.SkipExisting {
-		existing, err := st.queries.GetPageBySlug(ctx, baseSlug)
+		existing, err := st.queries.GetPageBySlug(ctx, store.GetPageBySlugParams{
+			Slug: baseSlug, SiteID: store.SiteIDFromContext(ctx),
+		})
 		switch {
 		case err == nil && existing.LanguageCode == nodeLanguage:
 			// Map the node to the page that is already there. Discarding the ID
@@ -1580,7 +1584,7 @@ func (s *Source) importNode(ctx context.Context, st *importState, n Node, now ti
 			countSkipped(st.result, pageType)
 			return
 		case err == nil:
-			// Slugs are globally unique in storage, but public routes are scoped
+			// Slugs are unique per site in storage, but public routes are scoped
 			// by language. A same-slug page in another language is not the
 			// source entity and must never become its node/menu mapping.
 		case !errors.Is(err, sql.ErrNoRows):
@@ -1634,6 +1638,7 @@ func (s *Source) importNode(ctx context.Context, st *importState, n Node, now ti
 		PublishedAt:     publishedAt,
 		CreatedAt:       unixOrNow(n.Created, now),
 		UpdatedAt:       unixOrNow(n.Changed, now),
+		SiteID:          store.SiteIDFromContext(ctx),
 	})
 	if err != nil {
 		st.result.AddError("failed to create page for node %d (%q): %v", n.NID, n.Title, err)
@@ -1702,7 +1707,7 @@ func (s *Source) nodeSlugIsFree(ctx context.Context, st *importState, slug strin
 		return false
 	}
 	exists, err := st.queries.SlugOrAliasExists(ctx, store.SlugOrAliasExistsParams{
-		Slug: slug, Alias: slug,
+		Slug: slug, SiteID: store.SiteIDFromContext(ctx), Alias: slug,
 	})
 	return err == nil && exists == 0
 }
@@ -1782,7 +1787,7 @@ func (s *Source) linkNodeTerms(ctx context.Context, st *importState, n Node, pag
 // site's old URLs keep resolving.
 //
 // An alias equal to the page's own slug is skipped — it would be a redundant
-// row that also collides with the globally-unique alias index.
+// row that also collides with the site's unique alias index.
 func (s *Source) importAliases(ctx context.Context, st *importState, now time.Time) {
 	if err := s.loadNodeAliases(ctx, st); err != nil {
 		// Reported, not fatal: the canonical node paths below are worth writing
@@ -1816,8 +1821,8 @@ func (s *Source) importAliases(ctx context.Context, st *importState, now time.Ti
 }
 
 // importNodeAlias preserves a node alias in the namespace where Drupal served
-// it. The legacy page_aliases table is global, so only default-language aliases
-// are stored there. Non-default aliases use a concrete tracked redirect such
+// it. The legacy page_aliases table is shared by all languages of a site, so
+// only default-language aliases are stored there. Non-default aliases use a concrete tracked redirect such
 // as /fr/about -> /fr/about-2; this permits identical source aliases in two
 // languages without one becoming unreachable or pointing at the other page.
 func (s *Source) importNodeAlias(ctx context.Context, st *importState, nodeID, pageID int64, alias string, now time.Time) {
@@ -1828,12 +1833,14 @@ func (s *Source) importNodeAlias(ctx context.Context, st *importState, nodeID, p
 	}
 	if page.LanguageCode == st.defaultLang {
 		if alias != page.Slug {
-			pageBySlug, lookupErr := st.queries.GetPageBySlug(ctx, alias)
+			pageBySlug, lookupErr := st.queries.GetPageBySlug(ctx, store.GetPageBySlugParams{
+				Slug: alias, SiteID: store.SiteIDFromContext(ctx),
+			})
 			switch {
 			case lookupErr == nil && pageBySlug.LanguageCode != page.LanguageCode:
-				// pages.slug is globally unique, but the foreign-language row is
+				// pages.slug is unique per site, but the foreign-language row is
 				// not routable at this unprefixed/default URL. A concrete redirect
-				// preserves the source URL without violating page_aliases' global
+				// preserves the source URL without violating page_aliases'
 				// cross-table policy.
 				s.createAliasRedirect(ctx, st, "/"+alias, "/"+alias, "/"+page.Slug, nodeID, now)
 				return
@@ -1991,12 +1998,12 @@ func (s *Source) createAliasRedirect(ctx context.Context, st *importState,
 }
 
 // aliasPathOccupied checks the namespace the request will actually use. The
-// unprefixed/default namespace is global in the legacy tables. A non-default
+// unprefixed/default namespace is shared by all languages in the legacy tables. A non-default
 // concrete redirect such as /fr/about is only shadowed by a page or alias that
 // belongs to fr; an English bare slug must not suppress that safe route.
 func aliasPathOccupied(ctx context.Context, st *importState, sourcePath, alias string) (bool, error) {
 	language := concreteAliasLanguage(sourcePath, "/"+alias, st.defaultLang)
-	page, err := st.queries.GetPageBySlug(ctx, alias)
+	page, err := st.queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: alias, SiteID: store.SiteIDFromContext(ctx)})
 	switch {
 	case err == nil && page.LanguageCode == language:
 		return true, nil
@@ -2005,7 +2012,7 @@ func aliasPathOccupied(ctx context.Context, st *importState, sourcePath, alias s
 		return false, err
 	}
 
-	page, err = st.queries.GetPageByAlias(ctx, alias)
+	page, err = st.queries.GetPageByAlias(ctx, store.GetPageByAliasParams{Alias: alias, SiteID: store.SiteIDFromContext(ctx)})
 	switch {
 	case err == nil:
 		// An unprefixed alias is globally routable and may intentionally
@@ -2223,7 +2230,7 @@ func (s *Source) createPageAlias(ctx context.Context, st *importState, pageID in
 	// Page slugs are resolved before aliases. Refuse to create a legacy alias
 	// that would be shadowed by another page even if a future schema change
 	// removes cross-table validation at write time.
-	pageBySlug, err := st.queries.GetPageBySlug(ctx, alias)
+	pageBySlug, err := st.queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: alias, SiteID: store.SiteIDFromContext(ctx)})
 	switch {
 	case err == nil && pageBySlug.ID == pageID:
 		return // redundant alias equal to this page's own slug
@@ -2518,6 +2525,7 @@ func (s *Source) ensureMenu(ctx context.Context, st *importState, menuName, slug
 		LanguageCode: language,
 		CreatedAt:    now,
 		UpdatedAt:    now,
+		SiteID:       store.SiteIDFromContext(ctx),
 	})
 	if err != nil {
 		return 0, false, err
@@ -2713,16 +2721,23 @@ func (s *Source) linkMenuParents(ctx context.Context, st *importState, links []M
 			continue
 		}
 		if _, err := st.queries.UpdateMenuItem(ctx, store.UpdateMenuItemParams{
-			ID:        childID,
-			ParentID:  sql.NullInt64{Int64: parentID, Valid: true},
-			Title:     item.Title,
-			Url:       item.Url,
-			Target:    item.Target,
-			PageID:    item.PageID,
-			Position:  item.Position,
-			CssClass:  item.CssClass,
-			IsActive:  item.IsActive,
-			UpdatedAt: now,
+			ID:               childID,
+			ParentID:         sql.NullInt64{Int64: parentID, Valid: true},
+			Title:            item.Title,
+			Url:              item.Url,
+			Target:           item.Target,
+			PageID:           item.PageID,
+			Position:         item.Position,
+			CssClass:         item.CssClass,
+			IsActive:         item.IsActive,
+			ItemType:         item.ItemType,
+			LinkID:           item.LinkID,
+			Visibility:       item.Visibility,
+			VisibleRoles:     item.VisibleRoles,
+			VisibleLanguages: item.VisibleLanguages,
+			MegaMenu:         item.MegaMenu,
+			ImageUrl:         item.ImageUrl,
+			UpdatedAt:        now,
 		}); err != nil {
 			st.result.AddError("failed to link menu item %q to its parent: %v", item.Title, err)
 		}
@@ -819,7 +819,7 @@ func TestNodeAliasesPrecedeTaxonomyRedirectsRegardlessOfRowOrder(t *testing.T) {
 	if err := source.importNodes(ctx, st); err != nil {
 		t.Fatalf("importNodes: %v", err)
 	}
-	page, err := queries.GetPublishedPageByAlias(ctx, "news/archive")
+	page, err := queries.GetPublishedPageByAlias(ctx, store.GetPublishedPageByAliasParams{Alias: "news/archive", SiteID: store.DefaultSiteID})
 	if err != nil || page.ID != st.nodes[2] {
 		t.Fatalf("multi-segment node alias owner = %+v, err=%v", page, err)
 	}
@@ -852,6 +852,7 @@ func TestTaxonomyRedirectRefusesPagesAliasesAndReservedRoutes(t *testing.T) {
 		Title: "Owned", Slug: "owned", Status: model.PageStatusPublished,
 		AuthorID: st.authorID, LanguageCode: st.defaultLang, PageType: pageTypePage,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage: %v", err)
@@ -951,6 +952,7 @@ func TestTaxonomyRedirectRefusesDraftPageAliasesInEachLanguageNamespace(t *testi
 		page, err := queries.CreatePage(ctx, store.CreatePageParams{
 			Title: "Draft " + tc.language, Slug: tc.slug, Status: "draft", AuthorID: st.authorID,
 			LanguageCode: tc.language, PageType: "page", CreatedAt: now, UpdatedAt: now,
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatalf("CreatePage(%s): %v", tc.language, err)
@@ -1250,6 +1252,7 @@ func TestImportNodesDoesNotAliasSkippedPages(t *testing.T) {
 		Title: "Duplicate", Slug: "duplicate", Body: "", Status: "published",
 		AuthorID: st.authorID, LanguageCode: st.defaultLang, PageType: "page",
 		CreatedAt: time.Now(), UpdatedAt: time.Now(),
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatalf("failed to pre-create page: %v", err)
 	}
@@ -1273,7 +1276,7 @@ func TestImportNodesDoesNotAliasSkippedPages(t *testing.T) {
 
 // TestImportNodesSkipsRedundantAlias covers the case where the Drupal alias is
 // a single segment and therefore already became the page slug — writing it
-// again would collide with the globally unique alias index.
+// again would collide with the unique alias index of the site.
 func TestImportNodesSkipsRedundantAlias(t *testing.T) {
 	reader := &fakeReader{
 		schema:  Schema{HasAliases: true},
@@ -1318,6 +1321,7 @@ func TestImportNodesSkipExisting(t *testing.T) {
 		Title: "Duplicate", Slug: "duplicate", Status: model.PageStatusPublished,
 		AuthorID: st.authorID, LanguageCode: st.defaultLang, PageType: pageTypePage,
 		CreatedAt: time.Now(), UpdatedAt: time.Now(),
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatalf("failed to create existing page: %v", err)
 	}
@@ -1348,6 +1352,7 @@ func TestImportNodesSkipExistingDoesNotMapAcrossLanguages(t *testing.T) {
 		Title: "English About", Slug: "about", Status: model.PageStatusPublished,
 		AuthorID: st.authorID, LanguageCode: st.defaultLang, PageType: pageTypePage,
 		CreatedAt: time.Now(), UpdatedAt: time.Now(),
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -1533,6 +1538,7 @@ func TestDefaultAliasesIgnoreForeignLanguageStoredSlugs(t *testing.T) {
 			Title: "French Topics", Slug: "topics", Status: model.PageStatusPublished,
 			AuthorID: st.authorID, LanguageCode: "fr", PageType: pageTypePage,
 			CreatedAt: time.Now(), UpdatedAt: time.Now(),
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatal(err)
@@ -1557,6 +1563,7 @@ func TestDefaultAliasesIgnoreForeignLanguageStoredSlugs(t *testing.T) {
 			Title: "French About", Slug: "about", Status: model.PageStatusPublished,
 			AuthorID: st.authorID, LanguageCode: "fr", PageType: pageTypePage,
 			CreatedAt: time.Now(), UpdatedAt: time.Now(),
+			SiteID: store.DefaultSiteID,
 		})
 		if err != nil {
 			t.Fatal(err)
@@ -1686,7 +1693,7 @@ func TestImportNodesRollsBackTrackingFailure(t *testing.T) {
 	if err := (&Source{}).importNodes(context.Background(), st); err != nil {
 		t.Fatalf("importNodes() error = %v", err)
 	}
-	if _, err := queries.GetPageBySlug(context.Background(), "transient"); !errors.Is(err, sql.ErrNoRows) {
+	if _, err := queries.GetPageBySlug(context.Background(), store.GetPageBySlugParams{Slug: "transient", SiteID: store.DefaultSiteID}); !errors.Is(err, sql.ErrNoRows) {
 		t.Errorf("untracked page survived rollback: %v", err)
 	}
 	if st.result.PagesImported != 0 || st.nodes[1] != 0 || st.createdNodes[1] {
@@ -1702,6 +1709,7 @@ func TestCreatePageAliasRefusesSlugShadow(t *testing.T) {
 		Title: "Owner", Slug: "claimed", Status: model.PageStatusPublished,
 		AuthorID: st.authorID, LanguageCode: st.defaultLang, PageType: pageTypePage,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage(owner): %v", err)
@@ -1710,6 +1718,7 @@ func TestCreatePageAliasRefusesSlugShadow(t *testing.T) {
 		Title: "Target", Slug: "target", Status: model.PageStatusPublished,
 		AuthorID: st.authorID, LanguageCode: st.defaultLang, PageType: pageTypePage,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage(target): %v", err)
@@ -1732,6 +1741,7 @@ func TestCreatePageAliasRefusesExactAndWildcardRedirectOwnership(t *testing.T) {
 		Title: "Target", Slug: "target", Status: model.PageStatusPublished,
 		AuthorID: st.authorID, LanguageCode: st.defaultLang, PageType: pageTypePage,
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreatePage(target): %v", err)
@@ -1920,6 +1930,7 @@ func TestImportMenusReusesExistingMenuWithoutTrackingIt(t *testing.T) {
 	if _, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Main", Slug: "main", LanguageCode: st.defaultLang,
 		CreatedAt: time.Now(), UpdatedAt: time.Now(),
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatalf("failed to create existing menu: %v", err)
 	}
@@ -1957,12 +1968,14 @@ func TestImportMenusClaimsReusedSlugAndScopesReuseToLanguage(t *testing.T) {
 	}
 	foo, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Foo", Slug: "foo-bar", LanguageCode: st.defaultLang, CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("CreateMenu(foo-bar): %v", err)
 	}
 	if _, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Principal", Slug: "main", LanguageCode: "fr", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatalf("CreateMenu(fr/main): %v", err)
 	}
@@ -2905,6 +2918,7 @@ func TestImportMenusKeepsHierarchyThroughSkippedParents(t *testing.T) {
 	menu, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Main", Slug: "main", LanguageCode: st.defaultLang,
 		CreatedAt: time.Now(), UpdatedAt: time.Now(),
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create existing menu: %v", err)
@@ -2973,6 +2987,7 @@ func TestImportMenusDoesNotReparentExistingItems(t *testing.T) {
 	menu, err := queries.CreateMenu(ctx, store.CreateMenuParams{
 		Name: "Main", Slug: "main", LanguageCode: st.defaultLang,
 		CreatedAt: time.Now(), UpdatedAt: time.Now(),
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatalf("failed to create existing menu: %v", err)
@@ -203,12 +203,12 @@ func (s *Source) createTrackedPageAlias(ctx context.Context, queries *store.Quer
 		return s.createTrackedRedirect(ctx, queries, "/"+alias, canonicalPagePath(ctx, queries, page), createdAt, result, tracker)
 	}
 
-	slugOwner, err := queries.GetPageBySlug(ctx, alias)
+	slugOwner, err := queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: alias, SiteID: store.SiteIDFromContext(ctx)})
 	switch {
 	case err == nil && slugOwner.ID == pageID:
 		return nil
 	case err == nil && slugOwner.LanguageCode != page.LanguageCode:
-		aliasOwner, aliasErr := queries.GetPageByAlias(ctx, alias)
+		aliasOwner, aliasErr := queries.GetPageByAlias(ctx, store.GetPageByAliasParams{Alias: alias, SiteID: store.SiteIDFromContext(ctx)})
 		if aliasErr == nil && aliasOwner.ID != pageID {
 			return fmt.Errorf("alias %q is already owned by page %d", alias, aliasOwner.ID)
 		}
@@ -277,7 +277,7 @@ func languageChildRouteReserved(path string) bool {
 }
 
 func languageAliasRouteOccupied(ctx context.Context, queries *store.Queries, languageCode, alias string) (bool, error) {
-	page, err := queries.GetPageBySlug(ctx, alias)
+	page, err := queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: alias, SiteID: store.SiteIDFromContext(ctx)})
 	switch {
 	case err == nil && page.LanguageCode == languageCode:
 		return true, nil
@@ -285,7 +285,7 @@ func languageAliasRouteOccupied(ctx context.Context, queries *store.Queries, lan
 	case err != nil:
 		return false, err
 	}
-	page, err = queries.GetPageByAlias(ctx, alias)
+	page, err = queries.GetPageByAlias(ctx, store.GetPageByAliasParams{Alias: alias, SiteID: store.SiteIDFromContext(ctx)})
 	switch {
 	case err == nil:
 		return page.LanguageCode == languageCode, nil
@@ -777,6 +777,7 @@ func (s *Source) importMedia(ctx context.Context, queries *store.Queries, filesP
 				LanguageCode: defaultLang.Code,
 				CreatedAt:    now,
 				UpdatedAt:    now,
+				SiteID:       store.SiteIDFromContext(ctx),
 			})
 			if err != nil {
 				cleanupErr := s.cleanupMediaFiles(ctx, tracker, canonicalUploadRoot, fileUUID)
@@ -846,6 +847,7 @@ func (s *Source) importMedia(ctx context.Context, queries *store.Queries, filesP
 				LanguageCode: defaultLang.Code,
 				CreatedAt:    now,
 				UpdatedAt:    now,
+				SiteID:       store.SiteIDFromContext(ctx),
 			})
 			if err != nil {
 				cleanupErr := s.cleanupMediaFiles(ctx, tracker, writtenRoot, fileUUID)
@@ -897,7 +899,7 @@ func (s *Source) importPosts(ctx context.Context, queries *store.Queries, reader
 
 		// Check if page already exists by slug
 		if opts.SkipExisting {
-			_, err := queries.GetPageBySlug(ctx, baseSlug)
+			_, err := queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: baseSlug, SiteID: store.SiteIDFromContext(ctx)})
 			if err == nil {
 				// Page exists, skip it
 				result.PostsSkipped++
@@ -944,6 +946,7 @@ func (s *Source) importPosts(ctx context.Context, queries *store.Queries, reader
 			MetaKeywords:    nullStringToString(post.Keywords),
 			CreatedAt:       post.Timestamp,
 			UpdatedAt:       now,
+			SiteID:          store.SiteIDFromContext(ctx),
 		})
 		if err != nil {
 			result.AddError("Failed to create page '%s': %v", post.Title, err)
@@ -1019,7 +1022,7 @@ func (s *Source) importPages(ctx context.Context, queries *store.Queries, reader
 
 		// Check if page already exists by slug
 		if opts.SkipExisting {
-			_, err := queries.GetPageBySlug(ctx, baseSlug)
+			_, err := queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: baseSlug, SiteID: store.SiteIDFromContext(ctx)})
 			if err == nil {
 				result.PagesSkipped++
 				continue
@@ -1070,6 +1073,7 @@ func (s *Source) importPages(ctx context.Context, queries *store.Queries, reader
 			MetaKeywords:    nullStringToString(wp.Keywords),
 			CreatedAt:       now,
 			UpdatedAt:       now,
+			SiteID:          store.SiteIDFromContext(ctx),
 		})
 		if err != nil {
 			result.AddError("Failed to create page '%s': %v", wp.Title, err)
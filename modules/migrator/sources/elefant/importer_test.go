@@ -469,7 +469,7 @@ func TestImportPagesPreservesSafeLegacyAlias(t *testing.T) {
 		lang.Code, nil, types.ImportOptions{}, result, tracker); err != nil {
 		t.Fatal(err)
 	}
-	page, err := queries.GetPageBySlug(ctx, "aboutus")
+	page, err := queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: "aboutus", SiteID: store.DefaultSiteID})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -529,6 +529,7 @@ func TestImportPagesRefusesLegacyAliasShadowedByExistingPageSlug(t *testing.T) {
 	existing, err := queries.CreatePage(ctx, store.CreatePageParams{
 		Title: "Existing about", Slug: "about", Status: "published", AuthorID: author.ID,
 		LanguageCode: lang.Code, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -538,7 +539,7 @@ func TestImportPagesRefusesLegacyAliasShadowedByExistingPageSlug(t *testing.T) {
 		lang.Code, nil, types.ImportOptions{}, result, &mockTracker{}); err != nil {
 		t.Fatal(err)
 	}
-	imported, err := queries.GetPageBySlug(ctx, "about-2")
+	imported, err := queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: "about-2", SiteID: store.DefaultSiteID})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -600,6 +601,7 @@ func TestImportPagesPreservesDefaultAliasBlockedOnlyByForeignLanguageSlug(t *tes
 	_, err = queries.CreatePage(ctx, store.CreatePageParams{
 		Title: "French about", Slug: "about", Status: "published", AuthorID: author.ID,
 		LanguageCode: "fr", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -610,7 +612,7 @@ func TestImportPagesPreservesDefaultAliasBlockedOnlyByForeignLanguageSlug(t *tes
 		defaultLang.Code, nil, types.ImportOptions{}, result, tracker); err != nil {
 		t.Fatal(err)
 	}
-	imported, err := queries.GetPageBySlug(ctx, "about-2")
+	imported, err := queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: "about-2", SiteID: store.DefaultSiteID})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -672,7 +674,7 @@ func TestImportPagesUsesConcreteRedirectForActiveLanguagePrefixAlias(t *testing.
 		defaultLang.Code, nil, types.ImportOptions{}, result, tracker); err != nil {
 		t.Fatal(err)
 	}
-	page, err := queries.GetPageBySlug(ctx, util.Slugify("fr/team"))
+	page, err := queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: util.Slugify("fr/team"), SiteID: store.DefaultSiteID})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -739,6 +741,7 @@ func TestPageSlugsAndAliasesRefuseCoreModuleAndRedirectOwnership(t *testing.T) {
 	page, err := queries.CreatePage(ctx, store.CreatePageParams{
 		Title: "Target", Slug: "target", Status: "published", AuthorID: author.ID,
 		LanguageCode: "en", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -752,6 +755,7 @@ func TestPageSlugsAndAliasesRefuseCoreModuleAndRedirectOwnership(t *testing.T) {
 	if _, err := queries.CreatePage(ctx, store.CreatePageParams{
 		Title: "Foreign owner", Slug: "bookmarks", Status: "published", AuthorID: author.ID,
 		LanguageCode: "fr", CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	}); err != nil {
 		t.Fatal(err)
 	}
@@ -966,7 +970,7 @@ func TestLegacyAliasInsertFailuresAreReported(t *testing.T) {
 				lang.Code, result, tracker); err != nil {
 				t.Fatal(err)
 			}
-			page, err := queries.GetPageBySlug(ctx, tt.slug)
+			page, err := queries.GetPageBySlug(ctx, store.GetPageBySlugParams{Slug: tt.slug, SiteID: store.DefaultSiteID})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1259,6 +1263,7 @@ func TestAliasTrackingFailureCompensatesAndDoesNotCount(t *testing.T) {
 		Title: "Alias parent", Slug: "alias-parent", Status: "draft",
 		AuthorID: author.ID, LanguageCode: lang.Code, PageType: "page",
 		CreatedAt: now, UpdatedAt: now,
+		SiteID: store.DefaultSiteID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -289,7 +289,8 @@ func routableLanguagePrefixes(ctx context.Context, queries *store.Queries) map[s
 	return prefixes
 }
 
-// slugIsFree reports whether a slug is claimed by neither a page nor an alias.
+// slugIsFree reports whether a slug is claimed by neither a page nor an alias
+// of the site being imported into.
 //
 // A lookup failure counts as "taken" rather than "free". The previous form read
 // any error — including a transient SQLite BUSY — as "no such page" and handed
@@ -297,8 +298,9 @@ func routableLanguagePrefixes(ctx context.Context, queries *store.Queries) map[s
 // into a permanently shadowed URL. Treating it as taken costs only a suffix.
 func slugIsFree(ctx context.Context, queries *store.Queries, slug string) bool {
 	exists, err := queries.SlugOrAliasExists(ctx, store.SlugOrAliasExistsParams{
-		Slug:  slug,
-		Alias: slug,
+		Slug:   slug,
+		SiteID: store.SiteIDFromContext(ctx),
+		Alias:  slug,
 	})
 	if err != nil {
 		return false
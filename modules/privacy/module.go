@@ -224,7 +224,58 @@ func (m *Module) Migrations() []module.Migration {
 				return nil
 			},
 		},
+		{
+			Version:     4,
+			Description: "Match the __Secure- session cookie in essential cookies",
+			Up: func(db *sql.DB) error {
+				return replaceCookiePattern(db, "klaro", "^(__Host-)?session$", "^(__Host-|__Secure-)?session$")
+			},
+			Down: func(db *sql.DB) error {
+				return replaceCookiePattern(db, "klaro", "^(__Host-|__Secure-)?session$", "^(__Host-)?session$")
+			},
+		},
+	}
+}
+
+// replaceCookiePattern replaces a cookie pattern of the named service in
+// the stored services, leaving services edited by the admin otherwise as
+// they are.
+func replaceCookiePattern(db *sql.DB, serviceName, oldPattern, newPattern string) error {
+	var servicesJSON string
+	if err := db.QueryRow(`SELECT services FROM privacy_settings WHERE id = 1`).Scan(&servicesJSON); err != nil {
+		return fmt.Errorf("reading services: %w", err)
+	}
+	if servicesJSON == "" || servicesJSON == "[]" {
+		return nil
+	}
+
+	var services []Service
+	if err := json.Unmarshal([]byte(servicesJSON), &services); err != nil {
+		return fmt.Errorf("parsing services JSON: %w", err)
+	}
+
+	changed := false
+	for i := range services {
+		if services[i].Name != serviceName {
+			continue
+		}
+		for j := range services[i].Cookies {
+			if services[i].Cookies[j].Pattern == oldPattern {
+				services[i].Cookies[j].Pattern = newPattern
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	data, err := json.Marshal(services)
+	if err != nil {
+		return fmt.Errorf("marshaling services: %w", err)
 	}
+	_, err = db.Exec(`UPDATE privacy_settings SET services = ?, updated_at = CURRENT_TIMESTAMP WHERE id = 1`, string(data))
+	return err
 }
 
 // ReloadSettings reloads settings from the database.
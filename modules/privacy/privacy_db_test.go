@@ -5,6 +5,7 @@ package privacy
 
 import (
 	"database/sql"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -75,7 +76,7 @@ func TestModuleShutdown(t *testing.T) {
 
 func TestModuleMigrations(t *testing.T) {
 	m := New()
-	moduleutil.AssertMigrations(t, m.Migrations(), 3)
+	moduleutil.AssertMigrations(t, m.Migrations(), 4)
 }
 
 func TestModuleTranslationsFS(t *testing.T) {
@@ -463,6 +464,59 @@ func TestMigrationV3SkipsEmptyServices(t *testing.T) {
 	}
 }
 
+func TestMigrationV4SecureSessionCookie(t *testing.T) {
+	db := testutil.TestMemoryDB(t)
+	defer func() { _ = db.Close() }()
+
+	migrations := New().Migrations()
+	for _, mig := range migrations[:2] {
+		if err := mig.Up(db); err != nil {
+			t.Fatalf("migration %d up: %v", mig.Version, err)
+		}
+	}
+	if _, err := db.Exec(`UPDATE privacy_settings SET services = ? WHERE id = 1`, `[{"name":"klaro","title":"Old"}]`); err != nil {
+		t.Fatalf("seed services: %v", err)
+	}
+	if err := migrations[2].Up(db); err != nil {
+		t.Fatalf("migration 3 up: %v", err)
+	}
+
+	sessionPattern := func() string {
+		t.Helper()
+		var servicesJSON string
+		if err := db.QueryRow(`SELECT services FROM privacy_settings WHERE id = 1`).Scan(&servicesJSON); err != nil {
+			t.Fatalf("read services: %v", err)
+		}
+		var services []Service
+		if err := json.Unmarshal([]byte(servicesJSON), &services); err != nil {
+			t.Fatalf("parse services: %v", err)
+		}
+		for _, c := range services[0].Cookies {
+			if strings.Contains(c.Pattern, "session") {
+				return c.Pattern
+			}
+		}
+		t.Fatalf("no session cookie pattern in %s", servicesJSON)
+		return ""
+	}
+	if got := sessionPattern(); got != `^(__Host-)?session$` {
+		t.Fatalf("after migration 3: pattern = %q", got)
+	}
+
+	if err := migrations[3].Up(db); err != nil {
+		t.Fatalf("migration 4 up: %v", err)
+	}
+	if got := sessionPattern(); got != `^(__Host-|__Secure-)?session$` {
+		t.Errorf("after migration 4 up: pattern = %q", got)
+	}
+	if err := migrations[3].Down(db); err != nil {
+		t.Fatalf("migration 4 down: %v", err)
+	}
+	if got := sessionPattern(); got != `^(__Host-)?session$` {
+		t.Errorf("after migration 4 down: pattern = %q", got)
+	}
+}
+
 // --- buildKlaroConfig edge cases ---
 
 func TestBuildKlaroConfigNilSettings(t *testing.T) {
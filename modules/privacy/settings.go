@@ -79,7 +79,7 @@ var PredefinedServices = []Service{
 		Cookies: []Cookie{
 			{Pattern: "^klaro"},
 			{Pattern: "^ocms_lang$"},
-			{Pattern: "^(__Host-)?session$"},
+			{Pattern: "^(__Host-|__Secure-)?session$"},
 			{Pattern: "^ocms_informer_dismissed"},
 		},
 	},
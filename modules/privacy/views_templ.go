@@ -936,7 +936,7 @@ func PrivacyPage(pc *adminviews.PageContext, data PrivacyViewData) templ.Compone
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 85, "\">\nfunction privacySettings() {\n    // Predefined services configuration\n    const predefinedServices = {\n        'klaro': {\n            name: 'klaro',\n            title: 'Essential Cookies',\n            description: 'Stores consent choices and UI preferences (required)',\n            purposes: ['essential'],\n            gcm_consent_type: '',\n            required: true,\n            default: true,\n            cookies: [{pattern: '^klaro'}, {pattern: '^ocms_lang$'}, {pattern: '^(__Host-)?session$'}, {pattern: '^ocms_informer_dismissed'}]\n        },\n        'google-analytics': {\n            name: 'google-analytics',\n            title: 'Google Analytics',\n            description: 'Website traffic analysis and statistics',\n            purposes: ['analytics'],\n            gcm_consent_type: 'analytics_storage',\n            required: false,\n            default: false,\n            cookies: [{pattern: '^_ga'}, {pattern: '^_gid'}, {pattern: '^_gat'}]\n        },\n        'google-ads': {\n            name: 'google-ads',\n            title: 'Google Ads',\n            description: 'Conversion tracking and personalized advertising',\n            purposes: ['marketing'],\n            gcm_consent_type: 'ad_storage,ad_user_data,ad_personalization',\n            required: false,\n            default: false,\n            cookies: [{pattern: '^_gcl'}, {pattern: '^_gac'}]\n        },\n        'google-tag-manager': {\n            name: 'google-tag-manager',\n            title: 'Google Tag Manager',\n            description: 'Tag management system for analytics and marketing tags',\n            purposes: ['functional'],\n            gcm_consent_type: '',\n            required: false,\n            default: false\n        },\n        'matomo': {\n            name: 'matomo',\n            title: 'Matomo',\n            description: 'Privacy-focused website analytics',\n            purposes: ['analytics'],\n            gcm_consent_type: '',\n            required: false,\n            default: false,\n            cookies: [{pattern: '^_pk_'}, {pattern: '^mtm_'}]\n        }\n    };\n\n    // Parse initial services from server data\n    let initialServices = [];\n    try {\n        const dataEl = document.getElementById('privacy-services-data');\n        const servicesData = JSON.parse(dataEl ? dataEl.dataset.services : '[]');\n        if (Array.isArray(servicesData) && servicesData.length > 0) {\n            // Convert purposes array to string for display\n            initialServices = servicesData.map(s => ({\n                ...s,\n                purposes: Array.isArray(s.purposes) ? s.purposes.join(', ') : (s.purposes || '')\n            }));\n        }\n    } catch (e) {\n        console.error('Failed to load services:', e);\n        initialServices = [];\n    }\n\n    return {\n        services: initialServices,\n        selectedPredefined: '',\n\n        addPredefinedService() {\n            if (!this.selectedPredefined) return;\n\n            const template = predefinedServices[this.selectedPredefined];\n            if (!template) return;\n\n            // Check if already exists\n            if (this.services.some(s => s.name === template.name)) {\n                alert('This service is already added.');\n                return;\n            }\n\n            this.services.push({\n                ...template,\n                purposes: template.purposes.join(', ')\n            });\n            this.selectedPredefined = '';\n        },\n\n        removeService(index) {\n            this.services.splice(index, 1);\n        },\n\n        // Convert services to proper JSON format for submission\n        getServicesJSON() {\n            return JSON.stringify(this.services.map(s => ({\n                ...s,\n                // Convert purposes string back to array\n                purposes: typeof s.purposes === 'string'\n                    ? s.purposes.split(',').map(p => p.trim()).filter(p => p)\n                    : s.purposes\n            })));\n        }\n    };\n}\n\t\t</script>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 85, "\">\nfunction privacySettings() {\n    // Predefined services configuration\n    const predefinedServices = {\n        'klaro': {\n            name: 'klaro',\n            title: 'Essential Cookies',\n            description: 'Stores consent choices and UI preferences (required)',\n            purposes: ['essential'],\n            gcm_consent_type: '',\n            required: true,\n            default: true,\n            cookies: [{pattern: '^klaro'}, {pattern: '^ocms_lang$'}, {pattern: '^(__Host-|__Secure-)?session$'}, {pattern: '^ocms_informer_dismissed'}]\n        },\n        'google-analytics': {\n            name: 'google-analytics',\n            title: 'Google Analytics',\n            description: 'Website traffic analysis and statistics',\n            purposes: ['analytics'],\n            gcm_consent_type: 'analytics_storage',\n            required: false,\n            default: false,\n            cookies: [{pattern: '^_ga'}, {pattern: '^_gid'}, {pattern: '^_gat'}]\n        },\n        'google-ads': {\n            name: 'google-ads',\n            title: 'Google Ads',\n            description: 'Conversion tracking and personalized advertising',\n            purposes: ['marketing'],\n            gcm_consent_type: 'ad_storage,ad_user_data,ad_personalization',\n            required: false,\n            default: false,\n            cookies: [{pattern: '^_gcl'}, {pattern: '^_gac'}]\n        },\n        'google-tag-manager': {\n            name: 'google-tag-manager',\n            title: 'Google Tag Manager',\n            description: 'Tag management system for analytics and marketing tags',\n            purposes: ['functional'],\n            gcm_consent_type: '',\n            required: false,\n            default: false\n        },\n        'matomo': {\n            name: 'matomo',\n            title: 'Matomo',\n            description: 'Privacy-focused website analytics',\n            purposes: ['analytics'],\n            gcm_consent_type: '',\n            required: false,\n            default: false,\n            cookies: [{pattern: '^_pk_'}, {pattern: '^mtm_'}]\n        }\n    };\n\n    // Parse initial services from server data\n    let initialServices = [];\n    try {\n        const dataEl = document.getElementById('privacy-services-data');\n        const servicesData = JSON.parse(dataEl ? dataEl.dataset.services : '[]');\n        if (Array.isArray(servicesData) && servicesData.length > 0) {\n            // Convert purposes array to string for display\n            initialServices = servicesData.map(s => ({\n                ...s,\n                purposes: Array.isArray(s.purposes) ? s.purposes.join(', ') : (s.purposes || '')\n            }));\n        }\n    } catch (e) {\n        console.error('Failed to load services:', e);\n        initialServices = [];\n    }\n\n    return {\n        services: initialServices,\n        selectedPredefined: '',\n\n        addPredefinedService() {\n            if (!this.selectedPredefined) return;\n\n            const template = predefinedServices[this.selectedPredefined];\n            if (!template) return;\n\n            // Check if already exists\n            if (this.services.some(s => s.name === template.name)) {\n                alert('This service is already added.');\n                return;\n            }\n\n            this.services.push({\n                ...template,\n                purposes: template.purposes.join(', ')\n            });\n            this.selectedPredefined = '';\n        },\n\n        removeService(index) {\n            this.services.splice(index, 1);\n        },\n\n        // Convert services to proper JSON format for submission\n        getServicesJSON() {\n            return JSON.stringify(this.services.map(s => ({\n                ...s,\n                // Convert purposes string back to array\n                purposes: typeof s.purposes === 'string'\n                    ? s.purposes.split(',').map(p => p.trim()).filter(p => p)\n                    : s.purposes\n            })));\n        }\n    };\n}\n\t\t</script>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
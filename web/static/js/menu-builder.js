@@ -8,6 +8,7 @@
  *   data-i18n-confirm-delete - Translated "confirm delete" prompt
  *   data-i18n-item-page    - Translated "Page" label
  *   data-i18n-item-custom  - Translated "Custom Link" label
+ *   data-i18n-item-types   - JSON map of item type to translated label
  */
 
 function menuBuilder() {
@@ -17,6 +18,7 @@ function menuBuilder() {
         items: [],
         sortIteration: 0,
         customLink: { title: '', url: '', target: '_self', parent_id: 0 },
+        typedLink: { item_type: 'category', link_id: '', title: '', parent_id: 0 },
         pagesParentId: 0,
         selectedPages: [],
         editingItem: null,
@@ -28,7 +30,8 @@ function menuBuilder() {
         labels: {
             confirmDelete: 'Are you sure?',
             itemPage: 'Page',
-            itemCustom: 'Custom Link'
+            itemCustom: 'Custom Link',
+            itemTypes: {}
         },
 
         init() {
@@ -41,6 +44,17 @@ function menuBuilder() {
             this.labels.confirmDelete = el.dataset.i18nConfirmDelete || 'Are you sure?';
             this.labels.itemPage = el.dataset.i18nItemPage || 'Page';
             this.labels.itemCustom = el.dataset.i18nItemCustom || 'Custom Link';
+            this.labels.itemTypes = JSON.parse(el.dataset.i18nItemTypes || '{}');
+        },
+
+        /**
+         * Label of an item's type in the menu structure.
+         */
+        itemTypeLabel(item) {
+            if (item.item_type) {
+                return this.labels.itemTypes[item.item_type] || item.item_type;
+            }
+            return item.page_id ? this.labels.itemPage : this.labels.itemCustom;
         },
 
         // =====================================================================
@@ -108,6 +122,12 @@ function menuBuilder() {
                 }
             }
 
+            let linkId = item.link_id;
+            if (linkId !== null && typeof linkId === 'object') {
+                linkId = linkId.Valid ? linkId.Int64 : null;
+            }
+            const splitList = (list) => (list || '').split(',').filter(v => v);
+
             return {
                 id: item.id,
                 title: item.title,
@@ -119,6 +139,13 @@ function menuBuilder() {
                 parent_id: parentId,
                 css_class: typeof item.css_class === 'string' ? item.css_class : (item.css_class?.String || ''),
                 is_active: item.is_active,
+                item_type: item.item_type || '',
+                link_id: linkId ? String(linkId) : '',
+                visibility: item.visibility || '',
+                visible_roles: splitList(item.visible_roles),
+                visible_languages: splitList(item.visible_languages),
+                mega_menu: !!item.mega_menu,
+                image_url: item.image_url || '',
                 children: []
             };
         },
@@ -252,6 +279,43 @@ function menuBuilder() {
             }
         },
 
+        /**
+         * Blog and language items need no link target; a language item
+         * without one links to the current language's homepage.
+         */
+        typedLinkComplete() {
+            const type = this.typedLink.item_type;
+            return type === 'blog' || type === 'language' || !!this.typedLink.link_id;
+        },
+
+        async addTypedLink() {
+            if (!this.typedLink.title || !this.typedLinkComplete()) return;
+
+            try {
+                const parentId = this.typedLink.parent_id ? parseInt(this.typedLink.parent_id) : null;
+                const response = await fetch(`/admin/menus/${this.menuId}/items`, {
+                    method: 'POST',
+                    headers: { 'Content-Type': 'application/json' },
+                    body: JSON.stringify({
+                        title: this.typedLink.title,
+                        item_type: this.typedLink.item_type,
+                        link_id: this.typedLink.link_id ? parseInt(this.typedLink.link_id) : null,
+                        target: '_self',
+                        parent_id: parentId
+                    })
+                });
+
+                if (response.ok) {
+                    const data = await response.json();
+                    const newItem = this.parseItem(data.item);
+                    this.addItemToTree(newItem, parentId);
+                    this.typedLink = { item_type: this.typedLink.item_type, link_id: '', title: '', parent_id: 0 };
+                }
+            } catch (e) {
+                console.error('Failed to add link:', e);
+            }
+        },
+
         async addSelectedPages() {
             const parentId = this.pagesParentId ? parseInt(this.pagesParentId) : null;
             for (const page of this.selectedPages) {
@@ -284,7 +348,12 @@ function menuBuilder() {
                 ? "0"
                 : String(item.parent_id);
 
-            this.editingItem = { ...item, parent_id: "0" };
+            this.editingItem = {
+                ...item,
+                visible_roles: [...item.visible_roles],
+                visible_languages: [...item.visible_languages],
+                parent_id: "0"
+            };
 
             this.$nextTick(() => {
                 this.editingItem.parent_id = parentId;
@@ -307,7 +376,14 @@ function menuBuilder() {
                         css_class: this.editingItem.css_class,
                         is_active: this.editingItem.is_active,
                         page_id: this.editingItem.page_id,
-                        parent_id: newParentId
+                        parent_id: newParentId,
+                        item_type: this.editingItem.item_type,
+                        link_id: this.editingItem.link_id ? parseInt(this.editingItem.link_id) : null,
+                        visibility: this.editingItem.visibility,
+                        visible_roles: this.editingItem.visibility === 'roles' ? this.editingItem.visible_roles : [],
+                        visible_languages: this.editingItem.visible_languages,
+                        mega_menu: newParentId === 0 && this.editingItem.mega_menu,
+                        image_url: this.editingItem.image_url
                     })
                 });
 